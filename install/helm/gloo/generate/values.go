@@ -287,6 +287,7 @@ type GatewayProxy struct {
 	LoopBackAddress                string                       `json:"loopBackAddress,omitempty" desc:"Name on which to bind the loop-back interface for this instance of Envoy. Defaults to 127.0.0.1, but other common values may be localhost or ::1"`
 	Failover                       Failover                     `json:"failover" desc:"(Enterprise Only): Failover configuration"`
 	Disabled                       bool                         `json:"disabled,omitempty" desc:"Skips creation of this gateway proxy. Used to turn off gateway proxies created by preceding configurations"`
+	OrderedAds                     bool                         `json:"orderedAds,omitempty" desc:"set to true to have the Gloo control plane push xDS updates to this proxy in make-before-break order (clusters, endpoints, listeners, then routes) on its ADS stream"`
 }
 
 type GatewayProxyGatewaySettings struct {
@@ -386,6 +387,12 @@ type Ingress struct {
 	Deployment          *IngressDeployment `json:"deployment,omitempty"`
 	RequireIngressClass *bool              `json:"requireIngressClass" desc:"only serve traffic for Ingress objects with the Ingress Class annotation 'kubernetes.io/ingress.class'. By default the annotation value must be set to 'gloo', however this can be overriden via customIngressClass."`
 	CustomIngress       *bool              `json:"customIngressClass" desc:"Only relevant when requireIngressClass is set to true. Setting this value will cause the Gloo Edge Ingress Controller to process only those Ingress objects which have their ingress class set to this value (e.g. 'kubernetes.io/ingress.class=SOMEVALUE')."`
+	GatewayApi          *IngressGatewayApi `json:"gatewayApi,omitempty"`
+}
+
+type IngressGatewayApi struct {
+	Enabled        *bool   `json:"enabled,omitempty" desc:"translate the resources of the Kubernetes Gateway API (GatewayClasses, Gateways, HTTPRoutes, TLSRoutes and ReferenceGrants) into proxies. The Gateway API CRDs must be installed in the cluster."`
+	ControllerName *string `json:"controllerName,omitempty" desc:"the controller name of the GatewayClasses reconciled by the ingress controller. Defaults to solo.io/gloo-gateway"`
 }
 
 type IngressDeployment struct {
@@ -550,6 +550,25 @@ var _ = Describe("Helm Test", func() {
 						}
 					})
 				})
+
+				It("should opt the gateway-proxy into ordered ADS if gatewayProxies.gatewayProxy.orderedAds is set", func() {
+					prepareMakefile(namespace, helmValues{
+						valuesArgs: []string{"gatewayProxies.gatewayProxy.orderedAds=true"},
+					})
+
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "ConfigMap"
+					}).ExpectAll(func(configMap *unstructured.Unstructured) {
+						configMapObject, err := kuberesource.ConvertUnstructured(configMap)
+						Expect(err).NotTo(HaveOccurred(), fmt.Sprintf("ConfigMap %+v should be able to convert from unstructured", configMap))
+						structuredConfigMap, ok := configMapObject.(*v1.ConfigMap)
+						Expect(ok).To(BeTrue(), fmt.Sprintf("ConfigMap %+v should be able to cast to a structured config map", configMap))
+
+						if structuredConfigMap.GetName() == "gateway-proxy-envoy-config" {
+							Expect(structuredConfigMap.Data["envoy.yaml"]).To(ContainSubstring("ordered_ads: true"))
+						}
+					})
+				})
 			})
 
 			Context("gloo with istio sds settings", func() {
@@ -2900,6 +2919,35 @@ metadata:
 
 			})
 
+			Context("ingress deployment", func() {
+
+				It("enables the gateway api translator", func() {
+					prepareMakefile(namespace, helmValues{
+						valuesArgs: []string{
+							"ingress.enabled=true",
+							"ingress.gatewayApi.enabled=true",
+							"ingress.gatewayApi.controllerName=example.com/gateway",
+						},
+					})
+
+					var resourcesTested = 0
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "Deployment" && resource.GetName() == "ingress"
+					}).ExpectAll(func(deployment *unstructured.Unstructured) {
+						deploymentObject, err := kuberesource.ConvertUnstructured(deployment)
+						Expect(err).NotTo(HaveOccurred())
+						structuredDeployment, ok := deploymentObject.(*appsv1.Deployment)
+						Expect(ok).To(BeTrue())
+
+						env := structuredDeployment.Spec.Template.Spec.Containers[0].Env
+						Expect(env).To(ContainElement(v1.EnvVar{Name: "ENABLE_GATEWAY_API", Value: "true"}))
+						Expect(env).To(ContainElement(v1.EnvVar{Name: "GATEWAY_API_CONTROLLER_NAME", Value: "example.com/gateway"}))
+						resourcesTested += 1
+					})
+					Expect(resourcesTested).To(Equal(1))
+				})
+			})
+
 			Context("ingress-proxy service", func() {
 
 				var ingressProxyService *v1.Service
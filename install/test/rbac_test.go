@@ -347,6 +347,16 @@ var _ = Describe("RBAC Test", func() {
 								Resources: []string{"proxies"},
 								Verbs:     []string{"get", "list", "watch", "create", "update", "delete"},
 							},
+							{
+								APIGroups: []string{"gloo.solo.io"},
+								Resources: []string{"upstreamgroups"},
+								Verbs:     []string{"get", "list", "watch", "update"},
+							},
+							{
+								APIGroups: []string{"coordination.k8s.io"},
+								Resources: []string{"leases"},
+								Verbs:     []string{"get", "create", "update"},
+							},
 						},
 						RoleRef: rbacv1.RoleRef{
 							APIGroup: "rbac.authorization.k8s.io",
@@ -404,7 +414,7 @@ var _ = Describe("RBAC Test", func() {
 						Rules: []rbacv1.PolicyRule{
 							{
 								APIGroups: []string{"gateway.solo.io"},
-								Resources: []string{"virtualservices", "routetables"},
+								Resources: []string{"virtualservices", "routetables", "routeoptions", "virtualhostoptions", "httpgateways", "referencepolicies", "canaries"},
 								Verbs:     []string{"get", "list", "watch", "update"},
 							}, {
 								APIGroups: []string{"gateway.solo.io"},
@@ -18,6 +18,12 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{"gloo.solo.io"},
 		[]string{"proxies"},
 		[]string{"get", "list", "watch", "create", "update", "delete"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gateway",
+		namespace,
+		[]string{"gloo.solo.io"},
+		[]string{"upstreamgroups"},
+		[]string{"get", "list", "watch", "update"})
 	permissions.AddExpectedPermission(
 		"gloo-system.gateway",
 		namespace,
@@ -28,7 +34,7 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		"gloo-system.gateway",
 		namespace,
 		[]string{"gateway.solo.io"},
-		[]string{"virtualservices", "routetables"},
+		[]string{"virtualservices", "routetables", "routeoptions", "virtualhostoptions", "httpgateways", "referencepolicies", "canaries"},
 		[]string{"get", "list", "watch", "update"})
 
 	// Gloo
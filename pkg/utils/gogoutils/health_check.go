@@ -47,6 +47,11 @@ func ToGlooOutlierDetection(detection *envoycluster.OutlierDetection) *envoyclus
 		ConsecutiveLocalOriginFailure:          UInt32ProtoToGogo(detection.GetConsecutiveLocalOriginFailure()),
 		EnforcingConsecutiveLocalOriginFailure: UInt32ProtoToGogo(detection.GetEnforcingConsecutiveLocalOriginFailure()),
 		EnforcingLocalOriginSuccessRate:        UInt32ProtoToGogo(detection.GetEnforcingLocalOriginSuccessRate()),
+		FailurePercentageThreshold:             UInt32ProtoToGogo(detection.GetFailurePercentageThreshold()),
+		EnforcingFailurePercentage:             UInt32ProtoToGogo(detection.GetEnforcingFailurePercentage()),
+		EnforcingFailurePercentageLocalOrigin:  UInt32ProtoToGogo(detection.GetEnforcingFailurePercentageLocalOrigin()),
+		FailurePercentageMinimumHosts:          UInt32ProtoToGogo(detection.GetFailurePercentageMinimumHosts()),
+		FailurePercentageRequestVolume:         UInt32ProtoToGogo(detection.GetFailurePercentageRequestVolume()),
 	}
 }
 
@@ -81,6 +86,11 @@ func ToEnvoyOutlierDetection(detection *envoycluster_gloo.OutlierDetection) *env
 		ConsecutiveLocalOriginFailure:          UInt32GogoToProto(detection.GetConsecutiveLocalOriginFailure()),
 		EnforcingConsecutiveLocalOriginFailure: UInt32GogoToProto(detection.GetEnforcingConsecutiveLocalOriginFailure()),
 		EnforcingLocalOriginSuccessRate:        UInt32GogoToProto(detection.GetEnforcingLocalOriginSuccessRate()),
+		FailurePercentageThreshold:             UInt32GogoToProto(detection.GetFailurePercentageThreshold()),
+		EnforcingFailurePercentage:             UInt32GogoToProto(detection.GetEnforcingFailurePercentage()),
+		EnforcingFailurePercentageLocalOrigin:  UInt32GogoToProto(detection.GetEnforcingFailurePercentageLocalOrigin()),
+		FailurePercentageMinimumHosts:          UInt32GogoToProto(detection.GetFailurePercentageMinimumHosts()),
+		FailurePercentageRequestVolume:         UInt32GogoToProto(detection.GetFailurePercentageRequestVolume()),
 	}
 }
 
@@ -65,6 +65,8 @@ func StringifySnapshot(snapshot interface{}) string {
 type ProtoRedactor interface {
 	// Build a JSON string representation of the proto message, zeroing-out all fields in the proto that match some criteria
 	BuildRedactedJsonString(message proto.Message) (string, error)
+	// Build a clone of the proto message, zeroing-out all fields in the clone that match some criteria
+	Redact(message proto.Message) (proto.Message, error)
 }
 
 // build a ProtoRedactor that zeroes out fields that have the given struct tag set to the given value
@@ -81,6 +83,16 @@ type protoRedactor struct {
 }
 
 func (p *protoRedactor) BuildRedactedJsonString(message proto.Message) (string, error) {
+	clone, err := p.Redact(message)
+	if err != nil {
+		return "", err
+	}
+
+	bytes, err := json.Marshal(clone)
+	return string(bytes), err
+}
+
+func (p *protoRedactor) Redact(message proto.Message) (proto.Message, error) {
 	// make a clone so that we can mutate it and zero-out fields
 	clone := proto.Clone(message)
 
@@ -90,11 +102,9 @@ func (p *protoRedactor) BuildRedactedJsonString(message proto.Message) (string,
 	}
 	err := reflectwalk.Walk(clone, walker)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-
-	bytes, err := json.Marshal(clone)
-	return string(bytes), err
+	return clone, nil
 }
 
 // run the StructField callback for every field in the proto
@@ -113,3 +123,43 @@ func (s *structWalker) StructField(field reflect.StructField, value reflect.Valu
 	}
 	return nil
 }
+
+// The fields holding secrets in config which cannot be redacted with struct tags, such as the TLS private keys of
+// Envoy transport sockets, which are packed in Any fields
+var SensitiveJsonFields = []string{"private_key", "password", "session_ticket_keys", "token", "client_secret"}
+
+// Replace the values of the fields with any of the given names, at any depth of the JSON document, with Redacted.
+// The names match both the original and the lowerCamelCase names of the fields.
+func RedactJsonFields(jsn []byte, fieldNames []string) ([]byte, error) {
+	var document interface{}
+	if err := json.Unmarshal(jsn, &document); err != nil {
+		return nil, err
+	}
+	redacted := map[string]bool{}
+	for _, name := range fieldNames {
+		redacted[normalizeFieldName(name)] = true
+	}
+	return json.Marshal(redactJsonValue(document, redacted))
+}
+
+func redactJsonValue(value interface{}, redacted map[string]bool) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range typed {
+			if redacted[normalizeFieldName(key)] {
+				typed[key] = Redacted
+			} else {
+				typed[key] = redactJsonValue(fieldValue, redacted)
+			}
+		}
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = redactJsonValue(item, redacted)
+		}
+	}
+	return value
+}
+
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "", -1))
+}
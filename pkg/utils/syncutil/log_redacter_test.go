@@ -156,3 +156,35 @@ var _ = Describe("Log Redacter", func() {
 	}),
 	)
 })
+
+var _ = Describe("Json Redacter", func() {
+
+	It("redacts the fields with the given names at any depth", func() {
+		jsn := []byte(`{"name":"listener","filterChains":[{"transportSocket":{"typedConfig":{` +
+			`"@type":"type.googleapis.com/envoy.api.v2.auth.DownstreamTlsContext","commonTlsContext":{"tlsCertificates":[{` +
+			`"certificateChain":{"inlineString":"cert"},"privateKey":{"inlineString":"RSA PRIVATE KEY CONTENT"}}]}}}}],` +
+			`"vault_secret_source":{"token":"vault-token"}}`)
+
+		redacted, err := syncutil.RedactJsonFields(jsn, syncutil.SensitiveJsonFields)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(redacted)).NotTo(ContainSubstring("RSA PRIVATE KEY CONTENT"))
+		Expect(string(redacted)).NotTo(ContainSubstring("vault-token"))
+		Expect(string(redacted)).To(ContainSubstring(`"privateKey":"` + syncutil.Redacted + `"`))
+		Expect(string(redacted)).To(ContainSubstring(`"certificateChain":{"inlineString":"cert"}`))
+	})
+
+	It("redacts tagged fields of Settings", func() {
+		redactor := syncutil.NewProtoRedactor(syncutil.LogRedactorTag, syncutil.LogRedactorTagValue)
+		jsonString, err := redactor.BuildRedactedJsonString(&v1.Settings{
+			SecretSource: &v1.Settings_VaultSecretSource{VaultSecretSource: &v1.Settings_VaultSecrets{
+				Token:   "vault-token",
+				Address: "http://vault:8200",
+			}},
+			Consul: &v1.Settings_ConsulConfiguration{Token: "consul-token"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jsonString).NotTo(ContainSubstring("vault-token"))
+		Expect(jsonString).NotTo(ContainSubstring("consul-token"))
+		Expect(jsonString).To(ContainSubstring("http://vault:8200"))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"strconv"
 	"syscall"
 
 	"github.com/solo-io/gloo/projects/envoyinit/cmd/utils"
@@ -19,6 +20,12 @@ func main() {
 	if err != nil {
 		log.Fatalf("initializer failed: %v", err)
 	}
+	if orderedAds() {
+		outCfg, err = utils.EnableOrderedAds(outCfg)
+		if err != nil {
+			log.Fatalf("initializer failed: %v", err)
+		}
+	}
 
 	// best effort - write to a file for debug purposes.
 	// this might fail if root fs is read only
@@ -57,3 +64,8 @@ func outputCfg() string {
 	}
 	return "/tmp/envoy.yaml"
 }
+
+func orderedAds() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv("ORDERED_ADS"))
+	return enabled
+}
@@ -4,9 +4,13 @@ import (
 	"bytes"
 	"os"
 
+	"github.com/ghodss/yaml"
 	"github.com/solo-io/envoy-operator/pkg/downward"
 )
 
+// must match xds.OrderedAdsMetadataKey in projects/gloo/pkg/xds/ads_server.go
+const orderedAdsMetadataKey = "ordered_ads"
+
 func GetConfig(inputFile string) (string, error) {
 	inreader, err := os.Open(inputFile)
 	if err != nil {
@@ -22,3 +26,31 @@ func GetConfig(inputFile string) (string, error) {
 	}
 	return buffer.String(), nil
 }
+
+// EnableOrderedAds sets the node metadata which opts the proxy into receiving
+// xDS updates from Gloo in make-before-break order.
+func EnableOrderedAds(cfg string) (string, error) {
+	var bootstrap map[string]interface{}
+	if err := yaml.Unmarshal([]byte(cfg), &bootstrap); err != nil {
+		return "", err
+	}
+	if bootstrap == nil {
+		bootstrap = map[string]interface{}{}
+	}
+	node, _ := bootstrap["node"].(map[string]interface{})
+	if node == nil {
+		node = map[string]interface{}{}
+		bootstrap["node"] = node
+	}
+	metadata, _ := node["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+		node["metadata"] = metadata
+	}
+	metadata[orderedAdsMetadataKey] = true
+	out, err := yaml.Marshal(bootstrap)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
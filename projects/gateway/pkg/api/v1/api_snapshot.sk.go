@@ -14,16 +14,24 @@ import (
 )
 
 type ApiSnapshot struct {
-	VirtualServices VirtualServiceList
-	RouteTables     RouteTableList
-	Gateways        GatewayList
+	VirtualServices    VirtualServiceList
+	RouteTables        RouteTableList
+	Gateways           GatewayList
+	RouteOptions       RouteOptionList
+	VirtualHostOptions VirtualHostOptionList
+	HttpGateways       MatchableHttpGatewayList
+	ReferencePolicies  ReferencePolicyList
 }
 
 func (s ApiSnapshot) Clone() ApiSnapshot {
 	return ApiSnapshot{
-		VirtualServices: s.VirtualServices.Clone(),
-		RouteTables:     s.RouteTables.Clone(),
-		Gateways:        s.Gateways.Clone(),
+		VirtualServices:    s.VirtualServices.Clone(),
+		RouteTables:        s.RouteTables.Clone(),
+		Gateways:           s.Gateways.Clone(),
+		RouteOptions:       s.RouteOptions.Clone(),
+		VirtualHostOptions: s.VirtualHostOptions.Clone(),
+		HttpGateways:       s.HttpGateways.Clone(),
+		ReferencePolicies:  s.ReferencePolicies.Clone(),
 	}
 }
 
@@ -40,6 +48,18 @@ func (s ApiSnapshot) Hash(hasher hash.Hash64) (uint64, error) {
 	if _, err := s.hashGateways(hasher); err != nil {
 		return 0, err
 	}
+	if _, err := s.hashRouteOptions(hasher); err != nil {
+		return 0, err
+	}
+	if _, err := s.hashVirtualHostOptions(hasher); err != nil {
+		return 0, err
+	}
+	if _, err := s.hashHttpGateways(hasher); err != nil {
+		return 0, err
+	}
+	if _, err := s.hashReferencePolicies(hasher); err != nil {
+		return 0, err
+	}
 	return hasher.Sum64(), nil
 }
 
@@ -55,6 +75,22 @@ func (s ApiSnapshot) hashGateways(hasher hash.Hash64) (uint64, error) {
 	return hashutils.HashAllSafe(hasher, s.Gateways.AsInterfaces()...)
 }
 
+func (s ApiSnapshot) hashRouteOptions(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.RouteOptions.AsInterfaces()...)
+}
+
+func (s ApiSnapshot) hashVirtualHostOptions(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.VirtualHostOptions.AsInterfaces()...)
+}
+
+func (s ApiSnapshot) hashHttpGateways(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.HttpGateways.AsInterfaces()...)
+}
+
+func (s ApiSnapshot) hashReferencePolicies(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.ReferencePolicies.AsInterfaces()...)
+}
+
 func (s ApiSnapshot) HashFields() []zap.Field {
 	var fields []zap.Field
 	hasher := fnv.New64()
@@ -73,6 +109,26 @@ func (s ApiSnapshot) HashFields() []zap.Field {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	fields = append(fields, zap.Uint64("gateways", GatewaysHash))
+	RouteOptionsHash, err := s.hashRouteOptions(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("routeOptions", RouteOptionsHash))
+	VirtualHostOptionsHash, err := s.hashVirtualHostOptions(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("virtualHostOptions", VirtualHostOptionsHash))
+	HttpGatewaysHash, err := s.hashHttpGateways(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("httpGateways", HttpGatewaysHash))
+	ReferencePoliciesHash, err := s.hashReferencePolicies(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("referencePolicies", ReferencePoliciesHash))
 	snapshotHash, err := s.Hash(hasher)
 	if err != nil {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
@@ -81,10 +137,14 @@ func (s ApiSnapshot) HashFields() []zap.Field {
 }
 
 type ApiSnapshotStringer struct {
-	Version         uint64
-	VirtualServices []string
-	RouteTables     []string
-	Gateways        []string
+	Version            uint64
+	VirtualServices    []string
+	RouteTables        []string
+	Gateways           []string
+	RouteOptions       []string
+	VirtualHostOptions []string
+	HttpGateways       []string
+	ReferencePolicies  []string
 }
 
 func (ss ApiSnapshotStringer) String() string {
@@ -105,6 +165,26 @@ func (ss ApiSnapshotStringer) String() string {
 		s += fmt.Sprintf("    %v\n", name)
 	}
 
+	s += fmt.Sprintf("  RouteOptions %v\n", len(ss.RouteOptions))
+	for _, name := range ss.RouteOptions {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
+	s += fmt.Sprintf("  VirtualHostOptions %v\n", len(ss.VirtualHostOptions))
+	for _, name := range ss.VirtualHostOptions {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
+	s += fmt.Sprintf("  HttpGateways %v\n", len(ss.HttpGateways))
+	for _, name := range ss.HttpGateways {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
+	s += fmt.Sprintf("  ReferencePolicies %v\n", len(ss.ReferencePolicies))
+	for _, name := range ss.ReferencePolicies {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
 	return s
 }
 
@@ -114,9 +194,13 @@ func (s ApiSnapshot) Stringer() ApiSnapshotStringer {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	return ApiSnapshotStringer{
-		Version:         snapshotHash,
-		VirtualServices: s.VirtualServices.NamespacesDotNames(),
-		RouteTables:     s.RouteTables.NamespacesDotNames(),
-		Gateways:        s.Gateways.NamespacesDotNames(),
+		Version:            snapshotHash,
+		VirtualServices:    s.VirtualServices.NamespacesDotNames(),
+		RouteTables:        s.RouteTables.NamespacesDotNames(),
+		Gateways:           s.Gateways.NamespacesDotNames(),
+		RouteOptions:       s.RouteOptions.NamespacesDotNames(),
+		VirtualHostOptions: s.VirtualHostOptions.NamespacesDotNames(),
+		HttpGateways:       s.HttpGateways.NamespacesDotNames(),
+		ReferencePolicies:  s.ReferencePolicies.NamespacesDotNames(),
 	}
 }
@@ -83,26 +83,38 @@ type ApiEmitter interface {
 	VirtualService() VirtualServiceClient
 	RouteTable() RouteTableClient
 	Gateway() GatewayClient
+	RouteOption() RouteOptionClient
+	VirtualHostOption() VirtualHostOptionClient
+	MatchableHttpGateway() MatchableHttpGatewayClient
+	ReferencePolicy() ReferencePolicyClient
 }
 
-func NewApiEmitter(virtualServiceClient VirtualServiceClient, routeTableClient RouteTableClient, gatewayClient GatewayClient) ApiEmitter {
-	return NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, make(chan struct{}))
+func NewApiEmitter(virtualServiceClient VirtualServiceClient, routeTableClient RouteTableClient, gatewayClient GatewayClient, routeOptionClient RouteOptionClient, virtualHostOptionClient VirtualHostOptionClient, matchableHttpGatewayClient MatchableHttpGatewayClient, referencePolicyClient ReferencePolicyClient) ApiEmitter {
+	return NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, routeOptionClient, virtualHostOptionClient, matchableHttpGatewayClient, referencePolicyClient, make(chan struct{}))
 }
 
-func NewApiEmitterWithEmit(virtualServiceClient VirtualServiceClient, routeTableClient RouteTableClient, gatewayClient GatewayClient, emit <-chan struct{}) ApiEmitter {
+func NewApiEmitterWithEmit(virtualServiceClient VirtualServiceClient, routeTableClient RouteTableClient, gatewayClient GatewayClient, routeOptionClient RouteOptionClient, virtualHostOptionClient VirtualHostOptionClient, matchableHttpGatewayClient MatchableHttpGatewayClient, referencePolicyClient ReferencePolicyClient, emit <-chan struct{}) ApiEmitter {
 	return &apiEmitter{
-		virtualService: virtualServiceClient,
-		routeTable:     routeTableClient,
-		gateway:        gatewayClient,
-		forceEmit:      emit,
+		virtualService:       virtualServiceClient,
+		routeTable:           routeTableClient,
+		gateway:              gatewayClient,
+		routeOption:          routeOptionClient,
+		virtualHostOption:    virtualHostOptionClient,
+		matchableHttpGateway: matchableHttpGatewayClient,
+		referencePolicy:      referencePolicyClient,
+		forceEmit:            emit,
 	}
 }
 
 type apiEmitter struct {
-	forceEmit      <-chan struct{}
-	virtualService VirtualServiceClient
-	routeTable     RouteTableClient
-	gateway        GatewayClient
+	forceEmit            <-chan struct{}
+	virtualService       VirtualServiceClient
+	routeTable           RouteTableClient
+	gateway              GatewayClient
+	routeOption          RouteOptionClient
+	virtualHostOption    VirtualHostOptionClient
+	matchableHttpGateway MatchableHttpGatewayClient
+	referencePolicy      ReferencePolicyClient
 }
 
 func (c *apiEmitter) Register() error {
@@ -115,6 +127,18 @@ func (c *apiEmitter) Register() error {
 	if err := c.gateway.Register(); err != nil {
 		return err
 	}
+	if err := c.routeOption.Register(); err != nil {
+		return err
+	}
+	if err := c.virtualHostOption.Register(); err != nil {
+		return err
+	}
+	if err := c.matchableHttpGateway.Register(); err != nil {
+		return err
+	}
+	if err := c.referencePolicy.Register(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -130,6 +154,22 @@ func (c *apiEmitter) Gateway() GatewayClient {
 	return c.gateway
 }
 
+func (c *apiEmitter) RouteOption() RouteOptionClient {
+	return c.routeOption
+}
+
+func (c *apiEmitter) VirtualHostOption() VirtualHostOptionClient {
+	return c.virtualHostOption
+}
+
+func (c *apiEmitter) MatchableHttpGateway() MatchableHttpGatewayClient {
+	return c.matchableHttpGateway
+}
+
+func (c *apiEmitter) ReferencePolicy() ReferencePolicyClient {
+	return c.referencePolicy
+}
+
 func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *ApiSnapshot, <-chan error, error) {
 
 	if len(watchNamespaces) == 0 {
@@ -170,6 +210,38 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 	gatewayChan := make(chan gatewayListWithNamespace)
 
 	var initialGatewayList GatewayList
+	/* Create channel for RouteOption */
+	type routeOptionListWithNamespace struct {
+		list      RouteOptionList
+		namespace string
+	}
+	routeOptionChan := make(chan routeOptionListWithNamespace)
+
+	var initialRouteOptionList RouteOptionList
+	/* Create channel for VirtualHostOption */
+	type virtualHostOptionListWithNamespace struct {
+		list      VirtualHostOptionList
+		namespace string
+	}
+	virtualHostOptionChan := make(chan virtualHostOptionListWithNamespace)
+
+	var initialVirtualHostOptionList VirtualHostOptionList
+	/* Create channel for MatchableHttpGateway */
+	type matchableHttpGatewayListWithNamespace struct {
+		list      MatchableHttpGatewayList
+		namespace string
+	}
+	matchableHttpGatewayChan := make(chan matchableHttpGatewayListWithNamespace)
+
+	var initialMatchableHttpGatewayList MatchableHttpGatewayList
+	/* Create channel for ReferencePolicy */
+	type referencePolicyListWithNamespace struct {
+		list      ReferencePolicyList
+		namespace string
+	}
+	referencePolicyChan := make(chan referencePolicyListWithNamespace)
+
+	var initialReferencePolicyList ReferencePolicyList
 
 	currentSnapshot := ApiSnapshot{}
 
@@ -228,6 +300,78 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 			defer done.Done()
 			errutils.AggregateErrs(ctx, errs, gatewayErrs, namespace+"-gateways")
 		}(namespace)
+		/* Setup namespaced watch for RouteOption */
+		{
+			routeOptions, err := c.routeOption.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial RouteOption list")
+			}
+			initialRouteOptionList = append(initialRouteOptionList, routeOptions...)
+		}
+		routeOptionNamespacesChan, routeOptionErrs, err := c.routeOption.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting RouteOption watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, routeOptionErrs, namespace+"-routeOptions")
+		}(namespace)
+		/* Setup namespaced watch for VirtualHostOption */
+		{
+			virtualHostOptions, err := c.virtualHostOption.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial VirtualHostOption list")
+			}
+			initialVirtualHostOptionList = append(initialVirtualHostOptionList, virtualHostOptions...)
+		}
+		virtualHostOptionNamespacesChan, virtualHostOptionErrs, err := c.virtualHostOption.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting VirtualHostOption watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, virtualHostOptionErrs, namespace+"-virtualHostOptions")
+		}(namespace)
+		/* Setup namespaced watch for MatchableHttpGateway */
+		{
+			httpGateways, err := c.matchableHttpGateway.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial MatchableHttpGateway list")
+			}
+			initialMatchableHttpGatewayList = append(initialMatchableHttpGatewayList, httpGateways...)
+		}
+		matchableHttpGatewayNamespacesChan, matchableHttpGatewayErrs, err := c.matchableHttpGateway.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting MatchableHttpGateway watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, matchableHttpGatewayErrs, namespace+"-httpGateways")
+		}(namespace)
+		/* Setup namespaced watch for ReferencePolicy */
+		{
+			referencePolicies, err := c.referencePolicy.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial ReferencePolicy list")
+			}
+			initialReferencePolicyList = append(initialReferencePolicyList, referencePolicies...)
+		}
+		referencePolicyNamespacesChan, referencePolicyErrs, err := c.referencePolicy.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting ReferencePolicy watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, referencePolicyErrs, namespace+"-referencePolicies")
+		}(namespace)
 
 		/* Watch for changes and update snapshot */
 		go func(namespace string) {
@@ -253,6 +397,30 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 						return
 					case gatewayChan <- gatewayListWithNamespace{list: gatewayList, namespace: namespace}:
 					}
+				case routeOptionList := <-routeOptionNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case routeOptionChan <- routeOptionListWithNamespace{list: routeOptionList, namespace: namespace}:
+					}
+				case virtualHostOptionList := <-virtualHostOptionNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case virtualHostOptionChan <- virtualHostOptionListWithNamespace{list: virtualHostOptionList, namespace: namespace}:
+					}
+				case matchableHttpGatewayList := <-matchableHttpGatewayNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case matchableHttpGatewayChan <- matchableHttpGatewayListWithNamespace{list: matchableHttpGatewayList, namespace: namespace}:
+					}
+				case referencePolicyList := <-referencePolicyNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case referencePolicyChan <- referencePolicyListWithNamespace{list: referencePolicyList, namespace: namespace}:
+					}
 				}
 			}
 		}(namespace)
@@ -263,6 +431,14 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 	currentSnapshot.RouteTables = initialRouteTableList.Sort()
 	/* Initialize snapshot for Gateways */
 	currentSnapshot.Gateways = initialGatewayList.Sort()
+	/* Initialize snapshot for RouteOptions */
+	currentSnapshot.RouteOptions = initialRouteOptionList.Sort()
+	/* Initialize snapshot for VirtualHostOptions */
+	currentSnapshot.VirtualHostOptions = initialVirtualHostOptionList.Sort()
+	/* Initialize snapshot for HttpGateways */
+	currentSnapshot.HttpGateways = initialMatchableHttpGatewayList.Sort()
+	/* Initialize snapshot for ReferencePolicies */
+	currentSnapshot.ReferencePolicies = initialReferencePolicyList.Sort()
 
 	snapshots := make(chan *ApiSnapshot)
 	go func() {
@@ -297,6 +473,10 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 		virtualServicesByNamespace := make(map[string]VirtualServiceList)
 		routeTablesByNamespace := make(map[string]RouteTableList)
 		gatewaysByNamespace := make(map[string]GatewayList)
+		routeOptionsByNamespace := make(map[string]RouteOptionList)
+		virtualHostOptionsByNamespace := make(map[string]VirtualHostOptionList)
+		httpGatewaysByNamespace := make(map[string]MatchableHttpGatewayList)
+		referencePoliciesByNamespace := make(map[string]ReferencePolicyList)
 
 		for {
 			record := func() { stats.Record(ctx, mApiSnapshotIn.M(1)) }
@@ -369,6 +549,82 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 					gatewayList = append(gatewayList, gateways...)
 				}
 				currentSnapshot.Gateways = gatewayList.Sort()
+			case routeOptionNamespacedList := <-routeOptionChan:
+				record()
+
+				namespace := routeOptionNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"route_option",
+					mApiResourcesIn,
+				)
+
+				// merge lists by namespace
+				routeOptionsByNamespace[namespace] = routeOptionNamespacedList.list
+				var routeOptionList RouteOptionList
+				for _, routeOptions := range routeOptionsByNamespace {
+					routeOptionList = append(routeOptionList, routeOptions...)
+				}
+				currentSnapshot.RouteOptions = routeOptionList.Sort()
+			case virtualHostOptionNamespacedList := <-virtualHostOptionChan:
+				record()
+
+				namespace := virtualHostOptionNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"virtual_host_option",
+					mApiResourcesIn,
+				)
+
+				// merge lists by namespace
+				virtualHostOptionsByNamespace[namespace] = virtualHostOptionNamespacedList.list
+				var virtualHostOptionList VirtualHostOptionList
+				for _, virtualHostOptions := range virtualHostOptionsByNamespace {
+					virtualHostOptionList = append(virtualHostOptionList, virtualHostOptions...)
+				}
+				currentSnapshot.VirtualHostOptions = virtualHostOptionList.Sort()
+			case matchableHttpGatewayNamespacedList := <-matchableHttpGatewayChan:
+				record()
+
+				namespace := matchableHttpGatewayNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"matchable_http_gateway",
+					mApiResourcesIn,
+				)
+
+				// merge lists by namespace
+				httpGatewaysByNamespace[namespace] = matchableHttpGatewayNamespacedList.list
+				var matchableHttpGatewayList MatchableHttpGatewayList
+				for _, httpGateways := range httpGatewaysByNamespace {
+					matchableHttpGatewayList = append(matchableHttpGatewayList, httpGateways...)
+				}
+				currentSnapshot.HttpGateways = matchableHttpGatewayList.Sort()
+			case referencePolicyNamespacedList := <-referencePolicyChan:
+				record()
+
+				namespace := referencePolicyNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"reference_policy",
+					mApiResourcesIn,
+				)
+
+				// merge lists by namespace
+				referencePoliciesByNamespace[namespace] = referencePolicyNamespacedList.list
+				var referencePolicyList ReferencePolicyList
+				for _, referencePolicies := range referencePoliciesByNamespace {
+					referencePolicyList = append(referencePolicyList, referencePolicies...)
+				}
+				currentSnapshot.ReferencePolicies = referencePolicyList.Sort()
 			}
 		}
 	}()
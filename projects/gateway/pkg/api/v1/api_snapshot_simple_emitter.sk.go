@@ -94,6 +94,14 @@ func (c *apiSimpleEmitter) Snapshots(ctx context.Context) (<-chan *ApiSnapshot,
 						currentSnapshot.RouteTables = append(currentSnapshot.RouteTables, typed)
 					case *Gateway:
 						currentSnapshot.Gateways = append(currentSnapshot.Gateways, typed)
+					case *RouteOption:
+						currentSnapshot.RouteOptions = append(currentSnapshot.RouteOptions, typed)
+					case *VirtualHostOption:
+						currentSnapshot.VirtualHostOptions = append(currentSnapshot.VirtualHostOptions, typed)
+					case *MatchableHttpGateway:
+						currentSnapshot.HttpGateways = append(currentSnapshot.HttpGateways, typed)
+					case *ReferencePolicy:
+						currentSnapshot.ReferencePolicies = append(currentSnapshot.ReferencePolicies, typed)
 					default:
 						select {
 						case errs <- fmt.Errorf("ApiSnapshotEmitter "+
@@ -0,0 +1,320 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gateway/api/v1/canary.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A **Canary** progressively shifts the traffic of an Upstream Group to a new version of an upstream.
+//
+// The Upstream Group must have two destinations: the canary upstream, and the primary upstream that serves the rest of
+// the traffic. At each interval, the gateway controller checks the stats of the requests Envoy sent to the canary
+// upstream during the interval. If their error rate and latency are under the thresholds, it increases the weight of the
+// canary by `stepWeight`, until the canary receives all the traffic. Otherwise, it counts a failed check, and once
+// `failureThreshold` checks failed, it rolls back the canary by sending all the traffic to the primary upstream.
+//
+// The progress of the canary, and the reason of its rollback, are recorded in its status. Changing the canary upstream
+// starts a new rollout.
+//
+// ```yaml
+// apiVersion: gateway.solo.io/v1
+// kind: Canary
+// metadata:
+//
+//	name: 'petstore'
+//	namespace: 'gloo-system'
+//
+// spec:
+//
+//	upstreamGroup:
+//	  name: 'petstore'
+//	  namespace: 'gloo-system'
+//	canaryUpstream:
+//	  name: 'petstore-v2'
+//	  namespace: 'gloo-system'
+//	interval: 1m
+//	stepWeight: 10
+//	maxErrorRate: 1
+//	maxLatency: 500ms
+//	statsUrls:
+//	- 'http://gateway-proxy-stats.gloo-system:8081/metrics'
+//
+// ```
+type Canary struct {
+	// The Upstream Group whose weights the canary shifts.
+	UpstreamGroup *core.ResourceRef `protobuf:"bytes,1,opt,name=upstream_group,json=upstreamGroup,proto3" json:"upstream_group,omitempty"`
+	// The destination of the Upstream Group that the traffic is shifted to.
+	CanaryUpstream *core.ResourceRef `protobuf:"bytes,2,opt,name=canary_upstream,json=canaryUpstream,proto3" json:"canary_upstream,omitempty"`
+	// The time between two checks of the canary. Defaults to 1 minute.
+	Interval *time.Duration `protobuf:"bytes,3,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	// The percentage of the traffic added to the canary after each successful check, between 1 and 100. Defaults to 10.
+	StepWeight *types.UInt32Value `protobuf:"bytes,4,opt,name=step_weight,json=stepWeight,proto3" json:"step_weight,omitempty"`
+	// The maximum percentage of the requests to the canary that may fail with a 5xx status code, between 0 and 100.
+	// Set it to 0 to roll back the canary on any 5xx response. Defaults to 1.
+	MaxErrorRate *types.DoubleValue `protobuf:"bytes,5,opt,name=max_error_rate,json=maxErrorRate,proto3" json:"max_error_rate,omitempty"`
+	// The maximum 99th percentile of the latency of the requests to the canary. Not checked if unset.
+	MaxLatency *time.Duration `protobuf:"bytes,6,opt,name=max_latency,json=maxLatency,proto3,stdduration" json:"max_latency,omitempty"`
+	// The number of consecutive failed checks after which the canary is rolled back. Defaults to 1.
+	FailureThreshold uint32 `protobuf:"varint,7,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	// The minimum number of requests to the canary during an interval for the interval to be checked. The weight of the
+	// canary is not increased during the intervals with fewer requests. Set it to 0 to also increase the weight of the
+	// canary after the intervals without requests. Defaults to 1.
+	MinRequests *types.UInt32Value `protobuf:"bytes,8,opt,name=min_requests,json=minRequests,proto3" json:"min_requests,omitempty"`
+	// The URLs of the Prometheus stats endpoints of the Envoy proxies serving the traffic of the Upstream Group, for
+	// example `http://gateway-proxy-stats.gloo-system:8081/metrics`. The stats of all the proxies are added up.
+	StatsUrls []string `protobuf:"bytes,9,rep,name=stats_urls,json=statsUrls,proto3" json:"stats_urls,omitempty"`
+	// Status indicates the validation status of this resource.
+	// Status is read-only by clients, and set by gloo during validation
+	Status core.Status `protobuf:"bytes,10,opt,name=status,proto3" json:"status" testdiff:"ignore"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,11,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Canary) Reset()         { *m = Canary{} }
+func (m *Canary) String() string { return proto.CompactTextString(m) }
+func (*Canary) ProtoMessage()    {}
+func (*Canary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17cc7858dc78dd4a, []int{0}
+}
+func (m *Canary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Canary.Unmarshal(m, b)
+}
+func (m *Canary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Canary.Marshal(b, m, deterministic)
+}
+func (m *Canary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Canary.Merge(m, src)
+}
+func (m *Canary) XXX_Size() int {
+	return xxx_messageInfo_Canary.Size(m)
+}
+func (m *Canary) XXX_DiscardUnknown() {
+	xxx_messageInfo_Canary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Canary proto.InternalMessageInfo
+
+func (m *Canary) GetUpstreamGroup() *core.ResourceRef {
+	if m != nil {
+		return m.UpstreamGroup
+	}
+	return nil
+}
+
+func (m *Canary) GetCanaryUpstream() *core.ResourceRef {
+	if m != nil {
+		return m.CanaryUpstream
+	}
+	return nil
+}
+
+func (m *Canary) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *Canary) GetStepWeight() *types.UInt32Value {
+	if m != nil {
+		return m.StepWeight
+	}
+	return nil
+}
+
+func (m *Canary) GetMaxErrorRate() *types.DoubleValue {
+	if m != nil {
+		return m.MaxErrorRate
+	}
+	return nil
+}
+
+func (m *Canary) GetMaxLatency() *time.Duration {
+	if m != nil {
+		return m.MaxLatency
+	}
+	return nil
+}
+
+func (m *Canary) GetFailureThreshold() uint32 {
+	if m != nil {
+		return m.FailureThreshold
+	}
+	return 0
+}
+
+func (m *Canary) GetMinRequests() *types.UInt32Value {
+	if m != nil {
+		return m.MinRequests
+	}
+	return nil
+}
+
+func (m *Canary) GetStatsUrls() []string {
+	if m != nil {
+		return m.StatsUrls
+	}
+	return nil
+}
+
+func (m *Canary) GetStatus() core.Status {
+	if m != nil {
+		return m.Status
+	}
+	return core.Status{}
+}
+
+func (m *Canary) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func init() {
+	proto.RegisterType((*Canary)(nil), "gateway.solo.io.Canary")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gateway/api/v1/canary.proto", fileDescriptor_17cc7858dc78dd4a)
+}
+
+var fileDescriptor_17cc7858dc78dd4a = []byte{
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6e, 0xd4, 0x3e,
+	0x14, 0xc5, 0xff, 0xf9, 0x77, 0x3a, 0x4c, 0x3d, 0xfd, 0xa0, 0xa6, 0x42, 0x69, 0xd5, 0x2f, 0x75,
+	0x55, 0x09, 0x91, 0x88, 0x76, 0x83, 0x0a, 0x15, 0x55, 0x28, 0x42, 0x08, 0xd8, 0x04, 0x06, 0x24,
+	0x36, 0x91, 0x27, 0x73, 0x93, 0x31, 0x4d, 0xe2, 0x60, 0x5f, 0x77, 0x66, 0xb6, 0x3c, 0x05, 0x8f,
+	0xc0, 0x23, 0xc0, 0x1b, 0xf0, 0x14, 0x45, 0xe2, 0x0d, 0x40, 0x62, 0x8f, 0xe2, 0x38, 0x95, 0x5a,
+	0x34, 0x62, 0x76, 0xf1, 0x3d, 0xf7, 0x77, 0x62, 0x1f, 0x5f, 0x93, 0x87, 0x29, 0xc7, 0xa1, 0xee,
+	0x7b, 0xb1, 0xc8, 0x7d, 0x25, 0x32, 0x71, 0x97, 0x0b, 0x3f, 0xcd, 0x84, 0xf0, 0x4b, 0x29, 0xde,
+	0x43, 0x8c, 0xca, 0x4f, 0x19, 0xc2, 0x88, 0x4d, 0x7c, 0x56, 0x72, 0xff, 0xfc, 0x9e, 0x1f, 0xb3,
+	0x82, 0xc9, 0x89, 0x57, 0x4a, 0x81, 0x82, 0xae, 0x58, 0xd1, 0xab, 0x50, 0x8f, 0x8b, 0x8d, 0xb5,
+	0x54, 0xa4, 0xc2, 0x68, 0x7e, 0xf5, 0x55, 0xb7, 0x6d, 0x50, 0x18, 0x63, 0x5d, 0x84, 0x31, 0xda,
+	0xda, 0x76, 0x2a, 0x44, 0x9a, 0x81, 0x6f, 0x56, 0x7d, 0x9d, 0xf8, 0x03, 0x2d, 0x19, 0x72, 0x51,
+	0x4c, 0xd3, 0x47, 0x92, 0x95, 0x25, 0x48, 0xd5, 0xe8, 0x66, 0xb7, 0x67, 0x1c, 0x9b, 0x8d, 0xe5,
+	0x80, 0x6c, 0xc0, 0x90, 0x59, 0x7d, 0xf3, 0xba, 0xae, 0x90, 0xa1, 0x9e, 0x4a, 0x37, 0x6b, 0xab,
+	0xaf, 0x5f, 0xd7, 0x25, 0x24, 0xb5, 0xb4, 0xf7, 0x75, 0x9e, 0xb4, 0x1f, 0x9b, 0x10, 0xe8, 0x09,
+	0x59, 0xd6, 0xa5, 0x42, 0x09, 0x2c, 0x8f, 0x52, 0x29, 0x74, 0xe9, 0x3a, 0xbb, 0xce, 0x7e, 0xf7,
+	0x60, 0xdd, 0x8b, 0x85, 0x84, 0x26, 0x14, 0x2f, 0x04, 0x25, 0xb4, 0x8c, 0x21, 0x84, 0x24, 0x5c,
+	0x6a, 0x80, 0xa7, 0x55, 0x3f, 0x0d, 0xc8, 0x4a, 0x1d, 0x68, 0xd4, 0xd4, 0xdd, 0xff, 0xff, 0x65,
+	0xb1, 0x5c, 0x13, 0x3d, 0x0b, 0xd0, 0x07, 0xa4, 0xc3, 0x0b, 0x04, 0x79, 0xce, 0x32, 0x77, 0xce,
+	0xc2, 0x75, 0x78, 0x5e, 0x13, 0x9e, 0x77, 0x6a, 0xc3, 0x0d, 0x5a, 0x9f, 0xbe, 0xef, 0x38, 0xe1,
+	0x25, 0x40, 0x8f, 0x49, 0x57, 0x21, 0x94, 0xd1, 0x08, 0x78, 0x3a, 0x44, 0xb7, 0x65, 0xf8, 0xcd,
+	0xbf, 0xf8, 0xde, 0xb3, 0x02, 0x0f, 0x0f, 0xde, 0xb0, 0x4c, 0x43, 0x48, 0x2a, 0xe0, 0xad, 0xe9,
+	0xa7, 0x01, 0x59, 0xce, 0xd9, 0x38, 0x02, 0x29, 0x85, 0x8c, 0x24, 0x43, 0x70, 0xe7, 0xa7, 0x38,
+	0x9c, 0x0a, 0xdd, 0xcf, 0xa0, 0x76, 0x58, 0xcc, 0xd9, 0xf8, 0x49, 0x85, 0x84, 0x0c, 0x81, 0x9e,
+	0x90, 0x6e, 0xe5, 0x91, 0x31, 0x84, 0x22, 0x9e, 0xb8, 0xed, 0xd9, 0x8e, 0x40, 0x72, 0x36, 0x7e,
+	0x51, 0x23, 0xf4, 0x0e, 0x59, 0x4d, 0x18, 0xcf, 0xb4, 0x84, 0x08, 0x87, 0x12, 0xd4, 0x50, 0x64,
+	0x03, 0xf7, 0xc6, 0xae, 0xb3, 0xbf, 0x14, 0xde, 0xb4, 0xc2, 0xeb, 0xa6, 0x4e, 0x1f, 0x91, 0xc5,
+	0x9c, 0x17, 0x91, 0x84, 0x0f, 0x1a, 0x14, 0x2a, 0xb7, 0x33, 0xc3, 0x91, 0xbb, 0x39, 0x2f, 0x42,
+	0x0b, 0xd0, 0x2d, 0x42, 0xaa, 0x59, 0x52, 0x91, 0x96, 0x99, 0x72, 0x17, 0x76, 0xe7, 0xf6, 0x17,
+	0xc2, 0x05, 0x53, 0xe9, 0xc9, 0x4c, 0xd1, 0xe7, 0xa4, 0x5d, 0x8f, 0x9a, 0x4b, 0x8c, 0xf3, 0xda,
+	0xd5, 0x9b, 0x7c, 0x65, 0xb4, 0x60, 0xeb, 0xcb, 0xef, 0x96, 0xf3, 0xed, 0x62, 0xe7, 0xbf, 0x5f,
+	0x17, 0x3b, 0xab, 0x08, 0x0a, 0x07, 0x3c, 0x49, 0x8e, 0xf6, 0x78, 0x5a, 0x08, 0x09, 0x7b, 0xa1,
+	0xb5, 0xa0, 0xf7, 0x49, 0xa7, 0x99, 0x6b, 0xb7, 0x6b, 0xec, 0x6e, 0x5f, 0xb5, 0x7b, 0x69, 0xd5,
+	0xa0, 0x55, 0x99, 0x85, 0x97, 0xdd, 0x47, 0xb7, 0x3e, 0xfe, 0x6c, 0xad, 0x90, 0xb9, 0x98, 0x15,
+	0xb4, 0x63, 0x06, 0x86, 0x83, 0x0a, 0x8e, 0xab, 0xbf, 0x7e, 0xfe, 0xb1, 0xed, 0xbc, 0x3b, 0x9c,
+	0xf9, 0xdd, 0x97, 0x67, 0xa9, 0x7d, 0x04, 0xfd, 0xb6, 0x09, 0xe7, 0xf0, 0xcf, 0x00, 0xb7, 0xd1,
+	0xdc, 0x39, 0x35, 0x04, 0x00, 0x00,
+}
+
+func (this *Canary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Canary)
+	if !ok {
+		that2, ok := that.(Canary)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UpstreamGroup.Equal(that1.UpstreamGroup) {
+		return false
+	}
+	if !this.CanaryUpstream.Equal(that1.CanaryUpstream) {
+		return false
+	}
+	if this.Interval != nil && that1.Interval != nil {
+		if *this.Interval != *that1.Interval {
+			return false
+		}
+	} else if this.Interval != nil {
+		return false
+	} else if that1.Interval != nil {
+		return false
+	}
+	if !this.StepWeight.Equal(that1.StepWeight) {
+		return false
+	}
+	if !this.MaxErrorRate.Equal(that1.MaxErrorRate) {
+		return false
+	}
+	if this.MaxLatency != nil && that1.MaxLatency != nil {
+		if *this.MaxLatency != *that1.MaxLatency {
+			return false
+		}
+	} else if this.MaxLatency != nil {
+		return false
+	} else if that1.MaxLatency != nil {
+		return false
+	}
+	if this.FailureThreshold != that1.FailureThreshold {
+		return false
+	}
+	if !this.MinRequests.Equal(that1.MinRequests) {
+		return false
+	}
+	if len(this.StatsUrls) != len(that1.StatsUrls) {
+		return false
+	}
+	for i := range this.StatsUrls {
+		if this.StatsUrls[i] != that1.StatsUrls[i] {
+			return false
+		}
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,163 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"log"
+	"sort"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func NewCanary(namespace, name string) *Canary {
+	canary := &Canary{}
+	canary.SetMetadata(core.Metadata{
+		Name:      name,
+		Namespace: namespace,
+	})
+	return canary
+}
+
+func (r *Canary) SetMetadata(meta core.Metadata) {
+	r.Metadata = meta
+}
+
+func (r *Canary) SetStatus(status core.Status) {
+	r.Status = status
+}
+
+func (r *Canary) MustHash() uint64 {
+	hashVal, err := r.Hash(nil)
+	if err != nil {
+		log.Panicf("error while hashing: (%s) this should never happen", err)
+	}
+	return hashVal
+}
+
+func (r *Canary) GroupVersionKind() schema.GroupVersionKind {
+	return CanaryGVK
+}
+
+type CanaryList []*Canary
+
+func (list CanaryList) Find(namespace, name string) (*Canary, error) {
+	for _, canary := range list {
+		if canary.GetMetadata().Name == name && canary.GetMetadata().Namespace == namespace {
+			return canary, nil
+		}
+	}
+	return nil, errors.Errorf("list did not find canary %v.%v", namespace, name)
+}
+
+func (list CanaryList) AsResources() resources.ResourceList {
+	var ress resources.ResourceList
+	for _, canary := range list {
+		ress = append(ress, canary)
+	}
+	return ress
+}
+
+func (list CanaryList) AsInputResources() resources.InputResourceList {
+	var ress resources.InputResourceList
+	for _, canary := range list {
+		ress = append(ress, canary)
+	}
+	return ress
+}
+
+func (list CanaryList) Names() []string {
+	var names []string
+	for _, canary := range list {
+		names = append(names, canary.GetMetadata().Name)
+	}
+	return names
+}
+
+func (list CanaryList) NamespacesDotNames() []string {
+	var names []string
+	for _, canary := range list {
+		names = append(names, canary.GetMetadata().Namespace+"."+canary.GetMetadata().Name)
+	}
+	return names
+}
+
+func (list CanaryList) Sort() CanaryList {
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].GetMetadata().Less(list[j].GetMetadata())
+	})
+	return list
+}
+
+func (list CanaryList) Clone() CanaryList {
+	var canaryList CanaryList
+	for _, canary := range list {
+		canaryList = append(canaryList, resources.Clone(canary).(*Canary))
+	}
+	return canaryList
+}
+
+func (list CanaryList) Each(f func(element *Canary)) {
+	for _, canary := range list {
+		f(canary)
+	}
+}
+
+func (list CanaryList) EachResource(f func(element resources.Resource)) {
+	for _, canary := range list {
+		f(canary)
+	}
+}
+
+func (list CanaryList) AsInterfaces() []interface{} {
+	var asInterfaces []interface{}
+	list.Each(func(element *Canary) {
+		asInterfaces = append(asInterfaces, element)
+	})
+	return asInterfaces
+}
+
+// Kubernetes Adapter for Canary
+
+func (o *Canary) GetObjectKind() schema.ObjectKind {
+	t := CanaryCrd.TypeMeta()
+	return &t
+}
+
+func (o *Canary) DeepCopyObject() runtime.Object {
+	return resources.Clone(o).(*Canary)
+}
+
+func (o *Canary) DeepCopyInto(out *Canary) {
+	clone := resources.Clone(o).(*Canary)
+	*out = *clone
+}
+
+var (
+	CanaryCrd = crd.NewCrd(
+		"canaries",
+		CanaryGVK.Group,
+		CanaryGVK.Version,
+		CanaryGVK.Kind,
+		"can",
+		false,
+		&Canary{})
+)
+
+func init() {
+	if err := crd.AddCrd(CanaryCrd); err != nil {
+		log.Fatalf("could not add crd to global registry")
+	}
+}
+
+var (
+	CanaryGVK = schema.GroupVersionKind{
+		Version: "v1",
+		Group:   "gateway.solo.io",
+		Kind:    "Canary",
+	}
+)
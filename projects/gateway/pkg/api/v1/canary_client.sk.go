@@ -0,0 +1,123 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+type CanaryWatcher interface {
+	// watch namespace-scoped Canaries
+	Watch(namespace string, opts clients.WatchOpts) (<-chan CanaryList, <-chan error, error)
+}
+
+type CanaryClient interface {
+	BaseClient() clients.ResourceClient
+	Register() error
+	Read(namespace, name string, opts clients.ReadOpts) (*Canary, error)
+	Write(resource *Canary, opts clients.WriteOpts) (*Canary, error)
+	Delete(namespace, name string, opts clients.DeleteOpts) error
+	List(namespace string, opts clients.ListOpts) (CanaryList, error)
+	CanaryWatcher
+}
+
+type canaryClient struct {
+	rc clients.ResourceClient
+}
+
+func NewCanaryClient(rcFactory factory.ResourceClientFactory) (CanaryClient, error) {
+	return NewCanaryClientWithToken(rcFactory, "")
+}
+
+func NewCanaryClientWithToken(rcFactory factory.ResourceClientFactory, token string) (CanaryClient, error) {
+	rc, err := rcFactory.NewResourceClient(factory.NewResourceClientParams{
+		ResourceType: &Canary{},
+		Token:        token,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating base Canary resource client")
+	}
+	return NewCanaryClientWithBase(rc), nil
+}
+
+func NewCanaryClientWithBase(rc clients.ResourceClient) CanaryClient {
+	return &canaryClient{
+		rc: rc,
+	}
+}
+
+func (client *canaryClient) BaseClient() clients.ResourceClient {
+	return client.rc
+}
+
+func (client *canaryClient) Register() error {
+	return client.rc.Register()
+}
+
+func (client *canaryClient) Read(namespace, name string, opts clients.ReadOpts) (*Canary, error) {
+	opts = opts.WithDefaults()
+
+	resource, err := client.rc.Read(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resource.(*Canary), nil
+}
+
+func (client *canaryClient) Write(canary *Canary, opts clients.WriteOpts) (*Canary, error) {
+	opts = opts.WithDefaults()
+	resource, err := client.rc.Write(canary, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resource.(*Canary), nil
+}
+
+func (client *canaryClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	opts = opts.WithDefaults()
+
+	return client.rc.Delete(namespace, name, opts)
+}
+
+func (client *canaryClient) List(namespace string, opts clients.ListOpts) (CanaryList, error) {
+	opts = opts.WithDefaults()
+
+	resourceList, err := client.rc.List(namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertToCanary(resourceList), nil
+}
+
+func (client *canaryClient) Watch(namespace string, opts clients.WatchOpts) (<-chan CanaryList, <-chan error, error) {
+	opts = opts.WithDefaults()
+
+	resourcesChan, errs, initErr := client.rc.Watch(namespace, opts)
+	if initErr != nil {
+		return nil, nil, initErr
+	}
+	canariesChan := make(chan CanaryList)
+	go func() {
+		for {
+			select {
+			case resourceList := <-resourcesChan:
+				canariesChan <- convertToCanary(resourceList)
+			case <-opts.Ctx.Done():
+				close(canariesChan)
+				return
+			}
+		}
+	}()
+	return canariesChan, errs, nil
+}
+
+func convertToCanary(resources resources.ResourceList) CanaryList {
+	var canaryList CanaryList
+	for _, resource := range resources {
+		canaryList = append(canaryList, resource.(*Canary))
+	}
+	return canaryList
+}
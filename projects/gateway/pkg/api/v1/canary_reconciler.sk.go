@@ -0,0 +1,47 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reconcile"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+// Option to copy anything from the original to the desired before writing. Return value of false means don't update
+type TransitionCanaryFunc func(original, desired *Canary) (bool, error)
+
+type CanaryReconciler interface {
+	Reconcile(namespace string, desiredResources CanaryList, transition TransitionCanaryFunc, opts clients.ListOpts) error
+}
+
+func canarysToResources(list CanaryList) resources.ResourceList {
+	var resourceList resources.ResourceList
+	for _, canary := range list {
+		resourceList = append(resourceList, canary)
+	}
+	return resourceList
+}
+
+func NewCanaryReconciler(client CanaryClient) CanaryReconciler {
+	return &canaryReconciler{
+		base: reconcile.NewReconciler(client.BaseClient()),
+	}
+}
+
+type canaryReconciler struct {
+	base reconcile.Reconciler
+}
+
+func (r *canaryReconciler) Reconcile(namespace string, desiredResources CanaryList, transition TransitionCanaryFunc, opts clients.ListOpts) error {
+	opts = opts.WithDefaults()
+	opts.Ctx = contextutils.WithLogger(opts.Ctx, "canary_reconciler")
+	var transitionResources reconcile.TransitionResourcesFunc
+	if transition != nil {
+		transitionResources = func(original, desired resources.Resource) (bool, error) {
+			return transition(original.(*Canary), desired.(*Canary))
+		}
+	}
+	return r.base.Reconcile(namespace, canarysToResources(desiredResources), transitionResources, opts)
+}
@@ -6,14 +6,14 @@ package v1
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -27,9 +27,8 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-//
-//A Gateway describes a single Listener (bind address:port)
-//and the routing configuration to upstreams that are reachable via a specific port on the Gateway Proxy itself.
+// A Gateway describes a single Listener (bind address:port)
+// and the routing configuration to upstreams that are reachable via a specific port on the Gateway Proxy itself.
 type Gateway struct {
 	// if set to false, only use virtual services without ssl configured.
 	// if set to true, only use virtual services with ssl configured.
@@ -54,6 +53,7 @@ type Gateway struct {
 	// Types that are valid to be assigned to GatewayType:
 	//	*Gateway_HttpGateway
 	//	*Gateway_TcpGateway
+	//	*Gateway_HybridGateway
 	GatewayType isGateway_GatewayType `protobuf_oneof:"GatewayType"`
 	//
 	// Names of the [`Proxy`](https://gloo.solo.io/api/github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto.sk/)
@@ -114,9 +114,13 @@ type Gateway_HttpGateway struct {
 type Gateway_TcpGateway struct {
 	TcpGateway *TcpGateway `protobuf:"bytes,10,opt,name=tcp_gateway,json=tcpGateway,proto3,oneof" json:"tcp_gateway,omitempty"`
 }
+type Gateway_HybridGateway struct {
+	HybridGateway *HybridGateway `protobuf:"bytes,11,opt,name=hybrid_gateway,json=hybridGateway,proto3,oneof" json:"hybrid_gateway,omitempty"`
+}
 
-func (*Gateway_HttpGateway) isGateway_GatewayType() {}
-func (*Gateway_TcpGateway) isGateway_GatewayType()  {}
+func (*Gateway_HttpGateway) isGateway_GatewayType()   {}
+func (*Gateway_TcpGateway) isGateway_GatewayType()    {}
+func (*Gateway_HybridGateway) isGateway_GatewayType() {}
 
 func (m *Gateway) GetGatewayType() isGateway_GatewayType {
 	if m != nil {
@@ -188,6 +192,13 @@ func (m *Gateway) GetTcpGateway() *TcpGateway {
 	return nil
 }
 
+func (m *Gateway) GetHybridGateway() *HybridGateway {
+	if x, ok := m.GetGatewayType().(*Gateway_HybridGateway); ok {
+		return x.HybridGateway
+	}
+	return nil
+}
+
 func (m *Gateway) GetProxyNames() []string {
 	if m != nil {
 		return m.ProxyNames
@@ -200,6 +211,7 @@ func (*Gateway) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Gateway_HttpGateway)(nil),
 		(*Gateway_TcpGateway)(nil),
+		(*Gateway_HybridGateway)(nil),
 	}
 }
 
@@ -324,11 +336,340 @@ func (m *TcpGateway) GetOptions() *v1.TcpListenerOptions {
 	return nil
 }
 
+// A HybridGateway serves the connections to its bind address with different gateways,
+// which are selected by the matchers of the gateways. Each gateway is translated into its own filter chains,
+// so gateways sharing a port can use different HTTP connection manager settings, access logs and filters.
+type HybridGateway struct {
+	// The gateways defined inline in this gateway.
+	MatchedGateways []*MatchedGateway `protobuf:"bytes,1,rep,name=matched_gateways,json=matchedGateways,proto3" json:"matched_gateways,omitempty"`
+	// Select the MatchableHttpGateways serving the connections to this gateway.
+	// The matchers of all the gateways, inline or delegated, must be unique.
+	DelegatedHttpGateways *DelegatedHttpGateway `protobuf:"bytes,2,opt,name=delegated_http_gateways,json=delegatedHttpGateways,proto3" json:"delegated_http_gateways,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}              `json:"-"`
+	XXX_unrecognized      []byte                `json:"-"`
+	XXX_sizecache         int32                 `json:"-"`
+}
+
+func (m *HybridGateway) Reset()         { *m = HybridGateway{} }
+func (m *HybridGateway) String() string { return proto.CompactTextString(m) }
+func (*HybridGateway) ProtoMessage()    {}
+func (*HybridGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{3}
+}
+func (m *HybridGateway) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridGateway.Unmarshal(m, b)
+}
+func (m *HybridGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridGateway.Marshal(b, m, deterministic)
+}
+func (m *HybridGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridGateway.Merge(m, src)
+}
+func (m *HybridGateway) XXX_Size() int {
+	return xxx_messageInfo_HybridGateway.Size(m)
+}
+func (m *HybridGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridGateway proto.InternalMessageInfo
+
+func (m *HybridGateway) GetMatchedGateways() []*MatchedGateway {
+	if m != nil {
+		return m.MatchedGateways
+	}
+	return nil
+}
+
+func (m *HybridGateway) GetDelegatedHttpGateways() *DelegatedHttpGateway {
+	if m != nil {
+		return m.DelegatedHttpGateways
+	}
+	return nil
+}
+
+// A MatchedGateway is an http or tcp gateway which only serves the connections satisfying its matcher.
+type MatchedGateway struct {
+	// The connections served by this gateway.
+	Matcher *Matcher `protobuf:"bytes,1,opt,name=matcher,proto3" json:"matcher,omitempty"`
+	// Types that are valid to be assigned to GatewayType:
+	//	*MatchedGateway_HttpGateway
+	//	*MatchedGateway_TcpGateway
+	GatewayType          isMatchedGateway_GatewayType `protobuf_oneof:"GatewayType"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *MatchedGateway) Reset()         { *m = MatchedGateway{} }
+func (m *MatchedGateway) String() string { return proto.CompactTextString(m) }
+func (*MatchedGateway) ProtoMessage()    {}
+func (*MatchedGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{4}
+}
+func (m *MatchedGateway) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchedGateway.Unmarshal(m, b)
+}
+func (m *MatchedGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchedGateway.Marshal(b, m, deterministic)
+}
+func (m *MatchedGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchedGateway.Merge(m, src)
+}
+func (m *MatchedGateway) XXX_Size() int {
+	return xxx_messageInfo_MatchedGateway.Size(m)
+}
+func (m *MatchedGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchedGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchedGateway proto.InternalMessageInfo
+
+type isMatchedGateway_GatewayType interface {
+	isMatchedGateway_GatewayType()
+	Equal(interface{}) bool
+}
+
+type MatchedGateway_HttpGateway struct {
+	HttpGateway *HttpGateway `protobuf:"bytes,2,opt,name=http_gateway,json=httpGateway,proto3,oneof" json:"http_gateway,omitempty"`
+}
+type MatchedGateway_TcpGateway struct {
+	TcpGateway *TcpGateway `protobuf:"bytes,3,opt,name=tcp_gateway,json=tcpGateway,proto3,oneof" json:"tcp_gateway,omitempty"`
+}
+
+func (*MatchedGateway_HttpGateway) isMatchedGateway_GatewayType() {}
+func (*MatchedGateway_TcpGateway) isMatchedGateway_GatewayType()  {}
+
+func (m *MatchedGateway) GetGatewayType() isMatchedGateway_GatewayType {
+	if m != nil {
+		return m.GatewayType
+	}
+	return nil
+}
+
+func (m *MatchedGateway) GetMatcher() *Matcher {
+	if m != nil {
+		return m.Matcher
+	}
+	return nil
+}
+
+func (m *MatchedGateway) GetHttpGateway() *HttpGateway {
+	if x, ok := m.GetGatewayType().(*MatchedGateway_HttpGateway); ok {
+		return x.HttpGateway
+	}
+	return nil
+}
+
+func (m *MatchedGateway) GetTcpGateway() *TcpGateway {
+	if x, ok := m.GetGatewayType().(*MatchedGateway_TcpGateway); ok {
+		return x.TcpGateway
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MatchedGateway) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MatchedGateway_HttpGateway)(nil),
+		(*MatchedGateway_TcpGateway)(nil),
+	}
+}
+
+// A Matcher selects the connections served by a gateway of a HybridGateway.
+type Matcher struct {
+	// If provided, the matched connections must use TLS and are terminated with this config.
+	// Its SNI domains are matched against the server name of the connection; connections with any server name
+	// are matched if there are none.
+	// The http gateways of a matcher with an ssl config serve the virtual services with an ssl config,
+	// whose own ssl configs are ignored.
+	SslConfig *v1.SslConfig `protobuf:"bytes,1,opt,name=ssl_config,json=sslConfig,proto3" json:"ssl_config,omitempty"`
+	// If provided, the matched connections must originate from one of these ranges.
+	SourcePrefixRanges   []*v3.CidrRange `protobuf:"bytes,2,rep,name=source_prefix_ranges,json=sourcePrefixRanges,proto3" json:"source_prefix_ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Matcher) Reset()         { *m = Matcher{} }
+func (m *Matcher) String() string { return proto.CompactTextString(m) }
+func (*Matcher) ProtoMessage()    {}
+func (*Matcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{5}
+}
+func (m *Matcher) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Matcher.Unmarshal(m, b)
+}
+func (m *Matcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Matcher.Marshal(b, m, deterministic)
+}
+func (m *Matcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Matcher.Merge(m, src)
+}
+func (m *Matcher) XXX_Size() int {
+	return xxx_messageInfo_Matcher.Size(m)
+}
+func (m *Matcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_Matcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Matcher proto.InternalMessageInfo
+
+func (m *Matcher) GetSslConfig() *v1.SslConfig {
+	if m != nil {
+		return m.SslConfig
+	}
+	return nil
+}
+
+func (m *Matcher) GetSourcePrefixRanges() []*v3.CidrRange {
+	if m != nil {
+		return m.SourcePrefixRanges
+	}
+	return nil
+}
+
+// Selects the MatchableHttpGateways of a HybridGateway, either by reference or by label.
+type DelegatedHttpGateway struct {
+	// Types that are valid to be assigned to SelectionType:
+	//	*DelegatedHttpGateway_Ref
+	//	*DelegatedHttpGateway_Selector
+	SelectionType        isDelegatedHttpGateway_SelectionType `protobuf_oneof:"selection_type"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *DelegatedHttpGateway) Reset()         { *m = DelegatedHttpGateway{} }
+func (m *DelegatedHttpGateway) String() string { return proto.CompactTextString(m) }
+func (*DelegatedHttpGateway) ProtoMessage()    {}
+func (*DelegatedHttpGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{6}
+}
+func (m *DelegatedHttpGateway) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegatedHttpGateway.Unmarshal(m, b)
+}
+func (m *DelegatedHttpGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegatedHttpGateway.Marshal(b, m, deterministic)
+}
+func (m *DelegatedHttpGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatedHttpGateway.Merge(m, src)
+}
+func (m *DelegatedHttpGateway) XXX_Size() int {
+	return xxx_messageInfo_DelegatedHttpGateway.Size(m)
+}
+func (m *DelegatedHttpGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatedHttpGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatedHttpGateway proto.InternalMessageInfo
+
+type isDelegatedHttpGateway_SelectionType interface {
+	isDelegatedHttpGateway_SelectionType()
+	Equal(interface{}) bool
+}
+
+type DelegatedHttpGateway_Ref struct {
+	Ref *core.ResourceRef `protobuf:"bytes,1,opt,name=ref,proto3,oneof" json:"ref,omitempty"`
+}
+type DelegatedHttpGateway_Selector struct {
+	Selector *HttpGatewaySelector `protobuf:"bytes,2,opt,name=selector,proto3,oneof" json:"selector,omitempty"`
+}
+
+func (*DelegatedHttpGateway_Ref) isDelegatedHttpGateway_SelectionType()      {}
+func (*DelegatedHttpGateway_Selector) isDelegatedHttpGateway_SelectionType() {}
+
+func (m *DelegatedHttpGateway) GetSelectionType() isDelegatedHttpGateway_SelectionType {
+	if m != nil {
+		return m.SelectionType
+	}
+	return nil
+}
+
+func (m *DelegatedHttpGateway) GetRef() *core.ResourceRef {
+	if x, ok := m.GetSelectionType().(*DelegatedHttpGateway_Ref); ok {
+		return x.Ref
+	}
+	return nil
+}
+
+func (m *DelegatedHttpGateway) GetSelector() *HttpGatewaySelector {
+	if x, ok := m.GetSelectionType().(*DelegatedHttpGateway_Selector); ok {
+		return x.Selector
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*DelegatedHttpGateway) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*DelegatedHttpGateway_Ref)(nil),
+		(*DelegatedHttpGateway_Selector)(nil),
+	}
+}
+
+type HttpGatewaySelector struct {
+	// Select the MatchableHttpGateways with these labels.
+	// All the MatchableHttpGateways of the selected namespaces are selected if this is empty.
+	Labels map[string]string `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Select the MatchableHttpGateways in these namespaces.
+	// Setting '*' selects all the namespaces watched by Gloo.
+	// Defaults to the namespace of the Gateway.
+	Namespaces           []string `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HttpGatewaySelector) Reset()         { *m = HttpGatewaySelector{} }
+func (m *HttpGatewaySelector) String() string { return proto.CompactTextString(m) }
+func (*HttpGatewaySelector) ProtoMessage()    {}
+func (*HttpGatewaySelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{7}
+}
+func (m *HttpGatewaySelector) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HttpGatewaySelector.Unmarshal(m, b)
+}
+func (m *HttpGatewaySelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HttpGatewaySelector.Marshal(b, m, deterministic)
+}
+func (m *HttpGatewaySelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpGatewaySelector.Merge(m, src)
+}
+func (m *HttpGatewaySelector) XXX_Size() int {
+	return xxx_messageInfo_HttpGatewaySelector.Size(m)
+}
+func (m *HttpGatewaySelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpGatewaySelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HttpGatewaySelector proto.InternalMessageInfo
+
+func (m *HttpGatewaySelector) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *HttpGatewaySelector) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Gateway)(nil), "gateway.solo.io.Gateway")
 	proto.RegisterType((*HttpGateway)(nil), "gateway.solo.io.HttpGateway")
 	proto.RegisterMapType((map[string]string)(nil), "gateway.solo.io.HttpGateway.VirtualServiceSelectorEntry")
 	proto.RegisterType((*TcpGateway)(nil), "gateway.solo.io.TcpGateway")
+	proto.RegisterType((*HybridGateway)(nil), "gateway.solo.io.HybridGateway")
+	proto.RegisterType((*MatchedGateway)(nil), "gateway.solo.io.MatchedGateway")
+	proto.RegisterType((*Matcher)(nil), "gateway.solo.io.Matcher")
+	proto.RegisterType((*DelegatedHttpGateway)(nil), "gateway.solo.io.DelegatedHttpGateway")
+	proto.RegisterType((*HttpGatewaySelector)(nil), "gateway.solo.io.HttpGatewaySelector")
+	proto.RegisterMapType((map[string]string)(nil), "gateway.solo.io.HttpGatewaySelector.LabelsEntry")
 }
 
 func init() {
@@ -336,53 +677,73 @@ func init() {
 }
 
 var fileDescriptor_30f7529f6633771c = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xc6, 0x49, 0x80, 0x64, 0x0d, 0x82, 0xae, 0x28, 0x32, 0xe1, 0x2f, 0x44, 0xaa, 0x9a, 0x4b,
-	0x6d, 0x15, 0x0e, 0x45, 0x69, 0xa9, 0x44, 0xa4, 0xaa, 0xf4, 0x8f, 0x22, 0x83, 0x38, 0xf4, 0x12,
-	0x39, 0xce, 0xc6, 0x71, 0x31, 0xd9, 0xd5, 0xee, 0x38, 0x10, 0xa9, 0x27, 0x5e, 0xa1, 0x2f, 0x51,
-	0xf5, 0x09, 0xfa, 0x08, 0x7d, 0x0a, 0x0e, 0x7d, 0x03, 0x2a, 0xf5, 0x5e, 0xed, 0x7a, 0x9d, 0x10,
-	0xd3, 0xa0, 0xde, 0x76, 0xe6, 0x9b, 0xef, 0xf3, 0xcc, 0xec, 0xb7, 0x46, 0x7b, 0x41, 0x08, 0xdd,
-	0xb8, 0x65, 0xfb, 0xf4, 0xdc, 0x11, 0x34, 0xa2, 0x4f, 0x42, 0xea, 0x04, 0x11, 0xa5, 0x0e, 0xe3,
-	0xf4, 0x33, 0xf1, 0x41, 0x38, 0x81, 0x07, 0xe4, 0xc2, 0x1b, 0x38, 0x1e, 0x0b, 0x9d, 0xfe, 0xd3,
-	0x34, 0xb4, 0x19, 0xa7, 0x40, 0xf1, 0x42, 0x1a, 0x4a, 0xae, 0x1d, 0xd2, 0xf2, 0x52, 0x40, 0x03,
-	0xaa, 0x30, 0x47, 0x9e, 0x92, 0xb2, 0x32, 0x26, 0x97, 0x90, 0x24, 0xc9, 0x25, 0xe8, 0xdc, 0x46,
-	0x40, 0x69, 0x10, 0x11, 0x47, 0x45, 0xad, 0xb8, 0xe3, 0x5c, 0x70, 0x8f, 0x31, 0xc2, 0x45, 0x8a,
-	0xab, 0x76, 0xce, 0x42, 0x48, 0xbf, 0x7c, 0x4e, 0xc0, 0x6b, 0x7b, 0xe0, 0x69, 0x7c, 0x2d, 0x8b,
-	0x0b, 0xf0, 0x20, 0x4e, 0xd9, 0x2b, 0x59, 0x94, 0x93, 0xce, 0x24, 0xe1, 0x34, 0xd6, 0xf8, 0xa3,
-	0xcc, 0xfc, 0x32, 0xd2, 0x95, 0x8c, 0xd3, 0x4b, 0x3d, 0x7a, 0xf9, 0xf1, 0xe4, 0x32, 0xca, 0x20,
-	0xa4, 0x3d, 0xdd, 0x4a, 0xf5, 0x7b, 0x01, 0xcd, 0xbe, 0x4e, 0xd6, 0x84, 0x17, 0x51, 0x5e, 0x88,
-	0xc8, 0x32, 0x2a, 0x46, 0xad, 0xe8, 0xca, 0x23, 0xde, 0x42, 0x73, 0xad, 0xb0, 0xd7, 0x6e, 0x7a,
-	0xed, 0x36, 0x27, 0x42, 0x58, 0xf9, 0x8a, 0x51, 0x2b, 0xb9, 0xa6, 0xcc, 0xed, 0x27, 0x29, 0xbc,
-	0x8a, 0x4a, 0xaa, 0x84, 0x51, 0x0e, 0x56, 0xa1, 0x62, 0xd4, 0xe6, 0xdd, 0xa2, 0x4c, 0x1c, 0x51,
-	0x0e, 0xf8, 0x19, 0x9a, 0xd5, 0x9f, 0xb3, 0xa6, 0x2b, 0x46, 0xcd, 0xdc, 0x5e, 0xb7, 0x65, 0x2b,
-	0xe9, 0x85, 0xd8, 0xef, 0x43, 0x01, 0xa4, 0x47, 0xf8, 0xc7, 0xa4, 0xc8, 0x4d, 0xab, 0xf1, 0x3b,
-	0x34, 0x93, 0x6c, 0xcc, 0x9a, 0x51, 0xbc, 0x25, 0xdb, 0xa7, 0x9c, 0x0c, 0x79, 0xc7, 0x0a, 0x6b,
-	0xac, 0xff, 0xf8, 0x53, 0x30, 0x7e, 0x5e, 0x6f, 0x4e, 0xfd, 0xbe, 0xde, 0x7c, 0x00, 0x44, 0x40,
-	0x3b, 0xec, 0x74, 0xea, 0xd5, 0x30, 0xe8, 0x51, 0x4e, 0xaa, 0xae, 0x96, 0xc0, 0xbb, 0xa8, 0x98,
-	0x5e, 0x8f, 0x35, 0xab, 0xe4, 0x96, 0xc7, 0xe5, 0x3e, 0x68, 0xb4, 0x51, 0x90, 0x62, 0xee, 0xb0,
-	0x1a, 0x37, 0xd0, 0x42, 0x2c, 0x48, 0x53, 0x6d, 0xb6, 0xa9, 0x16, 0x66, 0x15, 0x95, 0x40, 0xd9,
-	0x4e, 0x0c, 0x62, 0xa7, 0x06, 0xb1, 0x1b, 0x94, 0x46, 0xa7, 0x5e, 0x14, 0x13, 0x77, 0x3e, 0x16,
-	0xe4, 0x48, 0x32, 0x8e, 0x94, 0x0b, 0xf7, 0xd1, 0x5c, 0x17, 0x80, 0x35, 0xb5, 0x19, 0xad, 0x92,
-	0x12, 0x58, 0xb3, 0x33, 0xe6, 0xb4, 0x0f, 0x00, 0x98, 0xbe, 0x89, 0x83, 0x29, 0xd7, 0xec, 0x8e,
-	0x42, 0xfc, 0x12, 0x99, 0xe0, 0x8f, 0x14, 0x90, 0x52, 0x58, 0xbd, 0xa3, 0x70, 0xe2, 0xdf, 0x12,
-	0x40, 0x30, 0x8c, 0xf0, 0x26, 0x32, 0x93, 0x11, 0x7a, 0xde, 0x39, 0x11, 0xd6, 0x5c, 0x25, 0x5f,
-	0x2b, 0xb9, 0x48, 0xa5, 0x0e, 0x65, 0xa6, 0xbe, 0x7c, 0x75, 0x53, 0x28, 0xa0, 0x5c, 0x70, 0x71,
-	0x75, 0x53, 0x40, 0xb8, 0xa8, 0x85, 0x45, 0x63, 0x1e, 0x99, 0x5a, 0xe3, 0x64, 0xc0, 0x48, 0xf5,
-	0x6b, 0x1e, 0x99, 0xb7, 0xda, 0xc4, 0x6f, 0xd1, 0x62, 0x3f, 0xe4, 0x10, 0x7b, 0x51, 0x53, 0x10,
-	0xde, 0x0f, 0x7d, 0x22, 0x2c, 0xa3, 0x92, 0xaf, 0x99, 0xdb, 0x2b, 0xe3, 0x0b, 0x76, 0x89, 0xa0,
-	0x31, 0xf7, 0x89, 0x4b, 0x3a, 0x7a, 0xc7, 0x0b, 0x9a, 0x78, 0xac, 0x79, 0x98, 0x23, 0x2b, 0xa3,
-	0xd5, 0x14, 0x24, 0x22, 0x3e, 0x50, 0x6e, 0xe5, 0x94, 0xe6, 0xee, 0x7d, 0x2b, 0xb3, 0x4f, 0xc7,
-	0xf4, 0x8e, 0x35, 0xf5, 0x55, 0x0f, 0xf8, 0xc0, 0x5d, 0xee, 0xff, 0x13, 0xc4, 0x2f, 0x50, 0x39,
-	0xfb, 0x4d, 0xb5, 0x21, 0xe6, 0xc9, 0x49, 0xf2, 0x6a, 0x4d, 0xd6, 0x38, 0xf7, 0x70, 0x88, 0xe3,
-	0xe7, 0x23, 0x73, 0x27, 0xa6, 0xd8, 0x1a, 0x37, 0xb7, 0xec, 0x6e, 0x92, 0xc1, 0xcb, 0x6f, 0xd0,
-	0xea, 0x3d, 0x1d, 0xcb, 0xa7, 0x78, 0x46, 0x06, 0xea, 0x29, 0x96, 0x5c, 0x79, 0xc4, 0x4b, 0x68,
-	0xba, 0x2f, 0xed, 0x65, 0xe5, 0x54, 0x2e, 0x09, 0xea, 0xb9, 0x5d, 0xa3, 0xfa, 0x05, 0xa1, 0xd1,
-	0xcd, 0xe3, 0x6d, 0x54, 0x92, 0x5e, 0xe9, 0x52, 0x01, 0xe9, 0x65, 0x3c, 0x1c, 0xef, 0xeb, 0xc4,
-	0x67, 0x07, 0x54, 0x80, 0x5b, 0x84, 0xe4, 0x20, 0x70, 0x3d, 0x3b, 0x49, 0xe5, 0x0e, 0x63, 0xd2,
-	0x20, 0x8d, 0x3d, 0xf9, 0x06, 0xbf, 0xfd, 0xda, 0x30, 0x3e, 0xed, 0xfc, 0xf7, 0xdf, 0x9a, 0x9d,
-	0x05, 0xfa, 0x6f, 0xd4, 0x9a, 0x51, 0x0f, 0x68, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x68,
-	0x17, 0x9a, 0x44, 0xeb, 0x05, 0x00, 0x00,
+	// 1043 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdb, 0x6e, 0x1b, 0x45,
+	0x18, 0xce, 0xda, 0x69, 0x62, 0xff, 0x9b, 0x13, 0x43, 0xda, 0x6e, 0x9d, 0x36, 0x71, 0x0d, 0x15,
+	0xbe, 0xe9, 0x2e, 0x38, 0x12, 0x84, 0x40, 0x91, 0xea, 0x82, 0x6a, 0xa0, 0x2d, 0x61, 0x12, 0xf5,
+	0x02, 0x09, 0xad, 0xd6, 0xeb, 0xf1, 0x7a, 0xc9, 0xc6, 0xb3, 0x9a, 0x19, 0x3b, 0xb1, 0xc4, 0x15,
+	0xaf, 0x00, 0x77, 0xbc, 0x00, 0x8f, 0x80, 0xfa, 0x04, 0x3c, 0x01, 0x97, 0xbd, 0xe0, 0x05, 0x10,
+	0x48, 0xdc, 0xa3, 0x39, 0xac, 0xed, 0xb5, 0xe3, 0xa8, 0x55, 0xef, 0xe6, 0x3f, 0x7c, 0xdf, 0xfe,
+	0xf3, 0x9f, 0x66, 0xe1, 0x41, 0x14, 0x8b, 0xde, 0xa0, 0xed, 0x86, 0xf4, 0xcc, 0xe3, 0x34, 0xa1,
+	0xf7, 0x63, 0xea, 0x45, 0x09, 0xa5, 0x5e, 0xca, 0xe8, 0x0f, 0x24, 0x14, 0xdc, 0x8b, 0x02, 0x41,
+	0xce, 0x83, 0x91, 0x17, 0xa4, 0xb1, 0x37, 0xfc, 0x20, 0x13, 0xdd, 0x94, 0x51, 0x41, 0xd1, 0x66,
+	0x26, 0x4a, 0xac, 0x1b, 0xd3, 0xca, 0x76, 0x44, 0x23, 0xaa, 0x6c, 0x9e, 0x3c, 0x69, 0xb7, 0x0a,
+	0x22, 0x17, 0x42, 0x2b, 0xc9, 0x85, 0x30, 0xba, 0xdd, 0x88, 0xd2, 0x28, 0x21, 0x9e, 0x92, 0xda,
+	0x83, 0xae, 0x77, 0xce, 0x82, 0x34, 0x25, 0x8c, 0x67, 0x76, 0x15, 0xce, 0x69, 0x2c, 0xb2, 0x2f,
+	0x9f, 0x11, 0x11, 0x74, 0x02, 0x11, 0x18, 0xfb, 0xed, 0x59, 0x3b, 0x17, 0x81, 0x18, 0x64, 0xe8,
+	0x5b, 0xb3, 0x56, 0x46, 0xba, 0x8b, 0x88, 0x33, 0xd9, 0xd8, 0xef, 0xcd, 0xdc, 0x5f, 0x4a, 0xc6,
+	0x33, 0x65, 0xf4, 0xc2, 0x5c, 0xbd, 0xf2, 0xde, 0x62, 0x37, 0x9a, 0x8a, 0x98, 0xf6, 0xb3, 0x50,
+	0xde, 0x59, 0xec, 0xc8, 0x79, 0x62, 0x9c, 0x6a, 0xa4, 0x3f, 0xa4, 0x23, 0x2f, 0xa4, 0xfd, 0x6e,
+	0x1c, 0x79, 0x21, 0x65, 0xc4, 0x1b, 0xee, 0x7b, 0x41, 0xa7, 0xc3, 0x08, 0x37, 0x44, 0xb5, 0xbf,
+	0x97, 0x61, 0xf5, 0xb1, 0xce, 0x37, 0xda, 0x82, 0x22, 0xe7, 0x89, 0x63, 0x55, 0xad, 0x7a, 0x09,
+	0xcb, 0x23, 0xba, 0x0b, 0x6b, 0xed, 0xb8, 0xdf, 0xf1, 0x0d, 0xc6, 0x29, 0x56, 0xad, 0x7a, 0x19,
+	0xdb, 0x52, 0xf7, 0x50, 0xab, 0xd0, 0x0e, 0x94, 0x95, 0x4b, 0x4a, 0x99, 0x70, 0x96, 0xab, 0x56,
+	0x7d, 0x1d, 0x97, 0xa4, 0xe2, 0x88, 0x32, 0x81, 0x3e, 0x82, 0x55, 0x13, 0xb7, 0x73, 0xad, 0x6a,
+	0xd5, 0xed, 0xc6, 0x1d, 0x57, 0x86, 0x9a, 0x55, 0xd6, 0x7d, 0x12, 0x73, 0x41, 0xfa, 0x84, 0x7d,
+	0xa3, 0x9d, 0x70, 0xe6, 0x8d, 0xbe, 0x86, 0x15, 0x9d, 0x7a, 0x67, 0x45, 0xe1, 0xb6, 0x5d, 0x19,
+	0xfe, 0x18, 0x77, 0xac, 0x6c, 0xcd, 0x3b, 0xbf, 0xff, 0xb7, 0x6c, 0xfd, 0xf1, 0x72, 0x6f, 0xe9,
+	0xdf, 0x97, 0x7b, 0x6f, 0x09, 0xc2, 0x45, 0x27, 0xee, 0x76, 0x0f, 0x6b, 0x71, 0xd4, 0xa7, 0x8c,
+	0xd4, 0xb0, 0xa1, 0x40, 0x07, 0x50, 0xca, 0xea, 0xec, 0xac, 0x2a, 0xba, 0x1b, 0x79, 0xba, 0xa7,
+	0xc6, 0xda, 0x5c, 0x96, 0x64, 0x78, 0xec, 0x8d, 0x9a, 0xb0, 0x39, 0xe0, 0xc4, 0x57, 0x25, 0xf2,
+	0x55, 0xc2, 0x9c, 0x92, 0x22, 0xa8, 0xb8, 0xba, 0xd3, 0xdc, 0xac, 0xd3, 0xdc, 0x26, 0xa5, 0xc9,
+	0xf3, 0x20, 0x19, 0x10, 0xbc, 0x3e, 0xe0, 0xe4, 0x48, 0x22, 0x8e, 0x54, 0x3b, 0x3f, 0x84, 0xb5,
+	0x9e, 0x10, 0xa9, 0x6f, 0xba, 0xda, 0x29, 0x2b, 0x82, 0xdb, 0xee, 0x4c, 0x97, 0xbb, 0x2d, 0x21,
+	0x52, 0x53, 0x89, 0xd6, 0x12, 0xb6, 0x7b, 0x13, 0x11, 0x7d, 0x06, 0xb6, 0x08, 0x27, 0x0c, 0xa0,
+	0x18, 0x76, 0xe6, 0x18, 0x4e, 0xc2, 0x29, 0x02, 0x10, 0x63, 0x09, 0x3d, 0x86, 0x8d, 0xde, 0xa8,
+	0xcd, 0xe2, 0xce, 0x98, 0xc2, 0x56, 0x14, 0xbb, 0xf3, 0x41, 0x28, 0xb7, 0x09, 0xcb, 0x7a, 0x6f,
+	0x5a, 0x81, 0xf6, 0xc0, 0xd6, 0xb9, 0xe8, 0x07, 0x67, 0x84, 0x3b, 0x6b, 0xd5, 0x62, 0xbd, 0x8c,
+	0x41, 0xa9, 0x9e, 0x49, 0xcd, 0x21, 0xfa, 0xe9, 0x9f, 0xe5, 0x0d, 0x28, 0x44, 0xe7, 0xa8, 0x64,
+	0xa8, 0x79, 0x73, 0x1d, 0x6c, 0x83, 0x3f, 0x19, 0xa5, 0xa4, 0xf6, 0x73, 0x11, 0xec, 0xa9, 0xbb,
+	0xa2, 0xaf, 0x60, 0x6b, 0x18, 0x33, 0x31, 0x08, 0x12, 0x9f, 0x13, 0x36, 0x8c, 0x43, 0xc2, 0x1d,
+	0xab, 0x5a, 0xac, 0xdb, 0x8d, 0x5b, 0xf9, 0x2a, 0x61, 0xc2, 0xe9, 0x80, 0x85, 0x04, 0x93, 0xae,
+	0x29, 0xd4, 0xa6, 0x01, 0x1e, 0x1b, 0x1c, 0x62, 0xe0, 0xcc, 0x70, 0xf9, 0x9c, 0x24, 0x24, 0x14,
+	0x94, 0x39, 0x05, 0xc5, 0x79, 0x70, 0x55, 0xde, 0xdd, 0xe7, 0x39, 0xbe, 0x63, 0x03, 0xfd, 0xa2,
+	0x2f, 0xd8, 0x08, 0xdf, 0x18, 0x5e, 0x6a, 0x44, 0x9f, 0x42, 0x65, 0xf6, 0x9b, 0x2a, 0x3b, 0x69,
+	0x20, 0x6f, 0x52, 0x54, 0x29, 0x72, 0xf2, 0xd8, 0x67, 0x63, 0x3b, 0xfa, 0x64, 0x32, 0x21, 0xba,
+	0xb3, 0xee, 0xe6, 0x27, 0x44, 0x46, 0xb7, 0x68, 0x4a, 0x2a, 0x5f, 0xc2, 0xce, 0x15, 0x11, 0xcb,
+	0x79, 0x3e, 0x25, 0x23, 0x35, 0xcf, 0x65, 0x2c, 0x8f, 0x68, 0x1b, 0xae, 0x0d, 0x65, 0x8f, 0x3a,
+	0x05, 0xa5, 0xd3, 0xc2, 0x61, 0xe1, 0xc0, 0xaa, 0xfd, 0x08, 0x30, 0x69, 0x1f, 0xd4, 0x80, 0xb2,
+	0x6c, 0xb8, 0x1e, 0xe5, 0x22, 0x2b, 0xc6, 0xf5, 0x7c, 0x5c, 0x27, 0x61, 0xda, 0xa2, 0x5c, 0xe0,
+	0x92, 0xd0, 0x07, 0x8e, 0x0e, 0x67, 0x6f, 0x52, 0x9d, 0x43, 0x2c, 0xba, 0x48, 0xed, 0x85, 0x05,
+	0xeb, 0xb9, 0xd6, 0x93, 0x5d, 0x71, 0x16, 0x88, 0xb0, 0x47, 0xc6, 0x3d, 0x9b, 0x05, 0xb2, 0x37,
+	0x57, 0xc1, 0xa7, 0xda, 0xd1, 0x40, 0xf1, 0xe6, 0x59, 0x4e, 0xe6, 0xe8, 0x7b, 0xb8, 0xd9, 0x21,
+	0x09, 0x91, 0xb0, 0x8e, 0x3f, 0x3d, 0x8b, 0x5c, 0xe5, 0xc1, 0x6e, 0xdc, 0x9b, 0xa3, 0xfc, 0x3c,
+	0xf3, 0x9f, 0xea, 0x0e, 0x7c, 0xbd, 0x73, 0x89, 0x96, 0xd7, 0xfe, 0xb4, 0x60, 0x23, 0x1f, 0x02,
+	0x6a, 0xc0, 0xaa, 0x0e, 0x82, 0xa9, 0xec, 0xdb, 0x0d, 0x67, 0x41, 0xd0, 0x0c, 0x67, 0x8e, 0x73,
+	0x7b, 0xa2, 0xf0, 0xc6, 0x7b, 0xa2, 0xf8, 0x9a, 0x7b, 0x62, 0x76, 0x52, 0x7f, 0xb1, 0x60, 0xd5,
+	0x84, 0x89, 0x3e, 0x04, 0xe0, 0x3c, 0xf1, 0xf5, 0x5b, 0x62, 0x2e, 0x75, 0x33, 0x5f, 0xe0, 0x63,
+	0x9e, 0x3c, 0x52, 0x66, 0x5c, 0xe6, 0xd9, 0x11, 0x7d, 0x0b, 0xdb, 0x7a, 0x6a, 0xfd, 0x94, 0x91,
+	0x6e, 0x7c, 0xe1, 0xb3, 0xa0, 0x1f, 0x11, 0x6e, 0xa6, 0x71, 0xcf, 0x55, 0x4f, 0x94, 0xab, 0x69,
+	0xf5, 0xb8, 0x0f, 0xf7, 0xdd, 0x47, 0x71, 0x87, 0x61, 0xe9, 0x87, 0x91, 0x06, 0x1f, 0x29, 0xac,
+	0x52, 0xf1, 0xda, 0xaf, 0x16, 0x6c, 0x5f, 0x56, 0x1f, 0x74, 0x1f, 0x8a, 0x8c, 0x74, 0x4d, 0x70,
+	0x8b, 0x97, 0x47, 0x6b, 0x09, 0x4b, 0x3f, 0xd4, 0x84, 0xd2, 0xd4, 0x72, 0x90, 0x98, 0x77, 0xaf,
+	0x4a, 0x76, 0x36, 0x5b, 0xad, 0x25, 0x3c, 0xc6, 0x35, 0xb7, 0x60, 0x43, 0x9f, 0x63, 0xda, 0xf7,
+	0x85, 0x4c, 0xda, 0x0b, 0x0b, 0xde, 0xbe, 0x04, 0x85, 0x5a, 0xb0, 0x92, 0x04, 0x6d, 0x92, 0x64,
+	0x6d, 0xfc, 0xfe, 0xab, 0x7c, 0xcb, 0x7d, 0xa2, 0x20, 0x7a, 0x01, 0x19, 0x3c, 0xda, 0x05, 0x98,
+	0x5a, 0x30, 0x05, 0xbd, 0x83, 0x27, 0x9a, 0xca, 0xc7, 0x60, 0x4f, 0xc1, 0x5e, 0x67, 0x0b, 0x34,
+	0x1f, 0xc8, 0x07, 0xf5, 0xb7, 0xbf, 0x76, 0xad, 0xef, 0xf6, 0x5f, 0xf9, 0x1f, 0x2e, 0x3d, 0x8d,
+	0xcc, 0xaf, 0x47, 0x7b, 0x45, 0xbd, 0x86, 0xfb, 0xff, 0x0f, 0x00, 0x92, 0x0b, 0x02, 0xb6, 0x01,
+	0x0a, 0x00, 0x00,
 }
 
 func (this *Gateway) Equal(that interface{}) bool {
@@ -495,6 +856,30 @@ func (this *Gateway_TcpGateway) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Gateway_HybridGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Gateway_HybridGateway)
+	if !ok {
+		that2, ok := that.(Gateway_HybridGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HybridGateway.Equal(that1.HybridGateway) {
+		return false
+	}
+	return true
+}
 func (this *HttpGateway) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -581,3 +966,278 @@ func (this *TcpGateway) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HybridGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HybridGateway)
+	if !ok {
+		that2, ok := that.(HybridGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.MatchedGateways) != len(that1.MatchedGateways) {
+		return false
+	}
+	for i := range this.MatchedGateways {
+		if !this.MatchedGateways[i].Equal(that1.MatchedGateways[i]) {
+			return false
+		}
+	}
+	if !this.DelegatedHttpGateways.Equal(that1.DelegatedHttpGateways) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MatchedGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchedGateway)
+	if !ok {
+		that2, ok := that.(MatchedGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Matcher.Equal(that1.Matcher) {
+		return false
+	}
+	if that1.GatewayType == nil {
+		if this.GatewayType != nil {
+			return false
+		}
+	} else if this.GatewayType == nil {
+		return false
+	} else if !this.GatewayType.Equal(that1.GatewayType) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MatchedGateway_HttpGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchedGateway_HttpGateway)
+	if !ok {
+		that2, ok := that.(MatchedGateway_HttpGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HttpGateway.Equal(that1.HttpGateway) {
+		return false
+	}
+	return true
+}
+func (this *MatchedGateway_TcpGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchedGateway_TcpGateway)
+	if !ok {
+		that2, ok := that.(MatchedGateway_TcpGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TcpGateway.Equal(that1.TcpGateway) {
+		return false
+	}
+	return true
+}
+func (this *Matcher) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Matcher)
+	if !ok {
+		that2, ok := that.(Matcher)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SslConfig.Equal(that1.SslConfig) {
+		return false
+	}
+	if len(this.SourcePrefixRanges) != len(that1.SourcePrefixRanges) {
+		return false
+	}
+	for i := range this.SourcePrefixRanges {
+		if !this.SourcePrefixRanges[i].Equal(that1.SourcePrefixRanges[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DelegatedHttpGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatedHttpGateway)
+	if !ok {
+		that2, ok := that.(DelegatedHttpGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.SelectionType == nil {
+		if this.SelectionType != nil {
+			return false
+		}
+	} else if this.SelectionType == nil {
+		return false
+	} else if !this.SelectionType.Equal(that1.SelectionType) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DelegatedHttpGateway_Ref) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatedHttpGateway_Ref)
+	if !ok {
+		that2, ok := that.(DelegatedHttpGateway_Ref)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Ref.Equal(that1.Ref) {
+		return false
+	}
+	return true
+}
+func (this *DelegatedHttpGateway_Selector) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatedHttpGateway_Selector)
+	if !ok {
+		that2, ok := that.(DelegatedHttpGateway_Selector)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Selector.Equal(that1.Selector) {
+		return false
+	}
+	return true
+}
+func (this *HttpGatewaySelector) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HttpGatewaySelector)
+	if !ok {
+		that2, ok := that.(HttpGatewaySelector)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if this.Namespaces[i] != that1.Namespaces[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
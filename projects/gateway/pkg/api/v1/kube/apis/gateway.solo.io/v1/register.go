@@ -27,10 +27,20 @@ func Resource(resource string) schema.GroupResource {
 
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&Canary{},
+		&CanaryList{},
 		&Gateway{},
 		&GatewayList{},
+		&MatchableHttpGateway{},
+		&MatchableHttpGatewayList{},
+		&ReferencePolicy{},
+		&ReferencePolicyList{},
+		&RouteOption{},
+		&RouteOptionList{},
 		&RouteTable{},
 		&RouteTableList{},
+		&VirtualHostOption{},
+		&VirtualHostOptionList{},
 		&VirtualService{},
 		&VirtualServiceList{},
 	)
@@ -17,6 +17,65 @@ type metaOnly struct {
 	v1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resourceName=canaries
+// +genclient
+type Canary struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the implementation of this definition.
+	// +optional
+	Spec   api.Canary  `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status core.Status `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+func (o *Canary) MarshalJSON() ([]byte, error) {
+	spec, err := protoutils.MarshalMap(&o.Spec)
+	if err != nil {
+		return nil, err
+	}
+	delete(spec, "metadata")
+	delete(spec, "status")
+	asMap := map[string]interface{}{
+		"metadata":   o.ObjectMeta,
+		"apiVersion": o.TypeMeta.APIVersion,
+		"kind":       o.TypeMeta.Kind,
+		"status":     o.Status,
+		"spec":       spec,
+	}
+	return json.Marshal(asMap)
+}
+
+func (o *Canary) UnmarshalJSON(data []byte) error {
+	var metaOnly metaOnly
+	if err := json.Unmarshal(data, &metaOnly); err != nil {
+		return err
+	}
+	var spec api.Canary
+	if err := protoutils.UnmarshalResource(data, &spec); err != nil {
+		return err
+	}
+	*o = Canary{
+		ObjectMeta: metaOnly.ObjectMeta,
+		TypeMeta:   metaOnly.TypeMeta,
+		Spec:       spec,
+		Status:     spec.Status,
+	}
+
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// CanaryList is a collection of Canarys.
+type CanaryList struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items       []Canary `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +resourceName=gateways
 // +genclient
@@ -76,6 +135,183 @@ type GatewayList struct {
 	Items       []Gateway `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resourceName=httpgateways
+// +genclient
+type MatchableHttpGateway struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the implementation of this definition.
+	// +optional
+	Spec   api.MatchableHttpGateway `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status core.Status              `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+func (o *MatchableHttpGateway) MarshalJSON() ([]byte, error) {
+	spec, err := protoutils.MarshalMap(&o.Spec)
+	if err != nil {
+		return nil, err
+	}
+	delete(spec, "metadata")
+	delete(spec, "status")
+	asMap := map[string]interface{}{
+		"metadata":   o.ObjectMeta,
+		"apiVersion": o.TypeMeta.APIVersion,
+		"kind":       o.TypeMeta.Kind,
+		"status":     o.Status,
+		"spec":       spec,
+	}
+	return json.Marshal(asMap)
+}
+
+func (o *MatchableHttpGateway) UnmarshalJSON(data []byte) error {
+	var metaOnly metaOnly
+	if err := json.Unmarshal(data, &metaOnly); err != nil {
+		return err
+	}
+	var spec api.MatchableHttpGateway
+	if err := protoutils.UnmarshalResource(data, &spec); err != nil {
+		return err
+	}
+	*o = MatchableHttpGateway{
+		ObjectMeta: metaOnly.ObjectMeta,
+		TypeMeta:   metaOnly.TypeMeta,
+		Spec:       spec,
+		Status:     spec.Status,
+	}
+
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// MatchableHttpGatewayList is a collection of MatchableHttpGateways.
+type MatchableHttpGatewayList struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items       []MatchableHttpGateway `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resourceName=referencepolicies
+// +genclient
+type ReferencePolicy struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the implementation of this definition.
+	// +optional
+	Spec   api.ReferencePolicy `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status core.Status         `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+func (o *ReferencePolicy) MarshalJSON() ([]byte, error) {
+	spec, err := protoutils.MarshalMap(&o.Spec)
+	if err != nil {
+		return nil, err
+	}
+	delete(spec, "metadata")
+	delete(spec, "status")
+	asMap := map[string]interface{}{
+		"metadata":   o.ObjectMeta,
+		"apiVersion": o.TypeMeta.APIVersion,
+		"kind":       o.TypeMeta.Kind,
+		"status":     o.Status,
+		"spec":       spec,
+	}
+	return json.Marshal(asMap)
+}
+
+func (o *ReferencePolicy) UnmarshalJSON(data []byte) error {
+	var metaOnly metaOnly
+	if err := json.Unmarshal(data, &metaOnly); err != nil {
+		return err
+	}
+	var spec api.ReferencePolicy
+	if err := protoutils.UnmarshalResource(data, &spec); err != nil {
+		return err
+	}
+	*o = ReferencePolicy{
+		ObjectMeta: metaOnly.ObjectMeta,
+		TypeMeta:   metaOnly.TypeMeta,
+		Spec:       spec,
+		Status:     spec.Status,
+	}
+
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// ReferencePolicyList is a collection of ReferencePolicys.
+type ReferencePolicyList struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items       []ReferencePolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resourceName=routeoptions
+// +genclient
+type RouteOption struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the implementation of this definition.
+	// +optional
+	Spec   api.RouteOption `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status core.Status     `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+func (o *RouteOption) MarshalJSON() ([]byte, error) {
+	spec, err := protoutils.MarshalMap(&o.Spec)
+	if err != nil {
+		return nil, err
+	}
+	delete(spec, "metadata")
+	delete(spec, "status")
+	asMap := map[string]interface{}{
+		"metadata":   o.ObjectMeta,
+		"apiVersion": o.TypeMeta.APIVersion,
+		"kind":       o.TypeMeta.Kind,
+		"status":     o.Status,
+		"spec":       spec,
+	}
+	return json.Marshal(asMap)
+}
+
+func (o *RouteOption) UnmarshalJSON(data []byte) error {
+	var metaOnly metaOnly
+	if err := json.Unmarshal(data, &metaOnly); err != nil {
+		return err
+	}
+	var spec api.RouteOption
+	if err := protoutils.UnmarshalResource(data, &spec); err != nil {
+		return err
+	}
+	*o = RouteOption{
+		ObjectMeta: metaOnly.ObjectMeta,
+		TypeMeta:   metaOnly.TypeMeta,
+		Spec:       spec,
+		Status:     spec.Status,
+	}
+
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// RouteOptionList is a collection of RouteOptions.
+type RouteOptionList struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items       []RouteOption `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +resourceName=routetables
 // +genclient
@@ -135,6 +371,65 @@ type RouteTableList struct {
 	Items       []RouteTable `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resourceName=virtualhostoptions
+// +genclient
+type VirtualHostOption struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the implementation of this definition.
+	// +optional
+	Spec   api.VirtualHostOption `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status core.Status           `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+func (o *VirtualHostOption) MarshalJSON() ([]byte, error) {
+	spec, err := protoutils.MarshalMap(&o.Spec)
+	if err != nil {
+		return nil, err
+	}
+	delete(spec, "metadata")
+	delete(spec, "status")
+	asMap := map[string]interface{}{
+		"metadata":   o.ObjectMeta,
+		"apiVersion": o.TypeMeta.APIVersion,
+		"kind":       o.TypeMeta.Kind,
+		"status":     o.Status,
+		"spec":       spec,
+	}
+	return json.Marshal(asMap)
+}
+
+func (o *VirtualHostOption) UnmarshalJSON(data []byte) error {
+	var metaOnly metaOnly
+	if err := json.Unmarshal(data, &metaOnly); err != nil {
+		return err
+	}
+	var spec api.VirtualHostOption
+	if err := protoutils.UnmarshalResource(data, &spec); err != nil {
+		return err
+	}
+	*o = VirtualHostOption{
+		ObjectMeta: metaOnly.ObjectMeta,
+		TypeMeta:   metaOnly.TypeMeta,
+		Spec:       spec,
+		Status:     spec.Status,
+	}
+
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// VirtualHostOptionList is a collection of VirtualHostOptions.
+type VirtualHostOptionList struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items       []VirtualHostOption `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +resourceName=virtualservices
 // +genclient
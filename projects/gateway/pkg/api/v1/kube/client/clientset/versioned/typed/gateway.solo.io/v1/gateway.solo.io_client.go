@@ -26,8 +26,13 @@ import (
 
 type GatewayV1Interface interface {
 	RESTClient() rest.Interface
+	CanariesGetter
 	GatewaysGetter
+	MatchableHttpGatewaysGetter
+	ReferencePoliciesGetter
+	RouteOptionsGetter
 	RouteTablesGetter
+	VirtualHostOptionsGetter
 	VirtualServicesGetter
 }
 
@@ -36,14 +41,34 @@ type GatewayV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *GatewayV1Client) Canaries(namespace string) CanaryInterface {
+	return newCanaries(c, namespace)
+}
+
 func (c *GatewayV1Client) Gateways(namespace string) GatewayInterface {
 	return newGateways(c, namespace)
 }
 
+func (c *GatewayV1Client) MatchableHttpGateways(namespace string) MatchableHttpGatewayInterface {
+	return newMatchableHttpGateways(c, namespace)
+}
+
+func (c *GatewayV1Client) ReferencePolicies(namespace string) ReferencePolicyInterface {
+	return newReferencePolicies(c, namespace)
+}
+
+func (c *GatewayV1Client) RouteOptions(namespace string) RouteOptionInterface {
+	return newRouteOptions(c, namespace)
+}
+
 func (c *GatewayV1Client) RouteTables(namespace string) RouteTableInterface {
 	return newRouteTables(c, namespace)
 }
 
+func (c *GatewayV1Client) VirtualHostOptions(namespace string) VirtualHostOptionInterface {
+	return newVirtualHostOptions(c, namespace)
+}
+
 func (c *GatewayV1Client) VirtualServices(namespace string) VirtualServiceInterface {
 	return newVirtualServices(c, namespace)
 }
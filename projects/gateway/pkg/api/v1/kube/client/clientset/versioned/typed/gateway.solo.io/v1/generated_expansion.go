@@ -18,8 +18,18 @@ limitations under the License.
 
 package v1
 
+type CanaryExpansion interface{}
+
 type GatewayExpansion interface{}
 
+type MatchableHttpGatewayExpansion interface{}
+
+type ReferencePolicyExpansion interface{}
+
+type RouteOptionExpansion interface{}
+
 type RouteTableExpansion interface{}
 
+type VirtualHostOptionExpansion interface{}
+
 type VirtualServiceExpansion interface{}
@@ -24,10 +24,20 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// Canaries returns a CanaryInformer.
+	Canaries() CanaryInformer
 	// Gateways returns a GatewayInformer.
 	Gateways() GatewayInformer
+	// MatchableHttpGateways returns a MatchableHttpGatewayInformer.
+	MatchableHttpGateways() MatchableHttpGatewayInformer
+	// ReferencePolicies returns a ReferencePolicyInformer.
+	ReferencePolicies() ReferencePolicyInformer
+	// RouteOptions returns a RouteOptionInformer.
+	RouteOptions() RouteOptionInformer
 	// RouteTables returns a RouteTableInformer.
 	RouteTables() RouteTableInformer
+	// VirtualHostOptions returns a VirtualHostOptionInformer.
+	VirtualHostOptions() VirtualHostOptionInformer
 	// VirtualServices returns a VirtualServiceInformer.
 	VirtualServices() VirtualServiceInformer
 }
@@ -43,16 +53,41 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// Canaries returns a CanaryInformer.
+func (v *version) Canaries() CanaryInformer {
+	return &canaryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Gateways returns a GatewayInformer.
 func (v *version) Gateways() GatewayInformer {
 	return &gatewayInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// MatchableHttpGateways returns a MatchableHttpGatewayInformer.
+func (v *version) MatchableHttpGateways() MatchableHttpGatewayInformer {
+	return &matchableHttpGatewayInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ReferencePolicies returns a ReferencePolicyInformer.
+func (v *version) ReferencePolicies() ReferencePolicyInformer {
+	return &referencePolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// RouteOptions returns a RouteOptionInformer.
+func (v *version) RouteOptions() RouteOptionInformer {
+	return &routeOptionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // RouteTables returns a RouteTableInformer.
 func (v *version) RouteTables() RouteTableInformer {
 	return &routeTableInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VirtualHostOptions returns a VirtualHostOptionInformer.
+func (v *version) VirtualHostOptions() VirtualHostOptionInformer {
+	return &virtualHostOptionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VirtualServices returns a VirtualServiceInformer.
 func (v *version) VirtualServices() VirtualServiceInformer {
 	return &virtualServiceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1
 
+// CanaryListerExpansion allows custom methods to be added to
+// CanaryLister.
+type CanaryListerExpansion interface{}
+
+// CanaryNamespaceListerExpansion allows custom methods to be added to
+// CanaryNamespaceLister.
+type CanaryNamespaceListerExpansion interface{}
+
 // GatewayListerExpansion allows custom methods to be added to
 // GatewayLister.
 type GatewayListerExpansion interface{}
@@ -26,6 +34,30 @@ type GatewayListerExpansion interface{}
 // GatewayNamespaceLister.
 type GatewayNamespaceListerExpansion interface{}
 
+// MatchableHttpGatewayListerExpansion allows custom methods to be added to
+// MatchableHttpGatewayLister.
+type MatchableHttpGatewayListerExpansion interface{}
+
+// MatchableHttpGatewayNamespaceListerExpansion allows custom methods to be added to
+// MatchableHttpGatewayNamespaceLister.
+type MatchableHttpGatewayNamespaceListerExpansion interface{}
+
+// ReferencePolicyListerExpansion allows custom methods to be added to
+// ReferencePolicyLister.
+type ReferencePolicyListerExpansion interface{}
+
+// ReferencePolicyNamespaceListerExpansion allows custom methods to be added to
+// ReferencePolicyNamespaceLister.
+type ReferencePolicyNamespaceListerExpansion interface{}
+
+// RouteOptionListerExpansion allows custom methods to be added to
+// RouteOptionLister.
+type RouteOptionListerExpansion interface{}
+
+// RouteOptionNamespaceListerExpansion allows custom methods to be added to
+// RouteOptionNamespaceLister.
+type RouteOptionNamespaceListerExpansion interface{}
+
 // RouteTableListerExpansion allows custom methods to be added to
 // RouteTableLister.
 type RouteTableListerExpansion interface{}
@@ -34,6 +66,14 @@ type RouteTableListerExpansion interface{}
 // RouteTableNamespaceLister.
 type RouteTableNamespaceListerExpansion interface{}
 
+// VirtualHostOptionListerExpansion allows custom methods to be added to
+// VirtualHostOptionLister.
+type VirtualHostOptionListerExpansion interface{}
+
+// VirtualHostOptionNamespaceListerExpansion allows custom methods to be added to
+// VirtualHostOptionNamespaceLister.
+type VirtualHostOptionNamespaceListerExpansion interface{}
+
 // VirtualServiceListerExpansion allows custom methods to be added to
 // VirtualServiceLister.
 type VirtualServiceListerExpansion interface{}
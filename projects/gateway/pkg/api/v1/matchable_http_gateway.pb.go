@@ -0,0 +1,194 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gateway/api/v1/matchable_http_gateway.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A **MatchableHttpGateway** is a fragment of a Gateway, which serves the connections matching its matcher with
+// its own HTTP gateway. Gateways with a `hybridGateway` select MatchableHttpGateways by reference or by label with
+// `delegatedHttpGateways`.
+//
+// MatchableHttpGateways sharing a port are translated into separate filter chains, so different teams can use
+// different HTTP connection manager settings, access logs and filters on the same port.
+//
+// ```yaml
+// apiVersion: gateway.solo.io/v1
+// kind: MatchableHttpGateway
+// metadata:
+//
+//	name: 'internal'
+//	namespace: 'gloo-system'
+//	labels:
+//	  gateway: 'public'
+//
+// spec:
+//
+//	matcher:
+//	  sourcePrefixRanges:
+//	  - addressPrefix: '10.0.0.0'
+//	    prefixLen: 8
+//	httpGateway:
+//	  virtualServiceSelector:
+//	    team: 'internal'
+//	  options:
+//	    httpConnectionManagerSettings:
+//	      useRemoteAddress: true
+//
+// ```
+type MatchableHttpGateway struct {
+	// The connections served by this gateway.
+	Matcher *Matcher `protobuf:"bytes,1,opt,name=matcher,proto3" json:"matcher,omitempty"`
+	// The http gateway serving the matched connections.
+	HttpGateway *HttpGateway `protobuf:"bytes,2,opt,name=http_gateway,json=httpGateway,proto3" json:"http_gateway,omitempty"`
+	// Status indicates the validation status of this resource.
+	// Status is read-only by clients, and set by gloo during validation
+	Status core.Status `protobuf:"bytes,6,opt,name=status,proto3" json:"status" testdiff:"ignore"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *MatchableHttpGateway) Reset()         { *m = MatchableHttpGateway{} }
+func (m *MatchableHttpGateway) String() string { return proto.CompactTextString(m) }
+func (*MatchableHttpGateway) ProtoMessage()    {}
+func (*MatchableHttpGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_def6d1cabe2dde80, []int{0}
+}
+func (m *MatchableHttpGateway) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchableHttpGateway.Unmarshal(m, b)
+}
+func (m *MatchableHttpGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchableHttpGateway.Marshal(b, m, deterministic)
+}
+func (m *MatchableHttpGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchableHttpGateway.Merge(m, src)
+}
+func (m *MatchableHttpGateway) XXX_Size() int {
+	return xxx_messageInfo_MatchableHttpGateway.Size(m)
+}
+func (m *MatchableHttpGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchableHttpGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchableHttpGateway proto.InternalMessageInfo
+
+func (m *MatchableHttpGateway) GetMatcher() *Matcher {
+	if m != nil {
+		return m.Matcher
+	}
+	return nil
+}
+
+func (m *MatchableHttpGateway) GetHttpGateway() *HttpGateway {
+	if m != nil {
+		return m.HttpGateway
+	}
+	return nil
+}
+
+func (m *MatchableHttpGateway) GetStatus() core.Status {
+	if m != nil {
+		return m.Status
+	}
+	return core.Status{}
+}
+
+func (m *MatchableHttpGateway) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func init() {
+	proto.RegisterType((*MatchableHttpGateway)(nil), "gateway.solo.io.MatchableHttpGateway")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gateway/api/v1/matchable_http_gateway.proto", fileDescriptor_def6d1cabe2dde80)
+}
+
+var fileDescriptor_def6d1cabe2dde80 = []byte{
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0xff, 0xf4, 0x2f, 0xad, 0x4c, 0x15, 0x31, 0x04, 0x09, 0xa5, 0xb6, 0xd2, 0x95, 0x08,
+	0x66, 0xb0, 0xdd, 0x48, 0x41, 0x84, 0x6e, 0x14, 0xb4, 0x9b, 0xb8, 0x73, 0x53, 0xa6, 0xe9, 0x74,
+	0x32, 0xb6, 0xf5, 0x86, 0xe4, 0xd6, 0xd6, 0xad, 0x4f, 0xe3, 0xc2, 0x07, 0xf0, 0x11, 0x7c, 0x8a,
+	0x2e, 0x7c, 0x03, 0x05, 0xf7, 0x92, 0xc9, 0x24, 0xa4, 0x15, 0xc5, 0x5d, 0xe6, 0x7e, 0xf7, 0x9c,
+	0xc3, 0xb9, 0x84, 0x5c, 0x09, 0x89, 0xfe, 0x6c, 0xe0, 0x78, 0x30, 0xa5, 0x11, 0x4c, 0xe0, 0x48,
+	0x02, 0x15, 0x13, 0x00, 0x1a, 0x84, 0x70, 0xcb, 0x3d, 0x8c, 0xa8, 0x60, 0xc8, 0xe7, 0xec, 0x81,
+	0xb2, 0x40, 0xd2, 0xfb, 0x63, 0x3a, 0x65, 0xe8, 0xf9, 0x6c, 0x30, 0xe1, 0x7d, 0x1f, 0x31, 0xe8,
+	0x6b, 0xea, 0x04, 0x21, 0x20, 0x98, 0xdb, 0xe9, 0x33, 0xb6, 0x72, 0x24, 0x54, 0x2d, 0x01, 0x02,
+	0x14, 0xa3, 0xf1, 0x57, 0xb2, 0x56, 0x35, 0xf9, 0x02, 0x93, 0x21, 0x5f, 0xa0, 0x9e, 0xd5, 0x55,
+	0xfa, 0x58, 0x62, 0x16, 0xc4, 0x91, 0x0d, 0x19, 0x32, 0xcd, 0x6b, 0xeb, 0x3c, 0x42, 0x86, 0xb3,
+	0xe8, 0x27, 0x75, 0xfa, 0xd6, 0xfc, 0xf0, 0xd7, 0x4e, 0x2b, 0x25, 0x9a, 0xcf, 0x05, 0x62, 0xf5,
+	0xd2, 0x96, 0x17, 0x88, 0xc1, 0x79, 0x82, 0xcd, 0x16, 0x29, 0xab, 0xf6, 0x3c, 0xb4, 0x8d, 0x7d,
+	0xe3, 0xa0, 0xd2, 0xb2, 0x9d, 0xb5, 0xbe, 0x4e, 0x2f, 0xe1, 0x6e, 0xba, 0x68, 0x9e, 0x91, 0xcd,
+	0xfc, 0x9d, 0xec, 0x82, 0x12, 0xd6, 0xbe, 0x09, 0x73, 0x39, 0x6e, 0xc5, 0xcf, 0x85, 0x5e, 0x92,
+	0x52, 0xd2, 0xd4, 0x2e, 0x29, 0xa9, 0xe5, 0x78, 0x10, 0xf2, 0x4c, 0x77, 0xad, 0x58, 0x77, 0xef,
+	0xe5, 0xb3, 0x68, 0xbc, 0x2e, 0x1b, 0xff, 0x3e, 0x96, 0x8d, 0x1d, 0xe4, 0x11, 0x0e, 0xe5, 0x68,
+	0xd4, 0x69, 0x4a, 0x71, 0x07, 0x21, 0x6f, 0xba, 0xda, 0xc2, 0x3c, 0x21, 0x1b, 0xe9, 0x59, 0xed,
+	0xb2, 0xb2, 0xdb, 0x5d, 0xb5, 0xeb, 0x69, 0xda, 0x2d, 0xc6, 0x66, 0x6e, 0xb6, 0xdd, 0xb1, 0x1f,
+	0xdf, 0x8b, 0x16, 0xf9, 0xef, 0x8b, 0xb9, 0xb9, 0x95, 0x2f, 0x14, 0x75, 0x4f, 0xe3, 0xe8, 0xa7,
+	0xb7, 0xba, 0x71, 0xd3, 0xfe, 0xf3, 0xbf, 0x14, 0x8c, 0x85, 0xbe, 0xfd, 0xa0, 0xa4, 0x8e, 0xde,
+	0xfe, 0x1a, 0x00, 0xfa, 0x17, 0x4f, 0x97, 0x89, 0x02, 0x00, 0x00,
+}
+
+func (this *MatchableHttpGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchableHttpGateway)
+	if !ok {
+		that2, ok := that.(MatchableHttpGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Matcher.Equal(that1.Matcher) {
+		return false
+	}
+	if !this.HttpGateway.Equal(that1.HttpGateway) {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
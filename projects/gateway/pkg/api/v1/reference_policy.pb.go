@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gateway/api/v1/reference_policy.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Whether the policy allows or denies the references it matches.
+type ReferencePolicy_Action int32
+
+const (
+	ReferencePolicy_Allow ReferencePolicy_Action = 0
+	ReferencePolicy_Deny  ReferencePolicy_Action = 1
+)
+
+var ReferencePolicy_Action_name = map[int32]string{
+	0: "Allow",
+	1: "Deny",
+}
+
+var ReferencePolicy_Action_value = map[string]int32{
+	"Allow": 0,
+	"Deny":  1,
+}
+
+func (x ReferencePolicy_Action) String() string {
+	return proto.EnumName(ReferencePolicy_Action_name, int32(x))
+}
+
+func (ReferencePolicy_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f76d696dcd00c95e, []int{0, 0}
+}
+
+// A **ReferencePolicy** allows or denies references to the resources in its namespace from resources in other namespaces.
+//
+// Virtual Services and Route Tables may reference Upstreams, Route Tables and Secrets, and Matchable HTTP Gateways may
+// reference Secrets, in any watched namespace, unless the owner of the namespace of the referenced resource restricts
+// these references with ReferencePolicies:
+//   - A reference is denied if a `Deny` policy in the namespace of the referenced resource matches it.
+//   - Otherwise, if the `to` of an `Allow` policy in that namespace matches the referenced resource, the reference is
+//     only allowed if the `from` of one of these policies matches the referencing resource.
+//   - Otherwise, the reference is allowed.
+//
+// References within a namespace are always allowed. References that are not allowed are reported as errors on the
+// referencing resource.
+//
+// The following policy only allows the Virtual Services and Route Tables of the `team-a` namespace to reference
+// the Upstreams of the `backends` namespace:
+//
+// ```yaml
+// apiVersion: gateway.solo.io/v1
+// kind: ReferencePolicy
+// metadata:
+//
+//	name: 'team-a'
+//	namespace: 'backends'
+//
+// spec:
+//
+//	from:
+//	- namespace: 'team-a'
+//	to:
+//	- kind: 'Upstream'
+//
+// ```
+type ReferencePolicy struct {
+	// The referencing resources the policy applies to.
+	From []*ReferencePolicy_From `protobuf:"bytes,1,rep,name=from,proto3" json:"from,omitempty"`
+	// The referenced resources the policy applies to.
+	To []*ReferencePolicy_To `protobuf:"bytes,2,rep,name=to,proto3" json:"to,omitempty"`
+	// Whether the policy allows or denies the references. Defaults to `Allow`.
+	Action ReferencePolicy_Action `protobuf:"varint,3,opt,name=action,proto3,enum=gateway.solo.io.ReferencePolicy_Action" json:"action,omitempty"`
+	// Status indicates the validation status of this resource.
+	// Status is read-only by clients, and set by gloo during validation
+	Status core.Status `protobuf:"bytes,6,opt,name=status,proto3" json:"status" testdiff:"ignore"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReferencePolicy) Reset()         { *m = ReferencePolicy{} }
+func (m *ReferencePolicy) String() string { return proto.CompactTextString(m) }
+func (*ReferencePolicy) ProtoMessage()    {}
+func (*ReferencePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f76d696dcd00c95e, []int{0}
+}
+func (m *ReferencePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReferencePolicy.Unmarshal(m, b)
+}
+func (m *ReferencePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReferencePolicy.Marshal(b, m, deterministic)
+}
+func (m *ReferencePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferencePolicy.Merge(m, src)
+}
+func (m *ReferencePolicy) XXX_Size() int {
+	return xxx_messageInfo_ReferencePolicy.Size(m)
+}
+func (m *ReferencePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferencePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferencePolicy proto.InternalMessageInfo
+
+func (m *ReferencePolicy) GetFrom() []*ReferencePolicy_From {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *ReferencePolicy) GetTo() []*ReferencePolicy_To {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *ReferencePolicy) GetAction() ReferencePolicy_Action {
+	if m != nil {
+		return m.Action
+	}
+	return ReferencePolicy_Allow
+}
+
+func (m *ReferencePolicy) GetStatus() core.Status {
+	if m != nil {
+		return m.Status
+	}
+	return core.Status{}
+}
+
+func (m *ReferencePolicy) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+// A kind of resource in a namespace which may reference resources.
+type ReferencePolicy_From struct {
+	// The kind of the referencing resources: `VirtualService`, `RouteTable` or
+	// `MatchableHttpGateway`. Matches all of them if empty.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The namespace of the referencing resources. Matches all namespaces if empty or `*`.
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReferencePolicy_From) Reset()         { *m = ReferencePolicy_From{} }
+func (m *ReferencePolicy_From) String() string { return proto.CompactTextString(m) }
+func (*ReferencePolicy_From) ProtoMessage()    {}
+func (*ReferencePolicy_From) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f76d696dcd00c95e, []int{0, 0}
+}
+func (m *ReferencePolicy_From) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReferencePolicy_From.Unmarshal(m, b)
+}
+func (m *ReferencePolicy_From) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReferencePolicy_From.Marshal(b, m, deterministic)
+}
+func (m *ReferencePolicy_From) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferencePolicy_From.Merge(m, src)
+}
+func (m *ReferencePolicy_From) XXX_Size() int {
+	return xxx_messageInfo_ReferencePolicy_From.Size(m)
+}
+func (m *ReferencePolicy_From) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferencePolicy_From.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferencePolicy_From proto.InternalMessageInfo
+
+func (m *ReferencePolicy_From) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ReferencePolicy_From) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+// Resources in the namespace of the policy which may be referenced.
+type ReferencePolicy_To struct {
+	// The kind of the referenced resources: `Upstream`, `UpstreamGroup`, `RouteTable` or `Secret`.
+	// Matches all of them if empty.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The name of the referenced resource. Matches all the resources of the kind if empty.
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReferencePolicy_To) Reset()         { *m = ReferencePolicy_To{} }
+func (m *ReferencePolicy_To) String() string { return proto.CompactTextString(m) }
+func (*ReferencePolicy_To) ProtoMessage()    {}
+func (*ReferencePolicy_To) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f76d696dcd00c95e, []int{0, 1}
+}
+func (m *ReferencePolicy_To) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReferencePolicy_To.Unmarshal(m, b)
+}
+func (m *ReferencePolicy_To) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReferencePolicy_To.Marshal(b, m, deterministic)
+}
+func (m *ReferencePolicy_To) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferencePolicy_To.Merge(m, src)
+}
+func (m *ReferencePolicy_To) XXX_Size() int {
+	return xxx_messageInfo_ReferencePolicy_To.Size(m)
+}
+func (m *ReferencePolicy_To) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferencePolicy_To.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferencePolicy_To proto.InternalMessageInfo
+
+func (m *ReferencePolicy_To) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ReferencePolicy_To) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("gateway.solo.io.ReferencePolicy_Action", ReferencePolicy_Action_name, ReferencePolicy_Action_value)
+	proto.RegisterType((*ReferencePolicy)(nil), "gateway.solo.io.ReferencePolicy")
+	proto.RegisterType((*ReferencePolicy_From)(nil), "gateway.solo.io.ReferencePolicy.From")
+	proto.RegisterType((*ReferencePolicy_To)(nil), "gateway.solo.io.ReferencePolicy.To")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gateway/api/v1/reference_policy.proto", fileDescriptor_f76d696dcd00c95e)
+}
+
+var fileDescriptor_f76d696dcd00c95e = []byte{
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xd4, 0x50,
+	0x10, 0xc7, 0x9b, 0x6c, 0x8c, 0xdd, 0x29, 0xd8, 0xfa, 0x28, 0x12, 0x96, 0x6d, 0x1b, 0x56, 0xc4,
+	0x3d, 0x68, 0x82, 0xbb, 0x97, 0x5a, 0x10, 0xe9, 0x22, 0xbd, 0x88, 0x20, 0xb1, 0x27, 0x2f, 0xf2,
+	0x9a, 0x9d, 0xc4, 0xe7, 0x26, 0x99, 0xf0, 0x32, 0xb5, 0xdd, 0xab, 0x9f, 0xc6, 0x8f, 0xe0, 0x47,
+	0xf0, 0x53, 0xf4, 0xe0, 0x37, 0xb0, 0xe0, 0x5d, 0xf2, 0x92, 0xad, 0x18, 0x94, 0xed, 0x6d, 0x32,
+	0xff, 0xff, 0xef, 0xff, 0x26, 0xc3, 0xc0, 0x49, 0xaa, 0xf8, 0xe3, 0xf9, 0x59, 0x10, 0x53, 0x1e,
+	0x56, 0x94, 0xd1, 0x53, 0x45, 0x61, 0x9a, 0x11, 0x85, 0xa5, 0xa6, 0x4f, 0x18, 0x73, 0x15, 0xa6,
+	0x92, 0xf1, 0x42, 0x2e, 0x43, 0x59, 0xaa, 0xf0, 0xf3, 0xb3, 0x50, 0x63, 0x82, 0x1a, 0x8b, 0x18,
+	0x3f, 0x94, 0x94, 0xa9, 0x78, 0x19, 0x94, 0x9a, 0x98, 0xc4, 0x76, 0x6b, 0x0b, 0xea, 0x90, 0x40,
+	0xd1, 0x60, 0x37, 0xa5, 0x94, 0x8c, 0x16, 0xd6, 0x55, 0x63, 0x1b, 0x08, 0xbc, 0xe4, 0xa6, 0x89,
+	0x97, 0xdc, 0xf6, 0xf6, 0xcd, 0xbb, 0x0b, 0xc5, 0xab, 0x27, 0x72, 0x64, 0x39, 0x97, 0x2c, 0x5b,
+	0x7d, 0xd8, 0xd5, 0x2b, 0x96, 0x7c, 0x5e, 0xfd, 0x8f, 0x5e, 0x7d, 0x37, 0xfa, 0xe8, 0xba, 0x07,
+	0xdb, 0xd1, 0x6a, 0xe6, 0xb7, 0x66, 0x64, 0xf1, 0x1c, 0x9c, 0x44, 0x53, 0xee, 0x59, 0x7e, 0x6f,
+	0xbc, 0x35, 0x79, 0x14, 0x74, 0x66, 0x0f, 0x3a, 0xfe, 0xe0, 0x44, 0x53, 0x1e, 0x19, 0x44, 0x4c,
+	0xc1, 0x66, 0xf2, 0x6c, 0x03, 0x3e, 0x5c, 0x0b, 0x9e, 0x52, 0x64, 0x33, 0x89, 0x97, 0xe0, 0xca,
+	0x98, 0x15, 0x15, 0x5e, 0xcf, 0xb7, 0xc6, 0xf7, 0x26, 0x8f, 0xd7, 0x82, 0xc7, 0xc6, 0x1e, 0xb5,
+	0x98, 0x78, 0x0d, 0x6e, 0xf3, 0xd3, 0x9e, 0xeb, 0x5b, 0xe3, 0xad, 0xc9, 0x6e, 0x10, 0x93, 0xc6,
+	0x1b, 0xfa, 0x9d, 0xd1, 0x66, 0x7b, 0xdf, 0x7e, 0x39, 0xd6, 0xf7, 0xab, 0x83, 0x8d, 0xeb, 0xab,
+	0x83, 0xfb, 0x8c, 0x15, 0xcf, 0x55, 0x92, 0x1c, 0x8d, 0x54, 0x5a, 0x90, 0xc6, 0x51, 0xd4, 0x46,
+	0x88, 0x43, 0xd8, 0x5c, 0x6d, 0xd8, 0xbb, 0x6b, 0xe2, 0x1e, 0xfc, 0x1d, 0xf7, 0xa6, 0x55, 0x67,
+	0x4e, 0x1d, 0x16, 0xdd, 0xb8, 0x07, 0x87, 0xe0, 0xd4, 0xab, 0x10, 0x02, 0x9c, 0x85, 0x2a, 0xe6,
+	0x9e, 0xe5, 0x5b, 0xe3, 0x7e, 0x64, 0x6a, 0x31, 0x84, 0x7e, 0x21, 0x73, 0xac, 0x4a, 0x19, 0xa3,
+	0x67, 0x1b, 0xe1, 0x4f, 0x63, 0xf0, 0x04, 0xec, 0x53, 0xfa, 0x27, 0x27, 0xc0, 0xa9, 0x6d, 0x2d,
+	0x62, 0xea, 0xd1, 0x1e, 0xb8, 0xcd, 0x02, 0x44, 0x1f, 0xee, 0x1c, 0x67, 0x19, 0x5d, 0xec, 0x6c,
+	0x88, 0x4d, 0x70, 0x5e, 0x61, 0xb1, 0xdc, 0xb1, 0x8e, 0xfc, 0x2f, 0x3f, 0x9d, 0x21, 0xb8, 0x1a,
+	0x93, 0x92, 0x32, 0x21, 0x3a, 0x17, 0xa9, 0xb0, 0x9a, 0xbd, 0xa8, 0x37, 0xf1, 0xf5, 0xc7, 0xbe,
+	0xf5, 0x7e, 0x7a, 0xeb, 0xfb, 0x2e, 0x17, 0x69, 0x7b, 0x42, 0x67, 0xae, 0x39, 0x9d, 0xe9, 0xef,
+	0x01, 0x00, 0xff, 0xea, 0x33, 0x70, 0x1d, 0x03, 0x00, 0x00,
+}
+
+func (this *ReferencePolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReferencePolicy)
+	if !ok {
+		that2, ok := that.(ReferencePolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.From) != len(that1.From) {
+		return false
+	}
+	for i := range this.From {
+		if !this.From[i].Equal(that1.From[i]) {
+			return false
+		}
+	}
+	if len(this.To) != len(that1.To) {
+		return false
+	}
+	for i := range this.To {
+		if !this.To[i].Equal(that1.To[i]) {
+			return false
+		}
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ReferencePolicy_From) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReferencePolicy_From)
+	if !ok {
+		that2, ok := that.(ReferencePolicy_From)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ReferencePolicy_To) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReferencePolicy_To)
+	if !ok {
+		that2, ok := that.(ReferencePolicy_To)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,163 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"log"
+	"sort"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func NewReferencePolicy(namespace, name string) *ReferencePolicy {
+	referencepolicy := &ReferencePolicy{}
+	referencepolicy.SetMetadata(core.Metadata{
+		Name:      name,
+		Namespace: namespace,
+	})
+	return referencepolicy
+}
+
+func (r *ReferencePolicy) SetMetadata(meta core.Metadata) {
+	r.Metadata = meta
+}
+
+func (r *ReferencePolicy) SetStatus(status core.Status) {
+	r.Status = status
+}
+
+func (r *ReferencePolicy) MustHash() uint64 {
+	hashVal, err := r.Hash(nil)
+	if err != nil {
+		log.Panicf("error while hashing: (%s) this should never happen", err)
+	}
+	return hashVal
+}
+
+func (r *ReferencePolicy) GroupVersionKind() schema.GroupVersionKind {
+	return ReferencePolicyGVK
+}
+
+type ReferencePolicyList []*ReferencePolicy
+
+func (list ReferencePolicyList) Find(namespace, name string) (*ReferencePolicy, error) {
+	for _, referencePolicy := range list {
+		if referencePolicy.GetMetadata().Name == name && referencePolicy.GetMetadata().Namespace == namespace {
+			return referencePolicy, nil
+		}
+	}
+	return nil, errors.Errorf("list did not find referencePolicy %v.%v", namespace, name)
+}
+
+func (list ReferencePolicyList) AsResources() resources.ResourceList {
+	var ress resources.ResourceList
+	for _, referencePolicy := range list {
+		ress = append(ress, referencePolicy)
+	}
+	return ress
+}
+
+func (list ReferencePolicyList) AsInputResources() resources.InputResourceList {
+	var ress resources.InputResourceList
+	for _, referencePolicy := range list {
+		ress = append(ress, referencePolicy)
+	}
+	return ress
+}
+
+func (list ReferencePolicyList) Names() []string {
+	var names []string
+	for _, referencePolicy := range list {
+		names = append(names, referencePolicy.GetMetadata().Name)
+	}
+	return names
+}
+
+func (list ReferencePolicyList) NamespacesDotNames() []string {
+	var names []string
+	for _, referencePolicy := range list {
+		names = append(names, referencePolicy.GetMetadata().Namespace+"."+referencePolicy.GetMetadata().Name)
+	}
+	return names
+}
+
+func (list ReferencePolicyList) Sort() ReferencePolicyList {
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].GetMetadata().Less(list[j].GetMetadata())
+	})
+	return list
+}
+
+func (list ReferencePolicyList) Clone() ReferencePolicyList {
+	var referencePolicyList ReferencePolicyList
+	for _, referencePolicy := range list {
+		referencePolicyList = append(referencePolicyList, resources.Clone(referencePolicy).(*ReferencePolicy))
+	}
+	return referencePolicyList
+}
+
+func (list ReferencePolicyList) Each(f func(element *ReferencePolicy)) {
+	for _, referencePolicy := range list {
+		f(referencePolicy)
+	}
+}
+
+func (list ReferencePolicyList) EachResource(f func(element resources.Resource)) {
+	for _, referencePolicy := range list {
+		f(referencePolicy)
+	}
+}
+
+func (list ReferencePolicyList) AsInterfaces() []interface{} {
+	var asInterfaces []interface{}
+	list.Each(func(element *ReferencePolicy) {
+		asInterfaces = append(asInterfaces, element)
+	})
+	return asInterfaces
+}
+
+// Kubernetes Adapter for ReferencePolicy
+
+func (o *ReferencePolicy) GetObjectKind() schema.ObjectKind {
+	t := ReferencePolicyCrd.TypeMeta()
+	return &t
+}
+
+func (o *ReferencePolicy) DeepCopyObject() runtime.Object {
+	return resources.Clone(o).(*ReferencePolicy)
+}
+
+func (o *ReferencePolicy) DeepCopyInto(out *ReferencePolicy) {
+	clone := resources.Clone(o).(*ReferencePolicy)
+	*out = *clone
+}
+
+var (
+	ReferencePolicyCrd = crd.NewCrd(
+		"referencepolicies",
+		ReferencePolicyGVK.Group,
+		ReferencePolicyGVK.Version,
+		ReferencePolicyGVK.Kind,
+		"refpol",
+		false,
+		&ReferencePolicy{})
+)
+
+func init() {
+	if err := crd.AddCrd(ReferencePolicyCrd); err != nil {
+		log.Fatalf("could not add crd to global registry")
+	}
+}
+
+var (
+	ReferencePolicyGVK = schema.GroupVersionKind{
+		Version: "v1",
+		Group:   "gateway.solo.io",
+		Kind:    "ReferencePolicy",
+	}
+)
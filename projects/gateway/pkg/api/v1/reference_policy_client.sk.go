@@ -0,0 +1,123 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+type ReferencePolicyWatcher interface {
+	// watch namespace-scoped ReferencePolicies
+	Watch(namespace string, opts clients.WatchOpts) (<-chan ReferencePolicyList, <-chan error, error)
+}
+
+type ReferencePolicyClient interface {
+	BaseClient() clients.ResourceClient
+	Register() error
+	Read(namespace, name string, opts clients.ReadOpts) (*ReferencePolicy, error)
+	Write(resource *ReferencePolicy, opts clients.WriteOpts) (*ReferencePolicy, error)
+	Delete(namespace, name string, opts clients.DeleteOpts) error
+	List(namespace string, opts clients.ListOpts) (ReferencePolicyList, error)
+	ReferencePolicyWatcher
+}
+
+type referencePolicyClient struct {
+	rc clients.ResourceClient
+}
+
+func NewReferencePolicyClient(rcFactory factory.ResourceClientFactory) (ReferencePolicyClient, error) {
+	return NewReferencePolicyClientWithToken(rcFactory, "")
+}
+
+func NewReferencePolicyClientWithToken(rcFactory factory.ResourceClientFactory, token string) (ReferencePolicyClient, error) {
+	rc, err := rcFactory.NewResourceClient(factory.NewResourceClientParams{
+		ResourceType: &ReferencePolicy{},
+		Token:        token,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating base ReferencePolicy resource client")
+	}
+	return NewReferencePolicyClientWithBase(rc), nil
+}
+
+func NewReferencePolicyClientWithBase(rc clients.ResourceClient) ReferencePolicyClient {
+	return &referencePolicyClient{
+		rc: rc,
+	}
+}
+
+func (client *referencePolicyClient) BaseClient() clients.ResourceClient {
+	return client.rc
+}
+
+func (client *referencePolicyClient) Register() error {
+	return client.rc.Register()
+}
+
+func (client *referencePolicyClient) Read(namespace, name string, opts clients.ReadOpts) (*ReferencePolicy, error) {
+	opts = opts.WithDefaults()
+
+	resource, err := client.rc.Read(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resource.(*ReferencePolicy), nil
+}
+
+func (client *referencePolicyClient) Write(referencePolicy *ReferencePolicy, opts clients.WriteOpts) (*ReferencePolicy, error) {
+	opts = opts.WithDefaults()
+	resource, err := client.rc.Write(referencePolicy, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resource.(*ReferencePolicy), nil
+}
+
+func (client *referencePolicyClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	opts = opts.WithDefaults()
+
+	return client.rc.Delete(namespace, name, opts)
+}
+
+func (client *referencePolicyClient) List(namespace string, opts clients.ListOpts) (ReferencePolicyList, error) {
+	opts = opts.WithDefaults()
+
+	resourceList, err := client.rc.List(namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertToReferencePolicy(resourceList), nil
+}
+
+func (client *referencePolicyClient) Watch(namespace string, opts clients.WatchOpts) (<-chan ReferencePolicyList, <-chan error, error) {
+	opts = opts.WithDefaults()
+
+	resourcesChan, errs, initErr := client.rc.Watch(namespace, opts)
+	if initErr != nil {
+		return nil, nil, initErr
+	}
+	referencePoliciesChan := make(chan ReferencePolicyList)
+	go func() {
+		for {
+			select {
+			case resourceList := <-resourcesChan:
+				referencePoliciesChan <- convertToReferencePolicy(resourceList)
+			case <-opts.Ctx.Done():
+				close(referencePoliciesChan)
+				return
+			}
+		}
+	}()
+	return referencePoliciesChan, errs, nil
+}
+
+func convertToReferencePolicy(resources resources.ResourceList) ReferencePolicyList {
+	var referencePolicyList ReferencePolicyList
+	for _, resource := range resources {
+		referencePolicyList = append(referencePolicyList, resource.(*ReferencePolicy))
+	}
+	return referencePolicyList
+}
@@ -0,0 +1,47 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reconcile"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+// Option to copy anything from the original to the desired before writing. Return value of false means don't update
+type TransitionReferencePolicyFunc func(original, desired *ReferencePolicy) (bool, error)
+
+type ReferencePolicyReconciler interface {
+	Reconcile(namespace string, desiredResources ReferencePolicyList, transition TransitionReferencePolicyFunc, opts clients.ListOpts) error
+}
+
+func referencePolicysToResources(list ReferencePolicyList) resources.ResourceList {
+	var resourceList resources.ResourceList
+	for _, referencePolicy := range list {
+		resourceList = append(resourceList, referencePolicy)
+	}
+	return resourceList
+}
+
+func NewReferencePolicyReconciler(client ReferencePolicyClient) ReferencePolicyReconciler {
+	return &referencePolicyReconciler{
+		base: reconcile.NewReconciler(client.BaseClient()),
+	}
+}
+
+type referencePolicyReconciler struct {
+	base reconcile.Reconciler
+}
+
+func (r *referencePolicyReconciler) Reconcile(namespace string, desiredResources ReferencePolicyList, transition TransitionReferencePolicyFunc, opts clients.ListOpts) error {
+	opts = opts.WithDefaults()
+	opts.Ctx = contextutils.WithLogger(opts.Ctx, "referencePolicy_reconciler")
+	var transitionResources reconcile.TransitionResourcesFunc
+	if transition != nil {
+		transitionResources = func(original, desired resources.Resource) (bool, error) {
+			return transition(original.(*ReferencePolicy), desired.(*ReferencePolicy))
+		}
+	}
+	return r.base.Reconcile(namespace, referencePolicysToResources(desiredResources), transitionResources, opts)
+}
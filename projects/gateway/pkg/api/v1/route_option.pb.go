@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gateway/api/v1/route_option.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The **RouteOption** holds options which can be shared by many routes.
+//
+// Routes of Virtual Services and Route Tables use RouteOptions by referencing them in their `optionsConfigRefs`.
+// The options of the route itself take precedence over the options of the referenced RouteOptions.
+//
+// ```yaml
+// apiVersion: gateway.solo.io/v1
+// kind: RouteOption
+// metadata:
+//
+//	name: 'retries'
+//	namespace: 'default'
+//
+// spec:
+//
+//	options:
+//	  retries:
+//	    retryOn: '5xx'
+//	    numRetries: 3
+//
+// ```
+type RouteOption struct {
+	// The options which routes referencing this RouteOption use.
+	Options *v1.RouteOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	// Status indicates the validation status of this resource.
+	// Status is read-only by clients, and set by gloo during validation
+	Status core.Status `protobuf:"bytes,6,opt,name=status,proto3" json:"status" testdiff:"ignore"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RouteOption) Reset()         { *m = RouteOption{} }
+func (m *RouteOption) String() string { return proto.CompactTextString(m) }
+func (*RouteOption) ProtoMessage()    {}
+func (*RouteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaae0d91a72678eb, []int{0}
+}
+func (m *RouteOption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteOption.Unmarshal(m, b)
+}
+func (m *RouteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteOption.Marshal(b, m, deterministic)
+}
+func (m *RouteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteOption.Merge(m, src)
+}
+func (m *RouteOption) XXX_Size() int {
+	return xxx_messageInfo_RouteOption.Size(m)
+}
+func (m *RouteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteOption proto.InternalMessageInfo
+
+func (m *RouteOption) GetOptions() *v1.RouteOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *RouteOption) GetStatus() core.Status {
+	if m != nil {
+		return m.Status
+	}
+	return core.Status{}
+}
+
+func (m *RouteOption) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func init() {
+	proto.RegisterType((*RouteOption)(nil), "gateway.solo.io.RouteOption")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gateway/api/v1/route_option.proto", fileDescriptor_aaae0d91a72678eb)
+}
+
+var fileDescriptor_aaae0d91a72678eb = []byte{
+	// 321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcd, 0x4a, 0x03, 0x31,
+	0x10, 0x76, 0xb1, 0xb4, 0x92, 0x22, 0x62, 0x28, 0xb2, 0x14, 0x6d, 0xa5, 0x17, 0xbd, 0x98, 0xa0,
+	0xf5, 0x20, 0x05, 0x2f, 0x7b, 0x15, 0x11, 0xd6, 0x9b, 0x17, 0x49, 0xdb, 0x34, 0xc6, 0xfe, 0xcc,
+	0x92, 0x4c, 0xb5, 0x5e, 0x7d, 0x1a, 0x1f, 0xc1, 0x47, 0xf0, 0x29, 0x7a, 0x10, 0x5f, 0x40, 0xc1,
+	0xbb, 0x24, 0x9b, 0x2d, 0xb5, 0x20, 0x78, 0xcb, 0xcc, 0xf7, 0x33, 0xf9, 0x66, 0x48, 0xa2, 0x34,
+	0xde, 0x4d, 0xbb, 0xac, 0x07, 0x63, 0x6e, 0x61, 0x04, 0x47, 0x1a, 0xb8, 0x1a, 0x01, 0xf0, 0xcc,
+	0xc0, 0xbd, 0xec, 0xa1, 0xe5, 0x4a, 0xa0, 0x7c, 0x14, 0x4f, 0x5c, 0x64, 0x9a, 0x3f, 0x1c, 0x73,
+	0x03, 0x53, 0x94, 0xb7, 0x90, 0xa1, 0x86, 0x09, 0xcb, 0x0c, 0x20, 0xd0, 0xad, 0x40, 0x61, 0xce,
+	0x80, 0x69, 0xa8, 0xd7, 0x14, 0x28, 0xf0, 0x18, 0x77, 0xaf, 0x9c, 0x56, 0xa7, 0x72, 0x86, 0x79,
+	0x53, 0xce, 0x30, 0xf4, 0x1a, 0x7e, 0xe6, 0x50, 0x63, 0x61, 0x3f, 0x96, 0x28, 0xfa, 0x02, 0x45,
+	0xc0, 0x77, 0x57, 0x71, 0x8b, 0x02, 0xa7, 0xf6, 0x2f, 0x75, 0x51, 0x07, 0xfc, 0x60, 0x25, 0x89,
+	0xab, 0x02, 0x33, 0x0f, 0x10, 0x8c, 0x5a, 0x1f, 0x11, 0xa9, 0xa6, 0x2e, 0xd8, 0x95, 0x6f, 0xd3,
+	0x53, 0x52, 0x09, 0x84, 0x38, 0xda, 0x8f, 0x0e, 0xab, 0x27, 0x75, 0xe6, 0xc4, 0x45, 0x40, 0xb6,
+	0xc4, 0xb5, 0x69, 0x41, 0xa5, 0x17, 0xa4, 0x9c, 0x7f, 0x2f, 0x2e, 0x7b, 0x51, 0x8d, 0xf5, 0xc0,
+	0xc8, 0x85, 0xe8, 0xda, 0x63, 0xc9, 0xde, 0xeb, 0x77, 0x29, 0x7a, 0x9b, 0x37, 0xd7, 0xbe, 0xe6,
+	0xcd, 0x6d, 0x94, 0x16, 0xfb, 0x7a, 0x30, 0xe8, 0xb4, 0xb4, 0x9a, 0x80, 0x91, 0xad, 0x34, 0x58,
+	0xd0, 0x33, 0xb2, 0x51, 0xec, 0x22, 0xae, 0x78, 0xbb, 0x9d, 0xdf, 0x76, 0x97, 0x01, 0x4d, 0x4a,
+	0xce, 0x2c, 0x5d, 0xb0, 0x3b, 0xf1, 0xf3, 0x67, 0xa9, 0x46, 0xd6, 0x0d, 0x02, 0xdd, 0x5c, 0xbe,
+	0x96, 0x4d, 0xce, 0xdd, 0xe8, 0x97, 0xf7, 0x46, 0x74, 0xd3, 0xfe, 0xf7, 0xd9, 0xb3, 0xa1, 0x0a,
+	0x3b, 0xeb, 0x96, 0xfd, 0xb2, 0xda, 0x3f, 0x03, 0x00, 0xdc, 0xfd, 0x45, 0x65, 0x34, 0x02, 0x00,
+	0x00,
+}
+
+func (this *RouteOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteOption)
+	if !ok {
+		that2, ok := that.(RouteOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gateway/api/v1/virtual_host_option.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The **VirtualHostOption** holds options which can be shared by many virtual hosts.
+//
+// Virtual Services use VirtualHostOptions by referencing them in the `optionsConfigRefs` of their virtual host.
+// The options of the virtual host itself take precedence over the options of the referenced VirtualHostOptions.
+//
+// ```yaml
+// apiVersion: gateway.solo.io/v1
+// kind: VirtualHostOption
+// metadata:
+//
+//	name: 'cors'
+//	namespace: 'default'
+//
+// spec:
+//
+//	options:
+//	  cors:
+//	    allowOrigin:
+//	    - 'https://example.com'
+//
+// ```
+type VirtualHostOption struct {
+	// The options which virtual hosts referencing this VirtualHostOption use.
+	Options *v1.VirtualHostOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	// Status indicates the validation status of this resource.
+	// Status is read-only by clients, and set by gloo during validation
+	Status core.Status `protobuf:"bytes,6,opt,name=status,proto3" json:"status" testdiff:"ignore"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VirtualHostOption) Reset()         { *m = VirtualHostOption{} }
+func (m *VirtualHostOption) String() string { return proto.CompactTextString(m) }
+func (*VirtualHostOption) ProtoMessage()    {}
+func (*VirtualHostOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1faee37635f28798, []int{0}
+}
+func (m *VirtualHostOption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VirtualHostOption.Unmarshal(m, b)
+}
+func (m *VirtualHostOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VirtualHostOption.Marshal(b, m, deterministic)
+}
+func (m *VirtualHostOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VirtualHostOption.Merge(m, src)
+}
+func (m *VirtualHostOption) XXX_Size() int {
+	return xxx_messageInfo_VirtualHostOption.Size(m)
+}
+func (m *VirtualHostOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_VirtualHostOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VirtualHostOption proto.InternalMessageInfo
+
+func (m *VirtualHostOption) GetOptions() *v1.VirtualHostOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *VirtualHostOption) GetStatus() core.Status {
+	if m != nil {
+		return m.Status
+	}
+	return core.Status{}
+}
+
+func (m *VirtualHostOption) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func init() {
+	proto.RegisterType((*VirtualHostOption)(nil), "gateway.solo.io.VirtualHostOption")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gateway/api/v1/virtual_host_option.proto", fileDescriptor_1faee37635f28798)
+}
+
+var fileDescriptor_1faee37635f28798 = []byte{
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x4a, 0x03, 0x31,
+	0x10, 0x76, 0xb1, 0xb4, 0x12, 0x0f, 0xd2, 0x50, 0xa4, 0x54, 0x6d, 0x4b, 0x2f, 0x7a, 0x31, 0x41,
+	0x7b, 0x91, 0x82, 0x97, 0x9e, 0x14, 0x11, 0xa1, 0x82, 0x07, 0x2f, 0x25, 0x6d, 0xd3, 0x34, 0xf6,
+	0x67, 0x96, 0xcd, 0xec, 0x5a, 0xaf, 0x3e, 0x8d, 0x8f, 0xe0, 0x23, 0xf8, 0x14, 0x3d, 0xf8, 0x06,
+	0x0a, 0xe2, 0x55, 0x92, 0xcd, 0x16, 0xac, 0x0a, 0xde, 0x76, 0xe6, 0xfb, 0x99, 0xf9, 0x66, 0x43,
+	0xce, 0x95, 0xc6, 0x51, 0xdc, 0x63, 0x7d, 0x98, 0x72, 0x03, 0x13, 0x38, 0xd4, 0xc0, 0xd5, 0x04,
+	0x80, 0x87, 0x11, 0xdc, 0xc9, 0x3e, 0x1a, 0xae, 0x04, 0xca, 0x7b, 0xf1, 0xc0, 0x45, 0xa8, 0x79,
+	0x72, 0xc4, 0x13, 0x1d, 0x61, 0x2c, 0x26, 0xdd, 0x11, 0x18, 0xec, 0x42, 0x88, 0x1a, 0x66, 0x2c,
+	0x8c, 0x00, 0x81, 0x6e, 0x79, 0x26, 0xb3, 0x3e, 0x4c, 0x43, 0xa5, 0xa4, 0x40, 0x81, 0xc3, 0xb8,
+	0xfd, 0x4a, 0x69, 0x15, 0x2a, 0xe7, 0x98, 0x36, 0xe5, 0x1c, 0x7d, 0xaf, 0xea, 0x46, 0x8f, 0x35,
+	0x66, 0x53, 0xa6, 0x12, 0xc5, 0x40, 0xa0, 0xf0, 0xf8, 0xee, 0x2a, 0x6e, 0x50, 0x60, 0x6c, 0xfe,
+	0x52, 0x67, 0xb5, 0xc7, 0xf7, 0x57, 0x02, 0xd9, 0xca, 0x33, 0xd3, 0x00, 0xde, 0xa8, 0xf1, 0x19,
+	0x90, 0xe2, 0x4d, 0x9a, 0xef, 0x0c, 0x0c, 0x5e, 0x39, 0x90, 0xb6, 0x48, 0xc1, 0xd3, 0xca, 0x41,
+	0x3d, 0x38, 0xd8, 0x3c, 0xae, 0x33, 0x6b, 0x91, 0xc5, 0x64, 0x3f, 0x14, 0xa6, 0x93, 0x09, 0xe8,
+	0x05, 0xc9, 0xa7, 0xab, 0x96, 0xf3, 0x4e, 0x5a, 0x62, 0x7d, 0x88, 0xe4, 0x52, 0x7a, 0xed, 0xb0,
+	0xf6, 0xde, 0xf3, 0x47, 0x2e, 0x78, 0x59, 0xd4, 0xd6, 0xde, 0x17, 0xb5, 0x22, 0x4a, 0x83, 0x03,
+	0x3d, 0x1c, 0xb6, 0x1a, 0x5a, 0xcd, 0x20, 0x92, 0x8d, 0x8e, 0xb7, 0xa0, 0x27, 0x64, 0x23, 0xbb,
+	0x4b, 0xb9, 0xe0, 0xec, 0xb6, 0xbf, 0xdb, 0x5d, 0x7a, 0xb4, 0x9d, 0xb3, 0x66, 0x9d, 0x25, 0xbb,
+	0x55, 0x7b, 0x7c, 0xcb, 0xed, 0x90, 0xf5, 0x64, 0x04, 0xb4, 0xf4, 0xcb, 0x0f, 0x34, 0xed, 0x53,
+	0xbb, 0xc1, 0xd3, 0x6b, 0x35, 0xb8, 0x6d, 0xfe, 0xfb, 0x41, 0x84, 0x63, 0xe5, 0xcf, 0xd8, 0xcb,
+	0xbb, 0xfb, 0x35, 0xbf, 0x06, 0x00, 0x22, 0x78, 0x50, 0x24, 0x4e, 0x02, 0x00, 0x00,
+}
+
+func (this *VirtualHostOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VirtualHostOption)
+	if !ok {
+		that2, ok := that.(VirtualHostOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reconcile"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+// Option to copy anything from the original to the desired before writing. Return value of false means don't update
+type TransitionVirtualHostOptionFunc func(original, desired *VirtualHostOption) (bool, error)
+
+type VirtualHostOptionReconciler interface {
+	Reconcile(namespace string, desiredResources VirtualHostOptionList, transition TransitionVirtualHostOptionFunc, opts clients.ListOpts) error
+}
+
+func virtualHostOptionsToResources(list VirtualHostOptionList) resources.ResourceList {
+	var resourceList resources.ResourceList
+	for _, virtualHostOption := range list {
+		resourceList = append(resourceList, virtualHostOption)
+	}
+	return resourceList
+}
+
+func NewVirtualHostOptionReconciler(client VirtualHostOptionClient) VirtualHostOptionReconciler {
+	return &virtualHostOptionReconciler{
+		base: reconcile.NewReconciler(client.BaseClient()),
+	}
+}
+
+type virtualHostOptionReconciler struct {
+	base reconcile.Reconciler
+}
+
+func (r *virtualHostOptionReconciler) Reconcile(namespace string, desiredResources VirtualHostOptionList, transition TransitionVirtualHostOptionFunc, opts clients.ListOpts) error {
+	opts = opts.WithDefaults()
+	opts.Ctx = contextutils.WithLogger(opts.Ctx, "virtualHostOption_reconciler")
+	var transitionResources reconcile.TransitionResourcesFunc
+	if transition != nil {
+		transitionResources = func(original, desired resources.Resource) (bool, error) {
+			return transition(original.(*VirtualHostOption), desired.(*VirtualHostOption))
+		}
+	}
+	return r.base.Reconcile(namespace, virtualHostOptionsToResources(desiredResources), transitionResources, opts)
+}
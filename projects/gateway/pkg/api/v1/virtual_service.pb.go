@@ -6,8 +6,6 @@ package v1
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
@@ -15,6 +13,7 @@ import (
 	matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -28,28 +27,18 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// Route Table Selector expression operator, while the set-based syntax differs from Kubernetes (kubernetes: `key: !mylabel`, gloo: `key: mylabel, operator: "!"` | kubernetes: `key: mylabel`, gloo: `key: mylabel, operator: exists`), the functionality remains the same.
 type RouteTableSelector_Expression_Operator int32
 
 const (
-	// =
-	RouteTableSelector_Expression_Equals RouteTableSelector_Expression_Operator = 0
-	// ==
+	RouteTableSelector_Expression_Equals       RouteTableSelector_Expression_Operator = 0
 	RouteTableSelector_Expression_DoubleEquals RouteTableSelector_Expression_Operator = 1
-	// !=
-	RouteTableSelector_Expression_NotEquals RouteTableSelector_Expression_Operator = 2
-	// in
-	RouteTableSelector_Expression_In RouteTableSelector_Expression_Operator = 3
-	// notin
-	RouteTableSelector_Expression_NotIn RouteTableSelector_Expression_Operator = 4
-	// exists
-	RouteTableSelector_Expression_Exists RouteTableSelector_Expression_Operator = 5
-	// !
+	RouteTableSelector_Expression_NotEquals    RouteTableSelector_Expression_Operator = 2
+	RouteTableSelector_Expression_In           RouteTableSelector_Expression_Operator = 3
+	RouteTableSelector_Expression_NotIn        RouteTableSelector_Expression_Operator = 4
+	RouteTableSelector_Expression_Exists       RouteTableSelector_Expression_Operator = 5
 	RouteTableSelector_Expression_DoesNotExist RouteTableSelector_Expression_Operator = 6
-	// gt
-	RouteTableSelector_Expression_GreaterThan RouteTableSelector_Expression_Operator = 7
-	// lt
-	RouteTableSelector_Expression_LessThan RouteTableSelector_Expression_Operator = 8
+	RouteTableSelector_Expression_GreaterThan  RouteTableSelector_Expression_Operator = 7
+	RouteTableSelector_Expression_LessThan     RouteTableSelector_Expression_Operator = 8
 )
 
 var RouteTableSelector_Expression_Operator_name = map[int32]string{
@@ -81,11 +70,9 @@ func (x RouteTableSelector_Expression_Operator) String() string {
 }
 
 func (RouteTableSelector_Expression_Operator) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{4, 1, 0}
+	return fileDescriptor_93fa9472926a2049, []int{7, 1, 0}
 }
 
-//
-//
 // The **VirtualService** is the root routing object for the Gloo Gateway.
 // A virtual service describes the set of routes to match for a set of domains.
 //
@@ -107,21 +94,24 @@ func (RouteTableSelector_Expression_Operator) EnumDescriptor() ([]byte, []int) {
 // apiVersion: gateway.solo.io/v1
 // kind: VirtualService
 // metadata:
-//   name: 'http'
-//   namespace: 'usernamespace'
+//
+//	name: 'http'
+//	namespace: 'usernamespace'
+//
 // spec:
-//   virtualHost:
-//     domains:
-//     - '*.mydomain.com'
-//     - 'mydomain.com'
-//     routes:
-//     - matchers:
-//       - prefix: '/'
-//       # delegate all traffic to the `shared-routes` RouteTable
-//       delegateAction:
-//         ref:
-//           name: 'shared-routes'
-//           namespace: 'usernamespace'
+//
+//	virtualHost:
+//	  domains:
+//	  - '*.mydomain.com'
+//	  - 'mydomain.com'
+//	  routes:
+//	  - matchers:
+//	    - prefix: '/'
+//	    # delegate all traffic to the `shared-routes` RouteTable
+//	    delegateAction:
+//	      ref:
+//	        name: 'shared-routes'
+//	        namespace: 'usernamespace'
 //
 // ```
 //
@@ -130,25 +120,28 @@ func (RouteTableSelector_Expression_Operator) EnumDescriptor() ([]byte, []int) {
 // apiVersion: gateway.solo.io/v1
 // kind: VirtualService
 // metadata:
-//   name: 'https'
-//   namespace: 'usernamespace'
+//
+//	name: 'https'
+//	namespace: 'usernamespace'
+//
 // spec:
-//   virtualHost:
-//     domains:
-//     - '*.mydomain.com'
-//     - 'mydomain.com'
-//     routes:
-//     - matchers:
-//       - prefix: '/'
-//       # delegate all traffic to the `shared-routes` RouteTable
-//       delegateAction:
-//         ref:
-//           name: 'shared-routes'
-//           namespace: 'usernamespace'
-//   sslConfig:
-//     secretRef:
-//       name: gateway-tls
-//       namespace: gloo-system
+//
+//	virtualHost:
+//	  domains:
+//	  - '*.mydomain.com'
+//	  - 'mydomain.com'
+//	  routes:
+//	  - matchers:
+//	    - prefix: '/'
+//	    # delegate all traffic to the `shared-routes` RouteTable
+//	    delegateAction:
+//	      ref:
+//	        name: 'shared-routes'
+//	        namespace: 'usernamespace'
+//	sslConfig:
+//	  secretRef:
+//	    name: gateway-tls
+//	    namespace: gloo-system
 //
 // ```
 //
@@ -157,17 +150,21 @@ func (RouteTableSelector_Expression_Operator) EnumDescriptor() ([]byte, []int) {
 // apiVersion: gateway.solo.io/v1
 // kind: RouteTable
 // metadata:
-//   name: 'shared-routes'
-//   namespace: 'usernamespace'
+//
+//	name: 'shared-routes'
+//	namespace: 'usernamespace'
+//
 // spec:
-//   routes:
-//     - matchers:
-//       - prefix: '/some-route'
-//       routeAction:
-//         single:
-//           upstream:
-//             name: 'some-upstream'
-//      ...
+//
+//	routes:
+//	  - matchers:
+//	    - prefix: '/some-route'
+//	    routeAction:
+//	      single:
+//	        upstream:
+//	          name: 'some-upstream'
+//	   ...
+//
 // ```
 //
 // **Delegated Routes** are routes that use the `delegateAction` routing action. Delegated Routes obey the following
@@ -176,7 +173,6 @@ func (RouteTableSelector_Expression_Operator) EnumDescriptor() ([]byte, []int) {
 // - delegate routes must use `prefix` path matchers
 // - delegated routes cannot specify header, query, or methods portion of the normal route matcher.
 // - `routeOptions` configuration will be inherited from parent routes, but can be overridden by the child
-//
 type VirtualService struct {
 	// The VirtualHost contains the
 	// The list of HTTP routes define routing actions to be taken
@@ -259,16 +255,14 @@ func (m *VirtualService) GetMetadata() core.Metadata {
 	return core.Metadata{}
 }
 
+// Virtual Hosts serve an ordered list of routes for a set of domains.
 //
-//Virtual Hosts serve an ordered list of routes for a set of domains.
+// An HTTP request is first matched to a virtual host based on its host header, then to a route within the virtual host.
 //
-//An HTTP request is first matched to a virtual host based on its host header, then to a route within the virtual host.
-//
-//If a request is not matched to any virtual host or a route therein, the target proxy will reply with a 404.
-//
-//Unlike the [Gloo Virtual Host]({{< ref "/reference/api/github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto.sk.md" >}}/#virtualhost),
-//Gateway* Virtual Hosts can **delegate** their routes to `RouteTables`.
+// If a request is not matched to any virtual host or a route therein, the target proxy will reply with a 404.
 //
+// Unlike the [Gloo Virtual Host]({{< ref "/reference/api/github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto.sk.md" >}}/#virtualhost),
+// Gateway* Virtual Hosts can **delegate** their routes to `RouteTables`.
 type VirtualHost struct {
 	// The list of domains (i.e.: matching the `Host` header of a request) that belong to this virtual host.
 	// Note that the wildcard will not match the empty string. e.g. “*-bar.foo.com” will match “baz-bar.foo.com”
@@ -283,10 +277,12 @@ type VirtualHost struct {
 	Routes []*Route `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
 	// Virtual host options contain additional configuration to be applied to all traffic served by the Virtual Host.
 	// Some configuration here can be overridden by Route Options.
-	Options              *v1.VirtualHostOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Options *v1.VirtualHostOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// Reusable options from VirtualHostOption resources. The options above take precedence over the referenced ones.
+	OptionsConfigRefs    *DelegateOptionsRefs `protobuf:"bytes,5,opt,name=options_config_refs,json=optionsConfigRefs,proto3" json:"options_config_refs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *VirtualHost) Reset()         { *m = VirtualHost{} }
@@ -334,7 +330,13 @@ func (m *VirtualHost) GetOptions() *v1.VirtualHostOptions {
 	return nil
 }
 
-//
+func (m *VirtualHost) GetOptionsConfigRefs() *DelegateOptionsRefs {
+	if m != nil {
+		return m.OptionsConfigRefs
+	}
+	return nil
+}
+
 // A route specifies how to match a request and what action to take when the request is matched.
 //
 // When a request matches on a route, the route can perform one of the following actions:
@@ -365,10 +367,13 @@ type Route struct {
 	// RouteOption behavior will be inherited by delegated routes which do not specify their own `options`
 	Options *v1.RouteOptions `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
 	// The name provides a convenience for users to be able to refer to a route by name.
-	Name                 string   `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	// Reusable options from RouteOption resources. The options above take precedence over the referenced ones,
+	// and the options of the route, including the referenced ones, take precedence over the inherited options.
+	OptionsConfigRefs    *DelegateOptionsRefs `protobuf:"bytes,9,opt,name=options_config_refs,json=optionsConfigRefs,proto3" json:"options_config_refs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Route) Reset()         { *m = Route{} }
@@ -481,6 +486,13 @@ func (m *Route) GetName() string {
 	return ""
 }
 
+func (m *Route) GetOptionsConfigRefs() *DelegateOptionsRefs {
+	if m != nil {
+		return m.OptionsConfigRefs
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Route) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -491,6 +503,58 @@ func (*Route) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// References to resources holding reusable options: RouteOptions for routes and VirtualHostOptions for virtual hosts.
+// Referenced resources take precedence over selected resources, and earlier references take precedence over later ones.
+// Options set on the route or virtual host itself take precedence over all of them.
+type DelegateOptionsRefs struct {
+	// The resources to use. The namespace defaults to the namespace of the referencing resource.
+	DelegateOptions []*core.ResourceRef `protobuf:"bytes,1,rep,name=delegate_options,json=delegateOptions,proto3" json:"delegate_options,omitempty"`
+	// Also use the resources in the namespace of the referencing resource whose labels match these.
+	// Selected resources take precedence in the order of their names.
+	Selector             map[string]string `protobuf:"bytes,2,rep,name=selector,proto3" json:"selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DelegateOptionsRefs) Reset()         { *m = DelegateOptionsRefs{} }
+func (m *DelegateOptionsRefs) String() string { return proto.CompactTextString(m) }
+func (*DelegateOptionsRefs) ProtoMessage()    {}
+func (*DelegateOptionsRefs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93fa9472926a2049, []int{3}
+}
+func (m *DelegateOptionsRefs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegateOptionsRefs.Unmarshal(m, b)
+}
+func (m *DelegateOptionsRefs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegateOptionsRefs.Marshal(b, m, deterministic)
+}
+func (m *DelegateOptionsRefs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateOptionsRefs.Merge(m, src)
+}
+func (m *DelegateOptionsRefs) XXX_Size() int {
+	return xxx_messageInfo_DelegateOptionsRefs.Size(m)
+}
+func (m *DelegateOptionsRefs) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateOptionsRefs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateOptionsRefs proto.InternalMessageInfo
+
+func (m *DelegateOptionsRefs) GetDelegateOptions() []*core.ResourceRef {
+	if m != nil {
+		return m.DelegateOptions
+	}
+	return nil
+}
+
+func (m *DelegateOptionsRefs) GetSelector() map[string]string {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
 // DelegateActions are used to delegate routing decisions to Route Tables.
 type DelegateAction struct {
 	// The name of the Route Table to delegate to.
@@ -504,17 +568,21 @@ type DelegateAction struct {
 	// Types that are valid to be assigned to DelegationType:
 	//	*DelegateAction_Ref
 	//	*DelegateAction_Selector
-	DelegationType       isDelegateAction_DelegationType `protobuf_oneof:"delegation_type"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+	//	*DelegateAction_Weighted
+	DelegationType isDelegateAction_DelegationType `protobuf_oneof:"delegation_type"`
+	// Controls which options of this route the routes of the selected Route Tables inherit and may override.
+	// By default, they inherit all of them, and may override any of them.
+	OptionsInheritance   *DelegateOptionsInheritance `protobuf:"bytes,5,opt,name=options_inheritance,json=optionsInheritance,proto3" json:"options_inheritance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *DelegateAction) Reset()         { *m = DelegateAction{} }
 func (m *DelegateAction) String() string { return proto.CompactTextString(m) }
 func (*DelegateAction) ProtoMessage()    {}
 func (*DelegateAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{3}
+	return fileDescriptor_93fa9472926a2049, []int{4}
 }
 func (m *DelegateAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DelegateAction.Unmarshal(m, b)
@@ -545,9 +613,13 @@ type DelegateAction_Ref struct {
 type DelegateAction_Selector struct {
 	Selector *RouteTableSelector `protobuf:"bytes,4,opt,name=selector,proto3,oneof" json:"selector,omitempty"`
 }
+type DelegateAction_Weighted struct {
+	Weighted *WeightedRouteTables `protobuf:"bytes,6,opt,name=weighted,proto3,oneof" json:"weighted,omitempty"`
+}
 
 func (*DelegateAction_Ref) isDelegateAction_DelegationType()      {}
 func (*DelegateAction_Selector) isDelegateAction_DelegationType() {}
+func (*DelegateAction_Weighted) isDelegateAction_DelegationType() {}
 
 func (m *DelegateAction) GetDelegationType() isDelegateAction_DelegationType {
 	if m != nil {
@@ -586,12 +658,211 @@ func (m *DelegateAction) GetSelector() *RouteTableSelector {
 	return nil
 }
 
+func (m *DelegateAction) GetWeighted() *WeightedRouteTables {
+	if x, ok := m.GetDelegationType().(*DelegateAction_Weighted); ok {
+		return x.Weighted
+	}
+	return nil
+}
+
+func (m *DelegateAction) GetOptionsInheritance() *DelegateOptionsInheritance {
+	if m != nil {
+		return m.OptionsInheritance
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*DelegateAction) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*DelegateAction_Ref)(nil),
 		(*DelegateAction_Selector)(nil),
+		(*DelegateAction_Weighted)(nil),
+	}
+}
+
+// Splits the requests of a delegating route between several sets of Route Tables, in proportion to their weights.
+// The routes of each set only match its share of the requests, so all the sets should handle the same paths.
+// Routes that are delegated to by a weighted delegation may not delegate with weights themselves, nor match a
+// percentage of the requests.
+type WeightedRouteTables struct {
+	Destinations         []*WeightedRouteTables_Destination `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *WeightedRouteTables) Reset()         { *m = WeightedRouteTables{} }
+func (m *WeightedRouteTables) String() string { return proto.CompactTextString(m) }
+func (*WeightedRouteTables) ProtoMessage()    {}
+func (*WeightedRouteTables) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93fa9472926a2049, []int{5}
+}
+func (m *WeightedRouteTables) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WeightedRouteTables.Unmarshal(m, b)
+}
+func (m *WeightedRouteTables) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WeightedRouteTables.Marshal(b, m, deterministic)
+}
+func (m *WeightedRouteTables) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedRouteTables.Merge(m, src)
+}
+func (m *WeightedRouteTables) XXX_Size() int {
+	return xxx_messageInfo_WeightedRouteTables.Size(m)
+}
+func (m *WeightedRouteTables) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedRouteTables.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedRouteTables proto.InternalMessageInfo
+
+func (m *WeightedRouteTables) GetDestinations() []*WeightedRouteTables_Destination {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+// A set of Route Tables and its share of the requests.
+type WeightedRouteTables_Destination struct {
+	// Types that are valid to be assigned to DelegationType:
+	//	*WeightedRouteTables_Destination_Ref
+	//	*WeightedRouteTables_Destination_Selector
+	DelegationType isWeightedRouteTables_Destination_DelegationType `protobuf_oneof:"delegation_type"`
+	// The weight of the destination. It receives `weight / sum of the weights` of the requests.
+	Weight               uint32   `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WeightedRouteTables_Destination) Reset()         { *m = WeightedRouteTables_Destination{} }
+func (m *WeightedRouteTables_Destination) String() string { return proto.CompactTextString(m) }
+func (*WeightedRouteTables_Destination) ProtoMessage()    {}
+func (*WeightedRouteTables_Destination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93fa9472926a2049, []int{5, 0}
+}
+func (m *WeightedRouteTables_Destination) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WeightedRouteTables_Destination.Unmarshal(m, b)
+}
+func (m *WeightedRouteTables_Destination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WeightedRouteTables_Destination.Marshal(b, m, deterministic)
+}
+func (m *WeightedRouteTables_Destination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedRouteTables_Destination.Merge(m, src)
+}
+func (m *WeightedRouteTables_Destination) XXX_Size() int {
+	return xxx_messageInfo_WeightedRouteTables_Destination.Size(m)
+}
+func (m *WeightedRouteTables_Destination) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedRouteTables_Destination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedRouteTables_Destination proto.InternalMessageInfo
+
+type isWeightedRouteTables_Destination_DelegationType interface {
+	isWeightedRouteTables_Destination_DelegationType()
+	Equal(interface{}) bool
+}
+
+type WeightedRouteTables_Destination_Ref struct {
+	Ref *core.ResourceRef `protobuf:"bytes,1,opt,name=ref,proto3,oneof" json:"ref,omitempty"`
+}
+type WeightedRouteTables_Destination_Selector struct {
+	Selector *RouteTableSelector `protobuf:"bytes,2,opt,name=selector,proto3,oneof" json:"selector,omitempty"`
+}
+
+func (*WeightedRouteTables_Destination_Ref) isWeightedRouteTables_Destination_DelegationType()      {}
+func (*WeightedRouteTables_Destination_Selector) isWeightedRouteTables_Destination_DelegationType() {}
+
+func (m *WeightedRouteTables_Destination) GetDelegationType() isWeightedRouteTables_Destination_DelegationType {
+	if m != nil {
+		return m.DelegationType
+	}
+	return nil
+}
+
+func (m *WeightedRouteTables_Destination) GetRef() *core.ResourceRef {
+	if x, ok := m.GetDelegationType().(*WeightedRouteTables_Destination_Ref); ok {
+		return x.Ref
+	}
+	return nil
+}
+
+func (m *WeightedRouteTables_Destination) GetSelector() *RouteTableSelector {
+	if x, ok := m.GetDelegationType().(*WeightedRouteTables_Destination_Selector); ok {
+		return x.Selector
+	}
+	return nil
+}
+
+func (m *WeightedRouteTables_Destination) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WeightedRouteTables_Destination) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WeightedRouteTables_Destination_Ref)(nil),
+		(*WeightedRouteTables_Destination_Selector)(nil),
+	}
+}
+
+// Declares which options of a delegating route its child routes inherit, and which of them they may override.
+// Options are named after the fields of the route options, for example `extauth` or `headerManipulation`. The
+// options of a oneof, such as `hostRewrite` and `autoHostRewrite`, are a single option.
+type DelegateOptionsInheritance struct {
+	// The options the child routes inherit. If empty, they inherit all of them.
+	Inherited []string `protobuf:"bytes,1,rep,name=inherited,proto3" json:"inherited,omitempty"`
+	// The options the child routes inherit but may not override, for example to enforce an authentication policy.
+	// Locked options are inherited even if they are not in `inherited`, and remain locked for the routes of nested
+	// Route Tables. A child route that sets a locked option to a different value is rejected, and the error is
+	// reported on its Route Table.
+	Locked               []string `protobuf:"bytes,2,rep,name=locked,proto3" json:"locked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelegateOptionsInheritance) Reset()         { *m = DelegateOptionsInheritance{} }
+func (m *DelegateOptionsInheritance) String() string { return proto.CompactTextString(m) }
+func (*DelegateOptionsInheritance) ProtoMessage()    {}
+func (*DelegateOptionsInheritance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93fa9472926a2049, []int{6}
+}
+func (m *DelegateOptionsInheritance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegateOptionsInheritance.Unmarshal(m, b)
+}
+func (m *DelegateOptionsInheritance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegateOptionsInheritance.Marshal(b, m, deterministic)
+}
+func (m *DelegateOptionsInheritance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateOptionsInheritance.Merge(m, src)
+}
+func (m *DelegateOptionsInheritance) XXX_Size() int {
+	return xxx_messageInfo_DelegateOptionsInheritance.Size(m)
+}
+func (m *DelegateOptionsInheritance) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateOptionsInheritance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateOptionsInheritance proto.InternalMessageInfo
+
+func (m *DelegateOptionsInheritance) GetInherited() []string {
+	if m != nil {
+		return m.Inherited
+	}
+	return nil
+}
+
+func (m *DelegateOptionsInheritance) GetLocked() []string {
+	if m != nil {
+		return m.Locked
 	}
+	return nil
 }
 
 // Select route tables for delegation by namespace, labels, or both.
@@ -614,7 +885,7 @@ func (m *RouteTableSelector) Reset()         { *m = RouteTableSelector{} }
 func (m *RouteTableSelector) String() string { return proto.CompactTextString(m) }
 func (*RouteTableSelector) ProtoMessage()    {}
 func (*RouteTableSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{4}
+	return fileDescriptor_93fa9472926a2049, []int{7}
 }
 func (m *RouteTableSelector) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteTableSelector.Unmarshal(m, b)
@@ -656,10 +927,7 @@ func (m *RouteTableSelector) GetExpressions() []*RouteTableSelector_Expression {
 }
 
 type RouteTableSelector_Expression struct {
-	// Kubernetes label key, must conform to Kubernetes syntax requirements
-	// https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#syntax-and-character-set
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// The operator can only be in, notin, =, ==, !=, exists, ! (DoesNotExist), gt (GreaterThan), lt (LessThan).
+	Key                  string                                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Operator             RouteTableSelector_Expression_Operator `protobuf:"varint,2,opt,name=operator,proto3,enum=gateway.solo.io.RouteTableSelector_Expression_Operator" json:"operator,omitempty"`
 	Values               []string                               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
@@ -671,7 +939,7 @@ func (m *RouteTableSelector_Expression) Reset()         { *m = RouteTableSelecto
 func (m *RouteTableSelector_Expression) String() string { return proto.CompactTextString(m) }
 func (*RouteTableSelector_Expression) ProtoMessage()    {}
 func (*RouteTableSelector_Expression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{4, 1}
+	return fileDescriptor_93fa9472926a2049, []int{7, 1}
 }
 func (m *RouteTableSelector_Expression) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteTableSelector_Expression.Unmarshal(m, b)
@@ -717,7 +985,12 @@ func init() {
 	proto.RegisterType((*VirtualService)(nil), "gateway.solo.io.VirtualService")
 	proto.RegisterType((*VirtualHost)(nil), "gateway.solo.io.VirtualHost")
 	proto.RegisterType((*Route)(nil), "gateway.solo.io.Route")
+	proto.RegisterType((*DelegateOptionsRefs)(nil), "gateway.solo.io.DelegateOptionsRefs")
+	proto.RegisterMapType((map[string]string)(nil), "gateway.solo.io.DelegateOptionsRefs.SelectorEntry")
 	proto.RegisterType((*DelegateAction)(nil), "gateway.solo.io.DelegateAction")
+	proto.RegisterType((*WeightedRouteTables)(nil), "gateway.solo.io.WeightedRouteTables")
+	proto.RegisterType((*WeightedRouteTables_Destination)(nil), "gateway.solo.io.WeightedRouteTables.Destination")
+	proto.RegisterType((*DelegateOptionsInheritance)(nil), "gateway.solo.io.DelegateOptionsInheritance")
 	proto.RegisterType((*RouteTableSelector)(nil), "gateway.solo.io.RouteTableSelector")
 	proto.RegisterMapType((map[string]string)(nil), "gateway.solo.io.RouteTableSelector.LabelsEntry")
 	proto.RegisterType((*RouteTableSelector_Expression)(nil), "gateway.solo.io.RouteTableSelector.Expression")
@@ -728,72 +1001,85 @@ func init() {
 }
 
 var fileDescriptor_93fa9472926a2049 = []byte{
-	// 1034 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
-	0x14, 0x35, 0x25, 0x9a, 0x96, 0xae, 0x5c, 0x5b, 0x99, 0x1a, 0x2e, 0x63, 0xa4, 0xb6, 0x20, 0xa3,
-	0x88, 0x37, 0x21, 0x51, 0xa7, 0x48, 0x53, 0x03, 0x6d, 0x10, 0xd5, 0x86, 0x9d, 0x36, 0x76, 0x8a,
-	0x71, 0x90, 0x45, 0x36, 0xc2, 0x88, 0xba, 0x92, 0x59, 0x53, 0x1c, 0x76, 0x66, 0xa4, 0x48, 0xdb,
-	0x6c, 0xfa, 0x01, 0x45, 0x77, 0x5d, 0x75, 0xd5, 0x4f, 0xe8, 0x27, 0x74, 0xd7, 0x75, 0x37, 0x59,
-	0xf4, 0x0f, 0x52, 0xa0, 0xfb, 0x82, 0xc3, 0x21, 0xf5, 0xb0, 0x0d, 0xb8, 0x2b, 0xcf, 0x9d, 0x7b,
-	0xce, 0xe1, 0xe1, 0x7d, 0x98, 0x82, 0xa3, 0x7e, 0xa8, 0x2e, 0x86, 0x1d, 0x2f, 0xe0, 0x03, 0x5f,
-	0xf2, 0x88, 0x3f, 0x08, 0xb9, 0xdf, 0x8f, 0x38, 0xf7, 0x13, 0xc1, 0xbf, 0xc7, 0x40, 0x49, 0xbf,
-	0xcf, 0x14, 0xbe, 0x61, 0x13, 0x9f, 0x25, 0xa1, 0x3f, 0xfa, 0xd4, 0x1f, 0x85, 0x42, 0x0d, 0x59,
-	0xd4, 0x96, 0x28, 0x46, 0x61, 0x80, 0x5e, 0x22, 0xb8, 0xe2, 0x64, 0xdd, 0xa0, 0xbc, 0x54, 0xc3,
-	0x0b, 0xf9, 0xd6, 0x46, 0x9f, 0xf7, 0xb9, 0xce, 0xf9, 0xe9, 0x29, 0x83, 0x6d, 0x11, 0x1c, 0xab,
-	0xec, 0x12, 0xc7, 0xca, 0xdc, 0x6d, 0xf7, 0x39, 0xef, 0x47, 0xe8, 0xeb, 0xa8, 0x33, 0xec, 0xf9,
-	0x6f, 0x04, 0x4b, 0x12, 0x14, 0x32, 0xcf, 0x6b, 0x5b, 0x97, 0xa1, 0xca, 0x1d, 0x0c, 0x50, 0xb1,
-	0x2e, 0x53, 0xcc, 0xe4, 0xef, 0x2d, 0xe6, 0xa5, 0x62, 0x6a, 0x98, 0xb3, 0xef, 0x2e, 0x66, 0x05,
-	0xf6, 0x6e, 0x12, 0xce, 0x63, 0x93, 0xdf, 0x5d, 0xa8, 0x43, 0x1a, 0xe5, 0x48, 0x19, 0x19, 0xd0,
-	0x27, 0x37, 0x83, 0x12, 0xc1, 0xc7, 0x13, 0x03, 0xbb, 0x7f, 0x33, 0x8c, 0x27, 0x2a, 0xe4, 0x71,
-	0xee, 0xf7, 0xd1, 0xcd, 0xc0, 0x80, 0x0b, 0xf4, 0x07, 0x4c, 0x05, 0x17, 0x28, 0x64, 0x71, 0xc8,
-	0x78, 0xcd, 0xbf, 0x4a, 0xb0, 0xf6, 0x2a, 0x6b, 0xcd, 0x79, 0xd6, 0x19, 0xf2, 0x04, 0x56, 0xf3,
-	0x66, 0x5d, 0x70, 0xa9, 0x5c, 0xab, 0x61, 0xed, 0xd5, 0xf6, 0xef, 0x79, 0x0b, 0xad, 0xf2, 0x0c,
-	0xed, 0x84, 0x4b, 0x45, 0x6b, 0xa3, 0x69, 0x40, 0x1e, 0x01, 0x48, 0x19, 0xb5, 0x03, 0x1e, 0xf7,
-	0xc2, 0xbe, 0x5b, 0xd2, 0xf4, 0x8f, 0xbc, 0xd4, 0x52, 0xc1, 0x3d, 0x97, 0xd1, 0xd7, 0x3a, 0x4d,
-	0xab, 0x32, 0x3f, 0x92, 0xfb, 0xb0, 0xda, 0x0d, 0x65, 0x12, 0xb1, 0x49, 0x3b, 0x66, 0x03, 0x74,
-	0xcb, 0x0d, 0x6b, 0xaf, 0xda, 0xb2, 0x7f, 0xff, 0xd7, 0xb6, 0x68, 0xcd, 0x64, 0xce, 0xd8, 0x00,
-	0xc9, 0xb7, 0xe0, 0x64, 0xcd, 0x72, 0x1d, 0x2d, 0xbe, 0xe1, 0xa5, 0xef, 0x38, 0x15, 0xd7, 0xb9,
-	0xd6, 0xc7, 0x29, 0xf1, 0x8f, 0x77, 0x3b, 0x4b, 0xff, 0xbc, 0xdb, 0xb9, 0xa3, 0x50, 0xaa, 0x6e,
-	0xd8, 0xeb, 0x1d, 0x34, 0xc3, 0x7e, 0xcc, 0x05, 0x36, 0xa9, 0x91, 0x20, 0x8f, 0xa1, 0x92, 0x4f,
-	0x86, 0xbb, 0xa2, 0xe5, 0x36, 0xe7, 0xe5, 0x4e, 0x4d, 0xb6, 0x65, 0xa7, 0x62, 0xb4, 0x40, 0x1f,
-	0x6c, 0xbf, 0x7d, 0x6f, 0xdb, 0x50, 0x1a, 0xc9, 0xb7, 0xef, 0x6d, 0x42, 0xea, 0x0b, 0x13, 0x2e,
-	0x9b, 0x3f, 0x59, 0x50, 0x9b, 0x29, 0x12, 0x71, 0x61, 0xa5, 0xcb, 0x07, 0x2c, 0x8c, 0xa5, 0x5b,
-	0x6a, 0x94, 0xf7, 0xaa, 0x34, 0x0f, 0x89, 0x07, 0x8e, 0xe0, 0x43, 0x85, 0xd2, 0x2d, 0x37, 0xca,
-	0xda, 0xc1, 0x62, 0xb1, 0x69, 0x9a, 0xa6, 0x06, 0x45, 0x0e, 0x60, 0xc5, 0xb4, 0xdf, 0xb5, 0xb5,
-	0xe5, 0xc6, 0x7c, 0x79, 0x67, 0x9e, 0xfa, 0x22, 0xc3, 0xd1, 0x9c, 0xd0, 0xfc, 0xc5, 0x86, 0x65,
-	0xad, 0x46, 0x9e, 0x40, 0x25, 0x9f, 0x06, 0xd7, 0xd2, 0xcf, 0xdd, 0xf5, 0x8a, 0xf1, 0xd0, 0x25,
-	0x98, 0x13, 0x3d, 0xcd, 0x52, 0xb4, 0x20, 0x91, 0x53, 0xd8, 0x08, 0xe3, 0x0b, 0x14, 0xa1, 0x62,
-	0x9d, 0x08, 0xdb, 0x85, 0x58, 0x45, 0x7b, 0xda, 0xf2, 0xb2, 0x0d, 0xf5, 0xf2, 0x0d, 0xf5, 0x5a,
-	0x9c, 0x47, 0xaf, 0x58, 0x34, 0x44, 0xfa, 0xe1, 0x0c, 0xef, 0x34, 0x97, 0xfb, 0x0a, 0x56, 0xf5,
-	0xfb, 0xb5, 0x59, 0x90, 0x5a, 0x35, 0x93, 0x73, 0x77, 0xde, 0x85, 0xb6, 0xfe, 0x54, 0x03, 0x4e,
-	0x96, 0x68, 0x4d, 0x4c, 0x43, 0x72, 0x0c, 0xeb, 0x02, 0xbb, 0xa1, 0xc0, 0x40, 0xe5, 0x12, 0xe5,
-	0x7c, 0x76, 0xe7, 0x24, 0x0c, 0xa8, 0x50, 0x59, 0x13, 0x73, 0x37, 0xe4, 0x35, 0x6c, 0x1a, 0x19,
-	0x81, 0x32, 0xe1, 0xb1, 0x2c, 0x2c, 0x65, 0xd5, 0x6e, 0xce, 0xeb, 0x1d, 0x6a, 0x2c, 0x35, 0xd0,
-	0x42, 0x75, 0xa3, 0x7b, 0xcd, 0x3d, 0xf9, 0x06, 0xd6, 0xbb, 0x18, 0x61, 0xda, 0xdf, 0x5c, 0x74,
-	0x59, 0x8b, 0xee, 0x5c, 0xe9, 0xf9, 0xa1, 0xc1, 0x4d, 0x7d, 0x76, 0xe7, 0x6e, 0xc8, 0x67, 0xd3,
-	0x31, 0x70, 0xf2, 0x92, 0x5f, 0xa9, 0xd5, 0xe2, 0x00, 0x10, 0x02, 0xb6, 0x5e, 0xaf, 0x74, 0xd8,
-	0xab, 0x54, 0x9f, 0x5b, 0x15, 0x70, 0x32, 0x33, 0xcd, 0x3f, 0x2d, 0x58, 0x9b, 0x7f, 0x30, 0xd9,
-	0x34, 0x04, 0x4b, 0xef, 0x63, 0xc9, 0xb5, 0x32, 0x12, 0x69, 0x40, 0x35, 0xfd, 0x2b, 0x13, 0x16,
-	0xa0, 0x6e, 0x56, 0x96, 0x9c, 0x5e, 0x92, 0x07, 0x50, 0x16, 0xd8, 0x33, 0x5d, 0xb8, 0x3b, 0xbf,
-	0x56, 0x14, 0x25, 0x1f, 0x8a, 0x00, 0x29, 0xf6, 0x4e, 0x96, 0x68, 0x8a, 0x23, 0x4f, 0xa1, 0x22,
-	0x31, 0xc2, 0x40, 0x71, 0x61, 0x2a, 0xbd, 0x7b, 0xfd, 0x22, 0xbc, 0x4c, 0xe7, 0xe6, 0xdc, 0x40,
-	0x4f, 0x96, 0x68, 0x41, 0x6b, 0xdd, 0x29, 0xca, 0x1b, 0xf2, 0xb8, 0xad, 0x26, 0x09, 0x36, 0x7f,
-	0xb5, 0x81, 0x5c, 0x65, 0x91, 0x6d, 0x80, 0xc2, 0x68, 0x36, 0xff, 0x55, 0x3a, 0x73, 0x43, 0x8e,
-	0xc1, 0x89, 0x58, 0x07, 0xa3, 0x6c, 0x59, 0x6b, 0xfb, 0xfe, 0x2d, 0xac, 0x78, 0xcf, 0x35, 0xe3,
-	0x28, 0x56, 0x62, 0x42, 0x0d, 0x9d, 0x7c, 0x07, 0x35, 0x1c, 0x27, 0x02, 0xa5, 0xd4, 0x9d, 0xca,
-	0x36, 0xdc, 0xbb, 0x8d, 0xda, 0x51, 0x41, 0xa3, 0xb3, 0x12, 0x5b, 0x5f, 0x40, 0x6d, 0xe6, 0x41,
-	0xa4, 0x0e, 0xe5, 0x4b, 0x9c, 0x64, 0xed, 0xa1, 0xe9, 0x91, 0x6c, 0xc0, 0xf2, 0x28, 0xdd, 0xb3,
-	0xac, 0x2b, 0x34, 0x0b, 0x0e, 0x4a, 0x8f, 0xad, 0xad, 0x9f, 0x4b, 0x00, 0x53, 0xd9, 0x6b, 0xa8,
-	0xe7, 0x50, 0xe1, 0x09, 0x0a, 0x96, 0xf6, 0x20, 0x65, 0xaf, 0xed, 0x7f, 0xfe, 0xff, 0xac, 0x7a,
-	0x2f, 0x0c, 0x9d, 0x16, 0x42, 0x64, 0x13, 0x1c, 0x6d, 0x21, 0x7b, 0xfb, 0x2a, 0x35, 0x51, 0xf3,
-	0x47, 0x0b, 0x2a, 0x39, 0x9c, 0x00, 0x38, 0x47, 0x3f, 0x0c, 0x59, 0x24, 0xeb, 0x4b, 0xa4, 0x0e,
-	0xab, 0x87, 0x7c, 0xd8, 0x89, 0xd0, 0xdc, 0x58, 0xe4, 0x03, 0xa8, 0x9e, 0x71, 0x65, 0xc2, 0x12,
-	0x71, 0xa0, 0xf4, 0x2c, 0xae, 0x97, 0x49, 0x15, 0x96, 0xcf, 0xb8, 0x7a, 0x16, 0xd7, 0x6d, 0xcd,
-	0x1f, 0x87, 0x52, 0xc9, 0xfa, 0x72, 0xc6, 0x47, 0x99, 0x32, 0xd2, 0xab, 0xba, 0x43, 0xd6, 0xa1,
-	0x76, 0x2c, 0x90, 0x29, 0x14, 0x2f, 0x2f, 0x58, 0x5c, 0x5f, 0x21, 0xab, 0x50, 0x79, 0x8e, 0x52,
-	0xea, 0xa8, 0xd2, 0xfa, 0x32, 0xfd, 0x58, 0xfc, 0xf6, 0xf7, 0xb6, 0xf5, 0xfa, 0xe1, 0xad, 0x7f,
-	0xd9, 0x24, 0x97, 0x7d, 0xf3, 0x8d, 0xed, 0x38, 0xfa, 0x7f, 0xdc, 0xc3, 0xff, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xc8, 0xef, 0x17, 0xef, 0x17, 0x09, 0x00, 0x00,
+	// 1239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x8e, 0xe3, 0x7d, 0x4e, 0x13, 0x77, 0x12, 0x85, 0xad, 0x55, 0xda, 0xc8, 0x05,
+	0xb5, 0x12, 0xea, 0x1a, 0x52, 0x54, 0x4a, 0x10, 0x54, 0x35, 0x89, 0x9a, 0x42, 0x93, 0xa2, 0x49,
+	0x54, 0xa4, 0x0a, 0xc9, 0xda, 0xec, 0x3e, 0x3b, 0x4b, 0x36, 0x3b, 0xcb, 0xcc, 0x38, 0x4d, 0xae,
+	0x5c, 0xf8, 0x04, 0x48, 0x9c, 0x39, 0x21, 0x3e, 0x01, 0x1f, 0x81, 0x2f, 0xc0, 0xb5, 0x07, 0x4e,
+	0x70, 0x6c, 0x25, 0xee, 0x68, 0x67, 0x67, 0x76, 0xbd, 0x89, 0x2d, 0xd2, 0x72, 0xca, 0xbc, 0x3f,
+	0xbf, 0xdf, 0xbc, 0x79, 0xbf, 0xf7, 0x6c, 0x07, 0x36, 0x87, 0xa1, 0x3c, 0x18, 0xed, 0xbb, 0x3e,
+	0x3b, 0xea, 0x0a, 0x16, 0xb1, 0xdb, 0x21, 0xeb, 0x0e, 0x23, 0xc6, 0xba, 0x09, 0x67, 0xdf, 0xa2,
+	0x2f, 0x45, 0x77, 0xe8, 0x49, 0x7c, 0xee, 0x9d, 0x76, 0xbd, 0x24, 0xec, 0x1e, 0x7f, 0xd0, 0x3d,
+	0x0e, 0xb9, 0x1c, 0x79, 0x51, 0x5f, 0x20, 0x3f, 0x0e, 0x7d, 0x74, 0x13, 0xce, 0x24, 0x23, 0x8b,
+	0x3a, 0xcb, 0x4d, 0x39, 0xdc, 0x90, 0xb5, 0x97, 0x87, 0x6c, 0xc8, 0x54, 0xac, 0x9b, 0x9e, 0xb2,
+	0xb4, 0x36, 0xc1, 0x13, 0x99, 0x39, 0xf1, 0x44, 0x6a, 0xdf, 0xb5, 0x21, 0x63, 0xc3, 0x08, 0xbb,
+	0xca, 0xda, 0x1f, 0x0d, 0xba, 0xcf, 0xb9, 0x97, 0x24, 0xc8, 0x85, 0x89, 0xab, 0xb2, 0x0e, 0x43,
+	0x69, 0x2a, 0x38, 0x42, 0xe9, 0x05, 0x9e, 0xf4, 0x74, 0xfc, 0xea, 0xd9, 0xb8, 0x90, 0x9e, 0x1c,
+	0x19, 0xf4, 0x95, 0xb3, 0x51, 0x8e, 0x83, 0x69, 0xc4, 0xc6, 0xd6, 0xf1, 0x1b, 0x67, 0xfa, 0x90,
+	0x5a, 0x26, 0x53, 0x44, 0x3a, 0xe9, 0xdd, 0xe9, 0x49, 0x09, 0x67, 0x27, 0xa7, 0x3a, 0xed, 0xe6,
+	0xf4, 0x34, 0x96, 0xc8, 0x90, 0xc5, 0xa6, 0xde, 0xbb, 0xd3, 0x13, 0x7d, 0xc6, 0xb1, 0x7b, 0xe4,
+	0x49, 0xff, 0x00, 0xb9, 0xc8, 0x0f, 0x19, 0xae, 0xf3, 0x47, 0x05, 0x16, 0x9e, 0x66, 0xd2, 0xec,
+	0x66, 0xca, 0x90, 0xfb, 0x30, 0x6f, 0xc4, 0x3a, 0x60, 0x42, 0x3a, 0xd6, 0xaa, 0x75, 0xab, 0xb9,
+	0x76, 0xd5, 0x3d, 0x23, 0x95, 0xab, 0x61, 0x5b, 0x4c, 0x48, 0xda, 0x3c, 0x2e, 0x0c, 0x72, 0x17,
+	0x40, 0x88, 0xa8, 0xef, 0xb3, 0x78, 0x10, 0x0e, 0x9d, 0x8a, 0x82, 0xbf, 0xe5, 0xa6, 0x25, 0xe5,
+	0xd8, 0x5d, 0x11, 0x7d, 0xae, 0xc2, 0xd4, 0x16, 0xe6, 0x48, 0x6e, 0xc2, 0x7c, 0x10, 0x8a, 0x24,
+	0xf2, 0x4e, 0xfb, 0xb1, 0x77, 0x84, 0x4e, 0x75, 0xd5, 0xba, 0x65, 0xf7, 0x6a, 0xbf, 0xfd, 0x53,
+	0xb3, 0x68, 0x53, 0x47, 0x76, 0xbc, 0x23, 0x24, 0x5f, 0x42, 0x3d, 0x13, 0xcb, 0xa9, 0x2b, 0xf2,
+	0x65, 0x37, 0x7d, 0x63, 0x41, 0xae, 0x62, 0xbd, 0xb7, 0x53, 0xe0, 0xef, 0x2f, 0xae, 0xcf, 0xbc,
+	0x7a, 0x71, 0xfd, 0xb2, 0x44, 0x21, 0x83, 0x70, 0x30, 0x58, 0xef, 0x84, 0xc3, 0x98, 0x71, 0xec,
+	0x50, 0x4d, 0x41, 0xee, 0x41, 0xc3, 0x4c, 0x86, 0x33, 0xa7, 0xe8, 0x56, 0xca, 0x74, 0xdb, 0x3a,
+	0xda, 0xab, 0xa5, 0x64, 0x34, 0xcf, 0x5e, 0x6f, 0x7f, 0xff, 0xb2, 0xb6, 0x02, 0x95, 0x63, 0x41,
+	0x5a, 0x67, 0xa6, 0x5b, 0x74, 0xfe, 0xb6, 0xa0, 0x39, 0xd6, 0x20, 0xe2, 0xc0, 0x5c, 0xc0, 0x8e,
+	0xbc, 0x30, 0x16, 0x4e, 0x65, 0xb5, 0x7a, 0xcb, 0xa6, 0xc6, 0x24, 0x2e, 0xd4, 0x39, 0x1b, 0x49,
+	0x14, 0x4e, 0x75, 0xb5, 0xaa, 0x6e, 0x3f, 0xdb, 0x68, 0x9a, 0x86, 0xa9, 0xce, 0x22, 0xeb, 0x30,
+	0xa7, 0xa5, 0x77, 0x6a, 0xaa, 0xdc, 0xd5, 0x72, 0x6b, 0xc7, 0x6e, 0x7d, 0x92, 0xe5, 0x51, 0x03,
+	0x20, 0x7b, 0xb0, 0xa4, 0x8f, 0x5a, 0x9d, 0x3e, 0xc7, 0x81, 0x70, 0x66, 0x15, 0xcf, 0x3b, 0xe7,
+	0x2e, 0xde, 0xc0, 0x08, 0x53, 0x9f, 0xe1, 0xc1, 0x81, 0xa0, 0x97, 0x35, 0x81, 0x96, 0x0f, 0x07,
+	0xa2, 0xf3, 0xaa, 0x06, 0xb3, 0xaa, 0x46, 0x72, 0x1f, 0x1a, 0x66, 0xbe, 0x1c, 0x4b, 0xbd, 0xe6,
+	0x86, 0x6b, 0x1c, 0x59, 0x53, 0x4b, 0xa5, 0x6e, 0x67, 0x21, 0x9a, 0x83, 0xc8, 0x36, 0x2c, 0x87,
+	0xf1, 0x01, 0xf2, 0x50, 0x7a, 0xfb, 0x11, 0xf6, 0x73, 0xb2, 0x86, 0xaa, 0xb0, 0xed, 0x66, 0x3b,
+	0xef, 0x9a, 0x9d, 0x77, 0x7b, 0x8c, 0x45, 0x4f, 0xbd, 0x68, 0x84, 0x74, 0x69, 0x0c, 0xb7, 0x6d,
+	0xe8, 0x3e, 0x83, 0x79, 0xd5, 0xb5, 0xbe, 0xe7, 0xa7, 0x45, 0xeb, 0x59, 0xbc, 0x52, 0xae, 0x42,
+	0x95, 0xfe, 0x40, 0x25, 0x6c, 0xcd, 0xd0, 0x26, 0x2f, 0x4c, 0xf2, 0x10, 0x16, 0x39, 0x06, 0x21,
+	0x47, 0x5f, 0x1a, 0x8a, 0xaa, 0xd9, 0x86, 0x12, 0x85, 0x4e, 0xca, 0x59, 0x16, 0x78, 0xc9, 0x43,
+	0x9e, 0xc1, 0x8a, 0xa6, 0xe1, 0x28, 0x12, 0x16, 0x8b, 0xbc, 0xa4, 0x4c, 0xc3, 0x4e, 0x99, 0x6f,
+	0x43, 0xe5, 0x52, 0x9d, 0x9a, 0xb3, 0x2e, 0x07, 0x13, 0xfc, 0xe4, 0x0b, 0x58, 0x0c, 0xb4, 0x50,
+	0x86, 0x34, 0x13, 0xf4, 0xfa, 0x54, 0x41, 0x8b, 0x3a, 0x83, 0x92, 0x87, 0x7c, 0x58, 0x0c, 0x57,
+	0xdd, 0xb4, 0xfc, 0x5c, 0xaf, 0xce, 0x8d, 0x15, 0x81, 0x9a, 0x5a, 0xd8, 0x74, 0x7d, 0x6c, 0xaa,
+	0xce, 0xd3, 0x46, 0xcd, 0xfe, 0x5f, 0xa3, 0xd6, 0x6b, 0x40, 0x3d, 0x7b, 0x62, 0xe7, 0x2f, 0x0b,
+	0x96, 0x26, 0x80, 0xc8, 0x06, 0xb4, 0xf2, 0x6e, 0x98, 0xa7, 0x64, 0xa3, 0x78, 0xa5, 0xbc, 0xd6,
+	0x14, 0x05, 0x1b, 0x71, 0x1f, 0x29, 0x0e, 0xe8, 0x62, 0x50, 0x66, 0x22, 0x3b, 0xd0, 0x10, 0x18,
+	0xa1, 0x2f, 0x19, 0x57, 0xfb, 0xda, 0x5c, 0x5b, 0xbb, 0x48, 0xc9, 0xee, 0xae, 0x06, 0x6d, 0xc6,
+	0x92, 0x9f, 0xd2, 0x9c, 0xa3, 0xfd, 0x09, 0x5c, 0x2a, 0x85, 0x48, 0x0b, 0xaa, 0x87, 0x78, 0xaa,
+	0x3e, 0x5b, 0x6d, 0x9a, 0x1e, 0xc9, 0x32, 0xcc, 0x1e, 0xa7, 0x93, 0xac, 0x86, 0xd4, 0xa6, 0x99,
+	0xb1, 0x5e, 0xb9, 0x67, 0x75, 0x5e, 0x56, 0x60, 0xa1, 0xac, 0x1c, 0x59, 0xd1, 0x1d, 0x57, 0xf8,
+	0x5e, 0xc5, 0xb1, 0x74, 0xd7, 0x57, 0xc1, 0x4e, 0xff, 0x8a, 0xc4, 0xf3, 0x35, 0x91, 0x0a, 0x16,
+	0x4e, 0x72, 0x1b, 0xaa, 0x1c, 0x07, 0x7a, 0x8c, 0xa7, 0xb7, 0x64, 0x6b, 0x86, 0xa6, 0x79, 0xe4,
+	0xc1, 0x58, 0x23, 0xb2, 0x51, 0xbd, 0x31, 0xf9, 0xf3, 0x69, 0x2f, 0x5d, 0x3c, 0xf3, 0xc6, 0xad,
+	0x99, 0xe2, 0xed, 0xa4, 0x07, 0x8d, 0xe7, 0x18, 0x0e, 0x0f, 0x24, 0x06, 0x4e, 0x7d, 0x8a, 0xfc,
+	0x5f, 0xeb, 0x84, 0x82, 0x4a, 0xa4, 0x1c, 0x06, 0x47, 0xbe, 0x29, 0xa6, 0xc9, 0xec, 0x79, 0xec,
+	0xa3, 0x9e, 0xf3, 0xf7, 0xfe, 0x4b, 0x9a, 0x47, 0x05, 0x84, 0x12, 0x76, 0xce, 0xd7, 0xbb, 0x9c,
+	0x6f, 0x50, 0xc8, 0xe2, 0xbe, 0x3c, 0x4d, 0xb0, 0xf3, 0x53, 0x05, 0x96, 0x26, 0x14, 0x45, 0xf6,
+	0x60, 0x3e, 0x40, 0x21, 0xc3, 0xd8, 0x1b, 0x1f, 0xad, 0xf7, 0x2f, 0xf2, 0x20, 0x77, 0xa3, 0x00,
+	0xd2, 0x12, 0x4b, 0xfb, 0x57, 0x0b, 0x9a, 0x63, 0x51, 0x23, 0x92, 0xf5, 0x06, 0x22, 0x55, 0xde,
+	0x4c, 0xa4, 0x15, 0xa8, 0x67, 0xcd, 0x56, 0x93, 0x71, 0x89, 0x6a, 0x6b, 0x52, 0x6b, 0x28, 0xb4,
+	0xa7, 0xf7, 0x97, 0x5c, 0x05, 0x5b, 0x2b, 0x84, 0x81, 0xea, 0x8e, 0x4d, 0x0b, 0x47, 0x7a, 0x4d,
+	0xc4, 0xfc, 0x43, 0x0c, 0xf4, 0xb7, 0xa0, 0xb6, 0x3a, 0x3f, 0xd7, 0x80, 0x9c, 0xaf, 0x90, 0x5c,
+	0x03, 0xc8, 0x27, 0x57, 0x68, 0xb6, 0x31, 0x0f, 0x79, 0x08, 0xf5, 0xc8, 0xdb, 0xc7, 0x48, 0xe8,
+	0x25, 0xed, 0x5e, 0xe0, 0xd9, 0xee, 0x63, 0x85, 0xc8, 0x36, 0x54, 0xc3, 0xc9, 0x57, 0xd0, 0xc4,
+	0x93, 0x84, 0xa3, 0x10, 0x4a, 0xd5, 0xec, 0x9b, 0xd8, 0xbd, 0x08, 0xdb, 0x66, 0x0e, 0xa3, 0xe3,
+	0x14, 0xed, 0x8f, 0xa1, 0x39, 0x76, 0xd1, 0xeb, 0xec, 0x7b, 0xfb, 0xc7, 0x0a, 0x40, 0x41, 0x3b,
+	0x01, 0xba, 0x0b, 0x0d, 0x96, 0x20, 0xf7, 0x8c, 0xde, 0x0b, 0x6b, 0x1f, 0xbd, 0x5e, 0xa9, 0xee,
+	0x13, 0x0d, 0xa7, 0x39, 0x51, 0x2a, 0x8d, 0x2a, 0x21, 0x7b, 0xbd, 0x4d, 0xb5, 0xd5, 0xf9, 0xc1,
+	0x82, 0x86, 0x49, 0x27, 0x00, 0xf5, 0xcd, 0xef, 0x46, 0x5e, 0x24, 0x5a, 0x33, 0xa4, 0x05, 0xf3,
+	0x1b, 0x6c, 0xb4, 0x1f, 0xa1, 0xf6, 0x58, 0xe4, 0x12, 0xd8, 0x3b, 0x4c, 0x6a, 0xb3, 0x42, 0xea,
+	0x50, 0x79, 0x14, 0xb7, 0xaa, 0xc4, 0x86, 0xd9, 0x1d, 0x26, 0x1f, 0xc5, 0xad, 0x9a, 0xc2, 0x9f,
+	0x84, 0x42, 0x8a, 0xd6, 0x6c, 0x86, 0x47, 0x91, 0x22, 0x52, 0x57, 0xab, 0x4e, 0x16, 0xa1, 0xf9,
+	0x90, 0xa3, 0x27, 0x91, 0xef, 0x1d, 0x78, 0x71, 0x6b, 0x8e, 0xcc, 0x43, 0xe3, 0x31, 0x0a, 0xa1,
+	0xac, 0x46, 0xef, 0xd3, 0xf4, 0x07, 0xdd, 0x2f, 0x7f, 0x5e, 0xb3, 0x9e, 0xdd, 0xb9, 0xf0, 0x7f,
+	0x1f, 0xc9, 0xe1, 0x50, 0xff, 0x0e, 0xde, 0xaf, 0xab, 0x5f, 0x0d, 0x77, 0xfe, 0x1d, 0x00, 0x6b,
+	0x3e, 0x2c, 0xa6, 0xbb, 0x0c, 0x00, 0x00,
 }
 
 func (this *VirtualService) Equal(that interface{}) bool {
@@ -873,6 +1159,9 @@ func (this *VirtualHost) Equal(that interface{}) bool {
 	if !this.Options.Equal(that1.Options) {
 		return false
 	}
+	if !this.OptionsConfigRefs.Equal(that1.OptionsConfigRefs) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -923,6 +1212,9 @@ func (this *Route) Equal(that interface{}) bool {
 	if this.Name != that1.Name {
 		return false
 	}
+	if !this.OptionsConfigRefs.Equal(that1.OptionsConfigRefs) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1024,6 +1316,46 @@ func (this *Route_DelegateAction) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DelegateOptionsRefs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegateOptionsRefs)
+	if !ok {
+		that2, ok := that.(DelegateOptionsRefs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.DelegateOptions) != len(that1.DelegateOptions) {
+		return false
+	}
+	for i := range this.DelegateOptions {
+		if !this.DelegateOptions[i].Equal(that1.DelegateOptions[i]) {
+			return false
+		}
+	}
+	if len(this.Selector) != len(that1.Selector) {
+		return false
+	}
+	for i := range this.Selector {
+		if this.Selector[i] != that1.Selector[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *DelegateAction) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1058,6 +1390,9 @@ func (this *DelegateAction) Equal(that interface{}) bool {
 	} else if !this.DelegationType.Equal(that1.DelegationType) {
 		return false
 	}
+	if !this.OptionsInheritance.Equal(that1.OptionsInheritance) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1111,6 +1446,186 @@ func (this *DelegateAction_Selector) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DelegateAction_Weighted) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegateAction_Weighted)
+	if !ok {
+		that2, ok := that.(DelegateAction_Weighted)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Weighted.Equal(that1.Weighted) {
+		return false
+	}
+	return true
+}
+func (this *WeightedRouteTables) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedRouteTables)
+	if !ok {
+		that2, ok := that.(WeightedRouteTables)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Destinations) != len(that1.Destinations) {
+		return false
+	}
+	for i := range this.Destinations {
+		if !this.Destinations[i].Equal(that1.Destinations[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *WeightedRouteTables_Destination) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedRouteTables_Destination)
+	if !ok {
+		that2, ok := that.(WeightedRouteTables_Destination)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.DelegationType == nil {
+		if this.DelegationType != nil {
+			return false
+		}
+	} else if this.DelegationType == nil {
+		return false
+	} else if !this.DelegationType.Equal(that1.DelegationType) {
+		return false
+	}
+	if this.Weight != that1.Weight {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *WeightedRouteTables_Destination_Ref) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedRouteTables_Destination_Ref)
+	if !ok {
+		that2, ok := that.(WeightedRouteTables_Destination_Ref)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Ref.Equal(that1.Ref) {
+		return false
+	}
+	return true
+}
+func (this *WeightedRouteTables_Destination_Selector) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedRouteTables_Destination_Selector)
+	if !ok {
+		that2, ok := that.(WeightedRouteTables_Destination_Selector)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Selector.Equal(that1.Selector) {
+		return false
+	}
+	return true
+}
+func (this *DelegateOptionsInheritance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegateOptionsInheritance)
+	if !ok {
+		that2, ok := that.(DelegateOptionsInheritance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Inherited) != len(that1.Inherited) {
+		return false
+	}
+	for i := range this.Inherited {
+		if this.Inherited[i] != that1.Inherited[i] {
+			return false
+		}
+	}
+	if len(this.Locked) != len(that1.Locked) {
+		return false
+	}
+	for i := range this.Locked {
+		if this.Locked[i] != that1.Locked[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *RouteTableSelector) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -0,0 +1,374 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/hashicorp/go-multierror"
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	// The period at which the controller checks whether the interval of the canaries elapsed.
+	ResyncPeriod = 10 * time.Second
+	// The timeout of the requests to the stats endpoints of the proxies.
+	StatsRequestTimeout = 5 * time.Second
+
+	DefaultInterval         = time.Minute
+	DefaultStepWeight       = 10
+	DefaultMaxErrorRate     = 1
+	DefaultFailureThreshold = 1
+	DefaultMinRequests      = 1
+
+	// The total weight of the destinations of the upstream groups of canaries.
+	totalWeight = 100
+)
+
+// The phases of a canary, recorded in the details of its status.
+const (
+	PhaseProgressing = "Progressing"
+	PhaseSucceeded   = "Succeeded"
+	PhaseRolledBack  = "RolledBack"
+)
+
+// The fields of the details of the status of a canary.
+const (
+	phaseField          = "phase"
+	canaryUpstreamField = "canaryUpstream"
+	canaryWeightField   = "canaryWeight"
+	failedChecksField   = "failedChecks"
+	messageField        = "message"
+)
+
+var (
+	MissingRefsErr = errors.New("the upstream group and the canary upstream must be specified")
+
+	MissingCanaryDestinationErr = func(upstream core.ResourceRef) error {
+		return errors.Errorf("the upstream group has no destination for the canary upstream %v", upstream.Key())
+	}
+	DestinationCountErr = func(count int) error {
+		return errors.Errorf("the upstream group must have 2 destinations, the primary and the canary upstreams, but has %v", count)
+	}
+	ErrorRateExceededErr = func(errorRate, maxErrorRate float64) error {
+		return errors.Errorf("the error rate %.2f%% exceeds the maximum error rate %.2f%%", errorRate, maxErrorRate)
+	}
+	LatencyExceededErr = func(latency, maxLatency time.Duration) error {
+		return errors.Errorf("the 99th percentile latency %v exceeds the maximum latency %v", latency, maxLatency)
+	}
+	InvalidStepWeightErr = func(stepWeight uint32) error {
+		return errors.Errorf("the step weight must be between 1 and %v, but is %v", totalWeight, stepWeight)
+	}
+	InvalidMaxErrorRateErr = func(maxErrorRate float64) error {
+		return errors.Errorf("the maximum error rate must be between 0 and 100, but is %v", maxErrorRate)
+	}
+)
+
+// Progressively shifts the weights of the upstream groups of canaries, depending on the stats of their canary upstreams.
+type Controller struct {
+	canaries       v1.CanaryClient
+	upstreamGroups gloov1.UpstreamGroupClient
+	stats          StatsSource
+	namespaces     []string
+	now            func() time.Time
+
+	// The time and stats of the last check of the canaries. They are lost on restart or when another replica starts
+	// running the controller, in which case the next check happens one interval later.
+	lastChecks map[core.ResourceRef]*check
+}
+
+type check struct {
+	time  time.Time
+	stats UpstreamStats
+}
+
+// The state of a canary, recorded in the details of its status.
+type canaryState struct {
+	phase          string
+	canaryUpstream string
+	canaryWeight   uint32
+	failedChecks   uint32
+	message        string
+}
+
+func NewController(canaries v1.CanaryClient, upstreamGroups gloov1.UpstreamGroupClient, stats StatsSource, namespaces []string, now func() time.Time) *Controller {
+	return &Controller{
+		canaries:       canaries,
+		upstreamGroups: upstreamGroups,
+		stats:          stats,
+		namespaces:     namespaces,
+		now:            now,
+		lastChecks:     map[core.ResourceRef]*check{},
+	}
+}
+
+// Syncs the canaries every resync period until the context is done.
+func (c *Controller) Run(ctx context.Context, resyncPeriod time.Duration) {
+	logger := contextutils.LoggerFrom(ctx)
+	ticker := time.NewTicker(resyncPeriod)
+	defer ticker.Stop()
+	for {
+		if err := c.Sync(ctx); err != nil {
+			logger.Errorf("error syncing canaries: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Forgets the last checks of the canaries, which another replica may have made since.
+func (c *Controller) resetChecks() {
+	c.lastChecks = map[core.ResourceRef]*check{}
+}
+
+// Checks the canaries whose interval elapsed, and updates the weights of their upstream groups and their statuses.
+func (c *Controller) Sync(ctx context.Context) error {
+	var errs error
+	for _, namespace := range c.namespaces {
+		canaries, err := c.canaries.List(namespace, clients.ListOpts{Ctx: ctx})
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		for _, canary := range canaries {
+			if err := c.syncCanary(ctx, canary); err != nil {
+				errs = multierror.Append(errs, errors.Wrapf(err, "syncing canary %v", canary.GetMetadata().Ref().Key()))
+			}
+		}
+	}
+	return errs
+}
+
+func (c *Controller) syncCanary(ctx context.Context, canary *v1.Canary) error {
+	ref := canary.GetMetadata().Ref()
+	state := canaryStateFromStatus(canary.GetStatus())
+	if canary.GetUpstreamGroup() == nil || canary.GetCanaryUpstream() == nil {
+		return c.writeStatus(ctx, canary, state, MissingRefsErr)
+	}
+	if err := checkSettings(canary); err != nil {
+		return c.writeStatus(ctx, canary, state, err)
+	}
+	canaryUpstream := *canary.GetCanaryUpstream()
+
+	// A new canary upstream starts a new rollout
+	if state.canaryUpstream != canaryUpstream.Key() {
+		state = canaryState{phase: PhaseProgressing, canaryUpstream: canaryUpstream.Key()}
+		delete(c.lastChecks, ref)
+	}
+	if state.phase != PhaseProgressing {
+		delete(c.lastChecks, ref)
+		return nil
+	}
+
+	upstreamGroupRef := canary.GetUpstreamGroup()
+	upstreamGroup, err := c.upstreamGroups.Read(upstreamGroupRef.GetNamespace(), upstreamGroupRef.GetName(), clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		return c.writeStatus(ctx, canary, state, err)
+	}
+	if err := checkDestinations(upstreamGroup, canaryUpstream); err != nil {
+		return c.writeStatus(ctx, canary, state, err)
+	}
+
+	now := c.now()
+	stats, err := c.stats.UpstreamStats(ctx, canary)
+	if err != nil {
+		return c.writeStatus(ctx, canary, state, err)
+	}
+
+	// Start the rollout by shifting the first step of the traffic, and check the canary after an interval
+	lastCheck := c.lastChecks[ref]
+	if lastCheck == nil {
+		c.lastChecks[ref] = &check{time: now, stats: stats}
+		if state.canaryWeight == 0 {
+			state.canaryWeight = stepWeight(canary)
+			state.message = fmt.Sprintf("shifted %v%% of the traffic to the canary", state.canaryWeight)
+			if err := c.writeWeights(ctx, upstreamGroup, canaryUpstream, state.canaryWeight); err != nil {
+				return err
+			}
+		}
+		return c.writeStatus(ctx, canary, state, nil)
+	}
+	if now.Sub(lastCheck.time) < interval(canary) {
+		return nil
+	}
+	c.lastChecks[ref] = &check{time: now, stats: stats}
+
+	intervalStats := stats.Since(lastCheck.stats)
+	if intervalStats.Requests < minRequests(canary) {
+		state.message = fmt.Sprintf("waiting for requests: the canary received %v requests during the last interval", intervalStats.Requests)
+		return c.writeStatus(ctx, canary, state, nil)
+	}
+
+	if err := checkThresholds(canary, intervalStats); err != nil {
+		state.failedChecks++
+		if state.failedChecks < failureThreshold(canary) {
+			state.message = fmt.Sprintf("failed check %v of %v: %v", state.failedChecks, failureThreshold(canary), err)
+			return c.writeStatus(ctx, canary, state, nil)
+		}
+
+		state.phase = PhaseRolledBack
+		state.canaryWeight = 0
+		state.message = fmt.Sprintf("rolled back after %v failed checks: %v", state.failedChecks, err)
+		if err := c.writeWeights(ctx, upstreamGroup, canaryUpstream, state.canaryWeight); err != nil {
+			return err
+		}
+		return c.writeStatus(ctx, canary, state, nil)
+	}
+
+	state.failedChecks = 0
+	state.canaryWeight += stepWeight(canary)
+	if state.canaryWeight >= totalWeight {
+		state.canaryWeight = totalWeight
+		state.phase = PhaseSucceeded
+		state.message = "shifted all the traffic to the canary"
+	} else {
+		state.message = fmt.Sprintf("shifted %v%% of the traffic to the canary", state.canaryWeight)
+	}
+	if err := c.writeWeights(ctx, upstreamGroup, canaryUpstream, state.canaryWeight); err != nil {
+		return err
+	}
+	return c.writeStatus(ctx, canary, state, nil)
+}
+
+// The upstream group of a canary must have a destination for the canary upstream, and one for the primary upstream.
+func checkDestinations(upstreamGroup *gloov1.UpstreamGroup, canaryUpstream core.ResourceRef) error {
+	if count := len(upstreamGroup.GetDestinations()); count != 2 {
+		return DestinationCountErr(count)
+	}
+	for _, destination := range upstreamGroup.GetDestinations() {
+		if upstream := destination.GetDestination().GetUpstream(); upstream != nil && *upstream == canaryUpstream {
+			return nil
+		}
+	}
+	return MissingCanaryDestinationErr(canaryUpstream)
+}
+
+// The step weight and the maximum error rate of a canary are percentages.
+func checkSettings(canary *v1.Canary) error {
+	if weight := stepWeight(canary); weight == 0 || weight > totalWeight {
+		return InvalidStepWeightErr(weight)
+	}
+	if rate := maxErrorRate(canary); rate < 0 || rate > 100 {
+		return InvalidMaxErrorRateErr(rate)
+	}
+	return nil
+}
+
+func checkThresholds(canary *v1.Canary, stats UpstreamStats) error {
+	if errorRate := stats.ErrorRate(); errorRate > maxErrorRate(canary) {
+		return ErrorRateExceededErr(errorRate, maxErrorRate(canary))
+	}
+	if maxLatency := canary.GetMaxLatency(); maxLatency != nil {
+		if latency := stats.LatencyPercentile(99); latency > *maxLatency {
+			return LatencyExceededErr(latency, *maxLatency)
+		}
+	}
+	return nil
+}
+
+// Gives the canary weight to the canary upstream, and the rest of the total weight to the primary upstream.
+func (c *Controller) writeWeights(ctx context.Context, upstreamGroup *gloov1.UpstreamGroup, canaryUpstream core.ResourceRef, canaryWeight uint32) error {
+	for _, destination := range upstreamGroup.GetDestinations() {
+		if upstream := destination.GetDestination().GetUpstream(); upstream != nil && *upstream == canaryUpstream {
+			destination.Weight = canaryWeight
+		} else {
+			destination.Weight = totalWeight - canaryWeight
+		}
+	}
+	_, err := c.upstreamGroups.Write(upstreamGroup, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+	return err
+}
+
+// Records the state of the canary in its status. The status is rejected if the canary was rolled back or could not be
+// checked.
+func (c *Controller) writeStatus(ctx context.Context, canary *v1.Canary, state canaryState, checkErr error) error {
+	status := core.Status{
+		State:      core.Status_Pending,
+		ReportedBy: "gateway",
+	}
+	switch {
+	case checkErr != nil:
+		status.State = core.Status_Rejected
+		status.Reason = checkErr.Error()
+	case state.phase == PhaseSucceeded:
+		status.State = core.Status_Accepted
+	case state.phase == PhaseRolledBack:
+		status.State = core.Status_Rejected
+		status.Reason = state.message
+	}
+	status.Details = state.details()
+	if status.Equal(canary.GetStatus()) {
+		return nil
+	}
+
+	canary.SetStatus(status)
+	_, err := c.canaries.Write(canary, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+	return err
+}
+
+func canaryStateFromStatus(status core.Status) canaryState {
+	fields := status.GetDetails().GetFields()
+	return canaryState{
+		phase:          fields[phaseField].GetStringValue(),
+		canaryUpstream: fields[canaryUpstreamField].GetStringValue(),
+		canaryWeight:   uint32(fields[canaryWeightField].GetNumberValue()),
+		failedChecks:   uint32(fields[failedChecksField].GetNumberValue()),
+		message:        fields[messageField].GetStringValue(),
+	}
+}
+
+func (s canaryState) details() *types.Struct {
+	return &types.Struct{
+		Fields: map[string]*types.Value{
+			phaseField:          {Kind: &types.Value_StringValue{StringValue: s.phase}},
+			canaryUpstreamField: {Kind: &types.Value_StringValue{StringValue: s.canaryUpstream}},
+			canaryWeightField:   {Kind: &types.Value_NumberValue{NumberValue: float64(s.canaryWeight)}},
+			failedChecksField:   {Kind: &types.Value_NumberValue{NumberValue: float64(s.failedChecks)}},
+			messageField:        {Kind: &types.Value_StringValue{StringValue: s.message}},
+		},
+	}
+}
+
+func interval(canary *v1.Canary) time.Duration {
+	if interval := canary.GetInterval(); interval != nil {
+		return *interval
+	}
+	return DefaultInterval
+}
+
+func stepWeight(canary *v1.Canary) uint32 {
+	if stepWeight := canary.GetStepWeight(); stepWeight != nil {
+		return stepWeight.GetValue()
+	}
+	return DefaultStepWeight
+}
+
+func maxErrorRate(canary *v1.Canary) float64 {
+	if maxErrorRate := canary.GetMaxErrorRate(); maxErrorRate != nil {
+		return maxErrorRate.GetValue()
+	}
+	return DefaultMaxErrorRate
+}
+
+func failureThreshold(canary *v1.Canary) uint32 {
+	if canary.GetFailureThreshold() > 0 {
+		return canary.GetFailureThreshold()
+	}
+	return DefaultFailureThreshold
+}
+
+func minRequests(canary *v1.Canary) uint64 {
+	if minRequests := canary.GetMinRequests(); minRequests != nil {
+		return uint64(minRequests.GetValue())
+	}
+	return DefaultMinRequests
+}
@@ -0,0 +1,258 @@
+package canary_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/canary"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type fakeStatsSource struct {
+	stats canary.UpstreamStats
+}
+
+func (s *fakeStatsSource) UpstreamStats(_ context.Context, _ *v1.Canary) (canary.UpstreamStats, error) {
+	return s.stats, nil
+}
+
+var _ = Describe("Controller", func() {
+
+	var (
+		ctx            context.Context
+		cancel         context.CancelFunc
+		canaryClient   v1.CanaryClient
+		ugClient       gloov1.UpstreamGroupClient
+		stats          *fakeStatsSource
+		now            time.Time
+		controller     *canary.Controller
+		primaryRef     = core.ResourceRef{Namespace: "gloo-system", Name: "petstore-v1"}
+		canaryRef      = core.ResourceRef{Namespace: "gloo-system", Name: "petstore-v2"}
+		upstreamGroup  = core.ResourceRef{Namespace: "gloo-system", Name: "petstore"}
+		canaryResource = core.ResourceRef{Namespace: "gloo-system", Name: "petstore"}
+	)
+
+	destination := func(ref core.ResourceRef, weight uint32) *gloov1.WeightedDestination {
+		return &gloov1.WeightedDestination{
+			Destination: &gloov1.Destination{DestinationType: &gloov1.Destination_Upstream{Upstream: &ref}},
+			Weight:      weight,
+		}
+	}
+
+	readWeights := func() []uint32 {
+		ug, err := ugClient.Read(upstreamGroup.Namespace, upstreamGroup.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		var weights []uint32
+		for _, dest := range ug.GetDestinations() {
+			weights = append(weights, dest.GetWeight())
+		}
+		return weights
+	}
+
+	readStatus := func() core.Status {
+		can, err := canaryClient.Read(canaryResource.Namespace, canaryResource.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		return can.GetStatus()
+	}
+
+	// Advances the clock by an interval during which the canary received the given requests and errors.
+	serveInterval := func(requests, errs uint64) {
+		now = now.Add(time.Minute)
+		stats.stats.Requests += requests
+		stats.stats.Errors += errs
+		Expect(controller.Sync(ctx)).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		f := &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()}
+		var err error
+		canaryClient, err = v1.NewCanaryClient(f)
+		Expect(err).NotTo(HaveOccurred())
+		ugClient, err = gloov1.NewUpstreamGroupClient(f)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = ugClient.Write(&gloov1.UpstreamGroup{
+			Metadata:     core.Metadata{Namespace: upstreamGroup.Namespace, Name: upstreamGroup.Name},
+			Destinations: []*gloov1.WeightedDestination{destination(primaryRef, 100), destination(canaryRef, 0)},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = canaryClient.Write(&v1.Canary{
+			Metadata:       core.Metadata{Namespace: canaryResource.Namespace, Name: canaryResource.Name},
+			UpstreamGroup:  &upstreamGroup,
+			CanaryUpstream: &canaryRef,
+			StepWeight:     &types.UInt32Value{Value: 25},
+			MinRequests:    &types.UInt32Value{Value: 10},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		stats = &fakeStatsSource{}
+		now = time.Unix(0, 0)
+		controller = canary.NewController(canaryClient, ugClient, stats, []string{"gloo-system"}, func() time.Time { return now })
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("shifts the first step of the traffic to the canary", func() {
+		Expect(controller.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(readWeights()).To(Equal([]uint32{75, 25}))
+		status := readStatus()
+		Expect(status.State).To(Equal(core.Status_Pending))
+		Expect(status.Details.Fields["phase"].GetStringValue()).To(Equal(canary.PhaseProgressing))
+	})
+
+	It("does not check the canary before the interval elapsed", func() {
+		Expect(controller.Sync(ctx)).NotTo(HaveOccurred())
+		now = now.Add(30 * time.Second)
+		stats.stats.Requests = 100
+		Expect(controller.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(readWeights()).To(Equal([]uint32{75, 25}))
+	})
+
+	It("shifts all the traffic to a healthy canary", func() {
+		Expect(controller.Sync(ctx)).NotTo(HaveOccurred())
+		serveInterval(100, 0)
+		Expect(readWeights()).To(Equal([]uint32{50, 50}))
+		serveInterval(100, 0)
+		serveInterval(100, 0)
+
+		Expect(readWeights()).To(Equal([]uint32{0, 100}))
+		status := readStatus()
+		Expect(status.State).To(Equal(core.Status_Accepted))
+		Expect(status.Details.Fields["phase"].GetStringValue()).To(Equal(canary.PhaseSucceeded))
+
+		// the weights are no longer changed once the rollout succeeded
+		serveInterval(100, 100)
+		Expect(readWeights()).To(Equal([]uint32{0, 100}))
+	})
+
+	It("rolls back a canary exceeding the error rate", func() {
+		Expect(controller.Sync(ctx)).NotTo(HaveOccurred())
+		serveInterval(100, 0)
+		serveInterval(100, 5)
+
+		Expect(readWeights()).To(Equal([]uint32{100, 0}))
+		status := readStatus()
+		Expect(status.State).To(Equal(core.Status_Rejected))
+		Expect(status.Reason).To(ContainSubstring(canary.ErrorRateExceededErr(5, canary.DefaultMaxErrorRate).Error()))
+		Expect(status.Details.Fields["phase"].GetStringValue()).To(Equal(canary.PhaseRolledBack))
+	})
+
+	It("rolls back a canary on any error with a zero maximum error rate", func() {
+		can, err := canaryClient.Read(canaryResource.Namespace, canaryResource.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		can.MaxErrorRate = &types.DoubleValue{Value: 0}
+		_, err = canaryClient.Write(can, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(controller.Sync(ctx)).NotTo(HaveOccurred())
+		serveInterval(1000, 1)
+
+		Expect(readWeights()).To(Equal([]uint32{100, 0}))
+		status := readStatus()
+		Expect(status.State).To(Equal(core.Status_Rejected))
+		Expect(status.Reason).To(ContainSubstring(canary.ErrorRateExceededErr(0.1, 0).Error()))
+	})
+
+	It("rejects canaries with a zero step weight", func() {
+		can, err := canaryClient.Read(canaryResource.Namespace, canaryResource.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		can.StepWeight = &types.UInt32Value{Value: 0}
+		_, err = canaryClient.Write(can, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(controller.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(readWeights()).To(Equal([]uint32{100, 0}))
+		status := readStatus()
+		Expect(status.State).To(Equal(core.Status_Rejected))
+		Expect(status.Reason).To(Equal(canary.InvalidStepWeightErr(0).Error()))
+	})
+
+	It("only rolls back a canary after consecutive failed checks", func() {
+		can, err := canaryClient.Read(canaryResource.Namespace, canaryResource.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		can.FailureThreshold = 2
+		_, err = canaryClient.Write(can, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(controller.Sync(ctx)).NotTo(HaveOccurred())
+		serveInterval(100, 5)
+		Expect(readWeights()).To(Equal([]uint32{75, 25}))
+		serveInterval(100, 0)
+		Expect(readWeights()).To(Equal([]uint32{50, 50}))
+		Expect(readStatus().Details.Fields["failedChecks"].GetNumberValue()).To(BeZero())
+		serveInterval(100, 5)
+
+		Expect(readWeights()).To(Equal([]uint32{50, 50}))
+		status := readStatus()
+		Expect(status.State).To(Equal(core.Status_Pending))
+		Expect(status.Details.Fields["failedChecks"].GetNumberValue()).To(Equal(float64(1)))
+	})
+
+	It("waits for enough requests to check the canary", func() {
+		Expect(controller.Sync(ctx)).NotTo(HaveOccurred())
+		serveInterval(5, 5)
+
+		Expect(readWeights()).To(Equal([]uint32{75, 25}))
+		status := readStatus()
+		Expect(status.State).To(Equal(core.Status_Pending))
+		Expect(status.Details.Fields["message"].GetStringValue()).To(ContainSubstring("waiting for requests"))
+	})
+
+	It("checks the intervals without requests with zero minimum requests", func() {
+		can, err := canaryClient.Read(canaryResource.Namespace, canaryResource.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		can.MinRequests = &types.UInt32Value{Value: 0}
+		_, err = canaryClient.Write(can, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(controller.Sync(ctx)).NotTo(HaveOccurred())
+		serveInterval(0, 0)
+
+		Expect(readWeights()).To(Equal([]uint32{50, 50}))
+	})
+
+	It("rejects canaries whose upstream group has no destination for the canary upstream", func() {
+		can, err := canaryClient.Read(canaryResource.Namespace, canaryResource.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		otherRef := core.ResourceRef{Namespace: "gloo-system", Name: "petstore-v3"}
+		can.CanaryUpstream = &otherRef
+		_, err = canaryClient.Write(can, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(controller.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(readWeights()).To(Equal([]uint32{100, 0}))
+		status := readStatus()
+		Expect(status.State).To(Equal(core.Status_Rejected))
+		Expect(status.Reason).To(Equal(canary.MissingCanaryDestinationErr(otherRef).Error()))
+	})
+
+	It("runs in the replica holding the lease", func() {
+		kube := fake.NewSimpleClientset()
+		go controller.RunWithLeaderElection(ctx, kube, "gloo-system", time.Second)
+
+		Eventually(readWeights, 5*time.Second).Should(Equal([]uint32{75, 25}))
+		lease, err := kube.CoordinationV1().Leases("gloo-system").Get(canary.LeaseName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		hostname, err := os.Hostname()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*lease.Spec.HolderIdentity).To(Equal(hostname))
+	})
+})
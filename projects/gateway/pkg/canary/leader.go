@@ -0,0 +1,63 @@
+package canary
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/solo-io/go-utils/contextutils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	// The name of the lease held by the gateway replica that runs the controller.
+	LeaseName = "gateway-canary-controller"
+
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// Runs the controller in the replica that holds the lease in the given namespace, so that only one replica shifts the
+// weights of the upstream groups of the canaries. The other replicas wait to acquire the lease until the context is done.
+func (c *Controller) RunWithLeaderElection(ctx context.Context, kube kubernetes.Interface, namespace string, resyncPeriod time.Duration) {
+	logger := contextutils.LoggerFrom(ctx)
+	identity, err := os.Hostname()
+	if err != nil {
+		logger.Errorf("error reading the hostname for the canary controller lease: %v", err)
+		return
+	}
+
+	// The controller may still be syncing when the leadership is lost, and must not run twice at once.
+	var running sync.Mutex
+	for ctx.Err() == nil {
+		leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+			Lock: &resourcelock.LeaseLock{
+				LeaseMeta:  metav1.ObjectMeta{Namespace: namespace, Name: LeaseName},
+				Client:     kube.CoordinationV1(),
+				LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+			},
+			LeaseDuration:   leaseDuration,
+			RenewDeadline:   renewDeadline,
+			RetryPeriod:     retryPeriod,
+			ReleaseOnCancel: true,
+			Name:            LeaseName,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					running.Lock()
+					defer running.Unlock()
+					logger.Infof("%v started running the canary controller", identity)
+					c.resetChecks()
+					c.Run(ctx, resyncPeriod)
+				},
+				OnStoppedLeading: func() {
+					logger.Infof("%v stopped running the canary controller", identity)
+				},
+			},
+		})
+	}
+}
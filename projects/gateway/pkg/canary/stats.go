@@ -0,0 +1,214 @@
+package canary
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+const (
+	clusterNameLabel       = "envoy_cluster_name"
+	responseCodeClassLabel = "envoy_response_code_class"
+
+	requestsMetric       = "envoy_cluster_upstream_rq_total"
+	responseClassMetric  = "envoy_cluster_upstream_rq_xx"
+	requestLatencyMetric = "envoy_cluster_upstream_rq_time"
+)
+
+var (
+	NoStatsUrlsErr = errors.New("no stats urls are specified")
+
+	StatsRequestErr = func(url string, err error) error {
+		return errors.Wrapf(err, "getting the stats at %v", url)
+	}
+)
+
+// The cumulative stats of the requests that Envoy sent to an upstream.
+type UpstreamStats struct {
+	// The number of requests.
+	Requests uint64
+	// The number of requests that failed with a 5xx status code.
+	Errors uint64
+	// The number of requests by latency, from the smallest to the largest upper bound. The counts are cumulative:
+	// the count of a bucket includes the requests of the previous buckets.
+	LatencyBuckets []LatencyBucket
+}
+
+type LatencyBucket struct {
+	UpperBound time.Duration
+	Count      uint64
+}
+
+// Returns the stats of the requests sent since the previous stats.
+// Counters that decreased, for example because a proxy restarted, are reset to their current value.
+func (s UpstreamStats) Since(previous UpstreamStats) UpstreamStats {
+	since := UpstreamStats{
+		Requests: counterSince(s.Requests, previous.Requests),
+		Errors:   counterSince(s.Errors, previous.Errors),
+	}
+	previousCounts := map[time.Duration]uint64{}
+	for _, bucket := range previous.LatencyBuckets {
+		previousCounts[bucket.UpperBound] = bucket.Count
+	}
+	for _, bucket := range s.LatencyBuckets {
+		since.LatencyBuckets = append(since.LatencyBuckets, LatencyBucket{
+			UpperBound: bucket.UpperBound,
+			Count:      counterSince(bucket.Count, previousCounts[bucket.UpperBound]),
+		})
+	}
+	return since
+}
+
+func counterSince(current, previous uint64) uint64 {
+	if current < previous {
+		return current
+	}
+	return current - previous
+}
+
+// Returns the percentage of the requests that failed.
+func (s UpstreamStats) ErrorRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return 100 * float64(s.Errors) / float64(s.Requests)
+}
+
+// Returns the upper bound of the latency bucket holding the given percentile of the requests.
+// Returns 0 if there are no requests, and the largest finite upper bound if the percentile is in the overflow bucket.
+func (s UpstreamStats) LatencyPercentile(percentile float64) time.Duration {
+	var total uint64
+	if len(s.LatencyBuckets) > 0 {
+		total = s.LatencyBuckets[len(s.LatencyBuckets)-1].Count
+	}
+	if total == 0 {
+		return 0
+	}
+	rank := uint64(math.Ceil(percentile / 100 * float64(total)))
+	var upperBound time.Duration
+	for _, bucket := range s.LatencyBuckets {
+		if bucket.UpperBound != math.MaxInt64 {
+			upperBound = bucket.UpperBound
+		}
+		if bucket.Count >= rank {
+			break
+		}
+	}
+	return upperBound
+}
+
+// Provides the stats of the requests to the canary upstream of a Canary.
+type StatsSource interface {
+	UpstreamStats(ctx context.Context, canary *v1.Canary) (UpstreamStats, error)
+}
+
+// Returns a stats source which adds up the stats of the Envoy Prometheus stats endpoints of the canaries.
+func NewEnvoyStatsSource(client *http.Client) StatsSource {
+	return &envoyStatsSource{client: client}
+}
+
+type envoyStatsSource struct {
+	client *http.Client
+}
+
+func (s *envoyStatsSource) UpstreamStats(ctx context.Context, canary *v1.Canary) (UpstreamStats, error) {
+	if len(canary.GetStatsUrls()) == 0 {
+		return UpstreamStats{}, NoStatsUrlsErr
+	}
+	clusterName := translator.UpstreamToClusterName(*canary.GetCanaryUpstream())
+
+	var stats UpstreamStats
+	for _, url := range canary.GetStatsUrls() {
+		families, err := s.getMetrics(ctx, url)
+		if err != nil {
+			return UpstreamStats{}, StatsRequestErr(url, err)
+		}
+		stats = addStats(stats, clusterStats(families, clusterName))
+	}
+	return stats, nil
+}
+
+func (s *envoyStatsSource) getMetrics(ctx context.Context, url string) (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code %v", res.StatusCode)
+	}
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(res.Body)
+}
+
+// Returns the stats of the requests to the given cluster in the Envoy Prometheus metrics.
+func clusterStats(families map[string]*dto.MetricFamily, clusterName string) UpstreamStats {
+	var stats UpstreamStats
+	for _, metric := range families[requestsMetric].GetMetric() {
+		if labelValue(metric, clusterNameLabel) == clusterName {
+			stats.Requests += uint64(metric.GetCounter().GetValue())
+		}
+	}
+	for _, metric := range families[responseClassMetric].GetMetric() {
+		if labelValue(metric, clusterNameLabel) == clusterName && labelValue(metric, responseCodeClassLabel) == "5" {
+			stats.Errors += uint64(metric.GetCounter().GetValue())
+		}
+	}
+	for _, metric := range families[requestLatencyMetric].GetMetric() {
+		if labelValue(metric, clusterNameLabel) != clusterName {
+			continue
+		}
+		for _, bucket := range metric.GetHistogram().GetBucket() {
+			// Envoy reports the latencies in milliseconds
+			upperBound := time.Duration(math.MaxInt64)
+			if !math.IsInf(bucket.GetUpperBound(), 1) {
+				upperBound = time.Duration(bucket.GetUpperBound() * float64(time.Millisecond))
+			}
+			stats.LatencyBuckets = append(stats.LatencyBuckets, LatencyBucket{UpperBound: upperBound, Count: bucket.GetCumulativeCount()})
+		}
+	}
+	return stats
+}
+
+func labelValue(metric *dto.Metric, name string) string {
+	for _, label := range metric.GetLabel() {
+		if label.GetName() == name {
+			return label.GetValue()
+		}
+	}
+	return ""
+}
+
+// Adds up the stats of two proxies, which use the same latency buckets.
+func addStats(a, b UpstreamStats) UpstreamStats {
+	sum := UpstreamStats{
+		Requests: a.Requests + b.Requests,
+		Errors:   a.Errors + b.Errors,
+	}
+	counts := map[time.Duration]uint64{}
+	for _, bucket := range a.LatencyBuckets {
+		counts[bucket.UpperBound] += bucket.Count
+	}
+	for _, bucket := range b.LatencyBuckets {
+		counts[bucket.UpperBound] += bucket.Count
+	}
+	buckets := a.LatencyBuckets
+	if len(b.LatencyBuckets) > len(buckets) {
+		buckets = b.LatencyBuckets
+	}
+	for _, bucket := range buckets {
+		sum.LatencyBuckets = append(sum.LatencyBuckets, LatencyBucket{UpperBound: bucket.UpperBound, Count: counts[bucket.UpperBound]})
+	}
+	return sum
+}
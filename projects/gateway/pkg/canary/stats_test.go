@@ -0,0 +1,92 @@
+package canary_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/canary"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const envoyStats = `# TYPE envoy_cluster_upstream_rq_total counter
+envoy_cluster_upstream_rq_total{envoy_cluster_name="petstore-v2_gloo-system"} 200
+envoy_cluster_upstream_rq_total{envoy_cluster_name="petstore-v1_gloo-system"} 1000
+# TYPE envoy_cluster_upstream_rq_xx counter
+envoy_cluster_upstream_rq_xx{envoy_response_code_class="2",envoy_cluster_name="petstore-v2_gloo-system"} 190
+envoy_cluster_upstream_rq_xx{envoy_response_code_class="5",envoy_cluster_name="petstore-v2_gloo-system"} 10
+envoy_cluster_upstream_rq_xx{envoy_response_code_class="5",envoy_cluster_name="petstore-v1_gloo-system"} 500
+# TYPE envoy_cluster_upstream_rq_time histogram
+envoy_cluster_upstream_rq_time_bucket{envoy_cluster_name="petstore-v2_gloo-system",le="10"} 100
+envoy_cluster_upstream_rq_time_bucket{envoy_cluster_name="petstore-v2_gloo-system",le="100"} 198
+envoy_cluster_upstream_rq_time_bucket{envoy_cluster_name="petstore-v2_gloo-system",le="+Inf"} 200
+envoy_cluster_upstream_rq_time_sum{envoy_cluster_name="petstore-v2_gloo-system"} 4000
+envoy_cluster_upstream_rq_time_count{envoy_cluster_name="petstore-v2_gloo-system"} 200
+`
+
+var _ = Describe("Stats", func() {
+
+	Context("envoy stats source", func() {
+
+		var (
+			server *httptest.Server
+			can    *v1.Canary
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, envoyStats)
+			}))
+			can = &v1.Canary{
+				CanaryUpstream: &core.ResourceRef{Namespace: "gloo-system", Name: "petstore-v2"},
+				StatsUrls:      []string{server.URL, server.URL},
+			}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("adds up the stats of the canary upstream of all the proxies", func() {
+			stats, err := canary.NewEnvoyStatsSource(server.Client()).UpstreamStats(context.Background(), can)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(stats.Requests).To(BeEquivalentTo(400))
+			Expect(stats.Errors).To(BeEquivalentTo(20))
+			Expect(stats.ErrorRate()).To(Equal(5.0))
+			Expect(stats.LatencyPercentile(50)).To(Equal(10 * time.Millisecond))
+			Expect(stats.LatencyPercentile(99)).To(Equal(100 * time.Millisecond))
+			Expect(stats.LatencyPercentile(100)).To(Equal(100 * time.Millisecond))
+		})
+
+		It("errors without stats urls", func() {
+			can.StatsUrls = nil
+			_, err := canary.NewEnvoyStatsSource(server.Client()).UpstreamStats(context.Background(), can)
+			Expect(err).To(MatchError(canary.NoStatsUrlsErr))
+		})
+	})
+
+	It("computes the stats since previous stats", func() {
+		previous := canary.UpstreamStats{
+			Requests:       100,
+			Errors:         10,
+			LatencyBuckets: []canary.LatencyBucket{{UpperBound: time.Millisecond, Count: 100}},
+		}
+		current := canary.UpstreamStats{
+			Requests:       150,
+			Errors:         5,
+			LatencyBuckets: []canary.LatencyBucket{{UpperBound: time.Millisecond, Count: 150}},
+		}
+
+		Expect(current.Since(previous)).To(Equal(canary.UpstreamStats{
+			Requests:       50,
+			Errors:         5,
+			LatencyBuckets: []canary.LatencyBucket{{UpperBound: time.Millisecond, Count: 50}},
+		}))
+	})
+})
@@ -77,17 +77,14 @@ func forEachListener(proxy *gloov1.Proxy, reports reporter.ResourceReports, fn f
 	return nil
 }
 
-func forEachVhost(lis *gloov1.Listener, reports reporter.ResourceReports, fn func(*gloov1.VirtualHost, bool)) error {
-	if httpListenerType, ok := lis.ListenerType.(*gloov1.Listener_HttpListener); ok {
-
-		for _, vhost := range httpListenerType.HttpListener.GetVirtualHosts() {
-			accepted, err := reporting.AllSourcesAccepted(reports, vhost)
-			if err != nil {
-				return err
-			}
-
-			fn(vhost, accepted)
+func forEachVhost(httpListener *gloov1.HttpListener, reports reporter.ResourceReports, fn func(*gloov1.VirtualHost, bool)) error {
+	for _, vhost := range httpListener.GetVirtualHosts() {
+		accepted, err := reporting.AllSourcesAccepted(reports, vhost)
+		if err != nil {
+			return err
 		}
+
+		fn(vhost, accepted)
 	}
 	return nil
 }
@@ -153,24 +150,31 @@ func stripInvalidListenersAndVirtualHosts(ctx context.Context, proxiesToWrite Ge
 
 		for _, lis := range proxy.Listeners {
 
-			if httpListenerType, ok := lis.ListenerType.(*gloov1.Listener_HttpListener); ok {
-				var validVhosts []*gloov1.VirtualHost
-
-				if err := forEachVhost(lis, reports, func(vhost *gloov1.VirtualHost, accepted bool) {
-					if accepted {
-						validVhosts = append(validVhosts, vhost)
-					} else {
-						logger.Warnw("stripping invalid virtualhost from proxy", zap.Any("proxy", proxy.Metadata.Ref()), zap.String("listener", lis.Name), zap.String("virtual host", vhost.Name))
-					}
-				}); err != nil {
+			switch listenerType := lis.ListenerType.(type) {
+			case *gloov1.Listener_HttpListener:
+				if err := stripInvalidVirtualHosts(logger, proxy, lis.Name, listenerType.HttpListener, reports); err != nil {
 					return nil, err
 				}
-
-				sort.SliceStable(validVhosts, func(i, j int) bool {
-					return validVhosts[i].Name < validVhosts[j].Name
-				})
-
-				httpListenerType.HttpListener.VirtualHosts = validVhosts
+			case *gloov1.Listener_HybridListener:
+				// the matched listeners of a hybrid listener may be created from different resources
+				var validMatchedListeners []*gloov1.MatchedListener
+				for _, matchedListener := range listenerType.HybridListener.GetMatchedListeners() {
+					accepted, err := reporting.AllSourcesAccepted(reports, matchedListener)
+					if err != nil {
+						return nil, err
+					}
+					if !accepted {
+						logger.Warnw("stripping invalid matched listener from proxy", zap.Any("proxy", proxy.Metadata.Ref()), zap.String("listener", lis.Name))
+						continue
+					}
+					if httpListener := matchedListener.GetHttpListener(); httpListener != nil {
+						if err := stripInvalidVirtualHosts(logger, proxy, lis.Name, httpListener, reports); err != nil {
+							return nil, err
+						}
+					}
+					validMatchedListeners = append(validMatchedListeners, matchedListener)
+				}
+				listenerType.HybridListener.MatchedListeners = validMatchedListeners
 			}
 		}
 
@@ -187,6 +191,27 @@ func stripInvalidListenersAndVirtualHosts(ctx context.Context, proxiesToWrite Ge
 	return strippedProxies, nil
 }
 
+func stripInvalidVirtualHosts(logger *zap.SugaredLogger, proxy *gloov1.Proxy, listenerName string, httpListener *gloov1.HttpListener, reports reporter.ResourceReports) error {
+	var validVhosts []*gloov1.VirtualHost
+
+	if err := forEachVhost(httpListener, reports, func(vhost *gloov1.VirtualHost, accepted bool) {
+		if accepted {
+			validVhosts = append(validVhosts, vhost)
+		} else {
+			logger.Warnw("stripping invalid virtualhost from proxy", zap.Any("proxy", proxy.Metadata.Ref()), zap.String("listener", listenerName), zap.String("virtual host", vhost.Name))
+		}
+	}); err != nil {
+		return err
+	}
+
+	sort.SliceStable(validVhosts, func(i, j int) bool {
+		return validVhosts[i].Name < validVhosts[j].Name
+	})
+
+	httpListener.VirtualHosts = validVhosts
+	return nil
+}
+
 // this function is called by the base reconciler to update an existing proxy
 // persists listeners and virtual hosts from the existing proxy
 // it is necessary to call this transition function *after*
@@ -228,7 +253,7 @@ func transitionFunc(proxiesToWrite GeneratedProxies) gloov1.TransitionProxyFunc
 			}
 
 			// find any rejected vhosts on the original listener and copy them over
-			if err := forEachVhost(originalListener, proxiesToWrite[desired], func(vhost *gloov1.VirtualHost, accepted bool) {
+			if err := forEachVhost(originalListener.GetHttpListener(), proxiesToWrite[desired], func(vhost *gloov1.VirtualHost, accepted bool) {
 				// old vhost was rejected, preserve it on the desired proxy
 				if !accepted {
 					desiredHttpListener.VirtualHosts = append(desiredHttpListener.VirtualHosts, vhost)
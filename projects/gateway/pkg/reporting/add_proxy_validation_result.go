@@ -11,9 +11,10 @@ import (
 )
 
 var (
-	invalidReportsListenersErr    = errors.Errorf("internal err: reports did not match number of listeners")
-	invalidReportsVirtualHostsErr = errors.Errorf("internal err: reports did not match number of virtual hosts")
-	missingReportForSourceErr     = errors.Errorf("internal err: missing resource report for source resource")
+	invalidReportsListenersErr        = errors.Errorf("internal err: reports did not match number of listeners")
+	invalidReportsVirtualHostsErr     = errors.Errorf("internal err: reports did not match number of virtual hosts")
+	invalidReportsMatchedListenersErr = errors.Errorf("internal err: reports did not match number of matched listeners")
+	missingReportForSourceErr         = errors.Errorf("internal err: missing resource report for source resource")
 )
 
 // Update a set of ResourceReports with the results of a proxy validation
@@ -36,19 +37,33 @@ func AddProxyValidationResult(resourceReports reporter.ResourceReports, proxy *g
 		}
 
 		if httpListenerReport := listenerReport.GetHttpListenerReport(); httpListenerReport != nil {
-			vhReports := httpListenerReport.GetVirtualHostReports()
-			virtualHosts := listener.GetHttpListener().GetVirtualHosts()
+			if err := addVirtualHostResults(resourceReports, listener.GetHttpListener().GetVirtualHosts(), httpListenerReport); err != nil {
+				return err
+			}
+		}
 
-			if len(vhReports) != len(virtualHosts) {
-				return invalidReportsVirtualHostsErr
+		// the matched listeners and their virtual hosts are reported on their own sources,
+		// which may be the gateway or the matchable http gateway they were created from
+		if hybridListenerReport := listenerReport.GetHybridListenerReport(); hybridListenerReport != nil {
+			matchedListenerReports := hybridListenerReport.GetMatchedListenerReports()
+			matchedListeners := listener.GetHybridListener().GetMatchedListeners()
+
+			if len(matchedListenerReports) != len(matchedListeners) {
+				return invalidReportsMatchedListenersErr
 			}
 
-			for j, vhReport := range vhReports {
-				virtualHost := virtualHosts[j]
+			for j, matchedListenerReport := range matchedListenerReports {
+				matchedListener := matchedListeners[j]
 
-				if err := addVirtualHostResult(resourceReports, virtualHost, vhReport); err != nil {
+				if err := addListenerResult(resourceReports, matchedListener, matchedListenerReport); err != nil {
 					return err
 				}
+
+				if httpListenerReport := matchedListenerReport.GetHttpListenerReport(); httpListenerReport != nil {
+					if err := addVirtualHostResults(resourceReports, matchedListener.GetHttpListener().GetVirtualHosts(), httpListenerReport); err != nil {
+						return err
+					}
+				}
 			}
 		}
 	}
@@ -56,7 +71,24 @@ func AddProxyValidationResult(resourceReports reporter.ResourceReports, proxy *g
 	return nil
 }
 
-func addListenerResult(resourceReports reporter.ResourceReports, listener *gloov1.Listener, listenerReport *validation.ListenerReport) error {
+func addVirtualHostResults(resourceReports reporter.ResourceReports, virtualHosts []*gloov1.VirtualHost, httpListenerReport *validation.HttpListenerReport) error {
+	vhReports := httpListenerReport.GetVirtualHostReports()
+
+	if len(vhReports) != len(virtualHosts) {
+		return invalidReportsVirtualHostsErr
+	}
+
+	for j, vhReport := range vhReports {
+		virtualHost := virtualHosts[j]
+
+		if err := addVirtualHostResult(resourceReports, virtualHost, vhReport); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addListenerResult(resourceReports reporter.ResourceReports, listener translator.ObjectWithMetadata, listenerReport *validation.ListenerReport) error {
 	listenerErrs := getListenerLevelErrors(listenerReport)
 
 	return translator.ForEachSource(listener, func(src translator.SourceRef) error {
@@ -66,4 +66,31 @@ var _ = Describe("AddProxyValidationResult", func() {
 	* Route Error: InvalidMatcherError. Reason: bad route`))
 		}
 	})
+
+	It("adds the errors of matched listeners to their sources", func() {
+		snap = samples.HybridGatewaySnapshot(core.ResourceRef{ignored, ignored}, ignored)
+		tx := translator.NewTranslator([]translator.ListenerFactory{&translator.HybridTranslator{}}, translator.Opts{})
+		proxy, reports = tx.Translate(context.TODO(), ignored, ignored, snap, snap.Gateways)
+		proxyReport := validation.MakeReport(proxy)
+
+		matchedListenerReports := proxyReport.GetListenerReports()[0].GetHybridListenerReport().GetMatchedListenerReports()
+		Expect(matchedListenerReports).To(HaveLen(2))
+		validation.AppendListenerError(matchedListenerReports[0],
+			validationapi.ListenerReport_Error_ProcessingError,
+			"bad tcp listener")
+		validation.AppendListenerError(matchedListenerReports[1],
+			validationapi.ListenerReport_Error_SSLConfigError,
+			"bad http listener")
+		validation.AppendVirtualHostError(matchedListenerReports[1].GetHttpListenerReport().GetVirtualHostReports()[0],
+			validationapi.VirtualHostReport_Error_DomainsNotUniqueError,
+			"bad vhost")
+
+		err := AddProxyValidationResult(reports, proxy, proxyReport)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(reports[snap.Gateways[0]].Errors).To(MatchError(ContainSubstring("Listener Error: ProcessingError. Reason: bad tcp listener")))
+		Expect(reports[snap.Gateways[0]].Errors).NotTo(MatchError(ContainSubstring("bad http listener")))
+		Expect(reports[snap.HttpGateways[0]].Errors).To(MatchError(ContainSubstring("Listener Error: SSLConfigError. Reason: bad http listener")))
+		Expect(reports[snap.VirtualServices[0]].Errors).To(MatchError(ContainSubstring("VirtualHost Error: DomainsNotUniqueError. Reason: bad vhost")))
+	})
 })
@@ -408,6 +408,28 @@ func (wh *gatewayValidationWebhook) validate(ctx context.Context, gvk schema.Gro
 		} else {
 			return wh.validateRouteTable(ctx, rawJson, dryRun)
 		}
+	case gwv1.RouteOptionGVK:
+		if isDelete {
+			// references to missing route options only result in warnings, so we don't validate route option deletion
+			break
+		}
+		return wh.validateRouteOption(ctx, rawJson, dryRun)
+	case gwv1.VirtualHostOptionGVK:
+		if isDelete {
+			// references to missing virtual host options only result in warnings, so we don't validate virtual host option deletion
+			break
+		}
+		return wh.validateVirtualHostOption(ctx, rawJson, dryRun)
+	case gwv1.ReferencePolicyGVK:
+		if isDelete {
+			return validation.ProxyReports{}, &multierror.Error{Errors: []error{wh.validator.ValidateDeleteReferencePolicy(ctx, ref, dryRun)}}
+		}
+		return wh.validateReferencePolicy(ctx, rawJson, dryRun)
+	case gwv1.MatchableHttpGatewayGVK:
+		if isDelete {
+			return validation.ProxyReports{}, &multierror.Error{Errors: []error{wh.validator.ValidateDeleteMatchableHttpGateway(ctx, ref, dryRun)}}
+		}
+		return wh.validateMatchableHttpGateway(ctx, rawJson, dryRun)
 	}
 	return validation.ProxyReports{}, nil
 
@@ -482,3 +504,75 @@ func (wh *gatewayValidationWebhook) validateRouteTable(ctx context.Context, rawJ
 	}
 	return proxyReports, nil
 }
+
+func (wh *gatewayValidationWebhook) validateRouteOption(ctx context.Context, rawJson []byte, dryRun bool) (validation.ProxyReports, *multierror.Error) {
+	var (
+		rto          gwv1.RouteOption
+		proxyReports validation.ProxyReports
+		err          error
+	)
+	if err := protoutils.UnmarshalResource(rawJson, &rto); err != nil {
+		return nil, &multierror.Error{Errors: []error{WrappedUnmarshalErr(err)}}
+	}
+	if skipValidationCheck(rto.Metadata.Annotations) {
+		return nil, nil
+	}
+	if proxyReports, err = wh.validator.ValidateRouteOption(ctx, &rto, dryRun); err != nil {
+		return proxyReports, &multierror.Error{Errors: []error{errors.Wrapf(err, "Validating %T failed", rto)}}
+	}
+	return proxyReports, nil
+}
+
+func (wh *gatewayValidationWebhook) validateVirtualHostOption(ctx context.Context, rawJson []byte, dryRun bool) (validation.ProxyReports, *multierror.Error) {
+	var (
+		vho          gwv1.VirtualHostOption
+		proxyReports validation.ProxyReports
+		err          error
+	)
+	if err := protoutils.UnmarshalResource(rawJson, &vho); err != nil {
+		return nil, &multierror.Error{Errors: []error{WrappedUnmarshalErr(err)}}
+	}
+	if skipValidationCheck(vho.Metadata.Annotations) {
+		return nil, nil
+	}
+	if proxyReports, err = wh.validator.ValidateVirtualHostOption(ctx, &vho, dryRun); err != nil {
+		return proxyReports, &multierror.Error{Errors: []error{errors.Wrapf(err, "Validating %T failed", vho)}}
+	}
+	return proxyReports, nil
+}
+
+func (wh *gatewayValidationWebhook) validateReferencePolicy(ctx context.Context, rawJson []byte, dryRun bool) (validation.ProxyReports, *multierror.Error) {
+	var (
+		policy       gwv1.ReferencePolicy
+		proxyReports validation.ProxyReports
+		err          error
+	)
+	if err := protoutils.UnmarshalResource(rawJson, &policy); err != nil {
+		return nil, &multierror.Error{Errors: []error{WrappedUnmarshalErr(err)}}
+	}
+	if skipValidationCheck(policy.Metadata.Annotations) {
+		return nil, nil
+	}
+	if proxyReports, err = wh.validator.ValidateReferencePolicy(ctx, &policy, dryRun); err != nil {
+		return proxyReports, &multierror.Error{Errors: []error{errors.Wrapf(err, "Validating %T failed", policy)}}
+	}
+	return proxyReports, nil
+}
+
+func (wh *gatewayValidationWebhook) validateMatchableHttpGateway(ctx context.Context, rawJson []byte, dryRun bool) (validation.ProxyReports, *multierror.Error) {
+	var (
+		hgw          gwv1.MatchableHttpGateway
+		proxyReports validation.ProxyReports
+		err          error
+	)
+	if err := protoutils.UnmarshalResource(rawJson, &hgw); err != nil {
+		return nil, &multierror.Error{Errors: []error{WrappedUnmarshalErr(err)}}
+	}
+	if skipValidationCheck(hgw.Metadata.Annotations) {
+		return nil, nil
+	}
+	if proxyReports, err = wh.validator.ValidateMatchableHttpGateway(ctx, &hgw, dryRun); err != nil {
+		return proxyReports, &multierror.Error{Errors: []error{errors.Wrapf(err, "Validating %T failed", hgw)}}
+	}
+	return proxyReports, nil
+}
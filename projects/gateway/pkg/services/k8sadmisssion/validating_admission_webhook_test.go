@@ -58,6 +58,10 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 	}
 
 	routeTable := &v1.RouteTable{Metadata: core.Metadata{Namespace: "namespace", Name: "rt"}}
+	routeOption := &v1.RouteOption{Metadata: core.Metadata{Namespace: "namespace", Name: "rto"}}
+	virtualHostOption := &v1.VirtualHostOption{Metadata: core.Metadata{Namespace: "namespace", Name: "vho"}}
+	referencePolicy := &v1.ReferencePolicy{Metadata: core.Metadata{Namespace: "namespace", Name: "refpol"}}
+	matchableHttpGateway := &v1.MatchableHttpGateway{Metadata: core.Metadata{Namespace: "namespace", Name: "hgw"}}
 
 	errMsg := "didn't say the magic word"
 
@@ -78,6 +82,18 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 			mv.fValidateRouteTable = func(ctx context.Context, rt *v1.RouteTable, dryRun bool) (validation.ProxyReports, error) {
 				return proxyReports(), fmt.Errorf(errMsg)
 			}
+			mv.fValidateRouteOption = func(ctx context.Context, rto *v1.RouteOption, dryRun bool) (validation.ProxyReports, error) {
+				return proxyReports(), fmt.Errorf(errMsg)
+			}
+			mv.fValidateVirtualHostOption = func(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (validation.ProxyReports, error) {
+				return proxyReports(), fmt.Errorf(errMsg)
+			}
+			mv.fValidateReferencePolicy = func(ctx context.Context, policy *v1.ReferencePolicy, dryRun bool) (validation.ProxyReports, error) {
+				return proxyReports(), fmt.Errorf(errMsg)
+			}
+			mv.fValidateMatchableHttpGateway = func(ctx context.Context, hgw *v1.MatchableHttpGateway, dryRun bool) (validation.ProxyReports, error) {
+				return proxyReports(), fmt.Errorf(errMsg)
+			}
 		}
 		req, err := makeReviewRequest(srv.URL, crd, gvk, v1beta1.Create, resource)
 
@@ -104,6 +120,14 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 		Entry("invalid virtual service", false, v1.VirtualServiceCrd, v1.VirtualServiceCrd.GroupVersionKind(), vs),
 		Entry("valid route table", true, v1.RouteTableCrd, v1.RouteTableCrd.GroupVersionKind(), routeTable),
 		Entry("invalid route table", false, v1.RouteTableCrd, v1.RouteTableCrd.GroupVersionKind(), routeTable),
+		Entry("valid route option", true, v1.RouteOptionCrd, v1.RouteOptionCrd.GroupVersionKind(), routeOption),
+		Entry("invalid route option", false, v1.RouteOptionCrd, v1.RouteOptionCrd.GroupVersionKind(), routeOption),
+		Entry("valid virtual host option", true, v1.VirtualHostOptionCrd, v1.VirtualHostOptionCrd.GroupVersionKind(), virtualHostOption),
+		Entry("invalid virtual host option", false, v1.VirtualHostOptionCrd, v1.VirtualHostOptionCrd.GroupVersionKind(), virtualHostOption),
+		Entry("valid reference policy", true, v1.ReferencePolicyCrd, v1.ReferencePolicyCrd.GroupVersionKind(), referencePolicy),
+		Entry("invalid reference policy", false, v1.ReferencePolicyCrd, v1.ReferencePolicyCrd.GroupVersionKind(), referencePolicy),
+		Entry("valid matchable http gateway", true, v1.MatchableHttpGatewayCrd, v1.MatchableHttpGatewayCrd.GroupVersionKind(), matchableHttpGateway),
+		Entry("invalid matchable http gateway", false, v1.MatchableHttpGatewayCrd, v1.MatchableHttpGatewayCrd.GroupVersionKind(), matchableHttpGateway),
 		Entry("valid unstructured list", true, nil, ListGVK, unstructuredList),
 		Entry("invalid unstructured list", false, nil, ListGVK, unstructuredList),
 	)
@@ -140,6 +164,27 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 		})
 	})
 
+	It("validates the deletion of matchable http gateways", func() {
+		var deleted core.ResourceRef
+		mv.fValidateDeleteMatchableHttpGateway = func(ctx context.Context, hgw core.ResourceRef, dryRun bool) error {
+			deleted = hgw
+			return fmt.Errorf(errMsg)
+		}
+
+		req, err := makeReviewRequest(srv.URL, v1.MatchableHttpGatewayCrd, v1.MatchableHttpGatewayGVK, v1beta1.Delete, matchableHttpGateway)
+		Expect(err).NotTo(HaveOccurred())
+
+		res, err := srv.Client().Do(req)
+		Expect(err).NotTo(HaveOccurred())
+
+		review, err := parseReviewResponse(res)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(review.Response).NotTo(BeNil())
+		Expect(review.Response.Allowed).To(BeFalse())
+		Expect(review.Response.Result.Message).To(ContainSubstring(errMsg))
+		Expect(deleted).To(Equal(matchableHttpGateway.Metadata.Ref()))
+	})
+
 	Context("returns proxies", func() {
 		It("returns proxy if requested", func() {
 			mv.fValidateGateway = func(ctx context.Context, gw *v1.Gateway, dryRun bool) (validation.ProxyReports, error) {
@@ -299,13 +344,19 @@ func parseReviewResponse(resp *http.Response) (*AdmissionReviewWithProxies, erro
 }
 
 type mockValidator struct {
-	fSync                         func(context.Context, *v1.ApiSnapshot) error
-	fValidateList                 func(ctx context.Context, ul *unstructured.UnstructuredList, dryRun bool) (validation.ProxyReports, *multierror.Error)
-	fValidateGateway              func(ctx context.Context, gw *v1.Gateway, dryRun bool) (validation.ProxyReports, error)
-	fValidateVirtualService       func(ctx context.Context, vs *v1.VirtualService, dryRun bool) (validation.ProxyReports, error)
-	fValidateDeleteVirtualService func(ctx context.Context, vs core.ResourceRef, dryRun bool) error
-	fValidateRouteTable           func(ctx context.Context, rt *v1.RouteTable, dryRun bool) (validation.ProxyReports, error)
-	fValidateDeleteRouteTable     func(ctx context.Context, rt core.ResourceRef, dryRun bool) error
+	fSync                               func(context.Context, *v1.ApiSnapshot) error
+	fValidateList                       func(ctx context.Context, ul *unstructured.UnstructuredList, dryRun bool) (validation.ProxyReports, *multierror.Error)
+	fValidateGateway                    func(ctx context.Context, gw *v1.Gateway, dryRun bool) (validation.ProxyReports, error)
+	fValidateVirtualService             func(ctx context.Context, vs *v1.VirtualService, dryRun bool) (validation.ProxyReports, error)
+	fValidateDeleteVirtualService       func(ctx context.Context, vs core.ResourceRef, dryRun bool) error
+	fValidateRouteTable                 func(ctx context.Context, rt *v1.RouteTable, dryRun bool) (validation.ProxyReports, error)
+	fValidateDeleteRouteTable           func(ctx context.Context, rt core.ResourceRef, dryRun bool) error
+	fValidateRouteOption                func(ctx context.Context, rto *v1.RouteOption, dryRun bool) (validation.ProxyReports, error)
+	fValidateVirtualHostOption          func(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (validation.ProxyReports, error)
+	fValidateReferencePolicy            func(ctx context.Context, policy *v1.ReferencePolicy, dryRun bool) (validation.ProxyReports, error)
+	fValidateDeleteReferencePolicy      func(ctx context.Context, policy core.ResourceRef, dryRun bool) error
+	fValidateMatchableHttpGateway       func(ctx context.Context, hgw *v1.MatchableHttpGateway, dryRun bool) (validation.ProxyReports, error)
+	fValidateDeleteMatchableHttpGateway func(ctx context.Context, hgw core.ResourceRef, dryRun bool) error
 }
 
 func (v *mockValidator) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
@@ -357,6 +408,48 @@ func (v *mockValidator) ValidateDeleteRouteTable(ctx context.Context, rt core.Re
 	return v.fValidateDeleteRouteTable(ctx, rt, dryRun)
 }
 
+func (v *mockValidator) ValidateRouteOption(ctx context.Context, rto *v1.RouteOption, dryRun bool) (validation.ProxyReports, error) {
+	if v.fValidateRouteOption == nil {
+		return proxyReports(), nil
+	}
+	return v.fValidateRouteOption(ctx, rto, dryRun)
+}
+
+func (v *mockValidator) ValidateVirtualHostOption(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (validation.ProxyReports, error) {
+	if v.fValidateVirtualHostOption == nil {
+		return proxyReports(), nil
+	}
+	return v.fValidateVirtualHostOption(ctx, vho, dryRun)
+}
+
+func (v *mockValidator) ValidateReferencePolicy(ctx context.Context, policy *v1.ReferencePolicy, dryRun bool) (validation.ProxyReports, error) {
+	if v.fValidateReferencePolicy == nil {
+		return proxyReports(), nil
+	}
+	return v.fValidateReferencePolicy(ctx, policy, dryRun)
+}
+
+func (v *mockValidator) ValidateDeleteReferencePolicy(ctx context.Context, policy core.ResourceRef, dryRun bool) error {
+	if v.fValidateDeleteReferencePolicy == nil {
+		return nil
+	}
+	return v.fValidateDeleteReferencePolicy(ctx, policy, dryRun)
+}
+
+func (v *mockValidator) ValidateMatchableHttpGateway(ctx context.Context, hgw *v1.MatchableHttpGateway, dryRun bool) (validation.ProxyReports, error) {
+	if v.fValidateMatchableHttpGateway == nil {
+		return proxyReports(), nil
+	}
+	return v.fValidateMatchableHttpGateway(ctx, hgw, dryRun)
+}
+
+func (v *mockValidator) ValidateDeleteMatchableHttpGateway(ctx context.Context, hgw core.ResourceRef, dryRun bool) error {
+	if v.fValidateDeleteMatchableHttpGateway == nil {
+		return nil
+	}
+	return v.fValidateDeleteMatchableHttpGateway(ctx, hgw, dryRun)
+}
+
 func proxyReports() validation.ProxyReports {
 	return validation.ProxyReports{
 		{
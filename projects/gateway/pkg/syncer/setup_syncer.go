@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/solo-io/gloo/projects/gateway/pkg/canary"
 	"github.com/solo-io/gloo/projects/gateway/pkg/reconciler"
 
 	"go.uber.org/zap"
@@ -31,6 +32,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
 
@@ -77,6 +79,36 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		return err
 	}
 
+	routeOptionFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.RouteOptionCrd)
+	if err != nil {
+		return err
+	}
+
+	virtualHostOptionFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.VirtualHostOptionCrd)
+	if err != nil {
+		return err
+	}
+
+	matchableHttpGatewayFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.MatchableHttpGatewayCrd)
+	if err != nil {
+		return err
+	}
+
+	referencePolicyFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.ReferencePolicyCrd)
+	if err != nil {
+		return err
+	}
+
+	canaryFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.CanaryCrd)
+	if err != nil {
+		return err
+	}
+
+	upstreamGroupFactory, err := bootstrap.ConfigFactoryForSettings(params, gloov1.UpstreamGroupCrd)
+	if err != nil {
+		return err
+	}
+
 	refreshRate, err := types.DurationFromProto(settings.RefreshRate)
 	if err != nil {
 		return err
@@ -88,6 +120,16 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 	}
 	watchNamespaces := utils.ProcessWatchNamespaces(settings.WatchNamespaces, writeNamespace)
 
+	// The canary controller elects a leader among the gateway replicas with a lease when the config is stored in
+	// Kubernetes. With other config sources, a single gateway replica is expected to run.
+	var kubeClient kubernetes.Interface
+	if settings.GetKubernetesConfigSource() != nil && cfg != nil {
+		kubeClient, err = kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return err
+		}
+	}
+
 	var validation *translator.ValidationOpts
 	validationCfg := settings.GetGateway().GetValidation()
 	if validationCfg != nil {
@@ -132,19 +174,27 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 	}
 
 	opts := translator.Opts{
-		GlooNamespace:   settings.Metadata.Namespace,
-		WriteNamespace:  writeNamespace,
-		WatchNamespaces: watchNamespaces,
-		Gateways:        gatewayFactory,
-		VirtualServices: virtualServiceFactory,
-		RouteTables:     routeTableFactory,
-		Proxies:         proxyFactory,
+		GlooNamespace:         settings.Metadata.Namespace,
+		WriteNamespace:        writeNamespace,
+		WatchNamespaces:       watchNamespaces,
+		Gateways:              gatewayFactory,
+		VirtualServices:       virtualServiceFactory,
+		RouteTables:           routeTableFactory,
+		RouteOptions:          routeOptionFactory,
+		VirtualHostOptions:    virtualHostOptionFactory,
+		MatchableHttpGateways: matchableHttpGatewayFactory,
+		ReferencePolicies:     referencePolicyFactory,
+		Canaries:              canaryFactory,
+		UpstreamGroups:        upstreamGroupFactory,
+		Proxies:               proxyFactory,
+		KubeClient:            kubeClient,
 		WatchOpts: clients.WatchOpts{
 			Ctx:         ctx,
 			RefreshRate: refreshRate,
 		},
 		DevMode:                       true,
 		ReadGatewaysFromAllNamespaces: settings.GetGateway().GetReadGatewaysFromAllNamespaces(),
+		DenyCrossNamespaceReferences:  settings.GetGateway().GetDenyCrossNamespaceReferences(),
 		Validation:                    validation,
 	}
 
@@ -181,6 +231,38 @@ func RunGateway(opts translator.Opts) error {
 		return err
 	}
 
+	routeOptionClient, err := v1.NewRouteOptionClient(opts.RouteOptions)
+	if err != nil {
+		return err
+	}
+	if err := routeOptionClient.Register(); err != nil {
+		return err
+	}
+
+	virtualHostOptionClient, err := v1.NewVirtualHostOptionClient(opts.VirtualHostOptions)
+	if err != nil {
+		return err
+	}
+	if err := virtualHostOptionClient.Register(); err != nil {
+		return err
+	}
+
+	matchableHttpGatewayClient, err := v1.NewMatchableHttpGatewayClient(opts.MatchableHttpGateways)
+	if err != nil {
+		return err
+	}
+	if err := matchableHttpGatewayClient.Register(); err != nil {
+		return err
+	}
+
+	referencePolicyClient, err := v1.NewReferencePolicyClient(opts.ReferencePolicies)
+	if err != nil {
+		return err
+	}
+	if err := referencePolicyClient.Register(); err != nil {
+		return err
+	}
+
 	proxyClient, err := gloov1.NewProxyClient(opts.Proxies)
 	if err != nil {
 		return err
@@ -189,7 +271,25 @@ func RunGateway(opts translator.Opts) error {
 		return err
 	}
 
-	rpt := reporter.NewReporter("gateway", gatewayClient.BaseClient(), virtualServiceClient.BaseClient(), routeTableClient.BaseClient())
+	canaryClient, err := v1.NewCanaryClient(opts.Canaries)
+	if err != nil {
+		return err
+	}
+	if err := canaryClient.Register(); err != nil {
+		return err
+	}
+
+	upstreamGroupClient, err := gloov1.NewUpstreamGroupClient(opts.UpstreamGroups)
+	if err != nil {
+		return err
+	}
+	if err := upstreamGroupClient.Register(); err != nil {
+		return err
+	}
+
+	rpt := reporter.NewReporter("gateway", gatewayClient.BaseClient(), virtualServiceClient.BaseClient(), routeTableClient.BaseClient(),
+		routeOptionClient.BaseClient(), virtualHostOptionClient.BaseClient(), matchableHttpGatewayClient.BaseClient(),
+		referencePolicyClient.BaseClient())
 	writeErrs := make(chan error)
 
 	txlator := translator.NewDefaultTranslator(opts)
@@ -223,7 +323,7 @@ func RunGateway(opts translator.Opts) error {
 		allowWarnings = opts.Validation.AllowWarnings
 	}
 
-	emitter := v1.NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, notifications)
+	emitter := v1.NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, routeOptionClient, virtualHostOptionClient, matchableHttpGatewayClient, referencePolicyClient, notifications)
 
 	validationSyncer := gatewayvalidation.NewValidator(gatewayvalidation.NewValidatorConfig(
 		txlator,
@@ -255,6 +355,19 @@ func RunGateway(opts translator.Opts) error {
 	}
 	go errutils.AggregateErrs(ctx, writeErrs, eventLoopErrs, "event_loop")
 
+	canaryController := canary.NewController(
+		canaryClient,
+		upstreamGroupClient,
+		canary.NewEnvoyStatsSource(&http.Client{Timeout: canary.StatsRequestTimeout}),
+		opts.WatchNamespaces,
+		time.Now,
+	)
+	if opts.KubeClient != nil {
+		go canaryController.RunWithLeaderElection(ctx, opts.KubeClient, opts.WriteNamespace, canary.ResyncPeriod)
+	} else {
+		go canaryController.Run(ctx, canary.ResyncPeriod)
+	}
+
 	logger := contextutils.LoggerFrom(ctx)
 
 	go func() {
@@ -56,6 +56,8 @@ func setObjMeta(obj ObjectWithMetadata, meta *SourceMetadata) error {
 		obj.Metadata = metaStruct
 	case *v1.Listener:
 		obj.Metadata = metaStruct
+	case *v1.MatchedListener:
+		obj.Metadata = metaStruct
 	default:
 		return errors.Errorf("unimplemented object type: %T", obj)
 	}
@@ -33,6 +33,10 @@ var (
 	InvalidHeaderErr     = errors.New("invalid route: route table matchers must have all headers that were specified on their parent route's matcher")
 	InvalidQueryParamErr = errors.New("invalid route: route table matchers must have all query params that were specified on their parent route's matcher")
 	InvalidMethodErr     = errors.New("invalid route: route table matchers must have all methods that were specified on their parent route's matcher")
+	MissingWeightErr     = errors.New("invalid route: weighted delegations must have a destination with a positive weight")
+
+	NestedWeightedDelegationErr     = errors.New("invalid route: routes delegated to by a weighted delegation may not delegate with weights")
+	WeightedDelegationPercentageErr = errors.New("invalid route: routes delegated to by a weighted delegation may not match a percentage of the requests")
 
 	DelegationCycleErr = func(cycleInfo string) error {
 		return errors.Errorf("invalid route: delegation cycle detected: %s", cycleInfo)
@@ -60,10 +64,12 @@ type RouteConverter interface {
 	ConvertVirtualService(virtualService *gatewayv1.VirtualService, reports reporter.ResourceReports) ([]*gloov1.Route, error)
 }
 
-func NewRouteConverter(selector RouteTableSelector, indexer RouteTableIndexer) RouteConverter {
+func NewRouteConverter(selector RouteTableSelector, indexer RouteTableIndexer, optionsSelector OptionsSelector, referencePolicies ReferencePolicyChecker) RouteConverter {
 	return &routeVisitor{
 		routeTableSelector: selector,
 		routeTableIndexer:  indexer,
+		optionsSelector:    optionsSelector,
+		referencePolicies:  referencePolicies,
 	}
 }
 
@@ -99,6 +105,10 @@ type routeVisitor struct {
 	routeTableSelector RouteTableSelector
 	// Used to sort route tables when multiple ones are matched by a selector.
 	routeTableIndexer RouteTableIndexer
+	// Used to select the route options referenced by routes.
+	optionsSelector OptionsSelector
+	// Used to check the cross-namespace references to route tables and upstreams.
+	referencePolicies ReferencePolicyChecker
 }
 
 // Helper object used to store information about previously visited routes.
@@ -113,6 +123,10 @@ type routeInfo struct {
 	hasName bool
 	// Whether any child route objects should inherit headers, methods, and query param matchers from the parent.
 	inheritableMatchers bool
+	// The indices of the route options fields that child routes may not override.
+	lockedOptions sets.Int
+	// Is true if any route on the current route tree branch delegates with weights.
+	weighted bool
 }
 
 // Helper object for reporting errors and warnings
@@ -171,6 +185,23 @@ func (rv *routeVisitor) visit(
 		name, routeHasName := routeName(resource.InputResource(), routeClone, parentRoute)
 		routeClone.Name = name
 
+		// Merge the options of the referenced route options. The options of the route itself take precedence.
+		if refs := routeClone.GetOptionsConfigRefs(); refs != nil {
+			routeOptions, err := rv.optionsSelector.SelectRouteOptions(refs, resource.InputResource().GetMetadata().Namespace)
+			if err != nil {
+				reporterHelper.addWarning(resource.InputResource(), err)
+			}
+			for _, routeOption := range routeOptions {
+				merged, err := mergeRoutePlugins(routeClone.GetOptions(), routeOption.GetOptions())
+				if err != nil {
+					// Should never happen
+					return nil, errors.Wrapf(err, "internal error: merging route options into route")
+				}
+				routeClone.Options = merged
+			}
+			routeClone.OptionsConfigRefs = nil
+		}
+
 		// If the parent route is not nil, this route has been delegated to and we need to perform additional operations
 		if parentRoute != nil {
 			var err error
@@ -191,72 +222,55 @@ func (rv *routeVisitor) visit(
 				continue
 			}
 
-			// Determine the route tables to delegate to
-			routeTables, err := rv.routeTableSelector.SelectRouteTables(action.DelegateAction, resource.InputResource().GetMetadata().Namespace)
+			// Determine the options inherited by the delegated routes
+			var parentLockedOptions sets.Int
+			if parentRoute != nil {
+				parentLockedOptions = parentRoute.lockedOptions
+			}
+			inheritedOptions, lockedOptions, err := inheritRouteOptions(routeClone.GetOptions(), action.DelegateAction.GetOptionsInheritance(), parentLockedOptions)
 			if err != nil {
-				reporterHelper.addWarning(resource.InputResource(), err)
+				reporterHelper.addError(resource.InputResource(), err)
 				continue
 			}
 
-			// Default missing weights to 0
-			for _, routeTable := range routeTables {
-				if routeTable.GetWeight() == nil {
-					routeTable.Weight = &types.Int32Value{Value: defaultTableWeight}
-				}
+			// Collect information about this route that are relevant when visiting the delegated route tables
+			currentRouteInfo := &routeInfo{
+				matcher:             delegateMatcher,
+				options:             inheritedOptions,
+				name:                name,
+				hasName:             routeHasName,
+				inheritableMatchers: routeClone.InheritableMatchers.GetValue(),
+				lockedOptions:       lockedOptions,
+				weighted:            parentRoute != nil && parentRoute.weighted,
 			}
 
-			routeTablesByWeight, sortedWeights := rv.routeTableIndexer.IndexByWeight(routeTables)
-
-			// Process the route tables in order by weight
-			for _, weight := range sortedWeights {
-				routeTablesForWeight := routeTablesByWeight[weight]
-
-				var rtRoutesForWeight []*gloov1.Route
-				for _, routeTable := range routeTablesForWeight {
-
-					// Check for delegation cycles
-					if err := checkForCycles(routeTable, visitedRouteTables); err != nil {
-						// Note that we do not report the error on the table we are currently visiting, but on the
-						// one we are about to visit, since that is the one that started the cycle.
-						reporterHelper.addError(routeTable, err)
-						continue
-					}
-
-					// Collect information about this route that are relevant when visiting the delegated route table
-					currentRouteInfo := &routeInfo{
-						matcher:             delegateMatcher,
-						options:             routeClone.Options,
-						name:                name,
-						hasName:             routeHasName,
-						inheritableMatchers: routeClone.InheritableMatchers.GetValue(),
-					}
-
-					// Make a copy of the existing set of visited route tables. We need to pass this information into
-					// the recursive call and we do NOT want the original slice to be modified.
-					visitedRtCopy := append(append([]*gatewayv1.RouteTable{}, visitedRouteTables...), routeTable)
-
-					// Recursive call
-					subRoutes, err := rv.visit(
-						&visitableRouteTable{routeTable},
-						currentRouteInfo,
-						visitedRtCopy,
-						reporterHelper,
-					)
-					if err != nil {
-						return nil, err
-					}
-
-					rtRoutesForWeight = append(rtRoutesForWeight, subRoutes...)
+			if weighted := action.DelegateAction.GetWeighted(); weighted != nil {
+				if currentRouteInfo.weighted {
+					reporterHelper.addError(resource.InputResource(), NestedWeightedDelegationErr)
+					continue
 				}
+				currentRouteInfo.weighted = true
 
-				// If we have multiple route tables with this weight, we want to try and sort the resulting routes in
-				// order to protect against short-circuiting, e.g. we want to avoid `/foo` coming before `/foo/bar`.
-				if len(routeTablesForWeight) > 1 {
-					glooutils.SortRoutesByPath(rtRoutesForWeight)
+				weightedRoutes, err := rv.visitWeightedRouteTables(resource.InputResource(), weighted, currentRouteInfo, visitedRouteTables, reporterHelper)
+				if err != nil {
+					return nil, err
 				}
+				routes = append(routes, weightedRoutes...)
+				continue
+			}
 
-				routes = append(routes, rtRoutesForWeight...)
+			// Determine the route tables to delegate to
+			routeTables, err := rv.routeTableSelector.SelectRouteTables(action.DelegateAction, resource.InputResource().GetMetadata().Namespace)
+			if err != nil {
+				reporterHelper.addWarning(resource.InputResource(), err)
+				continue
+			}
+
+			delegatedRoutes, err := rv.visitRouteTables(resource.InputResource(), routeTables, currentRouteInfo, visitedRouteTables, reporterHelper)
+			if err != nil {
+				return nil, err
 			}
+			routes = append(routes, delegatedRoutes...)
 
 		default:
 
@@ -278,6 +292,10 @@ func (rv *routeVisitor) visit(
 						}
 					}
 				}
+				if err := rv.checkUpstreamReferences(resource.InputResource(), action.RouteAction); err != nil {
+					reporterHelper.addError(resource.InputResource(), err)
+					continue
+				}
 			}
 			glooRoute, err := convertSimpleAction(routeClone)
 			if err != nil {
@@ -299,6 +317,180 @@ func (rv *routeVisitor) visit(
 	return routes, nil
 }
 
+// Visits the route tables delegated to by a route, in order by weight.
+func (rv *routeVisitor) visitRouteTables(
+	resource resources.InputResource,
+	routeTables gatewayv1.RouteTableList,
+	currentRouteInfo *routeInfo,
+	visitedRouteTables gatewayv1.RouteTableList,
+	reporterHelper *reporterHelper,
+) ([]*gloov1.Route, error) {
+	var routes []*gloov1.Route
+
+	// Only delegate to the route tables the reference policies allow
+	routeTables = rv.allowedRouteTables(resource, routeTables, reporterHelper)
+
+	// Default missing weights to 0
+	for _, routeTable := range routeTables {
+		if routeTable.GetWeight() == nil {
+			routeTable.Weight = &types.Int32Value{Value: defaultTableWeight}
+		}
+	}
+
+	routeTablesByWeight, sortedWeights := rv.routeTableIndexer.IndexByWeight(routeTables)
+
+	// Process the route tables in order by weight
+	for _, weight := range sortedWeights {
+		routeTablesForWeight := routeTablesByWeight[weight]
+
+		var rtRoutesForWeight []*gloov1.Route
+		for _, routeTable := range routeTablesForWeight {
+
+			// Check for delegation cycles
+			if err := checkForCycles(routeTable, visitedRouteTables); err != nil {
+				// Note that we do not report the error on the table we are currently visiting, but on the
+				// one we are about to visit, since that is the one that started the cycle.
+				reporterHelper.addError(routeTable, err)
+				continue
+			}
+
+			// Make a copy of the existing set of visited route tables. We need to pass this information into
+			// the recursive call and we do NOT want the original slice to be modified.
+			visitedRtCopy := append(append([]*gatewayv1.RouteTable{}, visitedRouteTables...), routeTable)
+
+			// Recursive call
+			subRoutes, err := rv.visit(
+				&visitableRouteTable{routeTable},
+				currentRouteInfo,
+				visitedRtCopy,
+				reporterHelper,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			rtRoutesForWeight = append(rtRoutesForWeight, subRoutes...)
+		}
+
+		// If we have multiple route tables with this weight, we want to try and sort the resulting routes in
+		// order to protect against short-circuiting, e.g. we want to avoid `/foo` coming before `/foo/bar`.
+		if len(routeTablesForWeight) > 1 {
+			glooutils.SortRoutesByPath(rtRoutesForWeight)
+		}
+
+		routes = append(routes, rtRoutesForWeight...)
+	}
+
+	return routes, nil
+}
+
+// Visits the destinations of a weighted delegation, and restricts the routes of each destination to its share of the
+// requests. Envoy evaluates all the routes with the same random value per request, so the routes of each destination
+// match the cumulative percentage of the destinations up to it, and the routes of the last one match all the requests.
+func (rv *routeVisitor) visitWeightedRouteTables(
+	resource resources.InputResource,
+	weighted *gatewayv1.WeightedRouteTables,
+	currentRouteInfo *routeInfo,
+	visitedRouteTables gatewayv1.RouteTableList,
+	reporterHelper *reporterHelper,
+) ([]*gloov1.Route, error) {
+	var totalWeight uint32
+	for _, destination := range weighted.GetDestinations() {
+		totalWeight += destination.GetWeight()
+	}
+	if totalWeight == 0 {
+		reporterHelper.addError(resource, MissingWeightErr)
+		return nil, nil
+	}
+
+	var (
+		routesByDestination [][]*gloov1.Route
+		percentages         []float32
+		cumulativeWeight    uint32
+	)
+	for _, destination := range weighted.GetDestinations() {
+		if destination.GetWeight() == 0 {
+			continue
+		}
+		cumulativeWeight += destination.GetWeight()
+
+		routeTables, err := rv.routeTableSelector.SelectRouteTables(weightedDestinationAction(destination), resource.GetMetadata().Namespace)
+		if err != nil {
+			reporterHelper.addWarning(resource, err)
+			continue
+		}
+
+		destinationRoutes, err := rv.visitRouteTables(resource, routeTables, currentRouteInfo, visitedRouteTables, reporterHelper)
+		if err != nil {
+			return nil, err
+		}
+		if len(destinationRoutes) == 0 {
+			continue
+		}
+		routesByDestination = append(routesByDestination, destinationRoutes)
+		percentages = append(percentages, float32(100*float64(cumulativeWeight)/float64(totalWeight)))
+	}
+
+	var routes []*gloov1.Route
+	for i, destinationRoutes := range routesByDestination {
+		// The routes of the last destination match the remaining requests, including the share of missing destinations
+		if i < len(routesByDestination)-1 {
+			for _, route := range destinationRoutes {
+				for _, matcher := range route.GetMatchers() {
+					matcher.Percentage = &types.FloatValue{Value: percentages[i]}
+				}
+			}
+		}
+		routes = append(routes, destinationRoutes...)
+	}
+	return routes, nil
+}
+
+// Returns a delegate action selecting the route tables of a weighted destination.
+func weightedDestinationAction(destination *gatewayv1.WeightedRouteTables_Destination) *gatewayv1.DelegateAction {
+	action := &gatewayv1.DelegateAction{}
+	switch delegationType := destination.GetDelegationType().(type) {
+	case *gatewayv1.WeightedRouteTables_Destination_Ref:
+		action.DelegationType = &gatewayv1.DelegateAction_Ref{Ref: delegationType.Ref}
+	case *gatewayv1.WeightedRouteTables_Destination_Selector:
+		action.DelegationType = &gatewayv1.DelegateAction_Selector{Selector: delegationType.Selector}
+	}
+	return action
+}
+
+func (rv *routeVisitor) allowedRouteTables(resource resources.InputResource, routeTables gatewayv1.RouteTableList, reporterHelper *reporterHelper) gatewayv1.RouteTableList {
+	var allowed gatewayv1.RouteTableList
+	for _, routeTable := range routeTables {
+		if err := rv.referencePolicies.CheckReference(resource, RouteTableKind, routeTable.GetMetadata().Ref()); err != nil {
+			reporterHelper.addError(resource, err)
+			continue
+		}
+		allowed = append(allowed, routeTable)
+	}
+	return allowed
+}
+
+func (rv *routeVisitor) checkUpstreamReferences(resource resources.InputResource, action *gloov1.RouteAction) error {
+	if upstream := action.GetSingle().GetUpstream(); upstream != nil {
+		if err := rv.referencePolicies.CheckReference(resource, UpstreamKind, *upstream); err != nil {
+			return err
+		}
+	}
+	for _, dest := range action.GetMulti().GetDestinations() {
+		if upstream := dest.GetDestination().GetUpstream(); upstream != nil {
+			if err := rv.referencePolicies.CheckReference(resource, UpstreamKind, *upstream); err != nil {
+				return err
+			}
+		}
+	}
+	if upstreamGroup := action.GetUpstreamGroup(); upstreamGroup != nil {
+		if err := rv.referencePolicies.CheckReference(resource, UpstreamGroupKind, *upstreamGroup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Returns the name of the route and a flag that is true if either the route or the parent route are explicitly named.
 // Route names have the following format: "vs:myvirtualservice_route:myfirstroute_rt:myroutetable_route:<unnamed>"
 func routeName(resource resources.InputResource, route *gatewayv1.Route, parentRouteInfo *routeInfo) (string, bool) {
@@ -408,10 +600,16 @@ func validateAndMergeParentRoute(child *gatewayv1.Route, parent *routeInfo) (*ga
 
 	// inherit route table config from parent
 	if parent.inheritableMatchers {
+		// routes without matchers use the default matcher, which must inherit the matchers of the parent as well
+		if len(child.Matchers) == 0 {
+			child.Matchers = []*matchersv1.Matcher{defaults.DefaultMatcher()}
+		}
+		// the matchers of the parent are shared by all its child routes, so limit their capacity to make append copy them
+		parentHeaders, parentMethods, parentQueryParams := parent.matcher.Headers, parent.matcher.Methods, parent.matcher.QueryParameters
 		for _, childMatch := range child.Matchers {
-			childMatch.Headers = append(parent.matcher.Headers, childMatch.Headers...)
-			childMatch.Methods = append(parent.matcher.Methods, childMatch.Methods...)
-			childMatch.QueryParameters = append(parent.matcher.QueryParameters, childMatch.QueryParameters...)
+			childMatch.Headers = append(parentHeaders[:len(parentHeaders):len(parentHeaders)], childMatch.Headers...)
+			childMatch.Methods = append(parentMethods[:len(parentMethods):len(parentMethods)], childMatch.Methods...)
+			childMatch.QueryParameters = append(parentQueryParams[:len(parentQueryParams):len(parentQueryParams)], childMatch.QueryParameters...)
 		}
 	}
 
@@ -420,6 +618,20 @@ func validateAndMergeParentRoute(child *gatewayv1.Route, parent *routeInfo) (*ga
 		return nil, err
 	}
 
+	// The percentages of the routes of weighted delegations are set by the translator
+	if parent.weighted {
+		for _, childMatch := range child.Matchers {
+			if childMatch.GetPercentage() != nil {
+				return nil, WeightedDelegationPercentageErr
+			}
+		}
+	}
+
+	// Verify that the route does not override the options locked by the parent
+	if err := checkLockedRouteOptions(child.GetOptions(), parent.options, parent.lockedOptions); err != nil {
+		return nil, err
+	}
+
 	// Merge plugins from parent routes
 	merged, err := mergeRoutePlugins(child.GetOptions(), parent.options)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
@@ -29,7 +30,7 @@ var _ = Describe("Route converter", func() {
 					Routes: []*v1.Route{route},
 				},
 			}
-			rv := translator.NewRouteConverter(nil, nil)
+			rv := translator.NewRouteConverter(nil, nil, nil, nil)
 			_, err := rv.ConvertVirtualService(vs, reports)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -143,6 +144,8 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
 				translator.NewRouteTableIndexer(),
+				translator.NewOptionsSelector(nil, nil),
+				translator.NewReferencePolicyChecker(nil, false),
 			)
 			converted, err := rv.ConvertVirtualService(vs, rpt)
 			Expect(err).NotTo(HaveOccurred())
@@ -180,6 +183,8 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{}),
 				translator.NewRouteTableIndexer(),
+				translator.NewOptionsSelector(nil, nil),
+				translator.NewReferencePolicyChecker(nil, false),
 			)
 			converted, err := rv.ConvertVirtualService(vs, rpt)
 			Expect(err).NotTo(HaveOccurred())
@@ -223,6 +228,8 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{}),
 				translator.NewRouteTableIndexer(),
+				translator.NewOptionsSelector(nil, nil),
+				translator.NewReferencePolicyChecker(nil, false),
 			)
 			converted, err := rv.ConvertVirtualService(vs, rpt)
 			Expect(err).NotTo(HaveOccurred())
@@ -275,6 +282,8 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
 				translator.NewRouteTableIndexer(),
+				translator.NewOptionsSelector(nil, nil),
+				translator.NewReferencePolicyChecker(nil, false),
 			)
 			converted, err := rv.ConvertVirtualService(vs, rpt)
 
@@ -329,6 +338,8 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
 				translator.NewRouteTableIndexer(),
+				translator.NewOptionsSelector(nil, nil),
+				translator.NewReferencePolicyChecker(nil, false),
 			)
 			converted, err := rv.ConvertVirtualService(vs, rpt)
 
@@ -416,6 +427,8 @@ var _ = Describe("Route converter", func() {
 				rv = translator.NewRouteConverter(
 					translator.NewRouteTableSelector(v1.RouteTableList{rt}),
 					translator.NewRouteTableIndexer(),
+					translator.NewOptionsSelector(nil, nil),
+					translator.NewReferencePolicyChecker(nil, false),
 				)
 			})
 
@@ -499,6 +512,8 @@ var _ = Describe("Route converter", func() {
 				rv = translator.NewRouteConverter(
 					translator.NewRouteTableSelector(v1.RouteTableList{rt, rt2, rt3}),
 					translator.NewRouteTableIndexer(),
+					translator.NewOptionsSelector(nil, nil),
+					translator.NewReferencePolicyChecker(nil, false),
 				)
 
 				expectedHeaders := append(rtOnlyHeaders, vsOnlyHeaders...)
@@ -528,6 +543,19 @@ var _ = Describe("Route converter", func() {
 				))
 			})
 
+			It("adds the headers of the parent to route table routes without matchers", func() {
+				vs.VirtualHost.Routes[0].Matchers[0].PathSpecifier = &matchers.Matcher_Prefix{Prefix: "/"}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rpt).To(HaveLen(0))
+
+				Expect(converted).To(HaveLen(1))
+				Expect(converted[0].Matchers).To(HaveLen(1))
+				Expect(converted[0].Matchers[0].GetPrefix()).To(Equal("/"))
+				Expect(converted[0].Matchers[0].Headers).To(ConsistOf(vsOnlyHeaders))
+			})
 		})
 	})
 
@@ -587,6 +615,8 @@ var _ = Describe("Route converter", func() {
 			rv = translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{rt}),
 				translator.NewRouteTableIndexer(),
+				translator.NewOptionsSelector(nil, nil),
+				translator.NewReferencePolicyChecker(nil, false),
 			)
 		})
 
@@ -1074,6 +1104,8 @@ var _ = Describe("Route converter", func() {
 			visitor = translator.NewRouteConverter(
 				translator.NewRouteTableSelector(allRouteTables),
 				translator.NewRouteTableIndexer(),
+				translator.NewOptionsSelector(nil, nil),
+				translator.NewReferencePolicyChecker(nil, false),
 			)
 		})
 
@@ -1425,6 +1457,527 @@ var _ = Describe("Route converter", func() {
 			})
 		})
 	})
+
+	Describe("route options", func() {
+
+		var (
+			reports reporter.ResourceReports
+			shared  *v1.RouteOption
+		)
+
+		BeforeEach(func() {
+			reports = reporter.ResourceReports{}
+			shared = &v1.RouteOption{
+				Metadata: core.Metadata{Namespace: "ns", Name: "shared"},
+				Options: &gloov1.RouteOptions{
+					PrefixRewrite: &types.StringValue{Value: "/shared"},
+					Retries:       &retries.RetryPolicy{NumRetries: 3},
+				},
+			}
+		})
+
+		directResponseRoute := func(prefix string, options *gloov1.RouteOptions, refs *v1.DelegateOptionsRefs) *v1.Route {
+			return &v1.Route{
+				Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: prefix},
+				}},
+				Action: &v1.Route_DirectResponseAction{
+					DirectResponseAction: &gloov1.DirectResponseAction{Status: 200},
+				},
+				Options:           options,
+				OptionsConfigRefs: refs,
+			}
+		}
+
+		It("merges the referenced options into the options of the route", func() {
+			vs := &v1.VirtualService{
+				Metadata: core.Metadata{Namespace: "ns", Name: "vs"},
+				VirtualHost: &v1.VirtualHost{
+					Routes: []*v1.Route{
+						directResponseRoute("/", &gloov1.RouteOptions{
+							PrefixRewrite: &types.StringValue{Value: "/own"},
+						}, &v1.DelegateOptionsRefs{
+							DelegateOptions: []*core.ResourceRef{{Name: "shared"}},
+						}),
+					},
+				},
+			}
+
+			rv := translator.NewRouteConverter(nil, nil, translator.NewOptionsSelector(v1.RouteOptionList{shared}, nil), translator.NewReferencePolicyChecker(nil, false))
+			converted, err := rv.ConvertVirtualService(vs, reports)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reports.Validate()).NotTo(HaveOccurred())
+
+			Expect(converted).To(HaveLen(1))
+			Expect(converted[0].Options.PrefixRewrite.Value).To(Equal("/own"))
+			Expect(converted[0].Options.Retries.NumRetries).To(Equal(uint32(3)))
+
+			// the route option must not be modified
+			Expect(shared.Options.PrefixRewrite.Value).To(Equal("/shared"))
+		})
+
+		It("prefers the options of delegated routes, including the referenced ones, to the inherited options", func() {
+			rt := &v1.RouteTable{
+				Metadata: core.Metadata{Namespace: "ns", Name: "rt"},
+				Routes: []*v1.Route{
+					directResponseRoute("/foo", nil, &v1.DelegateOptionsRefs{
+						DelegateOptions: []*core.ResourceRef{{Name: "shared"}},
+					}),
+				},
+			}
+			vs := &v1.VirtualService{
+				Metadata: core.Metadata{Namespace: "ns", Name: "vs"},
+				VirtualHost: &v1.VirtualHost{
+					Routes: []*v1.Route{{
+						Matchers: []*matchers.Matcher{{
+							PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo"},
+						}},
+						Action: &v1.Route_DelegateAction{
+							DelegateAction: &v1.DelegateAction{
+								DelegationType: &v1.DelegateAction_Ref{
+									Ref: &core.ResourceRef{Namespace: "ns", Name: "rt"},
+								},
+							},
+						},
+						Options: &gloov1.RouteOptions{
+							PrefixRewrite:   &types.StringValue{Value: "/parent"},
+							HostRewriteType: &gloov1.RouteOptions_HostRewrite{HostRewrite: "parent.com"},
+						},
+					}},
+				},
+			}
+
+			rv := translator.NewRouteConverter(
+				translator.NewRouteTableSelector(v1.RouteTableList{rt}),
+				translator.NewRouteTableIndexer(),
+				translator.NewOptionsSelector(v1.RouteOptionList{shared}, nil),
+				translator.NewReferencePolicyChecker(nil, false),
+			)
+			converted, err := rv.ConvertVirtualService(vs, reports)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reports.Validate()).NotTo(HaveOccurred())
+
+			Expect(converted).To(HaveLen(1))
+			Expect(converted[0].Options.PrefixRewrite.Value).To(Equal("/shared"))
+			Expect(converted[0].Options.GetHostRewrite()).To(Equal("parent.com"))
+			Expect(converted[0].Options.Retries.NumRetries).To(Equal(uint32(3)))
+		})
+
+		It("adds a warning on missing route options", func() {
+			vs := &v1.VirtualService{
+				Metadata: core.Metadata{Namespace: "ns", Name: "vs"},
+				VirtualHost: &v1.VirtualHost{
+					Routes: []*v1.Route{
+						directResponseRoute("/", nil, &v1.DelegateOptionsRefs{
+							DelegateOptions: []*core.ResourceRef{{Name: "missing"}},
+						}),
+					},
+				},
+			}
+
+			rv := translator.NewRouteConverter(nil, nil, translator.NewOptionsSelector(nil, nil), translator.NewReferencePolicyChecker(nil, false))
+			converted, err := rv.ConvertVirtualService(vs, reports)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(converted).To(HaveLen(1))
+
+			_, vsReport := reports.Find("*v1.VirtualService", vs.Metadata.Ref())
+			Expect(vsReport.Warnings).To(ConsistOf(
+				translator.RouteOptionMissingWarning(core.ResourceRef{Namespace: "ns", Name: "missing"}).Error(),
+			))
+		})
+	})
+
+	Describe("options inheritance", func() {
+
+		var (
+			reports reporter.ResourceReports
+			rt      *v1.RouteTable
+			vs      *v1.VirtualService
+		)
+
+		BeforeEach(func() {
+			reports = reporter.ResourceReports{}
+			rt = &v1.RouteTable{
+				Metadata: core.Metadata{Namespace: "ns", Name: "rt"},
+				Routes: []*v1.Route{{
+					Matchers: []*matchers.Matcher{{
+						PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo"},
+					}},
+					Action: &v1.Route_DirectResponseAction{
+						DirectResponseAction: &gloov1.DirectResponseAction{Status: 200},
+					},
+				}},
+			}
+			vs = &v1.VirtualService{
+				Metadata: core.Metadata{Namespace: "ns", Name: "vs"},
+				VirtualHost: &v1.VirtualHost{
+					Routes: []*v1.Route{{
+						Matchers: []*matchers.Matcher{{
+							PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo"},
+						}},
+						Action: &v1.Route_DelegateAction{
+							DelegateAction: &v1.DelegateAction{
+								DelegationType: &v1.DelegateAction_Ref{
+									Ref: &core.ResourceRef{Namespace: "ns", Name: "rt"},
+								},
+							},
+						},
+						Options: &gloov1.RouteOptions{
+							PrefixRewrite:   &types.StringValue{Value: "/parent"},
+							HostRewriteType: &gloov1.RouteOptions_HostRewrite{HostRewrite: "parent.com"},
+							Retries:         &retries.RetryPolicy{NumRetries: 3},
+						},
+					}},
+				},
+			}
+		})
+
+		convert := func(routeTables ...*v1.RouteTable) []*gloov1.Route {
+			rv := translator.NewRouteConverter(
+				translator.NewRouteTableSelector(routeTables),
+				translator.NewRouteTableIndexer(),
+				translator.NewOptionsSelector(nil, nil),
+				translator.NewReferencePolicyChecker(nil, false),
+			)
+			converted, err := rv.ConvertVirtualService(vs, reports)
+			Expect(err).NotTo(HaveOccurred())
+			return converted
+		}
+
+		setInheritance := func(route *v1.Route, inheritance *v1.DelegateOptionsInheritance) {
+			route.GetDelegateAction().OptionsInheritance = inheritance
+		}
+
+		It("only inherits the inherited and locked options", func() {
+			setInheritance(vs.VirtualHost.Routes[0], &v1.DelegateOptionsInheritance{
+				Inherited: []string{"prefixRewrite"},
+				Locked:    []string{"hostRewrite"},
+			})
+
+			converted := convert(rt)
+			Expect(reports.Validate()).NotTo(HaveOccurred())
+			Expect(converted).To(HaveLen(1))
+			Expect(converted[0].Options.PrefixRewrite.Value).To(Equal("/parent"))
+			Expect(converted[0].Options.GetHostRewrite()).To(Equal("parent.com"))
+			Expect(converted[0].Options.Retries).To(BeNil())
+		})
+
+		It("lets child routes override the options that are not locked", func() {
+			setInheritance(vs.VirtualHost.Routes[0], &v1.DelegateOptionsInheritance{
+				Locked: []string{"hostRewrite"},
+			})
+			rt.Routes[0].Options = &gloov1.RouteOptions{
+				PrefixRewrite:   &types.StringValue{Value: "/child"},
+				HostRewriteType: &gloov1.RouteOptions_HostRewrite{HostRewrite: "parent.com"},
+			}
+
+			converted := convert(rt)
+			Expect(reports.Validate()).NotTo(HaveOccurred())
+			Expect(converted).To(HaveLen(1))
+			Expect(converted[0].Options.PrefixRewrite.Value).To(Equal("/child"))
+			Expect(converted[0].Options.Retries.NumRetries).To(Equal(uint32(3)))
+		})
+
+		It("reports an error on the route table and the virtual service if a child route overrides a locked option", func() {
+			setInheritance(vs.VirtualHost.Routes[0], &v1.DelegateOptionsInheritance{
+				Locked: []string{"hostRewrite"},
+			})
+			rt.Routes[0].Options = &gloov1.RouteOptions{
+				HostRewriteType: &gloov1.RouteOptions_AutoHostRewrite{AutoHostRewrite: &types.BoolValue{Value: true}},
+			}
+
+			converted := convert(rt)
+			Expect(converted).To(BeEmpty())
+
+			expectedErr := translator.LockedRouteOptionErr("autoHostRewrite")
+			_, rtReport := reports.Find("*v1.RouteTable", rt.Metadata.Ref())
+			Expect(rtReport.Errors).To(MatchError(ContainSubstring(expectedErr.Error())))
+			_, vsReport := reports.Find("*v1.VirtualService", vs.Metadata.Ref())
+			Expect(vsReport.Errors).To(MatchError(ContainSubstring(translator.TopLevelVirtualResourceErr(rt.Metadata, expectedErr).Error())))
+		})
+
+		It("keeps options locked for the routes of nested route tables", func() {
+			setInheritance(vs.VirtualHost.Routes[0], &v1.DelegateOptionsInheritance{
+				Locked: []string{"retries"},
+			})
+			rt.Routes[0].Matchers[0].PathSpecifier = &matchers.Matcher_Prefix{Prefix: "/foo/bar"}
+			rt.Routes[0].Action = &v1.Route_DelegateAction{
+				DelegateAction: &v1.DelegateAction{
+					DelegationType: &v1.DelegateAction_Ref{
+						Ref: &core.ResourceRef{Namespace: "ns", Name: "nested"},
+					},
+					OptionsInheritance: &v1.DelegateOptionsInheritance{
+						Inherited: []string{"prefixRewrite"},
+					},
+				},
+			}
+			nested := buildRouteTableWithSimpleAction("nested", "ns", "/foo/bar/baz", nil)
+			nested.Routes[0].Options = &gloov1.RouteOptions{
+				Retries: &retries.RetryPolicy{NumRetries: 5},
+			}
+
+			converted := convert(rt, nested)
+			Expect(converted).To(BeEmpty())
+
+			_, nestedReport := reports.Find("*v1.RouteTable", nested.Metadata.Ref())
+			Expect(nestedReport.Errors).To(MatchError(ContainSubstring(translator.LockedRouteOptionErr("retries").Error())))
+		})
+
+		It("reports an error on unknown options", func() {
+			setInheritance(vs.VirtualHost.Routes[0], &v1.DelegateOptionsInheritance{
+				Locked: []string{"unknown"},
+			})
+
+			converted := convert(rt)
+			Expect(converted).To(BeEmpty())
+
+			_, vsReport := reports.Find("*v1.VirtualService", vs.Metadata.Ref())
+			Expect(vsReport.Errors).To(MatchError(ContainSubstring(translator.UnknownRouteOptionErr("unknown").Error())))
+		})
+	})
+
+	Describe("reference policies", func() {
+
+		var (
+			reports       reporter.ResourceReports
+			vs            *v1.VirtualService
+			denyByDefault bool
+		)
+
+		BeforeEach(func() {
+			reports = reporter.ResourceReports{}
+			denyByDefault = false
+			vs = &v1.VirtualService{
+				Metadata: core.Metadata{Namespace: "team-a", Name: "vs"},
+				VirtualHost: &v1.VirtualHost{
+					Routes: []*v1.Route{{
+						Matchers: []*matchers.Matcher{{
+							PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo"},
+						}},
+						Action: &v1.Route_DelegateAction{
+							DelegateAction: &v1.DelegateAction{
+								DelegationType: &v1.DelegateAction_Selector{
+									Selector: &v1.RouteTableSelector{Namespaces: []string{"*"}},
+								},
+							},
+						},
+					}},
+				},
+			}
+		})
+
+		convert := func(routeTables v1.RouteTableList, policies v1.ReferencePolicyList) []*gloov1.Route {
+			rv := translator.NewRouteConverter(
+				translator.NewRouteTableSelector(routeTables),
+				translator.NewRouteTableIndexer(),
+				translator.NewOptionsSelector(nil, nil),
+				translator.NewReferencePolicyChecker(policies, denyByDefault),
+			)
+			converted, err := rv.ConvertVirtualService(vs, reports)
+			Expect(err).NotTo(HaveOccurred())
+			return converted
+		}
+
+		It("only delegates to the route tables the policies allow", func() {
+			allowed := buildRouteTableWithSimpleAction("allowed", "team-b", "/foo/a", nil)
+			denied := buildRouteTableWithSimpleAction("denied", "team-c", "/foo/b", nil)
+			policies := v1.ReferencePolicyList{{
+				Metadata: core.Metadata{Namespace: "team-c", Name: "team-c-only"},
+				From:     []*v1.ReferencePolicy_From{{Namespace: "team-c"}},
+				To:       []*v1.ReferencePolicy_To{{Kind: translator.RouteTableKind}},
+			}}
+
+			converted := convert(v1.RouteTableList{allowed, denied}, policies)
+			Expect(converted).To(HaveLen(1))
+			Expect(getFirstPrefixMatcher(converted[0])).To(Equal("/foo/a"))
+
+			_, vsReport := reports.Find("*v1.VirtualService", vs.Metadata.Ref())
+			Expect(vsReport.Errors).To(MatchError(ContainSubstring(translator.ReferenceNotAllowedErr(translator.RouteTableKind, denied.Metadata.Ref()).Error())))
+		})
+
+		It("reports an error on the route table when the policies deny a reference to an upstream", func() {
+			rt := buildRouteTableWithSimpleAction("rt", "team-b", "/foo", nil)
+			upstream := core.ResourceRef{Namespace: "backends", Name: "us"}
+			rt.Routes[0].Action = &v1.Route_RouteAction{
+				RouteAction: &gloov1.RouteAction{
+					Destination: &gloov1.RouteAction_Single{
+						Single: &gloov1.Destination{
+							DestinationType: &gloov1.Destination_Upstream{Upstream: &upstream},
+						},
+					},
+				},
+			}
+			policies := v1.ReferencePolicyList{{
+				Metadata: core.Metadata{Namespace: "backends", Name: "deny-route-tables"},
+				From:     []*v1.ReferencePolicy_From{{Kind: translator.RouteTableKind}},
+				To:       []*v1.ReferencePolicy_To{{Kind: translator.UpstreamKind}},
+				Action:   v1.ReferencePolicy_Deny,
+			}}
+
+			converted := convert(v1.RouteTableList{rt}, policies)
+			Expect(converted).To(BeEmpty())
+
+			_, rtReport := reports.Find("*v1.RouteTable", rt.Metadata.Ref())
+			Expect(rtReport.Errors).To(MatchError(ContainSubstring(translator.ReferenceNotAllowedErr(translator.UpstreamKind, upstream).Error())))
+		})
+
+		It("reports an error on the route table when the policies deny a reference to an upstream group", func() {
+			rt := buildRouteTableWithSimpleAction("rt", "team-b", "/foo", nil)
+			upstreamGroup := core.ResourceRef{Namespace: "backends", Name: "ug"}
+			rt.Routes[0].Action = &v1.Route_RouteAction{
+				RouteAction: &gloov1.RouteAction{
+					Destination: &gloov1.RouteAction_UpstreamGroup{UpstreamGroup: &upstreamGroup},
+				},
+			}
+			policies := v1.ReferencePolicyList{{
+				Metadata: core.Metadata{Namespace: "backends", Name: "deny-route-tables"},
+				From:     []*v1.ReferencePolicy_From{{Kind: translator.RouteTableKind}},
+				To:       []*v1.ReferencePolicy_To{{Kind: translator.UpstreamGroupKind}},
+				Action:   v1.ReferencePolicy_Deny,
+			}}
+
+			converted := convert(v1.RouteTableList{rt}, policies)
+			Expect(converted).To(BeEmpty())
+
+			_, rtReport := reports.Find("*v1.RouteTable", rt.Metadata.Ref())
+			Expect(rtReport.Errors).To(MatchError(ContainSubstring(translator.ReferenceNotAllowedErr(translator.UpstreamGroupKind, upstreamGroup).Error())))
+		})
+
+		It("only delegates to the route tables the policies allow when denying by default", func() {
+			denyByDefault = true
+			allowed := buildRouteTableWithSimpleAction("allowed", "team-b", "/foo/a", nil)
+			denied := buildRouteTableWithSimpleAction("denied", "team-c", "/foo/b", nil)
+			policies := v1.ReferencePolicyList{{
+				Metadata: core.Metadata{Namespace: "team-b", Name: "allow-team-a"},
+				From:     []*v1.ReferencePolicy_From{{Namespace: "team-a"}},
+				To:       []*v1.ReferencePolicy_To{{Kind: translator.RouteTableKind}},
+			}}
+
+			converted := convert(v1.RouteTableList{allowed, denied}, policies)
+			Expect(converted).To(HaveLen(1))
+			Expect(getFirstPrefixMatcher(converted[0])).To(Equal("/foo/a"))
+
+			_, vsReport := reports.Find("*v1.VirtualService", vs.Metadata.Ref())
+			Expect(vsReport.Errors).To(MatchError(ContainSubstring(translator.ReferenceNotAllowedErr(translator.RouteTableKind, denied.Metadata.Ref()).Error())))
+		})
+	})
+
+	Describe("weighted delegation", func() {
+
+		var (
+			reports reporter.ResourceReports
+			vs      *v1.VirtualService
+			stable  *v1.RouteTable
+			canary  *v1.RouteTable
+		)
+
+		BeforeEach(func() {
+			reports = reporter.ResourceReports{}
+			stable = buildRouteTableWithSimpleAction("stable", "ns", "/foo", nil)
+			canary = buildRouteTableWithSimpleAction("canary", "ns", "/foo", nil)
+			vs = &v1.VirtualService{
+				Metadata: core.Metadata{Namespace: "ns", Name: "vs"},
+				VirtualHost: &v1.VirtualHost{
+					Routes: []*v1.Route{{
+						Matchers: []*matchers.Matcher{{
+							PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo"},
+						}},
+						Action: &v1.Route_DelegateAction{
+							DelegateAction: &v1.DelegateAction{
+								DelegationType: &v1.DelegateAction_Weighted{
+									Weighted: &v1.WeightedRouteTables{
+										Destinations: []*v1.WeightedRouteTables_Destination{
+											{
+												DelegationType: &v1.WeightedRouteTables_Destination_Ref{Ref: &core.ResourceRef{Namespace: "ns", Name: "canary"}},
+												Weight:         1,
+											},
+											{
+												DelegationType: &v1.WeightedRouteTables_Destination_Selector{Selector: &v1.RouteTableSelector{Labels: map[string]string{"version": "stable"}}},
+												Weight:         3,
+											},
+										},
+									},
+								},
+							},
+						},
+					}},
+				},
+			}
+			stable.Metadata.Labels = map[string]string{"version": "stable"}
+		})
+
+		convert := func(routeTables ...*v1.RouteTable) []*gloov1.Route {
+			rv := translator.NewRouteConverter(
+				translator.NewRouteTableSelector(routeTables),
+				translator.NewRouteTableIndexer(),
+				translator.NewOptionsSelector(nil, nil),
+				translator.NewReferencePolicyChecker(nil, false),
+			)
+			converted, err := rv.ConvertVirtualService(vs, reports)
+			Expect(err).NotTo(HaveOccurred())
+			return converted
+		}
+
+		It("splits the requests between the destinations", func() {
+			converted := convert(stable, canary)
+			Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+			Expect(converted).To(HaveLen(2))
+
+			Expect(converted[0].Name).To(ContainSubstring("rt:canary"))
+			Expect(converted[0].GetMatchers()[0].GetPercentage()).To(Equal(&types.FloatValue{Value: 25}))
+			Expect(converted[1].Name).To(ContainSubstring("rt:stable"))
+			Expect(converted[1].GetMatchers()[0].GetPercentage()).To(BeNil())
+		})
+
+		It("sends the requests of missing destinations to the other destinations", func() {
+			converted := convert(stable)
+			Expect(converted).To(HaveLen(1))
+			Expect(converted[0].GetMatchers()[0].GetPercentage()).To(BeNil())
+
+			_, vsReport := reports.Find("*v1.VirtualService", vs.Metadata.Ref())
+			Expect(vsReport.Warnings).To(ConsistOf(translator.RouteTableMissingWarning(core.ResourceRef{Namespace: "ns", Name: "canary"}).Error()))
+		})
+
+		It("reports an error if no destination has a weight", func() {
+			for _, destination := range vs.GetVirtualHost().GetRoutes()[0].GetDelegateAction().GetWeighted().GetDestinations() {
+				destination.Weight = 0
+			}
+			converted := convert(stable, canary)
+			Expect(converted).To(BeEmpty())
+
+			_, vsReport := reports.Find("*v1.VirtualService", vs.Metadata.Ref())
+			Expect(vsReport.Errors).To(MatchError(ContainSubstring(translator.MissingWeightErr.Error())))
+		})
+
+		It("reports an error on delegated routes that match a percentage of the requests", func() {
+			canary.Routes[0].Matchers[0].Percentage = &types.FloatValue{Value: 50}
+			converted := convert(stable, canary)
+			Expect(converted).To(HaveLen(1))
+
+			_, rtReport := reports.Find("*v1.RouteTable", canary.Metadata.Ref())
+			Expect(rtReport.Errors).To(MatchError(ContainSubstring(translator.WeightedDelegationPercentageErr.Error())))
+		})
+
+		It("reports an error on nested weighted delegations", func() {
+			canary.Routes[0].Action = &v1.Route_DelegateAction{
+				DelegateAction: &v1.DelegateAction{
+					DelegationType: &v1.DelegateAction_Weighted{
+						Weighted: &v1.WeightedRouteTables{
+							Destinations: []*v1.WeightedRouteTables_Destination{{
+								DelegationType: &v1.WeightedRouteTables_Destination_Ref{Ref: &core.ResourceRef{Namespace: "ns", Name: "stable"}},
+								Weight:         1,
+							}},
+						},
+					},
+				},
+			}
+			converted := convert(stable, canary)
+			Expect(converted).To(HaveLen(1))
+
+			_, rtReport := reports.Find("*v1.RouteTable", canary.Metadata.Ref())
+			Expect(rtReport.Errors).To(MatchError(ContainSubstring(translator.NestedWeightedDelegationErr.Error())))
+		})
+	})
 })
 
 func getFirstPrefixMatcher(route *gloov1.Route) string {
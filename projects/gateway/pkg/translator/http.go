@@ -6,6 +6,7 @@ import (
 
 	"github.com/solo-io/go-utils/hashutils"
 
+	"github.com/gogo/protobuf/proto"
 	errors "github.com/rotisserie/eris"
 
 	"k8s.io/apimachinery/pkg/labels"
@@ -13,6 +14,7 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
 
@@ -41,7 +43,10 @@ var (
 	}
 )
 
-type HttpTranslator struct{}
+type HttpTranslator struct {
+	// Deny references to other namespaces that no ReferencePolicy allows.
+	DenyCrossNamespaceReferences bool
+}
 
 func (t *HttpTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnapshot, filteredGateways []*v1.Gateway, reports reporter.ResourceReports) []*gloov1.Listener {
 	if len(snap.VirtualServices) == 0 {
@@ -57,14 +62,14 @@ func (t *HttpTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnap
 
 		virtualServices := getVirtualServicesForGateway(gateway, snap.VirtualServices)
 		validateVirtualServiceDomains(gateway, virtualServices, reports)
-		listener := desiredListenerForHttp(gateway, virtualServices, snap.RouteTables, reports)
+		listener := desiredListenerForHttp(gateway, virtualServices, snap.RouteTables, NewOptionsSelector(snap.RouteOptions, snap.VirtualHostOptions), NewReferencePolicyChecker(snap.ReferencePolicies, t.DenyCrossNamespaceReferences), reports)
 		result = append(result, listener)
 	}
 	return result
 }
 
 // Errors will be added to the report object.
-func validateVirtualServiceDomains(gateway *v1.Gateway, virtualServices v1.VirtualServiceList, reports reporter.ResourceReports) {
+func validateVirtualServiceDomains(gateway resources.InputResource, virtualServices v1.VirtualServiceList, reports reporter.ResourceReports) {
 
 	// Index the virtual services for this gateway by the domain
 	vsByDomain := map[string]v1.VirtualServiceList{}
@@ -121,12 +126,33 @@ func getVirtualServicesForGateway(gateway *v1.Gateway, virtualServices v1.Virtua
 }
 
 func GatewayContainsVirtualService(gateway *v1.Gateway, virtualService *v1.VirtualService) bool {
+	if hybridGateway := gateway.GetHybridGateway(); hybridGateway != nil {
+		// the delegated http gateways are only resolved during translation,
+		// so assume that they may select the virtual service
+		if hybridGateway.GetDelegatedHttpGateways() != nil {
+			return true
+		}
+		for _, matchedGateway := range hybridGateway.GetMatchedGateways() {
+			if httpGateway := matchedGateway.GetHttpGateway(); httpGateway != nil &&
+				HttpGatewayContainsVirtualService(httpGateway, virtualService, matchedGateway.GetMatcher().GetSslConfig() != nil) {
+				return true
+			}
+		}
+		return false
+	}
+
 	httpGateway := gateway.GetHttpGateway()
 	if httpGateway == nil {
 		return false
 	}
 
-	if gateway.Ssl != hasSsl(virtualService) {
+	return HttpGatewayContainsVirtualService(httpGateway, virtualService, gateway.Ssl)
+}
+
+// HttpGatewayContainsVirtualService returns true if the http gateway selects the virtual service.
+// Gateways serving TLS only select virtual services with an ssl config, and vice versa.
+func HttpGatewayContainsVirtualService(httpGateway *v1.HttpGateway, virtualService *v1.VirtualService, ssl bool) bool {
+	if ssl != hasSsl(virtualService) {
 		return false
 	}
 
@@ -136,13 +162,13 @@ func GatewayContainsVirtualService(gateway *v1.Gateway, virtualService *v1.Virtu
 
 		vsLabels := labels.Set(virtualService.Metadata.Labels)
 
-		return virtualServiceNamespaceValidForGateway(gateway, virtualService) && selector.Matches(vsLabels)
+		return virtualServiceNamespaceValidForGateway(httpGateway, virtualService) && selector.Matches(vsLabels)
 	}
 	// use individual refs to collect virtual services
 	virtualServiceRefs := httpGateway.VirtualServices
 
 	if len(virtualServiceRefs) == 0 {
-		return virtualServiceNamespaceValidForGateway(gateway, virtualService)
+		return virtualServiceNamespaceValidForGateway(httpGateway, virtualService)
 	}
 
 	vsRef := virtualService.Metadata.Ref()
@@ -156,12 +182,7 @@ func GatewayContainsVirtualService(gateway *v1.Gateway, virtualService *v1.Virtu
 	return false
 }
 
-func virtualServiceNamespaceValidForGateway(gateway *v1.Gateway, virtualService *v1.VirtualService) bool {
-	httpGateway := gateway.GetHttpGateway()
-	if httpGateway == nil {
-		return false
-	}
-
+func virtualServiceNamespaceValidForGateway(httpGateway *v1.HttpGateway, virtualService *v1.VirtualService) bool {
 	if len(httpGateway.VirtualServiceNamespaces) > 0 {
 		for _, ns := range httpGateway.VirtualServiceNamespaces {
 			if ns == "*" || virtualService.Metadata.Namespace == ns {
@@ -179,17 +200,41 @@ func hasSsl(vs *v1.VirtualService) bool {
 	return vs.SslConfig != nil
 }
 
-func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.VirtualServiceList, tables v1.RouteTableList, reports reporter.ResourceReports) *gloov1.Listener {
+func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.VirtualServiceList, tables v1.RouteTableList, optionsSelector OptionsSelector, referencePolicies ReferencePolicyChecker, reports reporter.ResourceReports) *gloov1.Listener {
+	httpListener, sslConfigs := desiredHttpListener(gateway.GetHttpGateway(), virtualServicesForGateway, tables, optionsSelector, referencePolicies, reports)
+
+	listener := makeListener(gateway)
+	listener.ListenerType = &gloov1.Listener_HttpListener{
+		HttpListener: httpListener,
+	}
+	listener.SslConfigurations = sslConfigs
+
+	if err := appendSource(listener, gateway); err != nil {
+		// should never happen
+		reports.AddError(gateway, err)
+	}
+
+	return listener
+}
+
+// returns the http listener of the http gateway, and the ssl configs of its virtual services
+func desiredHttpListener(httpGateway *v1.HttpGateway, virtualServices v1.VirtualServiceList, tables v1.RouteTableList, optionsSelector OptionsSelector, referencePolicies ReferencePolicyChecker, reports reporter.ResourceReports) (*gloov1.HttpListener, []*gloov1.SslConfig) {
 	var (
 		virtualHosts []*gloov1.VirtualHost
 		sslConfigs   []*gloov1.SslConfig
 	)
 
-	for _, virtualService := range virtualServicesForGateway.Sort() {
+	for _, virtualService := range virtualServices.Sort() {
 		if virtualService.VirtualHost == nil {
 			virtualService.VirtualHost = &v1.VirtualHost{}
 		}
-		vh, err := virtualServiceToVirtualHost(virtualService, tables, reports)
+		if secretRef := virtualService.GetSslConfig().GetSecretRef(); secretRef != nil {
+			if err := referencePolicies.CheckReference(virtualService, SecretKind, *secretRef); err != nil {
+				reports.AddError(virtualService, err)
+				continue
+			}
+		}
+		vh, err := virtualServiceToVirtualHost(virtualService, tables, optionsSelector, referencePolicies, reports)
 		if err != nil {
 			reports.AddError(virtualService, err)
 			continue
@@ -200,40 +245,31 @@ func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.Vi
 		}
 	}
 
-	var httpPlugins *gloov1.HttpListenerOptions
-	if httpGateway := gateway.GetHttpGateway(); httpGateway != nil {
-		httpPlugins = httpGateway.Options
-	}
-	listener := makeListener(gateway)
-	listener.ListenerType = &gloov1.Listener_HttpListener{
-		HttpListener: &gloov1.HttpListener{
-			VirtualHosts: virtualHosts,
-			Options:      httpPlugins,
-		},
-	}
-	listener.SslConfigurations = sslConfigs
-
-	if err := appendSource(listener, gateway); err != nil {
-		// should never happen
-		reports.AddError(gateway, err)
-	}
-
-	return listener
+	return &gloov1.HttpListener{
+		VirtualHosts: virtualHosts,
+		Options:      httpGateway.GetOptions(),
+	}, sslConfigs
 }
 
-func virtualServiceToVirtualHost(vs *v1.VirtualService, tables v1.RouteTableList, reports reporter.ResourceReports) (*gloov1.VirtualHost, error) {
-	converter := NewRouteConverter(NewRouteTableSelector(tables), NewRouteTableIndexer())
+func virtualServiceToVirtualHost(vs *v1.VirtualService, tables v1.RouteTableList, optionsSelector OptionsSelector, referencePolicies ReferencePolicyChecker, reports reporter.ResourceReports) (*gloov1.VirtualHost, error) {
+	converter := NewRouteConverter(NewRouteTableSelector(tables), NewRouteTableIndexer(), optionsSelector, referencePolicies)
 	routes, err := converter.ConvertVirtualService(vs, reports)
 	if err != nil {
 		// internal error, should never happen
 		return nil, err
 	}
 
+	options, err := virtualHostOptions(vs, optionsSelector, reports)
+	if err != nil {
+		// internal error, should never happen
+		return nil, err
+	}
+
 	vh := &gloov1.VirtualHost{
 		Name:    VirtualHostName(vs),
 		Domains: vs.VirtualHost.Domains,
 		Routes:  routes,
-		Options: vs.VirtualHost.Options,
+		Options: options,
 	}
 
 	if err := appendSource(vh, vs); err != nil {
@@ -244,6 +280,32 @@ func virtualServiceToVirtualHost(vs *v1.VirtualService, tables v1.RouteTableList
 	return vh, nil
 }
 
+// Merges the options of the virtual host options referenced by the virtual host. The options of the virtual host itself take precedence.
+func virtualHostOptions(vs *v1.VirtualService, optionsSelector OptionsSelector, reports reporter.ResourceReports) (*gloov1.VirtualHostOptions, error) {
+	refs := vs.GetVirtualHost().GetOptionsConfigRefs()
+	if refs == nil {
+		return vs.GetVirtualHost().GetOptions(), nil
+	}
+
+	selected, err := optionsSelector.SelectVirtualHostOptions(refs, vs.GetMetadata().Namespace)
+	if err != nil {
+		reports.AddWarning(vs, err.Error())
+	}
+
+	// the virtual service must not be modified, so merge into a copy of its options
+	var options *gloov1.VirtualHostOptions
+	if vs.GetVirtualHost().GetOptions() != nil {
+		options = proto.Clone(vs.GetVirtualHost().GetOptions()).(*gloov1.VirtualHostOptions)
+	}
+	for _, virtualHostOption := range selected {
+		options, err = mergeVirtualHostOptions(options, virtualHostOption.GetOptions())
+		if err != nil {
+			return nil, errors.Wrapf(err, "merging virtual host options")
+		}
+	}
+	return options, nil
+}
+
 func VirtualHostName(vs *v1.VirtualService) string {
 	return fmt.Sprintf("%v.%v", vs.Metadata.Namespace, vs.Metadata.Name)
 }
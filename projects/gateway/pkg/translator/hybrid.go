@@ -0,0 +1,190 @@
+package translator
+
+import (
+	"context"
+
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var (
+	MatchableHttpGatewayMissingErr = func(ref core.ResourceRef) error {
+		return errors.Errorf("matchable http gateway %v is missing", ref.Key())
+	}
+	DuplicateMatcherErr = errors.New("the matchers of the gateways of a hybrid gateway must be unique")
+)
+
+type HybridTranslator struct {
+	// Deny references to other namespaces that no ReferencePolicy allows.
+	DenyCrossNamespaceReferences bool
+}
+
+func (t *HybridTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnapshot, filteredGateways []*v1.Gateway, reports reporter.ResourceReports) []*gloov1.Listener {
+	var result []*gloov1.Listener
+	for _, gateway := range filteredGateways {
+		hybridGateway := gateway.GetHybridGateway()
+		if hybridGateway == nil {
+			continue
+		}
+
+		optionsSelector := NewOptionsSelector(snap.RouteOptions, snap.VirtualHostOptions)
+		referencePolicies := NewReferencePolicyChecker(snap.ReferencePolicies, t.DenyCrossNamespaceReferences)
+		hybridListener := &gloov1.HybridListener{}
+		for _, matchedGateway := range hybridGateway.GetMatchedGateways() {
+			matchedListener := &gloov1.MatchedListener{
+				Matcher: convertMatcher(matchedGateway.GetMatcher()),
+			}
+			switch gatewayType := matchedGateway.GetGatewayType().(type) {
+			case *v1.MatchedGateway_HttpGateway:
+				matchedListener.ListenerType = &gloov1.MatchedListener_HttpListener{
+					HttpListener: matchedHttpListener(gateway, gatewayType.HttpGateway, matchedGateway.GetMatcher(), snap, optionsSelector, referencePolicies, reports),
+				}
+			case *v1.MatchedGateway_TcpGateway:
+				matchedListener.ListenerType = &gloov1.MatchedListener_TcpListener{
+					TcpListener: &gloov1.TcpListener{
+						Options:  gatewayType.TcpGateway.GetOptions(),
+						TcpHosts: gatewayType.TcpGateway.GetTcpHosts(),
+					},
+				}
+			}
+			if err := appendSource(matchedListener, gateway); err != nil {
+				// should never happen
+				reports.AddError(gateway, err)
+			}
+			hybridListener.MatchedListeners = append(hybridListener.MatchedListeners, matchedListener)
+		}
+
+		for _, matchableHttpGateway := range selectMatchableHttpGateways(gateway, hybridGateway.GetDelegatedHttpGateways(), snap.HttpGateways, reports) {
+			if secretRef := matchableHttpGateway.GetMatcher().GetSslConfig().GetSecretRef(); secretRef != nil {
+				if err := referencePolicies.CheckReference(matchableHttpGateway, SecretKind, *secretRef); err != nil {
+					reports.AddError(matchableHttpGateway, err)
+					continue
+				}
+			}
+			matchedListener := &gloov1.MatchedListener{
+				Matcher: convertMatcher(matchableHttpGateway.GetMatcher()),
+				ListenerType: &gloov1.MatchedListener_HttpListener{
+					HttpListener: matchedHttpListener(matchableHttpGateway, matchableHttpGateway.GetHttpGateway(), matchableHttpGateway.GetMatcher(), snap, optionsSelector, referencePolicies, reports),
+				},
+			}
+			if err := appendSource(matchedListener, matchableHttpGateway); err != nil {
+				// should never happen
+				reports.AddError(matchableHttpGateway, err)
+			}
+			hybridListener.MatchedListeners = append(hybridListener.MatchedListeners, matchedListener)
+		}
+
+		if len(hybridListener.MatchedListeners) == 0 {
+			continue
+		}
+		validateMatchers(gateway, hybridListener, reports)
+
+		listener := makeListener(gateway)
+		listener.ListenerType = &gloov1.Listener_HybridListener{
+			HybridListener: hybridListener,
+		}
+		if err := appendSource(listener, gateway); err != nil {
+			// should never happen
+			reports.AddError(gateway, err)
+		}
+		result = append(result, listener)
+	}
+	return result
+}
+
+// The virtual services of the http gateway of a matcher with an ssl config are served with the ssl config of the matcher.
+func matchedHttpListener(parent resources.InputResource, httpGateway *v1.HttpGateway, matcher *v1.Matcher, snap *v1.ApiSnapshot, optionsSelector OptionsSelector, referencePolicies ReferencePolicyChecker, reports reporter.ResourceReports) *gloov1.HttpListener {
+	ssl := matcher.GetSslConfig() != nil
+	var virtualServices v1.VirtualServiceList
+	for _, vs := range snap.VirtualServices {
+		if HttpGatewayContainsVirtualService(httpGateway, vs, ssl) {
+			virtualServices = append(virtualServices, vs)
+		}
+	}
+	validateVirtualServiceDomains(parent, virtualServices, reports)
+	httpListener, _ := desiredHttpListener(httpGateway, virtualServices, snap.RouteTables, optionsSelector, referencePolicies, reports)
+	return httpListener
+}
+
+// Selects the matchable http gateways by reference, or by labels in the selected namespaces.
+// The namespace of the gateway is selected if no namespaces are set.
+func selectMatchableHttpGateways(gateway *v1.Gateway, delegated *v1.DelegatedHttpGateway, matchableHttpGateways v1.MatchableHttpGatewayList, reports reporter.ResourceReports) v1.MatchableHttpGatewayList {
+	switch selectionType := delegated.GetSelectionType().(type) {
+	case *v1.DelegatedHttpGateway_Ref:
+		matchableHttpGateway, err := matchableHttpGateways.Find(selectionType.Ref.Strings())
+		if err != nil {
+			reports.AddError(gateway, MatchableHttpGatewayMissingErr(*selectionType.Ref))
+			return nil
+		}
+		return v1.MatchableHttpGatewayList{matchableHttpGateway}
+	case *v1.DelegatedHttpGateway_Selector:
+		var selected v1.MatchableHttpGatewayList
+		for _, matchableHttpGateway := range matchableHttpGateways {
+			if selectorSelects(gateway, selectionType.Selector, matchableHttpGateway) {
+				selected = append(selected, matchableHttpGateway)
+			}
+		}
+		return selected.Sort()
+	}
+	return nil
+}
+
+// GatewayContainsMatchableHttpGateway returns true if the gateway is a hybrid gateway which delegates to the
+// matchable http gateway, by reference or by labels.
+func GatewayContainsMatchableHttpGateway(gateway *v1.Gateway, matchableHttpGateway *v1.MatchableHttpGateway) bool {
+	switch selectionType := gateway.GetHybridGateway().GetDelegatedHttpGateways().GetSelectionType().(type) {
+	case *v1.DelegatedHttpGateway_Ref:
+		return selectionType.Ref != nil && *selectionType.Ref == matchableHttpGateway.GetMetadata().Ref()
+	case *v1.DelegatedHttpGateway_Selector:
+		return selectorSelects(gateway, selectionType.Selector, matchableHttpGateway)
+	}
+	return false
+}
+
+func selectorSelects(gateway *v1.Gateway, selector *v1.HttpGatewaySelector, matchableHttpGateway *v1.MatchableHttpGateway) bool {
+	namespaces := selector.GetNamespaces()
+	if len(namespaces) == 0 {
+		namespaces = []string{gateway.GetMetadata().Namespace}
+	}
+	if !namespaceSelected(namespaces, matchableHttpGateway.GetMetadata().Namespace) {
+		return false
+	}
+	return labels.SelectorFromSet(selector.GetLabels()).Matches(labels.Set(matchableHttpGateway.GetMetadata().Labels))
+}
+
+func namespaceSelected(namespaces []string, namespace string) bool {
+	for _, ns := range namespaces {
+		if ns == "*" || ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// envoy rejects listeners whose filter chains have the same match
+func validateMatchers(gateway *v1.Gateway, hybridListener *gloov1.HybridListener, reports reporter.ResourceReports) {
+	matchers := map[uint64]bool{}
+	for _, matchedListener := range hybridListener.GetMatchedListeners() {
+		hash, _ := matchedListener.GetMatcher().Hash(nil)
+		if matchers[hash] {
+			reports.AddError(gateway, DuplicateMatcherErr)
+			return
+		}
+		matchers[hash] = true
+	}
+}
+
+func convertMatcher(matcher *v1.Matcher) *gloov1.Matcher {
+	if matcher == nil {
+		return nil
+	}
+	return &gloov1.Matcher{
+		SslConfig:          matcher.GetSslConfig(),
+		SourcePrefixRanges: matcher.GetSourcePrefixRanges(),
+	}
+}
@@ -24,6 +24,23 @@ func mergeRoutePlugins(dst, src *v1.RouteOptions) (*v1.RouteOptions, error) {
 	return proto.Clone(src).(*v1.RouteOptions), nil
 }
 
+func mergeVirtualHostOptions(dst, src *v1.VirtualHostOptions) (*v1.VirtualHostOptions, error) {
+	if src == nil {
+		return dst, nil
+	}
+	if dst != nil {
+		dstValue, srcValue := reflect.ValueOf(dst).Elem(), reflect.ValueOf(src).Elem()
+
+		for i := 0; i < dstValue.NumField(); i++ {
+			dstField, srcField := dstValue.Field(i), srcValue.Field(i)
+			shallowMerge(dstField, srcField, false)
+		}
+
+		return dst, nil
+	}
+	return proto.Clone(src).(*v1.VirtualHostOptions), nil
+}
+
 // sets src to dst, if src is non-zero and dest is zero-valued or overwrite=true.
 func shallowMerge(dst, src reflect.Value, overwrite bool) {
 	if !src.IsValid() {
@@ -0,0 +1,128 @@
+package translator
+
+import (
+	"reflect"
+	"strings"
+
+	errors "github.com/rotisserie/eris"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var (
+	UnknownRouteOptionErr = func(name string) error {
+		return errors.Errorf("invalid route: unknown route option %s in options inheritance", name)
+	}
+	LockedRouteOptionErr = func(name string) error {
+		return errors.Errorf("invalid route: route option %s is locked by the parent route and may not be overridden", name)
+	}
+)
+
+// The names of the route options, as used in DelegateOptionsInheritance, mapped to the indices of their fields.
+// The options of a oneof map to the index of the oneof field.
+var routeOptionFields = indexRouteOptionFields()
+
+func indexRouteOptionFields() map[string]int {
+	fields := map[string]int{}
+	optionsType := reflect.TypeOf(gloov1.RouteOptions{})
+	oneofWrappers := (&gloov1.RouteOptions{}).XXX_OneofWrappers()
+	for i := 0; i < optionsType.NumField(); i++ {
+		field := optionsType.Field(i)
+		if strings.HasPrefix(field.Name, "XXX_") {
+			continue
+		}
+		if _, isOneof := field.Tag.Lookup("protobuf_oneof"); isOneof {
+			for _, wrapper := range oneofWrappers {
+				if wrapperType := reflect.TypeOf(wrapper); wrapperType.Implements(field.Type) {
+					fields[protoJsonName(wrapperType.Elem().Field(0).Tag)] = i
+				}
+			}
+			continue
+		}
+		fields[protoJsonName(field.Tag)] = i
+	}
+	return fields
+}
+
+// Returns the json name of a field from its protobuf struct tag.
+func protoJsonName(tag reflect.StructTag) string {
+	var name string
+	for _, part := range strings.Split(tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "json=") {
+			return strings.TrimPrefix(part, "json=")
+		}
+		if strings.HasPrefix(part, "name=") {
+			name = strings.TrimPrefix(part, "name=")
+		}
+	}
+	return name
+}
+
+// Returns the options of a delegating route that its child routes inherit, and the options that they may not override.
+// The options locked by the ancestors of the route remain locked.
+func inheritRouteOptions(options *gloov1.RouteOptions, inheritance *gatewayv1.DelegateOptionsInheritance, parentLocked sets.Int) (*gloov1.RouteOptions, sets.Int, error) {
+	locked := sets.NewInt(parentLocked.UnsortedList()...)
+	for _, name := range inheritance.GetLocked() {
+		i, ok := routeOptionFields[name]
+		if !ok {
+			return nil, nil, UnknownRouteOptionErr(name)
+		}
+		locked.Insert(i)
+	}
+
+	if len(inheritance.GetInherited()) == 0 || options == nil {
+		return options, locked, nil
+	}
+
+	inherited := sets.NewInt(locked.UnsortedList()...)
+	for _, name := range inheritance.GetInherited() {
+		i, ok := routeOptionFields[name]
+		if !ok {
+			return nil, nil, UnknownRouteOptionErr(name)
+		}
+		inherited.Insert(i)
+	}
+
+	result := &gloov1.RouteOptions{}
+	src, dst := reflect.ValueOf(options).Elem(), reflect.ValueOf(result).Elem()
+	for _, i := range inherited.List() {
+		dst.Field(i).Set(src.Field(i))
+	}
+	return result, locked, nil
+}
+
+// Child routes may only set locked options to the values of their parent route.
+func checkLockedRouteOptions(child, parent *gloov1.RouteOptions, locked sets.Int) error {
+	if child == nil {
+		return nil
+	}
+	if parent == nil {
+		parent = &gloov1.RouteOptions{}
+	}
+	childValue, parentValue := reflect.ValueOf(child).Elem(), reflect.ValueOf(parent).Elem()
+	for _, i := range locked.List() {
+		childField := childValue.Field(i)
+		if isEmptyValue(childField) {
+			continue
+		}
+
+		// compare the fields as route options holding only these fields, as the fields themselves may be oneofs
+		childOption, parentOption := &gloov1.RouteOptions{}, &gloov1.RouteOptions{}
+		reflect.ValueOf(childOption).Elem().Field(i).Set(childField)
+		reflect.ValueOf(parentOption).Elem().Field(i).Set(parentValue.Field(i))
+		if !childOption.Equal(parentOption) {
+			return LockedRouteOptionErr(routeOptionName(childValue, i))
+		}
+	}
+	return nil
+}
+
+// Returns the name of a route option that is set, which is the name of the set option for oneofs.
+func routeOptionName(options reflect.Value, i int) string {
+	field := options.Field(i)
+	if field.Kind() == reflect.Interface {
+		return protoJsonName(field.Elem().Elem().Type().Field(0).Tag)
+	}
+	return protoJsonName(options.Type().Field(i).Tag)
+}
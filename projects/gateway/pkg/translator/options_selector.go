@@ -0,0 +1,162 @@
+package translator
+
+import (
+	"sort"
+
+	errors "github.com/rotisserie/eris"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var (
+	RouteOptionMissingWarning = func(ref core.ResourceRef) error {
+		return errors.Errorf("route option %v.%v missing", ref.Namespace, ref.Name)
+	}
+	VirtualHostOptionMissingWarning = func(ref core.ResourceRef) error {
+		return errors.Errorf("virtual host option %v.%v missing", ref.Namespace, ref.Name)
+	}
+)
+
+type OptionsSelector interface {
+	// Returns the route options referenced or selected by the given refs, in order of precedence.
+	// Missing references result in an error, which is returned together with the route options that were found.
+	SelectRouteOptions(refs *gatewayv1.DelegateOptionsRefs, parentNamespace string) (gatewayv1.RouteOptionList, error)
+	// Returns the virtual host options referenced or selected by the given refs, in order of precedence.
+	// Missing references result in an error, which is returned together with the virtual host options that were found.
+	SelectVirtualHostOptions(refs *gatewayv1.DelegateOptionsRefs, parentNamespace string) (gatewayv1.VirtualHostOptionList, error)
+}
+
+func NewOptionsSelector(routeOptions gatewayv1.RouteOptionList, virtualHostOptions gatewayv1.VirtualHostOptionList) OptionsSelector {
+	return &optionsSelector{
+		routeOptions:       routeOptions,
+		virtualHostOptions: virtualHostOptions,
+	}
+}
+
+type optionsSelector struct {
+	routeOptions       gatewayv1.RouteOptionList
+	virtualHostOptions gatewayv1.VirtualHostOptionList
+}
+
+func (s *optionsSelector) SelectRouteOptions(refs *gatewayv1.DelegateOptionsRefs, parentNamespace string) (gatewayv1.RouteOptionList, error) {
+	var (
+		selected gatewayv1.RouteOptionList
+		missing  error
+	)
+	seen := map[*gatewayv1.RouteOption]bool{}
+	add := func(routeOption *gatewayv1.RouteOption) {
+		if !seen[routeOption] {
+			seen[routeOption] = true
+			selected = append(selected, routeOption)
+		}
+	}
+
+	for _, ref := range optionsRefs(refs, parentNamespace) {
+		// missing refs should only result in a warning
+		// this allows resources to be applied asynchronously
+		routeOption, err := s.routeOptions.Find(ref.Strings())
+		if err != nil {
+			if missing == nil {
+				missing = RouteOptionMissingWarning(ref)
+			}
+			continue
+		}
+		add(routeOption)
+	}
+
+	if len(refs.GetSelector()) > 0 {
+		var matching gatewayv1.RouteOptionList
+		for _, routeOption := range s.routeOptions {
+			if optionsSelected(refs, routeOption.GetMetadata(), parentNamespace) {
+				matching = append(matching, routeOption)
+			}
+		}
+		sort.SliceStable(matching, func(i, j int) bool {
+			return matching[i].GetMetadata().Name < matching[j].GetMetadata().Name
+		})
+		for _, routeOption := range matching {
+			add(routeOption)
+		}
+	}
+
+	return selected, missing
+}
+
+func (s *optionsSelector) SelectVirtualHostOptions(refs *gatewayv1.DelegateOptionsRefs, parentNamespace string) (gatewayv1.VirtualHostOptionList, error) {
+	var (
+		selected gatewayv1.VirtualHostOptionList
+		missing  error
+	)
+	seen := map[*gatewayv1.VirtualHostOption]bool{}
+	add := func(virtualHostOption *gatewayv1.VirtualHostOption) {
+		if !seen[virtualHostOption] {
+			seen[virtualHostOption] = true
+			selected = append(selected, virtualHostOption)
+		}
+	}
+
+	for _, ref := range optionsRefs(refs, parentNamespace) {
+		// missing refs should only result in a warning
+		// this allows resources to be applied asynchronously
+		virtualHostOption, err := s.virtualHostOptions.Find(ref.Strings())
+		if err != nil {
+			if missing == nil {
+				missing = VirtualHostOptionMissingWarning(ref)
+			}
+			continue
+		}
+		add(virtualHostOption)
+	}
+
+	if len(refs.GetSelector()) > 0 {
+		var matching gatewayv1.VirtualHostOptionList
+		for _, virtualHostOption := range s.virtualHostOptions {
+			if optionsSelected(refs, virtualHostOption.GetMetadata(), parentNamespace) {
+				matching = append(matching, virtualHostOption)
+			}
+		}
+		sort.SliceStable(matching, func(i, j int) bool {
+			return matching[i].GetMetadata().Name < matching[j].GetMetadata().Name
+		})
+		for _, virtualHostOption := range matching {
+			add(virtualHostOption)
+		}
+	}
+
+	return selected, missing
+}
+
+// References without a namespace refer to resources in the namespace of the referencing resource.
+func optionsRefs(refs *gatewayv1.DelegateOptionsRefs, parentNamespace string) []core.ResourceRef {
+	var result []core.ResourceRef
+	for _, ref := range refs.GetDelegateOptions() {
+		if ref == nil {
+			continue
+		}
+		namespace := ref.GetNamespace()
+		if namespace == "" {
+			namespace = parentNamespace
+		}
+		result = append(result, core.ResourceRef{Name: ref.GetName(), Namespace: namespace})
+	}
+	return result
+}
+
+// Selectors only match resources in the namespace of the referencing resource.
+func optionsSelected(refs *gatewayv1.DelegateOptionsRefs, metadata core.Metadata, parentNamespace string) bool {
+	if len(refs.GetSelector()) == 0 || metadata.Namespace != parentNamespace {
+		return false
+	}
+	return labels.SelectorFromSet(refs.GetSelector()).Matches(labels.Set(metadata.Labels))
+}
+
+// Returns whether the given refs reference or select the resource with the given metadata.
+func OptionsRefsContain(refs *gatewayv1.DelegateOptionsRefs, metadata core.Metadata, parentNamespace string) bool {
+	for _, ref := range optionsRefs(refs, parentNamespace) {
+		if ref == metadata.Ref() {
+			return true
+		}
+	}
+	return optionsSelected(refs, metadata, parentNamespace)
+}
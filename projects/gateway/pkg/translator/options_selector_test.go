@@ -0,0 +1,63 @@
+package translator_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("OptionsSelector", func() {
+
+	routeOption := func(namespace, name string, labels map[string]string) *v1.RouteOption {
+		return &v1.RouteOption{
+			Metadata: core.Metadata{Namespace: namespace, Name: name, Labels: labels},
+		}
+	}
+
+	var (
+		shared    = map[string]string{"shared": "true"}
+		first     = routeOption("ns", "a", shared)
+		second    = routeOption("ns", "b", shared)
+		other     = routeOption("other", "c", shared)
+		unlabeled = routeOption("ns", "d", nil)
+		selector  = translator.NewOptionsSelector(v1.RouteOptionList{first, second, other, unlabeled}, nil)
+	)
+
+	It("returns referenced options before selected options", func() {
+		selected, err := selector.SelectRouteOptions(&v1.DelegateOptionsRefs{
+			DelegateOptions: []*core.ResourceRef{{Name: "d"}, {Name: "b", Namespace: "ns"}},
+			Selector:        shared,
+		}, "ns")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selected).To(Equal(v1.RouteOptionList{unlabeled, second, first}))
+	})
+
+	It("only selects options in the namespace of the referencing resource", func() {
+		selected, err := selector.SelectRouteOptions(&v1.DelegateOptionsRefs{Selector: shared}, "other")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selected).To(Equal(v1.RouteOptionList{other}))
+	})
+
+	It("returns the options found together with a warning for missing refs", func() {
+		selected, err := selector.SelectRouteOptions(&v1.DelegateOptionsRefs{
+			DelegateOptions: []*core.ResourceRef{{Name: "missing"}, {Name: "a"}},
+		}, "ns")
+
+		Expect(err).To(MatchError(translator.RouteOptionMissingWarning(core.ResourceRef{Namespace: "ns", Name: "missing"}).Error()))
+		Expect(selected).To(Equal(v1.RouteOptionList{first}))
+	})
+
+	It("reports whether refs reference or select a resource", func() {
+		refs := &v1.DelegateOptionsRefs{DelegateOptions: []*core.ResourceRef{{Name: "d"}}}
+		Expect(translator.OptionsRefsContain(refs, unlabeled.Metadata, "ns")).To(BeTrue())
+		Expect(translator.OptionsRefsContain(refs, unlabeled.Metadata, "other")).To(BeFalse())
+
+		refs = &v1.DelegateOptionsRefs{Selector: shared}
+		Expect(translator.OptionsRefsContain(refs, first.Metadata, "ns")).To(BeTrue())
+		Expect(translator.OptionsRefsContain(refs, unlabeled.Metadata, "ns")).To(BeFalse())
+	})
+})
@@ -3,6 +3,7 @@ package translator
 import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"k8s.io/client-go/kubernetes"
 )
 
 type Opts struct {
@@ -12,11 +13,19 @@ type Opts struct {
 	Gateways                      factory.ResourceClientFactory
 	VirtualServices               factory.ResourceClientFactory
 	RouteTables                   factory.ResourceClientFactory
+	RouteOptions                  factory.ResourceClientFactory
+	VirtualHostOptions            factory.ResourceClientFactory
+	MatchableHttpGateways         factory.ResourceClientFactory
+	ReferencePolicies             factory.ResourceClientFactory
+	Canaries                      factory.ResourceClientFactory
+	UpstreamGroups                factory.ResourceClientFactory
 	Proxies                       factory.ResourceClientFactory
+	KubeClient                    kubernetes.Interface
 	WatchOpts                     clients.WatchOpts
 	ValidationServerAddress       string
 	DevMode                       bool
 	ReadGatewaysFromAllNamespaces bool
+	DenyCrossNamespaceReferences  bool
 	Validation                    *ValidationOpts
 }
 
@@ -0,0 +1,106 @@
+package translator
+
+import (
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// The kinds of resources used in ReferencePolicies.
+const (
+	VirtualServiceKind       = "VirtualService"
+	RouteTableKind           = "RouteTable"
+	MatchableHttpGatewayKind = "MatchableHttpGateway"
+	UpstreamKind             = "Upstream"
+	UpstreamGroupKind        = "UpstreamGroup"
+	SecretKind               = "Secret"
+)
+
+var (
+	ReferenceNotAllowedErr = func(kind string, ref core.ResourceRef) error {
+		return errors.Errorf("the reference policies of namespace %v do not allow references to %v %v from this namespace", ref.Namespace, kind, ref.Key())
+	}
+)
+
+type ReferencePolicyChecker interface {
+	// Returns an error if the reference policies do not allow the resource to reference the resource of the given kind.
+	CheckReference(from resources.InputResource, kind string, to core.ResourceRef) error
+}
+
+// If denyByDefault is true, references to other namespaces are only allowed if an Allow policy matches them.
+func NewReferencePolicyChecker(policies v1.ReferencePolicyList, denyByDefault bool) ReferencePolicyChecker {
+	policiesByNamespace := map[string]v1.ReferencePolicyList{}
+	for _, policy := range policies {
+		namespace := policy.GetMetadata().Namespace
+		policiesByNamespace[namespace] = append(policiesByNamespace[namespace], policy)
+	}
+	return &referencePolicyChecker{policiesByNamespace: policiesByNamespace, denyByDefault: denyByDefault}
+}
+
+type referencePolicyChecker struct {
+	policiesByNamespace map[string]v1.ReferencePolicyList
+	denyByDefault       bool
+}
+
+// A reference is denied by the Deny policies matching it. Otherwise, the Allow policies matching the referenced resource
+// only allow the references from the resources they match. References no Allow policy matches are allowed unless the
+// checker denies them by default. References within a namespace are always allowed.
+func (c *referencePolicyChecker) CheckReference(from resources.InputResource, kind string, to core.ResourceRef) error {
+	fromNamespace := from.GetMetadata().Namespace
+	if to.Namespace == "" || to.Namespace == fromNamespace {
+		return nil
+	}
+	fromKind := resourceKind(from)
+
+	restricted, allowed := c.denyByDefault, false
+	for _, policy := range c.policiesByNamespace[to.Namespace] {
+		if !policyMatchesTo(policy, kind, to.Name) {
+			continue
+		}
+		matchesFrom := policyMatchesFrom(policy, fromKind, fromNamespace)
+		switch policy.GetAction() {
+		case v1.ReferencePolicy_Deny:
+			if matchesFrom {
+				return ReferenceNotAllowedErr(kind, to)
+			}
+		case v1.ReferencePolicy_Allow:
+			restricted = true
+			allowed = allowed || matchesFrom
+		}
+	}
+	if restricted && !allowed {
+		return ReferenceNotAllowedErr(kind, to)
+	}
+	return nil
+}
+
+func policyMatchesTo(policy *v1.ReferencePolicy, kind, name string) bool {
+	for _, to := range policy.GetTo() {
+		if (to.GetKind() == "" || to.GetKind() == kind) && (to.GetName() == "" || to.GetName() == name) {
+			return true
+		}
+	}
+	return false
+}
+
+func policyMatchesFrom(policy *v1.ReferencePolicy, kind, namespace string) bool {
+	for _, from := range policy.GetFrom() {
+		if (from.GetKind() == "" || from.GetKind() == kind) && (from.GetNamespace() == "" || from.GetNamespace() == "*" || from.GetNamespace() == namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceKind(resource resources.InputResource) string {
+	switch resource.(type) {
+	case *v1.VirtualService:
+		return VirtualServiceKind
+	case *v1.RouteTable:
+		return RouteTableKind
+	case *v1.MatchableHttpGateway:
+		return MatchableHttpGatewayKind
+	}
+	return ""
+}
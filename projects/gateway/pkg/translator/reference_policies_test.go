@@ -0,0 +1,66 @@
+package translator_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("ReferencePolicyChecker", func() {
+
+	var (
+		vs = &v1.VirtualService{Metadata: core.Metadata{Namespace: "team-a", Name: "vs"}}
+		rt = &v1.RouteTable{Metadata: core.Metadata{Namespace: "team-b", Name: "rt"}}
+
+		allowTeamA = &v1.ReferencePolicy{
+			Metadata: core.Metadata{Namespace: "backends", Name: "allow-team-a"},
+			From:     []*v1.ReferencePolicy_From{{Namespace: "team-a"}},
+			To:       []*v1.ReferencePolicy_To{{Kind: translator.UpstreamKind}},
+		}
+		denyRouteTables = &v1.ReferencePolicy{
+			Metadata: core.Metadata{Namespace: "backends", Name: "deny-route-tables"},
+			From:     []*v1.ReferencePolicy_From{{Kind: translator.RouteTableKind, Namespace: "*"}},
+			To:       []*v1.ReferencePolicy_To{{Kind: translator.SecretKind, Name: "tls"}},
+			Action:   v1.ReferencePolicy_Deny,
+		}
+		policies = v1.ReferencePolicyList{allowTeamA, denyRouteTables}
+	)
+
+	DescribeTable("checks references",
+		func(from resources.InputResource, kind string, to core.ResourceRef, allowed bool) {
+			err := translator.NewReferencePolicyChecker(policies, false).CheckReference(from, kind, to)
+			if allowed {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(translator.ReferenceNotAllowedErr(kind, to).Error()))
+			}
+		},
+		Entry("allows references within a namespace", rt, translator.UpstreamKind, core.ResourceRef{Namespace: "team-b", Name: "us"}, true),
+		Entry("allows references the allow policies match", vs, translator.UpstreamKind, core.ResourceRef{Namespace: "backends", Name: "us"}, true),
+		Entry("denies references to resources matched by allow policies that do not match the referencing resource", rt, translator.UpstreamKind, core.ResourceRef{Namespace: "backends", Name: "us"}, false),
+		Entry("allows references to resources no allow policy matches", rt, translator.SecretKind, core.ResourceRef{Namespace: "backends", Name: "other"}, true),
+		Entry("denies references the deny policies match", rt, translator.SecretKind, core.ResourceRef{Namespace: "backends", Name: "tls"}, false),
+		Entry("allows references the deny policies do not match", vs, translator.SecretKind, core.ResourceRef{Namespace: "backends", Name: "tls"}, true),
+		Entry("allows references to namespaces without policies", rt, translator.RouteTableKind, core.ResourceRef{Namespace: "team-a", Name: "rt"}, true),
+	)
+
+	DescribeTable("checks references when denying by default",
+		func(from resources.InputResource, kind string, to core.ResourceRef, allowed bool) {
+			err := translator.NewReferencePolicyChecker(policies, true).CheckReference(from, kind, to)
+			if allowed {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(translator.ReferenceNotAllowedErr(kind, to).Error()))
+			}
+		},
+		Entry("allows references within a namespace", rt, translator.UpstreamKind, core.ResourceRef{Namespace: "team-b", Name: "us"}, true),
+		Entry("allows references the allow policies match", vs, translator.UpstreamKind, core.ResourceRef{Namespace: "backends", Name: "us"}, true),
+		Entry("denies references to resources no allow policy matches", rt, translator.SecretKind, core.ResourceRef{Namespace: "backends", Name: "other"}, false),
+		Entry("denies references the deny policies match", rt, translator.SecretKind, core.ResourceRef{Namespace: "backends", Name: "tls"}, false),
+		Entry("denies references to namespaces without policies", rt, translator.RouteTableKind, core.ResourceRef{Namespace: "team-a", Name: "rt"}, false),
+	)
+})
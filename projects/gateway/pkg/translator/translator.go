@@ -41,7 +41,11 @@ func NewTranslator(factories []ListenerFactory, opts Opts) *translator {
 }
 
 func NewDefaultTranslator(opts Opts) *translator {
-	return NewTranslator([]ListenerFactory{&HttpTranslator{}, &TcpTranslator{}}, opts)
+	return NewTranslator([]ListenerFactory{
+		&HttpTranslator{DenyCrossNamespaceReferences: opts.DenyCrossNamespaceReferences},
+		&TcpTranslator{},
+		&HybridTranslator{DenyCrossNamespaceReferences: opts.DenyCrossNamespaceReferences},
+	}, opts)
 }
 
 func (t *translator) Translate(ctx context.Context, proxyName, namespace string, snap *v1.ApiSnapshot, gatewaysByProxy v1.GatewayList) (*gloov1.Proxy, reporter.ResourceReports) {
@@ -53,6 +57,10 @@ func (t *translator) Translate(ctx context.Context, proxyName, namespace string,
 	reports.Accept(snap.Gateways.AsInputResources()...)
 	reports.Accept(snap.VirtualServices.AsInputResources()...)
 	reports.Accept(snap.RouteTables.AsInputResources()...)
+	reports.Accept(snap.RouteOptions.AsInputResources()...)
+	reports.Accept(snap.VirtualHostOptions.AsInputResources()...)
+	reports.Accept(snap.HttpGateways.AsInputResources()...)
+	reports.Accept(snap.ReferencePolicies.AsInputResources()...)
 	if len(filteredGateways) == 0 {
 		snapHash := hashutils.MustHash(snap)
 		logger.Infof("%v had no gateways", snapHash)
@@ -97,8 +105,12 @@ func validateGateways(gateways v1.GatewayList, virtualServices v1.VirtualService
 		bindAddress := fmt.Sprintf("%s:%d", gw.BindAddress, gw.BindPort)
 		bindAddresses[bindAddress] = append(bindAddresses[bindAddress], gw)
 
-		if httpGw := gw.GetHttpGateway(); httpGw != nil {
-			for _, vs := range httpGw.VirtualServices {
+		httpGateways := []*v1.HttpGateway{gw.GetHttpGateway()}
+		for _, matchedGateway := range gw.GetHybridGateway().GetMatchedGateways() {
+			httpGateways = append(httpGateways, matchedGateway.GetHttpGateway())
+		}
+		for _, httpGw := range httpGateways {
+			for _, vs := range httpGw.GetVirtualServices() {
 				if _, err := virtualServices.Find(vs.Strings()); err != nil {
 					reports.AddError(gw, fmt.Errorf("invalid virtual service ref %v", vs))
 				}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
@@ -22,6 +24,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tcp"
 
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	envoycorev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
@@ -228,6 +231,34 @@ var _ = Describe("Translator", func() {
 			Expect(err.Error()).To(ContainSubstring("route table exist.don't missing"))
 		})
 
+		It("should merge the virtual host options selected by virtual services", func() {
+			snap.VirtualHostOptions = v1.VirtualHostOptionList{
+				{
+					Metadata: core.Metadata{Namespace: ns, Name: "retries", Labels: labelSet},
+					Options: &gloov1.VirtualHostOptions{
+						Retries: &retries.RetryPolicy{RetryOn: "5xx", NumRetries: 3},
+						Cors:    &cors.CorsPolicy{AllowOrigin: []string{"shared.com"}},
+					},
+				},
+			}
+			snap.VirtualServices[0].VirtualHost.Options = &gloov1.VirtualHostOptions{
+				Cors: &cors.CorsPolicy{AllowOrigin: []string{"d1.com"}},
+			}
+			snap.VirtualServices[0].VirtualHost.OptionsConfigRefs = &v1.DelegateOptionsRefs{Selector: labelSet}
+
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+			vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
+			Expect(vhosts).To(HaveLen(2))
+			Expect(vhosts[0].Options.GetRetries().GetNumRetries()).To(Equal(uint32(3)))
+			Expect(vhosts[0].Options.GetCors().GetAllowOrigin()).To(Equal([]string{"d1.com"}))
+			Expect(vhosts[1].Options).To(BeNil())
+
+			// the virtual service must not be modified
+			Expect(snap.VirtualServices[0].VirtualHost.Options.GetRetries()).To(BeNil())
+		})
+
 		Context("when the gateway CRDs don't clash", func() {
 			BeforeEach(func() {
 				translator = NewTranslator([]ListenerFactory{&HttpTranslator{}, &TcpTranslator{}}, Opts{
@@ -539,6 +570,28 @@ var _ = Describe("Translator", func() {
 				Expect(listener.VirtualHosts[0].Name).To(ContainSubstring("name1"))
 			})
 
+			It("should report an error on virtual services whose secrets the reference policies do not allow", func() {
+				snap.Gateways[0].Ssl = true
+				secretRef := core.ResourceRef{Namespace: "certs", Name: "tls"}
+				snap.VirtualServices[0].SslConfig = &gloov1.SslConfig{
+					SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: &secretRef},
+				}
+				snap.ReferencePolicies = v1.ReferencePolicyList{{
+					Metadata: core.Metadata{Namespace: "certs", Name: "other-only"},
+					From:     []*v1.ReferencePolicy_From{{Namespace: "other"}},
+					To:       []*v1.ReferencePolicy_To{{Kind: SecretKind}},
+				}}
+
+				proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+				_, vsReport := errs.Find("*v1.VirtualService", snap.VirtualServices[0].Metadata.Ref())
+				Expect(vsReport.Errors).To(MatchError(ContainSubstring(ReferenceNotAllowedErr(SecretKind, secretRef).Error())))
+				Expect(proxy.Listeners).To(HaveLen(1))
+				Expect(proxy.Listeners[0].SslConfigurations).To(BeEmpty())
+				listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+				Expect(listener.VirtualHosts).To(BeEmpty())
+			})
+
 			Context("validate domains", func() {
 				BeforeEach(func() {
 					snap.VirtualServices[1].VirtualHost.Domains = snap.VirtualServices[0].VirtualHost.Domains
@@ -1118,6 +1171,122 @@ var _ = Describe("Translator", func() {
 
 	})
 
+	Context("hybrid", func() {
+		var (
+			hybridGateway *v1.HybridGateway
+			upstreamRef   = core.ResourceRef{Namespace: ns, Name: "upstream"}
+		)
+
+		BeforeEach(func() {
+			translator = NewTranslator([]ListenerFactory{&HybridTranslator{}}, Opts{})
+			snap = samples.HybridGatewaySnapshot(upstreamRef, ns)
+			hybridGateway = snap.Gateways[0].GetHybridGateway()
+		})
+
+		It("translates the inline and delegated gateways into matched listeners", func() {
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+			Expect(proxy.Listeners).To(HaveLen(1))
+			Expect(proxy.Listeners[0].GetBindPort()).To(Equal(uint32(8080)))
+			matchedListeners := proxy.Listeners[0].GetHybridListener().GetMatchedListeners()
+			Expect(matchedListeners).To(HaveLen(2))
+
+			Expect(matchedListeners[0].GetMatcher().GetSourcePrefixRanges()).To(Equal(hybridGateway.GetMatchedGateways()[0].GetMatcher().GetSourcePrefixRanges()))
+			Expect(matchedListeners[0].GetTcpListener().GetTcpHosts()).To(Equal(hybridGateway.GetMatchedGateways()[0].GetTcpGateway().GetTcpHosts()))
+			tcpSources, err := GetSourceMeta(matchedListeners[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tcpSources.Sources).To(HaveLen(1))
+			Expect(tcpSources.Sources[0].ResourceKind).To(Equal("*v1.Gateway"))
+
+			virtualHosts := matchedListeners[1].GetHttpListener().GetVirtualHosts()
+			Expect(virtualHosts).To(HaveLen(1))
+			Expect(virtualHosts[0].GetName()).To(Equal(VirtualHostName(snap.VirtualServices[0])))
+			httpSources, err := GetSourceMeta(matchedListeners[1])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(httpSources.Sources).To(HaveLen(1))
+			Expect(httpSources.Sources[0].ResourceRef).To(Equal(snap.HttpGateways[0].GetMetadata().Ref()))
+			Expect(httpSources.Sources[0].ResourceKind).To(Equal("*v1.MatchableHttpGateway"))
+		})
+
+		It("only serves the virtual services with an ssl config on matchers with an ssl config", func() {
+			snap.HttpGateways[0].Matcher = &v1.Matcher{
+				SslConfig: &gloov1.SslConfig{SniDomains: []string{"secure.example.com"}},
+			}
+			snap.VirtualServices = append(snap.VirtualServices, &v1.VirtualService{
+				Metadata: core.Metadata{Namespace: ns, Name: "secure"},
+				VirtualHost: &v1.VirtualHost{
+					Domains: []string{"secure.example.com"},
+					Routes:  samples.SimpleRoute(upstreamRef),
+				},
+				SslConfig: &gloov1.SslConfig{SniDomains: []string{"secure.example.com"}},
+			})
+
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+			httpListener := proxy.Listeners[0].GetHybridListener().GetMatchedListeners()[1]
+			Expect(httpListener.GetMatcher().GetSslConfig().GetSniDomains()).To(Equal([]string{"secure.example.com"}))
+			Expect(httpListener.GetHttpListener().GetVirtualHosts()).To(HaveLen(1))
+			Expect(httpListener.GetHttpListener().GetVirtualHosts()[0].GetName()).To(Equal(VirtualHostName(snap.VirtualServices[1])))
+		})
+
+		It("selects the matchable http gateways of the namespace of the gateway by default", func() {
+			snap.HttpGateways = append(snap.HttpGateways, &v1.MatchableHttpGateway{
+				Metadata: core.Metadata{
+					Name:      "other-namespace",
+					Namespace: ns2,
+					Labels:    map[string]string{"gateway": "hybrid"},
+				},
+				Matcher: &v1.Matcher{
+					SourcePrefixRanges: []*envoycorev3.CidrRange{{AddressPrefix: "192.168.0.0", PrefixLen: &types.UInt32Value{Value: 16}}},
+				},
+				HttpGateway: &v1.HttpGateway{},
+			})
+
+			proxy, _ := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(proxy.Listeners[0].GetHybridListener().GetMatchedListeners()).To(HaveLen(2))
+
+			hybridGateway.GetDelegatedHttpGateways().GetSelector().Namespaces = []string{"*"}
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+			Expect(proxy.Listeners[0].GetHybridListener().GetMatchedListeners()).To(HaveLen(3))
+		})
+
+		It("selects a matchable http gateway by reference", func() {
+			ref := snap.HttpGateways[0].GetMetadata().Ref()
+			hybridGateway.DelegatedHttpGateways = &v1.DelegatedHttpGateway{
+				SelectionType: &v1.DelegatedHttpGateway_Ref{Ref: &ref},
+			}
+			snap.HttpGateways[0].Metadata.Labels = nil
+
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+			Expect(proxy.Listeners[0].GetHybridListener().GetMatchedListeners()).To(HaveLen(2))
+		})
+
+		It("reports missing matchable http gateways on the gateway", func() {
+			ref := core.ResourceRef{Namespace: ns, Name: "missing"}
+			hybridGateway.DelegatedHttpGateways = &v1.DelegatedHttpGateway{
+				SelectionType: &v1.DelegatedHttpGateway_Ref{Ref: &ref},
+			}
+
+			_, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			err := reports.ValidateStrict()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(MatchableHttpGatewayMissingErr(ref).Error()))
+		})
+
+		It("reports duplicate matchers on the gateway", func() {
+			snap.HttpGateways[0].Matcher = hybridGateway.GetMatchedGateways()[0].GetMatcher()
+
+			_, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			err := reports.ValidateStrict()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(DuplicateMatcherErr.Error()))
+		})
+	})
+
 })
 
 var expectedRouteMetadatas = [][]*SourceMetadata{
@@ -42,6 +42,9 @@ var (
 	VirtualServiceDeleteErr = func(parentGateways []core.ResourceRef) error {
 		return errors.Errorf("Deletion blocked because active Gateways reference this Virtual Service. Remove refs to this virtual service from the gateways: %v, then try again", parentGateways)
 	}
+	MatchableHttpGatewayDeleteErr = func(parentGateways []core.ResourceRef) error {
+		return errors.Errorf("Deletion blocked because active Gateways reference this Matchable Http Gateway. Remove refs to this matchable http gateway from the gateways: %v, then try again", parentGateways)
+	}
 	unmarshalErrMsg     = "could not unmarshal raw object"
 	WrappedUnmarshalErr = func(err error) error {
 		return errors.Wrapf(err, unmarshalErrMsg)
@@ -65,6 +68,12 @@ type Validator interface {
 	ValidateDeleteVirtualService(ctx context.Context, vs core.ResourceRef, dryRun bool) error
 	ValidateRouteTable(ctx context.Context, rt *v1.RouteTable, dryRun bool) (ProxyReports, error)
 	ValidateDeleteRouteTable(ctx context.Context, rt core.ResourceRef, dryRun bool) error
+	ValidateRouteOption(ctx context.Context, rto *v1.RouteOption, dryRun bool) (ProxyReports, error)
+	ValidateVirtualHostOption(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (ProxyReports, error)
+	ValidateReferencePolicy(ctx context.Context, policy *v1.ReferencePolicy, dryRun bool) (ProxyReports, error)
+	ValidateDeleteReferencePolicy(ctx context.Context, policy core.ResourceRef, dryRun bool) error
+	ValidateMatchableHttpGateway(ctx context.Context, hgw *v1.MatchableHttpGateway, dryRun bool) (ProxyReports, error)
+	ValidateDeleteMatchableHttpGateway(ctx context.Context, hgw core.ResourceRef, dryRun bool) error
 }
 
 type validator struct {
@@ -157,6 +166,13 @@ func (v *validator) deleteFromLocalSnapshot(resource resources.Resource) {
 				break
 			}
 		}
+	case *v1.MatchableHttpGateway:
+		for i, hgw := range v.latestSnapshot.HttpGateways {
+			if hgw.Metadata.Ref() == ref {
+				v.latestSnapshot.HttpGateways = append(v.latestSnapshot.HttpGateways[:i], v.latestSnapshot.HttpGateways[i+1:]...)
+				break
+			}
+		}
 	}
 }
 
@@ -328,6 +344,38 @@ func (v *validator) processItem(ctx context.Context, item unstructured.Unstructu
 			return ProxyReports{}, WrappedUnmarshalErr(unmarshalErr)
 		}
 		return v.validateRouteTableInternal(ctx, &rt, false, false)
+	case v1.RouteOptionGVK:
+		var (
+			rto v1.RouteOption
+		)
+		if unmarshalErr := skprotoutils.UnmarshalResource(jsonBytes, &rto); unmarshalErr != nil {
+			return ProxyReports{}, WrappedUnmarshalErr(unmarshalErr)
+		}
+		return v.validateRouteOptionInternal(ctx, &rto, false, false)
+	case v1.VirtualHostOptionGVK:
+		var (
+			vho v1.VirtualHostOption
+		)
+		if unmarshalErr := skprotoutils.UnmarshalResource(jsonBytes, &vho); unmarshalErr != nil {
+			return ProxyReports{}, WrappedUnmarshalErr(unmarshalErr)
+		}
+		return v.validateVirtualHostOptionInternal(ctx, &vho, false, false)
+	case v1.ReferencePolicyGVK:
+		var (
+			policy v1.ReferencePolicy
+		)
+		if unmarshalErr := skprotoutils.UnmarshalResource(jsonBytes, &policy); unmarshalErr != nil {
+			return ProxyReports{}, WrappedUnmarshalErr(unmarshalErr)
+		}
+		return v.validateReferencePolicyInternal(ctx, &policy, false, false)
+	case v1.MatchableHttpGatewayGVK:
+		var (
+			hgw v1.MatchableHttpGateway
+		)
+		if unmarshalErr := skprotoutils.UnmarshalResource(jsonBytes, &hgw); unmarshalErr != nil {
+			return ProxyReports{}, WrappedUnmarshalErr(unmarshalErr)
+		}
+		return v.validateMatchableHttpGatewayInternal(ctx, &hgw, false, false)
 	}
 	// should not happen
 	return ProxyReports{}, errors.Errorf("Unknown group/version/kind, %v", itemGvk)
@@ -487,6 +535,125 @@ func (v *validator) ValidateDeleteRouteTable(ctx context.Context, rtRef core.Res
 	return nil
 }
 
+func (v *validator) ValidateRouteOption(ctx context.Context, rto *v1.RouteOption, dryRun bool) (ProxyReports, error) {
+	return v.validateRouteOptionInternal(ctx, rto, dryRun, true)
+}
+
+func (v *validator) validateRouteOptionInternal(ctx context.Context, rto *v1.RouteOption, dryRun, acquireLock bool) (ProxyReports, error) {
+	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, core.ResourceRef) {
+		rtoRef := rto.GetMetadata().Ref()
+
+		// TODO: move this to a function when generics become a thing
+		var isUpdate bool
+		for i, existingRto := range snap.RouteOptions {
+			if rtoRef == existingRto.GetMetadata().Ref() {
+				// replace the existing route option in the snapshot
+				snap.RouteOptions[i] = rto
+				isUpdate = true
+				break
+			}
+		}
+		if !isUpdate {
+			snap.RouteOptions = append(snap.RouteOptions, rto)
+			snap.RouteOptions.Sort()
+		}
+
+		proxiesToConsider := proxiesForRouteOption(snap.Gateways, snap.VirtualServices, snap.RouteTables, rto)
+
+		return proxiesToConsider, rto, rtoRef
+	}
+
+	return v.validateSnapshot(ctx, apply, dryRun, acquireLock)
+}
+
+func (v *validator) ValidateVirtualHostOption(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (ProxyReports, error) {
+	return v.validateVirtualHostOptionInternal(ctx, vho, dryRun, true)
+}
+
+func (v *validator) validateVirtualHostOptionInternal(ctx context.Context, vho *v1.VirtualHostOption, dryRun, acquireLock bool) (ProxyReports, error) {
+	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, core.ResourceRef) {
+		vhoRef := vho.GetMetadata().Ref()
+
+		// TODO: move this to a function when generics become a thing
+		var isUpdate bool
+		for i, existingVho := range snap.VirtualHostOptions {
+			if vhoRef == existingVho.GetMetadata().Ref() {
+				// replace the existing virtual host option in the snapshot
+				snap.VirtualHostOptions[i] = vho
+				isUpdate = true
+				break
+			}
+		}
+		if !isUpdate {
+			snap.VirtualHostOptions = append(snap.VirtualHostOptions, vho)
+			snap.VirtualHostOptions.Sort()
+		}
+
+		proxiesToConsider := proxiesForVirtualHostOption(snap.Gateways, snap.VirtualServices, vho)
+
+		return proxiesToConsider, vho, vhoRef
+	}
+
+	return v.validateSnapshot(ctx, apply, dryRun, acquireLock)
+}
+
+func (v *validator) ValidateReferencePolicy(ctx context.Context, policy *v1.ReferencePolicy, dryRun bool) (ProxyReports, error) {
+	return v.validateReferencePolicyInternal(ctx, policy, dryRun, true)
+}
+
+func (v *validator) validateReferencePolicyInternal(ctx context.Context, policy *v1.ReferencePolicy, dryRun, acquireLock bool) (ProxyReports, error) {
+	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, core.ResourceRef) {
+		policyRef := policy.GetMetadata().Ref()
+
+		// TODO: move this to a function when generics become a thing
+		var isUpdate bool
+		for i, existingPolicy := range snap.ReferencePolicies {
+			if policyRef == existingPolicy.GetMetadata().Ref() {
+				// replace the existing reference policy in the snapshot
+				snap.ReferencePolicies[i] = policy
+				isUpdate = true
+				break
+			}
+		}
+		if !isUpdate {
+			snap.ReferencePolicies = append(snap.ReferencePolicies, policy)
+			snap.ReferencePolicies.Sort()
+		}
+
+		return proxiesForReferencePolicy(snap.Gateways), policy, policyRef
+	}
+
+	return v.validateSnapshot(ctx, apply, dryRun, acquireLock)
+}
+
+// Deleting a reference policy may deny the references it allowed, so the proxies are validated without it.
+func (v *validator) ValidateDeleteReferencePolicy(ctx context.Context, policyRef core.ResourceRef, dryRun bool) error {
+	if !v.ready() {
+		return errors.Errorf("Gateway validation is yet not available. Waiting for first snapshot")
+	}
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	policy, err := v.latestSnapshot.ReferencePolicies.Find(policyRef.Strings())
+	if err != nil {
+		// if it's not present in the snapshot, allow deletion
+		return nil
+	}
+
+	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, core.ResourceRef) {
+		for i, existingPolicy := range snap.ReferencePolicies {
+			if policyRef == existingPolicy.GetMetadata().Ref() {
+				snap.ReferencePolicies = append(snap.ReferencePolicies[:i], snap.ReferencePolicies[i+1:]...)
+				break
+			}
+		}
+		return proxiesForReferencePolicy(snap.Gateways), policy, policyRef
+	}
+
+	_, err = v.validateSnapshot(ctx, apply, dryRun, false)
+	return err
+}
+
 func (v *validator) ValidateGateway(ctx context.Context, gw *v1.Gateway, dryRun bool) (ProxyReports, error) {
 	return v.validateGatewayInternal(ctx, gw, dryRun, true)
 }
@@ -518,6 +685,76 @@ func (v *validator) validateGatewayInternal(ctx context.Context, gw *v1.Gateway,
 	return v.validateSnapshot(ctx, apply, dryRun, acquireLock)
 }
 
+func (v *validator) ValidateMatchableHttpGateway(ctx context.Context, hgw *v1.MatchableHttpGateway, dryRun bool) (ProxyReports, error) {
+	return v.validateMatchableHttpGatewayInternal(ctx, hgw, dryRun, true)
+}
+
+func (v *validator) validateMatchableHttpGatewayInternal(ctx context.Context, hgw *v1.MatchableHttpGateway, dryRun, acquireLock bool) (ProxyReports, error) {
+	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, core.ResourceRef) {
+		hgwRef := hgw.GetMetadata().Ref()
+
+		// TODO: move this to a function when generics become a thing
+		var isUpdate bool
+		for i, existingHgw := range snap.HttpGateways {
+			if hgwRef == existingHgw.GetMetadata().Ref() {
+				// replace the existing matchable http gateway in the snapshot
+				snap.HttpGateways[i] = hgw
+				isUpdate = true
+				break
+			}
+		}
+		if !isUpdate {
+			snap.HttpGateways = append(snap.HttpGateways, hgw)
+			snap.HttpGateways.Sort()
+		}
+
+		return proxiesForMatchableHttpGateway(snap.Gateways, hgw), hgw, hgwRef
+	}
+
+	return v.validateSnapshot(ctx, apply, dryRun, acquireLock)
+}
+
+// Gateways referencing a missing matchable http gateway are rejected, so its deletion is blocked while they reference it.
+// Gateways selecting it by labels only stop serving it.
+func (v *validator) ValidateDeleteMatchableHttpGateway(ctx context.Context, hgwRef core.ResourceRef, dryRun bool) error {
+	if !v.ready() {
+		return errors.Errorf("Gateway validation is yet not available. Waiting for first snapshot")
+	}
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	snap := v.latestSnapshot.Clone()
+
+	hgw, err := snap.HttpGateways.Find(hgwRef.Strings())
+	if err != nil {
+		// if it's not present in the snapshot, allow deletion
+		return nil
+	}
+
+	var parentGateways []core.ResourceRef
+	snap.Gateways.Each(func(element *v1.Gateway) {
+		if ref := element.GetHybridGateway().GetDelegatedHttpGateways().GetRef(); ref != nil && *ref == hgwRef {
+			// this gateway points at this matchable http gateway
+			parentGateways = append(parentGateways, element.Metadata.Ref())
+		}
+	})
+
+	if len(parentGateways) > 0 {
+		err := MatchableHttpGatewayDeleteErr(parentGateways)
+		if !v.allowWarnings {
+			contextutils.LoggerFrom(ctx).Infof("Rejected deletion of Matchable Http Gateway %v: %v", hgwRef, err)
+			return err
+		}
+		contextutils.LoggerFrom(ctx).Warn("Allowed deletion of Matchable Http Gateway %v with warning: %v", hgwRef, err)
+	} else {
+		contextutils.LoggerFrom(ctx).Debugw("Accepted deletion of Matchable Http Gateway %v", hgwRef)
+	}
+
+	if !dryRun {
+		v.deleteFromLocalSnapshot(hgw)
+	}
+	return nil
+}
+
 func proxiesForVirtualService(gwList v1.GatewayList, vs *v1.VirtualService) []string {
 
 	gatewaysByProxy := utils.GatewaysByProxyName(gwList)
@@ -537,8 +774,77 @@ func proxiesForVirtualService(gwList v1.GatewayList, vs *v1.VirtualService) []st
 }
 
 func proxiesForRouteTable(gwList v1.GatewayList, vsList v1.VirtualServiceList, rtList v1.RouteTableList, rt *v1.RouteTable) []string {
-	affectedVirtualServices := virtualServicesForRouteTable(rt, vsList, rtList)
+	return proxiesForVirtualServices(gwList, virtualServicesForRouteTable(rt, vsList, rtList))
+}
+
+// All the virtual services whose routes use the route option, directly or through the route tables they delegate to, are affected.
+func proxiesForRouteOption(gwList v1.GatewayList, vsList v1.VirtualServiceList, rtList v1.RouteTableList, rto *v1.RouteOption) []string {
+	affected := map[*v1.VirtualService]struct{}{}
+	var affectedVirtualServices v1.VirtualServiceList
+	addAffected := func(vs *v1.VirtualService) {
+		if _, ok := affected[vs]; !ok {
+			affected[vs] = struct{}{}
+			affectedVirtualServices = append(affectedVirtualServices, vs)
+		}
+	}
+
+	for _, vs := range vsList {
+		if routesUseOptions(vs.GetVirtualHost().GetRoutes(), vs.Metadata.Namespace, rto.Metadata) {
+			addAffected(vs)
+		}
+	}
+	for _, rt := range rtList {
+		if routesUseOptions(rt.GetRoutes(), rt.Metadata.Namespace, rto.Metadata) {
+			for _, vs := range virtualServicesForRouteTable(rt, vsList, rtList) {
+				addAffected(vs)
+			}
+		}
+	}
+
+	return proxiesForVirtualServices(gwList, affectedVirtualServices)
+}
+
+func proxiesForVirtualHostOption(gwList v1.GatewayList, vsList v1.VirtualServiceList, vho *v1.VirtualHostOption) []string {
+	var affectedVirtualServices v1.VirtualServiceList
+	for _, vs := range vsList {
+		if translator.OptionsRefsContain(vs.GetVirtualHost().GetOptionsConfigRefs(), vho.Metadata, vs.Metadata.Namespace) {
+			affectedVirtualServices = append(affectedVirtualServices, vs)
+		}
+	}
+
+	return proxiesForVirtualServices(gwList, affectedVirtualServices)
+}
+
+func proxiesForMatchableHttpGateway(gwList v1.GatewayList, hgw *v1.MatchableHttpGateway) []string {
+	var proxiesToConsider []string
+	for proxyName, gatewayList := range utils.GatewaysByProxyName(gwList) {
+		for _, gw := range gatewayList {
+			if translator.GatewayContainsMatchableHttpGateway(gw, hgw) {
+				// we only care about validating this proxy if it contains this matchable http gateway
+				proxiesToConsider = append(proxiesToConsider, proxyName)
+				break
+			}
+		}
+	}
+
+	sort.Strings(proxiesToConsider)
+
+	return proxiesToConsider
+}
+
+// Reference policies may allow or deny references from any namespace, so all the proxies are affected.
+func proxiesForReferencePolicy(gwList v1.GatewayList) []string {
+	var proxiesToConsider []string
+	for proxyName := range utils.GatewaysByProxyName(gwList) {
+		proxiesToConsider = append(proxiesToConsider, proxyName)
+	}
+
+	sort.Strings(proxiesToConsider)
 
+	return proxiesToConsider
+}
+
+func proxiesForVirtualServices(gwList v1.GatewayList, affectedVirtualServices v1.VirtualServiceList) []string {
 	affectedProxies := make(map[string]struct{})
 	for _, vs := range affectedVirtualServices {
 		proxiesToConsider := proxiesForVirtualService(gwList, vs)
@@ -606,6 +912,15 @@ func routesContainRefs(list []*v1.Route, refs refSet) bool {
 				continue
 			case *v1.DelegateAction_Ref:
 				routeTableRef = selectorType.Ref
+			case *v1.DelegateAction_Weighted:
+				for _, destination := range selectorType.Weighted.GetDestinations() {
+					if ref := destination.GetRef(); ref != nil {
+						if _, ok := refs[*ref]; ok {
+							return true
+						}
+					}
+				}
+				continue
 			}
 		}
 
@@ -620,6 +935,15 @@ func routesContainRefs(list []*v1.Route, refs refSet) bool {
 	return false
 }
 
+func routesUseOptions(list []*v1.Route, namespace string, options core.Metadata) bool {
+	for _, r := range list {
+		if translator.OptionsRefsContain(r.GetOptionsConfigRefs(), options, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
 func gatewayListContainsVirtualService(gwList v1.GatewayList, vs *v1.VirtualService) bool {
 	for _, gw := range gwList {
 		if translator.GatewayContainsVirtualService(gw, vs) {
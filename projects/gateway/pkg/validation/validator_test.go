@@ -11,6 +11,7 @@ import (
 
 	"github.com/solo-io/go-utils/testutils"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/rotisserie/eris"
 
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
@@ -21,6 +22,7 @@ import (
 	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/gloo/test/samples"
 	"google.golang.org/grpc"
@@ -435,6 +437,228 @@ var _ = Describe("Validator", func() {
 		})
 	})
 
+	Context("validating a route option", func() {
+		var (
+			snap *gatewayv1.ApiSnapshot
+			rto  *gatewayv1.RouteOption
+		)
+		BeforeEach(func() {
+			us := samples.SimpleUpstream()
+			snap = samples.GatewaySnapshotWithDelegates(us.Metadata.Ref(), ns)
+			rto = &gatewayv1.RouteOption{
+				Metadata: core.Metadata{Namespace: ns, Name: "retries"},
+				Options: &gloov1.RouteOptions{
+					PrefixRewrite: &types.StringValue{Value: "/"},
+				},
+			}
+			snap.RouteOptions = gatewayv1.RouteOptionList{rto}
+		})
+
+		It("validates the proxies of the virtual services whose routes use it through route tables", func() {
+			snap.RouteTables[0].Routes[0].OptionsConfigRefs = &gatewayv1.DelegateOptionsRefs{
+				DelegateOptions: []*core.ResourceRef{{Name: rto.Metadata.Name}},
+			}
+			vc.validateProxy = acceptProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			proxyReports, err := v.ValidateRouteOption(context.TODO(), rto, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyReports).To(HaveLen(1))
+		})
+
+		It("rejects the route option when a proxy using it is rejected", func() {
+			snap.VirtualServices[0].VirtualHost.Routes[0].OptionsConfigRefs = &gatewayv1.DelegateOptionsRefs{
+				DelegateOptions: []*core.ResourceRef{{Name: rto.Metadata.Name, Namespace: ns}},
+			}
+			vc.validateProxy = failProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			proxyReports, err := v.ValidateRouteOption(context.TODO(), rto, false)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to validate Proxy with Gloo validation server"))
+			Expect(proxyReports).To(HaveLen(1))
+		})
+
+		It("does not validate proxies when no route uses it", func() {
+			// validate proxy should never be called
+			vc.validateProxy = nil
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			proxyReports, err := v.ValidateRouteOption(context.TODO(), rto, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyReports).To(BeEmpty())
+		})
+	})
+
+	Context("validating a virtual host option", func() {
+		var (
+			snap *gatewayv1.ApiSnapshot
+			vho  *gatewayv1.VirtualHostOption
+		)
+		BeforeEach(func() {
+			us := samples.SimpleUpstream()
+			snap = samples.SimpleGatewaySnapshot(us.Metadata.Ref(), ns)
+			vho = &gatewayv1.VirtualHostOption{
+				Metadata: core.Metadata{Namespace: ns, Name: "headers", Labels: map[string]string{"shared": "true"}},
+				Options:  &gloov1.VirtualHostOptions{},
+			}
+			snap.VirtualHostOptions = gatewayv1.VirtualHostOptionList{vho}
+		})
+
+		It("validates the proxies of the virtual services selecting it", func() {
+			snap.VirtualServices[0].VirtualHost.OptionsConfigRefs = &gatewayv1.DelegateOptionsRefs{
+				Selector: map[string]string{"shared": "true"},
+			}
+			vc.validateProxy = acceptProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			proxyReports, err := v.ValidateVirtualHostOption(context.TODO(), vho, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyReports).To(HaveLen(1))
+		})
+
+		It("does not validate proxies when no virtual service uses it", func() {
+			// validate proxy should never be called
+			vc.validateProxy = nil
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			proxyReports, err := v.ValidateVirtualHostOption(context.TODO(), vho, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyReports).To(BeEmpty())
+		})
+	})
+
+	Context("validating a reference policy", func() {
+		var (
+			snap       *gatewayv1.ApiSnapshot
+			allowOther *gatewayv1.ReferencePolicy
+		)
+		BeforeEach(func() {
+			// the virtual service references the upstream in another namespace
+			us := samples.SimpleUpstream()
+			snap = samples.SimpleGatewaySnapshot(us.Metadata.Ref(), ns)
+			allowOther = &gatewayv1.ReferencePolicy{
+				Metadata: core.Metadata{Namespace: us.Metadata.Namespace, Name: "allow-other"},
+				From:     []*gatewayv1.ReferencePolicy_From{{Namespace: "other"}},
+				To:       []*gatewayv1.ReferencePolicy_To{{Kind: translator.UpstreamKind}},
+			}
+			vc.validateProxy = acceptProxy
+		})
+
+		It("accepts a policy allowing the existing references", func() {
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			allowOther.From = append(allowOther.From, &gatewayv1.ReferencePolicy_From{Namespace: ns})
+			_, err = v.ValidateReferencePolicy(context.TODO(), allowOther, false)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rejects a policy denying existing references", func() {
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = v.ValidateReferencePolicy(context.TODO(), allowOther, false)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("could not render proxy"))
+		})
+
+		It("rejects the deletion of a policy allowing existing references", func() {
+			allowNs := &gatewayv1.ReferencePolicy{
+				Metadata: core.Metadata{Namespace: allowOther.Metadata.Namespace, Name: "allow-ns"},
+				From:     []*gatewayv1.ReferencePolicy_From{{Namespace: ns}},
+				To:       []*gatewayv1.ReferencePolicy_To{{Kind: translator.UpstreamKind}},
+			}
+			snap.ReferencePolicies = gatewayv1.ReferencePolicyList{allowOther, allowNs}
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = v.ValidateDeleteReferencePolicy(context.TODO(), allowNs.Metadata.Ref(), false)
+			Expect(err).To(HaveOccurred())
+
+			err = v.ValidateDeleteReferencePolicy(context.TODO(), allowOther.Metadata.Ref(), false)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("validating a matchable http gateway", func() {
+		var (
+			snap *gatewayv1.ApiSnapshot
+			hgw  *gatewayv1.MatchableHttpGateway
+		)
+		BeforeEach(func() {
+			us := samples.SimpleUpstream()
+			snap = samples.SimpleGatewaySnapshot(us.Metadata.Ref(), ns)
+			hgw = &gatewayv1.MatchableHttpGateway{
+				Metadata:    core.Metadata{Namespace: ns, Name: "hgw", Labels: map[string]string{"team": "pets"}},
+				Matcher:     &gatewayv1.Matcher{},
+				HttpGateway: &gatewayv1.HttpGateway{},
+			}
+			hgwRef := hgw.Metadata.Ref()
+			snap.Gateways = append(snap.Gateways, &gatewayv1.Gateway{
+				Metadata:   core.Metadata{Namespace: ns, Name: "hybrid"},
+				ProxyNames: []string{"hybrid-proxy"},
+				BindPort:   9090,
+				GatewayType: &gatewayv1.Gateway_HybridGateway{HybridGateway: &gatewayv1.HybridGateway{
+					DelegatedHttpGateways: &gatewayv1.DelegatedHttpGateway{
+						SelectionType: &gatewayv1.DelegatedHttpGateway_Ref{Ref: &hgwRef},
+					},
+				}},
+			})
+			snap.HttpGateways = gatewayv1.MatchableHttpGatewayList{hgw}
+			vc.validateProxy = acceptProxy
+		})
+
+		It("validates the proxies of the hybrid gateways delegating to it", func() {
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			proxyReports, err := v.ValidateMatchableHttpGateway(context.TODO(), hgw, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyReports).To(HaveLen(1))
+			for proxy := range proxyReports {
+				Expect(proxy.Metadata.Name).To(Equal("hybrid-proxy"))
+			}
+
+			vc.validateProxy = failProxy
+			_, err = v.ValidateMatchableHttpGateway(context.TODO(), hgw, false)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to validate Proxy with Gloo validation server"))
+		})
+
+		It("does not validate proxies when no gateway delegates to it", func() {
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			other := &gatewayv1.MatchableHttpGateway{
+				Metadata:    core.Metadata{Namespace: ns, Name: "other"},
+				Matcher:     &gatewayv1.Matcher{},
+				HttpGateway: &gatewayv1.HttpGateway{},
+			}
+			proxyReports, err := v.ValidateMatchableHttpGateway(context.TODO(), other, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyReports).To(BeEmpty())
+		})
+
+		It("rejects the deletion of a matchable http gateway referenced by a gateway", func() {
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			err = v.ValidateDeleteMatchableHttpGateway(context.TODO(), hgw.Metadata.Ref(), false)
+			Expect(err).To(MatchError(MatchableHttpGatewayDeleteErr([]core.ResourceRef{{Namespace: ns, Name: "hybrid"}}).Error()))
+		})
+
+		It("deletes a matchable http gateway selected by labels safely", func() {
+			snap.Gateways[len(snap.Gateways)-1].GetHybridGateway().DelegatedHttpGateways.SelectionType = &gatewayv1.DelegatedHttpGateway_Selector{
+				Selector: &gatewayv1.HttpGatewaySelector{Labels: map[string]string{"team": "pets"}},
+			}
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			proxyReports, err := v.ValidateMatchableHttpGateway(context.TODO(), hgw, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyReports).To(HaveLen(1))
+
+			err = v.ValidateDeleteMatchableHttpGateway(context.TODO(), hgw.Metadata.Ref(), false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.latestSnapshot.HttpGateways).To(BeEmpty())
+		})
+	})
+
 	Context("validating a gateway", func() {
 
 		Context("proxy validation returns error", func() {
@@ -0,0 +1,380 @@
+package gatewayapi
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubewatch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+)
+
+// KubeResource is a wrapped gateway.networking.k8s.io resource
+type KubeResource interface {
+	resources.Resource
+	GetKubeSpec() *types.Any
+	GetKubeStatus() *types.Any
+}
+
+type resourceKind struct {
+	gvr           schema.GroupVersionResource
+	kind          string
+	clusterScoped bool
+}
+
+func (k resourceKind) typeUrl() string {
+	return k.gvr.GroupVersion().String() + "/" + k.kind
+}
+
+func kindOf(resource resources.Resource) (resourceKind, error) {
+	switch resource.(type) {
+	case *v1.GatewayClass:
+		return resourceKind{gvr: GatewayClassGVR, kind: GatewayClassKind, clusterScoped: true}, nil
+	case *v1.Gateway:
+		return resourceKind{gvr: GatewayGVR, kind: GatewayKind}, nil
+	case *v1.HttpRoute:
+		return resourceKind{gvr: HTTPRouteGVR, kind: HTTPRouteKind}, nil
+	case *v1.TlsRoute:
+		return resourceKind{gvr: TLSRouteGVR, kind: TLSRouteKind}, nil
+	case *v1.ReferenceGrant:
+		return resourceKind{gvr: ReferenceGrantGVR, kind: ReferenceGrantKind}, nil
+	}
+	return resourceKind{}, errors.Errorf("internal error: invalid resource %v passed to gateway api client", resources.Kind(resource))
+}
+
+// ResourceClient reads and writes the resources of the Kubernetes Gateway API with a dynamic client,
+// since the typed clients of the API are not available to Gloo.
+// GatewayClasses are cluster scoped, so they are listed and watched regardless of the given namespace.
+type ResourceClient struct {
+	client       dynamic.Interface
+	kind         resourceKind
+	resourceType resources.Resource
+}
+
+func NewResourceClient(client dynamic.Interface, resourceType resources.Resource) (*ResourceClient, error) {
+	kind, err := kindOf(resourceType)
+	if err != nil {
+		return nil, err
+	}
+	return &ResourceClient{
+		client:       client,
+		kind:         kind,
+		resourceType: resourceType,
+	}, nil
+}
+
+func FromKube(obj *unstructured.Unstructured, resourceType resources.Resource) (resources.Resource, error) {
+	kind, err := kindOf(resourceType)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := toAny(obj.Object["spec"], kind)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshalling kube %v spec", kind.kind)
+	}
+	status, err := toAny(obj.Object["status"], kind)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshalling kube %v status", kind.kind)
+	}
+
+	var meta metav1.ObjectMeta
+	if err := convert(obj.Object["metadata"], &meta); err != nil {
+		return nil, errors.Wrapf(err, "converting kube %v metadata", kind.kind)
+	}
+
+	resource := resources.Clone(resourceType)
+	switch resource := resource.(type) {
+	case *v1.GatewayClass:
+		resource.KubeSpec, resource.KubeStatus = spec, status
+	case *v1.Gateway:
+		resource.KubeSpec, resource.KubeStatus = spec, status
+	case *v1.HttpRoute:
+		resource.KubeSpec, resource.KubeStatus = spec, status
+	case *v1.TlsRoute:
+		resource.KubeSpec, resource.KubeStatus = spec, status
+	case *v1.ReferenceGrant:
+		resource.KubeSpec, resource.KubeStatus = spec, status
+	}
+	resource.SetMetadata(kubeutils.FromKubeMeta(meta))
+
+	return resource, nil
+}
+
+func ToKube(resource resources.Resource) (*unstructured.Unstructured, error) {
+	kind, err := kindOf(resource)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := resource.(KubeResource)
+	if wrapped.GetKubeSpec() == nil {
+		return nil, errors.Errorf("internal error: %v %v spec cannot be nil", kind.kind, resource.GetMetadata().Ref())
+	}
+
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	obj.SetAPIVersion(kind.gvr.GroupVersion().String())
+	obj.SetKind(kind.kind)
+
+	var spec interface{}
+	if err := json.Unmarshal(wrapped.GetKubeSpec().GetValue(), &spec); err != nil {
+		return nil, errors.Wrapf(err, "unmarshalling kube %v spec data", kind.kind)
+	}
+	obj.Object["spec"] = spec
+	if wrapped.GetKubeStatus() != nil {
+		var status interface{}
+		if err := json.Unmarshal(wrapped.GetKubeStatus().GetValue(), &status); err != nil {
+			return nil, errors.Wrapf(err, "unmarshalling kube %v status data", kind.kind)
+		}
+		obj.Object["status"] = status
+	}
+
+	meta := kubeutils.ToKubeMeta(resource.GetMetadata())
+	if kind.clusterScoped {
+		meta.Namespace = ""
+	}
+	var metadata interface{}
+	if err := convert(meta, &metadata); err != nil {
+		return nil, errors.Wrapf(err, "converting kube %v metadata", kind.kind)
+	}
+	obj.Object["metadata"] = metadata
+
+	return obj, nil
+}
+
+// DecodeSpec unmarshals the spec of a wrapped resource into the given object.
+func DecodeSpec(resource KubeResource, into interface{}) error {
+	if err := json.Unmarshal(resource.GetKubeSpec().GetValue(), into); err != nil {
+		return errors.Wrapf(err, "unmarshalling spec of %v", resource.GetMetadata().Ref())
+	}
+	return nil
+}
+
+// DecodeStatus unmarshals the status of a wrapped resource into the given object.
+// Resources without a status leave the object unchanged.
+func DecodeStatus(resource KubeResource, into interface{}) error {
+	if len(resource.GetKubeStatus().GetValue()) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(resource.GetKubeStatus().GetValue(), into); err != nil {
+		return errors.Wrapf(err, "unmarshalling status of %v", resource.GetMetadata().Ref())
+	}
+	return nil
+}
+
+// EncodeStatus marshals the given status into the representation used by the wrapped resource.
+func EncodeStatus(resource resources.Resource, status interface{}) (*types.Any, error) {
+	kind, err := kindOf(resource)
+	if err != nil {
+		return nil, err
+	}
+	return toAny(status, kind)
+}
+
+func toAny(value interface{}, kind resourceKind) (*types.Any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Any{
+		TypeUrl: kind.typeUrl(),
+		Value:   raw,
+	}, nil
+}
+
+func convert(in, out interface{}) error {
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, out)
+}
+
+var _ clients.ResourceClient = &ResourceClient{}
+
+func (rc *ResourceClient) Kind() string {
+	return resources.Kind(rc.resourceType)
+}
+
+func (rc *ResourceClient) NewResource() resources.Resource {
+	return resources.Clone(rc.resourceType)
+}
+
+func (rc *ResourceClient) Register() error {
+	return nil
+}
+
+func (rc *ResourceClient) resourceInterface(namespace string) dynamic.ResourceInterface {
+	if rc.kind.clusterScoped {
+		return rc.client.Resource(rc.kind.gvr)
+	}
+	return rc.client.Resource(rc.kind.gvr).Namespace(namespace)
+}
+
+func (rc *ResourceClient) namespace(namespace string) string {
+	if rc.kind.clusterScoped {
+		return ""
+	}
+	return clients.DefaultNamespaceIfEmpty(namespace)
+}
+
+func (rc *ResourceClient) Read(namespace, name string, opts clients.ReadOpts) (resources.Resource, error) {
+	if err := resources.ValidateName(name); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	opts = opts.WithDefaults()
+	namespace = rc.namespace(namespace)
+
+	obj, err := rc.resourceInterface(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errors.NewNotExistErr(namespace, name, err)
+		}
+		return nil, errors.Wrapf(err, "reading %v from kubernetes", rc.kind.kind)
+	}
+	return FromKube(obj, rc.resourceType)
+}
+
+func (rc *ResourceClient) Write(resource resources.Resource, opts clients.WriteOpts) (resources.Resource, error) {
+	opts = opts.WithDefaults()
+	if err := resources.Validate(resource); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	meta := resource.GetMetadata()
+	meta.Namespace = rc.namespace(meta.Namespace)
+
+	obj, err := ToKube(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := rc.Read(meta.Namespace, meta.Name, clients.ReadOpts{
+		Ctx: opts.Ctx,
+	})
+	if original != nil && err == nil {
+		if !opts.OverwriteExisting {
+			return nil, errors.NewExistErr(meta)
+		}
+		if meta.ResourceVersion != original.GetMetadata().ResourceVersion {
+			return nil, errors.NewResourceVersionErr(meta.Namespace, meta.Name, meta.ResourceVersion, original.GetMetadata().ResourceVersion)
+		}
+		updated, err := rc.resourceInterface(meta.Namespace).Update(obj, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "updating kube %v %v", rc.kind.kind, meta.Name)
+		}
+		// the status is a subresource, so it is written separately
+		if status, ok := obj.Object["status"]; ok {
+			updated.Object["status"] = status
+			if _, err := rc.resourceInterface(meta.Namespace).UpdateStatus(updated, metav1.UpdateOptions{}); err != nil {
+				return nil, errors.Wrapf(err, "updating kube %v status %v", rc.kind.kind, meta.Name)
+			}
+		}
+	} else {
+		if _, err := rc.resourceInterface(meta.Namespace).Create(obj, metav1.CreateOptions{}); err != nil {
+			return nil, errors.Wrapf(err, "creating kube %v %v", rc.kind.kind, meta.Name)
+		}
+	}
+
+	// return a read object to update the resource version
+	return rc.Read(meta.Namespace, meta.Name, clients.ReadOpts{Ctx: opts.Ctx})
+}
+
+func (rc *ResourceClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	opts = opts.WithDefaults()
+	namespace = rc.namespace(namespace)
+	if err := rc.resourceInterface(namespace).Delete(name, nil); err != nil {
+		if apierrors.IsNotFound(err) {
+			if !opts.IgnoreNotExist {
+				return errors.NewNotExistErr(namespace, name, err)
+			}
+			return nil
+		}
+		return errors.Wrapf(err, "deleting %v %v", rc.kind.kind, name)
+	}
+	return nil
+}
+
+func (rc *ResourceClient) List(namespace string, opts clients.ListOpts) (resources.ResourceList, error) {
+	opts = opts.WithDefaults()
+
+	objList, err := rc.resourceInterface(namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(opts.Selector).String(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing %v in %v", rc.kind.kind, namespace)
+	}
+	var resourceList resources.ResourceList
+	for i := range objList.Items {
+		resource, err := FromKube(&objList.Items[i], rc.resourceType)
+		if err != nil {
+			return nil, err
+		}
+		resourceList = append(resourceList, resource)
+	}
+
+	sort.SliceStable(resourceList, func(i, j int) bool {
+		return resourceList[i].GetMetadata().Name < resourceList[j].GetMetadata().Name
+	})
+
+	return resourceList, nil
+}
+
+func (rc *ResourceClient) Watch(namespace string, opts clients.WatchOpts) (<-chan resources.ResourceList, <-chan error, error) {
+	opts = opts.WithDefaults()
+	watch, err := rc.resourceInterface(namespace).Watch(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(opts.Selector).String(),
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "initiating kube watch in %v", namespace)
+	}
+	resourcesChan := make(chan resources.ResourceList)
+	errs := make(chan error)
+	updateResourceList := func() {
+		list, err := rc.List(namespace, clients.ListOpts{
+			Ctx:      opts.Ctx,
+			Selector: opts.Selector,
+		})
+		if err != nil {
+			errs <- err
+			return
+		}
+		resourcesChan <- list
+	}
+
+	go func() {
+		// watch should open up with an initial read
+		updateResourceList()
+		for {
+			select {
+			case <-time.After(opts.RefreshRate):
+				updateResourceList()
+			case event := <-watch.ResultChan():
+				switch event.Type {
+				case kubewatch.Error:
+					errs <- errors.Errorf("error during watch: %v", event)
+				default:
+					updateResourceList()
+				}
+			case <-opts.Ctx.Done():
+				watch.Stop()
+				close(resourcesChan)
+				close(errs)
+				return
+			}
+		}
+	}()
+
+	return resourcesChan, errs, nil
+}
@@ -0,0 +1,302 @@
+package gatewayapi
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// The subset of the gateway.networking.k8s.io API that is translated by Gloo.
+// The types mirror the upstream API, so the json representation of the resources is compatible with it.
+
+const (
+	GroupName = "gateway.networking.k8s.io"
+
+	GatewayClassKind   = "GatewayClass"
+	GatewayKind        = "Gateway"
+	HTTPRouteKind      = "HTTPRoute"
+	TLSRouteKind       = "TLSRoute"
+	ReferenceGrantKind = "ReferenceGrant"
+	ServiceKind        = "Service"
+	SecretKind         = "Secret"
+)
+
+var (
+	GatewayClassGVR   = schema.GroupVersionResource{Group: GroupName, Version: "v1beta1", Resource: "gatewayclasses"}
+	GatewayGVR        = schema.GroupVersionResource{Group: GroupName, Version: "v1beta1", Resource: "gateways"}
+	HTTPRouteGVR      = schema.GroupVersionResource{Group: GroupName, Version: "v1beta1", Resource: "httproutes"}
+	TLSRouteGVR       = schema.GroupVersionResource{Group: GroupName, Version: "v1alpha2", Resource: "tlsroutes"}
+	ReferenceGrantGVR = schema.GroupVersionResource{Group: GroupName, Version: "v1beta1", Resource: "referencegrants"}
+)
+
+type ProtocolType string
+
+const (
+	HTTPProtocolType  ProtocolType = "HTTP"
+	HTTPSProtocolType ProtocolType = "HTTPS"
+	TLSProtocolType   ProtocolType = "TLS"
+	TCPProtocolType   ProtocolType = "TCP"
+	UDPProtocolType   ProtocolType = "UDP"
+)
+
+type TLSModeType string
+
+const (
+	TLSModeTerminate   TLSModeType = "Terminate"
+	TLSModePassthrough TLSModeType = "Passthrough"
+)
+
+type FromNamespaces string
+
+const (
+	NamespacesFromAll      FromNamespaces = "All"
+	NamespacesFromSame     FromNamespaces = "Same"
+	NamespacesFromSelector FromNamespaces = "Selector"
+)
+
+type PathMatchType string
+
+const (
+	PathMatchExact             PathMatchType = "Exact"
+	PathMatchPathPrefix        PathMatchType = "PathPrefix"
+	PathMatchRegularExpression PathMatchType = "RegularExpression"
+)
+
+type MatchType string
+
+const (
+	MatchExact             MatchType = "Exact"
+	MatchRegularExpression MatchType = "RegularExpression"
+)
+
+type HTTPRouteFilterType string
+
+const (
+	HTTPRouteFilterRequestHeaderModifier  HTTPRouteFilterType = "RequestHeaderModifier"
+	HTTPRouteFilterResponseHeaderModifier HTTPRouteFilterType = "ResponseHeaderModifier"
+	HTTPRouteFilterRequestRedirect        HTTPRouteFilterType = "RequestRedirect"
+	HTTPRouteFilterURLRewrite             HTTPRouteFilterType = "URLRewrite"
+)
+
+type HTTPPathModifierType string
+
+const (
+	FullPathHTTPPathModifier    HTTPPathModifierType = "ReplaceFullPath"
+	PrefixMatchHTTPPathModifier HTTPPathModifierType = "ReplacePrefixMatch"
+)
+
+type ConditionStatus string
+
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// Condition mirrors the metav1.Condition type, which is not available in the kubernetes version used by Gloo.
+type Condition struct {
+	Type               string          `json:"type"`
+	Status             ConditionStatus `json:"status"`
+	ObservedGeneration int64           `json:"observedGeneration,omitempty"`
+	LastTransitionTime metav1.Time     `json:"lastTransitionTime"`
+	Reason             string          `json:"reason"`
+	Message            string          `json:"message"`
+}
+
+type GatewayClassSpec struct {
+	ControllerName string  `json:"controllerName"`
+	Description    *string `json:"description,omitempty"`
+}
+
+type GatewayClassStatus struct {
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+type GatewaySpec struct {
+	GatewayClassName string           `json:"gatewayClassName"`
+	Listeners        []Listener       `json:"listeners"`
+	Addresses        []GatewayAddress `json:"addresses,omitempty"`
+}
+
+type Listener struct {
+	Name          string            `json:"name"`
+	Hostname      *string           `json:"hostname,omitempty"`
+	Port          int32             `json:"port"`
+	Protocol      ProtocolType      `json:"protocol"`
+	TLS           *GatewayTLSConfig `json:"tls,omitempty"`
+	AllowedRoutes *AllowedRoutes    `json:"allowedRoutes,omitempty"`
+}
+
+type GatewayTLSConfig struct {
+	Mode            *TLSModeType            `json:"mode,omitempty"`
+	CertificateRefs []SecretObjectReference `json:"certificateRefs,omitempty"`
+}
+
+type SecretObjectReference struct {
+	Group     *string `json:"group,omitempty"`
+	Kind      *string `json:"kind,omitempty"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+type AllowedRoutes struct {
+	Namespaces *RouteNamespaces `json:"namespaces,omitempty"`
+	Kinds      []RouteGroupKind `json:"kinds,omitempty"`
+}
+
+type RouteNamespaces struct {
+	From     *FromNamespaces       `json:"from,omitempty"`
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+type RouteGroupKind struct {
+	Group *string `json:"group,omitempty"`
+	Kind  string  `json:"kind"`
+}
+
+type GatewayAddress struct {
+	Type  *string `json:"type,omitempty"`
+	Value string  `json:"value"`
+}
+
+type GatewayStatus struct {
+	Addresses  []GatewayAddress `json:"addresses,omitempty"`
+	Conditions []Condition      `json:"conditions,omitempty"`
+	Listeners  []ListenerStatus `json:"listeners,omitempty"`
+}
+
+type ListenerStatus struct {
+	Name           string           `json:"name"`
+	SupportedKinds []RouteGroupKind `json:"supportedKinds"`
+	AttachedRoutes int32            `json:"attachedRoutes"`
+	Conditions     []Condition      `json:"conditions"`
+}
+
+type ParentReference struct {
+	Group       *string `json:"group,omitempty"`
+	Kind        *string `json:"kind,omitempty"`
+	Namespace   *string `json:"namespace,omitempty"`
+	Name        string  `json:"name"`
+	SectionName *string `json:"sectionName,omitempty"`
+	Port        *int32  `json:"port,omitempty"`
+}
+
+type HTTPRouteSpec struct {
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+	Hostnames  []string          `json:"hostnames,omitempty"`
+	Rules      []HTTPRouteRule   `json:"rules,omitempty"`
+}
+
+type HTTPRouteRule struct {
+	Matches     []HTTPRouteMatch  `json:"matches,omitempty"`
+	Filters     []HTTPRouteFilter `json:"filters,omitempty"`
+	BackendRefs []BackendRef      `json:"backendRefs,omitempty"`
+}
+
+type HTTPRouteMatch struct {
+	Path        *HTTPPathMatch        `json:"path,omitempty"`
+	Headers     []HTTPHeaderMatch     `json:"headers,omitempty"`
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty"`
+	Method      *string               `json:"method,omitempty"`
+}
+
+type HTTPPathMatch struct {
+	Type  *PathMatchType `json:"type,omitempty"`
+	Value *string        `json:"value,omitempty"`
+}
+
+type HTTPHeaderMatch struct {
+	Type  *MatchType `json:"type,omitempty"`
+	Name  string     `json:"name"`
+	Value string     `json:"value"`
+}
+
+type HTTPQueryParamMatch struct {
+	Type  *MatchType `json:"type,omitempty"`
+	Name  string     `json:"name"`
+	Value string     `json:"value"`
+}
+
+type HTTPRouteFilter struct {
+	Type                   HTTPRouteFilterType        `json:"type"`
+	RequestHeaderModifier  *HTTPHeaderFilter          `json:"requestHeaderModifier,omitempty"`
+	ResponseHeaderModifier *HTTPHeaderFilter          `json:"responseHeaderModifier,omitempty"`
+	RequestRedirect        *HTTPRequestRedirectFilter `json:"requestRedirect,omitempty"`
+	URLRewrite             *HTTPURLRewriteFilter      `json:"urlRewrite,omitempty"`
+}
+
+type HTTPHeaderFilter struct {
+	Set    []HTTPHeader `json:"set,omitempty"`
+	Add    []HTTPHeader `json:"add,omitempty"`
+	Remove []string     `json:"remove,omitempty"`
+}
+
+type HTTPHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type HTTPRequestRedirectFilter struct {
+	Scheme     *string           `json:"scheme,omitempty"`
+	Hostname   *string           `json:"hostname,omitempty"`
+	Path       *HTTPPathModifier `json:"path,omitempty"`
+	Port       *int32            `json:"port,omitempty"`
+	StatusCode *int              `json:"statusCode,omitempty"`
+}
+
+type HTTPURLRewriteFilter struct {
+	Hostname *string           `json:"hostname,omitempty"`
+	Path     *HTTPPathModifier `json:"path,omitempty"`
+}
+
+type HTTPPathModifier struct {
+	Type               HTTPPathModifierType `json:"type"`
+	ReplaceFullPath    *string              `json:"replaceFullPath,omitempty"`
+	ReplacePrefixMatch *string              `json:"replacePrefixMatch,omitempty"`
+}
+
+type BackendRef struct {
+	Group     *string `json:"group,omitempty"`
+	Kind      *string `json:"kind,omitempty"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	Port      *int32  `json:"port,omitempty"`
+	Weight    *int32  `json:"weight,omitempty"`
+}
+
+type TLSRouteSpec struct {
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+	Hostnames  []string          `json:"hostnames,omitempty"`
+	Rules      []TLSRouteRule    `json:"rules,omitempty"`
+}
+
+type TLSRouteRule struct {
+	BackendRefs []BackendRef `json:"backendRefs,omitempty"`
+}
+
+// RouteStatus is the status of both HTTPRoutes and TLSRoutes.
+type RouteStatus struct {
+	Parents []RouteParentStatus `json:"parents,omitempty"`
+}
+
+type RouteParentStatus struct {
+	ParentRef      ParentReference `json:"parentRef"`
+	ControllerName string          `json:"controllerName"`
+	Conditions     []Condition     `json:"conditions,omitempty"`
+}
+
+type ReferenceGrantSpec struct {
+	From []ReferenceGrantFrom `json:"from"`
+	To   []ReferenceGrantTo   `json:"to"`
+}
+
+type ReferenceGrantFrom struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+}
+
+type ReferenceGrantTo struct {
+	Group string  `json:"group"`
+	Kind  string  `json:"kind"`
+	Name  *string `json:"name,omitempty"`
+}
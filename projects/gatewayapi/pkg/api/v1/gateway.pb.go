@@ -0,0 +1,149 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gatewayapi/api/v1/gateway.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A simple wrapper for a Kubernetes Gateway API Gateway Object.
+type Gateway struct {
+	// a raw byte representation of the spec of the Gateway this resource wraps
+	KubeSpec *types.Any `protobuf:"bytes,1,opt,name=kube_spec,json=kubeSpec,proto3" json:"kube_spec,omitempty"`
+	// a raw byte representation of the status of the Gateway this resource wraps
+	KubeStatus *types.Any `protobuf:"bytes,2,opt,name=kube_status,json=kubeStatus,proto3" json:"kube_status,omitempty"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Gateway) Reset()         { *m = Gateway{} }
+func (m *Gateway) String() string { return proto.CompactTextString(m) }
+func (*Gateway) ProtoMessage()    {}
+func (*Gateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_202f4a021e4fb60b, []int{0}
+}
+func (m *Gateway) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Gateway.Unmarshal(m, b)
+}
+func (m *Gateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Gateway.Marshal(b, m, deterministic)
+}
+func (m *Gateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gateway.Merge(m, src)
+}
+func (m *Gateway) XXX_Size() int {
+	return xxx_messageInfo_Gateway.Size(m)
+}
+func (m *Gateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gateway proto.InternalMessageInfo
+
+func (m *Gateway) GetKubeSpec() *types.Any {
+	if m != nil {
+		return m.KubeSpec
+	}
+	return nil
+}
+
+func (m *Gateway) GetKubeStatus() *types.Any {
+	if m != nil {
+		return m.KubeStatus
+	}
+	return nil
+}
+
+func (m *Gateway) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func init() {
+	proto.RegisterType((*Gateway)(nil), "gatewayapi.solo.io.Gateway")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gatewayapi/api/v1/gateway.proto", fileDescriptor_202f4a021e4fb60b)
+}
+
+var fileDescriptor_202f4a021e4fb60b = []byte{
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0x48, 0xcf, 0x2c, 0xc9,
+	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0xce, 0xcf, 0xc9, 0xd7, 0xcd, 0xcc, 0xd7, 0x4f,
+	0xcf, 0xc9, 0xcf, 0xd7, 0x2f, 0x28, 0xca, 0xcf, 0x4a, 0x4d, 0x2e, 0x29, 0xd6, 0x4f, 0x4f, 0x2c,
+	0x49, 0x2d, 0x4f, 0xac, 0x4c, 0x2c, 0xc8, 0xd4, 0x07, 0xe1, 0x32, 0x43, 0x98, 0x88, 0x5e, 0x41,
+	0x51, 0x7e, 0x49, 0xbe, 0x90, 0x10, 0x42, 0x81, 0x1e, 0xc8, 0x04, 0xbd, 0xcc, 0x7c, 0x29, 0x91,
+	0xf4, 0xfc, 0xf4, 0x7c, 0xb0, 0xb4, 0x3e, 0x88, 0x05, 0x51, 0x29, 0x25, 0x99, 0x9e, 0x9f, 0x9f,
+	0x9e, 0x93, 0xaa, 0x0f, 0xe6, 0x25, 0x95, 0xa6, 0xe9, 0x27, 0xe6, 0x41, 0x0d, 0x91, 0x92, 0x03,
+	0xdb, 0x9d, 0x9d, 0x59, 0x02, 0xb3, 0x23, 0x37, 0xb5, 0x24, 0x31, 0x25, 0xb1, 0x24, 0x11, 0x97,
+	0x3c, 0x8c, 0x0f, 0x95, 0x17, 0x4a, 0xad, 0x28, 0x81, 0xd8, 0x97, 0x5a, 0x01, 0x15, 0x53, 0x3a,
+	0xce, 0xc8, 0xc5, 0xee, 0x0e, 0x71, 0x9b, 0x90, 0x21, 0x17, 0x67, 0x76, 0x69, 0x52, 0x6a, 0x7c,
+	0x71, 0x41, 0x6a, 0xb2, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x88, 0x1e, 0xc4, 0x39, 0x7a,
+	0x30, 0xe7, 0xe8, 0x39, 0xe6, 0x55, 0x06, 0x71, 0x80, 0x94, 0x05, 0x17, 0xa4, 0x26, 0x0b, 0x59,
+	0x73, 0x71, 0x43, 0xb4, 0x94, 0x24, 0x96, 0x94, 0x16, 0x4b, 0x30, 0xe1, 0xd6, 0xe4, 0xc4, 0xb2,
+	0xe3, 0x2b, 0x0b, 0x63, 0x10, 0x17, 0x58, 0x2b, 0x58, 0xb5, 0x90, 0x05, 0x17, 0x07, 0xcc, 0x07,
+	0x12, 0xec, 0x60, 0x9d, 0x62, 0x7a, 0xc9, 0xf9, 0x45, 0xa9, 0xb0, 0x10, 0xd2, 0xf3, 0x85, 0xca,
+	0x3a, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x57, 0x6d, 0x25, 0xd4, 0xf4, 0x91, 0x85, 0x8f,
+	0x8b, 0x29, 0xbd, 0x5c, 0x88, 0x03, 0x1a, 0xb0, 0xc5, 0x4e, 0x0e, 0x20, 0xf3, 0x57, 0x3c, 0x92,
+	0x63, 0x8c, 0x32, 0x23, 0x25, 0xba, 0x0a, 0xb2, 0xd3, 0xa1, 0xc1, 0x95, 0xc4, 0x06, 0x76, 0xaf,
+	0x31, 0x60, 0x00, 0xab, 0x98, 0xd1, 0x9d, 0xef, 0x01, 0x00, 0x00,
+}
+
+func (this *Gateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Gateway)
+	if !ok {
+		that2, ok := that.(Gateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.KubeSpec.Equal(that1.KubeSpec) {
+		return false
+	}
+	if !this.KubeStatus.Equal(that1.KubeStatus) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gatewayapi/api/v1/gateway_class.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A simple wrapper for a Kubernetes Gateway API GatewayClass Object.
+type GatewayClass struct {
+	// a raw byte representation of the spec of the GatewayClass this resource wraps
+	KubeSpec *types.Any `protobuf:"bytes,1,opt,name=kube_spec,json=kubeSpec,proto3" json:"kube_spec,omitempty"`
+	// a raw byte representation of the status of the GatewayClass this resource wraps
+	KubeStatus *types.Any `protobuf:"bytes,2,opt,name=kube_status,json=kubeStatus,proto3" json:"kube_status,omitempty"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GatewayClass) Reset()         { *m = GatewayClass{} }
+func (m *GatewayClass) String() string { return proto.CompactTextString(m) }
+func (*GatewayClass) ProtoMessage()    {}
+func (*GatewayClass) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a699261c893850e9, []int{0}
+}
+func (m *GatewayClass) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayClass.Unmarshal(m, b)
+}
+func (m *GatewayClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayClass.Marshal(b, m, deterministic)
+}
+func (m *GatewayClass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayClass.Merge(m, src)
+}
+func (m *GatewayClass) XXX_Size() int {
+	return xxx_messageInfo_GatewayClass.Size(m)
+}
+func (m *GatewayClass) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayClass.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayClass proto.InternalMessageInfo
+
+func (m *GatewayClass) GetKubeSpec() *types.Any {
+	if m != nil {
+		return m.KubeSpec
+	}
+	return nil
+}
+
+func (m *GatewayClass) GetKubeStatus() *types.Any {
+	if m != nil {
+		return m.KubeStatus
+	}
+	return nil
+}
+
+func (m *GatewayClass) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func init() {
+	proto.RegisterType((*GatewayClass)(nil), "gatewayapi.solo.io.GatewayClass")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gatewayapi/api/v1/gateway_class.proto", fileDescriptor_a699261c893850e9)
+}
+
+var fileDescriptor_a699261c893850e9 = []byte{
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xff, 0xfc, 0x44, 0x50, 0x5c, 0x24, 0x24, 0xab, 0xaa, 0x4a, 0x86, 0x82, 0x98, 0x58,
+	0xb0, 0x55, 0x90, 0x10, 0x82, 0x05, 0x82, 0x04, 0x13, 0x4b, 0xd8, 0x58, 0x2a, 0xc7, 0x18, 0x13,
+	0x92, 0xf6, 0x5a, 0xb1, 0x43, 0x9b, 0x95, 0xa7, 0xe1, 0x11, 0x78, 0x04, 0x1e, 0x80, 0x99, 0x81,
+	0x37, 0x60, 0x60, 0x47, 0x76, 0x62, 0x10, 0x43, 0x07, 0x86, 0x48, 0xb9, 0xf7, 0x9c, 0xe3, 0xfb,
+	0xf9, 0x1a, 0x9d, 0xcb, 0xcc, 0xdc, 0x55, 0x29, 0xe1, 0x30, 0xa1, 0x1a, 0x0a, 0xd8, 0xcd, 0x80,
+	0xca, 0x02, 0x80, 0xaa, 0x12, 0xee, 0x05, 0x37, 0x9a, 0x4a, 0x66, 0xc4, 0x8c, 0xd5, 0x4c, 0x65,
+	0xd4, 0x7e, 0x0f, 0x23, 0xdf, 0x19, 0xf3, 0x82, 0x69, 0x4d, 0x54, 0x09, 0x06, 0x30, 0xfe, 0xb1,
+	0x11, 0x7b, 0x0e, 0xc9, 0x20, 0xea, 0x49, 0x90, 0xe0, 0x64, 0x6a, 0xff, 0x1a, 0x67, 0xb4, 0x21,
+	0x01, 0x64, 0x21, 0xa8, 0xab, 0xd2, 0xea, 0x96, 0xb2, 0x69, 0xdd, 0x4a, 0x43, 0x47, 0x90, 0x67,
+	0xc6, 0x4f, 0x9a, 0x08, 0xc3, 0x6e, 0x98, 0x61, 0x8b, 0x74, 0x5f, 0xb7, 0x3a, 0x16, 0x73, 0xd3,
+	0xcc, 0x13, 0xf3, 0xb6, 0xb7, 0xfd, 0x1a, 0xa0, 0xb5, 0x8b, 0x86, 0xed, 0xcc, 0xf2, 0xe2, 0x11,
+	0x5a, 0xcd, 0xab, 0x54, 0x8c, 0xb5, 0x12, 0x7c, 0x10, 0x6c, 0x05, 0x3b, 0xdd, 0xbd, 0x1e, 0x69,
+	0x98, 0x88, 0x67, 0x22, 0xa7, 0xd3, 0x3a, 0xe9, 0x58, 0xdb, 0x95, 0x12, 0x1c, 0x1f, 0xa3, 0x6e,
+	0x13, 0x31, 0xcc, 0x54, 0x7a, 0xf0, 0x7f, 0x71, 0x28, 0x0e, 0x9f, 0x3f, 0xc3, 0x20, 0x41, 0x2e,
+	0xea, 0xdc, 0xf8, 0x10, 0x75, 0xfc, 0x35, 0x06, 0x2b, 0x2e, 0xd9, 0x27, 0x1c, 0x4a, 0xe1, 0xd7,
+	0x44, 0x2e, 0x5b, 0x35, 0x0e, 0x5f, 0xde, 0x36, 0xff, 0x25, 0xdf, 0xee, 0xa3, 0xe8, 0xf1, 0x23,
+	0xec, 0xa3, 0x25, 0x39, 0xe3, 0x78, 0xfd, 0xd7, 0xce, 0x85, 0x8e, 0x4f, 0xec, 0x9c, 0xa7, 0xf7,
+	0x61, 0x70, 0x7d, 0xf0, 0x97, 0x17, 0x54, 0xb9, 0x6c, 0x77, 0x97, 0x2e, 0x3b, 0xee, 0xfd, 0xaf,
+	0x01, 0x00, 0xd5, 0xa0, 0x12, 0x0d, 0x02, 0x02, 0x00, 0x00,
+}
+
+func (this *GatewayClass) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayClass)
+	if !ok {
+		that2, ok := that.(GatewayClass)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.KubeSpec.Equal(that1.KubeSpec) {
+		return false
+	}
+	if !this.KubeStatus.Equal(that1.KubeStatus) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
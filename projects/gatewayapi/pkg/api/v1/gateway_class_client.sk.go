@@ -0,0 +1,123 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+type GatewayClassWatcher interface {
+	// watch namespace-scoped GatewayClasses
+	Watch(namespace string, opts clients.WatchOpts) (<-chan GatewayClassList, <-chan error, error)
+}
+
+type GatewayClassClient interface {
+	BaseClient() clients.ResourceClient
+	Register() error
+	Read(namespace, name string, opts clients.ReadOpts) (*GatewayClass, error)
+	Write(resource *GatewayClass, opts clients.WriteOpts) (*GatewayClass, error)
+	Delete(namespace, name string, opts clients.DeleteOpts) error
+	List(namespace string, opts clients.ListOpts) (GatewayClassList, error)
+	GatewayClassWatcher
+}
+
+type gatewayClassClient struct {
+	rc clients.ResourceClient
+}
+
+func NewGatewayClassClient(rcFactory factory.ResourceClientFactory) (GatewayClassClient, error) {
+	return NewGatewayClassClientWithToken(rcFactory, "")
+}
+
+func NewGatewayClassClientWithToken(rcFactory factory.ResourceClientFactory, token string) (GatewayClassClient, error) {
+	rc, err := rcFactory.NewResourceClient(factory.NewResourceClientParams{
+		ResourceType: &GatewayClass{},
+		Token:        token,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating base GatewayClass resource client")
+	}
+	return NewGatewayClassClientWithBase(rc), nil
+}
+
+func NewGatewayClassClientWithBase(rc clients.ResourceClient) GatewayClassClient {
+	return &gatewayClassClient{
+		rc: rc,
+	}
+}
+
+func (client *gatewayClassClient) BaseClient() clients.ResourceClient {
+	return client.rc
+}
+
+func (client *gatewayClassClient) Register() error {
+	return client.rc.Register()
+}
+
+func (client *gatewayClassClient) Read(namespace, name string, opts clients.ReadOpts) (*GatewayClass, error) {
+	opts = opts.WithDefaults()
+
+	resource, err := client.rc.Read(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resource.(*GatewayClass), nil
+}
+
+func (client *gatewayClassClient) Write(gatewayClass *GatewayClass, opts clients.WriteOpts) (*GatewayClass, error) {
+	opts = opts.WithDefaults()
+	resource, err := client.rc.Write(gatewayClass, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resource.(*GatewayClass), nil
+}
+
+func (client *gatewayClassClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	opts = opts.WithDefaults()
+
+	return client.rc.Delete(namespace, name, opts)
+}
+
+func (client *gatewayClassClient) List(namespace string, opts clients.ListOpts) (GatewayClassList, error) {
+	opts = opts.WithDefaults()
+
+	resourceList, err := client.rc.List(namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertToGatewayClass(resourceList), nil
+}
+
+func (client *gatewayClassClient) Watch(namespace string, opts clients.WatchOpts) (<-chan GatewayClassList, <-chan error, error) {
+	opts = opts.WithDefaults()
+
+	resourcesChan, errs, initErr := client.rc.Watch(namespace, opts)
+	if initErr != nil {
+		return nil, nil, initErr
+	}
+	gatewayClassesChan := make(chan GatewayClassList)
+	go func() {
+		for {
+			select {
+			case resourceList := <-resourcesChan:
+				gatewayClassesChan <- convertToGatewayClass(resourceList)
+			case <-opts.Ctx.Done():
+				close(gatewayClassesChan)
+				return
+			}
+		}
+	}()
+	return gatewayClassesChan, errs, nil
+}
+
+func convertToGatewayClass(resources resources.ResourceList) GatewayClassList {
+	var gatewayClassList GatewayClassList
+	for _, resource := range resources {
+		gatewayClassList = append(gatewayClassList, resource.(*GatewayClass))
+	}
+	return gatewayClassList
+}
@@ -0,0 +1,47 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reconcile"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+// Option to copy anything from the original to the desired before writing. Return value of false means don't update
+type TransitionGatewayClassFunc func(original, desired *GatewayClass) (bool, error)
+
+type GatewayClassReconciler interface {
+	Reconcile(namespace string, desiredResources GatewayClassList, transition TransitionGatewayClassFunc, opts clients.ListOpts) error
+}
+
+func gatewayClasssToResources(list GatewayClassList) resources.ResourceList {
+	var resourceList resources.ResourceList
+	for _, gatewayClass := range list {
+		resourceList = append(resourceList, gatewayClass)
+	}
+	return resourceList
+}
+
+func NewGatewayClassReconciler(client GatewayClassClient) GatewayClassReconciler {
+	return &gatewayClassReconciler{
+		base: reconcile.NewReconciler(client.BaseClient()),
+	}
+}
+
+type gatewayClassReconciler struct {
+	base reconcile.Reconciler
+}
+
+func (r *gatewayClassReconciler) Reconcile(namespace string, desiredResources GatewayClassList, transition TransitionGatewayClassFunc, opts clients.ListOpts) error {
+	opts = opts.WithDefaults()
+	opts.Ctx = contextutils.WithLogger(opts.Ctx, "gatewayClass_reconciler")
+	var transitionResources reconcile.TransitionResourcesFunc
+	if transition != nil {
+		transitionResources = func(original, desired resources.Resource) (bool, error) {
+			return transition(original.(*GatewayClass), desired.(*GatewayClass))
+		}
+	}
+	return r.base.Reconcile(namespace, gatewayClasssToResources(desiredResources), transitionResources, opts)
+}
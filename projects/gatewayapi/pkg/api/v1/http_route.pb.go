@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gatewayapi/api/v1/http_route.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A simple wrapper for an Kubernetes Gateway API HTTPRoute Object.
+type HttpRoute struct {
+	// a raw byte representation of the spec of the HTTPRoute this resource wraps
+	KubeSpec *types.Any `protobuf:"bytes,1,opt,name=kube_spec,json=kubeSpec,proto3" json:"kube_spec,omitempty"`
+	// a raw byte representation of the status of the HTTPRoute this resource wraps
+	KubeStatus *types.Any `protobuf:"bytes,2,opt,name=kube_status,json=kubeStatus,proto3" json:"kube_status,omitempty"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *HttpRoute) Reset()         { *m = HttpRoute{} }
+func (m *HttpRoute) String() string { return proto.CompactTextString(m) }
+func (*HttpRoute) ProtoMessage()    {}
+func (*HttpRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544786ff208613b4, []int{0}
+}
+func (m *HttpRoute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HttpRoute.Unmarshal(m, b)
+}
+func (m *HttpRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HttpRoute.Marshal(b, m, deterministic)
+}
+func (m *HttpRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpRoute.Merge(m, src)
+}
+func (m *HttpRoute) XXX_Size() int {
+	return xxx_messageInfo_HttpRoute.Size(m)
+}
+func (m *HttpRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HttpRoute proto.InternalMessageInfo
+
+func (m *HttpRoute) GetKubeSpec() *types.Any {
+	if m != nil {
+		return m.KubeSpec
+	}
+	return nil
+}
+
+func (m *HttpRoute) GetKubeStatus() *types.Any {
+	if m != nil {
+		return m.KubeStatus
+	}
+	return nil
+}
+
+func (m *HttpRoute) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func init() {
+	proto.RegisterType((*HttpRoute)(nil), "gatewayapi.solo.io.HttpRoute")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gatewayapi/api/v1/http_route.proto", fileDescriptor_544786ff208613b4)
+}
+
+var fileDescriptor_544786ff208613b4 = []byte{
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0xb1, 0x4e, 0xc3, 0x30,
+	0x14, 0x24, 0x28, 0x82, 0xd6, 0xdd, 0xac, 0x0a, 0x85, 0x0a, 0x15, 0xc4, 0xc4, 0x82, 0xad, 0x82,
+	0x84, 0x10, 0x2c, 0x50, 0x16, 0x16, 0x96, 0xb0, 0xb1, 0x54, 0x4e, 0x78, 0xb8, 0x21, 0x69, 0x9f,
+	0x95, 0xbc, 0x40, 0xb3, 0xf2, 0x35, 0x7c, 0x02, 0x9f, 0xc0, 0xce, 0xce, 0xc0, 0x1f, 0x30, 0xb0,
+	0x23, 0x3b, 0x09, 0x9d, 0x3a, 0x30, 0x58, 0xf2, 0xf3, 0xdd, 0xf9, 0xce, 0x67, 0x76, 0xa5, 0x13,
+	0x9a, 0x96, 0x91, 0x88, 0x71, 0x26, 0x0b, 0xcc, 0xf0, 0x30, 0x41, 0xa9, 0x33, 0x44, 0x69, 0x72,
+	0x7c, 0x84, 0x98, 0x0a, 0xa9, 0x15, 0xc1, 0xb3, 0xaa, 0x94, 0x49, 0xa4, 0x5d, 0x4f, 0x23, 0x39,
+	0x25, 0x32, 0x93, 0x1c, 0x4b, 0x02, 0x61, 0x72, 0x24, 0xe4, 0x7c, 0xc9, 0x11, 0xf6, 0x12, 0x91,
+	0xe0, 0xa0, 0xaf, 0x51, 0xa3, 0x83, 0xa5, 0xdd, 0xd5, 0xcc, 0xc1, 0xb6, 0x46, 0xd4, 0x19, 0x48,
+	0x37, 0x45, 0xe5, 0x83, 0x54, 0xf3, 0xaa, 0x81, 0x86, 0xce, 0x3e, 0x4d, 0xa8, 0xb5, 0x99, 0x01,
+	0xa9, 0x7b, 0x45, 0x6a, 0x15, 0xde, 0xce, 0x0d, 0xce, 0x61, 0x41, 0xb5, 0x1f, 0x2c, 0x9a, 0xb3,
+	0xfd, 0x0f, 0x8f, 0x75, 0xaf, 0x89, 0x4c, 0x68, 0xc3, 0xf2, 0x11, 0xeb, 0xa6, 0x65, 0x04, 0x93,
+	0xc2, 0x40, 0x1c, 0x78, 0x7b, 0xde, 0x41, 0xef, 0xa8, 0x2f, 0xea, 0x40, 0xa2, 0x0d, 0x24, 0x2e,
+	0xe7, 0x55, 0xd8, 0xb1, 0xb4, 0x5b, 0x03, 0x31, 0x3f, 0x67, 0xbd, 0x5a, 0x42, 0x8a, 0xca, 0x22,
+	0x58, 0x5f, 0x2d, 0x1a, 0xfb, 0x6f, 0x3f, 0xbe, 0x17, 0x32, 0x27, 0x75, 0x6c, 0x7e, 0xca, 0x3a,
+	0xed, 0x1b, 0x82, 0x4d, 0xa7, 0xdc, 0x12, 0x31, 0xe6, 0xd0, 0x76, 0x24, 0x6e, 0x1a, 0x74, 0xec,
+	0xbf, 0x7f, 0xee, 0xae, 0x85, 0x7f, 0xec, 0xb3, 0x9d, 0x97, 0x6f, 0x3f, 0x60, 0x5d, 0x5b, 0xb4,
+	0xeb, 0x99, 0xf7, 0x96, 0x9d, 0x17, 0xe3, 0x0b, 0xeb, 0xf4, 0xfa, 0x35, 0xf4, 0xee, 0x4e, 0xfe,
+	0xf3, 0x7b, 0x26, 0xd5, 0x4d, 0x75, 0xd1, 0x86, 0x4b, 0x7e, 0xfc, 0x3b, 0x00, 0xef, 0x74, 0x59,
+	0x33, 0xfe, 0x01, 0x00, 0x00,
+}
+
+func (this *HttpRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HttpRoute)
+	if !ok {
+		that2, ok := that.(HttpRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.KubeSpec.Equal(that1.KubeSpec) {
+		return false
+	}
+	if !this.KubeStatus.Equal(that1.KubeStatus) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
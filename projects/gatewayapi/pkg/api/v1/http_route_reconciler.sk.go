@@ -0,0 +1,47 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reconcile"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+// Option to copy anything from the original to the desired before writing. Return value of false means don't update
+type TransitionHttpRouteFunc func(original, desired *HttpRoute) (bool, error)
+
+type HttpRouteReconciler interface {
+	Reconcile(namespace string, desiredResources HttpRouteList, transition TransitionHttpRouteFunc, opts clients.ListOpts) error
+}
+
+func httpRoutesToResources(list HttpRouteList) resources.ResourceList {
+	var resourceList resources.ResourceList
+	for _, httpRoute := range list {
+		resourceList = append(resourceList, httpRoute)
+	}
+	return resourceList
+}
+
+func NewHttpRouteReconciler(client HttpRouteClient) HttpRouteReconciler {
+	return &httpRouteReconciler{
+		base: reconcile.NewReconciler(client.BaseClient()),
+	}
+}
+
+type httpRouteReconciler struct {
+	base reconcile.Reconciler
+}
+
+func (r *httpRouteReconciler) Reconcile(namespace string, desiredResources HttpRouteList, transition TransitionHttpRouteFunc, opts clients.ListOpts) error {
+	opts = opts.WithDefaults()
+	opts.Ctx = contextutils.WithLogger(opts.Ctx, "httpRoute_reconciler")
+	var transitionResources reconcile.TransitionResourcesFunc
+	if transition != nil {
+		transitionResources = func(original, desired resources.Resource) (bool, error) {
+			return transition(original.(*HttpRoute), desired.(*HttpRoute))
+		}
+	}
+	return r.base.Reconcile(namespace, httpRoutesToResources(desiredResources), transitionResources, opts)
+}
@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gatewayapi/api/v1/reference_grant.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A simple wrapper for a Kubernetes Gateway API ReferenceGrant Object.
+type ReferenceGrant struct {
+	// a raw byte representation of the spec of the ReferenceGrant this resource wraps
+	KubeSpec *types.Any `protobuf:"bytes,1,opt,name=kube_spec,json=kubeSpec,proto3" json:"kube_spec,omitempty"`
+	// a raw byte representation of the status of the ReferenceGrant this resource wraps
+	KubeStatus *types.Any `protobuf:"bytes,2,opt,name=kube_status,json=kubeStatus,proto3" json:"kube_status,omitempty"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReferenceGrant) Reset()         { *m = ReferenceGrant{} }
+func (m *ReferenceGrant) String() string { return proto.CompactTextString(m) }
+func (*ReferenceGrant) ProtoMessage()    {}
+func (*ReferenceGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c95fdfee4d316cb9, []int{0}
+}
+func (m *ReferenceGrant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReferenceGrant.Unmarshal(m, b)
+}
+func (m *ReferenceGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReferenceGrant.Marshal(b, m, deterministic)
+}
+func (m *ReferenceGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferenceGrant.Merge(m, src)
+}
+func (m *ReferenceGrant) XXX_Size() int {
+	return xxx_messageInfo_ReferenceGrant.Size(m)
+}
+func (m *ReferenceGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferenceGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferenceGrant proto.InternalMessageInfo
+
+func (m *ReferenceGrant) GetKubeSpec() *types.Any {
+	if m != nil {
+		return m.KubeSpec
+	}
+	return nil
+}
+
+func (m *ReferenceGrant) GetKubeStatus() *types.Any {
+	if m != nil {
+		return m.KubeStatus
+	}
+	return nil
+}
+
+func (m *ReferenceGrant) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func init() {
+	proto.RegisterType((*ReferenceGrant)(nil), "gatewayapi.solo.io.ReferenceGrant")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gatewayapi/api/v1/reference_grant.proto", fileDescriptor_c95fdfee4d316cb9)
+}
+
+var fileDescriptor_c95fdfee4d316cb9 = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0x31, 0x4b, 0x03, 0x31,
+	0x18, 0xf5, 0xe4, 0xd0, 0x9a, 0x82, 0x48, 0x28, 0x52, 0x8b, 0xd4, 0xe2, 0xe4, 0x62, 0x42, 0x15,
+	0x44, 0x74, 0xd1, 0x2e, 0xba, 0xb8, 0x9c, 0x9b, 0x4b, 0xc9, 0xc5, 0xaf, 0xf1, 0x6c, 0x7b, 0x5f,
+	0xc8, 0xe5, 0xb4, 0x5d, 0xfd, 0x35, 0xfe, 0x04, 0x7f, 0x82, 0x3f, 0x42, 0x1c, 0xfc, 0x07, 0x0e,
+	0xee, 0x92, 0xdc, 0x45, 0x41, 0xe8, 0xe0, 0x10, 0xc8, 0x97, 0xf7, 0x5e, 0xde, 0xcb, 0x0b, 0xb9,
+	0x54, 0x99, 0xbd, 0x2b, 0x53, 0x26, 0x71, 0xca, 0x0b, 0x9c, 0xe0, 0x7e, 0x86, 0x5c, 0x4d, 0x10,
+	0xb9, 0x36, 0x78, 0x0f, 0xd2, 0x16, 0x5c, 0x09, 0x0b, 0x8f, 0x62, 0x2e, 0x74, 0xc6, 0xdd, 0x7a,
+	0xe8, 0x73, 0x03, 0x23, 0x30, 0x90, 0x4b, 0x18, 0x2a, 0x23, 0x72, 0xcb, 0xb4, 0x41, 0x8b, 0x94,
+	0xfe, 0x12, 0x99, 0xbb, 0x89, 0x65, 0xd8, 0x69, 0x29, 0x54, 0xe8, 0x61, 0xee, 0x76, 0x15, 0xb3,
+	0xb3, 0xa5, 0x10, 0xd5, 0x04, 0xb8, 0x9f, 0xd2, 0x72, 0xc4, 0x45, 0x3e, 0xaf, 0xa1, 0xae, 0xcf,
+	0x30, 0xce, 0x6c, 0xf0, 0x9a, 0x82, 0x15, 0xb7, 0xc2, 0x8a, 0x45, 0x78, 0x98, 0x6b, 0x9c, 0xc2,
+	0xcc, 0x56, 0x7e, 0x30, 0xab, 0xcf, 0x76, 0xdf, 0x22, 0xb2, 0x9e, 0x84, 0xc8, 0x17, 0x2e, 0x31,
+	0xed, 0x93, 0xb5, 0x71, 0x99, 0xc2, 0xb0, 0xd0, 0x20, 0xdb, 0x51, 0x2f, 0xda, 0x6b, 0x1e, 0xb4,
+	0x58, 0x95, 0x8a, 0x85, 0x54, 0xec, 0x3c, 0x9f, 0x27, 0x0d, 0x47, 0xbb, 0xd6, 0x20, 0xe9, 0x29,
+	0x69, 0x56, 0x12, 0x2b, 0x6c, 0x59, 0xb4, 0x97, 0x17, 0x8b, 0x06, 0xf1, 0xcb, 0x57, 0x1c, 0x25,
+	0xc4, 0x4b, 0x3d, 0x9b, 0x1e, 0x93, 0x46, 0x78, 0x48, 0x7b, 0xd5, 0x2b, 0x37, 0x99, 0x44, 0x03,
+	0xa1, 0x28, 0x76, 0x55, 0xa3, 0x83, 0xf8, 0xf5, 0x7d, 0x67, 0x29, 0xf9, 0x61, 0x9f, 0xf4, 0x9e,
+	0x3e, 0xe3, 0x6d, 0xd2, 0x30, 0x30, 0xf2, 0x5d, 0xd3, 0x8d, 0x3f, 0xe5, 0x17, 0x83, 0x33, 0xe7,
+	0xf6, 0xfc, 0xd1, 0x8d, 0x6e, 0x8e, 0xfe, 0xf3, 0x97, 0x7a, 0xac, 0xea, 0x0e, 0xd3, 0x15, 0x9f,
+	0xfe, 0xf0, 0x7b, 0x00, 0xeb, 0x24, 0x93, 0x8f, 0x0c, 0x02, 0x00, 0x00,
+}
+
+func (this *ReferenceGrant) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReferenceGrant)
+	if !ok {
+		that2, ok := that.(ReferenceGrant)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.KubeSpec.Equal(that1.KubeSpec) {
+		return false
+	}
+	if !this.KubeStatus.Equal(that1.KubeStatus) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
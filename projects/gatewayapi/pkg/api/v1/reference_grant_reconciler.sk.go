@@ -0,0 +1,47 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reconcile"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+// Option to copy anything from the original to the desired before writing. Return value of false means don't update
+type TransitionReferenceGrantFunc func(original, desired *ReferenceGrant) (bool, error)
+
+type ReferenceGrantReconciler interface {
+	Reconcile(namespace string, desiredResources ReferenceGrantList, transition TransitionReferenceGrantFunc, opts clients.ListOpts) error
+}
+
+func referenceGrantsToResources(list ReferenceGrantList) resources.ResourceList {
+	var resourceList resources.ResourceList
+	for _, referenceGrant := range list {
+		resourceList = append(resourceList, referenceGrant)
+	}
+	return resourceList
+}
+
+func NewReferenceGrantReconciler(client ReferenceGrantClient) ReferenceGrantReconciler {
+	return &referenceGrantReconciler{
+		base: reconcile.NewReconciler(client.BaseClient()),
+	}
+}
+
+type referenceGrantReconciler struct {
+	base reconcile.Reconciler
+}
+
+func (r *referenceGrantReconciler) Reconcile(namespace string, desiredResources ReferenceGrantList, transition TransitionReferenceGrantFunc, opts clients.ListOpts) error {
+	opts = opts.WithDefaults()
+	opts.Ctx = contextutils.WithLogger(opts.Ctx, "referenceGrant_reconciler")
+	var transitionResources reconcile.TransitionResourcesFunc
+	if transition != nil {
+		transitionResources = func(original, desired resources.Resource) (bool, error) {
+			return transition(original.(*ReferenceGrant), desired.(*ReferenceGrant))
+		}
+	}
+	return r.base.Reconcile(namespace, referenceGrantsToResources(desiredResources), transitionResources, opts)
+}
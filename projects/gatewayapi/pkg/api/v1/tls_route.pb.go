@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gatewayapi/api/v1/tls_route.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A simple wrapper for a Kubernetes Gateway API TLSRoute Object.
+type TlsRoute struct {
+	// a raw byte representation of the spec of the TLSRoute this resource wraps
+	KubeSpec *types.Any `protobuf:"bytes,1,opt,name=kube_spec,json=kubeSpec,proto3" json:"kube_spec,omitempty"`
+	// a raw byte representation of the status of the TLSRoute this resource wraps
+	KubeStatus *types.Any `protobuf:"bytes,2,opt,name=kube_status,json=kubeStatus,proto3" json:"kube_status,omitempty"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TlsRoute) Reset()         { *m = TlsRoute{} }
+func (m *TlsRoute) String() string { return proto.CompactTextString(m) }
+func (*TlsRoute) ProtoMessage()    {}
+func (*TlsRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc016481729dbe0, []int{0}
+}
+func (m *TlsRoute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TlsRoute.Unmarshal(m, b)
+}
+func (m *TlsRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TlsRoute.Marshal(b, m, deterministic)
+}
+func (m *TlsRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TlsRoute.Merge(m, src)
+}
+func (m *TlsRoute) XXX_Size() int {
+	return xxx_messageInfo_TlsRoute.Size(m)
+}
+func (m *TlsRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_TlsRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TlsRoute proto.InternalMessageInfo
+
+func (m *TlsRoute) GetKubeSpec() *types.Any {
+	if m != nil {
+		return m.KubeSpec
+	}
+	return nil
+}
+
+func (m *TlsRoute) GetKubeStatus() *types.Any {
+	if m != nil {
+		return m.KubeStatus
+	}
+	return nil
+}
+
+func (m *TlsRoute) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func init() {
+	proto.RegisterType((*TlsRoute)(nil), "gatewayapi.solo.io.TlsRoute")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gatewayapi/api/v1/tls_route.proto", fileDescriptor_adc016481729dbe0)
+}
+
+var fileDescriptor_adc016481729dbe0 = []byte{
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0xb1, 0x4e, 0xf3, 0x30,
+	0x18, 0xfc, 0xf3, 0x2b, 0x82, 0xe0, 0x6e, 0x56, 0x55, 0x95, 0x0c, 0x05, 0x31, 0xb1, 0x60, 0xab,
+	0x20, 0x21, 0x04, 0x0b, 0x64, 0x67, 0x09, 0x4c, 0x2c, 0x95, 0x13, 0x8c, 0x09, 0x71, 0xfb, 0x59,
+	0xf1, 0x17, 0x68, 0x56, 0x9e, 0x86, 0x47, 0xe0, 0x11, 0x18, 0x79, 0x02, 0x06, 0xde, 0x80, 0x81,
+	0x1d, 0xd9, 0x49, 0xca, 0xd4, 0x81, 0xc1, 0x92, 0x3f, 0xdf, 0x9d, 0xef, 0x7c, 0x26, 0x89, 0x2a,
+	0xf0, 0xbe, 0xce, 0x58, 0x0e, 0x73, 0x6e, 0x41, 0xc3, 0x41, 0x01, 0x5c, 0x69, 0x00, 0x6e, 0x2a,
+	0x78, 0x90, 0x39, 0x5a, 0xae, 0x04, 0xca, 0x27, 0xd1, 0x08, 0x53, 0x70, 0xb7, 0x1e, 0xa7, 0x1c,
+	0xb5, 0x9d, 0x55, 0x50, 0xa3, 0x64, 0xa6, 0x02, 0x04, 0x4a, 0x7f, 0x29, 0xcc, 0xdd, 0xc1, 0x0a,
+	0x88, 0x87, 0x0a, 0x14, 0x78, 0x98, 0xbb, 0x5d, 0xcb, 0x8c, 0xb7, 0x15, 0x80, 0xd2, 0x92, 0xfb,
+	0x29, 0xab, 0xef, 0xb8, 0x58, 0x34, 0x1d, 0x34, 0xf1, 0xee, 0x65, 0x81, 0xbd, 0xcb, 0x5c, 0xa2,
+	0xb8, 0x15, 0x28, 0xd6, 0xe1, 0xfd, 0xdc, 0xe1, 0x54, 0x2e, 0xb1, 0xf5, 0x93, 0xcb, 0xee, 0x6c,
+	0xef, 0x3d, 0x20, 0xd1, 0xb5, 0xb6, 0xa9, 0xcb, 0x4a, 0xa7, 0x64, 0xab, 0xac, 0x33, 0x39, 0xb3,
+	0x46, 0xe6, 0xe3, 0x60, 0x37, 0xd8, 0x1f, 0x1c, 0x0e, 0x59, 0x9b, 0x87, 0xf5, 0x79, 0xd8, 0xc5,
+	0xa2, 0x49, 0x23, 0x47, 0xbb, 0x32, 0x32, 0xa7, 0x67, 0x64, 0xd0, 0x4a, 0x50, 0x60, 0x6d, 0xc7,
+	0xff, 0xd7, 0x8b, 0x92, 0xf0, 0xf5, 0x3b, 0x0c, 0x52, 0xe2, 0xa5, 0x9e, 0x4d, 0x4f, 0x48, 0xd4,
+	0x3f, 0x61, 0xbc, 0xe9, 0x95, 0x23, 0x96, 0x43, 0x25, 0xfb, 0x8a, 0xd8, 0x65, 0x87, 0x26, 0xe1,
+	0xdb, 0xc7, 0xce, 0xbf, 0x74, 0xc5, 0x3e, 0x8d, 0x9f, 0xbf, 0xc2, 0x11, 0x89, 0x50, 0x5b, 0xdf,
+	0x32, 0x25, 0xab, 0xc2, 0x6d, 0x72, 0xee, 0x7c, 0x5e, 0x3e, 0x27, 0xc1, 0xcd, 0xf1, 0x5f, 0x7e,
+	0xce, 0x94, 0xaa, 0xeb, 0x2d, 0xdb, 0xf0, 0xb9, 0x8f, 0x7e, 0x06, 0x00, 0xce, 0x84, 0x11, 0xbc,
+	0xfa, 0x01, 0x00, 0x00,
+}
+
+func (this *TlsRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TlsRoute)
+	if !ok {
+		that2, ok := that.(TlsRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.KubeSpec.Equal(that1.KubeSpec) {
+		return false
+	}
+	if !this.KubeStatus.Equal(that1.KubeStatus) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reconcile"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+// Option to copy anything from the original to the desired before writing. Return value of false means don't update
+type TransitionTlsRouteFunc func(original, desired *TlsRoute) (bool, error)
+
+type TlsRouteReconciler interface {
+	Reconcile(namespace string, desiredResources TlsRouteList, transition TransitionTlsRouteFunc, opts clients.ListOpts) error
+}
+
+func tlsRoutesToResources(list TlsRouteList) resources.ResourceList {
+	var resourceList resources.ResourceList
+	for _, tlsRoute := range list {
+		resourceList = append(resourceList, tlsRoute)
+	}
+	return resourceList
+}
+
+func NewTlsRouteReconciler(client TlsRouteClient) TlsRouteReconciler {
+	return &tlsRouteReconciler{
+		base: reconcile.NewReconciler(client.BaseClient()),
+	}
+}
+
+type tlsRouteReconciler struct {
+	base reconcile.Reconciler
+}
+
+func (r *tlsRouteReconciler) Reconcile(namespace string, desiredResources TlsRouteList, transition TransitionTlsRouteFunc, opts clients.ListOpts) error {
+	opts = opts.WithDefaults()
+	opts.Ctx = contextutils.WithLogger(opts.Ctx, "tlsRoute_reconciler")
+	var transitionResources reconcile.TransitionResourcesFunc
+	if transition != nil {
+		transitionResources = func(original, desired resources.Resource) (bool, error) {
+			return transition(original.(*TlsRoute), desired.(*TlsRoute))
+		}
+	}
+	return r.base.Reconcile(namespace, tlsRoutesToResources(desiredResources), transitionResources, opts)
+}
@@ -0,0 +1,187 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"log"
+
+	gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/hashutils"
+	"go.uber.org/zap"
+)
+
+type TranslatorSnapshot struct {
+	Upstreams       gloo_solo_io.UpstreamList
+	GatewayClasses  GatewayClassList
+	Gateways        GatewayList
+	HttpRoutes      HttpRouteList
+	TlsRoutes       TlsRouteList
+	ReferenceGrants ReferenceGrantList
+}
+
+func (s TranslatorSnapshot) Clone() TranslatorSnapshot {
+	return TranslatorSnapshot{
+		Upstreams:       s.Upstreams.Clone(),
+		GatewayClasses:  s.GatewayClasses.Clone(),
+		Gateways:        s.Gateways.Clone(),
+		HttpRoutes:      s.HttpRoutes.Clone(),
+		TlsRoutes:       s.TlsRoutes.Clone(),
+		ReferenceGrants: s.ReferenceGrants.Clone(),
+	}
+}
+
+func (s TranslatorSnapshot) Hash(hasher hash.Hash64) (uint64, error) {
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	if _, err := s.hashUpstreams(hasher); err != nil {
+		return 0, err
+	}
+	if _, err := s.hashGatewayClasses(hasher); err != nil {
+		return 0, err
+	}
+	if _, err := s.hashGateways(hasher); err != nil {
+		return 0, err
+	}
+	if _, err := s.hashHttpRoutes(hasher); err != nil {
+		return 0, err
+	}
+	if _, err := s.hashTlsRoutes(hasher); err != nil {
+		return 0, err
+	}
+	if _, err := s.hashReferenceGrants(hasher); err != nil {
+		return 0, err
+	}
+	return hasher.Sum64(), nil
+}
+
+func (s TranslatorSnapshot) hashUpstreams(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.Upstreams.AsInterfaces()...)
+}
+
+func (s TranslatorSnapshot) hashGatewayClasses(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.GatewayClasses.AsInterfaces()...)
+}
+
+func (s TranslatorSnapshot) hashGateways(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.Gateways.AsInterfaces()...)
+}
+
+func (s TranslatorSnapshot) hashHttpRoutes(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.HttpRoutes.AsInterfaces()...)
+}
+
+func (s TranslatorSnapshot) hashTlsRoutes(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.TlsRoutes.AsInterfaces()...)
+}
+
+func (s TranslatorSnapshot) hashReferenceGrants(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.ReferenceGrants.AsInterfaces()...)
+}
+
+func (s TranslatorSnapshot) HashFields() []zap.Field {
+	var fields []zap.Field
+	hasher := fnv.New64()
+	UpstreamsHash, err := s.hashUpstreams(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("upstreams", UpstreamsHash))
+	GatewayClassesHash, err := s.hashGatewayClasses(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("gatewayClasses", GatewayClassesHash))
+	GatewaysHash, err := s.hashGateways(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("gateways", GatewaysHash))
+	HttpRoutesHash, err := s.hashHttpRoutes(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("httpRoutes", HttpRoutesHash))
+	TlsRoutesHash, err := s.hashTlsRoutes(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("tlsRoutes", TlsRoutesHash))
+	ReferenceGrantsHash, err := s.hashReferenceGrants(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("referenceGrants", ReferenceGrantsHash))
+	snapshotHash, err := s.Hash(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	return append(fields, zap.Uint64("snapshotHash", snapshotHash))
+}
+
+type TranslatorSnapshotStringer struct {
+	Version         uint64
+	Upstreams       []string
+	GatewayClasses  []string
+	Gateways        []string
+	HttpRoutes      []string
+	TlsRoutes       []string
+	ReferenceGrants []string
+}
+
+func (ss TranslatorSnapshotStringer) String() string {
+	s := fmt.Sprintf("TranslatorSnapshot %v\n", ss.Version)
+
+	s += fmt.Sprintf("  Upstreams %v\n", len(ss.Upstreams))
+	for _, name := range ss.Upstreams {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
+	s += fmt.Sprintf("  GatewayClasses %v\n", len(ss.GatewayClasses))
+	for _, name := range ss.GatewayClasses {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
+	s += fmt.Sprintf("  Gateways %v\n", len(ss.Gateways))
+	for _, name := range ss.Gateways {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
+	s += fmt.Sprintf("  HttpRoutes %v\n", len(ss.HttpRoutes))
+	for _, name := range ss.HttpRoutes {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
+	s += fmt.Sprintf("  TlsRoutes %v\n", len(ss.TlsRoutes))
+	for _, name := range ss.TlsRoutes {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
+	s += fmt.Sprintf("  ReferenceGrants %v\n", len(ss.ReferenceGrants))
+	for _, name := range ss.ReferenceGrants {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
+	return s
+}
+
+func (s TranslatorSnapshot) Stringer() TranslatorSnapshotStringer {
+	snapshotHash, err := s.Hash(nil)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	return TranslatorSnapshotStringer{
+		Version:         snapshotHash,
+		Upstreams:       s.Upstreams.NamespacesDotNames(),
+		GatewayClasses:  s.GatewayClasses.NamespacesDotNames(),
+		Gateways:        s.Gateways.NamespacesDotNames(),
+		HttpRoutes:      s.HttpRoutes.NamespacesDotNames(),
+		TlsRoutes:       s.TlsRoutes.NamespacesDotNames(),
+		ReferenceGrants: s.ReferenceGrants.NamespacesDotNames(),
+	}
+}
@@ -0,0 +1,570 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"sync"
+	"time"
+
+	gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	skstats "github.com/solo-io/solo-kit/pkg/stats"
+
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/errutils"
+)
+
+var (
+	// Deprecated. See mTranslatorResourcesIn
+	mTranslatorSnapshotIn = stats.Int64("translator.gatewayapi.solo.io/emitter/snap_in", "Deprecated. Use translator.gatewayapi.solo.io/emitter/resources_in. The number of snapshots in", "1")
+
+	// metrics for emitter
+	mTranslatorResourcesIn    = stats.Int64("translator.gatewayapi.solo.io/emitter/resources_in", "The number of resource lists received on open watch channels", "1")
+	mTranslatorSnapshotOut    = stats.Int64("translator.gatewayapi.solo.io/emitter/snap_out", "The number of snapshots out", "1")
+	mTranslatorSnapshotMissed = stats.Int64("translator.gatewayapi.solo.io/emitter/snap_missed", "The number of snapshots missed", "1")
+
+	// views for emitter
+	// deprecated: see translatorResourcesInView
+	translatorsnapshotInView = &view.View{
+		Name:        "translator.gatewayapi.solo.io/emitter/snap_in",
+		Measure:     mTranslatorSnapshotIn,
+		Description: "Deprecated. Use translator.gatewayapi.solo.io/emitter/resources_in. The number of snapshots updates coming in.",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{},
+	}
+
+	translatorResourcesInView = &view.View{
+		Name:        "translator.gatewayapi.solo.io/emitter/resources_in",
+		Measure:     mTranslatorResourcesIn,
+		Description: "The number of resource lists received on open watch channels",
+		Aggregation: view.Count(),
+		TagKeys: []tag.Key{
+			skstats.NamespaceKey,
+			skstats.ResourceKey,
+		},
+	}
+	translatorsnapshotOutView = &view.View{
+		Name:        "translator.gatewayapi.solo.io/emitter/snap_out",
+		Measure:     mTranslatorSnapshotOut,
+		Description: "The number of snapshots updates going out",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{},
+	}
+	translatorsnapshotMissedView = &view.View{
+		Name:        "translator.gatewayapi.solo.io/emitter/snap_missed",
+		Measure:     mTranslatorSnapshotMissed,
+		Description: "The number of snapshots updates going missed. this can happen in heavy load. missed snapshot will be re-tried after a second.",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{},
+	}
+)
+
+func init() {
+	view.Register(
+		translatorsnapshotInView,
+		translatorsnapshotOutView,
+		translatorsnapshotMissedView,
+		translatorResourcesInView,
+	)
+}
+
+type TranslatorSnapshotEmitter interface {
+	Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *TranslatorSnapshot, <-chan error, error)
+}
+
+type TranslatorEmitter interface {
+	TranslatorSnapshotEmitter
+	Register() error
+	Upstream() gloo_solo_io.UpstreamClient
+	GatewayClass() GatewayClassClient
+	Gateway() GatewayClient
+	HttpRoute() HttpRouteClient
+	TlsRoute() TlsRouteClient
+	ReferenceGrant() ReferenceGrantClient
+}
+
+func NewTranslatorEmitter(upstreamClient gloo_solo_io.UpstreamClient, gatewayClassClient GatewayClassClient, gatewayClient GatewayClient, httpRouteClient HttpRouteClient, tlsRouteClient TlsRouteClient, referenceGrantClient ReferenceGrantClient) TranslatorEmitter {
+	return NewTranslatorEmitterWithEmit(upstreamClient, gatewayClassClient, gatewayClient, httpRouteClient, tlsRouteClient, referenceGrantClient, make(chan struct{}))
+}
+
+func NewTranslatorEmitterWithEmit(upstreamClient gloo_solo_io.UpstreamClient, gatewayClassClient GatewayClassClient, gatewayClient GatewayClient, httpRouteClient HttpRouteClient, tlsRouteClient TlsRouteClient, referenceGrantClient ReferenceGrantClient, emit <-chan struct{}) TranslatorEmitter {
+	return &translatorEmitter{
+		upstream:       upstreamClient,
+		gatewayClass:   gatewayClassClient,
+		gateway:        gatewayClient,
+		httpRoute:      httpRouteClient,
+		tlsRoute:       tlsRouteClient,
+		referenceGrant: referenceGrantClient,
+		forceEmit:      emit,
+	}
+}
+
+type translatorEmitter struct {
+	forceEmit      <-chan struct{}
+	upstream       gloo_solo_io.UpstreamClient
+	gatewayClass   GatewayClassClient
+	gateway        GatewayClient
+	httpRoute      HttpRouteClient
+	tlsRoute       TlsRouteClient
+	referenceGrant ReferenceGrantClient
+}
+
+func (c *translatorEmitter) Register() error {
+	if err := c.upstream.Register(); err != nil {
+		return err
+	}
+	if err := c.gatewayClass.Register(); err != nil {
+		return err
+	}
+	if err := c.gateway.Register(); err != nil {
+		return err
+	}
+	if err := c.httpRoute.Register(); err != nil {
+		return err
+	}
+	if err := c.tlsRoute.Register(); err != nil {
+		return err
+	}
+	if err := c.referenceGrant.Register(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *translatorEmitter) Upstream() gloo_solo_io.UpstreamClient {
+	return c.upstream
+}
+
+func (c *translatorEmitter) GatewayClass() GatewayClassClient {
+	return c.gatewayClass
+}
+
+func (c *translatorEmitter) Gateway() GatewayClient {
+	return c.gateway
+}
+
+func (c *translatorEmitter) HttpRoute() HttpRouteClient {
+	return c.httpRoute
+}
+
+func (c *translatorEmitter) TlsRoute() TlsRouteClient {
+	return c.tlsRoute
+}
+
+func (c *translatorEmitter) ReferenceGrant() ReferenceGrantClient {
+	return c.referenceGrant
+}
+
+func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *TranslatorSnapshot, <-chan error, error) {
+
+	if len(watchNamespaces) == 0 {
+		watchNamespaces = []string{""}
+	}
+
+	for _, ns := range watchNamespaces {
+		if ns == "" && len(watchNamespaces) > 1 {
+			return nil, nil, errors.Errorf("the \"\" namespace is used to watch all namespaces. Snapshots can either be tracked for " +
+				"specific namespaces or \"\" AllNamespaces, but not both.")
+		}
+	}
+
+	errs := make(chan error)
+	var done sync.WaitGroup
+	ctx := opts.Ctx
+	/* Create channel for Upstream */
+	type upstreamListWithNamespace struct {
+		list      gloo_solo_io.UpstreamList
+		namespace string
+	}
+	upstreamChan := make(chan upstreamListWithNamespace)
+
+	var initialUpstreamList gloo_solo_io.UpstreamList
+	/* Create channel for GatewayClass */
+	type gatewayClassListWithNamespace struct {
+		list      GatewayClassList
+		namespace string
+	}
+	gatewayClassChan := make(chan gatewayClassListWithNamespace)
+
+	var initialGatewayClassList GatewayClassList
+	/* Create channel for Gateway */
+	type gatewayListWithNamespace struct {
+		list      GatewayList
+		namespace string
+	}
+	gatewayChan := make(chan gatewayListWithNamespace)
+
+	var initialGatewayList GatewayList
+	/* Create channel for HttpRoute */
+	type httpRouteListWithNamespace struct {
+		list      HttpRouteList
+		namespace string
+	}
+	httpRouteChan := make(chan httpRouteListWithNamespace)
+
+	var initialHttpRouteList HttpRouteList
+	/* Create channel for TlsRoute */
+	type tlsRouteListWithNamespace struct {
+		list      TlsRouteList
+		namespace string
+	}
+	tlsRouteChan := make(chan tlsRouteListWithNamespace)
+
+	var initialTlsRouteList TlsRouteList
+	/* Create channel for ReferenceGrant */
+	type referenceGrantListWithNamespace struct {
+		list      ReferenceGrantList
+		namespace string
+	}
+	referenceGrantChan := make(chan referenceGrantListWithNamespace)
+
+	var initialReferenceGrantList ReferenceGrantList
+
+	currentSnapshot := TranslatorSnapshot{}
+
+	for _, namespace := range watchNamespaces {
+		/* Setup namespaced watch for Upstream */
+		{
+			upstreams, err := c.upstream.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial Upstream list")
+			}
+			initialUpstreamList = append(initialUpstreamList, upstreams...)
+		}
+		upstreamNamespacesChan, upstreamErrs, err := c.upstream.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting Upstream watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, upstreamErrs, namespace+"-upstreams")
+		}(namespace)
+		/* Setup namespaced watch for GatewayClass */
+		{
+			gatewayClasses, err := c.gatewayClass.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial GatewayClass list")
+			}
+			initialGatewayClassList = append(initialGatewayClassList, gatewayClasses...)
+		}
+		gatewayClassNamespacesChan, gatewayClassErrs, err := c.gatewayClass.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting GatewayClass watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, gatewayClassErrs, namespace+"-gatewayClasses")
+		}(namespace)
+		/* Setup namespaced watch for Gateway */
+		{
+			gateways, err := c.gateway.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial Gateway list")
+			}
+			initialGatewayList = append(initialGatewayList, gateways...)
+		}
+		gatewayNamespacesChan, gatewayErrs, err := c.gateway.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting Gateway watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, gatewayErrs, namespace+"-gateways")
+		}(namespace)
+		/* Setup namespaced watch for HttpRoute */
+		{
+			httpRoutes, err := c.httpRoute.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial HttpRoute list")
+			}
+			initialHttpRouteList = append(initialHttpRouteList, httpRoutes...)
+		}
+		httpRouteNamespacesChan, httpRouteErrs, err := c.httpRoute.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting HttpRoute watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, httpRouteErrs, namespace+"-httpRoutes")
+		}(namespace)
+		/* Setup namespaced watch for TlsRoute */
+		{
+			tlsRoutes, err := c.tlsRoute.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial TlsRoute list")
+			}
+			initialTlsRouteList = append(initialTlsRouteList, tlsRoutes...)
+		}
+		tlsRouteNamespacesChan, tlsRouteErrs, err := c.tlsRoute.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting TlsRoute watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, tlsRouteErrs, namespace+"-tlsRoutes")
+		}(namespace)
+		/* Setup namespaced watch for ReferenceGrant */
+		{
+			referenceGrants, err := c.referenceGrant.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial ReferenceGrant list")
+			}
+			initialReferenceGrantList = append(initialReferenceGrantList, referenceGrants...)
+		}
+		referenceGrantNamespacesChan, referenceGrantErrs, err := c.referenceGrant.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting ReferenceGrant watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, referenceGrantErrs, namespace+"-referenceGrants")
+		}(namespace)
+
+		/* Watch for changes and update snapshot */
+		go func(namespace string) {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case upstreamList := <-upstreamNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case upstreamChan <- upstreamListWithNamespace{list: upstreamList, namespace: namespace}:
+					}
+				case gatewayClassList := <-gatewayClassNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case gatewayClassChan <- gatewayClassListWithNamespace{list: gatewayClassList, namespace: namespace}:
+					}
+				case gatewayList := <-gatewayNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case gatewayChan <- gatewayListWithNamespace{list: gatewayList, namespace: namespace}:
+					}
+				case httpRouteList := <-httpRouteNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case httpRouteChan <- httpRouteListWithNamespace{list: httpRouteList, namespace: namespace}:
+					}
+				case tlsRouteList := <-tlsRouteNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case tlsRouteChan <- tlsRouteListWithNamespace{list: tlsRouteList, namespace: namespace}:
+					}
+				case referenceGrantList := <-referenceGrantNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case referenceGrantChan <- referenceGrantListWithNamespace{list: referenceGrantList, namespace: namespace}:
+					}
+				}
+			}
+		}(namespace)
+	}
+	/* Initialize snapshot for Upstreams */
+	currentSnapshot.Upstreams = initialUpstreamList.Sort()
+	/* Initialize snapshot for GatewayClasses */
+	currentSnapshot.GatewayClasses = initialGatewayClassList.Sort()
+	/* Initialize snapshot for Gateways */
+	currentSnapshot.Gateways = initialGatewayList.Sort()
+	/* Initialize snapshot for HttpRoutes */
+	currentSnapshot.HttpRoutes = initialHttpRouteList.Sort()
+	/* Initialize snapshot for TlsRoutes */
+	currentSnapshot.TlsRoutes = initialTlsRouteList.Sort()
+	/* Initialize snapshot for ReferenceGrants */
+	currentSnapshot.ReferenceGrants = initialReferenceGrantList.Sort()
+
+	snapshots := make(chan *TranslatorSnapshot)
+	go func() {
+		// sent initial snapshot to kick off the watch
+		initialSnapshot := currentSnapshot.Clone()
+		snapshots <- &initialSnapshot
+
+		timer := time.NewTicker(time.Second * 1)
+		previousHash, err := currentSnapshot.Hash(nil)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Panicw("error while hashing, this should never happen", zap.Error(err))
+		}
+		sync := func() {
+			currentHash, err := currentSnapshot.Hash(nil)
+			// this should never happen, so panic if it does
+			if err != nil {
+				contextutils.LoggerFrom(ctx).Panicw("error while hashing, this should never happen", zap.Error(err))
+			}
+			if previousHash == currentHash {
+				return
+			}
+
+			sentSnapshot := currentSnapshot.Clone()
+			select {
+			case snapshots <- &sentSnapshot:
+				stats.Record(ctx, mTranslatorSnapshotOut.M(1))
+				previousHash = currentHash
+			default:
+				stats.Record(ctx, mTranslatorSnapshotMissed.M(1))
+			}
+		}
+		upstreamsByNamespace := make(map[string]gloo_solo_io.UpstreamList)
+		gatewayClassesByNamespace := make(map[string]GatewayClassList)
+		gatewaysByNamespace := make(map[string]GatewayList)
+		httpRoutesByNamespace := make(map[string]HttpRouteList)
+		tlsRoutesByNamespace := make(map[string]TlsRouteList)
+		referenceGrantsByNamespace := make(map[string]ReferenceGrantList)
+
+		for {
+			record := func() { stats.Record(ctx, mTranslatorSnapshotIn.M(1)) }
+
+			select {
+			case <-timer.C:
+				sync()
+			case <-ctx.Done():
+				close(snapshots)
+				done.Wait()
+				close(errs)
+				return
+			case <-c.forceEmit:
+				sentSnapshot := currentSnapshot.Clone()
+				snapshots <- &sentSnapshot
+			case upstreamNamespacedList := <-upstreamChan:
+				record()
+
+				namespace := upstreamNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"upstream",
+					mTranslatorResourcesIn,
+				)
+
+				// merge lists by namespace
+				upstreamsByNamespace[namespace] = upstreamNamespacedList.list
+				var upstreamList gloo_solo_io.UpstreamList
+				for _, upstreams := range upstreamsByNamespace {
+					upstreamList = append(upstreamList, upstreams...)
+				}
+				currentSnapshot.Upstreams = upstreamList.Sort()
+			case gatewayClassNamespacedList := <-gatewayClassChan:
+				record()
+
+				namespace := gatewayClassNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"gateway_class",
+					mTranslatorResourcesIn,
+				)
+
+				// merge lists by namespace
+				gatewayClassesByNamespace[namespace] = gatewayClassNamespacedList.list
+				var gatewayClassList GatewayClassList
+				for _, gatewayClasses := range gatewayClassesByNamespace {
+					gatewayClassList = append(gatewayClassList, gatewayClasses...)
+				}
+				currentSnapshot.GatewayClasses = gatewayClassList.Sort()
+			case gatewayNamespacedList := <-gatewayChan:
+				record()
+
+				namespace := gatewayNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"gateway",
+					mTranslatorResourcesIn,
+				)
+
+				// merge lists by namespace
+				gatewaysByNamespace[namespace] = gatewayNamespacedList.list
+				var gatewayList GatewayList
+				for _, gateways := range gatewaysByNamespace {
+					gatewayList = append(gatewayList, gateways...)
+				}
+				currentSnapshot.Gateways = gatewayList.Sort()
+			case httpRouteNamespacedList := <-httpRouteChan:
+				record()
+
+				namespace := httpRouteNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"http_route",
+					mTranslatorResourcesIn,
+				)
+
+				// merge lists by namespace
+				httpRoutesByNamespace[namespace] = httpRouteNamespacedList.list
+				var httpRouteList HttpRouteList
+				for _, httpRoutes := range httpRoutesByNamespace {
+					httpRouteList = append(httpRouteList, httpRoutes...)
+				}
+				currentSnapshot.HttpRoutes = httpRouteList.Sort()
+			case tlsRouteNamespacedList := <-tlsRouteChan:
+				record()
+
+				namespace := tlsRouteNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"tls_route",
+					mTranslatorResourcesIn,
+				)
+
+				// merge lists by namespace
+				tlsRoutesByNamespace[namespace] = tlsRouteNamespacedList.list
+				var tlsRouteList TlsRouteList
+				for _, tlsRoutes := range tlsRoutesByNamespace {
+					tlsRouteList = append(tlsRouteList, tlsRoutes...)
+				}
+				currentSnapshot.TlsRoutes = tlsRouteList.Sort()
+			case referenceGrantNamespacedList := <-referenceGrantChan:
+				record()
+
+				namespace := referenceGrantNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"reference_grant",
+					mTranslatorResourcesIn,
+				)
+
+				// merge lists by namespace
+				referenceGrantsByNamespace[namespace] = referenceGrantNamespacedList.list
+				var referenceGrantList ReferenceGrantList
+				for _, referenceGrants := range referenceGrantsByNamespace {
+					referenceGrantList = append(referenceGrantList, referenceGrants...)
+				}
+				currentSnapshot.ReferenceGrants = referenceGrantList.Sort()
+			}
+		}
+	}()
+	return snapshots, errs, nil
+}
@@ -0,0 +1,216 @@
+package status
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/syncutil"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/gatewayapi"
+	v1 "github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/translator"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.uber.org/zap/zapcore"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type statusSyncer struct {
+	controllerName     string
+	gatewayClassClient v1.GatewayClassClient
+	gatewayClient      v1.GatewayClient
+	httpRouteClient    v1.HttpRouteClient
+	tlsRouteClient     v1.TlsRouteClient
+	now                func() metav1.Time
+}
+
+// NewSyncer returns a syncer which writes the conditions of the Gateway API resources reconciled by the controller.
+// Statuses are only written when they change.
+func NewSyncer(
+	controllerName string,
+	gatewayClassClient v1.GatewayClassClient,
+	gatewayClient v1.GatewayClient,
+	httpRouteClient v1.HttpRouteClient,
+	tlsRouteClient v1.TlsRouteClient,
+) v1.TranslatorSyncer {
+	return &statusSyncer{
+		controllerName:     controllerName,
+		gatewayClassClient: gatewayClassClient,
+		gatewayClient:      gatewayClient,
+		httpRouteClient:    httpRouteClient,
+		tlsRouteClient:     tlsRouteClient,
+		now: func() metav1.Time {
+			return metav1.NewTime(time.Now().Truncate(time.Second))
+		},
+	}
+}
+
+func (s *statusSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot) error {
+	ctx = contextutils.WithLogger(ctx, "statusSyncer")
+	snapHash := hashutils.MustHash(snap)
+	logger := contextutils.LoggerFrom(ctx)
+	logger.Infof("begin sync %v (%v gateway classes, %v gateways, %v http routes, %v tls routes)", snapHash,
+		len(snap.GatewayClasses), len(snap.Gateways), len(snap.HttpRoutes), len(snap.TlsRoutes))
+	defer logger.Infof("end sync %v", snapHash)
+
+	// stringifying the snapshot may be an expensive operation, so we'd like to avoid building the large
+	// string if we're not even going to log it anyway
+	if contextutils.GetLogLevel() == zapcore.DebugLevel {
+		logger.Debug(syncutil.StringifySnapshot(snap))
+	}
+
+	_, reports := translator.Translate(ctx, s.controllerName, "", snap)
+	now := s.now()
+
+	// gateway classes are cluster scoped, so they may be listed once for every watched namespace
+	written := map[core.ResourceRef]bool{}
+	for _, gwc := range snap.GatewayClasses {
+		desired, ok := reports.GatewayClasses[gwc.GetMetadata().Ref()]
+		if !ok || written[gwc.GetMetadata().Ref()] {
+			continue
+		}
+		written[gwc.GetMetadata().Ref()] = true
+		var current gatewayapi.GatewayClassStatus
+		if err := gatewayapi.DecodeStatus(gwc, &current); err != nil {
+			return err
+		}
+		desired.Conditions = mergeConditions(current.Conditions, desired.Conditions, now)
+		if reflect.DeepEqual(&current, desired) {
+			continue
+		}
+		if err := s.writeStatus(ctx, gwc, desired, func(resource resources.Resource) error {
+			_, err := s.gatewayClassClient.Write(resource.(*v1.GatewayClass), clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, gw := range snap.Gateways {
+		desired, ok := reports.Gateways[gw.GetMetadata().Ref()]
+		if !ok {
+			continue
+		}
+		var current gatewayapi.GatewayStatus
+		if err := gatewayapi.DecodeStatus(gw, &current); err != nil {
+			return err
+		}
+		// the addresses are not managed by the controller
+		desired.Addresses = current.Addresses
+		desired.Conditions = mergeConditions(current.Conditions, desired.Conditions, now)
+		for i := range desired.Listeners {
+			var currentConditions []gatewayapi.Condition
+			for _, listener := range current.Listeners {
+				if listener.Name == desired.Listeners[i].Name {
+					currentConditions = listener.Conditions
+				}
+			}
+			desired.Listeners[i].Conditions = mergeConditions(currentConditions, desired.Listeners[i].Conditions, now)
+		}
+		if reflect.DeepEqual(&current, desired) {
+			continue
+		}
+		if err := s.writeStatus(ctx, gw, desired, func(resource resources.Resource) error {
+			_, err := s.gatewayClient.Write(resource.(*v1.Gateway), clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, route := range snap.HttpRoutes {
+		if err := s.syncRouteStatus(ctx, route, reports.HttpRoutes[route.GetMetadata().Ref()], now, func(resource resources.Resource) error {
+			_, err := s.httpRouteClient.Write(resource.(*v1.HttpRoute), clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	for _, route := range snap.TlsRoutes {
+		if err := s.syncRouteStatus(ctx, route, reports.TlsRoutes[route.GetMetadata().Ref()], now, func(resource resources.Resource) error {
+			_, err := s.tlsRouteClient.Write(resource.(*v1.TlsRoute), clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncRouteStatus replaces the statuses of the parents of the controller, and keeps those of other controllers.
+// The parents of the controller are removed from routes which no longer reference them.
+func (s *statusSyncer) syncRouteStatus(
+	ctx context.Context,
+	route gatewayapi.KubeResource,
+	parents []gatewayapi.RouteParentStatus,
+	now metav1.Time,
+	write func(resource resources.Resource) error,
+) error {
+	var current gatewayapi.RouteStatus
+	if err := gatewayapi.DecodeStatus(route, &current); err != nil {
+		return err
+	}
+
+	desired := &gatewayapi.RouteStatus{}
+	for _, parent := range current.Parents {
+		if parent.ControllerName != s.controllerName {
+			desired.Parents = append(desired.Parents, parent)
+		}
+	}
+	for _, parent := range parents {
+		var currentConditions []gatewayapi.Condition
+		for _, currentParent := range current.Parents {
+			if currentParent.ControllerName == s.controllerName && reflect.DeepEqual(currentParent.ParentRef, parent.ParentRef) {
+				currentConditions = currentParent.Conditions
+			}
+		}
+		parent.Conditions = mergeConditions(currentConditions, parent.Conditions, now)
+		desired.Parents = append(desired.Parents, parent)
+	}
+
+	if reflect.DeepEqual(&current, desired) {
+		return nil
+	}
+	return s.writeStatus(ctx, route, desired, write)
+}
+
+func (s *statusSyncer) writeStatus(ctx context.Context, resource resources.Resource, status interface{}, write func(resource resources.Resource) error) error {
+	encoded, err := gatewayapi.EncodeStatus(resource, status)
+	if err != nil {
+		return errors.Wrapf(err, "internal error: encoding status of %v", resource.GetMetadata().Ref())
+	}
+	clone := resources.Clone(resource)
+	switch clone := clone.(type) {
+	case *v1.GatewayClass:
+		clone.KubeStatus = encoded
+	case *v1.Gateway:
+		clone.KubeStatus = encoded
+	case *v1.HttpRoute:
+		clone.KubeStatus = encoded
+	case *v1.TlsRoute:
+		clone.KubeStatus = encoded
+	}
+	if err := write(clone); err != nil {
+		return errors.Wrapf(err, "writing updated status of %v to kubernetes", resource.GetMetadata().Ref())
+	}
+	contextutils.LoggerFrom(ctx).Infof("updated status of %v %v", resources.Kind(resource), resource.GetMetadata().Ref())
+	return nil
+}
+
+// mergeConditions keeps the transition times of the conditions whose status did not change.
+func mergeConditions(current, desired []gatewayapi.Condition, now metav1.Time) []gatewayapi.Condition {
+	merged := make([]gatewayapi.Condition, len(desired))
+	for i, condition := range desired {
+		condition.LastTransitionTime = now
+		if existing := translator.FindCondition(current, condition.Type); existing != nil && existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		merged[i] = condition
+	}
+	return merged
+}
@@ -0,0 +1,169 @@
+package status_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/gatewayapi"
+	v1 "github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gatewayapi/pkg/status"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+var _ = Describe("StatusSyncer", func() {
+
+	encode := func(spec interface{}) *types.Any {
+		raw, err := json.Marshal(spec)
+		Expect(err).NotTo(HaveOccurred())
+		return &types.Any{Value: raw}
+	}
+
+	var (
+		ctx                context.Context
+		gatewayClassClient v1.GatewayClassClient
+		gatewayClient      v1.GatewayClient
+		httpRouteClient    v1.HttpRouteClient
+		tlsRouteClient     v1.TlsRouteClient
+		syncer             v1.TranslatorSyncer
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+
+		baseGatewayClassClient, err := gatewayapi.NewResourceClient(dynamicClient, &v1.GatewayClass{})
+		Expect(err).NotTo(HaveOccurred())
+		gatewayClassClient = v1.NewGatewayClassClientWithBase(baseGatewayClassClient)
+		baseGatewayClient, err := gatewayapi.NewResourceClient(dynamicClient, &v1.Gateway{})
+		Expect(err).NotTo(HaveOccurred())
+		gatewayClient = v1.NewGatewayClientWithBase(baseGatewayClient)
+		baseHttpRouteClient, err := gatewayapi.NewResourceClient(dynamicClient, &v1.HttpRoute{})
+		Expect(err).NotTo(HaveOccurred())
+		httpRouteClient = v1.NewHttpRouteClientWithBase(baseHttpRouteClient)
+		baseTlsRouteClient, err := gatewayapi.NewResourceClient(dynamicClient, &v1.TlsRoute{})
+		Expect(err).NotTo(HaveOccurred())
+		tlsRouteClient = v1.NewTlsRouteClientWithBase(baseTlsRouteClient)
+
+		syncer = NewSyncer(translator.DefaultControllerName, gatewayClassClient, gatewayClient, httpRouteClient, tlsRouteClient)
+	})
+
+	snapshot := func() *v1.TranslatorSnapshot {
+		gwc, err := gatewayClassClient.Read("", "gloo", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		gw, err := gatewayClient.Read("default", "gw", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		route, err := httpRouteClient.Read("default", "route", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		return &v1.TranslatorSnapshot{
+			GatewayClasses: v1.GatewayClassList{gwc},
+			Gateways:       v1.GatewayList{gw},
+			HttpRoutes:     v1.HttpRouteList{route},
+		}
+	}
+
+	BeforeEach(func() {
+		_, err := gatewayClassClient.Write(&v1.GatewayClass{
+			Metadata: core.Metadata{Name: "gloo"},
+			KubeSpec: encode(gatewayapi.GatewayClassSpec{ControllerName: translator.DefaultControllerName}),
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = gatewayClient.Write(&v1.Gateway{
+			Metadata: core.Metadata{Namespace: "default", Name: "gw"},
+			KubeSpec: encode(gatewayapi.GatewaySpec{
+				GatewayClassName: "gloo",
+				Listeners:        []gatewayapi.Listener{{Name: "http", Port: 8080, Protocol: gatewayapi.HTTPProtocolType}},
+			}),
+			KubeStatus: encode(gatewayapi.GatewayStatus{
+				Addresses: []gatewayapi.GatewayAddress{{Value: "10.0.0.1"}},
+			}),
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = httpRouteClient.Write(&v1.HttpRoute{
+			Metadata: core.Metadata{Namespace: "default", Name: "route"},
+			KubeSpec: encode(gatewayapi.HTTPRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{{Name: "gw"}},
+			}),
+			KubeStatus: encode(gatewayapi.RouteStatus{
+				Parents: []gatewayapi.RouteParentStatus{{
+					ParentRef:      gatewayapi.ParentReference{Name: "other"},
+					ControllerName: "example.com/other",
+				}},
+			}),
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("writes the statuses of the resources", func() {
+		Expect(syncer.Sync(ctx, snapshot())).NotTo(HaveOccurred())
+
+		snap := snapshot()
+
+		var gatewayClassStatus gatewayapi.GatewayClassStatus
+		Expect(gatewayapi.DecodeStatus(snap.GatewayClasses[0], &gatewayClassStatus)).NotTo(HaveOccurred())
+		accepted := translator.FindCondition(gatewayClassStatus.Conditions, translator.ConditionAccepted)
+		Expect(accepted).NotTo(BeNil())
+		Expect(accepted.Status).To(Equal(gatewayapi.ConditionTrue))
+		Expect(accepted.LastTransitionTime.IsZero()).To(BeFalse())
+
+		var gatewayStatus gatewayapi.GatewayStatus
+		Expect(gatewayapi.DecodeStatus(snap.Gateways[0], &gatewayStatus)).NotTo(HaveOccurred())
+		Expect(gatewayStatus.Addresses).To(Equal([]gatewayapi.GatewayAddress{{Value: "10.0.0.1"}}))
+		Expect(translator.FindCondition(gatewayStatus.Conditions, translator.ConditionProgrammed).Status).To(Equal(gatewayapi.ConditionTrue))
+		Expect(gatewayStatus.Listeners).To(HaveLen(1))
+		Expect(gatewayStatus.Listeners[0].AttachedRoutes).To(Equal(int32(1)))
+
+		var routeStatus gatewayapi.RouteStatus
+		Expect(gatewayapi.DecodeStatus(snap.HttpRoutes[0], &routeStatus)).NotTo(HaveOccurred())
+		Expect(routeStatus.Parents).To(HaveLen(2))
+		Expect(routeStatus.Parents[0].ControllerName).To(Equal("example.com/other"))
+		Expect(routeStatus.Parents[1].ControllerName).To(Equal(translator.DefaultControllerName))
+		Expect(translator.FindCondition(routeStatus.Parents[1].Conditions, translator.ConditionAccepted).Status).To(Equal(gatewayapi.ConditionTrue))
+	})
+
+	It("does not write statuses which did not change", func() {
+		Expect(syncer.Sync(ctx, snapshot())).NotTo(HaveOccurred())
+		snap := snapshot()
+
+		// writes would fail, as the resources are out of date
+		for _, gwc := range snap.GatewayClasses {
+			gwc.Metadata.ResourceVersion = "stale"
+		}
+		for _, gw := range snap.Gateways {
+			gw.Metadata.ResourceVersion = "stale"
+		}
+		for _, route := range snap.HttpRoutes {
+			route.Metadata.ResourceVersion = "stale"
+		}
+		Expect(syncer.Sync(ctx, snap)).NotTo(HaveOccurred())
+	})
+
+	It("replaces stale statuses of the controller", func() {
+		Expect(syncer.Sync(ctx, snapshot())).NotTo(HaveOccurred())
+
+		gw, err := gatewayClient.Read("default", "gw", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		gw.KubeSpec = encode(gatewayapi.GatewaySpec{
+			GatewayClassName: "gloo",
+			Listeners:        []gatewayapi.Listener{{Name: "http", Port: 8080, Protocol: gatewayapi.UDPProtocolType}},
+		})
+		_, err = gatewayClient.Write(gw, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(syncer.Sync(ctx, snapshot())).NotTo(HaveOccurred())
+
+		gw, err = gatewayClient.Read("default", "gw", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		var gatewayStatus gatewayapi.GatewayStatus
+		Expect(gatewayapi.DecodeStatus(gw, &gatewayStatus)).NotTo(HaveOccurred())
+		accepted := translator.FindCondition(gatewayStatus.Conditions, translator.ConditionAccepted)
+		Expect(accepted.Status).To(Equal(gatewayapi.ConditionFalse))
+		Expect(accepted.Reason).To(Equal(translator.ReasonListenersNotValid))
+	})
+})
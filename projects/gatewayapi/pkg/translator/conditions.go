@@ -0,0 +1,61 @@
+package translator
+
+import (
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/gatewayapi"
+)
+
+// The condition types and reasons of the Gateway API that are reported by Gloo.
+const (
+	ConditionAccepted     = "Accepted"
+	ConditionResolvedRefs = "ResolvedRefs"
+	ConditionProgrammed   = "Programmed"
+	ConditionConflicted   = "Conflicted"
+
+	ReasonAccepted                   = "Accepted"
+	ReasonProgrammed                 = "Programmed"
+	ReasonResolvedRefs               = "ResolvedRefs"
+	ReasonInvalid                    = "Invalid"
+	ReasonListenersNotValid          = "ListenersNotValid"
+	ReasonUnsupportedProtocol        = "UnsupportedProtocol"
+	ReasonUnsupportedValue           = "UnsupportedValue"
+	ReasonInvalidCertificateRef      = "InvalidCertificateRef"
+	ReasonInvalidRouteKinds          = "InvalidRouteKinds"
+	ReasonRefNotPermitted            = "RefNotPermitted"
+	ReasonNoConflicts                = "NoConflicts"
+	ReasonProtocolConflict           = "ProtocolConflict"
+	ReasonHostnameConflict           = "HostnameConflict"
+	ReasonNotAllowedByListeners      = "NotAllowedByListeners"
+	ReasonNoMatchingListenerHostname = "NoMatchingListenerHostname"
+	ReasonNoMatchingParent           = "NoMatchingParent"
+	ReasonInvalidKind                = "InvalidKind"
+	ReasonBackendNotFound            = "BackendNotFound"
+)
+
+func trueCondition(conditionType, reason string, generation int64) gatewayapi.Condition {
+	return gatewayapi.Condition{
+		Type:               conditionType,
+		Status:             gatewayapi.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             reason,
+	}
+}
+
+func falseCondition(conditionType, reason, message string, generation int64) gatewayapi.Condition {
+	return gatewayapi.Condition{
+		Type:               conditionType,
+		Status:             gatewayapi.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// FindCondition returns the condition of the given type, or nil if there is none.
+func FindCondition(conditions []gatewayapi.Condition, conditionType string) *gatewayapi.Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package translator
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/gatewayapi"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	envoycore "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
+)
+
+var (
+	UnsupportedFilterErr = func(filterType gatewayapi.HTTPRouteFilterType) error {
+		return errors.Errorf("filters of type %v are not supported", filterType)
+	}
+	UnsupportedFullPathRewriteErr = errors.New("full path rewrites are only supported for exact path matches")
+	UnsupportedRedirectPortErr    = errors.New("redirects to another port must also specify a hostname")
+	UnsupportedRedirectStatusErr  = func(statusCode int) error {
+		return errors.Errorf("redirects with status code %v are not supported", statusCode)
+	}
+)
+
+// httpRoutes translates the rules of an HTTPRoute into gloo routes, with a route for each of their matches.
+// Rules without valid backends respond with a 500, as required by the Gateway API.
+// An error is returned if the route uses features which are not supported, in which case the route is not attached.
+func (t *translation) httpRoutes(namespace string, generation int64, spec gatewayapi.HTTPRouteSpec) ([]*gloov1.Route, gatewayapi.Condition, error) {
+	var (
+		routes     []*gloov1.Route
+		backendErr error
+	)
+	for _, rule := range spec.Rules {
+		destinations, err := t.destinations(gatewayapi.HTTPRouteKind, namespace, rule.BackendRefs)
+		if err != nil && backendErr == nil {
+			backendErr = err
+		}
+
+		matches := rule.Matches
+		if len(matches) == 0 {
+			matches = []gatewayapi.HTTPRouteMatch{{}}
+		}
+		for _, match := range matches {
+			route := &gloov1.Route{
+				Matchers: []*matchers.Matcher{translateMatch(match)},
+			}
+			if err := applyFilters(route, match, rule.Filters); err != nil {
+				return nil, gatewayapi.Condition{}, err
+			}
+			if route.Action == nil {
+				setRouteAction(route, destinations)
+			}
+			routes = append(routes, route)
+		}
+	}
+	return routes, t.resolvedRefsCondition(backendErr, generation), nil
+}
+
+func translateMatch(match gatewayapi.HTTPRouteMatch) *matchers.Matcher {
+	matcher := &matchers.Matcher{}
+
+	pathType, path := gatewayapi.PathMatchPathPrefix, "/"
+	if match.Path != nil {
+		if match.Path.Type != nil {
+			pathType = *match.Path.Type
+		}
+		if match.Path.Value != nil {
+			path = *match.Path.Value
+		}
+	}
+	switch pathType {
+	case gatewayapi.PathMatchExact:
+		matcher.PathSpecifier = &matchers.Matcher_Exact{Exact: path}
+	case gatewayapi.PathMatchRegularExpression:
+		matcher.PathSpecifier = &matchers.Matcher_Regex{Regex: path}
+	default:
+		matcher.PathSpecifier = &matchers.Matcher_Prefix{Prefix: path}
+	}
+
+	for _, header := range match.Headers {
+		matcher.Headers = append(matcher.Headers, &matchers.HeaderMatcher{
+			Name:  header.Name,
+			Value: header.Value,
+			Regex: header.Type != nil && *header.Type == gatewayapi.MatchRegularExpression,
+		})
+	}
+	for _, queryParam := range match.QueryParams {
+		matcher.QueryParameters = append(matcher.QueryParameters, &matchers.QueryParameterMatcher{
+			Name:  queryParam.Name,
+			Value: queryParam.Value,
+			Regex: queryParam.Type != nil && *queryParam.Type == gatewayapi.MatchRegularExpression,
+		})
+	}
+	if match.Method != nil {
+		matcher.Methods = []string{*match.Method}
+	}
+	return matcher
+}
+
+func setRouteAction(route *gloov1.Route, destinations []*gloov1.WeightedDestination) {
+	switch len(destinations) {
+	case 0:
+		route.Action = &gloov1.Route_DirectResponseAction{
+			DirectResponseAction: &gloov1.DirectResponseAction{Status: http.StatusInternalServerError},
+		}
+	case 1:
+		route.Action = &gloov1.Route_RouteAction{
+			RouteAction: &gloov1.RouteAction{
+				Destination: &gloov1.RouteAction_Single{Single: destinations[0].GetDestination()},
+			},
+		}
+	default:
+		route.Action = &gloov1.Route_RouteAction{
+			RouteAction: &gloov1.RouteAction{
+				Destination: &gloov1.RouteAction_Multi{Multi: &gloov1.MultiDestination{Destinations: destinations}},
+			},
+		}
+	}
+}
+
+func applyFilters(route *gloov1.Route, match gatewayapi.HTTPRouteMatch, filters []gatewayapi.HTTPRouteFilter) error {
+	options := &gloov1.RouteOptions{}
+	for _, filter := range filters {
+		switch filter.Type {
+		case gatewayapi.HTTPRouteFilterRequestHeaderModifier:
+			if options.HeaderManipulation == nil {
+				options.HeaderManipulation = &headers.HeaderManipulation{}
+			}
+			modifier := filter.RequestHeaderModifier
+			for _, header := range headersToAdd(modifier) {
+				options.HeaderManipulation.RequestHeadersToAdd = append(options.HeaderManipulation.RequestHeadersToAdd, &envoycore.HeaderValueOption{
+					HeaderOption: &envoycore.HeaderValueOption_Header{
+						Header: &envoycore.HeaderValue{Key: header.Name, Value: header.Value},
+					},
+					Append: &types.BoolValue{Value: header.append},
+				})
+			}
+			options.HeaderManipulation.RequestHeadersToRemove = append(options.HeaderManipulation.RequestHeadersToRemove, headersToRemove(modifier)...)
+		case gatewayapi.HTTPRouteFilterResponseHeaderModifier:
+			if options.HeaderManipulation == nil {
+				options.HeaderManipulation = &headers.HeaderManipulation{}
+			}
+			modifier := filter.ResponseHeaderModifier
+			for _, header := range headersToAdd(modifier) {
+				options.HeaderManipulation.ResponseHeadersToAdd = append(options.HeaderManipulation.ResponseHeadersToAdd, &headers.HeaderValueOption{
+					Header: &headers.HeaderValue{Key: header.Name, Value: header.Value},
+					Append: &types.BoolValue{Value: header.append},
+				})
+			}
+			options.HeaderManipulation.ResponseHeadersToRemove = append(options.HeaderManipulation.ResponseHeadersToRemove, headersToRemove(modifier)...)
+		case gatewayapi.HTTPRouteFilterRequestRedirect:
+			redirect, err := redirectAction(match, filter.RequestRedirect)
+			if err != nil {
+				return err
+			}
+			route.Action = &gloov1.Route_RedirectAction{RedirectAction: redirect}
+		case gatewayapi.HTTPRouteFilterURLRewrite:
+			rewrite := filter.URLRewrite
+			if rewrite == nil {
+				continue
+			}
+			if rewrite.Hostname != nil {
+				options.HostRewriteType = &gloov1.RouteOptions_HostRewrite{HostRewrite: *rewrite.Hostname}
+			}
+			if rewrite.Path != nil {
+				path, err := pathRewrite(match, rewrite.Path)
+				if err != nil {
+					return err
+				}
+				options.PrefixRewrite = &types.StringValue{Value: path}
+			}
+		default:
+			return UnsupportedFilterErr(filter.Type)
+		}
+	}
+	if !options.Equal(&gloov1.RouteOptions{}) {
+		route.Options = options
+	}
+	return nil
+}
+
+// envoy only rewrites the matched prefix of the path,
+// so the full path can only be replaced if the whole path is matched
+func pathRewrite(match gatewayapi.HTTPRouteMatch, modifier *gatewayapi.HTTPPathModifier) (string, error) {
+	switch modifier.Type {
+	case gatewayapi.PrefixMatchHTTPPathModifier:
+		return stringValue(modifier.ReplacePrefixMatch), nil
+	case gatewayapi.FullPathHTTPPathModifier:
+		if match.Path == nil || match.Path.Type == nil || *match.Path.Type != gatewayapi.PathMatchExact {
+			return "", UnsupportedFullPathRewriteErr
+		}
+		return stringValue(modifier.ReplaceFullPath), nil
+	}
+	return "", errors.Errorf("unknown path modifier type %v", modifier.Type)
+}
+
+func redirectAction(match gatewayapi.HTTPRouteMatch, filter *gatewayapi.HTTPRequestRedirectFilter) (*gloov1.RedirectAction, error) {
+	redirect := &gloov1.RedirectAction{
+		ResponseCode: gloov1.RedirectAction_FOUND,
+	}
+	if filter == nil {
+		return redirect, nil
+	}
+	redirect.HttpsRedirect = strings.EqualFold(stringValue(filter.Scheme), "https")
+	redirect.HostRedirect = stringValue(filter.Hostname)
+	if filter.Port != nil {
+		if redirect.HostRedirect == "" {
+			return nil, UnsupportedRedirectPortErr
+		}
+		redirect.HostRedirect = fmt.Sprintf("%v:%v", redirect.HostRedirect, *filter.Port)
+	}
+	if filter.StatusCode != nil {
+		switch *filter.StatusCode {
+		case http.StatusMovedPermanently:
+			redirect.ResponseCode = gloov1.RedirectAction_MOVED_PERMANENTLY
+		case http.StatusFound:
+		default:
+			return nil, UnsupportedRedirectStatusErr(*filter.StatusCode)
+		}
+	}
+	if filter.Path != nil {
+		switch filter.Path.Type {
+		case gatewayapi.FullPathHTTPPathModifier:
+			redirect.PathRewriteSpecifier = &gloov1.RedirectAction_PathRedirect{PathRedirect: stringValue(filter.Path.ReplaceFullPath)}
+		default:
+			path, err := pathRewrite(match, filter.Path)
+			if err != nil {
+				return nil, err
+			}
+			redirect.PathRewriteSpecifier = &gloov1.RedirectAction_PrefixRewrite{PrefixRewrite: path}
+		}
+	}
+	return redirect, nil
+}
+
+type headerToAdd struct {
+	gatewayapi.HTTPHeader
+	append bool
+}
+
+// headers which are set replace the existing values, while added headers are appended to them
+func headersToAdd(filter *gatewayapi.HTTPHeaderFilter) []headerToAdd {
+	if filter == nil {
+		return nil
+	}
+	var result []headerToAdd
+	for _, header := range filter.Set {
+		result = append(result, headerToAdd{HTTPHeader: header})
+	}
+	for _, header := range filter.Add {
+		result = append(result, headerToAdd{HTTPHeader: header, append: true})
+	}
+	return result
+}
+
+func headersToRemove(filter *gatewayapi.HTTPHeaderFilter) []string {
+	if filter == nil {
+		return nil
+	}
+	return filter.Remove
+}
@@ -0,0 +1,36 @@
+package translator
+
+import (
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/gatewayapi"
+)
+
+var groupName = gatewayapi.GroupName
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func stringOrDefault(value *string, defaultValue string) string {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
+
+// Listeners only allow routes from the namespace of their Gateway by default.
+func namespacesFrom(allowedRoutes *gatewayapi.AllowedRoutes) gatewayapi.FromNamespaces {
+	if allowedRoutes == nil || allowedRoutes.Namespaces == nil || allowedRoutes.Namespaces.From == nil {
+		return gatewayapi.NamespacesFromSame
+	}
+	return *allowedRoutes.Namespaces.From
+}
+
+func allowedKinds(allowedRoutes *gatewayapi.AllowedRoutes) []gatewayapi.RouteGroupKind {
+	if allowedRoutes == nil {
+		return nil
+	}
+	return allowedRoutes.Kinds
+}
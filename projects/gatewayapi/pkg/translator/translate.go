@@ -0,0 +1,727 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/gatewayapi"
+	v1 "github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// The controller name of the GatewayClasses which are reconciled by Gloo, unless configured otherwise.
+const DefaultControllerName = "solo.io/gloo-gateway"
+
+var (
+	UpstreamNotFoundErr = func(namespace, name string, port int32) error {
+		return errors.Errorf("upstream not found for kube service %v.%v with port %v", namespace, name, port)
+	}
+	BackendPortMissingErr = func(namespace, name string) error {
+		return errors.Errorf("backend %v.%v must specify a port", namespace, name)
+	}
+	BackendKindErr = func(group, kind string) error {
+		return errors.Errorf("backends of kind %v.%v are not supported", kind, group)
+	}
+	BackendRefNotPermittedErr = func(namespace, name string) error {
+		return errors.Errorf("backend %v.%v is in another namespace, and no ReferenceGrant allows the reference", namespace, name)
+	}
+)
+
+// Reports contain the statuses computed for the resources reconciled by Gloo.
+// Routes only contain the statuses of the parents which are Gateways of Gloo.
+type Reports struct {
+	GatewayClasses map[core.ResourceRef]*gatewayapi.GatewayClassStatus
+	Gateways       map[core.ResourceRef]*gatewayapi.GatewayStatus
+	HttpRoutes     map[core.ResourceRef][]gatewayapi.RouteParentStatus
+	TlsRoutes      map[core.ResourceRef][]gatewayapi.RouteParentStatus
+}
+
+// ProxyName returns the name of the proxy generated for a Gateway.
+// The proxy is written to the write namespace of Gloo, so the name includes the namespace of the Gateway.
+func ProxyName(gateway core.ResourceRef) string {
+	return fmt.Sprintf("%v-%v", gateway.Namespace, gateway.Name)
+}
+
+// Translate generates a proxy for each Gateway whose GatewayClass is reconciled by the given controller,
+// together with the statuses of the translated resources.
+func Translate(ctx context.Context, controllerName, writeNamespace string, snap *v1.TranslatorSnapshot) (gloov1.ProxyList, *Reports) {
+	t := &translation{
+		ctx:            ctx,
+		controllerName: controllerName,
+		upstreams:      snap.Upstreams,
+		gateways:       map[core.ResourceRef]*gatewayInfo{},
+		reports: &Reports{
+			GatewayClasses: map[core.ResourceRef]*gatewayapi.GatewayClassStatus{},
+			Gateways:       map[core.ResourceRef]*gatewayapi.GatewayStatus{},
+			HttpRoutes:     map[core.ResourceRef][]gatewayapi.RouteParentStatus{},
+			TlsRoutes:      map[core.ResourceRef][]gatewayapi.RouteParentStatus{},
+		},
+	}
+	t.decodeReferenceGrants(snap.ReferenceGrants)
+	classes := t.acceptGatewayClasses(snap.GatewayClasses)
+
+	var gateways []*gatewayInfo
+	for _, gw := range snap.Gateways {
+		info := t.validateGateway(gw, classes)
+		if info == nil {
+			continue
+		}
+		t.gateways[gw.GetMetadata().Ref()] = info
+		gateways = append(gateways, info)
+	}
+
+	for _, route := range snap.HttpRoutes {
+		t.attachHttpRoute(route)
+	}
+	for _, route := range snap.TlsRoutes {
+		t.attachTlsRoute(route)
+	}
+
+	var proxies gloov1.ProxyList
+	for _, gw := range gateways {
+		proxies = append(proxies, t.gatewayProxy(gw, writeNamespace))
+		t.reports.Gateways[gw.ref] = gw.status()
+	}
+	return proxies, t.reports
+}
+
+type translation struct {
+	ctx            context.Context
+	controllerName string
+	upstreams      gloov1.UpstreamList
+	grants         []*referenceGrant
+	gateways       map[core.ResourceRef]*gatewayInfo
+	reports        *Reports
+}
+
+type referenceGrant struct {
+	namespace string
+	spec      gatewayapi.ReferenceGrantSpec
+}
+
+type gatewayInfo struct {
+	ref        core.ResourceRef
+	generation int64
+	listeners  []*listenerInfo
+}
+
+type listenerInfo struct {
+	spec       gatewayapi.Listener
+	kinds      []gatewayapi.RouteGroupKind
+	secret     *core.ResourceRef
+	conditions []gatewayapi.Condition
+	accepted   bool
+	httpRoutes []*attachedHttpRoute
+	tlsRoutes  []*attachedTlsRoute
+}
+
+type attachedHttpRoute struct {
+	hostnames []string
+	routes    []*gloov1.Route
+}
+
+type attachedTlsRoute struct {
+	name        string
+	hostnames   []string
+	destination *gloov1.TcpHost_TcpAction
+}
+
+func (t *translation) decodeReferenceGrants(grants v1.ReferenceGrantList) {
+	for _, grant := range grants {
+		var spec gatewayapi.ReferenceGrantSpec
+		if err := gatewayapi.DecodeSpec(grant, &spec); err != nil {
+			contextutils.LoggerFrom(t.ctx).Errorf("internal error: parsing reference grant: %v", err)
+			continue
+		}
+		t.grants = append(t.grants, &referenceGrant{namespace: grant.GetMetadata().Namespace, spec: spec})
+	}
+}
+
+// referenceAllowed returns whether a resource may reference a resource in another namespace.
+func (t *translation) referenceAllowed(fromKind, fromNamespace, toGroup, toKind, toNamespace, toName string) bool {
+	if fromNamespace == toNamespace {
+		return true
+	}
+	for _, grant := range t.grants {
+		if grant.namespace != toNamespace {
+			continue
+		}
+		fromAllowed := false
+		for _, from := range grant.spec.From {
+			if from.Group == gatewayapi.GroupName && from.Kind == fromKind && from.Namespace == fromNamespace {
+				fromAllowed = true
+			}
+		}
+		if !fromAllowed {
+			continue
+		}
+		for _, to := range grant.spec.To {
+			if to.Group == toGroup && to.Kind == toKind && (to.Name == nil || *to.Name == toName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// acceptGatewayClasses returns the names of the GatewayClasses of the controller.
+// GatewayClasses are cluster scoped, so they may be listed once for every watched namespace.
+func (t *translation) acceptGatewayClasses(gatewayClasses v1.GatewayClassList) map[string]bool {
+	classes := map[string]bool{}
+	for _, gwc := range gatewayClasses {
+		var spec gatewayapi.GatewayClassSpec
+		if err := gatewayapi.DecodeSpec(gwc, &spec); err != nil {
+			contextutils.LoggerFrom(t.ctx).Errorf("internal error: parsing gateway class: %v", err)
+			continue
+		}
+		if spec.ControllerName != t.controllerName {
+			continue
+		}
+		classes[gwc.GetMetadata().Name] = true
+		t.reports.GatewayClasses[gwc.GetMetadata().Ref()] = &gatewayapi.GatewayClassStatus{
+			Conditions: []gatewayapi.Condition{
+				trueCondition(ConditionAccepted, ReasonAccepted, gwc.GetMetadata().Generation),
+			},
+		}
+	}
+	return classes
+}
+
+func (t *translation) validateGateway(gw *v1.Gateway, classes map[string]bool) *gatewayInfo {
+	var spec gatewayapi.GatewaySpec
+	if err := gatewayapi.DecodeSpec(gw, &spec); err != nil {
+		contextutils.LoggerFrom(t.ctx).Errorf("internal error: parsing gateway: %v", err)
+		return nil
+	}
+	if !classes[spec.GatewayClassName] {
+		return nil
+	}
+
+	info := &gatewayInfo{
+		ref:        gw.GetMetadata().Ref(),
+		generation: gw.GetMetadata().Generation,
+	}
+	for _, listener := range spec.Listeners {
+		info.listeners = append(info.listeners, t.validateListener(info, listener))
+	}
+	return info
+}
+
+func (t *translation) validateListener(gw *gatewayInfo, listener gatewayapi.Listener) *listenerInfo {
+	info := &listenerInfo{spec: listener, accepted: true}
+	generation := gw.generation
+	reject := func(conditionType, reason, message string) {
+		info.accepted = false
+		info.conditions = append(info.conditions, falseCondition(conditionType, reason, message, generation))
+	}
+
+	// listeners which share a port must have the same protocol and distinct hostnames
+	conflicted := false
+	for _, other := range gw.listeners {
+		if other.spec.Port != listener.Port {
+			continue
+		}
+		if other.spec.Protocol != listener.Protocol {
+			reject(ConditionConflicted, ReasonProtocolConflict, fmt.Sprintf("listener %v uses port %v with protocol %v", other.spec.Name, listener.Port, other.spec.Protocol))
+			conflicted = true
+			break
+		}
+		if stringValue(other.spec.Hostname) == stringValue(listener.Hostname) {
+			reject(ConditionConflicted, ReasonHostnameConflict, fmt.Sprintf("listener %v uses port %v with the same hostname", other.spec.Name, listener.Port))
+			conflicted = true
+			break
+		}
+	}
+	if !conflicted {
+		info.conditions = append(info.conditions, gatewayapi.Condition{
+			Type:               ConditionConflicted,
+			Status:             gatewayapi.ConditionFalse,
+			ObservedGeneration: generation,
+			Reason:             ReasonNoConflicts,
+		})
+	}
+
+	var routeKind string
+	switch listener.Protocol {
+	case gatewayapi.HTTPProtocolType:
+		routeKind = gatewayapi.HTTPRouteKind
+	case gatewayapi.HTTPSProtocolType:
+		routeKind = gatewayapi.HTTPRouteKind
+		t.resolveCertificate(gw, info, reject)
+	case gatewayapi.TLSProtocolType:
+		routeKind = gatewayapi.TLSRouteKind
+		// the listener terminates TLS, and TLSRoutes select the backends by SNI
+		t.resolveCertificate(gw, info, reject)
+	default:
+		reject(ConditionAccepted, ReasonUnsupportedProtocol, fmt.Sprintf("protocol %v is not supported", listener.Protocol))
+	}
+
+	if from := namespacesFrom(listener.AllowedRoutes); from != gatewayapi.NamespacesFromSame && from != gatewayapi.NamespacesFromAll {
+		reject(ConditionAccepted, ReasonUnsupportedValue, fmt.Sprintf("routes from namespaces %v are not supported", from))
+	}
+
+	if routeKind != "" {
+		if len(allowedKinds(listener.AllowedRoutes)) == 0 {
+			info.kinds = []gatewayapi.RouteGroupKind{{Group: &groupName, Kind: routeKind}}
+		}
+		for _, kind := range allowedKinds(listener.AllowedRoutes) {
+			if stringOrDefault(kind.Group, gatewayapi.GroupName) == gatewayapi.GroupName && kind.Kind == routeKind {
+				info.kinds = append(info.kinds, gatewayapi.RouteGroupKind{Group: &groupName, Kind: routeKind})
+			} else {
+				info.conditions = append(info.conditions, falseCondition(ConditionResolvedRefs, ReasonInvalidRouteKinds,
+					fmt.Sprintf("routes of kind %v are not supported by %v listeners", kind.Kind, listener.Protocol), generation))
+			}
+		}
+	}
+
+	if FindCondition(info.conditions, ConditionAccepted) == nil {
+		info.conditions = append(info.conditions, trueCondition(ConditionAccepted, ReasonAccepted, generation))
+	}
+	if FindCondition(info.conditions, ConditionResolvedRefs) == nil {
+		info.conditions = append(info.conditions, trueCondition(ConditionResolvedRefs, ReasonResolvedRefs, generation))
+	}
+	if info.accepted {
+		info.conditions = append(info.conditions, trueCondition(ConditionProgrammed, ReasonProgrammed, generation))
+	} else {
+		info.conditions = append(info.conditions, falseCondition(ConditionProgrammed, ReasonInvalid, "the listener is not valid", generation))
+	}
+	return info
+}
+
+func (t *translation) resolveCertificate(gw *gatewayInfo, info *listenerInfo, reject func(conditionType, reason, message string)) {
+	tls := info.spec.TLS
+	if tls != nil && tls.Mode != nil && *tls.Mode != gatewayapi.TLSModeTerminate {
+		reject(ConditionAccepted, ReasonUnsupportedValue, fmt.Sprintf("tls mode %v is not supported", *tls.Mode))
+		return
+	}
+	if tls == nil || len(tls.CertificateRefs) == 0 {
+		reject(ConditionResolvedRefs, ReasonInvalidCertificateRef, "the listener must reference a certificate")
+		return
+	}
+	certificate := tls.CertificateRefs[0]
+	if stringValue(certificate.Group) != "" || stringOrDefault(certificate.Kind, gatewayapi.SecretKind) != gatewayapi.SecretKind {
+		reject(ConditionResolvedRefs, ReasonInvalidCertificateRef, "certificates must be secrets")
+		return
+	}
+	namespace := stringOrDefault(certificate.Namespace, gw.ref.Namespace)
+	if !t.referenceAllowed(gatewayapi.GatewayKind, gw.ref.Namespace, "", gatewayapi.SecretKind, namespace, certificate.Name) {
+		reject(ConditionResolvedRefs, ReasonRefNotPermitted, fmt.Sprintf("no ReferenceGrant allows the reference to secret %v.%v", namespace, certificate.Name))
+		return
+	}
+	info.secret = &core.ResourceRef{Namespace: namespace, Name: certificate.Name}
+}
+
+func (gw *gatewayInfo) status() *gatewayapi.GatewayStatus {
+	status := &gatewayapi.GatewayStatus{}
+	accepted := false
+	for _, listener := range gw.listeners {
+		accepted = accepted || listener.accepted
+		kinds := listener.kinds
+		if kinds == nil {
+			kinds = []gatewayapi.RouteGroupKind{}
+		}
+		status.Listeners = append(status.Listeners, gatewayapi.ListenerStatus{
+			Name:           listener.spec.Name,
+			SupportedKinds: kinds,
+			AttachedRoutes: int32(len(listener.httpRoutes) + len(listener.tlsRoutes)),
+			Conditions:     listener.conditions,
+		})
+	}
+	if accepted {
+		status.Conditions = []gatewayapi.Condition{
+			trueCondition(ConditionAccepted, ReasonAccepted, gw.generation),
+			trueCondition(ConditionProgrammed, ReasonProgrammed, gw.generation),
+		}
+	} else {
+		status.Conditions = []gatewayapi.Condition{
+			falseCondition(ConditionAccepted, ReasonListenersNotValid, "none of the listeners are valid", gw.generation),
+			falseCondition(ConditionProgrammed, ReasonInvalid, "none of the listeners are valid", gw.generation),
+		}
+	}
+	return status
+}
+
+// attachToParents attaches a route to the listeners of the Gateways of the controller which it references,
+// and returns the status of the route for each of these parents.
+// Routes which use unsupported features are not attached.
+func (t *translation) attachToParents(
+	kind string,
+	namespace string,
+	generation int64,
+	parentRefs []gatewayapi.ParentReference,
+	routeHostnames []string,
+	resolvedRefs gatewayapi.Condition,
+	unsupported error,
+	attach func(listener *listenerInfo, hostnames []string),
+) []gatewayapi.RouteParentStatus {
+	var parents []gatewayapi.RouteParentStatus
+	for _, parentRef := range parentRefs {
+		if stringOrDefault(parentRef.Group, gatewayapi.GroupName) != gatewayapi.GroupName ||
+			stringOrDefault(parentRef.Kind, gatewayapi.GatewayKind) != gatewayapi.GatewayKind {
+			continue
+		}
+		gw, ok := t.gateways[core.ResourceRef{Namespace: stringOrDefault(parentRef.Namespace, namespace), Name: parentRef.Name}]
+		if !ok {
+			continue
+		}
+
+		accepted := falseCondition(ConditionAccepted, ReasonNoMatchingParent, "no listener matches the parent reference", generation)
+		if unsupported != nil {
+			parents = append(parents, gatewayapi.RouteParentStatus{
+				ParentRef:      parentRef,
+				ControllerName: t.controllerName,
+				Conditions: []gatewayapi.Condition{
+					falseCondition(ConditionAccepted, ReasonUnsupportedValue, unsupported.Error(), generation),
+					resolvedRefs,
+				},
+			})
+			continue
+		}
+		for _, listener := range gw.listeners {
+			if parentRef.SectionName != nil && *parentRef.SectionName != listener.spec.Name ||
+				parentRef.Port != nil && *parentRef.Port != listener.spec.Port {
+				continue
+			}
+			if !listener.accepted || !listener.allows(kind, namespace, gw.ref.Namespace) {
+				if accepted.Reason == ReasonNoMatchingParent {
+					accepted = falseCondition(ConditionAccepted, ReasonNotAllowedByListeners, "the matching listeners do not allow the route", generation)
+				}
+				continue
+			}
+			hostnames := intersectHostnames(listener.spec.Hostname, routeHostnames)
+			if len(hostnames) == 0 {
+				if accepted.Reason != ReasonAccepted {
+					accepted = falseCondition(ConditionAccepted, ReasonNoMatchingListenerHostname, "the hostnames of the route do not match the matching listeners", generation)
+				}
+				continue
+			}
+			attach(listener, hostnames)
+			accepted = trueCondition(ConditionAccepted, ReasonAccepted, generation)
+		}
+
+		parents = append(parents, gatewayapi.RouteParentStatus{
+			ParentRef:      parentRef,
+			ControllerName: t.controllerName,
+			Conditions:     []gatewayapi.Condition{accepted, resolvedRefs},
+		})
+	}
+	return parents
+}
+
+func (l *listenerInfo) allows(kind, routeNamespace, gatewayNamespace string) bool {
+	kindAllowed := false
+	for _, allowed := range l.kinds {
+		kindAllowed = kindAllowed || allowed.Kind == kind
+	}
+	if !kindAllowed {
+		return false
+	}
+	return namespacesFrom(l.spec.AllowedRoutes) == gatewayapi.NamespacesFromAll || routeNamespace == gatewayNamespace
+}
+
+func (t *translation) attachHttpRoute(route *v1.HttpRoute) {
+	var spec gatewayapi.HTTPRouteSpec
+	if err := gatewayapi.DecodeSpec(route, &spec); err != nil {
+		contextutils.LoggerFrom(t.ctx).Errorf("internal error: parsing http route: %v", err)
+		return
+	}
+	meta := route.GetMetadata()
+
+	routes, resolvedRefs, err := t.httpRoutes(meta.Namespace, meta.Generation, spec)
+	parents := t.attachToParents(gatewayapi.HTTPRouteKind, meta.Namespace, meta.Generation, spec.ParentRefs, spec.Hostnames, resolvedRefs, err,
+		func(listener *listenerInfo, hostnames []string) {
+			listener.httpRoutes = append(listener.httpRoutes, &attachedHttpRoute{hostnames: hostnames, routes: routes})
+		})
+	if len(parents) > 0 {
+		t.reports.HttpRoutes[meta.Ref()] = parents
+	}
+}
+
+func (t *translation) attachTlsRoute(route *v1.TlsRoute) {
+	var spec gatewayapi.TLSRouteSpec
+	if err := gatewayapi.DecodeSpec(route, &spec); err != nil {
+		contextutils.LoggerFrom(t.ctx).Errorf("internal error: parsing tls route: %v", err)
+		return
+	}
+	meta := route.GetMetadata()
+
+	// the backends of all rules share the route
+	var backendRefs []gatewayapi.BackendRef
+	for _, rule := range spec.Rules {
+		backendRefs = append(backendRefs, rule.BackendRefs...)
+	}
+	destinations, err := t.destinations(gatewayapi.TLSRouteKind, meta.Namespace, backendRefs)
+	resolvedRefs := t.resolvedRefsCondition(err, meta.Generation)
+
+	var destination *gloov1.TcpHost_TcpAction
+	switch len(destinations) {
+	case 0:
+	case 1:
+		destination = &gloov1.TcpHost_TcpAction{
+			Destination: &gloov1.TcpHost_TcpAction_Single{Single: destinations[0].GetDestination()},
+		}
+	default:
+		destination = &gloov1.TcpHost_TcpAction{
+			Destination: &gloov1.TcpHost_TcpAction_Multi{Multi: &gloov1.MultiDestination{Destinations: destinations}},
+		}
+	}
+
+	parents := t.attachToParents(gatewayapi.TLSRouteKind, meta.Namespace, meta.Generation, spec.ParentRefs, spec.Hostnames, resolvedRefs, nil,
+		func(listener *listenerInfo, hostnames []string) {
+			if destination == nil {
+				return
+			}
+			listener.tlsRoutes = append(listener.tlsRoutes, &attachedTlsRoute{
+				name:        meta.Namespace + "-" + meta.Name,
+				hostnames:   hostnames,
+				destination: destination,
+			})
+		})
+	if len(parents) > 0 {
+		t.reports.TlsRoutes[meta.Ref()] = parents
+	}
+}
+
+func (t *translation) resolvedRefsCondition(err error, generation int64) gatewayapi.Condition {
+	if err == nil {
+		return trueCondition(ConditionResolvedRefs, ReasonResolvedRefs, generation)
+	}
+	reason := ReasonBackendNotFound
+	switch {
+	case errors.Is(err, errRefNotPermitted):
+		reason = ReasonRefNotPermitted
+	case errors.Is(err, errInvalidKind):
+		reason = ReasonInvalidKind
+	}
+	return falseCondition(ConditionResolvedRefs, reason, err.Error(), generation)
+}
+
+var (
+	errRefNotPermitted = errors.New("reference not permitted")
+	errInvalidKind     = errors.New("invalid kind")
+)
+
+// destinations returns the upstreams of the backends which could be resolved.
+// The error describes the first backend which could not be resolved.
+func (t *translation) destinations(routeKind, namespace string, backendRefs []gatewayapi.BackendRef) ([]*gloov1.WeightedDestination, error) {
+	var (
+		destinations []*gloov1.WeightedDestination
+		firstErr     error
+	)
+	for _, backend := range backendRefs {
+		weight := int32(1)
+		if backend.Weight != nil {
+			weight = *backend.Weight
+		}
+		upstream, err := t.upstreamForBackend(routeKind, namespace, backend)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if weight == 0 {
+			continue
+		}
+		destinations = append(destinations, &gloov1.WeightedDestination{
+			Destination: &gloov1.Destination{
+				DestinationType: &gloov1.Destination_Upstream{
+					Upstream: utils.ResourceRefPtr(upstream.GetMetadata().Ref()),
+				},
+			},
+			Weight: uint32(weight),
+		})
+	}
+	return destinations, firstErr
+}
+
+// upstreamForBackend returns the kube upstream of the service of a backend.
+func (t *translation) upstreamForBackend(routeKind, namespace string, backend gatewayapi.BackendRef) (*gloov1.Upstream, error) {
+	group, kind := stringValue(backend.Group), stringOrDefault(backend.Kind, gatewayapi.ServiceKind)
+	if group != "" || kind != gatewayapi.ServiceKind {
+		return nil, errors.Wrap(errInvalidKind, BackendKindErr(group, kind).Error())
+	}
+	serviceNamespace := stringOrDefault(backend.Namespace, namespace)
+	if !t.referenceAllowed(routeKind, namespace, "", gatewayapi.ServiceKind, serviceNamespace, backend.Name) {
+		return nil, errors.Wrap(errRefNotPermitted, BackendRefNotPermittedErr(serviceNamespace, backend.Name).Error())
+	}
+	if backend.Port == nil {
+		return nil, BackendPortMissingErr(serviceNamespace, backend.Name)
+	}
+
+	// find the upstream with the smallest matching selector
+	// longer selectors represent subsets of pods for a service
+	var matchingUpstream *gloov1.Upstream
+	for _, us := range t.upstreams {
+		kube := us.GetKube()
+		if kube == nil || kube.GetServiceNamespace() != serviceNamespace || kube.GetServiceName() != backend.Name ||
+			kube.GetServicePort() != uint32(*backend.Port) {
+			continue
+		}
+		if matchingUpstream != nil && len(kube.GetSelector()) > len(matchingUpstream.GetKube().GetSelector()) {
+			continue
+		}
+		matchingUpstream = us
+	}
+	if matchingUpstream == nil {
+		return nil, UpstreamNotFoundErr(serviceNamespace, backend.Name, *backend.Port)
+	}
+	return matchingUpstream, nil
+}
+
+// gatewayProxy generates the proxy of a Gateway, with a listener for each port of its valid listeners.
+func (t *translation) gatewayProxy(gw *gatewayInfo, writeNamespace string) *gloov1.Proxy {
+	proxy := &gloov1.Proxy{
+		Metadata: core.Metadata{
+			Name:      ProxyName(gw.ref),
+			Namespace: writeNamespace,
+		},
+	}
+
+	var ports []int32
+	listenersByPort := map[int32][]*listenerInfo{}
+	for _, listener := range gw.listeners {
+		if !listener.accepted {
+			continue
+		}
+		port := listener.spec.Port
+		if _, ok := listenersByPort[port]; !ok {
+			ports = append(ports, port)
+		}
+		listenersByPort[port] = append(listenersByPort[port], listener)
+	}
+
+	for _, port := range ports {
+		listeners := listenersByPort[port]
+		switch listeners[0].spec.Protocol {
+		case gatewayapi.HTTPProtocolType, gatewayapi.HTTPSProtocolType:
+			proxy.Listeners = append(proxy.Listeners, httpListener(port, listeners))
+		case gatewayapi.TLSProtocolType:
+			proxy.Listeners = append(proxy.Listeners, tcpListener(port, listeners))
+		}
+	}
+	return proxy
+}
+
+func httpListener(port int32, listeners []*listenerInfo) *gloov1.Listener {
+	var (
+		hosts        []string
+		routesByHost = map[string][]*gloov1.Route{}
+		sslConfigs   []*gloov1.SslConfig
+	)
+	for _, listener := range listeners {
+		for _, route := range listener.httpRoutes {
+			for _, host := range route.hostnames {
+				if _, ok := routesByHost[host]; !ok {
+					hosts = append(hosts, host)
+				}
+				for _, r := range route.routes {
+					routesByHost[host] = append(routesByHost[host], proto.Clone(r).(*gloov1.Route))
+				}
+			}
+		}
+		if listener.secret != nil {
+			secret := *listener.secret
+			sslConfig := &gloov1.SslConfig{
+				SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: &secret},
+			}
+			if listener.spec.Hostname != nil {
+				sslConfig.SniDomains = []string{*listener.spec.Hostname}
+			}
+			sslConfigs = append(sslConfigs, sslConfig)
+		}
+	}
+
+	var virtualHosts []*gloov1.VirtualHost
+	for _, host := range hosts {
+		routes := routesByHost[host]
+		glooutils.SortRoutesByPath(routes)
+		domains := []string{host}
+		if host != "*" {
+			domains = append(domains, fmt.Sprintf("%v:%v", host, port))
+		}
+		virtualHosts = append(virtualHosts, &gloov1.VirtualHost{
+			Name:    strings.Replace(host, "*", "wildcard", 1),
+			Domains: domains,
+			Routes:  routes,
+		})
+	}
+
+	return &gloov1.Listener{
+		Name:        fmt.Sprintf("http-%v", port),
+		BindAddress: "::",
+		BindPort:    uint32(port),
+		ListenerType: &gloov1.Listener_HttpListener{
+			HttpListener: &gloov1.HttpListener{
+				VirtualHosts: virtualHosts,
+			},
+		},
+		SslConfigurations: sslConfigs,
+	}
+}
+
+func tcpListener(port int32, listeners []*listenerInfo) *gloov1.Listener {
+	var tcpHosts []*gloov1.TcpHost
+	for _, listener := range listeners {
+		for _, route := range listener.tlsRoutes {
+			secret := *listener.secret
+			tcpHosts = append(tcpHosts, &gloov1.TcpHost{
+				Name: route.name,
+				SslConfig: &gloov1.SslConfig{
+					SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: &secret},
+					SniDomains: route.hostnames,
+				},
+				Destination: proto.Clone(route.destination).(*gloov1.TcpHost_TcpAction),
+			})
+		}
+	}
+	return &gloov1.Listener{
+		Name:        fmt.Sprintf("tls-%v", port),
+		BindAddress: "::",
+		BindPort:    uint32(port),
+		ListenerType: &gloov1.Listener_TcpListener{
+			TcpListener: &gloov1.TcpListener{
+				TcpHosts: tcpHosts,
+			},
+		},
+	}
+}
+
+// intersectHostnames returns the hostnames which are matched by both a listener and a route.
+// Wildcard hostnames match any hostname with the same suffix. The more specific hostname is returned.
+func intersectHostnames(listenerHostname *string, routeHostnames []string) []string {
+	if listenerHostname == nil || *listenerHostname == "" {
+		if len(routeHostnames) == 0 {
+			return []string{"*"}
+		}
+		return routeHostnames
+	}
+	if len(routeHostnames) == 0 {
+		return []string{*listenerHostname}
+	}
+	var hostnames []string
+	for _, hostname := range routeHostnames {
+		switch {
+		case hostname == *listenerHostname || hostnameMatches(*listenerHostname, hostname):
+			hostnames = append(hostnames, hostname)
+		case hostnameMatches(hostname, *listenerHostname):
+			hostnames = append(hostnames, *listenerHostname)
+		}
+	}
+	return hostnames
+}
+
+// hostnameMatches returns whether the wildcard hostname matches a more specific hostname.
+func hostnameMatches(wildcard, hostname string) bool {
+	if !strings.HasPrefix(wildcard, "*.") || strings.HasPrefix(hostname, "*.") && len(hostname) <= len(wildcard) {
+		return false
+	}
+	return strings.HasSuffix(hostname, wildcard[1:])
+}
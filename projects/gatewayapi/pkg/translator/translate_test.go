@@ -0,0 +1,492 @@
+package translator_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/gatewayapi"
+	v1 "github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gatewayapi/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Translate", func() {
+
+	const writeNamespace = "gloo-system"
+
+	encode := func(spec interface{}) *types.Any {
+		raw, err := json.Marshal(spec)
+		Expect(err).NotTo(HaveOccurred())
+		return &types.Any{Value: raw}
+	}
+	str := func(s string) *string { return &s }
+	port := func(p int32) *int32 { return &p }
+
+	upstream := func(namespace, service string, port uint32) *gloov1.Upstream {
+		return &gloov1.Upstream{
+			Metadata: core.Metadata{Namespace: writeNamespace, Name: namespace + "-" + service},
+			UpstreamType: &gloov1.Upstream_Kube{
+				Kube: &kubernetes.UpstreamSpec{
+					ServiceNamespace: namespace,
+					ServiceName:      service,
+					ServicePort:      port,
+				},
+			},
+		}
+	}
+	upstreamDestination := func(us *gloov1.Upstream) *gloov1.Destination {
+		ref := us.Metadata.Ref()
+		return &gloov1.Destination{DestinationType: &gloov1.Destination_Upstream{Upstream: &ref}}
+	}
+
+	var (
+		snap         *v1.TranslatorSnapshot
+		gatewayClass *v1.GatewayClass
+		httpListener gatewayapi.Listener
+		gatewayRef   = core.ResourceRef{Namespace: "default", Name: "gw"}
+		routeRef     = core.ResourceRef{Namespace: "default", Name: "route"}
+		defaultUs    = upstream("default", "svc", 8080)
+		otherUs      = upstream("other", "svc", 8080)
+	)
+
+	gateway := func(listeners ...gatewayapi.Listener) *v1.Gateway {
+		return &v1.Gateway{
+			Metadata: core.Metadata{Namespace: gatewayRef.Namespace, Name: gatewayRef.Name, Generation: 2},
+			KubeSpec: encode(gatewayapi.GatewaySpec{GatewayClassName: "gloo", Listeners: listeners}),
+		}
+	}
+	httpRoute := func(spec gatewayapi.HTTPRouteSpec) *v1.HttpRoute {
+		if spec.ParentRefs == nil {
+			spec.ParentRefs = []gatewayapi.ParentReference{{Name: gatewayRef.Name}}
+		}
+		return &v1.HttpRoute{
+			Metadata: core.Metadata{Namespace: routeRef.Namespace, Name: routeRef.Name, Generation: 3},
+			KubeSpec: encode(spec),
+		}
+	}
+	backend := func(namespace, name string, p int32) gatewayapi.BackendRef {
+		ref := gatewayapi.BackendRef{Name: name, Port: port(p)}
+		if namespace != "" {
+			ref.Namespace = str(namespace)
+		}
+		return ref
+	}
+
+	translate := func() (gloov1.ProxyList, *Reports) {
+		return Translate(context.TODO(), DefaultControllerName, writeNamespace, snap)
+	}
+	routeCondition := func(reports *Reports, conditionType string) *gatewayapi.Condition {
+		parents := reports.HttpRoutes[routeRef]
+		ExpectWithOffset(1, parents).To(HaveLen(1))
+		return FindCondition(parents[0].Conditions, conditionType)
+	}
+	vhostRoutes := func(proxies gloov1.ProxyList) []*gloov1.Route {
+		ExpectWithOffset(1, proxies).To(HaveLen(1))
+		vhosts := proxies[0].Listeners[0].GetHttpListener().GetVirtualHosts()
+		ExpectWithOffset(1, vhosts).To(HaveLen(1))
+		return vhosts[0].Routes
+	}
+
+	BeforeEach(func() {
+		gatewayClass = &v1.GatewayClass{
+			Metadata: core.Metadata{Name: "gloo"},
+			KubeSpec: encode(gatewayapi.GatewayClassSpec{ControllerName: DefaultControllerName}),
+		}
+		httpListener = gatewayapi.Listener{Name: "http", Port: 8080, Protocol: gatewayapi.HTTPProtocolType}
+		snap = &v1.TranslatorSnapshot{
+			Upstreams:      gloov1.UpstreamList{defaultUs, otherUs},
+			GatewayClasses: v1.GatewayClassList{gatewayClass},
+			Gateways:       v1.GatewayList{gateway(httpListener)},
+		}
+	})
+
+	It("translates gateways and http routes into a proxy", func() {
+		snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{
+			Hostnames: []string{"example.com"},
+			Rules: []gatewayapi.HTTPRouteRule{{
+				BackendRefs: []gatewayapi.BackendRef{backend("", "svc", 8080)},
+			}},
+		})}
+
+		proxies, reports := translate()
+
+		Expect(proxies).To(Equal(gloov1.ProxyList{{
+			Metadata: core.Metadata{Namespace: writeNamespace, Name: ProxyName(gatewayRef)},
+			Listeners: []*gloov1.Listener{{
+				Name:        "http-8080",
+				BindAddress: "::",
+				BindPort:    8080,
+				ListenerType: &gloov1.Listener_HttpListener{
+					HttpListener: &gloov1.HttpListener{
+						VirtualHosts: []*gloov1.VirtualHost{{
+							Name:    "example.com",
+							Domains: []string{"example.com", "example.com:8080"},
+							Routes: []*gloov1.Route{{
+								Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}}},
+								Action: &gloov1.Route_RouteAction{
+									RouteAction: &gloov1.RouteAction{
+										Destination: &gloov1.RouteAction_Single{Single: upstreamDestination(defaultUs)},
+									},
+								},
+							}},
+						}},
+					},
+				},
+			}},
+		}}))
+
+		Expect(reports.GatewayClasses[gatewayClass.Metadata.Ref()].Conditions).To(ConsistOf(
+			gatewayapi.Condition{Type: ConditionAccepted, Status: gatewayapi.ConditionTrue, Reason: ReasonAccepted},
+		))
+		gatewayStatus := reports.Gateways[gatewayRef]
+		Expect(FindCondition(gatewayStatus.Conditions, ConditionAccepted).Status).To(Equal(gatewayapi.ConditionTrue))
+		Expect(FindCondition(gatewayStatus.Conditions, ConditionProgrammed).ObservedGeneration).To(Equal(int64(2)))
+		Expect(gatewayStatus.Listeners).To(HaveLen(1))
+		Expect(gatewayStatus.Listeners[0].AttachedRoutes).To(Equal(int32(1)))
+		Expect(gatewayStatus.Listeners[0].SupportedKinds[0].Kind).To(Equal(gatewayapi.HTTPRouteKind))
+
+		Expect(reports.HttpRoutes[routeRef][0].ControllerName).To(Equal(DefaultControllerName))
+		Expect(*routeCondition(reports, ConditionAccepted)).To(Equal(gatewayapi.Condition{
+			Type: ConditionAccepted, Status: gatewayapi.ConditionTrue, Reason: ReasonAccepted, ObservedGeneration: 3,
+		}))
+		Expect(routeCondition(reports, ConditionResolvedRefs).Status).To(Equal(gatewayapi.ConditionTrue))
+	})
+
+	It("ignores gateways of other controllers", func() {
+		gatewayClass.KubeSpec = encode(gatewayapi.GatewayClassSpec{ControllerName: "example.com/other"})
+		snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{})}
+
+		proxies, reports := translate()
+
+		Expect(proxies).To(BeEmpty())
+		Expect(reports.GatewayClasses).To(BeEmpty())
+		Expect(reports.Gateways).To(BeEmpty())
+		Expect(reports.HttpRoutes).To(BeEmpty())
+	})
+
+	It("splits traffic between weighted backends", func() {
+		snap.ReferenceGrants = v1.ReferenceGrantList{{
+			Metadata: core.Metadata{Namespace: "other", Name: "grant"},
+			KubeSpec: encode(gatewayapi.ReferenceGrantSpec{
+				From: []gatewayapi.ReferenceGrantFrom{{Group: gatewayapi.GroupName, Kind: gatewayapi.HTTPRouteKind, Namespace: "default"}},
+				To:   []gatewayapi.ReferenceGrantTo{{Kind: gatewayapi.ServiceKind}},
+			}),
+		}}
+		weighted := backend("other", "svc", 8080)
+		weighted.Weight = port(3)
+		snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{
+			Rules: []gatewayapi.HTTPRouteRule{{
+				BackendRefs: []gatewayapi.BackendRef{backend("", "svc", 8080), weighted},
+			}},
+		})}
+
+		proxies, reports := translate()
+
+		Expect(vhostRoutes(proxies)[0].GetRouteAction().GetMulti().GetDestinations()).To(Equal([]*gloov1.WeightedDestination{
+			{Destination: upstreamDestination(defaultUs), Weight: 1},
+			{Destination: upstreamDestination(otherUs), Weight: 3},
+		}))
+		Expect(routeCondition(reports, ConditionResolvedRefs).Status).To(Equal(gatewayapi.ConditionTrue))
+	})
+
+	It("requires a reference grant for backends in other namespaces", func() {
+		snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{
+			Rules: []gatewayapi.HTTPRouteRule{{
+				BackendRefs: []gatewayapi.BackendRef{backend("other", "svc", 8080)},
+			}},
+		})}
+
+		proxies, reports := translate()
+
+		Expect(vhostRoutes(proxies)[0].GetDirectResponseAction().GetStatus()).To(Equal(uint32(500)))
+		resolvedRefs := routeCondition(reports, ConditionResolvedRefs)
+		Expect(resolvedRefs.Status).To(Equal(gatewayapi.ConditionFalse))
+		Expect(resolvedRefs.Reason).To(Equal(ReasonRefNotPermitted))
+		Expect(routeCondition(reports, ConditionAccepted).Status).To(Equal(gatewayapi.ConditionTrue))
+	})
+
+	It("reports backends without upstreams", func() {
+		snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{
+			Rules: []gatewayapi.HTTPRouteRule{{
+				BackendRefs: []gatewayapi.BackendRef{backend("", "missing", 80)},
+			}},
+		})}
+
+		_, reports := translate()
+
+		resolvedRefs := routeCondition(reports, ConditionResolvedRefs)
+		Expect(resolvedRefs.Reason).To(Equal(ReasonBackendNotFound))
+		Expect(resolvedRefs.Message).To(Equal(UpstreamNotFoundErr("default", "missing", 80).Error()))
+	})
+
+	Context("attaching routes", func() {
+
+		It("uses the intersection of the hostnames of the listener and the route", func() {
+			httpListener.Hostname = str("*.example.com")
+			snap.Gateways = v1.GatewayList{gateway(httpListener)}
+			snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{
+				Hostnames: []string{"foo.example.com", "example.org"},
+			})}
+
+			proxies, _ := translate()
+
+			vhosts := proxies[0].Listeners[0].GetHttpListener().GetVirtualHosts()
+			Expect(vhosts).To(HaveLen(1))
+			Expect(vhosts[0].Domains).To(Equal([]string{"foo.example.com", "foo.example.com:8080"}))
+		})
+
+		It("does not attach routes without matching hostnames", func() {
+			httpListener.Hostname = str("*.example.com")
+			snap.Gateways = v1.GatewayList{gateway(httpListener)}
+			snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{
+				Hostnames: []string{"example.org"},
+			})}
+
+			proxies, reports := translate()
+
+			Expect(proxies[0].Listeners[0].GetHttpListener().GetVirtualHosts()).To(BeEmpty())
+			Expect(routeCondition(reports, ConditionAccepted).Reason).To(Equal(ReasonNoMatchingListenerHostname))
+			Expect(reports.Gateways[gatewayRef].Listeners[0].AttachedRoutes).To(BeZero())
+		})
+
+		It("only attaches routes from the namespace of the gateway by default", func() {
+			route := httpRoute(gatewayapi.HTTPRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{{Name: gatewayRef.Name, Namespace: str(gatewayRef.Namespace)}},
+			})
+			route.Metadata.Namespace = "other"
+			snap.HttpRoutes = v1.HttpRouteList{route}
+
+			_, reports := translate()
+
+			parents := reports.HttpRoutes[route.Metadata.Ref()]
+			Expect(parents).To(HaveLen(1))
+			Expect(FindCondition(parents[0].Conditions, ConditionAccepted).Reason).To(Equal(ReasonNotAllowedByListeners))
+
+			from := gatewayapi.NamespacesFromAll
+			httpListener.AllowedRoutes = &gatewayapi.AllowedRoutes{Namespaces: &gatewayapi.RouteNamespaces{From: &from}}
+			snap.Gateways = v1.GatewayList{gateway(httpListener)}
+
+			_, reports = translate()
+
+			parents = reports.HttpRoutes[route.Metadata.Ref()]
+			Expect(FindCondition(parents[0].Conditions, ConditionAccepted).Status).To(Equal(gatewayapi.ConditionTrue))
+		})
+
+		It("attaches routes to the listener selected by the section name", func() {
+			other := gatewayapi.Listener{Name: "other", Port: 8081, Protocol: gatewayapi.HTTPProtocolType}
+			snap.Gateways = v1.GatewayList{gateway(httpListener, other)}
+			snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{{Name: gatewayRef.Name, SectionName: str("other")}},
+			})}
+
+			proxies, reports := translate()
+
+			Expect(proxies[0].Listeners).To(HaveLen(2))
+			Expect(proxies[0].Listeners[0].GetHttpListener().GetVirtualHosts()).To(BeEmpty())
+			Expect(proxies[0].Listeners[1].GetHttpListener().GetVirtualHosts()).To(HaveLen(1))
+			Expect(reports.Gateways[gatewayRef].Listeners[1].AttachedRoutes).To(Equal(int32(1)))
+
+			snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{{Name: gatewayRef.Name, SectionName: str("missing")}},
+			})}
+
+			_, reports = translate()
+
+			Expect(routeCondition(reports, ConditionAccepted).Reason).To(Equal(ReasonNoMatchingParent))
+		})
+	})
+
+	Context("listeners", func() {
+
+		It("terminates tls with the referenced certificate", func() {
+			snap.Gateways = v1.GatewayList{gateway(gatewayapi.Listener{
+				Name:     "https",
+				Hostname: str("example.com"),
+				Port:     8443,
+				Protocol: gatewayapi.HTTPSProtocolType,
+				TLS: &gatewayapi.GatewayTLSConfig{
+					CertificateRefs: []gatewayapi.SecretObjectReference{{Name: "cert"}},
+				},
+			})}
+			snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{})}
+
+			proxies, _ := translate()
+
+			Expect(proxies[0].Listeners[0].SslConfigurations).To(Equal([]*gloov1.SslConfig{{
+				SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Namespace: "default", Name: "cert"}},
+				SniDomains: []string{"example.com"},
+			}}))
+			Expect(proxies[0].Listeners[0].GetHttpListener().GetVirtualHosts()[0].Domains).To(ConsistOf("example.com", "example.com:8443"))
+		})
+
+		It("rejects https listeners without certificates", func() {
+			snap.Gateways = v1.GatewayList{gateway(gatewayapi.Listener{Name: "https", Port: 8443, Protocol: gatewayapi.HTTPSProtocolType})}
+
+			proxies, reports := translate()
+
+			Expect(proxies[0].Listeners).To(BeEmpty())
+			listener := reports.Gateways[gatewayRef].Listeners[0]
+			Expect(FindCondition(listener.Conditions, ConditionResolvedRefs).Reason).To(Equal(ReasonInvalidCertificateRef))
+			Expect(FindCondition(listener.Conditions, ConditionProgrammed).Status).To(Equal(gatewayapi.ConditionFalse))
+			Expect(FindCondition(reports.Gateways[gatewayRef].Conditions, ConditionAccepted).Reason).To(Equal(ReasonListenersNotValid))
+		})
+
+		It("rejects listeners which conflict with other listeners", func() {
+			snap.Gateways = v1.GatewayList{gateway(
+				httpListener,
+				gatewayapi.Listener{Name: "tls", Port: 8080, Protocol: gatewayapi.TLSProtocolType},
+				gatewayapi.Listener{Name: "udp", Port: 8081, Protocol: gatewayapi.UDPProtocolType},
+			)}
+
+			proxies, reports := translate()
+
+			Expect(proxies[0].Listeners).To(HaveLen(1))
+			listeners := reports.Gateways[gatewayRef].Listeners
+			Expect(FindCondition(listeners[0].Conditions, ConditionConflicted).Reason).To(Equal(ReasonNoConflicts))
+			Expect(FindCondition(listeners[1].Conditions, ConditionConflicted).Reason).To(Equal(ReasonProtocolConflict))
+			Expect(FindCondition(listeners[2].Conditions, ConditionAccepted).Reason).To(Equal(ReasonUnsupportedProtocol))
+		})
+
+		It("routes tls routes by sni", func() {
+			snap.Gateways = v1.GatewayList{gateway(gatewayapi.Listener{
+				Name:     "tls",
+				Port:     8443,
+				Protocol: gatewayapi.TLSProtocolType,
+				TLS: &gatewayapi.GatewayTLSConfig{
+					CertificateRefs: []gatewayapi.SecretObjectReference{{Name: "cert"}},
+				},
+			})}
+			snap.TlsRoutes = v1.TlsRouteList{{
+				Metadata: core.Metadata{Namespace: "default", Name: "tls"},
+				KubeSpec: encode(gatewayapi.TLSRouteSpec{
+					ParentRefs: []gatewayapi.ParentReference{{Name: gatewayRef.Name}},
+					Hostnames:  []string{"example.com"},
+					Rules: []gatewayapi.TLSRouteRule{{
+						BackendRefs: []gatewayapi.BackendRef{backend("", "svc", 8080)},
+					}},
+				}),
+			}}
+
+			proxies, reports := translate()
+
+			Expect(proxies[0].Listeners[0].GetTcpListener().GetTcpHosts()).To(Equal([]*gloov1.TcpHost{{
+				Name: "default-tls",
+				SslConfig: &gloov1.SslConfig{
+					SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Namespace: "default", Name: "cert"}},
+					SniDomains: []string{"example.com"},
+				},
+				Destination: &gloov1.TcpHost_TcpAction{
+					Destination: &gloov1.TcpHost_TcpAction_Single{Single: upstreamDestination(defaultUs)},
+				},
+			}}))
+			Expect(reports.TlsRoutes[core.ResourceRef{Namespace: "default", Name: "tls"}]).To(HaveLen(1))
+		})
+	})
+
+	Context("http route rules", func() {
+
+		It("translates matches", func() {
+			exact, regex := gatewayapi.PathMatchExact, gatewayapi.MatchRegularExpression
+			snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{{
+					Matches: []gatewayapi.HTTPRouteMatch{{
+						Path:        &gatewayapi.HTTPPathMatch{Type: &exact, Value: str("/exact")},
+						Headers:     []gatewayapi.HTTPHeaderMatch{{Name: "x-version", Value: "v[12]", Type: &regex}},
+						QueryParams: []gatewayapi.HTTPQueryParamMatch{{Name: "debug", Value: "true"}},
+						Method:      str("GET"),
+					}},
+				}},
+			})}
+
+			proxies, _ := translate()
+
+			Expect(vhostRoutes(proxies)[0].Matchers).To(Equal([]*matchers.Matcher{{
+				PathSpecifier:   &matchers.Matcher_Exact{Exact: "/exact"},
+				Headers:         []*matchers.HeaderMatcher{{Name: "x-version", Value: "v[12]", Regex: true}},
+				QueryParameters: []*matchers.QueryParameterMatcher{{Name: "debug", Value: "true"}},
+				Methods:         []string{"GET"},
+			}}))
+		})
+
+		It("translates filters", func() {
+			snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{{
+					Matches: []gatewayapi.HTTPRouteMatch{{Path: &gatewayapi.HTTPPathMatch{Value: str("/old")}}},
+					Filters: []gatewayapi.HTTPRouteFilter{{
+						Type: gatewayapi.HTTPRouteFilterRequestHeaderModifier,
+						RequestHeaderModifier: &gatewayapi.HTTPHeaderFilter{
+							Set:    []gatewayapi.HTTPHeader{{Name: "x-set", Value: "a"}},
+							Add:    []gatewayapi.HTTPHeader{{Name: "x-add", Value: "b"}},
+							Remove: []string{"x-remove"},
+						},
+					}, {
+						Type: gatewayapi.HTTPRouteFilterURLRewrite,
+						URLRewrite: &gatewayapi.HTTPURLRewriteFilter{
+							Hostname: str("internal.example.com"),
+							Path:     &gatewayapi.HTTPPathModifier{Type: gatewayapi.PrefixMatchHTTPPathModifier, ReplacePrefixMatch: str("/new")},
+						},
+					}},
+					BackendRefs: []gatewayapi.BackendRef{backend("", "svc", 8080)},
+				}, {
+					Matches: []gatewayapi.HTTPRouteMatch{{Path: &gatewayapi.HTTPPathMatch{Value: str("/redirect")}}},
+					Filters: []gatewayapi.HTTPRouteFilter{{
+						Type: gatewayapi.HTTPRouteFilterRequestRedirect,
+						RequestRedirect: &gatewayapi.HTTPRequestRedirectFilter{
+							Scheme:   str("https"),
+							Hostname: str("example.com"),
+						},
+					}},
+				}},
+			})}
+
+			proxies, _ := translate()
+
+			routes := vhostRoutes(proxies)
+			Expect(routes).To(HaveLen(2))
+			redirect, rewrite := routes[0], routes[1]
+
+			options := rewrite.GetOptions()
+			Expect(options.GetHostRewrite()).To(Equal("internal.example.com"))
+			Expect(options.GetPrefixRewrite().GetValue()).To(Equal("/new"))
+			Expect(options.GetHeaderManipulation().GetRequestHeadersToRemove()).To(Equal([]string{"x-remove"}))
+			added := options.GetHeaderManipulation().GetRequestHeadersToAdd()
+			Expect(added).To(HaveLen(2))
+			Expect(added[0].GetHeader().GetKey()).To(Equal("x-set"))
+			Expect(added[0].GetAppend().GetValue()).To(BeFalse())
+			Expect(added[1].GetHeader().GetKey()).To(Equal("x-add"))
+			Expect(added[1].GetAppend().GetValue()).To(BeTrue())
+
+			Expect(redirect.GetRedirectAction()).To(Equal(&gloov1.RedirectAction{
+				HostRedirect:  "example.com",
+				HttpsRedirect: true,
+				ResponseCode:  gloov1.RedirectAction_FOUND,
+			}))
+		})
+
+		It("does not attach routes with unsupported features", func() {
+			snap.HttpRoutes = v1.HttpRouteList{httpRoute(gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{{
+					Filters: []gatewayapi.HTTPRouteFilter{{
+						Type: gatewayapi.HTTPRouteFilterURLRewrite,
+						URLRewrite: &gatewayapi.HTTPURLRewriteFilter{
+							Path: &gatewayapi.HTTPPathModifier{Type: gatewayapi.FullPathHTTPPathModifier, ReplaceFullPath: str("/new")},
+						},
+					}},
+				}},
+			})}
+
+			proxies, reports := translate()
+
+			Expect(proxies[0].Listeners[0].GetHttpListener().GetVirtualHosts()).To(BeEmpty())
+			accepted := routeCondition(reports, ConditionAccepted)
+			Expect(accepted.Reason).To(Equal(ReasonUnsupportedValue))
+			Expect(accepted.Message).To(Equal(UnsupportedFullPathRewriteErr.Error()))
+		})
+	})
+})
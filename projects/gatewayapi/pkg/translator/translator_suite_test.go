@@ -0,0 +1,13 @@
+package translator_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTranslator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Translator Suite")
+}
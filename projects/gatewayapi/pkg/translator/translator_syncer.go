@@ -0,0 +1,60 @@
+package translator
+
+import (
+	"context"
+
+	"github.com/solo-io/gloo/pkg/utils/syncutil"
+	"github.com/solo-io/go-utils/hashutils"
+	"go.uber.org/zap/zapcore"
+
+	v1 "github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/utils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+type translatorSyncer struct {
+	controllerName  string
+	writeNamespace  string
+	proxyReconciler gloov1.ProxyReconciler
+}
+
+func NewSyncer(controllerName, writeNamespace string, proxyClient gloov1.ProxyClient) v1.TranslatorSyncer {
+	return &translatorSyncer{
+		controllerName:  controllerName,
+		writeNamespace:  writeNamespace,
+		proxyReconciler: gloov1.NewProxyReconciler(proxyClient),
+	}
+}
+
+func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot) error {
+	ctx = contextutils.WithLogger(ctx, "translatorSyncer")
+
+	snapHash := hashutils.MustHash(snap)
+	logger := contextutils.LoggerFrom(ctx)
+	logger.Infof("begin sync %v (%v gateways, %v http routes, %v tls routes)", snapHash,
+		len(snap.Gateways), len(snap.HttpRoutes), len(snap.TlsRoutes))
+	defer logger.Infof("end sync %v", snapHash)
+
+	// stringifying the snapshot may be an expensive operation, so we'd like to avoid building the large
+	// string if we're not even going to log it anyway
+	if contextutils.GetLogLevel() == zapcore.DebugLevel {
+		logger.Debug(syncutil.StringifySnapshot(snap))
+	}
+
+	proxies, _ := Translate(ctx, s.controllerName, s.writeNamespace, snap)
+
+	labels := map[string]string{
+		"created_by": "gatewayapi",
+	}
+	for _, proxy := range proxies {
+		logger.Infof("creating proxy %v", proxy.Metadata.Ref())
+		proxy.Metadata.Labels = labels
+	}
+
+	return s.proxyReconciler.Reconcile(s.writeNamespace, proxies, utils.TransitionFunction, clients.ListOpts{
+		Ctx:      ctx,
+		Selector: labels,
+	})
+}
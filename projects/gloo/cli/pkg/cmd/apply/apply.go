@@ -0,0 +1,268 @@
+package apply
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/cliutil/install"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/render"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	DryRunErr = func(err error, out []byte) error {
+		return eris.Wrapf(err, "validating the changes with a server-side dry run: %v", strings.TrimSpace(string(out)))
+	}
+	ApplyErr = func(err error, change *Change) error {
+		return eris.Wrapf(err, "applying %v %v, the resources already applied were rolled back", change.Kind, change.Ref.Key())
+	}
+	RollbackErr = func(err, rollbackErr error, change *Change) error {
+		return eris.Wrapf(err, "applying %v %v, and rolling back the resources already applied failed: %v",
+			change.Kind, change.Ref.Key(), rollbackErr)
+	}
+)
+
+type resourceKind struct {
+	name   string
+	crd    crd.Crd
+	client func() (clients.ResourceClient, error)
+	// The resources of the kind read from the files
+	inputs func(*render.Inputs) resources.InputResourceList
+}
+
+// The kinds of resources applied, in the order they are applied: the referenced resources before the resources
+// referencing them, so that the validation webhook accepts each write.
+var resourceKinds = []*resourceKind{
+	{
+		name: "Settings",
+		crd:  gloov1.SettingsCrd,
+		client: func() (clients.ResourceClient, error) {
+			client, err := helpers.SettingsClient([]string{metav1.NamespaceAll})
+			if err != nil {
+				return nil, err
+			}
+			return client.BaseClient(), nil
+		},
+		inputs: func(inputs *render.Inputs) resources.InputResourceList {
+			if inputs.Settings == nil {
+				return nil
+			}
+			return resources.InputResourceList{inputs.Settings}
+		},
+	},
+	{
+		name: "Upstream",
+		crd:  gloov1.UpstreamCrd,
+		client: func() (clients.ResourceClient, error) {
+			client, err := helpers.UpstreamClient([]string{metav1.NamespaceAll})
+			if err != nil {
+				return nil, err
+			}
+			return client.BaseClient(), nil
+		},
+		inputs: func(inputs *render.Inputs) resources.InputResourceList {
+			return inputs.Upstreams.AsInputResources()
+		},
+	},
+	{
+		name: "UpstreamGroup",
+		crd:  gloov1.UpstreamGroupCrd,
+		client: func() (clients.ResourceClient, error) {
+			client, err := helpers.UpstreamGroupClient([]string{metav1.NamespaceAll})
+			if err != nil {
+				return nil, err
+			}
+			return client.BaseClient(), nil
+		},
+		inputs: func(inputs *render.Inputs) resources.InputResourceList {
+			return inputs.UpstreamGroups.AsInputResources()
+		},
+	},
+	{
+		name: "ReferencePolicy",
+		crd:  gatewayv1.ReferencePolicyCrd,
+		client: func() (clients.ResourceClient, error) {
+			client, err := helpers.ReferencePolicyClient([]string{metav1.NamespaceAll})
+			if err != nil {
+				return nil, err
+			}
+			return client.BaseClient(), nil
+		},
+		inputs: func(inputs *render.Inputs) resources.InputResourceList {
+			return inputs.ReferencePolicies.AsInputResources()
+		},
+	},
+	{
+		name: "RouteOption",
+		crd:  gatewayv1.RouteOptionCrd,
+		client: func() (clients.ResourceClient, error) {
+			client, err := helpers.RouteOptionClient([]string{metav1.NamespaceAll})
+			if err != nil {
+				return nil, err
+			}
+			return client.BaseClient(), nil
+		},
+		inputs: func(inputs *render.Inputs) resources.InputResourceList {
+			return inputs.RouteOptions.AsInputResources()
+		},
+	},
+	{
+		name: "VirtualHostOption",
+		crd:  gatewayv1.VirtualHostOptionCrd,
+		client: func() (clients.ResourceClient, error) {
+			client, err := helpers.VirtualHostOptionClient([]string{metav1.NamespaceAll})
+			if err != nil {
+				return nil, err
+			}
+			return client.BaseClient(), nil
+		},
+		inputs: func(inputs *render.Inputs) resources.InputResourceList {
+			return inputs.VirtualHostOptions.AsInputResources()
+		},
+	},
+	{
+		name: "RouteTable",
+		crd:  gatewayv1.RouteTableCrd,
+		client: func() (clients.ResourceClient, error) {
+			client, err := helpers.RouteTableClient([]string{metav1.NamespaceAll})
+			if err != nil {
+				return nil, err
+			}
+			return client.BaseClient(), nil
+		},
+		inputs: func(inputs *render.Inputs) resources.InputResourceList {
+			return inputs.RouteTables.AsInputResources()
+		},
+	},
+	{
+		name: "VirtualService",
+		crd:  gatewayv1.VirtualServiceCrd,
+		client: func() (clients.ResourceClient, error) {
+			client, err := helpers.VirtualServiceClient([]string{metav1.NamespaceAll})
+			if err != nil {
+				return nil, err
+			}
+			return client.BaseClient(), nil
+		},
+		inputs: func(inputs *render.Inputs) resources.InputResourceList {
+			return inputs.VirtualServices.AsInputResources()
+		},
+	},
+	{
+		name: "MatchableHttpGateway",
+		crd:  gatewayv1.MatchableHttpGatewayCrd,
+		client: func() (clients.ResourceClient, error) {
+			client, err := helpers.MatchableHttpGatewayClient([]string{metav1.NamespaceAll})
+			if err != nil {
+				return nil, err
+			}
+			return client.BaseClient(), nil
+		},
+		inputs: func(inputs *render.Inputs) resources.InputResourceList {
+			return inputs.HttpGateways.AsInputResources()
+		},
+	},
+	{
+		name: "Gateway",
+		crd:  gatewayv1.GatewayCrd,
+		client: func() (clients.ResourceClient, error) {
+			client, err := helpers.GatewayClient([]string{metav1.NamespaceAll})
+			if err != nil {
+				return nil, err
+			}
+			return client.BaseClient(), nil
+		},
+		inputs: func(inputs *render.Inputs) resources.InputResourceList {
+			return inputs.Gateways.AsInputResources()
+		},
+	},
+}
+
+// Validates the manifests with the API server and its admission webhooks, without persisting them.
+type Validator func(manifests []byte) error
+
+// Validates the manifests with kubectl apply --dry-run=server, which runs the Gloo resources through the gateway
+// validation webhook. Each resource is validated against the resources in the cluster, not the other manifests.
+func ServerDryRun(kubeCli install.KubeCli) Validator {
+	return func(manifests []byte) error {
+		out, err := kubeCli.KubectlOut(bytes.NewReader(manifests), "apply", "--dry-run=server", "-f", "-")
+		if err != nil {
+			return DryRunErr(err, out)
+		}
+		return nil
+	}
+}
+
+type appliedChange struct {
+	change  *Change
+	client  clients.ResourceClient
+	written resources.Resource
+}
+
+// Writes the created and updated resources of the plan in order. If a write fails, the resources already written
+// are rolled back: the created ones are deleted and the updated ones are restored.
+func Apply(ctx context.Context, plan *Plan) error {
+	var applied []*appliedChange
+	for _, change := range plan.Changes {
+		if change.Action == Unchanged {
+			continue
+		}
+		client, err := change.kind.client()
+		if err == nil {
+			var written resources.Resource
+			if written, err = write(ctx, client, change); err == nil {
+				applied = append(applied, &appliedChange{change: change, client: client, written: written})
+				continue
+			}
+		}
+		if rollbackErr := rollback(ctx, applied); rollbackErr != nil {
+			return RollbackErr(err, rollbackErr, change)
+		}
+		return ApplyErr(err, change)
+	}
+	return nil
+}
+
+func write(ctx context.Context, client clients.ResourceClient, change *Change) (resources.Resource, error) {
+	desired := resources.Clone(change.Desired).(resources.InputResource)
+	if change.Action == Create {
+		return client.Write(desired, clients.WriteOpts{Ctx: ctx})
+	}
+	// update the version read when planning, and keep the status until the resource is reported again
+	meta := desired.GetMetadata()
+	meta.ResourceVersion = change.Current.GetMetadata().ResourceVersion
+	desired.SetMetadata(meta)
+	desired.SetStatus(change.Current.GetStatus())
+	return client.Write(desired, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+}
+
+func rollback(ctx context.Context, applied []*appliedChange) error {
+	var errs *multierror.Error
+	for i := len(applied) - 1; i >= 0; i-- {
+		a := applied[i]
+		meta := a.written.GetMetadata()
+		if a.change.Action == Create {
+			if err := a.client.Delete(meta.Namespace, meta.Name, clients.DeleteOpts{Ctx: ctx}); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+			continue
+		}
+		previous := resources.Clone(a.change.Current)
+		previousMeta := previous.GetMetadata()
+		previousMeta.ResourceVersion = meta.ResourceVersion
+		previous.SetMetadata(previousMeta)
+		if _, err := a.client.Write(previous, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true}); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
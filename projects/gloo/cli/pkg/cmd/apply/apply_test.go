@@ -0,0 +1,223 @@
+package apply_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/apply"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/render"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const appliedYaml = `
+apiVersion: gloo.solo.io/v1
+kind: Upstream
+metadata:
+  name: web
+spec:
+  static:
+    hosts:
+    - addr: web.example.com
+      port: 80
+---
+apiVersion: gloo.solo.io/v1
+kind: Upstream
+metadata:
+  name: api
+spec:
+  static:
+    hosts:
+    - addr: api.example.com
+      port: 8080
+---
+apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: petstore
+spec:
+  virtualHost:
+    domains:
+    - petstore.example.com
+    routes:
+    - matchers:
+      - prefix: /api
+      delegateAction:
+        ref:
+          name: api
+          namespace: gloo-system
+    - matchers:
+      - prefix: /
+      routeAction:
+        single:
+          upstream:
+            name: web
+            namespace: gloo-system
+---
+apiVersion: gateway.solo.io/v1
+kind: RouteTable
+metadata:
+  name: api
+spec:
+  routes:
+  - matchers:
+    - prefix: /api
+    routeAction:
+      single:
+        upstream:
+          name: api
+          namespace: gloo-system
+`
+
+var _ = Describe("Apply", func() {
+
+	var (
+		ctx       context.Context
+		inputs    *render.Inputs
+		upstreams gloov1.UpstreamClient
+		vsClient  gatewayv1.VirtualServiceClient
+		rtClient  gatewayv1.RouteTableClient
+	)
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		ctx = context.Background()
+		upstreams = helpers.MustUpstreamClient()
+		vsClient = helpers.MustVirtualServiceClient()
+		rtClient = helpers.MustRouteTableClient()
+
+		_, err := upstreams.Write(&gloov1.Upstream{
+			Metadata: core.Metadata{Name: "web", Namespace: "gloo-system"},
+			UpstreamType: &gloov1.Upstream_Static{Static: &static.UpstreamSpec{
+				Hosts: []*static.Host{{Addr: "web.example.com", Port: 80}},
+			}},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = vsClient.Write(&gatewayv1.VirtualService{
+			Metadata: core.Metadata{Name: "petstore", Namespace: "gloo-system"},
+			VirtualHost: &gatewayv1.VirtualHost{
+				Domains: []string{"petstore.example.com"},
+				Routes: []*gatewayv1.Route{{
+					Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}}},
+					Action: &gatewayv1.Route_RouteAction{RouteAction: &gloov1.RouteAction{
+						Destination: &gloov1.RouteAction_Single{Single: &gloov1.Destination{
+							DestinationType: &gloov1.Destination_Upstream{
+								Upstream: &core.ResourceRef{Name: "web", Namespace: "gloo-system"},
+							},
+						}},
+					}},
+				}},
+			},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		inputs, err = render.ReadInputs([]string{"-"}, strings.NewReader(appliedYaml), "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		helpers.UseDefaultClients()
+	})
+
+	It("plans the changes in dependency order with their diff", func() {
+		plan, err := apply.NewPlan(ctx, inputs, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Rejected).To(BeEmpty())
+
+		var changes []string
+		for _, change := range plan.Changes {
+			changes = append(changes, string(change.Action)+" "+change.Kind+" "+change.Ref.Key())
+		}
+		Expect(changes).To(Equal([]string{
+			"unchanged Upstream gloo-system.web",
+			"create Upstream gloo-system.api",
+			"create RouteTable gloo-system.api",
+			"update VirtualService gloo-system.petstore",
+		}))
+		Expect(plan.Changes[0].Diff).To(BeEmpty())
+		Expect(plan.Changes[3].Diff).To(ContainSubstring("+     - delegateAction:\n"))
+		Expect(plan.HasChanges()).To(BeTrue())
+		Expect(strings.Count(string(plan.Manifests()), "---\n")).To(Equal(2))
+
+		Expect(plan.Proxies).To(HaveLen(1))
+		Expect(plan.Proxies[0].Ref.Key()).To(Equal("gloo-system.gateway-proxy"))
+		Expect(plan.Proxies[0].Action).To(Equal(apply.Update))
+		Expect(plan.Proxies[0].Diff).To(ContainSubstring("+                 name: api\n"))
+
+		out := &bytes.Buffer{}
+		Expect(apply.PrintPlan(out, plan)).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("  create Upstream gloo-system.api\n    + apiVersion: gloo.solo.io/v1\n"))
+		Expect(out.String()).To(ContainSubstring("  update Proxy gloo-system.gateway-proxy\n"))
+		Expect(out.String()).To(HaveSuffix("Plan: 2 to create, 1 to update, 1 unchanged.\n"))
+	})
+
+	It("reports the resources rejected by the planned translation", func() {
+		// the routes of a delegated route table must start with the prefix of the delegating route
+		inputs.RouteTables[0].Routes[0].Matchers[0].PathSpecifier = &matchers.Matcher_Prefix{Prefix: "/store"}
+		plan, err := apply.NewPlan(ctx, inputs, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Rejected).To(HaveLen(2))
+		Expect(plan.Rejected[0]).To(HavePrefix("RouteTable gloo-system.api: "))
+		Expect(plan.Rejected[1]).To(HavePrefix("VirtualService gloo-system.petstore: on sub route table gloo-system.api: "))
+	})
+
+	It("rejects resources defined more than once", func() {
+		inputs.Upstreams = append(inputs.Upstreams, inputs.Upstreams[0])
+		_, err := apply.NewPlan(ctx, inputs, "gloo-system")
+		Expect(err).To(MatchError(apply.DuplicateResourceErr("Upstream", core.ResourceRef{Name: "web", Namespace: "gloo-system"})))
+	})
+
+	It("applies the changes", func() {
+		plan, err := apply.NewPlan(ctx, inputs, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apply.Apply(ctx, plan)).NotTo(HaveOccurred())
+
+		_, err = upstreams.Read("gloo-system", "api", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = rtClient.Read("gloo-system", "api", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		vs, err := vsClient.Read("gloo-system", "petstore", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vs.GetVirtualHost().GetRoutes()).To(HaveLen(2))
+
+		plan, err = apply.NewPlan(ctx, inputs, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.HasChanges()).To(BeFalse())
+	})
+
+	It("rolls back the resources already applied when a write fails", func() {
+		inputs.Upstreams[0].GetStatic().Hosts[0].Port = 8081
+		plan, err := apply.NewPlan(ctx, inputs, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+
+		// the virtual service changes after planning, so its update conflicts
+		vs, err := vsClient.Read("gloo-system", "petstore", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		vs.Metadata.Labels = map[string]string{"team": "pets"}
+		_, err = vsClient.Write(vs, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = apply.Apply(ctx, plan)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("applying VirtualService gloo-system.petstore, the resources already applied were rolled back"))
+
+		web, err := upstreams.Read("gloo-system", "web", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(web.GetStatic().GetHosts()[0].GetPort()).To(BeEquivalentTo(80))
+		_, err = upstreams.Read("gloo-system", "api", clients.ReadOpts{})
+		Expect(err).To(HaveOccurred())
+		_, err = rtClient.Read("gloo-system", "api", clients.ReadOpts{})
+		Expect(err).To(HaveOccurred())
+		vs, err = vsClient.Read("gloo-system", "petstore", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vs.GetVirtualHost().GetRoutes()).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,379 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/render"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+type Action string
+
+const (
+	Create    Action = "create"
+	Update    Action = "update"
+	Unchanged Action = "unchanged"
+	// Only for Proxies, which are not applied but translated from the other resources
+	Delete Action = "delete"
+)
+
+// lines of context around the changed lines of a diff
+const diffContext = 3
+
+var (
+	DuplicateResourceErr = func(kind string, ref core.ResourceRef) error {
+		return eris.Errorf("%v %v is defined more than once", kind, ref.Key())
+	}
+	ListResourcesErr = func(err error, kind string) error {
+		return eris.Wrapf(err, "listing %v resources in the cluster", kind)
+	}
+)
+
+// The changes to make to the cluster for the resources read from files, and the resulting changes to the Proxies.
+type Plan struct {
+	// In the order they are applied
+	Changes []*Change
+	Proxies []*ProxyChange
+	// The resources rejected by the translation of the planned resources, as "<kind> <namespace>.<name>: <error>"
+	Rejected []string
+}
+
+type Change struct {
+	Kind   string
+	Ref    core.ResourceRef
+	Action Action
+	// The resource read from the files
+	Desired resources.InputResource
+	// The resource in the cluster, nil when it is created
+	Current resources.InputResource
+	// The manifest of the desired resource
+	Manifest string
+	// The line diff of the current and desired manifests
+	Diff string
+
+	kind *resourceKind
+}
+
+type ProxyChange struct {
+	Ref    core.ResourceRef
+	Action Action
+	Diff   string
+}
+
+// Works out the changes to make to the cluster for the inputs, by comparing them with the resources in the cluster,
+// and the resulting changes to the Proxies by translating the current and planned resources of the cluster. Settings
+// are read from the given namespace, which is the namespace of the Proxies.
+func NewPlan(ctx context.Context, inputs *render.Inputs, namespace string) (*Plan, error) {
+	plan := &Plan{}
+	// secrets are not read from the cluster, so both translations use the secrets of the inputs, and stub the others
+	current, planned := &render.Inputs{Secrets: inputs.Secrets}, &render.Inputs{Secrets: inputs.Secrets}
+	for _, kind := range resourceKinds {
+		listNamespace := ""
+		if kind.name == "Settings" {
+			listNamespace = namespace
+		}
+		client, err := kind.client()
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.List(listNamespace, clients.ListOpts{Ctx: ctx})
+		if err != nil {
+			return nil, ListResourcesErr(err, kind.name)
+		}
+
+		currentByRef := map[core.ResourceRef]resources.InputResource{}
+		for _, res := range list {
+			currentByRef[res.GetMetadata().Ref()] = res.(resources.InputResource)
+			if err := current.AddResource(res); err != nil {
+				return nil, err
+			}
+		}
+
+		desiredRefs := map[core.ResourceRef]bool{}
+		for _, desired := range kind.inputs(inputs) {
+			ref := desired.GetMetadata().Ref()
+			if desiredRefs[ref] {
+				return nil, DuplicateResourceErr(kind.name, ref)
+			}
+			desiredRefs[ref] = true
+			change, err := newChange(kind, desired, currentByRef[ref])
+			if err != nil {
+				return nil, err
+			}
+			plan.Changes = append(plan.Changes, change)
+			if err := planned.AddResource(desired); err != nil {
+				return nil, err
+			}
+		}
+		for _, res := range list {
+			if !desiredRefs[res.GetMetadata().Ref()] {
+				if err := planned.AddResource(res); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	currentResult, err := render.Render(ctx, current, namespace)
+	if err != nil {
+		return nil, err
+	}
+	plannedResult, err := render.Render(ctx, planned, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if plan.Proxies, err = proxyChanges(currentResult.Proxies, plannedResult.Proxies); err != nil {
+		return nil, err
+	}
+	// the resources already rejected in the cluster do not prevent applying the plan
+	alreadyRejected := map[string]bool{}
+	for _, r := range rejected(currentResult.Reports) {
+		alreadyRejected[r] = true
+	}
+	for _, r := range rejected(plannedResult.Reports) {
+		if !alreadyRejected[r] {
+			plan.Rejected = append(plan.Rejected, r)
+		}
+	}
+	return plan, nil
+}
+
+func newChange(kind *resourceKind, desired, current resources.InputResource) (*Change, error) {
+	change := &Change{
+		Kind:    kind.name,
+		Ref:     desired.GetMetadata().Ref(),
+		Action:  Create,
+		Desired: desired,
+		Current: current,
+		kind:    kind,
+	}
+	var err error
+	if change.Manifest, err = manifest(kind.crd, desired); err != nil {
+		return nil, err
+	}
+	currentManifest := ""
+	if current != nil {
+		if currentManifest, err = manifest(kind.crd, current); err != nil {
+			return nil, err
+		}
+		change.Action = Update
+		if currentManifest == change.Manifest {
+			change.Action = Unchanged
+		}
+	}
+	if change.Action != Unchanged {
+		change.Diff = lineDiff(currentManifest, change.Manifest)
+	}
+	return change, nil
+}
+
+func proxyChanges(current, planned []*render.RenderedProxy) ([]*ProxyChange, error) {
+	manifests := map[core.ResourceRef][2]string{}
+	for i, list := range [][]*render.RenderedProxy{current, planned} {
+		for _, proxy := range list {
+			m, err := manifest(gloov1.ProxyCrd, proxy.Proxy)
+			if err != nil {
+				return nil, err
+			}
+			ref := proxy.Proxy.GetMetadata().Ref()
+			pair := manifests[ref]
+			pair[i] = m
+			manifests[ref] = pair
+		}
+	}
+
+	var out []*ProxyChange
+	for ref, pair := range manifests {
+		change := &ProxyChange{Ref: ref, Action: Update}
+		switch {
+		case pair[0] == pair[1]:
+			change.Action = Unchanged
+		case pair[0] == "":
+			change.Action = Create
+		case pair[1] == "":
+			change.Action = Delete
+		}
+		if change.Action != Unchanged {
+			change.Diff = lineDiff(pair[0], pair[1])
+		}
+		out = append(out, change)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Ref.Key() < out[j].Ref.Key()
+	})
+	return out, nil
+}
+
+func rejected(reports reporter.ResourceReports) []string {
+	var out []string
+	for res, report := range reports {
+		if report.Errors != nil {
+			kind := reflect.TypeOf(res).Elem().Name()
+			out = append(out, fmt.Sprintf("%v %v: %v", kind, res.GetMetadata().Ref().Key(), errorString(report.Errors)))
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func errorString(err error) string {
+	multiErr, ok := err.(*multierror.Error)
+	if !ok {
+		return err.Error()
+	}
+	var out []string
+	for _, err := range multiErr.Errors {
+		out = append(out, errorString(err))
+	}
+	return strings.Join(out, "; ")
+}
+
+// The kube manifest of the resource, without the status and the metadata set by the cluster.
+func manifest(resourceCrd crd.Crd, resource resources.InputResource) (string, error) {
+	kubeResource, err := resourceCrd.KubeResource(resource)
+	if err != nil {
+		return "", err
+	}
+	meta := resource.GetMetadata()
+	metadata := map[string]interface{}{
+		"name":      meta.Name,
+		"namespace": meta.Namespace,
+	}
+	if len(meta.Labels) > 0 {
+		metadata["labels"] = meta.Labels
+	}
+	if len(meta.Annotations) > 0 {
+		metadata["annotations"] = meta.Annotations
+	}
+	out, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": kubeResource.APIVersion,
+		"kind":       kubeResource.Kind,
+		"metadata":   metadata,
+		"spec":       kubeResource.Spec,
+	})
+	return string(out), err
+}
+
+// The lines removed from and added to the first text to get the second one, prefixed with "-" and "+", with a few
+// unchanged lines around them.
+func lineDiff(from, to string) string {
+	dmp := diffmatchpatch.New()
+	fromChars, toChars, lines := dmp.DiffLinesToChars(from, to)
+	diffs := dmp.DiffCharsToLines(dmp.DiffMain(fromChars, toChars, false), lines)
+
+	type diffLine struct {
+		op   diffmatchpatch.Operation
+		text string
+	}
+	var diffLines []diffLine
+	for _, diff := range diffs {
+		for _, line := range strings.SplitAfter(diff.Text, "\n") {
+			if line != "" {
+				diffLines = append(diffLines, diffLine{diff.Type, strings.TrimSuffix(line, "\n")})
+			}
+		}
+	}
+
+	// show the unchanged lines close to a changed line
+	shown := make([]bool, len(diffLines))
+	for i, line := range diffLines {
+		if line.op == diffmatchpatch.DiffEqual {
+			continue
+		}
+		for j := i - diffContext; j <= i+diffContext; j++ {
+			if j >= 0 && j < len(diffLines) {
+				shown[j] = true
+			}
+		}
+	}
+	var b strings.Builder
+	for i, line := range diffLines {
+		if !shown[i] {
+			if i == 0 || shown[i-1] {
+				b.WriteString("  ...\n")
+			}
+			continue
+		}
+		switch line.op {
+		case diffmatchpatch.DiffDelete:
+			b.WriteString("- ")
+		case diffmatchpatch.DiffInsert:
+			b.WriteString("+ ")
+		default:
+			b.WriteString("  ")
+		}
+		b.WriteString(line.text + "\n")
+	}
+	return b.String()
+}
+
+// The manifests of the resources to create or update, as a multi-document yaml.
+func (p *Plan) Manifests() []byte {
+	var docs []string
+	for _, change := range p.Changes {
+		if change.Action != Unchanged {
+			docs = append(docs, change.Manifest)
+		}
+	}
+	return []byte(strings.Join(docs, "---\n"))
+}
+
+// Whether applying the plan changes any resource.
+func (p *Plan) HasChanges() bool {
+	for _, change := range p.Changes {
+		if change.Action != Unchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// Prints the changes with their diff, the changes to the Proxies, and the rejected resources.
+func PrintPlan(w io.Writer, plan *Plan) error {
+	var b strings.Builder
+	counts := map[Action]int{}
+	b.WriteString("Resources:\n")
+	for _, change := range plan.Changes {
+		counts[change.Action]++
+		fmt.Fprintf(&b, "  %v %v %v\n", change.Action, change.Kind, change.Ref.Key())
+		b.WriteString(indent(change.Diff, "    "))
+	}
+	if len(plan.Proxies) > 0 {
+		b.WriteString("\nProxies:\n")
+	}
+	for _, proxy := range plan.Proxies {
+		fmt.Fprintf(&b, "  %v Proxy %v\n", proxy.Action, proxy.Ref.Key())
+		b.WriteString(indent(proxy.Diff, "    "))
+	}
+	if len(plan.Rejected) > 0 {
+		b.WriteString("\nRejected resources:\n")
+		for _, rejected := range plan.Rejected {
+			fmt.Fprintf(&b, "  %v\n", rejected)
+		}
+	}
+	fmt.Fprintf(&b, "\nPlan: %d to create, %d to update, %d unchanged.\n", counts[Create], counts[Update], counts[Unchanged])
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func indent(text, prefix string) string {
+	if text == "" {
+		return ""
+	}
+	lines := strings.SplitAfter(strings.TrimSuffix(text, "\n"), "\n")
+	return prefix + strings.Join(lines, prefix) + "\n"
+}
@@ -0,0 +1,74 @@
+package apply
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/solo-io/gloo/pkg/cliutil/install"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/render"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.APPLY_COMMAND.Use,
+		Short: constants.APPLY_COMMAND.Short,
+		Long:  constants.APPLY_COMMAND.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(opts.Apply.Files) == 0 {
+				return fmt.Errorf("at least one file must be provided with --%v", flagutils.FileFlag)
+			}
+			inputs, err := render.ReadInputs(opts.Apply.Files, os.Stdin, opts.Metadata.Namespace)
+			if err != nil {
+				return err
+			}
+			for _, ignored := range inputs.Ignored {
+				fmt.Fprintf(os.Stderr, "ignoring %v: not a Gloo resource\n", ignored)
+			}
+			for _, secret := range inputs.Secrets {
+				fmt.Fprintf(os.Stderr, "ignoring Secret %v: secrets are only used to plan the changes, apply them with kubectl\n", secret.GetMetadata().Ref().Key())
+			}
+			return applyInputs(opts, inputs, ServerDryRun(&install.CmdKubectl{}))
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	pflags.StringSliceVarP(&opts.Apply.Files, flagutils.FileFlag, "f", nil,
+		"files or directories of resources to apply, or - for stdin (can be repeated)")
+	pflags.BoolVar(&opts.Apply.Plan, "plan", false, "only show and validate the changes, without applying them")
+	flagutils.AddNamespaceFlag(pflags, &opts.Metadata.Namespace)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func applyInputs(opts *options.Options, inputs *render.Inputs, validate Validator) error {
+	plan, err := NewPlan(opts.Top.Ctx, inputs, opts.Metadata.Namespace)
+	if err != nil {
+		return err
+	}
+	if err := PrintPlan(os.Stdout, plan); err != nil {
+		return err
+	}
+	if len(plan.Rejected) > 0 {
+		return render.RejectedResourcesError(len(plan.Rejected))
+	}
+	if !plan.HasChanges() {
+		return nil
+	}
+	if err := validate(plan.Manifests()); err != nil {
+		return err
+	}
+	fmt.Println("The changes were validated by the cluster.")
+	if opts.Apply.Plan {
+		return nil
+	}
+	if err := Apply(opts.Top.Ctx, plan); err != nil {
+		return err
+	}
+	fmt.Println("The changes were applied.")
+	return nil
+}
@@ -0,0 +1,328 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	InvalidCheckErr   = eris.New("checks must have an id, a description and a run function")
+	DuplicateCheckErr = func(id string) error {
+		return eris.Errorf("a check with id %v is already registered", id)
+	}
+	UnknownCheckErr = func(id string, known []string) error {
+		return eris.Errorf("unknown check %v, the known checks are: %v", id, strings.Join(known, ", "))
+	}
+)
+
+type Severity string
+
+const (
+	// Failed checks with this severity fail glooctl check
+	SeverityError Severity = "error"
+	// Failed checks with this severity are reported, but do not fail glooctl check
+	SeverityWarning Severity = "warning"
+)
+
+type Status string
+
+const (
+	StatusPassed  Status = "passed"
+	StatusFailed  Status = "failed"
+	StatusSkipped Status = "skipped"
+)
+
+// A unit of glooctl check. Extensions add their own checks to glooctl check with Register.
+type Check struct {
+	// Unique id of the check, used to select it with --only and --exclude
+	Id string
+	// Printed as "Checking <description>..."
+	Description string
+	// Defaults to SeverityError
+	Severity Severity
+	// Hint printed when the check fails
+	Remediation string
+	// Returns the problems found, or Skip(reason) if the check cannot run
+	Run func(ctx context.Context, env *Environment) error
+}
+
+func (c *Check) severity() Severity {
+	if c.Severity == "" {
+		return SeverityError
+	}
+	return c.Severity
+}
+
+type skipError struct {
+	reason string
+}
+
+func (e *skipError) Error() string {
+	return e.reason
+}
+
+// Returned by checks which cannot run, for example because what they check is not installed.
+func Skip(reason string) error {
+	return &skipError{reason: reason}
+}
+
+// The outcome of a check.
+type Result struct {
+	Id          string        `json:"id"`
+	Description string        `json:"description"`
+	Severity    Severity      `json:"severity"`
+	Status      Status        `json:"status"`
+	Errors      []string      `json:"errors,omitempty"`
+	SkipReason  string        `json:"skipReason,omitempty"`
+	Remediation string        `json:"remediation,omitempty"`
+	Duration    time.Duration `json:"-"`
+}
+
+// Whether the result fails glooctl check.
+func (r *Result) Failed() bool {
+	return r.Status == StatusFailed && r.Severity == SeverityError
+}
+
+// An ordered set of checks.
+type Registry struct {
+	checks []*Check
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// The checks of glooctl check. The built-in checks are registered first, in the order they run.
+var DefaultRegistry = NewRegistry()
+
+// Adds checks to the default registry, after the ones already registered.
+func Register(checks ...*Check) error {
+	return DefaultRegistry.Register(checks...)
+}
+
+func (r *Registry) Register(checks ...*Check) error {
+	for _, check := range checks {
+		if check.Id == "" || check.Description == "" || check.Run == nil {
+			return InvalidCheckErr
+		}
+		if r.find(check.Id) != nil {
+			return DuplicateCheckErr(check.Id)
+		}
+		r.checks = append(r.checks, check)
+	}
+	return nil
+}
+
+func (r *Registry) Checks() []*Check {
+	return append([]*Check{}, r.checks...)
+}
+
+// Returns the checks with the ids in only, or all checks if only is empty, without the ones with the ids in exclude.
+func (r *Registry) Select(only, exclude []string) ([]*Check, error) {
+	selected := map[string]bool{}
+	for _, id := range only {
+		if r.find(id) == nil {
+			return nil, UnknownCheckErr(id, r.ids())
+		}
+		selected[id] = true
+	}
+	excluded := map[string]bool{}
+	for _, id := range exclude {
+		if r.find(id) == nil {
+			return nil, UnknownCheckErr(id, r.ids())
+		}
+		excluded[id] = true
+	}
+
+	var checks []*Check
+	for _, check := range r.checks {
+		if (len(only) == 0 || selected[check.Id]) && !excluded[check.Id] {
+			checks = append(checks, check)
+		}
+	}
+	return checks, nil
+}
+
+func (r *Registry) find(id string) *Check {
+	for _, check := range r.checks {
+		if check.Id == id {
+			return check
+		}
+	}
+	return nil
+}
+
+func (r *Registry) ids() []string {
+	var ids []string
+	for _, check := range r.checks {
+		ids = append(ids, check.Id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Runs the checks in order, printing "Checking <description>... <outcome>" lines to progress if it is not nil.
+func RunChecks(ctx context.Context, env *Environment, checks []*Check, progress io.Writer) []*Result {
+	if progress == nil {
+		progress = ioutil.Discard
+	}
+	var results []*Result
+	for _, check := range checks {
+		fmt.Fprintf(progress, "Checking %s... ", check.Description)
+		start := time.Now()
+		err := check.Run(ctx, env)
+		result := &Result{
+			Id:          check.Id,
+			Description: check.Description,
+			Severity:    check.severity(),
+			Status:      StatusPassed,
+			Duration:    time.Since(start),
+		}
+
+		switch typed := err.(type) {
+		case nil:
+			fmt.Fprintf(progress, "OK\n")
+		case *skipError:
+			result.Status = StatusSkipped
+			result.SkipReason = typed.reason
+			fmt.Fprintf(progress, "Skipped: %s\n", typed.reason)
+		default:
+			result.Status = StatusFailed
+			result.Errors = errorStrings(err)
+			result.Remediation = check.Remediation
+			if result.Severity == SeverityWarning {
+				fmt.Fprintf(progress, "%v Warnings!\n", len(result.Errors))
+				for _, message := range result.Errors {
+					fmt.Fprintf(progress, "  %s\n", message)
+				}
+			} else {
+				fmt.Fprintf(progress, "%v Errors!\n", len(result.Errors))
+			}
+			if check.Remediation != "" {
+				fmt.Fprintf(progress, "  Hint: %s\n", check.Remediation)
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// Returns the errors of the results failing glooctl check.
+func ResultsError(results []*Result) *multierror.Error {
+	var multiErr *multierror.Error
+	for _, result := range results {
+		if !result.Failed() {
+			continue
+		}
+		for _, message := range result.Errors {
+			multiErr = multierror.Append(multiErr, eris.New(message))
+		}
+	}
+	return multiErr
+}
+
+func errorStrings(err error) []string {
+	multiErr, ok := err.(*multierror.Error)
+	if !ok {
+		return []string{strings.TrimSpace(err.Error())}
+	}
+	var out []string
+	for _, err := range multiErr.Errors {
+		out = append(out, errorStrings(err)...)
+	}
+	return out
+}
+
+// The installation the checks run against. The resources shared by several checks are only read once.
+type Environment struct {
+	Opts *options.Options
+
+	settings        *v1.Settings
+	settingsErr     error
+	settingsRead    bool
+	deployments     *appsv1.DeploymentList
+	deploymentsErr  error
+	deploymentsRead bool
+	glooMetrics     string
+	glooMetricsErr  error
+	glooMetricsRead bool
+}
+
+func NewEnvironment(opts *options.Options) *Environment {
+	return &Environment{Opts: opts}
+}
+
+// The namespace Gloo is installed in.
+func (e *Environment) Namespace() string {
+	return e.Opts.Metadata.GetNamespace()
+}
+
+func (e *Environment) Settings() (*v1.Settings, error) {
+	if !e.settingsRead {
+		client := helpers.MustNamespacedSettingsClient(e.Namespace())
+		e.settings, e.settingsErr = client.Read(e.Namespace(), defaults.SettingsName, clients.ReadOpts{})
+		e.settingsRead = true
+	}
+	return e.settings, e.settingsErr
+}
+
+// The namespaces watched by Gloo.
+func (e *Environment) Namespaces() ([]string, error) {
+	settings, err := e.Settings()
+	if err != nil {
+		return nil, err
+	}
+	if settings.GetWatchNamespaces() != nil {
+		return settings.GetWatchNamespaces(), nil
+	}
+	return helpers.GetNamespaces()
+}
+
+// The deployments of the Gloo namespace.
+func (e *Environment) Deployments() (*appsv1.DeploymentList, error) {
+	if !e.deploymentsRead {
+		e.deployments, e.deploymentsErr = listDeployments(e.Namespace())
+		e.deploymentsRead = true
+	}
+	return e.deployments, e.deploymentsErr
+}
+
+// The prometheus metrics of the gloo deployment.
+func (e *Environment) GlooMetrics(ctx context.Context) (string, error) {
+	if !e.glooMetricsRead {
+		e.glooMetrics, e.glooMetricsErr = getGlooMetrics(ctx, e.Namespace())
+		e.glooMetricsRead = true
+	}
+	return e.glooMetrics, e.glooMetricsErr
+}
+
+func listDeployments(namespace string) (*appsv1.DeploymentList, error) {
+	client := helpers.MustKubeClient()
+	_, err := client.CoreV1().Namespaces().Get(namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, eris.New("Gloo namespace does not exist")
+	}
+	deployments, err := client.AppsV1().Deployments(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if len(deployments.Items) == 0 {
+		return nil, eris.New("Gloo is not installed")
+	}
+	return deployments, nil
+}
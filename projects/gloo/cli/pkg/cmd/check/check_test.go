@@ -0,0 +1,167 @@
+package check_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/check"
+)
+
+var _ = Describe("Check", func() {
+
+	var (
+		registry *check.Registry
+		ran      []string
+	)
+
+	newCheck := func(id string, severity check.Severity, err error) *check.Check {
+		return &check.Check{
+			Id:          id,
+			Description: id + " things",
+			Severity:    severity,
+			Remediation: "fix the " + id,
+			Run: func(ctx context.Context, env *check.Environment) error {
+				ran = append(ran, id)
+				return err
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ran = nil
+		registry = check.NewRegistry()
+		err := registry.Register(
+			newCheck("a", "", nil),
+			newCheck("b", check.SeverityError, multierror.Append(eris.New("b1"), eris.New("b2\n"))),
+			newCheck("c", check.SeverityWarning, eris.New("c1")),
+			newCheck("d", "", check.Skip("d is not installed")),
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("registry", func() {
+
+		It("rejects invalid and duplicate checks", func() {
+			Expect(registry.Register(&check.Check{Id: "e"})).To(MatchError(check.InvalidCheckErr))
+			Expect(registry.Register(newCheck("a", "", nil))).To(MatchError(check.DuplicateCheckErr("a")))
+		})
+
+		It("selects the checks in registration order", func() {
+			checks, err := registry.Select([]string{"c", "a", "b"}, []string{"b"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks).To(HaveLen(2))
+			Expect(checks[0].Id).To(Equal("a"))
+			Expect(checks[1].Id).To(Equal("c"))
+
+			checks, err = registry.Select(nil, []string{"a"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks).To(HaveLen(3))
+		})
+
+		It("errors on unknown check ids", func() {
+			_, err := registry.Select([]string{"z"}, nil)
+			Expect(err).To(MatchError(check.UnknownCheckErr("z", []string{"a", "b", "c", "d"})))
+			_, err = registry.Select(nil, []string{"z"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("registers the built-in checks in the default registry", func() {
+			var ids []string
+			for _, c := range check.DefaultRegistry.Checks() {
+				ids = append(ids, c.Id)
+			}
+			Expect(ids).To(ContainElements(check.DeploymentsCheck, check.PodsCheck, check.UpstreamsCheck,
+				check.AuthConfigsCheck, check.VirtualServicesCheck, check.ProxiesCheck, check.SecretsCheck, check.ProxyStatsCheck))
+			Expect(ids[0]).To(Equal(check.DeploymentsCheck))
+		})
+	})
+
+	Context("running checks", func() {
+
+		var results []*check.Result
+
+		BeforeEach(func() {
+			checks, err := registry.Select(nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			results = check.RunChecks(context.Background(), nil, checks, nil)
+		})
+
+		It("reports the outcome of each check", func() {
+			Expect(ran).To(Equal([]string{"a", "b", "c", "d"}))
+			Expect(results).To(HaveLen(4))
+
+			Expect(results[0].Status).To(Equal(check.StatusPassed))
+			Expect(results[0].Severity).To(Equal(check.SeverityError))
+			Expect(results[0].Remediation).To(BeEmpty())
+
+			Expect(results[1].Status).To(Equal(check.StatusFailed))
+			Expect(results[1].Errors).To(Equal([]string{"b1", "b2"}))
+			Expect(results[1].Remediation).To(Equal("fix the b"))
+			Expect(results[1].Failed()).To(BeTrue())
+
+			Expect(results[2].Status).To(Equal(check.StatusFailed))
+			Expect(results[2].Failed()).To(BeFalse())
+
+			Expect(results[3].Status).To(Equal(check.StatusSkipped))
+			Expect(results[3].SkipReason).To(Equal("d is not installed"))
+		})
+
+		It("only fails on the errors of the checks with error severity", func() {
+			err := check.ResultsError(results)
+			Expect(err).NotTo(BeNil())
+			Expect(err.Errors).To(HaveLen(2))
+		})
+
+		It("prints the progress", func() {
+			checks, err := registry.Select(nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			out := &bytes.Buffer{}
+			check.RunChecks(context.Background(), nil, checks, out)
+			Expect(out.String()).To(Equal("Checking a things... OK\n" +
+				"Checking b things... 2 Errors!\n" +
+				"  Hint: fix the b\n" +
+				"Checking c things... 1 Warnings!\n" +
+				"  c1\n" +
+				"  Hint: fix the c\n" +
+				"Checking d things... Skipped: d is not installed\n"))
+		})
+
+		It("prints json", func() {
+			out := &bytes.Buffer{}
+			Expect(check.PrintJson(out, results)).NotTo(HaveOccurred())
+
+			var report struct {
+				Success bool
+				Checks  []map[string]interface{}
+			}
+			Expect(json.Unmarshal(out.Bytes(), &report)).NotTo(HaveOccurred())
+			Expect(report.Success).To(BeFalse())
+			Expect(report.Checks).To(HaveLen(4))
+			Expect(report.Checks[1]).To(Equal(map[string]interface{}{
+				"id":          "b",
+				"description": "b things",
+				"severity":    "error",
+				"status":      "failed",
+				"errors":      []interface{}{"b1", "b2"},
+				"remediation": "fix the b",
+			}))
+		})
+
+		It("prints junit", func() {
+			results[0].Duration = 1500 * time.Millisecond
+			out := &bytes.Buffer{}
+			Expect(check.PrintJunit(out, results)).NotTo(HaveOccurred())
+			Expect(out.String()).To(ContainSubstring(`<testsuite name="glooctl check" tests="4" failures="2" skipped="1" time="1.500">`))
+			Expect(out.String()).To(ContainSubstring(`<testcase name="a" classname="glooctl check" time="1.500"></testcase>`))
+			Expect(out.String()).To(ContainSubstring(`<failure message="Checking b things failed with 2 errors" type="error">b1&#xA;b2&#xA;Hint: fix the b</failure>`))
+			Expect(out.String()).To(ContainSubstring(`<failure message="Checking c things failed with 1 errors" type="warning">`))
+			Expect(out.String()).To(ContainSubstring(`<skipped message="d is not installed"></skipped>`))
+		})
+	})
+})
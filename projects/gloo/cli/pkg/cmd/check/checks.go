@@ -0,0 +1,621 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
+	rlopts "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	CrdNotFoundErr = func(crdName string) error {
+		return eris.Errorf("%s CRD has not been registered", crdName)
+	}
+)
+
+const (
+	DeploymentsCheck      = "deployments"
+	PodsCheck             = "pods"
+	SettingsCheck         = "settings"
+	UpstreamsCheck        = "upstreams"
+	UpstreamGroupsCheck   = "upstream-groups"
+	AuthConfigsCheck      = "auth-configs"
+	RateLimitConfigsCheck = "rate-limit-configs"
+	SecretsCheck          = "secrets"
+	VirtualServicesCheck  = "virtual-services"
+	GatewaysCheck         = "gateways"
+	ProxiesCheck          = "proxies"
+	ProxyStatsCheck       = "proxy-stats"
+	XdsMetricsCheck       = "xds-metrics"
+	RateLimitServerCheck  = "rate-limit-server"
+)
+
+// the ids accepted by --exclude before the checks were registered units
+var deprecatedCheckIds = map[string]string{
+	"upstreamgroup": UpstreamGroupsCheck,
+}
+
+const (
+	statusRemediation = "Run `glooctl get %s -o yaml` to see the status of the resource, " +
+		"and `glooctl debug logs --errors-only` to find the relevant errors."
+	settingsSkipReason = "the Gloo settings could not be read"
+)
+
+func init() {
+	if err := Register(builtinChecks()...); err != nil {
+		panic(err)
+	}
+}
+
+func builtinChecks() []*Check {
+	return []*Check{
+		{
+			Id:          DeploymentsCheck,
+			Description: "deployments",
+			Remediation: "Run `kubectl describe deployments -n <namespace>` to find why the deployments are not available.",
+			Run:         checkDeployments,
+		},
+		{
+			Id:          PodsCheck,
+			Description: "pods",
+			Remediation: "Run `kubectl describe pods -n <namespace>` and `glooctl debug logs` to find why the pods are not ready.",
+			Run:         checkPods,
+		},
+		{
+			Id:          SettingsCheck,
+			Description: "settings",
+			Remediation: "Make sure Gloo is installed in the namespace given with --namespace.",
+			Run:         checkSettings,
+		},
+		{
+			Id:          UpstreamsCheck,
+			Description: "upstreams",
+			Remediation: fmt.Sprintf(statusRemediation, "upstream"),
+			Run:         checkUpstreams,
+		},
+		{
+			Id:          UpstreamGroupsCheck,
+			Description: "upstream groups",
+			Remediation: fmt.Sprintf(statusRemediation, "upstreamgroup"),
+			Run:         checkUpstreamGroups,
+		},
+		{
+			Id:          AuthConfigsCheck,
+			Description: "auth configs",
+			Remediation: fmt.Sprintf(statusRemediation, "authconfig"),
+			Run:         checkAuthConfigs,
+		},
+		{
+			Id:          RateLimitConfigsCheck,
+			Description: "rate limit configs",
+			Remediation: "Run `kubectl get ratelimitconfigs -o yaml` to see the status of the rate limit configs.",
+			Run:         checkRateLimitConfigs,
+		},
+		{
+			Id:          SecretsCheck,
+			Description: "secrets",
+			Remediation: "Make sure the secrets in the watched namespaces are well formed.",
+			Run:         checkSecrets,
+		},
+		{
+			Id:          VirtualServicesCheck,
+			Description: "virtual services",
+			Remediation: "Fix the rejected virtual services and the references to missing resources; " + fmt.Sprintf(statusRemediation, "virtualservice"),
+			Run:         checkVirtualServices,
+		},
+		{
+			Id:          GatewaysCheck,
+			Description: "gateways",
+			Remediation: "Run `kubectl get gateways.gateway.solo.io -A -o yaml` to see the status of the gateways.",
+			Run:         checkGateways,
+		},
+		{
+			Id:          ProxiesCheck,
+			Description: "proxies",
+			Remediation: fmt.Sprintf(statusRemediation, "proxy"),
+			Run:         checkProxies,
+		},
+		{
+			Id:          ProxyStatsCheck,
+			Description: "proxy stats",
+			Remediation: "Run `glooctl proxy logs` or `glooctl debug logs` to find why the proxies do not accept the config.",
+			Run:         checkProxiesPromStats,
+		},
+		{
+			Id:          XdsMetricsCheck,
+			Description: "xds metrics",
+			Remediation: "Run `glooctl debug logs --errors-only` to find why the config is not accepted.",
+			Run:         checkXdsMetrics,
+		},
+		{
+			Id:          RateLimitServerCheck,
+			Description: "rate limit server",
+			Remediation: "Run `glooctl debug logs --errors-only` to find the relevant errors.",
+			Run:         checkRateLimitServer,
+		},
+	}
+}
+
+func checkDeployments(_ context.Context, env *Environment) error {
+	deployments, err := env.Deployments()
+	if err != nil {
+		return err
+	}
+	var multiErr *multierror.Error
+	var message string
+	setMessage := func(c appsv1.DeploymentCondition) {
+		if c.Message != "" {
+			message = fmt.Sprintf(" Message: %s", c.Message)
+		}
+	}
+
+	for _, deployment := range deployments.Items {
+		// possible condition types listed at https://godoc.org/k8s.io/api/apps/v1#DeploymentConditionType
+		// check for each condition independently because multiple conditions will be True and DeploymentReplicaFailure
+		// tends to provide the most explicit error message.
+		for _, condition := range deployment.Status.Conditions {
+			setMessage(condition)
+			if condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue {
+				err := fmt.Errorf("Deployment %s in namespace %s failed to create pods!%s", deployment.Name, deployment.Namespace, message)
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+
+		for _, condition := range deployment.Status.Conditions {
+			setMessage(condition)
+			if condition.Type == appsv1.DeploymentProgressing && condition.Status != corev1.ConditionTrue {
+				err := fmt.Errorf("Deployment %s in namespace %s is not progressing!%s", deployment.Name, deployment.Namespace, message)
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+
+		for _, condition := range deployment.Status.Conditions {
+			setMessage(condition)
+			if condition.Type == appsv1.DeploymentAvailable && condition.Status != corev1.ConditionTrue {
+				err := fmt.Errorf("Deployment %s in namespace %s is not available!%s", deployment.Name, deployment.Namespace, message)
+				multiErr = multierror.Append(multiErr, err)
+			}
+
+		}
+
+		for _, condition := range deployment.Status.Conditions {
+			if condition.Type != appsv1.DeploymentAvailable &&
+				condition.Type != appsv1.DeploymentReplicaFailure &&
+				condition.Type != appsv1.DeploymentProgressing {
+				err := fmt.Errorf("Deployment %s has an unhandled deployment condition %s", deployment.Name, condition.Type)
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func checkPods(_ context.Context, env *Environment) error {
+	client := helpers.MustKubeClient()
+	pods, err := client.CoreV1().Pods(env.Namespace()).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var multiErr *multierror.Error
+	for _, pod := range pods.Items {
+		for _, condition := range pod.Status.Conditions {
+			var errorToPrint string
+			var message string
+
+			if condition.Message != "" {
+				message = fmt.Sprintf(" Message: %s", condition.Message)
+			}
+
+			// if condition is not met and the pod is not completed
+			conditionNotMet := condition.Status != corev1.ConditionTrue && condition.Reason != "PodCompleted"
+
+			// possible condition types listed at https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#pod-conditions
+			switch condition.Type {
+			case corev1.PodScheduled:
+				if conditionNotMet {
+					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is not yet scheduled!%s", pod.Name, pod.Namespace, message)
+				}
+			case corev1.PodReady:
+				if conditionNotMet {
+					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is not ready!%s", pod.Name, pod.Namespace, message)
+				}
+			case corev1.PodInitialized:
+				if conditionNotMet {
+					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is not yet initialized!%s", pod.Name, pod.Namespace, message)
+				}
+			case corev1.PodReasonUnschedulable:
+				if conditionNotMet {
+					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is unschedulable!%s", pod.Name, pod.Namespace, message)
+				}
+			case corev1.ContainersReady:
+				if conditionNotMet {
+					errorToPrint = fmt.Sprintf("Not all containers in pod %s in namespace %s are ready!%s", pod.Name, pod.Namespace, message)
+				}
+			}
+
+			if errorToPrint != "" {
+				multiErr = multierror.Append(multiErr, errors.New(errorToPrint))
+			}
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func checkSettings(_ context.Context, env *Environment) error {
+	_, err := env.Settings()
+	return err
+}
+
+func checkUpstreams(_ context.Context, env *Environment) error {
+	namespaces, err := env.Namespaces()
+	if err != nil {
+		return Skip(settingsSkipReason)
+	}
+	var multiErr *multierror.Error
+	for _, ns := range namespaces {
+		upstreams, err := helpers.MustNamespacedUpstreamClient(ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return err
+		}
+		for _, upstream := range upstreams {
+			if upstream.Status.GetState() == core.Status_Rejected {
+				errMessage := fmt.Sprintf("Found rejected upstream: %s ", renderMetadata(upstream.GetMetadata()))
+				errMessage += fmt.Sprintf("(Reason: %s)", upstream.Status.Reason)
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			}
+			if upstream.Status.GetState() == core.Status_Warning {
+				errMessage := fmt.Sprintf("Found upstream with warnings: %s ", renderMetadata(upstream.GetMetadata()))
+				errMessage += fmt.Sprintf("(Reason: %s)", upstream.Status.Reason)
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			}
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func checkUpstreamGroups(_ context.Context, env *Environment) error {
+	namespaces, err := env.Namespaces()
+	if err != nil {
+		return Skip(settingsSkipReason)
+	}
+	var multiErr *multierror.Error
+	for _, ns := range namespaces {
+		upstreamGroups, err := helpers.MustNamespacedUpstreamGroupClient(ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return err
+		}
+		for _, upstreamGroup := range upstreamGroups {
+			if upstreamGroup.Status.GetState() == core.Status_Rejected {
+				errMessage := fmt.Sprintf("Found rejected upstream group: %s ", renderMetadata(upstreamGroup.GetMetadata()))
+				errMessage += fmt.Sprintf("(Reason: %s)", upstreamGroup.Status.Reason)
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			}
+			if upstreamGroup.Status.GetState() == core.Status_Warning {
+				errMessage := fmt.Sprintf("Found upstream group with warnings: %s ", renderMetadata(upstreamGroup.GetMetadata()))
+				errMessage += fmt.Sprintf("(Reason: %s)", upstreamGroup.Status.Reason)
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			}
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func checkAuthConfigs(_ context.Context, env *Environment) error {
+	namespaces, err := env.Namespaces()
+	if err != nil {
+		return Skip(settingsSkipReason)
+	}
+	var multiErr *multierror.Error
+	for _, ns := range namespaces {
+		authConfigs, err := helpers.MustNamespacedAuthConfigClient(ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return err
+		}
+		for _, authConfig := range authConfigs {
+			if authConfig.Status.GetState() == core.Status_Rejected {
+				errMessage := fmt.Sprintf("Found rejected auth config: %s ", renderMetadata(authConfig.GetMetadata()))
+				errMessage += fmt.Sprintf("(Reason: %s)", authConfig.Status.Reason)
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			} else if authConfig.Status.GetState() == core.Status_Warning {
+				errMessage := fmt.Sprintf("Found auth config with warnings: %s ", renderMetadata(authConfig.GetMetadata()))
+				errMessage += fmt.Sprintf("(Reason: %s)", authConfig.Status.Reason)
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			}
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func checkRateLimitConfigs(_ context.Context, env *Environment) error {
+	namespaces, err := env.Namespaces()
+	if err != nil {
+		return Skip(settingsSkipReason)
+	}
+	var multiErr *multierror.Error
+	for _, ns := range namespaces {
+
+		rlcClient, err := helpers.RateLimitConfigClient([]string{ns})
+		if err != nil {
+			if isCrdNotFoundErr(err) {
+				// If the CRD is required, the check would have failed on the crashing gloo/gloo-ee pod.
+				return Skip(CrdNotFoundErr(ratelimit.RateLimitConfigCrd.KindName).Error())
+			}
+			return err
+		}
+
+		configs, err := rlcClient.List(ns, clients.ListOpts{})
+		if err != nil {
+			return err
+		}
+		for _, config := range configs {
+			if config.Status.GetState() == v1alpha1.RateLimitConfigStatus_REJECTED {
+				errMessage := fmt.Sprintf("Found rejected rate limit config: %s ", renderMetadata(config.GetMetadata()))
+				errMessage += fmt.Sprintf("(Reason: %s)", config.Status.Message)
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			}
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func checkVirtualServices(_ context.Context, env *Environment) error {
+	namespaces, err := env.Namespaces()
+	if err != nil {
+		return Skip(settingsSkipReason)
+	}
+	knownUpstreams, knownAuthConfigs, knownRateLimitConfigs, err := listReferencedResources(namespaces)
+	if err != nil {
+		return err
+	}
+	var multiErr *multierror.Error
+
+	for _, ns := range namespaces {
+		virtualServices, err := helpers.MustNamespacedVirtualServiceClient(ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return err
+		}
+		for _, virtualService := range virtualServices {
+			if virtualService.Status.GetState() == core.Status_Rejected {
+				errMessage := fmt.Sprintf("Found rejected virtual service: %s ", renderMetadata(virtualService.GetMetadata()))
+				errMessage += fmt.Sprintf("(Reason: %s)", virtualService.Status.GetReason())
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			}
+			if virtualService.Status.GetState() == core.Status_Warning {
+				errMessage := fmt.Sprintf("Found virtual service with warnings: %s ", renderMetadata(virtualService.GetMetadata()))
+				errMessage += fmt.Sprintf("(Reason: %s)", virtualService.Status.GetReason())
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			}
+			for _, route := range virtualService.GetVirtualHost().GetRoutes() {
+				if route.GetRouteAction() != nil {
+					if route.GetRouteAction().GetSingle() != nil {
+						us := route.GetRouteAction().GetSingle()
+						if us.GetUpstream() != nil {
+							if !cliutils.Contains(knownUpstreams, renderRef(us.GetUpstream())) {
+								//TODO warning message if using rejected or warning upstream
+								errMessage := fmt.Sprintf("Virtual service references unknown upstream: ")
+								errMessage += fmt.Sprintf("(Virtual service: %s", renderMetadata(virtualService.GetMetadata()))
+								errMessage += fmt.Sprintf(" | Upstream: %s)", renderRef(us.GetUpstream()))
+								multiErr = multierror.Append(multiErr, errors.New(errMessage))
+							}
+						}
+					}
+				}
+			}
+
+			// Check references to auth configs
+			isAuthConfigRefValid := func(knownConfigs []string, ref *core.ResourceRef) error {
+				// If the virtual service points to a specific, non-existent authconfig, it is not valid.
+				if ref != nil && !cliutils.Contains(knownConfigs, renderRef(ref)) {
+					//TODO: Virtual service references rejected or warning auth config
+					errMessage := fmt.Sprintf("Virtual service references unknown auth config:\n")
+					errMessage += fmt.Sprintf("  Virtual service: %s\n", renderMetadata(virtualService.GetMetadata()))
+					errMessage += fmt.Sprintf("  Auth Config: %s\n", renderRef(ref))
+					return errors.New(errMessage)
+				}
+				return nil
+			}
+			// Check virtual host options
+			if err := isAuthConfigRefValid(knownAuthConfigs, virtualService.GetVirtualHost().GetOptions().GetExtauth().GetConfigRef()); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+			// Check route options
+			for _, route := range virtualService.GetVirtualHost().GetRoutes() {
+				if err := isAuthConfigRefValid(knownAuthConfigs, route.GetOptions().GetExtauth().GetConfigRef()); err != nil {
+					multiErr = multierror.Append(multiErr, err)
+				}
+				// Check weighted destination options
+				for _, weightedDest := range route.GetRouteAction().GetMulti().GetDestinations() {
+					if err := isAuthConfigRefValid(knownAuthConfigs, weightedDest.GetOptions().GetExtauth().GetConfigRef()); err != nil {
+						multiErr = multierror.Append(multiErr, err)
+					}
+				}
+			}
+
+			// Check references to rate limit configs
+			isRateLimitConfigRefValid := func(knownConfigs []string, ref *rlopts.RateLimitConfigRef) error {
+				resourceRef := &core.ResourceRef{
+					Name:      ref.Name,
+					Namespace: ref.Namespace,
+				}
+				if !cliutils.Contains(knownConfigs, renderRef(resourceRef)) {
+					//TODO: check if references rate limit config with error or warning
+					errMessage := fmt.Sprintf("Virtual service references unknown rate limit config:\n")
+					errMessage += fmt.Sprintf("  Virtual service: %s\n", renderMetadata(virtualService.GetMetadata()))
+					errMessage += fmt.Sprintf("  Rate Limit Config: %s\n", renderRef(resourceRef))
+					return errors.New(errMessage)
+				}
+				return nil
+			}
+			// Check virtual host options
+			for _, ref := range virtualService.GetVirtualHost().GetOptions().GetRateLimitConfigs().GetRefs() {
+				if err := isRateLimitConfigRefValid(knownRateLimitConfigs, ref); err != nil {
+					multiErr = multierror.Append(multiErr, err)
+				}
+			}
+			// Check route options
+			for _, route := range virtualService.GetVirtualHost().GetRoutes() {
+				for _, ref := range route.GetOptions().GetRateLimitConfigs().GetRefs() {
+					if err := isRateLimitConfigRefValid(knownRateLimitConfigs, ref); err != nil {
+						multiErr = multierror.Append(multiErr, err)
+					}
+				}
+			}
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
+
+// Returns the upstreams, auth configs and rate limit configs which virtual services can reference.
+func listReferencedResources(namespaces []string) (upstreams, authConfigs, rateLimitConfigs []string, err error) {
+	for _, ns := range namespaces {
+		upstreamList, err := helpers.MustNamespacedUpstreamClient(ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, upstream := range upstreamList {
+			upstreams = append(upstreams, renderMetadata(upstream.GetMetadata()))
+		}
+
+		authConfigList, err := helpers.MustNamespacedAuthConfigClient(ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, authConfig := range authConfigList {
+			authConfigs = append(authConfigs, renderMetadata(authConfig.GetMetadata()))
+		}
+
+		rlcClient, err := helpers.RateLimitConfigClient([]string{ns})
+		if err != nil {
+			if isCrdNotFoundErr(err) {
+				continue
+			}
+			return nil, nil, nil, err
+		}
+		rateLimitConfigList, err := rlcClient.List(ns, clients.ListOpts{})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, config := range rateLimitConfigList {
+			rateLimitConfigs = append(rateLimitConfigs, renderMetadata(config.GetMetadata()))
+		}
+	}
+	return upstreams, authConfigs, rateLimitConfigs, nil
+}
+
+func checkGateways(_ context.Context, env *Environment) error {
+	namespaces, err := env.Namespaces()
+	if err != nil {
+		return Skip(settingsSkipReason)
+	}
+	var multiErr *multierror.Error
+	for _, ns := range namespaces {
+		gateways, err := helpers.MustNamespacedGatewayClient(ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return err
+		}
+		for _, gateway := range gateways {
+			if gateway.Status.GetState() == core.Status_Rejected {
+				errMessage := fmt.Sprintf("Found rejected gateway: %s\n", renderMetadata(gateway.GetMetadata()))
+				errMessage += fmt.Sprintf("Reason: %s\n", gateway.Status.Reason)
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			}
+			if gateway.Status.GetState() == core.Status_Warning {
+				errMessage := fmt.Sprintf("Found gateway with warnings: %s\n", renderMetadata(gateway.GetMetadata()))
+				errMessage += fmt.Sprintf("Reason: %s\n", gateway.Status.Reason)
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			}
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func checkProxies(_ context.Context, env *Environment) error {
+	namespaces, err := env.Namespaces()
+	if err != nil {
+		return Skip(settingsSkipReason)
+	}
+	var multiErr *multierror.Error
+	for _, ns := range namespaces {
+		proxies, err := helpers.MustNamespacedProxyClient(ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return err
+		}
+		for _, proxy := range proxies {
+			if proxy.Status.GetState() == core.Status_Rejected {
+				errMessage := fmt.Sprintf("Found rejected proxy: %s\n", renderMetadata(proxy.GetMetadata()))
+				errMessage += fmt.Sprintf("Reason: %s\n", proxy.Status.Reason)
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			}
+			if proxy.Status.GetState() == core.Status_Warning {
+				errMessage := fmt.Sprintf("Found proxy with warnings: %s\n", renderMetadata(proxy.GetMetadata()))
+				errMessage += fmt.Sprintf("Reason: %s\n", proxy.Status.Reason)
+				multiErr = multierror.Append(multiErr, errors.New(errMessage))
+			}
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func checkSecrets(_ context.Context, env *Environment) error {
+	namespaces, err := env.Namespaces()
+	if err != nil {
+		return Skip(settingsSkipReason)
+	}
+	var multiErr *multierror.Error
+	client := helpers.MustSecretClientWithOptions(5*time.Second, namespaces)
+
+	for _, ns := range namespaces {
+		_, err := client.List(ns, clients.ListOpts{})
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+		// currently this would only find syntax errors
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func renderMetadata(metadata core.Metadata) string {
+	return renderNamespaceName(metadata.Namespace, metadata.Name)
+}
+
+func renderRef(ref *core.ResourceRef) string {
+	return renderNamespaceName(ref.Namespace, ref.Name)
+}
+
+func renderNamespaceName(namespace, name string) string {
+	return fmt.Sprintf("%s %s", namespace, name)
+}
+
+func isCrdNotFoundErr(err error) bool {
+	for {
+		if statusErr, ok := err.(*apierrors.StatusError); ok {
+			if apierrors.IsNotFound(err) &&
+				statusErr.ErrStatus.Details != nil &&
+				statusErr.ErrStatus.Details.Kind == ratelimit.RateLimitConfigCrd.Plural {
+				return true
+			}
+			return false
+		}
+
+		// This works for "github.com/pkg/errors"-based errors as well
+		if wrappedErr := eris.Unwrap(err); wrappedErr != nil {
+			err = wrappedErr
+			continue
+		}
+		return false
+	}
+}
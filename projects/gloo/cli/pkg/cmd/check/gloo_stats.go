@@ -3,9 +3,11 @@ package check
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/cliutil"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	v1 "k8s.io/api/apps/v1"
@@ -27,9 +29,14 @@ var (
 		return fmt.Sprintf("Gloo has detected that the data plane is out of sync. The following types of resources have not been accepted: %v. "+
 			"Gloo will not be able to process any other configuration updates until these errors are resolved.", resourceNames)
 	}
+	rateLimitNotConnectedMessage = "The rate limit server is out of sync with the Gloo control plane and is not receiving valid gloo config."
 )
 
 func ResourcesSyncedOverXds(stats, deploymentName string) bool {
+	return len(outOfSyncXdsResources(stats, deploymentName)) == 0
+}
+
+func outOfSyncXdsResources(stats, deploymentName string) []string {
 	var outOfSyncResources []string
 	metrics := parseMetrics(stats, []string{glooeTotalEntites, glooeInSyncEntities}, deploymentName)
 	for metric, val := range metrics {
@@ -42,72 +49,82 @@ func ResourcesSyncedOverXds(stats, deploymentName string) bool {
 			}
 		}
 	}
-	if len(outOfSyncResources) > 0 {
-		fmt.Println(resourcesOutOfSyncMessage(outOfSyncResources))
-		return false
-	}
-	return true
+	sort.Strings(outOfSyncResources)
+	return outOfSyncResources
 }
 
 func RateLimitIsConnected(stats string) bool {
-	connectedStateErrMessage := "The rate limit server is out of sync with the Gloo control plane and is not receiving valid gloo config.\n" +
-		"You may want to try using the `glooctl debug logs --errors-only` command to find any relevant error logs."
-
 	metrics := parseMetrics(stats, []string{GlooeRateLimitConnectedState}, "gloo")
 
 	if val, ok := metrics[GlooeRateLimitConnectedState]; ok && val == 0 {
-		fmt.Println(connectedStateErrMessage)
 		return false
 	}
 
 	return true
 }
 
-func checkXdsMetrics(ctx context.Context, glooNamespace string, deployments *v1.DeploymentList) error {
+// port-forwards the gloo deployment and gets its prometheus metrics
+func getGlooMetrics(ctx context.Context, glooNamespace string) (string, error) {
 	errMessage := "Problem while checking for gloo xds errors"
-	if deployments == nil {
-		fmt.Println("Skipping due to an error in checking deployments")
-		return fmt.Errorf("xds metrics check was skipped due to an error in checking deployments")
-	}
-	// port-forward proxy deployment and get prometheus metrics
 	freePort, err := cliutil.GetFreePort()
 	if err != nil {
-		fmt.Println(errMessage)
-		return err
+		return "", eris.Wrap(err, errMessage)
 	}
 	localPort := strconv.Itoa(freePort)
 	adminPort := strconv.Itoa(int(defaults.GlooAdminPort))
-	// stats is the string containing all stats from /stats/prometheus
 	stats, portFwdCmd, err := cliutil.PortForwardGet(ctx, glooNamespace, "deploy/"+glooDeployment,
 		localPort, adminPort, false, glooStatsPath)
 	if err != nil {
-		return err
+		return "", eris.Wrap(err, errMessage)
 	}
 	if portFwdCmd.Process != nil {
-		defer portFwdCmd.Process.Release()
-		defer portFwdCmd.Process.Kill()
+		portFwdCmd.Process.Kill()
+		portFwdCmd.Process.Release()
 	}
 
 	if strings.TrimSpace(stats) == "" {
-		err := fmt.Sprint(errMessage+": could not find any metrics at", glooStatsPath, "endpoint of the "+glooDeployment+" deployment")
-		fmt.Println(err)
-		return fmt.Errorf(err)
+		return "", eris.Errorf("%s: could not find any metrics at %s endpoint of the %s deployment", errMessage, glooStatsPath, glooDeployment)
 	}
+	return stats, nil
+}
 
-	if !ResourcesSyncedOverXds(stats, glooDeployment) {
-		fmt.Println(errMessage)
-		return fmt.Errorf(errMessage)
+func checkXdsMetrics(ctx context.Context, env *Environment) error {
+	if _, err := env.Deployments(); err != nil {
+		return Skip("the Gloo deployments could not be listed")
 	}
+	stats, err := env.GlooMetrics(ctx)
+	if err != nil {
+		return err
+	}
+	if outOfSyncResources := outOfSyncXdsResources(stats, glooDeployment); len(outOfSyncResources) > 0 {
+		return eris.New(resourcesOutOfSyncMessage(outOfSyncResources))
+	}
+	return nil
+}
+
+func checkRateLimitServer(ctx context.Context, env *Environment) error {
+	deployments, err := env.Deployments()
+	if err != nil {
+		return Skip("the Gloo deployments could not be listed")
+	}
+	if !hasDeployment(deployments, rateLimitDeployment) {
+		return Skip("the rate limit server is not installed")
+	}
+	stats, err := env.GlooMetrics(ctx)
+	if err != nil {
+		return err
+	}
+	if !RateLimitIsConnected(stats) {
+		return eris.New(rateLimitNotConnectedMessage)
+	}
+	return nil
+}
 
+func hasDeployment(deployments *v1.DeploymentList, name string) bool {
 	for _, deployment := range deployments.Items {
-		if deployment.Name == rateLimitDeployment {
-			fmt.Printf("Checking rate limit server... ")
-			if !RateLimitIsConnected(stats) {
-				return fmt.Errorf("rate limit server is not connected")
-			}
-			fmt.Printf("OK\n")
+		if deployment.Name == name {
+			return true
 		}
 	}
-
-	return nil
+	return false
 }
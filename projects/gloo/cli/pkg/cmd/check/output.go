@@ -0,0 +1,119 @@
+package check
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
+const (
+	OutputText  = "text"
+	OutputJson  = "json"
+	OutputJunit = "junit"
+)
+
+var (
+	UnknownOutputError = func(output string) error {
+		return eris.Errorf("unknown output format %v, must be one of: %v, %v, %v", output, OutputText, OutputJson, OutputJunit)
+	}
+)
+
+type jsonReport struct {
+	Success bool      `json:"success"`
+	Checks  []*Result `json:"checks"`
+}
+
+// Prints the results as a json object with the results of the checks, and whether glooctl check succeeded.
+func PrintJson(w io.Writer, results []*Result) error {
+	report := jsonReport{
+		Success: ResultsError(results) == nil,
+		Checks:  results,
+	}
+	if report.Checks == nil {
+		report.Checks = []*Result{}
+	}
+	jsn, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(jsn))
+	return err
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// Prints the results as a JUnit report with a test case per check. The type of the failures is the severity of the checks.
+func PrintJunit(w io.Writer, results []*Result) error {
+	suite := junitTestSuite{
+		Name:  "glooctl check",
+		Tests: len(results),
+	}
+	var total float64
+	for _, result := range results {
+		testCase := junitTestCase{
+			Name:      result.Id,
+			ClassName: suite.Name,
+			Time:      fmt.Sprintf("%.3f", result.Duration.Seconds()),
+		}
+		total += result.Duration.Seconds()
+		switch result.Status {
+		case StatusFailed:
+			suite.Failures++
+			text := strings.Join(result.Errors, "\n")
+			if result.Remediation != "" {
+				text += "\nHint: " + result.Remediation
+			}
+			testCase.Failure = &junitFailure{
+				Message: fmt.Sprintf("Checking %s failed with %d errors", result.Description, len(result.Errors)),
+				Type:    string(result.Severity),
+				Text:    text,
+			}
+		case StatusSkipped:
+			suite.Skipped++
+			testCase.Skipped = &junitSkipped{Message: result.SkipReason}
+		}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+	suite.Time = fmt.Sprintf("%.3f", total)
+
+	out, err := xml.MarshalIndent(junitTestSuites{Suites: []junitTestSuite{suite}}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s%s\n", xml.Header, out)
+	return err
+}
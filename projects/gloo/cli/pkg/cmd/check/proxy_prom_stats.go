@@ -10,8 +10,7 @@ import (
 	"strings"
 	"time"
 
-	v1 "k8s.io/api/apps/v1"
-
+	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/cliutil"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 )
@@ -20,7 +19,12 @@ const promStatsPath = "/stats/prometheus"
 
 const metricsUpdateInterval = time.Millisecond * 250
 
-func checkProxiesPromStats(ctx context.Context, glooNamespace string, deployments *v1.DeploymentList) error {
+func checkProxiesPromStats(ctx context.Context, env *Environment) error {
+	deployments, err := env.Deployments()
+	if err != nil {
+		return Skip("the Gloo deployments could not be listed")
+	}
+	glooNamespace := env.Namespace()
 	for _, deployment := range deployments.Items {
 		if deployment.Name == "gateway-proxy" || deployment.Name == "ingress-proxy" || deployment.Name == "knative-external-proxy" || deployment.Name == "knative-internal-proxy" {
 			if err := checkProxyPromStats(ctx, glooNamespace, deployment.Name); err != nil {
@@ -39,8 +43,7 @@ func checkProxyPromStats(ctx context.Context, glooNamespace string, deploymentNa
 	// port-forward proxy deployment and get prometheus metrics
 	freePort, err := cliutil.GetFreePort()
 	if err != nil {
-		fmt.Println(errMessage)
-		return err
+		return eris.Wrap(err, errMessage)
 	}
 	localPort := strconv.Itoa(freePort)
 	adminPort := strconv.Itoa(int(defaults.EnvoyAdminPort))
@@ -48,8 +51,7 @@ func checkProxyPromStats(ctx context.Context, glooNamespace string, deploymentNa
 	stats, portFwdCmd, err := cliutil.PortForwardGet(ctx, glooNamespace, "deploy/"+deploymentName,
 		localPort, adminPort, false, promStatsPath)
 	if err != nil {
-		fmt.Println(errMessage)
-		return err
+		return eris.Wrap(err, errMessage)
 	}
 	if portFwdCmd.Process != nil {
 		defer portFwdCmd.Process.Release()
@@ -69,12 +71,12 @@ func checkProxyPromStats(ctx context.Context, glooNamespace string, deploymentNa
 func checkProxyConnectedState(stats string, deploymentName string, genericErrMessage string, connectedStateErrMessage string) error {
 
 	if strings.TrimSpace(stats) == "" {
-		err := fmt.Errorf(genericErrMessage+": could not find any metrics at", promStatsPath, "endpoint of the "+deploymentName+" deployment")
+		err := fmt.Errorf("%s: could not find any metrics at %s endpoint of the %s deployment", genericErrMessage, promStatsPath, deploymentName)
 		return err
 	}
 
 	if !strings.Contains(stats, "envoy_control_plane_connected_state{} 1") {
-		err := fmt.Errorf(connectedStateErrMessage)
+		err := eris.New(connectedStateErrMessage)
 		return err
 	}
 
@@ -90,23 +92,21 @@ func checkProxyUpdate(stats string, localPort string, deploymentName string, err
 	// gather metrics again
 	res, err := http.Get("http://localhost:" + localPort + promStatsPath)
 	if err != nil {
-		fmt.Println(errMessage)
-		return err
+		return eris.Wrap(err, errMessage)
 	}
 	if res.StatusCode != 200 {
-		err := fmt.Errorf(errMessage+": received unexpected status code", res.StatusCode, "from", promStatsPath, "endpoint of the "+deploymentName+" deployment")
+		err := fmt.Errorf("%s: received unexpected status code %d from %s endpoint of the %s deployment", errMessage, res.StatusCode, promStatsPath, deploymentName)
 		return err
 	}
 	b, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		fmt.Println(errMessage)
-		return err
+		return eris.Wrap(err, errMessage)
 	}
 	res.Body.Close()
 	newStats := string(b)
 
 	if strings.TrimSpace(newStats) == "" {
-		err := fmt.Errorf(errMessage+": could not find any metrics at", promStatsPath, "endpoint of the "+deploymentName+" deployment")
+		err := fmt.Errorf("%s: could not find any metrics at %s endpoint of the %s deployment", errMessage, promStatsPath, deploymentName)
 		return err
 	}
 
@@ -160,8 +160,7 @@ func parseMetrics(stats string, desiredMetricSegments []string, deploymentName s
 			metric := strings.Join(pieces[0:len(pieces)-1], "")   // get all but last piece (as one string)- this is metric name and labels
 			metricVal, err := strconv.Atoi(pieces[len(pieces)-1]) // get last piece (as int)- this is metric value
 			if err != nil {
-				fmt.Printf("Found an unexpected format in metrics at %v endpoint of the "+deploymentName+" deployment. "+
-					"Expected %v metric to have an int value but got value %v.\nContinuing check...", promStatsPath, metric, pieces[len(pieces)-1])
+				// ignore the metrics with an unexpected format
 				continue
 			}
 			statsMap[metric] = metricVal
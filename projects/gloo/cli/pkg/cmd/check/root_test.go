@@ -4,6 +4,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v12 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/check"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -132,6 +133,41 @@ var _ = Describe("Root", func() {
 
 		})
 
+		It("reports the selected checks as json", func() {
+			client := helpers.MustKubeClient()
+			client.CoreV1().Namespaces().Create(&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: defaults.GlooSystem,
+				},
+			})
+
+			helpers.MustNamespacedSettingsClient("gloo-system").Write(&v1.Settings{
+				Metadata: core.Metadata{
+					Name:      "default",
+					Namespace: "gloo-system",
+				},
+			}, clients.WriteOpts{})
+
+			helpers.MustNamespacedUpstreamClient("gloo-system").Write(&v1.Upstream{
+				Metadata: core.Metadata{
+					Name:      "some-rejected-upstream",
+					Namespace: "gloo-system",
+				},
+				Status: core.Status{
+					State:  core.Status_Rejected,
+					Reason: "I am a rejected upstream",
+				},
+			}, clients.WriteOpts{})
+
+			output, err := testutils.GlooctlOut("check --only upstreams,gateways -o json")
+			Expect(err).To(MatchError(check.FailedChecksError(1)))
+			Expect(output).To(ContainSubstring(`"success": false`))
+			Expect(output).To(ContainSubstring(`"id": "gateways"`))
+			Expect(output).To(ContainSubstring(`"status": "failed"`))
+			Expect(output).To(ContainSubstring("Found rejected upstream: gloo-system some-rejected-upstream"))
+			Expect(output).NotTo(ContainSubstring(`"id": "pods"`))
+		})
+
 	})
 
 	Context("With a custom namespace", func() {
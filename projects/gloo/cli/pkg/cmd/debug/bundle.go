@@ -0,0 +1,428 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	golangjsonpb "github.com/golang/protobuf/jsonpb"
+	golangproto "github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/cliutil/install"
+	"github.com/solo-io/gloo/pkg/utils/syncutil"
+	installcmd "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/install"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"github.com/spf13/afero"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	bundleErrorsFile  = "errors.txt"
+	envoyAdminTimeout = 10 * time.Second
+)
+
+// The Envoy admin endpoints collected for each proxy, with the name of the file of their response in the bundle
+var envoyAdminPaths = map[string]string{
+	"/config_dump": "config_dump.json",
+	"/clusters":    "clusters.txt",
+	"/stats":       "stats.txt",
+}
+
+// The Gloo resources which are read with their clients, so that the fields tagged as secret can be redacted. The other
+// Gloo CRDs are read with kubectl.
+var bundledResources = []struct {
+	crd  string
+	list func(ctx context.Context) (resources.ResourceList, error)
+}{
+	{"settings.gloo.solo.io", func(ctx context.Context) (resources.ResourceList, error) {
+		client, err := helpers.SettingsClient([]string{metav1.NamespaceAll})
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.List(metav1.NamespaceAll, clients.ListOpts{Ctx: ctx})
+		return list.AsResources(), err
+	}},
+	{"upstreams.gloo.solo.io", func(ctx context.Context) (resources.ResourceList, error) {
+		client, err := helpers.UpstreamClient([]string{metav1.NamespaceAll})
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.List(metav1.NamespaceAll, clients.ListOpts{Ctx: ctx})
+		return list.AsResources(), err
+	}},
+	{"upstreamgroups.gloo.solo.io", func(ctx context.Context) (resources.ResourceList, error) {
+		client, err := helpers.UpstreamGroupClient([]string{metav1.NamespaceAll})
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.List(metav1.NamespaceAll, clients.ListOpts{Ctx: ctx})
+		return list.AsResources(), err
+	}},
+	{"proxies.gloo.solo.io", func(ctx context.Context) (resources.ResourceList, error) {
+		client, err := helpers.ProxyClient([]string{metav1.NamespaceAll})
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.List(metav1.NamespaceAll, clients.ListOpts{Ctx: ctx})
+		return list.AsResources(), err
+	}},
+	{"gateways.gateway.solo.io", func(ctx context.Context) (resources.ResourceList, error) {
+		client, err := helpers.GatewayClient([]string{metav1.NamespaceAll})
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.List(metav1.NamespaceAll, clients.ListOpts{Ctx: ctx})
+		return list.AsResources(), err
+	}},
+	{"virtualservices.gateway.solo.io", func(ctx context.Context) (resources.ResourceList, error) {
+		client, err := helpers.VirtualServiceClient([]string{metav1.NamespaceAll})
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.List(metav1.NamespaceAll, clients.ListOpts{Ctx: ctx})
+		return list.AsResources(), err
+	}},
+	{"routetables.gateway.solo.io", func(ctx context.Context) (resources.ResourceList, error) {
+		client, err := helpers.RouteTableClient([]string{metav1.NamespaceAll})
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.List(metav1.NamespaceAll, clients.ListOpts{Ctx: ctx})
+		return list.AsResources(), err
+	}},
+	{"authconfigs.enterprise.gloo.solo.io", func(ctx context.Context) (resources.ResourceList, error) {
+		client, err := helpers.AuthConfigClient([]string{metav1.NamespaceAll})
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.List(metav1.NamespaceAll, clients.ListOpts{Ctx: ctx})
+		return list.AsResources(), err
+	}},
+}
+
+// The files of a bundle being collected in a directory, and the parts which could not be collected.
+type bundle struct {
+	fs       afero.Fs
+	dir      string
+	errs     []string
+	progress io.Writer
+}
+
+func (b *bundle) write(path string, data []byte) {
+	path = filepath.Join(b.dir, path)
+	if err := b.fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		b.fail(path, err)
+		return
+	}
+	if err := afero.WriteFile(b.fs, path, data, filePermissions); err != nil {
+		b.fail(path, err)
+	}
+}
+
+func (b *bundle) fail(part string, err error) {
+	message := fmt.Sprintf("collecting %v: %v", part, err)
+	b.errs = append(b.errs, message)
+	fmt.Fprintf(b.progress, "Warning: %v\n", message)
+}
+
+// Collects the Gloo resources with their status, the Gloo manifests of the namespace, the logs of the Gloo pods, the
+// config served by the Gloo xDS server to each proxy and the config, clusters and stats of their Envoys into a tarball.
+// Secrets are redacted. The parts which cannot be collected are listed in errors.txt in the tarball rather than
+// failing the bundle, since bundles are usually collected when something is broken. Returns the path of the tarball.
+func DebugBundle(opts *options.Options, kubeCli install.KubeCli, progress io.Writer) (string, error) {
+	file := opts.Top.File
+	if file == "" {
+		file = fmt.Sprintf("gloo-bundle-%v.tgz", time.Now().UTC().Format("20060102T150405Z"))
+	}
+
+	fs := afero.NewOsFs()
+	tmpDir, err := afero.TempDir(fs, "", "")
+	if err != nil {
+		return "", err
+	}
+	defer fs.RemoveAll(tmpDir)
+	// the files are in a directory named after the tarball, so that extracting it does not litter the current directory
+	b := &bundle{fs: fs, dir: filepath.Join(tmpDir, strings.TrimSuffix(filepath.Base(file), ".tgz")), progress: progress}
+
+	ctx := opts.Top.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	namespace := opts.Metadata.Namespace
+
+	fmt.Fprintf(progress, "Collecting Gloo resources...\n")
+	collectResources(ctx, b)
+	collectManifests(b, kubeCli, namespace)
+	fmt.Fprintf(progress, "Collecting logs...\n")
+	collectLogs(opts, b)
+	collectProxies(ctx, b, namespace)
+
+	if len(b.errs) > 0 {
+		b.write(bundleErrorsFile, []byte(strings.Join(b.errs, "\n")+"\n"))
+	}
+
+	if err := zip(fs, tmpDir, file); err != nil {
+		return "", err
+	}
+	fmt.Fprintf(progress, "Wrote %v\n", file)
+	return file, nil
+}
+
+func collectResources(ctx context.Context, b *bundle) {
+	redactor := syncutil.NewProtoRedactor(syncutil.LogRedactorTag, syncutil.LogRedactorTagValue)
+	for _, bundled := range bundledResources {
+		list, err := bundled.list(ctx)
+		if err != nil {
+			b.fail(bundled.crd, err)
+			continue
+		}
+		var docs []string
+		for _, resource := range list {
+			doc, err := redactedResourceYaml(redactor, resource)
+			if err != nil {
+				b.fail(bundled.crd, err)
+				continue
+			}
+			docs = append(docs, doc)
+		}
+		b.write(filepath.Join("resources", bundled.crd+".yaml"), []byte(strings.Join(docs, "---\n")))
+	}
+}
+
+func redactedResourceYaml(redactor syncutil.ProtoRedactor, resource resources.Resource) (string, error) {
+	message, ok := resource.(proto.Message)
+	if !ok {
+		return "", eris.Errorf("resource %v is not a proto message", resource.GetMetadata().Ref().Key())
+	}
+	redacted, err := redactor.Redact(message)
+	if err != nil {
+		return "", err
+	}
+	jsn, err := protoutils.MarshalBytes(redacted.(resources.Resource))
+	if err != nil {
+		return "", err
+	}
+	out, err := redactedYaml(jsn)
+	return string(out), err
+}
+
+// Collects the kube resources of the Gloo namespace and the Gloo CRDs without clients in glooctl.
+func collectManifests(b *bundle, kubeCli install.KubeCli, namespace string) {
+	for _, kind := range installcmd.GlooNamespacedKinds {
+		collectManifest(b, kubeCli, filepath.Join("kube", strings.ToLower(kind)+".yaml"), "get", kind, "-ojson", "-n", namespace)
+	}
+	for _, crd := range installcmd.GlooCrdNames {
+		if isBundledResource(crd) {
+			continue
+		}
+		collectManifest(b, kubeCli, filepath.Join("resources", crd+".yaml"), "get", crd, "-ojson", "--all-namespaces")
+	}
+}
+
+func collectManifest(b *bundle, kubeCli install.KubeCli, path string, args ...string) {
+	jsn, err := kubeCli.KubectlOut(nil, args...)
+	if err != nil {
+		b.fail(path, err)
+		return
+	}
+	out, err := redactedYaml(jsn)
+	if err != nil {
+		b.fail(path, err)
+		return
+	}
+	b.write(path, out)
+}
+
+func isBundledResource(crd string) bool {
+	for _, bundled := range bundledResources {
+		if bundled.crd == crd {
+			return true
+		}
+	}
+	return false
+}
+
+func collectLogs(opts *options.Options, b *bundle) {
+	responses, err := setup(opts)
+	if err != nil {
+		b.fail("logs", err)
+		return
+	}
+	for _, response := range responses {
+		logs, err := ioutil.ReadAll(response.Response)
+		response.Response.Close()
+		if err != nil {
+			b.fail("logs of "+response.ResourceId(), err)
+			continue
+		}
+		b.write(filepath.Join("logs", response.ResourceId()+".log"), logs)
+	}
+}
+
+// Collects the config served by Gloo to each proxy of the namespace, and the admin data of their Envoys.
+func collectProxies(ctx context.Context, b *bundle, namespace string) {
+	client, err := helpers.ProxyClient([]string{namespace})
+	if err != nil {
+		b.fail("proxies", err)
+		return
+	}
+	proxies, err := client.List(namespace, clients.ListOpts{Ctx: ctx})
+	if err != nil {
+		b.fail("proxies", err)
+		return
+	}
+	for _, proxy := range proxies {
+		name := proxy.GetMetadata().Name
+		fmt.Fprintf(b.progress, "Collecting the config of proxy %v...\n", name)
+
+		xdsPath := filepath.Join("xds", name+".yaml")
+		dump, err := xdsinspection.GetGlooXdsDump(ctx, name, namespace, false)
+		if err != nil {
+			b.fail(xdsPath, err)
+		} else if out, err := redactedXdsDumpYaml(dump); err != nil {
+			b.fail(xdsPath, err)
+		} else {
+			b.write(xdsPath, out)
+		}
+
+		adminData, err := getEnvoyAdminData(ctx, namespace, name)
+		if err != nil {
+			b.fail(filepath.Join("envoy", name), err)
+			continue
+		}
+		for path, file := range envoyAdminPaths {
+			data := adminData[path]
+			if strings.HasSuffix(file, ".json") {
+				if data, err = redactedJson(data); err != nil {
+					b.fail(filepath.Join("envoy", name, file), err)
+					continue
+				}
+			}
+			b.write(filepath.Join("envoy", name, file), data)
+		}
+	}
+}
+
+// Builds a yaml document of the xDS resources, with the secrets of the TLS contexts packed in their Any fields redacted.
+func redactedXdsDumpYaml(dump *xdsinspection.XdsDump) ([]byte, error) {
+	messages := map[string][]golangproto.Message{}
+	for i := range dump.Clusters {
+		messages["clusters"] = append(messages["clusters"], &dump.Clusters[i])
+	}
+	for i := range dump.Endpoints {
+		messages["endpoints"] = append(messages["endpoints"], &dump.Endpoints[i])
+	}
+	for i := range dump.Listeners {
+		messages["listeners"] = append(messages["listeners"], &dump.Listeners[i])
+	}
+	for i := range dump.Routes {
+		messages["routes"] = append(messages["routes"], &dump.Routes[i])
+	}
+	out := map[string]interface{}{"role": dump.Role}
+	// the Envoy protos are golang protos, which the gogo marshalers cannot unpack from Any fields
+	marshaler := &golangjsonpb.Marshaler{}
+	for key, list := range messages {
+		var jsns []json.RawMessage
+		for _, message := range list {
+			jsn, err := marshaler.MarshalToString(message)
+			if err != nil {
+				return nil, err
+			}
+			jsns = append(jsns, json.RawMessage(jsn))
+		}
+		out[key] = jsns
+	}
+	jsn, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	return redactedYaml(jsn)
+}
+
+func redactedYaml(jsn []byte) ([]byte, error) {
+	redacted, err := syncutil.RedactJsonFields(jsn, syncutil.SensitiveJsonFields)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(redacted)
+}
+
+func redactedJson(jsn []byte) ([]byte, error) {
+	redacted, err := syncutil.RedactJsonFields(jsn, syncutil.SensitiveJsonFields)
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, redacted, "", "  "); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// Port-forwards to the admin port of the Envoy deployment, and returns the response of each admin endpoint.
+func getEnvoyAdminData(ctx context.Context, namespace, deployment string) (map[string][]byte, error) {
+	adminPort := strconv.Itoa(int(defaults.EnvoyAdminPort))
+	portFwd := exec.Command("kubectl", "port-forward", "-n", namespace, "deployment/"+deployment, adminPort)
+	portFwdOutput := &bytes.Buffer{}
+	portFwd.Stdout = portFwdOutput
+	portFwd.Stderr = portFwdOutput
+	if err := portFwd.Start(); err != nil {
+		return nil, eris.Wrapf(err, "failed to start port-forward")
+	}
+	defer func() {
+		if portFwd.Process != nil {
+			portFwd.Process.Kill()
+		}
+	}()
+
+	client := &http.Client{Timeout: envoyAdminTimeout}
+	deadline := time.Now().Add(envoyAdminTimeout)
+	out := map[string][]byte{}
+	for path := range envoyAdminPaths {
+		for {
+			data, err := getEnvoyAdminPath(client, "http://localhost:"+adminPort+path)
+			if err == nil {
+				out[path] = data
+				break
+			}
+			if time.Now().After(deadline) {
+				return nil, eris.Wrapf(err, "timed out trying to connect to Envoy admin port: %v", portFwdOutput.String())
+			}
+			select {
+			case <-ctx.Done():
+				return nil, eris.Errorf("cancelled")
+			case <-time.After(250 * time.Millisecond):
+			}
+		}
+	}
+	return out, nil
+}
+
+func getEnvoyAdminPath(client *http.Client, url string) ([]byte, error) {
+	res, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, eris.Errorf("invalid status code: %v %v", res.StatusCode, res.Status)
+	}
+	return ioutil.ReadAll(res.Body)
+}
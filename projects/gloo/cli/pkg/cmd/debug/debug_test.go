@@ -13,10 +13,23 @@ import (
 
 	"github.com/solo-io/gloo/pkg/cliutil/install"
 
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/pkg/utils/syncutil"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/tarutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/spf13/afero"
 )
 
 var _ = Describe("Debug", func() {
@@ -120,4 +133,98 @@ var _ = Describe("Debug", func() {
 			Expect(manifests).To(HaveLen(len(cmds)), "Should have written the same number of manifests as commands")
 		})
 	})
+
+	Context("bundle", func() {
+
+		It("collects the resources and manifests with redacted secrets", func() {
+			_, err := helpers.MustNamespacedSettingsClient("gloo-system").Write(&gloov1.Settings{
+				Metadata: core.Metadata{Name: "default", Namespace: "gloo-system"},
+				SecretSource: &gloov1.Settings_VaultSecretSource{VaultSecretSource: &gloov1.Settings_VaultSecrets{
+					Token:   "vault-token",
+					Address: "http://vault:8200",
+				}},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = helpers.MustNamespacedUpstreamClient("default").Write(&gloov1.Upstream{
+				Metadata: core.Metadata{Name: "petstore", Namespace: "default"},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			var cmds, stdout []string
+			for _, kind := range installcmd.GlooNamespacedKinds {
+				cmds = append(cmds, fmt.Sprintf("get %s -ojson -n gloo-system", kind))
+				stdout = append(stdout, `{"items":[]}`)
+			}
+			for _, crd := range []string{"routeoptions.gateway.solo.io", "virtualhostoptions.gateway.solo.io",
+				"httpgateways.gateway.solo.io", "referencepolicies.gateway.solo.io", "canaries.gateway.solo.io"} {
+				cmds = append(cmds, fmt.Sprintf("get %s -ojson --all-namespaces", crd))
+				stdout = append(stdout, `{"items":[{"kind":"RouteOption","spec":{"options":{"headerManipulation":{"password":"pw"}}}}]}`)
+			}
+			stdout[0] = "not json"
+			kubeCli := install.NewMockKubectl(cmds, stdout)
+
+			dir, err := ioutil.TempDir("", "bundle")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			opts := options.Options{}
+			opts.Metadata.Namespace = "gloo-system"
+			opts.Top.File = filepath.Join(dir, "bundle.tgz")
+			file, err := DebugBundle(&opts, kubeCli, ioutil.Discard)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file).To(Equal(opts.Top.File))
+			Expect(kubeCli.Next).To(Equal(len(cmds)))
+
+			fs := afero.NewOsFs()
+			untarred := filepath.Join(dir, "untarred")
+			Expect(tarutils.Untar(untarred, file, fs)).NotTo(HaveOccurred())
+			readFile := func(path string) string {
+				b, err := ioutil.ReadFile(filepath.Join(untarred, "bundle", path))
+				Expect(err).NotTo(HaveOccurred())
+				return string(b)
+			}
+
+			settings := readFile("resources/settings.gloo.solo.io.yaml")
+			Expect(settings).To(ContainSubstring("http://vault:8200"))
+			Expect(settings).NotTo(ContainSubstring("vault-token"))
+			Expect(readFile("resources/upstreams.gloo.solo.io.yaml")).To(ContainSubstring("name: petstore"))
+
+			routeOptions := readFile("resources/routeoptions.gateway.solo.io.yaml")
+			Expect(routeOptions).To(ContainSubstring("password: '" + syncutil.Redacted + "'"))
+			Expect(routeOptions).NotTo(ContainSubstring("pw"))
+			Expect(readFile("kube/daemonset.yaml")).To(Equal("items: []\n"))
+
+			Expect(readFile(bundleErrorsFile)).To(HavePrefix("collecting kube/deployment.yaml"))
+		})
+
+		It("redacts the TLS keys packed in the xDS listeners", func() {
+			tlsContext, err := ptypes.MarshalAny(&envoyauth.DownstreamTlsContext{
+				CommonTlsContext: &envoyauth.CommonTlsContext{
+					TlsCertificates: []*envoyauth.TlsCertificate{{
+						CertificateChain: &envoycore.DataSource{Specifier: &envoycore.DataSource_InlineString{InlineString: "cert"}},
+						PrivateKey:       &envoycore.DataSource{Specifier: &envoycore.DataSource_InlineString{InlineString: "RSA PRIVATE KEY CONTENT"}},
+					}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			dump := &xdsinspection.XdsDump{
+				Role: "gloo-system~gateway-proxy",
+				Listeners: []envoyapi.Listener{{
+					Name: "listener-::-8443",
+					FilterChains: []*envoylistener.FilterChain{{
+						TransportSocket: &envoycore.TransportSocket{
+							Name:       wellknown.TransportSocketTls,
+							ConfigType: &envoycore.TransportSocket_TypedConfig{TypedConfig: tlsContext},
+						},
+					}},
+				}},
+			}
+
+			out, err := redactedXdsDumpYaml(dump)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("role: gloo-system~gateway-proxy"))
+			Expect(string(out)).To(ContainSubstring("inlineString: cert"))
+			Expect(string(out)).NotTo(ContainSubstring("RSA PRIVATE KEY CONTENT"))
+		})
+	})
 })
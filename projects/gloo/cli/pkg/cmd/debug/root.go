@@ -3,6 +3,7 @@ package debug
 import (
 	"os"
 
+	"github.com/solo-io/gloo/pkg/cliutil/install"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
@@ -21,6 +22,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 
 	cmd.AddCommand(DebugLogCmd(opts))
 	cmd.AddCommand(DebugYamlCmd(opts))
+	cmd.AddCommand(DebugBundleCmd(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
@@ -59,3 +61,23 @@ func DebugYamlCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *c
 
 	return cmd
 }
+
+func DebugBundleCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.DEBUG_BUNDLE_COMMAND.Use,
+		Short: constants.DEBUG_BUNDLE_COMMAND.Short,
+		Long:  constants.DEBUG_BUNDLE_COMMAND.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, err := DebugBundle(opts, &install.CmdKubectl{}, os.Stdout)
+			return err
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	pflags.StringVarP(&opts.Top.File, flagutils.FileFlag, "f", "",
+		"path of the tarball to write, defaults to gloo-bundle-<timestamp>.tgz in the current directory")
+	flagutils.AddNamespaceFlag(pflags, &opts.Metadata.Namespace)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+
+	return cmd
+}
@@ -50,6 +50,11 @@ func init() {
 		"upstreamgroups.gloo.solo.io",
 		"virtualservices.gateway.solo.io",
 		"routetables.gateway.solo.io",
+		"routeoptions.gateway.solo.io",
+		"virtualhostoptions.gateway.solo.io",
+		"httpgateways.gateway.solo.io",
+		"referencepolicies.gateway.solo.io",
+		"canaries.gateway.solo.io",
 		"authconfigs.enterprise.gloo.solo.io",
 	}
 
@@ -30,6 +30,9 @@ type Options struct {
 	Istio     Istio
 	Remove    Remove
 	Cluster   Cluster
+	Render    Render
+	Check     Check
+	Apply     Apply
 }
 
 type Top struct {
@@ -118,6 +121,33 @@ type Edit struct {
 }
 
 type Route struct {
+	Test RouteTest
+}
+
+type RouteTest struct {
+	Method          string
+	Host            string
+	Path            string
+	Headers         []string
+	QueryParameters []string
+	Files           []string
+	ProxyNamespace  string
+}
+
+type Render struct {
+	Files  []string
+	Output string
+}
+
+type Apply struct {
+	Files []string
+	Plan  bool
+}
+
+type Check struct {
+	Only   []string
+	Output string
+	List   bool
 }
 
 type Consul struct {
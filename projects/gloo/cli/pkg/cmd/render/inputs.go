@@ -0,0 +1,265 @@
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/rotisserie/eris"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	kubeconverters "github.com/solo-io/gloo/projects/gloo/pkg/api/converters/kube"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+var kubeSecretGVK = kubev1.SchemeGroupVersion.WithKind("Secret")
+
+var (
+	MultipleSettingsErr = eris.New("at most one Settings resource can be rendered")
+	InvalidResourceErr  = func(err error, source string, index int) error {
+		return eris.Wrapf(err, "invalid resource %d of %v", index, source)
+	}
+)
+
+// The resources read by glooctl render, with the Settings to render them with.
+type Inputs struct {
+	Gateways           gatewayv1.GatewayList
+	VirtualServices    gatewayv1.VirtualServiceList
+	RouteTables        gatewayv1.RouteTableList
+	RouteOptions       gatewayv1.RouteOptionList
+	VirtualHostOptions gatewayv1.VirtualHostOptionList
+	HttpGateways       gatewayv1.MatchableHttpGatewayList
+	ReferencePolicies  gatewayv1.ReferencePolicyList
+	Upstreams          gloov1.UpstreamList
+	UpstreamGroups     gloov1.UpstreamGroupList
+	Secrets            gloov1.SecretList
+	Settings           *gloov1.Settings
+
+	// The kube resources which are not Gloo resources, as "<kind> <namespace>.<name>"
+	Ignored []string
+}
+
+type kubeResource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Items             []json.RawMessage `json:"items,omitempty"`
+}
+
+// Reads the kube resources in the files, the yaml files of the directories, or stdin for "-".
+// Resources without a namespace are put in the given namespace.
+func ReadInputs(paths []string, stdin io.Reader, namespace string) (*Inputs, error) {
+	inputs := &Inputs{}
+	for _, path := range paths {
+		if path == "-" {
+			if err := inputs.read("stdin", stdin, namespace); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		files, err := yamlFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			f, err := os.Open(file)
+			if err != nil {
+				return nil, err
+			}
+			err = inputs.read(file, f, namespace)
+			f.Close()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return inputs, nil
+}
+
+// Returns the path if it is a file, or the yaml files under the path if it is a directory.
+func yamlFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	var files []string
+	err = filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		switch filepath.Ext(file) {
+		case ".yaml", ".yml", ".json":
+			if !info.IsDir() {
+				files = append(files, file)
+			}
+		}
+		return nil
+	})
+	sort.Strings(files)
+	return files, err
+}
+
+func (i *Inputs) read(source string, r io.Reader, namespace string) error {
+	reader := kubeyaml.NewYAMLReader(bufio.NewReader(r))
+	for index := 0; ; index++ {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return eris.Wrapf(err, "reading %v", source)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		jsn, err := yaml.YAMLToJSON(doc)
+		if err != nil {
+			return InvalidResourceErr(err, source, index)
+		}
+		if err := i.add(jsn, namespace); err != nil {
+			return InvalidResourceErr(err, source, index)
+		}
+	}
+}
+
+func (i *Inputs) add(jsn []byte, namespace string) error {
+	if string(bytes.TrimSpace(jsn)) == "null" {
+		return nil
+	}
+	var res kubeResource
+	if err := json.Unmarshal(jsn, &res); err != nil {
+		return err
+	}
+	if res.Kind == "List" {
+		for _, item := range res.Items {
+			if err := i.add(item, namespace); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var resource resources.Resource
+	if res.GroupVersionKind() == kubeSecretGVK {
+		secret, err := secretFromKube(jsn)
+		if err != nil {
+			return err
+		}
+		resource = secret
+	} else if resource = newResource(res.GroupVersionKind()); resource != nil {
+		if err := protoutils.UnmarshalResource(jsn, resource); err != nil {
+			return err
+		}
+	}
+	if resource == nil {
+		i.Ignored = append(i.Ignored, res.Kind+" "+strings.TrimPrefix(res.Namespace+"."+res.Name, "."))
+		return nil
+	}
+	if resource.GetMetadata().Namespace == "" {
+		metadata := resource.GetMetadata()
+		metadata.Namespace = namespace
+		resource.SetMetadata(metadata)
+	}
+	return i.AddResource(resource)
+}
+
+// Adds the Gloo resource to the inputs. Resources of other types are ignored.
+func (i *Inputs) AddResource(resource resources.Resource) error {
+	switch typed := resource.(type) {
+	case *gatewayv1.Gateway:
+		i.Gateways = append(i.Gateways, typed)
+	case *gatewayv1.VirtualService:
+		i.VirtualServices = append(i.VirtualServices, typed)
+	case *gatewayv1.RouteTable:
+		i.RouteTables = append(i.RouteTables, typed)
+	case *gatewayv1.RouteOption:
+		i.RouteOptions = append(i.RouteOptions, typed)
+	case *gatewayv1.VirtualHostOption:
+		i.VirtualHostOptions = append(i.VirtualHostOptions, typed)
+	case *gatewayv1.MatchableHttpGateway:
+		i.HttpGateways = append(i.HttpGateways, typed)
+	case *gatewayv1.ReferencePolicy:
+		i.ReferencePolicies = append(i.ReferencePolicies, typed)
+	case *gloov1.Upstream:
+		i.Upstreams = append(i.Upstreams, typed)
+	case *gloov1.UpstreamGroup:
+		i.UpstreamGroups = append(i.UpstreamGroups, typed)
+	case *gloov1.Secret:
+		i.Secrets = append(i.Secrets, typed)
+	case *gloov1.Settings:
+		if i.Settings != nil {
+			return MultipleSettingsErr
+		}
+		i.Settings = typed
+	}
+	return nil
+}
+
+// Converts the kube Secret like the secret clients of Gloo do. Returns nil for the Secrets that Gloo does not use.
+func secretFromKube(jsn []byte) (resources.Resource, error) {
+	var secret kubev1.Secret
+	if err := json.Unmarshal(jsn, &secret); err != nil {
+		return nil, err
+	}
+	// the API server merges the string data into the data
+	for key, value := range secret.StringData {
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[key] = []byte(value)
+	}
+	return kubeconverters.GlooSecretConverterChain.FromKubeSecret(context.Background(), nil, &secret)
+}
+
+func newResource(gvk schema.GroupVersionKind) resources.Resource {
+	switch gvk {
+	case gatewayv1.GatewayGVK:
+		return &gatewayv1.Gateway{}
+	case gatewayv1.VirtualServiceGVK:
+		return &gatewayv1.VirtualService{}
+	case gatewayv1.RouteTableGVK:
+		return &gatewayv1.RouteTable{}
+	case gatewayv1.RouteOptionGVK:
+		return &gatewayv1.RouteOption{}
+	case gatewayv1.VirtualHostOptionGVK:
+		return &gatewayv1.VirtualHostOption{}
+	case gatewayv1.MatchableHttpGatewayGVK:
+		return &gatewayv1.MatchableHttpGateway{}
+	case gatewayv1.ReferencePolicyGVK:
+		return &gatewayv1.ReferencePolicy{}
+	case gloov1.UpstreamGVK:
+		return &gloov1.Upstream{}
+	case gloov1.UpstreamGroupGVK:
+		return &gloov1.UpstreamGroup{}
+	case gloov1.SettingsGVK:
+		return &gloov1.Settings{}
+	}
+	return nil
+}
+
+// The Settings read, or the default Settings of an installation in the namespace.
+func (i *Inputs) settingsOrDefault(namespace string) *gloov1.Settings {
+	if i.Settings != nil {
+		return i.Settings
+	}
+	return &gloov1.Settings{
+		Metadata: core.Metadata{Name: "default", Namespace: namespace},
+		Gloo:     &gloov1.GlooOptions{},
+		Gateway:  &gloov1.GatewayOptions{},
+	}
+}
@@ -0,0 +1,407 @@
+package render
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/settingsutil"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gatewaydefaults "github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	gwtranslator "github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	gwutils "github.com/solo-io/gloo/projects/gateway/pkg/utils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+)
+
+const (
+	OutputYaml = "yaml"
+	OutputJson = "json"
+
+	// The certificate chain and private key of the Secrets that are referenced but missing from the inputs.
+	StubSecretValue = "stubbed by glooctl render"
+)
+
+var (
+	UnknownOutputError = func(output string) error {
+		return eris.Errorf("unknown output format %v, must be %v or %v", output, OutputYaml, OutputJson)
+	}
+	RejectedResourcesError = func(count int) error {
+		return eris.Errorf("%d resources were rejected", count)
+	}
+	StubbedSecretWarning = func(ref core.ResourceRef) string {
+		return fmt.Sprintf("secret %v is not in the inputs, so a placeholder TLS secret was rendered in its place", ref.Key())
+	}
+)
+
+// The Proxies and Envoy config rendered from the inputs, with the reports of the inputs and Proxies.
+type Result struct {
+	Proxies []*RenderedProxy
+	Reports reporter.ResourceReports
+}
+
+type RenderedProxy struct {
+	Proxy     *gloov1.Proxy
+	Listeners []*envoyapi.Listener
+	Routes    []*envoyapi.RouteConfiguration
+	Clusters  []*envoyapi.Cluster
+	Endpoints []*envoyapi.ClusterLoadAssignment
+}
+
+// Runs the gateway translator and the Gloo translator on the inputs, like the gateway and gloo pods would.
+// Gateways are only read from the given namespace, unless the Settings say otherwise. Without Gateways, the default
+// Gateways of an installation are used.
+func Render(ctx context.Context, inputs *Inputs, namespace string) (*Result, error) {
+	settings := inputs.settingsOrDefault(namespace)
+	ctx = settingsutil.WithSettings(ctx, settings)
+
+	proxies, reports := TranslateProxies(ctx, inputs, namespace)
+	result := &Result{Reports: reports}
+
+	secrets, stubbed := stubMissingSecrets(inputs)
+	glooSnap := &gloov1.ApiSnapshot{
+		Proxies:        proxies,
+		Upstreams:      inputs.Upstreams,
+		UpstreamGroups: inputs.UpstreamGroups,
+		Secrets:        secrets,
+	}
+	// rendering does not read any resource from clients, but some plugins require their factories
+	memoryClientFactory := &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()}
+	getPlugins := func() []plugins.Plugin {
+		return registry.Plugins(bootstrap.Opts{
+			Settings:  settings,
+			Secrets:   memoryClientFactory,
+			Upstreams: memoryClientFactory,
+		})
+	}
+	glooTranslator := translator.NewTranslator(utils.NewSslConfigTranslator(), settings, getPlugins)
+	for _, proxy := range proxies {
+		xdsSnap, reports, _, err := glooTranslator.Translate(plugins.Params{Ctx: ctx, Snapshot: glooSnap}, proxy)
+		if err != nil {
+			return nil, eris.Wrapf(err, "translating proxy %v", proxy.Metadata.Ref().Key())
+		}
+		result.Reports.Merge(reports)
+		rendered, err := renderProxy(proxy, xdsSnap)
+		if err != nil {
+			return nil, err
+		}
+		result.Proxies = append(result.Proxies, rendered)
+	}
+	for _, stub := range stubbed {
+		result.Reports.AddWarning(stub.resource, StubbedSecretWarning(stub.secret))
+	}
+	return result, nil
+}
+
+type stubbedSecret struct {
+	resource resources.InputResource
+	secret   core.ResourceRef
+}
+
+// Secrets are often left out of the rendered manifests, so the secrets that the SSL configs of the inputs reference but
+// that are missing from the inputs are replaced with placeholder TLS secrets, rather than rejecting the resources
+// referencing them. Returns the secrets of the inputs with the placeholders, and the references that were stubbed.
+func stubMissingSecrets(inputs *Inputs) (gloov1.SecretList, []stubbedSecret) {
+	secrets := append(gloov1.SecretList{}, inputs.Secrets...)
+	var stubbed []stubbedSecret
+	addRef := func(resource resources.InputResource, ref *core.ResourceRef) {
+		if ref == nil {
+			return
+		}
+		if _, err := inputs.Secrets.Find(ref.Strings()); err == nil {
+			return
+		}
+		// a placeholder is added once, but each resource referencing it gets a warning
+		if _, err := secrets.Find(ref.Strings()); err != nil {
+			secrets = append(secrets, &gloov1.Secret{
+				Metadata: core.Metadata{Name: ref.Name, Namespace: ref.Namespace},
+				Kind: &gloov1.Secret_Tls{
+					Tls: &gloov1.TlsSecret{CertChain: StubSecretValue, PrivateKey: StubSecretValue},
+				},
+			})
+		}
+		stubbed = append(stubbed, stubbedSecret{resource: resource, secret: *ref})
+	}
+	addTcpHosts := func(resource resources.InputResource, tcpGateway *gatewayv1.TcpGateway) {
+		for _, tcpHost := range tcpGateway.GetTcpHosts() {
+			addRef(resource, tcpHost.GetSslConfig().GetSecretRef())
+		}
+	}
+
+	for _, gateway := range inputs.Gateways {
+		addTcpHosts(gateway, gateway.GetTcpGateway())
+		for _, matchedGateway := range gateway.GetHybridGateway().GetMatchedGateways() {
+			addRef(gateway, matchedGateway.GetMatcher().GetSslConfig().GetSecretRef())
+			addTcpHosts(gateway, matchedGateway.GetTcpGateway())
+		}
+	}
+	for _, virtualService := range inputs.VirtualServices {
+		addRef(virtualService, virtualService.GetSslConfig().GetSecretRef())
+	}
+	for _, httpGateway := range inputs.HttpGateways {
+		addRef(httpGateway, httpGateway.GetMatcher().GetSslConfig().GetSecretRef())
+	}
+	for _, upstream := range inputs.Upstreams {
+		addRef(upstream, upstream.GetSslConfig().GetSecretRef())
+	}
+	return secrets, stubbed
+}
+
+// Runs the gateway translator on the inputs, and returns the Proxies with the reports of the gateway resources.
+func TranslateProxies(ctx context.Context, inputs *Inputs, namespace string) (gloov1.ProxyList, reporter.ResourceReports) {
+	settings := inputs.settingsOrDefault(namespace)
+	ctx = settingsutil.WithSettings(ctx, settings)
+
+	gateways := inputs.Gateways
+	if len(gateways) == 0 {
+		gateways = gatewayv1.GatewayList{
+			gatewaydefaults.DefaultGateway(namespace),
+			gatewaydefaults.DefaultSslGateway(namespace),
+		}
+	}
+	gatewaySnap := &gatewayv1.ApiSnapshot{
+		Gateways:           gateways,
+		VirtualServices:    inputs.VirtualServices,
+		RouteTables:        inputs.RouteTables,
+		RouteOptions:       inputs.RouteOptions,
+		VirtualHostOptions: inputs.VirtualHostOptions,
+		HttpGateways:       inputs.HttpGateways,
+		ReferencePolicies:  inputs.ReferencePolicies,
+	}
+	gatewayTranslator := gwtranslator.NewDefaultTranslator(gwtranslator.Opts{
+		WriteNamespace:                namespace,
+		ReadGatewaysFromAllNamespaces: settings.GetGateway().GetReadGatewaysFromAllNamespaces(),
+		DenyCrossNamespaceReferences:  settings.GetGateway().GetDenyCrossNamespaceReferences(),
+	})
+
+	allReports := reporter.ResourceReports{}
+	gatewaysByProxy := gwutils.GatewaysByProxyName(gatewaySnap.Gateways)
+	var proxyNames []string
+	for proxyName := range gatewaysByProxy {
+		proxyNames = append(proxyNames, proxyName)
+	}
+	sort.Strings(proxyNames)
+	var proxies gloov1.ProxyList
+	for _, proxyName := range proxyNames {
+		proxy, reports := gatewayTranslator.Translate(ctx, proxyName, namespace, gatewaySnap, gatewaysByProxy[proxyName])
+		allReports.Merge(reports)
+		if proxy != nil {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies, allReports
+}
+
+func renderProxy(proxy *gloov1.Proxy, xdsSnap envoycache.Snapshot) (*RenderedProxy, error) {
+	rendered := &RenderedProxy{Proxy: proxy}
+	for _, typ := range []string{xds.ListenerType, xds.RouteType, xds.ClusterType, xds.EndpointType} {
+		messages, err := sortedResources(xdsSnap.GetResources(typ))
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range messages {
+			switch typed := msg.(type) {
+			case *envoyapi.Listener:
+				rendered.Listeners = append(rendered.Listeners, typed)
+			case *envoyapi.RouteConfiguration:
+				rendered.Routes = append(rendered.Routes, typed)
+			case *envoyapi.Cluster:
+				rendered.Clusters = append(rendered.Clusters, typed)
+			case *envoyapi.ClusterLoadAssignment:
+				rendered.Endpoints = append(rendered.Endpoints, typed)
+			}
+		}
+	}
+	return rendered, nil
+}
+
+// Returns the resources sorted by name, so that renders of the same inputs can be diffed.
+func sortedResources(in envoycache.Resources) ([]proto.Message, error) {
+	var names []string
+	for name := range in.Items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var out []proto.Message
+	for _, name := range names {
+		msg := in.Items[name].ResourceProto()
+		if anyMsg, ok := msg.(*any.Any); ok {
+			var err error
+			if msg, err = utils.AnyToMessage(anyMsg); err != nil {
+				return nil, err
+			}
+		}
+		out = append(out, msg)
+	}
+	return out, nil
+}
+
+type printedProxy struct {
+	Proxy     map[string]interface{}   `json:"proxy"`
+	Listeners []map[string]interface{} `json:"listeners"`
+	Routes    []map[string]interface{} `json:"routeConfigurations"`
+	Clusters  []map[string]interface{} `json:"clusters"`
+	Endpoints []map[string]interface{} `json:"endpoints"`
+}
+
+type printedReport struct {
+	Resource string   `json:"resource"`
+	State    string   `json:"state"`
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+type printedResult struct {
+	Proxies []printedProxy  `json:"proxies"`
+	Reports []printedReport `json:"reports"`
+}
+
+// Prints the result as yaml or json. Returns an error after printing it if any resource was rejected.
+func PrintResult(w io.Writer, result *Result, output string) error {
+	if output != OutputYaml && output != OutputJson {
+		return UnknownOutputError(output)
+	}
+
+	printed := printedResult{Proxies: []printedProxy{}, Reports: []printedReport{}}
+	for _, proxy := range result.Proxies {
+		p, err := printProxy(proxy)
+		if err != nil {
+			return err
+		}
+		printed.Proxies = append(printed.Proxies, p)
+	}
+	rejected := 0
+	for _, res := range sortedReportResources(result.Reports) {
+		report := result.Reports[res]
+		printedReport := printedReport{
+			Resource: fmt.Sprintf("%v %v", kindName(res), res.GetMetadata().Ref().Key()),
+			State:    core.Status_Accepted.String(),
+			Warnings: report.Warnings,
+		}
+		if report.Errors != nil {
+			printedReport.State = core.Status_Rejected.String()
+			printedReport.Errors = errorStrings(report.Errors)
+			rejected++
+		} else if len(report.Warnings) > 0 {
+			printedReport.State = core.Status_Warning.String()
+		}
+		printed.Reports = append(printed.Reports, printedReport)
+	}
+
+	out, err := json.MarshalIndent(printed, "", "  ")
+	if err != nil {
+		return err
+	}
+	if output == OutputYaml {
+		if out, err = yaml.JSONToYAML(out); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintln(w, string(bytes.TrimSpace(out))); err != nil {
+		return err
+	}
+	if rejected > 0 {
+		return RejectedResourcesError(rejected)
+	}
+	return nil
+}
+
+func printProxy(proxy *RenderedProxy) (printedProxy, error) {
+	var printed printedProxy
+	var err error
+	if printed.Proxy, err = protoutils.MarshalMap(proxy.Proxy); err != nil {
+		return printed, err
+	}
+	for _, listener := range proxy.Listeners {
+		m, err := envoyToMap(listener)
+		if err != nil {
+			return printed, err
+		}
+		printed.Listeners = append(printed.Listeners, m)
+	}
+	for _, route := range proxy.Routes {
+		m, err := envoyToMap(route)
+		if err != nil {
+			return printed, err
+		}
+		printed.Routes = append(printed.Routes, m)
+	}
+	for _, cluster := range proxy.Clusters {
+		m, err := envoyToMap(cluster)
+		if err != nil {
+			return printed, err
+		}
+		printed.Clusters = append(printed.Clusters, m)
+	}
+	for _, endpoints := range proxy.Endpoints {
+		m, err := envoyToMap(endpoints)
+		if err != nil {
+			return printed, err
+		}
+		printed.Endpoints = append(printed.Endpoints, m)
+	}
+	return printed, nil
+}
+
+func envoyToMap(msg proto.Message) (map[string]interface{}, error) {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	err := json.Unmarshal(buf.Bytes(), &m)
+	return m, err
+}
+
+func sortedReportResources(reports reporter.ResourceReports) []resources.InputResource {
+	var out []resources.InputResource
+	for res := range reports {
+		out = append(out, res)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if kindI, kindJ := kindName(out[i]), kindName(out[j]); kindI != kindJ {
+			return kindI < kindJ
+		}
+		return out[i].GetMetadata().Ref().Key() < out[j].GetMetadata().Ref().Key()
+	})
+	return out
+}
+
+func kindName(res resources.Resource) string {
+	return reflect.TypeOf(res).Elem().Name()
+}
+
+func errorStrings(err error) []string {
+	multiErr, ok := err.(*multierror.Error)
+	if !ok {
+		return []string{err.Error()}
+	}
+	var out []string
+	for _, err := range multiErr.Errors {
+		out = append(out, errorStrings(err)...)
+	}
+	return out
+}
@@ -0,0 +1,250 @@
+package render_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/render"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const upstreamYaml = `
+apiVersion: gloo.solo.io/v1
+kind: Upstream
+metadata:
+  name: petstore
+spec:
+  static:
+    hosts:
+    - addr: petstore.example.com
+      port: 8080
+`
+
+const virtualServiceYaml = `
+apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: petstore
+  namespace: gloo-system
+spec:
+  virtualHost:
+    domains:
+    - petstore.example.com
+    routes:
+    - matchers:
+      - prefix: /api
+      routeAction:
+        single:
+          upstream:
+            name: %s
+            namespace: gloo-system
+`
+
+const deploymentYaml = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: petstore
+  namespace: default
+`
+
+const secretYaml = `
+apiVersion: v1
+kind: Secret
+type: kubernetes.io/tls
+metadata:
+  name: petstore-tls
+stringData:
+  tls.crt: cert
+  tls.key: key
+`
+
+const opaqueSecretYaml = `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: opaque
+  namespace: default
+data:
+  password: cGFzc3dvcmQ=
+`
+
+func sslVirtualService(upstream string) string {
+	return virtualService(upstream) + `
+  sslConfig:
+    secretRef:
+      name: petstore-tls
+      namespace: gloo-system
+`
+}
+
+func virtualService(upstream string) string {
+	return strings.Replace(virtualServiceYaml, "%s", upstream, 1)
+}
+
+var _ = Describe("Render", func() {
+
+	var (
+		ctx context.Context
+		dir string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		dir, err = ioutil.TempDir("", "render")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	writeFile := func(name string, docs ...string) string {
+		path := filepath.Join(dir, name)
+		err := ioutil.WriteFile(path, []byte(strings.Join(docs, "\n---\n")), 0644)
+		Expect(err).NotTo(HaveOccurred())
+		return path
+	}
+
+	Context("reading inputs", func() {
+
+		It("reads the gloo resources of files and directories", func() {
+			writeFile("upstream.yaml", upstreamYaml)
+			writeFile("ignored.txt", deploymentYaml)
+			file := filepath.Join(dir, "vs.yml")
+			Expect(ioutil.WriteFile(file, []byte(virtualService("petstore")+"\n---\n"+deploymentYaml), 0644)).To(Succeed())
+
+			inputs, err := ReadInputs([]string{dir}, nil, "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputs.Upstreams).To(HaveLen(1))
+			Expect(inputs.Upstreams[0].Metadata.Ref()).To(Equal(core.ResourceRef{Name: "petstore", Namespace: "gloo-system"}))
+			Expect(inputs.Upstreams[0].GetStatic().GetHosts()[0].GetAddr()).To(Equal("petstore.example.com"))
+			Expect(inputs.VirtualServices).To(HaveLen(1))
+			Expect(inputs.Ignored).To(ConsistOf("Deployment default.petstore"))
+		})
+
+		It("reads lists from stdin", func() {
+			list := map[string]interface{}{"apiVersion": "v1", "kind": "List", "items": []interface{}{}}
+			for _, doc := range []string{upstreamYaml, virtualService("petstore")} {
+				var item map[string]interface{}
+				Expect(yaml.Unmarshal([]byte(doc), &item)).To(Succeed())
+				list["items"] = append(list["items"].([]interface{}), item)
+			}
+			listYaml, err := yaml.Marshal(list)
+			Expect(err).NotTo(HaveOccurred())
+
+			inputs, err := ReadInputs([]string{"-"}, bytes.NewReader(listYaml), "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputs.Upstreams).To(HaveLen(1))
+			Expect(inputs.VirtualServices).To(HaveLen(1))
+		})
+
+		It("reads the secrets that gloo uses", func() {
+			file := writeFile("secrets.yaml", secretYaml, opaqueSecretYaml)
+			inputs, err := ReadInputs([]string{file}, nil, "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputs.Secrets).To(HaveLen(1))
+			Expect(inputs.Secrets[0].Metadata.Ref()).To(Equal(core.ResourceRef{Name: "petstore-tls", Namespace: "gloo-system"}))
+			Expect(inputs.Secrets[0].GetTls().GetCertChain()).To(Equal("cert"))
+			Expect(inputs.Secrets[0].GetTls().GetPrivateKey()).To(Equal("key"))
+			Expect(inputs.Ignored).To(ConsistOf("Secret default.opaque"))
+		})
+
+		It("errors on invalid resources", func() {
+			file := writeFile("upstream.yaml", strings.Replace(upstreamYaml, "static:", "unknownType:", 1))
+			_, err := ReadInputs([]string{file}, nil, "gloo-system")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid resource 0 of " + file))
+		})
+	})
+
+	Context("rendering", func() {
+
+		render := func(docs ...string) (*Result, string, error) {
+			inputs, err := ReadInputs([]string{writeFile("resources.yaml", docs...)}, nil, "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			result, err := Render(ctx, inputs, "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			var out bytes.Buffer
+			err = PrintResult(&out, result, OutputYaml)
+			return result, out.String(), err
+		}
+
+		It("renders the proxy and the envoy config of the default gateways", func() {
+			result, out, err := render(upstreamYaml, virtualService("petstore"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Proxies).To(HaveLen(1))
+			proxy := result.Proxies[0]
+			Expect(proxy.Proxy.Metadata.Ref()).To(Equal(core.ResourceRef{Name: "gateway-proxy", Namespace: "gloo-system"}))
+			Expect(proxy.Listeners).To(HaveLen(1))
+			Expect(proxy.Routes).To(HaveLen(1))
+			Expect(proxy.Routes[0].GetVirtualHosts()[0].GetDomains()).To(ConsistOf("petstore.example.com"))
+			Expect(proxy.Clusters).To(HaveLen(1))
+			Expect(proxy.Clusters[0].GetName()).To(Equal("petstore_gloo-system"))
+
+			Expect(out).To(ContainSubstring("routeConfigurations:"))
+			Expect(out).To(ContainSubstring("resource: VirtualService gloo-system.petstore"))
+			Expect(out).NotTo(ContainSubstring("Rejected"))
+		})
+
+		It("reports the rejected resources and fails", func() {
+			conflicting := strings.Replace(virtualService("petstore"), "name: petstore\n  namespace", "name: conflicting\n  namespace", 1)
+			result, out, err := render(upstreamYaml, virtualService("petstore"), conflicting)
+			Expect(err).To(MatchError(RejectedResourcesError(4)), out)
+
+			Expect(result.Reports).NotTo(BeEmpty())
+			Expect(out).To(ContainSubstring("state: Rejected"))
+			Expect(out).To(ContainSubstring("resource: VirtualService gloo-system.conflicting"))
+			Expect(out).To(ContainSubstring("domain conflict"))
+		})
+
+		It("reports warnings", func() {
+			_, out, err := render(upstreamYaml, virtualService("missing"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(ContainSubstring("state: Warning"))
+			Expect(out).To(ContainSubstring("missing"))
+		})
+
+		It("renders the ssl config of the secrets of the inputs", func() {
+			result, out, err := render(upstreamYaml, sslVirtualService("petstore"), secretYaml)
+			Expect(err).NotTo(HaveOccurred(), out)
+			Expect(out).NotTo(ContainSubstring("Warning"))
+
+			Expect(result.Proxies).To(HaveLen(1))
+			Expect(result.Proxies[0].Listeners).To(HaveLen(1))
+			Expect(out).To(ContainSubstring("inlineString: cert"))
+		})
+
+		It("stubs the missing secrets with a warning", func() {
+			result, out, err := render(upstreamYaml, sslVirtualService("petstore"))
+			Expect(err).NotTo(HaveOccurred(), out)
+
+			Expect(result.Proxies).To(HaveLen(1))
+			Expect(result.Proxies[0].Listeners).To(HaveLen(1))
+			Expect(out).To(ContainSubstring("inlineString: " + StubSecretValue))
+			Expect(out).To(ContainSubstring("state: Warning"))
+			Expect(out).To(ContainSubstring(StubbedSecretWarning(core.ResourceRef{Name: "petstore-tls", Namespace: "gloo-system"})))
+		})
+
+		It("prints json", func() {
+			inputs, err := ReadInputs([]string{writeFile("resources.yaml", upstreamYaml, virtualService("petstore"))}, nil, "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			result, err := Render(ctx, inputs, "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+
+			var out bytes.Buffer
+			Expect(PrintResult(&out, result, OutputJson)).To(Succeed())
+			Expect(out.String()).To(HavePrefix("{"))
+			Expect(PrintResult(&out, result, "table")).To(MatchError(UnknownOutputError("table")))
+		})
+	})
+})
@@ -0,0 +1,46 @@
+package render
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     constants.RENDER_COMMAND.Use,
+		Aliases: constants.RENDER_COMMAND.Aliases,
+		Short:   constants.RENDER_COMMAND.Short,
+		Long:    constants.RENDER_COMMAND.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(opts.Render.Files) == 0 {
+				return fmt.Errorf("at least one file must be provided with --%v", flagutils.FileFlag)
+			}
+			inputs, err := ReadInputs(opts.Render.Files, os.Stdin, opts.Metadata.Namespace)
+			if err != nil {
+				return err
+			}
+			for _, ignored := range inputs.Ignored {
+				fmt.Fprintf(os.Stderr, "ignoring %v: not a Gloo resource\n", ignored)
+			}
+			result, err := Render(opts.Top.Ctx, inputs, opts.Metadata.Namespace)
+			if err != nil {
+				return err
+			}
+			return PrintResult(os.Stdout, result, opts.Render.Output)
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	pflags.StringSliceVarP(&opts.Render.Files, flagutils.FileFlag, "f", nil,
+		"files or directories of resources to render, or - for stdin (can be repeated)")
+	pflags.StringVarP(&opts.Render.Output, flagutils.OutputFlag, "o", OutputYaml, "output format: (yaml, json)")
+	flagutils.AddNamespaceFlag(pflags, &opts.Metadata.Namespace)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
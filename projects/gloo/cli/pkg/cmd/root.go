@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/apply"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/dashboard"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/debug"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/demo"
@@ -22,6 +23,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/get"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/install"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/remove"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/render"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/route"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/upgrade"
 	versioncmd "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/version"
@@ -101,6 +103,8 @@ func GlooCli() *cobra.Command {
 			add.RootCmd(opts),
 			remove.RootCmd(opts),
 			route.RootCmd(opts),
+			render.RootCmd(opts),
+			apply.RootCmd(opts),
 			create.RootCmd(opts),
 			edit.RootCmd(opts),
 			upgrade.RootCmd(opts),
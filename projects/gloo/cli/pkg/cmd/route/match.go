@@ -0,0 +1,367 @@
+package route
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+)
+
+var (
+	InvalidHeaderErr = func(header string) error {
+		return errors.Errorf("invalid header %v, must be name:value", header)
+	}
+	InvalidQueryParameterErr = func(param string) error {
+		return errors.Errorf("invalid query parameter %v, must be name=value", param)
+	}
+)
+
+// A request to match against the routes of a virtual host, the way Envoy does.
+type Request struct {
+	Method string
+	Host   string
+	// Without the query string
+	Path string
+	// By lowercase name
+	Headers map[string]string
+	Query   url.Values
+}
+
+// Builds a request from the headers as "name:value" and the query parameters as "name=value". The path may include a
+// query string.
+func NewRequest(method, host, path string, headers, queryParameters []string) (*Request, error) {
+	if path == "" {
+		path = "/"
+	}
+	req := &Request{
+		Method:  strings.ToUpper(method),
+		Host:    host,
+		Path:    path,
+		Headers: map[string]string{},
+		Query:   url.Values{},
+	}
+	if i := strings.Index(path, "?"); i >= 0 {
+		query, err := url.ParseQuery(path[i+1:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing the query of %v", path)
+		}
+		req.Path = path[:i]
+		req.Query = query
+	}
+	for _, header := range headers {
+		i := strings.Index(header, ":")
+		if i <= 0 {
+			return nil, InvalidHeaderErr(header)
+		}
+		req.Headers[strings.ToLower(strings.TrimSpace(header[:i]))] = strings.TrimSpace(header[i+1:])
+	}
+	for _, param := range queryParameters {
+		i := strings.Index(param, "=")
+		if i <= 0 {
+			return nil, InvalidQueryParameterErr(param)
+		}
+		req.Query.Add(param[:i], param[i+1:])
+	}
+	return req, nil
+}
+
+// Returns the value of the header, including the pseudo headers Envoy derives from the request line.
+func (r *Request) header(name string) (string, bool) {
+	switch name = strings.ToLower(name); name {
+	case ":method":
+		return r.Method, r.Method != ""
+	case ":authority", "host":
+		return r.Host, r.Host != ""
+	case ":path":
+		if len(r.Query) > 0 {
+			return r.Path + "?" + r.Query.Encode(), true
+		}
+		return r.Path, true
+	}
+	value, ok := r.Headers[name]
+	return value, ok
+}
+
+// A route of the virtual host matched by the request.
+type RouteMatch struct {
+	// The position of the route in the virtual host
+	Index   int
+	Route   *gloov1.Route
+	Matcher *matchers.Matcher
+}
+
+// Whether the route only matches a share of the requests, so that the next matching routes may match the request too.
+func (m *RouteMatch) Partial() bool {
+	return m.Matcher.GetPercentage() != nil && m.Matcher.GetPercentage().GetValue() < 100
+}
+
+// Returns the routes matching the request in order, up to and including the first route matching all such requests.
+func MatchRoutes(routes []*gloov1.Route, req *Request) []*RouteMatch {
+	var out []*RouteMatch
+	for i, route := range routes {
+		for _, matcher := range routeMatchers(route) {
+			if !matches(matcher, req) {
+				continue
+			}
+			match := &RouteMatch{Index: i, Route: route, Matcher: matcher}
+			out = append(out, match)
+			if !match.Partial() {
+				return out
+			}
+			break
+		}
+	}
+	return out
+}
+
+// A route which can never match, because the requests it matches are matched by an earlier route.
+type ShadowedRoute struct {
+	Index int
+	Route *gloov1.Route
+	// The position of the earlier route
+	ShadowedBy int
+}
+
+// Returns the routes all the matchers of which are covered by the matchers of an earlier route. Only the routes
+// which are certainly shadowed are returned, since regexes are only compared as strings.
+func ShadowedRoutes(routes []*gloov1.Route) []*ShadowedRoute {
+	var out []*ShadowedRoute
+	for j := range routes {
+		for i := 0; i < j; i++ {
+			if routeCovers(routes[i], routes[j]) {
+				out = append(out, &ShadowedRoute{Index: j, Route: routes[j], ShadowedBy: i})
+				break
+			}
+		}
+	}
+	return out
+}
+
+func routeMatchers(route *gloov1.Route) []*matchers.Matcher {
+	if len(route.GetMatchers()) == 0 {
+		return []*matchers.Matcher{defaults.DefaultMatcher()}
+	}
+	return route.GetMatchers()
+}
+
+func matches(matcher *matchers.Matcher, req *Request) bool {
+	if !pathMatches(matcher, req.Path) {
+		return false
+	}
+	if len(matcher.GetMethods()) > 0 && !containsString(matcher.GetMethods(), req.Method) {
+		return false
+	}
+	for _, header := range matcher.GetHeaders() {
+		value, ok := req.header(header.GetName())
+		if !headerMatches(header, value, ok) {
+			return false
+		}
+	}
+	for _, param := range matcher.GetQueryParameters() {
+		values, ok := req.Query[param.GetName()]
+		if !ok || len(values) == 0 {
+			return false
+		}
+		// Envoy matches the first value of the parameter
+		if param.GetValue() != "" && !valueMatches(param.GetValue(), param.GetRegex(), values[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+func pathMatches(matcher *matchers.Matcher, path string) bool {
+	switch specifier := matcher.GetPathSpecifier().(type) {
+	case *matchers.Matcher_Prefix:
+		return strings.HasPrefix(path, specifier.Prefix)
+	case *matchers.Matcher_Exact:
+		return path == specifier.Exact
+	case *matchers.Matcher_Regex:
+		return fullMatch(specifier.Regex, path)
+	}
+	return true
+}
+
+func headerMatches(header *matchers.HeaderMatcher, value string, present bool) bool {
+	if !present {
+		// Envoy only matches an absent header with an inverted presence match
+		return header.GetInvertMatch() && header.GetValue() == ""
+	}
+	matched := header.GetValue() == "" || valueMatches(header.GetValue(), header.GetRegex(), value)
+	return matched != header.GetInvertMatch()
+}
+
+func valueMatches(expected string, regex bool, value string) bool {
+	if regex {
+		return fullMatch(expected, value)
+	}
+	return expected == value
+}
+
+// Envoy regexes must match the whole value
+func fullMatch(regex, value string) bool {
+	re, err := regexp.Compile("^(?:" + regex + ")$")
+	if err != nil {
+		return false
+	}
+	return re.MatchString(value)
+}
+
+// Whether any request matched by the second route is matched by the first one.
+func routeCovers(first, second *gloov1.Route) bool {
+	for _, secondMatcher := range routeMatchers(second) {
+		covered := false
+		for _, firstMatcher := range routeMatchers(first) {
+			if matcherCovers(firstMatcher, secondMatcher) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func matcherCovers(first, second *matchers.Matcher) bool {
+	if percentage := first.GetPercentage(); percentage != nil && percentage.GetValue() < 100 {
+		return false
+	}
+	if !pathCovers(first, second) {
+		return false
+	}
+	if len(first.GetMethods()) > 0 {
+		if len(second.GetMethods()) == 0 {
+			return false
+		}
+		for _, method := range second.GetMethods() {
+			if !containsString(first.GetMethods(), method) {
+				return false
+			}
+		}
+	}
+	for _, header := range first.GetHeaders() {
+		if !headerImplied(header, second.GetHeaders()) {
+			return false
+		}
+	}
+	for _, param := range first.GetQueryParameters() {
+		if !queryParameterImplied(param, second.GetQueryParameters()) {
+			return false
+		}
+	}
+	return true
+}
+
+// paths always start with a slash, so these regexes match any path
+var matchAllRegexes = []string{".*", "/.*"}
+
+func pathCovers(first, second *matchers.Matcher) bool {
+	switch firstPath := first.GetPathSpecifier().(type) {
+	case *matchers.Matcher_Prefix:
+		switch secondPath := second.GetPathSpecifier().(type) {
+		case *matchers.Matcher_Prefix:
+			return strings.HasPrefix(secondPath.Prefix, firstPath.Prefix)
+		case *matchers.Matcher_Exact:
+			return strings.HasPrefix(secondPath.Exact, firstPath.Prefix)
+		case *matchers.Matcher_Regex:
+			return firstPath.Prefix == "" || firstPath.Prefix == "/"
+		}
+	case *matchers.Matcher_Exact:
+		return second.GetExact() != "" && second.GetExact() == firstPath.Exact
+	case *matchers.Matcher_Regex:
+		if containsString(matchAllRegexes, firstPath.Regex) {
+			return true
+		}
+		switch secondPath := second.GetPathSpecifier().(type) {
+		case *matchers.Matcher_Exact:
+			return fullMatch(firstPath.Regex, secondPath.Exact)
+		case *matchers.Matcher_Regex:
+			return firstPath.Regex == secondPath.Regex
+		}
+	}
+	return false
+}
+
+// Whether the header matcher matches all the requests matched by the other header matchers.
+func headerImplied(header *matchers.HeaderMatcher, others []*matchers.HeaderMatcher) bool {
+	for _, other := range others {
+		if !strings.EqualFold(header.GetName(), other.GetName()) || header.GetInvertMatch() != other.GetInvertMatch() {
+			continue
+		}
+		if header.GetValue() == other.GetValue() && header.GetRegex() == other.GetRegex() {
+			return true
+		}
+		// a presence match is implied by any match on the value
+		if header.GetValue() == "" && !header.GetInvertMatch() {
+			return true
+		}
+	}
+	return false
+}
+
+func queryParameterImplied(param *matchers.QueryParameterMatcher, others []*matchers.QueryParameterMatcher) bool {
+	for _, other := range others {
+		if param.GetName() != other.GetName() {
+			continue
+		}
+		if param.GetValue() == "" || (param.GetValue() == other.GetValue() && param.GetRegex() == other.GetRegex()) {
+			return true
+		}
+	}
+	return false
+}
+
+type domainMatch struct {
+	vhost *gloov1.VirtualHost
+	// lower is better
+	kind   int
+	length int
+}
+
+// Returns the virtual host Envoy selects for the host: exact domains first, then the longest suffix wildcards, the
+// longest prefix wildcards, and the * domain. As in Envoy, the port of the host must be part of the domain to match it.
+func SelectVirtualHost(virtualHosts []*gloov1.VirtualHost, host string) *gloov1.VirtualHost {
+	host = strings.ToLower(host)
+	var candidates []domainMatch
+	for _, vhost := range virtualHosts {
+		for _, domain := range vhost.GetDomains() {
+			domain = strings.ToLower(domain)
+			switch {
+			case domain == host:
+				candidates = append(candidates, domainMatch{vhost, 0, len(domain)})
+			case domain == "*":
+				candidates = append(candidates, domainMatch{vhost, 3, 0})
+			case strings.HasPrefix(domain, "*") && len(host) > len(domain)-1 && strings.HasSuffix(host, domain[1:]):
+				candidates = append(candidates, domainMatch{vhost, 1, len(domain)})
+			case strings.HasSuffix(domain, "*") && len(host) > len(domain)-1 && strings.HasPrefix(host, domain[:len(domain)-1]):
+				candidates = append(candidates, domainMatch{vhost, 2, len(domain)})
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].kind != candidates[j].kind {
+			return candidates[i].kind < candidates[j].kind
+		}
+		return candidates[i].length > candidates[j].length
+	})
+	return candidates[0].vhost
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,283 @@
+package route_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/render"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/route"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
+)
+
+const delegationYaml = `
+apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: petstore
+  namespace: gloo-system
+spec:
+  virtualHost:
+    domains:
+    - petstore.example.com
+    routes:
+    - matchers:
+      - prefix: /api
+      delegateAction:
+        ref:
+          name: api
+          namespace: gloo-system
+    - matchers:
+      - prefix: /
+      routeAction:
+        single:
+          upstream:
+            name: web
+            namespace: gloo-system
+---
+apiVersion: gateway.solo.io/v1
+kind: RouteTable
+metadata:
+  name: api
+  namespace: gloo-system
+spec:
+  routes:
+  - matchers:
+    - prefix: /api/pets
+      headers:
+      - name: x-canary
+    directResponseAction:
+      status: 418
+  - matchers:
+    - prefix: /api
+    options:
+      retries:
+        numRetries: 3
+    routeAction:
+      single:
+        upstream:
+          name: api
+          namespace: gloo-system
+  - matchers:
+    - prefix: /api/store
+    routeAction:
+      single:
+        upstream:
+          name: store
+          namespace: gloo-system
+`
+
+var _ = Describe("Route test", func() {
+
+	newRequest := func(path string, headers ...string) *route.Request {
+		req, err := route.NewRequest("get", "petstore.example.com", path, headers, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return req
+	}
+
+	prefixRoute := func(prefix string) *gloov1.Route {
+		return &gloov1.Route{Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: prefix}}}}
+	}
+
+	Context("requests", func() {
+
+		It("parses the headers and the query parameters", func() {
+			req, err := route.NewRequest("post", "example.com", "/pets?id=1", []string{"X-User: 2"}, []string{"sort=name"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(req.Method).To(Equal("POST"))
+			Expect(req.Path).To(Equal("/pets"))
+			Expect(req.Headers).To(Equal(map[string]string{"x-user": "2"}))
+			Expect(req.Query.Get("id")).To(Equal("1"))
+			Expect(req.Query.Get("sort")).To(Equal("name"))
+		})
+
+		It("rejects invalid headers and query parameters", func() {
+			_, err := route.NewRequest("get", "", "/", []string{"x-user"}, nil)
+			Expect(err).To(MatchError(route.InvalidHeaderErr("x-user")))
+			_, err = route.NewRequest("get", "", "/", nil, []string{"sort"})
+			Expect(err).To(MatchError(route.InvalidQueryParameterErr("sort")))
+		})
+	})
+
+	Context("matching", func() {
+
+		It("matches the first route matching the request", func() {
+			routes := []*gloov1.Route{
+				{Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Exact{Exact: "/pets"},
+					Methods:       []string{"POST"},
+				}}},
+				{Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Regex{Regex: "/pets/[0-9]+"},
+					Headers:       []*matchers.HeaderMatcher{{Name: "x-user", Value: "a.*", Regex: true}},
+				}}},
+				{Matchers: []*matchers.Matcher{{
+					PathSpecifier:   &matchers.Matcher_Prefix{Prefix: "/pets"},
+					QueryParameters: []*matchers.QueryParameterMatcher{{Name: "id"}},
+				}}},
+				prefixRoute("/"),
+			}
+
+			match := route.MatchRoutes(routes, newRequest("/pets"))
+			Expect(match).To(HaveLen(1))
+			Expect(match[0].Index).To(Equal(3))
+
+			match = route.MatchRoutes(routes, newRequest("/pets/12", "x-user: alice"))
+			Expect(match[0].Index).To(Equal(1))
+			match = route.MatchRoutes(routes, newRequest("/pets/12/toys", "x-user: alice"))
+			Expect(match[0].Index).To(Equal(3))
+
+			match = route.MatchRoutes(routes, newRequest("/pets?id=1"))
+			Expect(match[0].Index).To(Equal(2))
+
+			Expect(route.MatchRoutes(routes[:3], newRequest("/store"))).To(BeEmpty())
+		})
+
+		It("matches absent headers only with inverted presence matchers", func() {
+			invertedValue := []*gloov1.Route{{Matchers: []*matchers.Matcher{{
+				PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+				Headers:       []*matchers.HeaderMatcher{{Name: "x-user", Value: "alice", InvertMatch: true}},
+			}}}}
+			Expect(route.MatchRoutes(invertedValue, newRequest("/"))).To(BeEmpty())
+			Expect(route.MatchRoutes(invertedValue, newRequest("/", "x-user: alice"))).To(BeEmpty())
+			Expect(route.MatchRoutes(invertedValue, newRequest("/", "x-user: bob"))).To(HaveLen(1))
+
+			invertedPresence := []*gloov1.Route{{Matchers: []*matchers.Matcher{{
+				PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+				Headers:       []*matchers.HeaderMatcher{{Name: "x-user", InvertMatch: true}},
+			}}}}
+			Expect(route.MatchRoutes(invertedPresence, newRequest("/"))).To(HaveLen(1))
+			Expect(route.MatchRoutes(invertedPresence, newRequest("/", "x-user: bob"))).To(BeEmpty())
+		})
+
+		It("continues after the routes matching a share of the requests", func() {
+			routes := []*gloov1.Route{
+				{Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+					Percentage:    &types.FloatValue{Value: 10},
+				}}},
+				prefixRoute("/"),
+			}
+			match := route.MatchRoutes(routes, newRequest("/"))
+			Expect(match).To(HaveLen(2))
+			Expect(match[0].Partial()).To(BeTrue())
+			Expect(match[1].Partial()).To(BeFalse())
+		})
+
+		It("finds the shadowed routes", func() {
+			routes := []*gloov1.Route{
+				prefixRoute("/api"),
+				prefixRoute("/api/pets"),
+				{Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/store"},
+					Headers:       []*matchers.HeaderMatcher{{Name: "x-user"}},
+				}}},
+				{Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Exact{Exact: "/store/orders"},
+					Headers:       []*matchers.HeaderMatcher{{Name: "x-user", Value: "alice"}},
+				}}},
+				prefixRoute("/store"),
+			}
+			shadowed := route.ShadowedRoutes(routes)
+			Expect(shadowed).To(HaveLen(2))
+			Expect(shadowed[0].Index).To(Equal(1))
+			Expect(shadowed[0].ShadowedBy).To(Equal(0))
+			Expect(shadowed[1].Index).To(Equal(3))
+			Expect(shadowed[1].ShadowedBy).To(Equal(2))
+		})
+
+		It("selects the virtual host by domain", func() {
+			exact := &gloov1.VirtualHost{Name: "exact", Domains: []string{"api.example.com"}}
+			suffix := &gloov1.VirtualHost{Name: "suffix", Domains: []string{"*.example.com"}}
+			any := &gloov1.VirtualHost{Name: "any", Domains: []string{"*"}}
+			vhosts := []*gloov1.VirtualHost{any, suffix, exact}
+
+			Expect(route.SelectVirtualHost(vhosts, "api.example.com").Name).To(Equal("exact"))
+			Expect(route.SelectVirtualHost(vhosts, "API.example.com").Name).To(Equal("exact"))
+			Expect(route.SelectVirtualHost(vhosts, "api.example.com:8080").Name).To(Equal("any"))
+			Expect(route.SelectVirtualHost(vhosts, "www.example.com").Name).To(Equal("suffix"))
+			Expect(route.SelectVirtualHost(vhosts, "example.org").Name).To(Equal("any"))
+			Expect(route.SelectVirtualHost(vhosts[1:], "example.org")).To(BeNil())
+		})
+	})
+
+	Context("testing a virtual service", func() {
+
+		var vhost *gloov1.VirtualHost
+
+		BeforeEach(func() {
+			inputs, err := render.ReadInputs([]string{"-"}, strings.NewReader(delegationYaml), "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			proxies, _ := render.TranslateProxies(context.Background(), inputs, "gloo-system")
+			Expect(proxies).To(HaveLen(1))
+			vhosts := proxies[0].GetListeners()[0].GetHttpListener().GetVirtualHosts()
+			Expect(vhosts).To(HaveLen(1))
+			vhost = vhosts[0]
+		})
+
+		It("shows the route, its delegation chain, destination and options", func() {
+			result, err := route.TestVirtualHost(vhost, newRequest("/api/store/orders"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.VirtualHost).To(Equal("gloo-system.petstore"))
+			Expect(result.Route).NotTo(BeNil())
+			Expect(result.Route.Index).To(Equal(1))
+			Expect(result.Route.DelegationChain).To(Equal([]string{
+				"VirtualService gloo-system.petstore",
+				"RouteTable gloo-system.api",
+			}))
+			Expect(result.Route.Destination).To(Equal("upstream gloo-system.api"))
+			Expect(result.Route.Matcher).To(Equal(map[string]interface{}{"prefix": "/api"}))
+			Expect(vhost.GetRoutes()[1].GetOptions().GetRetries()).To(Equal(&retries.RetryPolicy{NumRetries: 3}))
+			Expect(result.Route.Options).To(HaveKey("retries"))
+
+			result, err = route.TestVirtualHost(vhost, newRequest("/api/pets", "x-canary: true"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Route.Index).To(Equal(0))
+			Expect(result.Route.Destination).To(Equal("direct response with status 418"))
+
+			result, err = route.TestVirtualHost(vhost, newRequest("/web"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Route.Index).To(Equal(3))
+			Expect(result.Route.DelegationChain).To(Equal([]string{"VirtualService gloo-system.petstore"}))
+		})
+
+		It("reports the shadowed routes", func() {
+			result, err := route.TestVirtualHost(vhost, newRequest("/"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ShadowedRoutes).To(HaveLen(1))
+			Expect(result.ShadowedRoutes[0].Index).To(Equal(2))
+			Expect(result.ShadowedRoutes[0].ShadowedBy).To(Equal(1))
+			Expect(result.ShadowedRoutes[0].Matchers).To(Equal("prefix /api/store"))
+		})
+
+		It("prints the result", func() {
+			result, err := route.TestVirtualHost(vhost, newRequest("/api/store"))
+			Expect(err).NotTo(HaveOccurred())
+
+			out := &bytes.Buffer{}
+			Expect(route.PrintTestResult(out, result, printers.TABLE)).NotTo(HaveOccurred())
+			Expect(out.String()).To(ContainSubstring("Matching route: route 1\n" +
+				"  Matcher: prefix /api\n" +
+				"  Delegation chain: VirtualService gloo-system.petstore -> RouteTable gloo-system.api\n" +
+				"  Destination: upstream gloo-system.api\n" +
+				"  Options:\n" +
+				"    retries:\n" +
+				"      numRetries: 3\n"))
+			Expect(out.String()).To(ContainSubstring("route 2 (prefix /api/store) can never match, route 1 matches all its requests"))
+
+			out.Reset()
+			Expect(route.PrintTestResult(out, result, printers.JSON)).NotTo(HaveOccurred())
+			var printed map[string]interface{}
+			Expect(json.Unmarshal(out.Bytes(), &printed)).NotTo(HaveOccurred())
+			Expect(printed).To(HaveKeyWithValue("virtualHost", "gloo-system.petstore"))
+			Expect(printed["route"]).To(HaveKeyWithValue("destination", "upstream gloo-system.api"))
+		})
+	})
+})
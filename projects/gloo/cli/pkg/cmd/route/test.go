@@ -0,0 +1,397 @@
+package route
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	errors "github.com/rotisserie/eris"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gwtranslator "github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/render"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	MissingNameOrHostErr   = errors.New("the name of a virtual service or the host of the request must be provided")
+	NoRouteMatchesErr      = errors.New("no route matches the request")
+	VirtualHostNotFoundErr = func(name string) error {
+		return errors.Errorf("no proxy serves the virtual service %v", name)
+	}
+	NoVirtualHostForHostErr = func(host string) error {
+		return errors.Errorf("no virtual service serves the host %v", host)
+	}
+	HostNotInDomainsErr = func(host string, domains []string) error {
+		return errors.Errorf("the host %v does not match the domains %v of the virtual service", host, domains)
+	}
+)
+
+func Test(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "test",
+		Aliases: []string{"t"},
+		Short:   "show which route of a virtual service a request matches",
+		Long: "Evaluates a request against the routes of a virtual service in the order Envoy does, after delegation " +
+			"to route tables. Shows the matching route, the chain of resources it was delegated through, its destination " +
+			"and its options, and the routes which can never match because an earlier route matches all their requests. " +
+			"The routes are read from the proxies of the cluster, or translated from the resources of the files given with -f. " +
+			"Without --name, the virtual service is selected by the host of the request." +
+			"\n\n" +
+			"Usage: `glooctl route test [--name virtual-service-name] [--namespace namespace] --host example.com --path /api -H 'x-user: 1'`",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			result, err := testRoute(opts)
+			if err != nil {
+				return err
+			}
+			if err := PrintTestResult(os.Stdout, result, opts.Top.Output); err != nil {
+				return err
+			}
+			if result.Route == nil {
+				return NoRouteMatchesErr
+			}
+			return nil
+		},
+	}
+	pflags := cmd.PersistentFlags()
+	testOpts := &opts.Route.Test
+	pflags.StringVarP(&testOpts.Method, "method", "X", "GET", "method of the request")
+	pflags.StringVar(&testOpts.Host, "host", "", "host of the request")
+	pflags.StringVar(&testOpts.Path, "path", "/", "path of the request, which may include a query string")
+	pflags.StringArrayVarP(&testOpts.Headers, "header", "H", nil, "header of the request as name:value (can be repeated)")
+	pflags.StringArrayVarP(&testOpts.QueryParameters, "query", "q", nil, "query parameter of the request as name=value (can be repeated)")
+	pflags.StringSliceVarP(&testOpts.Files, flagutils.FileFlag, "f", nil,
+		"files or directories of resources to translate instead of reading the proxies of the cluster, or - for stdin")
+	pflags.StringVar(&testOpts.ProxyNamespace, "proxy-namespace", defaults.GlooSystem, "namespace of the proxies to read from the cluster")
+	flagutils.AddOutputFlag(pflags, &opts.Top.Output)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func testRoute(opts *options.Options) (*TestResult, error) {
+	testOpts := opts.Route.Test
+	if opts.Metadata.Name == "" && testOpts.Host == "" {
+		return nil, MissingNameOrHostErr
+	}
+	req, err := NewRequest(testOpts.Method, testOpts.Host, testOpts.Path, testOpts.Headers, testOpts.QueryParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	var proxies gloov1.ProxyList
+	if len(testOpts.Files) > 0 {
+		inputs, err := render.ReadInputs(testOpts.Files, os.Stdin, opts.Metadata.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+		proxies, _ = render.TranslateProxies(opts.Top.Ctx, inputs, testOpts.ProxyNamespace)
+	} else {
+		proxies, err = helpers.MustNamespacedProxyClient(testOpts.ProxyNamespace).List(testOpts.ProxyNamespace,
+			clients.ListOpts{Ctx: opts.Top.Ctx})
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing proxies")
+		}
+	}
+
+	var vhost *gloov1.VirtualHost
+	if opts.Metadata.Name != "" {
+		vhost, err = findVirtualHost(proxies, opts.Metadata.GetNamespace(), opts.Metadata.Name)
+		if err != nil {
+			return nil, err
+		}
+		if req.Host != "" && SelectVirtualHost([]*gloov1.VirtualHost{vhost}, req.Host) == nil {
+			return nil, HostNotInDomainsErr(req.Host, vhost.GetDomains())
+		}
+	} else {
+		for _, virtualHosts := range virtualHostsByListener(proxies) {
+			if vhost = SelectVirtualHost(virtualHosts, req.Host); vhost != nil {
+				break
+			}
+		}
+		if vhost == nil {
+			return nil, NoVirtualHostForHostErr(req.Host)
+		}
+	}
+	return TestVirtualHost(vhost, req)
+}
+
+// Returns the virtual host of the virtual service.
+func findVirtualHost(proxies gloov1.ProxyList, namespace, name string) (*gloov1.VirtualHost, error) {
+	vhostName := gwtranslator.VirtualHostName(&gatewayv1.VirtualService{Metadata: core.Metadata{Namespace: namespace, Name: name}})
+	for _, virtualHosts := range virtualHostsByListener(proxies) {
+		for _, vhost := range virtualHosts {
+			if vhost.GetName() == vhostName {
+				return vhost, nil
+			}
+		}
+	}
+	return nil, VirtualHostNotFoundErr(vhostName)
+}
+
+// Returns the virtual hosts of each http listener of the proxies.
+func virtualHostsByListener(proxies gloov1.ProxyList) [][]*gloov1.VirtualHost {
+	var out [][]*gloov1.VirtualHost
+	for _, proxy := range proxies {
+		for _, listener := range proxy.GetListeners() {
+			if httpListener := listener.GetHttpListener(); httpListener != nil {
+				out = append(out, httpListener.GetVirtualHosts())
+			}
+			for _, matchedListener := range listener.GetHybridListener().GetMatchedListeners() {
+				if httpListener := matchedListener.GetHttpListener(); httpListener != nil {
+					out = append(out, httpListener.GetVirtualHosts())
+				}
+			}
+		}
+	}
+	return out
+}
+
+// The outcome of testing a request against the routes of a virtual host.
+type TestResult struct {
+	VirtualHost string `json:"virtualHost"`
+	// The route handling the request, if any
+	Route *TestedRoute `json:"route,omitempty"`
+	// The earlier routes which handle a share of the requests
+	PartialMatches []*TestedRoute `json:"partialMatches,omitempty"`
+	// The routes of the virtual host which can never match
+	ShadowedRoutes []*TestedShadowedRoute `json:"shadowedRoutes,omitempty"`
+}
+
+type TestedRoute struct {
+	Index   int                    `json:"index"`
+	Name    string                 `json:"name,omitempty"`
+	Matcher map[string]interface{} `json:"matcher"`
+	// The virtual service and route tables the route was delegated through
+	DelegationChain []string               `json:"delegationChain,omitempty"`
+	Destination     string                 `json:"destination"`
+	Options         map[string]interface{} `json:"options,omitempty"`
+
+	matcher *matchers.Matcher
+}
+
+type TestedShadowedRoute struct {
+	Index      int    `json:"index"`
+	Name       string `json:"name,omitempty"`
+	Matchers   string `json:"matchers"`
+	ShadowedBy int    `json:"shadowedBy"`
+}
+
+// Matches the request against the routes of the virtual host, and finds its shadowed routes.
+func TestVirtualHost(vhost *gloov1.VirtualHost, req *Request) (*TestResult, error) {
+	result := &TestResult{VirtualHost: vhost.GetName()}
+	for _, match := range MatchRoutes(vhost.GetRoutes(), req) {
+		tested, err := newTestedRoute(match)
+		if err != nil {
+			return nil, err
+		}
+		if match.Partial() {
+			result.PartialMatches = append(result.PartialMatches, tested)
+		} else {
+			result.Route = tested
+		}
+	}
+	for _, shadowed := range ShadowedRoutes(vhost.GetRoutes()) {
+		result.ShadowedRoutes = append(result.ShadowedRoutes, &TestedShadowedRoute{
+			Index:      shadowed.Index,
+			Name:       shadowed.Route.GetName(),
+			Matchers:   describeMatchers(routeMatchers(shadowed.Route)),
+			ShadowedBy: shadowed.ShadowedBy,
+		})
+	}
+	return result, nil
+}
+
+func newTestedRoute(match *RouteMatch) (*TestedRoute, error) {
+	tested := &TestedRoute{
+		Index:       match.Index,
+		Name:        match.Route.GetName(),
+		Destination: describeAction(match.Route),
+		matcher:     match.Matcher,
+	}
+	var err error
+	if tested.Matcher, err = protoutils.MarshalMapFromProto(match.Matcher); err != nil {
+		return nil, err
+	}
+	if match.Route.GetOptions() != nil {
+		if tested.Options, err = protoutils.MarshalMapFromProto(match.Route.GetOptions()); err != nil {
+			return nil, err
+		}
+	}
+	sourceMeta, err := gwtranslator.GetSourceMeta(match.Route)
+	if err != nil {
+		return nil, err
+	}
+	// the sources are appended from the innermost route table up to the virtual service
+	for i := len(sourceMeta.Sources) - 1; i >= 0; i-- {
+		source := sourceMeta.Sources[i]
+		kind := source.ResourceKind[strings.LastIndex(source.ResourceKind, ".")+1:]
+		tested.DelegationChain = append(tested.DelegationChain, fmt.Sprintf("%v %v", kind, source.ResourceRef.Key()))
+	}
+	return tested, nil
+}
+
+// Prints the result as text for table outputs, or as yaml or json.
+func PrintTestResult(w io.Writer, result *TestResult, output printers.OutputType) error {
+	switch output {
+	case printers.YAML, printers.KUBE_YAML:
+		out, err := yaml.Marshal(result)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		return err
+	case printers.JSON:
+		out, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(out))
+		return err
+	}
+
+	fmt.Fprintf(w, "Virtual host: %v\n", result.VirtualHost)
+	for _, partial := range result.PartialMatches {
+		fmt.Fprintf(w, "Matches %v%% of the requests first: ", partial.matcher.GetPercentage().GetValue())
+		printTestedRoute(w, partial)
+	}
+	if result.Route == nil {
+		fmt.Fprintf(w, "No route matches the request\n")
+	} else {
+		fmt.Fprintf(w, "Matching route: ")
+		printTestedRoute(w, result.Route)
+	}
+	if len(result.ShadowedRoutes) > 0 {
+		fmt.Fprintf(w, "Shadowed routes:\n")
+		for _, shadowed := range result.ShadowedRoutes {
+			fmt.Fprintf(w, "  %v (%v) can never match, route %d matches all its requests\n",
+				routeLabel(shadowed.Index, shadowed.Name), shadowed.Matchers, shadowed.ShadowedBy)
+		}
+	}
+	return nil
+}
+
+func printTestedRoute(w io.Writer, route *TestedRoute) {
+	fmt.Fprintf(w, "%v\n", routeLabel(route.Index, route.Name))
+	fmt.Fprintf(w, "  Matcher: %v\n", describeMatchers([]*matchers.Matcher{route.matcher}))
+	if len(route.DelegationChain) > 0 {
+		fmt.Fprintf(w, "  Delegation chain: %v\n", strings.Join(route.DelegationChain, " -> "))
+	}
+	fmt.Fprintf(w, "  Destination: %v\n", route.Destination)
+	if route.Options != nil {
+		out, err := yaml.Marshal(route.Options)
+		if err == nil {
+			fmt.Fprintf(w, "  Options:\n")
+			for _, line := range strings.Split(strings.TrimRight(string(out), "\n"), "\n") {
+				fmt.Fprintf(w, "    %v\n", line)
+			}
+		}
+	}
+}
+
+func routeLabel(index int, name string) string {
+	if name == "" {
+		return fmt.Sprintf("route %d", index)
+	}
+	return fmt.Sprintf("route %d (%v)", index, name)
+}
+
+func describeMatchers(matchersList []*matchers.Matcher) string {
+	var descriptions []string
+	for _, matcher := range matchersList {
+		var parts []string
+		switch path := matcher.GetPathSpecifier().(type) {
+		case *matchers.Matcher_Prefix:
+			parts = append(parts, "prefix "+path.Prefix)
+		case *matchers.Matcher_Exact:
+			parts = append(parts, "exact "+path.Exact)
+		case *matchers.Matcher_Regex:
+			parts = append(parts, "regex "+path.Regex)
+		default:
+			parts = append(parts, "prefix /")
+		}
+		if len(matcher.GetMethods()) > 0 {
+			parts = append(parts, "methods "+strings.Join(matcher.GetMethods(), ","))
+		}
+		for _, header := range matcher.GetHeaders() {
+			parts = append(parts, "header "+describeValueMatcher(header.GetName(), ":", header.GetValue(), header.GetRegex(), header.GetInvertMatch()))
+		}
+		for _, param := range matcher.GetQueryParameters() {
+			parts = append(parts, "query "+describeValueMatcher(param.GetName(), "=", param.GetValue(), param.GetRegex(), false))
+		}
+		if percentage := matcher.GetPercentage(); percentage != nil {
+			parts = append(parts, fmt.Sprintf("%v%% of the requests", percentage.GetValue()))
+		}
+		descriptions = append(descriptions, strings.Join(parts, ", "))
+	}
+	return strings.Join(descriptions, " | ")
+}
+
+func describeValueMatcher(name, separator, value string, regex, invert bool) string {
+	description := name
+	switch {
+	case value == "":
+		description += " present"
+	case regex:
+		description += separator + "~" + value
+	default:
+		description += separator + value
+	}
+	if invert {
+		description = "not " + description
+	}
+	return description
+}
+
+func describeAction(route *gloov1.Route) string {
+	switch action := route.GetAction().(type) {
+	case *gloov1.Route_RouteAction:
+		switch dest := action.RouteAction.GetDestination().(type) {
+		case *gloov1.RouteAction_Single:
+			return describeDestination(dest.Single)
+		case *gloov1.RouteAction_Multi:
+			var weighted []string
+			for _, weightedDest := range dest.Multi.GetDestinations() {
+				weighted = append(weighted, fmt.Sprintf("%v (weight %d)", describeDestination(weightedDest.GetDestination()), weightedDest.GetWeight()))
+			}
+			return strings.Join(weighted, ", ")
+		case *gloov1.RouteAction_UpstreamGroup:
+			return "upstream group " + dest.UpstreamGroup.Key()
+		case *gloov1.RouteAction_ClusterHeader:
+			return "the cluster named by the " + dest.ClusterHeader + " header"
+		}
+	case *gloov1.Route_RedirectAction:
+		return "redirect to " + action.RedirectAction.String()
+	case *gloov1.Route_DirectResponseAction:
+		return fmt.Sprintf("direct response with status %d", action.DirectResponseAction.GetStatus())
+	}
+	return "none"
+}
+
+func describeDestination(dest *gloov1.Destination) string {
+	var description string
+	switch typed := dest.GetDestinationType().(type) {
+	case *gloov1.Destination_Upstream:
+		description = "upstream " + typed.Upstream.Key()
+	case *gloov1.Destination_Kube:
+		description = fmt.Sprintf("kube service %v port %d", typed.Kube.GetRef().Key(), typed.Kube.GetPort())
+	case *gloov1.Destination_Consul:
+		description = "consul service " + typed.Consul.GetServiceName()
+	}
+	if subset := dest.GetSubset(); subset != nil {
+		description += fmt.Sprintf(" subset %v", subset.GetValues())
+	}
+	return description
+}
@@ -59,6 +59,9 @@ var (
 	CHECK_COMMAND = cobra.Command{
 		Use:   "check",
 		Short: "Checks Gloo resources for errors (requires Gloo running on Kubernetes)",
+		Long: "Runs the checks of the Gloo installation, like the status of its deployments and resources. " +
+			"Use --list to see the checks, and --only or --exclude to select them. " +
+			"The json and junit outputs report the id, severity, errors and remediation hint of each check.",
 	}
 
 	CREATE_COMMAND = cobra.Command{
@@ -84,6 +87,15 @@ var (
 		Short: "Dump YAML representing the current Gloo state (requires Gloo running on Kubernetes)",
 	}
 
+	DEBUG_BUNDLE_COMMAND = cobra.Command{
+		Use:   "bundle",
+		Short: "Collect Gloo resources, logs, xDS config and Envoy admin data into a tarball (requires Gloo running on Kubernetes)",
+		Long: "Collects the Gloo resources of all namespaces with their status, the Gloo deployments, services and config maps " +
+			"of the namespace, the logs of the Gloo pods, the config served by the Gloo xDS server to each proxy of the namespace, " +
+			"and the /config_dump, /clusters and /stats of their Envoys into a timestamped tarball. Secrets are redacted. " +
+			"The parts which cannot be collected are listed in errors.txt in the tarball.",
+	}
+
 	DELETE_COMMAND = cobra.Command{
 		Use:     "delete",
 		Aliases: []string{"d"},
@@ -126,6 +138,26 @@ var (
 		Short: "uninstall gloo federation",
 	}
 
+	RENDER_COMMAND = cobra.Command{
+		Use:     "render",
+		Aliases: []string{"translate"},
+		Short:   "Render the Envoy config of Gloo resources read from files (does not require Gloo running)",
+		Long: "Translates the Gateways, VirtualServices, RouteTables, Upstreams and Settings read from files (including stdin) " +
+			"into Proxies and Envoy config, the way Gloo would, and prints them with the reports of the resources. " +
+			"The secrets referenced by SSL configs and missing from the files are replaced with placeholders, with a warning. " +
+			"Fails if any resource is rejected.",
+	}
+
+	APPLY_COMMAND = cobra.Command{
+		Use:   "apply",
+		Short: "Apply Gloo resources read from files to the cluster, showing the plan first",
+		Long: "Compares the Gloo resources read from files (including stdin) with the cluster, and shows the resources " +
+			"to create or update with their diff and the resulting changes to the Proxies. The changes are validated by " +
+			"a server-side dry run, which runs them through the gateway validation webhook, then applied in dependency " +
+			"order (Upstreams before RouteTables before VirtualServices). If a write fails, the resources already " +
+			"written are rolled back. With --plan, only the plan is shown and validated.",
+	}
+
 	UPGRADE_COMMAND = cobra.Command{
 		Use:     "upgrade",
 		Aliases: []string{"ug"},
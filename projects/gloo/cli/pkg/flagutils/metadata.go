@@ -23,5 +23,9 @@ func AddNamespaceFlag(set *pflag.FlagSet, strptr *string) {
 }
 
 func AddExcludecheckFlag(set *pflag.FlagSet, strarrptr *[]string) {
-	set.StringSliceVarP(strarrptr, "exclude", "x", []string{}, "check to exclude: (pods, upstreamgroup, secrets, gateways, proxies)")
+	set.StringSliceVarP(strarrptr, "exclude", "x", []string{}, "ids of the checks to exclude (see --list)")
+}
+
+func AddOnlyCheckFlag(set *pflag.FlagSet, strarrptr *[]string) {
+	set.StringSliceVar(strarrptr, "only", []string{}, "ids of the checks to run, instead of all of them (see --list)")
 }
@@ -452,6 +452,182 @@ func RouteTableClient(namespaces []string) (gatewayv1.RouteTableClient, error) {
 	return routeTableClient, nil
 }
 
+func MustRouteOptionClient() gatewayv1.RouteOptionClient {
+	return MustNamespacedRouteOptionClient(metav1.NamespaceAll) // will require cluster-scoped permissions
+}
+
+func MustNamespacedRouteOptionClient(ns string) gatewayv1.RouteOptionClient {
+	return MustMultiNamespacedRouteOptionClient([]string{ns})
+}
+
+func MustMultiNamespacedRouteOptionClient(namespaces []string) gatewayv1.RouteOptionClient {
+	client, err := RouteOptionClient(namespaces)
+	if err != nil {
+		log.Fatalf("failed to create routeOption client: %v", err)
+	}
+	return client
+}
+
+// provide "" (metav1.NamespaceAll) to get a cluster-scoped route option client
+func RouteOptionClient(namespaces []string) (gatewayv1.RouteOptionClient, error) {
+	customFactory := getConfigClientFactory()
+	if customFactory != nil {
+		return gatewayv1.NewRouteOptionClient(customFactory)
+	}
+
+	cfg, err := kubeutils.GetConfig("", "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting kube config")
+	}
+	cache := kube.NewKubeCache(context.TODO())
+	routeOptionClient, err := gatewayv1.NewRouteOptionClient(&factory.KubeResourceClientFactory{
+		Crd:                gatewayv1.RouteOptionCrd,
+		Cfg:                cfg,
+		SharedCache:        cache,
+		SkipCrdCreation:    true,
+		NamespaceWhitelist: namespaces,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating routeOptions client")
+	}
+	if err := routeOptionClient.Register(); err != nil {
+		return nil, err
+	}
+	return routeOptionClient, nil
+}
+
+func MustVirtualHostOptionClient() gatewayv1.VirtualHostOptionClient {
+	return MustNamespacedVirtualHostOptionClient(metav1.NamespaceAll) // will require cluster-scoped permissions
+}
+
+func MustNamespacedVirtualHostOptionClient(ns string) gatewayv1.VirtualHostOptionClient {
+	return MustMultiNamespacedVirtualHostOptionClient([]string{ns})
+}
+
+func MustMultiNamespacedVirtualHostOptionClient(namespaces []string) gatewayv1.VirtualHostOptionClient {
+	client, err := VirtualHostOptionClient(namespaces)
+	if err != nil {
+		log.Fatalf("failed to create virtualHostOption client: %v", err)
+	}
+	return client
+}
+
+// provide "" (metav1.NamespaceAll) to get a cluster-scoped virtual host option client
+func VirtualHostOptionClient(namespaces []string) (gatewayv1.VirtualHostOptionClient, error) {
+	customFactory := getConfigClientFactory()
+	if customFactory != nil {
+		return gatewayv1.NewVirtualHostOptionClient(customFactory)
+	}
+
+	cfg, err := kubeutils.GetConfig("", "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting kube config")
+	}
+	cache := kube.NewKubeCache(context.TODO())
+	virtualHostOptionClient, err := gatewayv1.NewVirtualHostOptionClient(&factory.KubeResourceClientFactory{
+		Crd:                gatewayv1.VirtualHostOptionCrd,
+		Cfg:                cfg,
+		SharedCache:        cache,
+		SkipCrdCreation:    true,
+		NamespaceWhitelist: namespaces,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating virtualHostOptions client")
+	}
+	if err := virtualHostOptionClient.Register(); err != nil {
+		return nil, err
+	}
+	return virtualHostOptionClient, nil
+}
+
+func MustMatchableHttpGatewayClient() gatewayv1.MatchableHttpGatewayClient {
+	return MustNamespacedMatchableHttpGatewayClient(metav1.NamespaceAll) // will require cluster-scoped permissions
+}
+
+func MustNamespacedMatchableHttpGatewayClient(ns string) gatewayv1.MatchableHttpGatewayClient {
+	return MustMultiNamespacedMatchableHttpGatewayClient([]string{ns})
+}
+
+func MustMultiNamespacedMatchableHttpGatewayClient(namespaces []string) gatewayv1.MatchableHttpGatewayClient {
+	client, err := MatchableHttpGatewayClient(namespaces)
+	if err != nil {
+		log.Fatalf("failed to create matchableHttpGateway client: %v", err)
+	}
+	return client
+}
+
+// provide "" (metav1.NamespaceAll) to get a cluster-scoped matchable http gateway client
+func MatchableHttpGatewayClient(namespaces []string) (gatewayv1.MatchableHttpGatewayClient, error) {
+	customFactory := getConfigClientFactory()
+	if customFactory != nil {
+		return gatewayv1.NewMatchableHttpGatewayClient(customFactory)
+	}
+
+	cfg, err := kubeutils.GetConfig("", "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting kube config")
+	}
+	cache := kube.NewKubeCache(context.TODO())
+	matchableHttpGatewayClient, err := gatewayv1.NewMatchableHttpGatewayClient(&factory.KubeResourceClientFactory{
+		Crd:                gatewayv1.MatchableHttpGatewayCrd,
+		Cfg:                cfg,
+		SharedCache:        cache,
+		SkipCrdCreation:    true,
+		NamespaceWhitelist: namespaces,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating matchableHttpGateways client")
+	}
+	if err := matchableHttpGatewayClient.Register(); err != nil {
+		return nil, err
+	}
+	return matchableHttpGatewayClient, nil
+}
+
+func MustReferencePolicyClient() gatewayv1.ReferencePolicyClient {
+	return MustNamespacedReferencePolicyClient(metav1.NamespaceAll) // will require cluster-scoped permissions
+}
+
+func MustNamespacedReferencePolicyClient(ns string) gatewayv1.ReferencePolicyClient {
+	return MustMultiNamespacedReferencePolicyClient([]string{ns})
+}
+
+func MustMultiNamespacedReferencePolicyClient(namespaces []string) gatewayv1.ReferencePolicyClient {
+	client, err := ReferencePolicyClient(namespaces)
+	if err != nil {
+		log.Fatalf("failed to create referencePolicy client: %v", err)
+	}
+	return client
+}
+
+// provide "" (metav1.NamespaceAll) to get a cluster-scoped reference policy client
+func ReferencePolicyClient(namespaces []string) (gatewayv1.ReferencePolicyClient, error) {
+	customFactory := getConfigClientFactory()
+	if customFactory != nil {
+		return gatewayv1.NewReferencePolicyClient(customFactory)
+	}
+
+	cfg, err := kubeutils.GetConfig("", "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting kube config")
+	}
+	cache := kube.NewKubeCache(context.TODO())
+	referencePolicyClient, err := gatewayv1.NewReferencePolicyClient(&factory.KubeResourceClientFactory{
+		Crd:                gatewayv1.ReferencePolicyCrd,
+		Cfg:                cfg,
+		SharedCache:        cache,
+		SkipCrdCreation:    true,
+		NamespaceWhitelist: namespaces,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating referencePolicies client")
+	}
+	if err := referencePolicyClient.Register(); err != nil {
+		return nil, err
+	}
+	return referencePolicyClient, nil
+}
+
 func MustSettingsClient() v1.SettingsClient {
 	return MustNamespacedSettingsClient(metav1.NamespaceAll) // will require cluster-scoped permissions
 }
@@ -73,6 +73,8 @@ func upstreamType(up *v1.Upstream) string {
 		return "Kubernetes"
 	case *v1.Upstream_Static:
 		return "Static"
+	case *v1.Upstream_DynamicForwardProxy:
+		return "Dynamic Forward Proxy"
 	default:
 		return "Unknown"
 	}
@@ -160,6 +162,16 @@ func upstreamDetails(up *v1.Upstream, xdsDump *xdsinspection.XdsDump) []string {
 		if usType.Static.ServiceSpec != nil {
 			add(linesForServiceSpec(usType.Static.ServiceSpec)...)
 		}
+	case *v1.Upstream_DynamicForwardProxy:
+		if len(usType.DynamicForwardProxy.AllowedHosts) == 0 {
+			add("allowed hosts: all")
+		}
+		for i := range usType.DynamicForwardProxy.AllowedHosts {
+			if i == 0 {
+				add("allowed hosts:")
+			}
+			add(fmt.Sprintf("- %v", usType.DynamicForwardProxy.AllowedHosts[i]))
+		}
 	}
 	add("")
 	return details
@@ -6,13 +6,12 @@ package cluster
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -105,9 +104,30 @@ type OutlierDetection struct {
 	// `split_external_local_origin_errors (envoy_api_field_cluster.OutlierDetection.split_external_local_origin_errors)`
 	// is set to true.
 	EnforcingLocalOriginSuccessRate *types.UInt32Value `protobuf:"bytes,15,opt,name=enforcing_local_origin_success_rate,json=enforcingLocalOriginSuccessRate,proto3" json:"enforcing_local_origin_success_rate,omitempty"`
-	XXX_NoUnkeyedLiteral            struct{}           `json:"-"`
-	XXX_unrecognized                []byte             `json:"-"`
-	XXX_sizecache                   int32              `json:"-"`
+	// The failure percentage to use when determining failure percentage-based outlier detection. If
+	// the failure percentage of a given host is greater than or equal to this value, it will be
+	// ejected. Defaults to 85.
+	FailurePercentageThreshold *types.UInt32Value `protobuf:"bytes,16,opt,name=failure_percentage_threshold,json=failurePercentageThreshold,proto3" json:"failure_percentage_threshold,omitempty"`
+	// The % chance that a host will be actually ejected when an outlier status is detected through
+	// failure percentage statistics. This setting can be used to disable ejection or to ramp it up
+	// slowly. Defaults to 0.
+	EnforcingFailurePercentage *types.UInt32Value `protobuf:"bytes,17,opt,name=enforcing_failure_percentage,json=enforcingFailurePercentage,proto3" json:"enforcing_failure_percentage,omitempty"`
+	// The % chance that a host will be actually ejected when an outlier status is detected through
+	// local-origin failure percentage statistics. This setting can be used to disable ejection or to
+	// ramp it up slowly. Defaults to 0.
+	EnforcingFailurePercentageLocalOrigin *types.UInt32Value `protobuf:"bytes,18,opt,name=enforcing_failure_percentage_local_origin,json=enforcingFailurePercentageLocalOrigin,proto3" json:"enforcing_failure_percentage_local_origin,omitempty"`
+	// The minimum number of hosts in a cluster in order to perform failure percentage-based ejection.
+	// If the total number of hosts in the cluster is less than this value, failure percentage-based
+	// ejection will not be performed. Defaults to 5.
+	FailurePercentageMinimumHosts *types.UInt32Value `protobuf:"bytes,19,opt,name=failure_percentage_minimum_hosts,json=failurePercentageMinimumHosts,proto3" json:"failure_percentage_minimum_hosts,omitempty"`
+	// The minimum number of total requests that must be collected in one interval (as defined by the
+	// interval duration above) to perform failure percentage-based ejection for this host. If the
+	// volume is lower than this setting, failure percentage-based ejection will not be performed for
+	// this host. Defaults to 50.
+	FailurePercentageRequestVolume *types.UInt32Value `protobuf:"bytes,20,opt,name=failure_percentage_request_volume,json=failurePercentageRequestVolume,proto3" json:"failure_percentage_request_volume,omitempty"`
+	XXX_NoUnkeyedLiteral           struct{}           `json:"-"`
+	XXX_unrecognized               []byte             `json:"-"`
+	XXX_sizecache                  int32              `json:"-"`
 }
 
 func (m *OutlierDetection) Reset()         { *m = OutlierDetection{} }
@@ -239,6 +259,41 @@ func (m *OutlierDetection) GetEnforcingLocalOriginSuccessRate() *types.UInt32Val
 	return nil
 }
 
+func (m *OutlierDetection) GetFailurePercentageThreshold() *types.UInt32Value {
+	if m != nil {
+		return m.FailurePercentageThreshold
+	}
+	return nil
+}
+
+func (m *OutlierDetection) GetEnforcingFailurePercentage() *types.UInt32Value {
+	if m != nil {
+		return m.EnforcingFailurePercentage
+	}
+	return nil
+}
+
+func (m *OutlierDetection) GetEnforcingFailurePercentageLocalOrigin() *types.UInt32Value {
+	if m != nil {
+		return m.EnforcingFailurePercentageLocalOrigin
+	}
+	return nil
+}
+
+func (m *OutlierDetection) GetFailurePercentageMinimumHosts() *types.UInt32Value {
+	if m != nil {
+		return m.FailurePercentageMinimumHosts
+	}
+	return nil
+}
+
+func (m *OutlierDetection) GetFailurePercentageRequestVolume() *types.UInt32Value {
+	if m != nil {
+		return m.FailurePercentageRequestVolume
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*OutlierDetection)(nil), "envoy.api.v2.cluster.OutlierDetection")
 }
@@ -248,50 +303,56 @@ func init() {
 }
 
 var fileDescriptor_d66ea649b88221e5 = []byte{
-	// 688 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x5f, 0x4f, 0xdb, 0x3a,
-	0x18, 0xc6, 0x4f, 0x38, 0xfc, 0xe9, 0x31, 0xe7, 0x00, 0xb2, 0x7a, 0x20, 0x85, 0x8d, 0xa1, 0x4e,
-	0x9b, 0x10, 0xd2, 0x12, 0xa9, 0x88, 0xeb, 0x49, 0x1d, 0x65, 0xff, 0x05, 0x2b, 0x1b, 0x68, 0x63,
-	0x92, 0xe5, 0xa6, 0x6f, 0x83, 0x99, 0x13, 0x67, 0xb6, 0x13, 0xc2, 0xe5, 0xbe, 0xc2, 0x6e, 0xf7,
-	0x05, 0xa6, 0x7d, 0x82, 0x7d, 0x9e, 0x7d, 0x87, 0xdd, 0x70, 0x35, 0x35, 0x69, 0xda, 0xb4, 0x44,
-	0x22, 0xbd, 0x73, 0xec, 0xf7, 0xf9, 0x3d, 0x8f, 0x5f, 0x27, 0x31, 0x22, 0x2e, 0xd3, 0xe7, 0x61,
-	0xc7, 0x72, 0x84, 0x67, 0x2b, 0xc1, 0xc5, 0x23, 0x26, 0x6c, 0x97, 0x0b, 0x61, 0x07, 0x52, 0x5c,
-	0x80, 0xa3, 0x55, 0xfa, 0x44, 0x03, 0x66, 0x43, 0xac, 0x41, 0xfa, 0x94, 0xdb, 0xe0, 0x47, 0xe2,
-	0x2a, 0x99, 0x8a, 0x1a, 0xb6, 0xc3, 0x43, 0xa5, 0x41, 0xda, 0x22, 0xd4, 0x9c, 0x81, 0x24, 0x5d,
-	0xd0, 0xe0, 0x68, 0x26, 0x7c, 0x2b, 0x90, 0x42, 0x0b, 0x5c, 0x4d, 0xaa, 0x2d, 0x1a, 0x30, 0x2b,
-	0x6a, 0x58, 0x83, 0xea, 0xf5, 0x4d, 0x57, 0x08, 0x97, 0x83, 0x9d, 0xd4, 0x74, 0xc2, 0x9e, 0xdd,
-	0x0d, 0x25, 0x1d, 0xa9, 0x6e, 0xae, 0x5f, 0x4a, 0x1a, 0x04, 0x20, 0xd5, 0x60, 0x7d, 0x2d, 0xa2,
-	0x9c, 0x75, 0xa9, 0x06, 0x3b, 0x1b, 0x0c, 0x16, 0xaa, 0xae, 0x70, 0x45, 0x32, 0xb4, 0xfb, 0xa3,
-	0xc1, 0x2c, 0x86, 0x58, 0xa7, 0x93, 0x10, 0xeb, 0x74, 0xae, 0xfe, 0x6d, 0x11, 0xad, 0x1c, 0xa6,
-	0xa1, 0xf7, 0xb3, 0xcc, 0xb8, 0x85, 0x96, 0x1d, 0xe1, 0x2b, 0x70, 0x42, 0xcd, 0x22, 0x20, 0x7b,
-	0x71, 0x6c, 0x1a, 0x5b, 0xc6, 0xf6, 0x62, 0xe3, 0x8e, 0x95, 0x26, 0xb2, 0xb2, 0x44, 0xd6, 0xbb,
-	0xe7, 0xbe, 0xde, 0x6d, 0x9c, 0x50, 0x1e, 0x42, 0x7b, 0x29, 0x27, 0xda, 0x8b, 0x63, 0xfc, 0x18,
-	0x55, 0x98, 0xaf, 0x41, 0x46, 0x94, 0x9b, 0x33, 0x89, 0xbe, 0x76, 0x43, 0xbf, 0x3f, 0xd8, 0x71,
-	0xb3, 0x72, 0xdd, 0x9c, 0xfb, 0x61, 0xcc, 0xec, 0xfc, 0xd5, 0x1e, 0x8a, 0xf0, 0x1b, 0x84, 0x3b,
-	0x54, 0x01, 0x81, 0x8b, 0x34, 0x18, 0xd1, 0xcc, 0x03, 0xf3, 0xef, 0xf2, 0xa8, 0x95, 0xbe, 0xbc,
-	0x35, 0x50, 0xbf, 0x65, 0x1e, 0xe0, 0x53, 0x54, 0xf5, 0x68, 0x3c, 0x22, 0x06, 0x20, 0x1d, 0xf0,
-	0xb5, 0x39, 0x7b, 0xfb, 0xfe, 0x9a, 0x0b, 0xd7, 0xcd, 0xd9, 0x9d, 0x19, 0xb3, 0xdb, 0xc6, 0x1e,
-	0x8d, 0x33, 0xea, 0x51, 0x0a, 0xc0, 0x14, 0xd5, 0xc0, 0xef, 0x09, 0xe9, 0x30, 0xdf, 0x25, 0x93,
-	0xdd, 0x9b, 0x9b, 0x86, 0xbe, 0x36, 0xe4, 0x3c, 0x19, 0xef, 0xe7, 0x19, 0x5a, 0x1d, 0x59, 0xa8,
-	0xd0, 0x71, 0x40, 0x29, 0x22, 0xa9, 0x06, 0x73, 0x7e, 0x1a, 0x7e, 0x75, 0x08, 0x39, 0x4e, 0x19,
-	0x6d, 0xaa, 0x01, 0xbf, 0x47, 0xeb, 0x79, 0x24, 0xf1, 0x98, 0xcf, 0xbc, 0xd0, 0x23, 0xe7, 0x42,
-	0x69, 0x65, 0x2e, 0x94, 0x38, 0xfe, 0x35, 0x35, 0xc2, 0xbd, 0x4e, 0xd5, 0xcf, 0xfa, 0x62, 0x7c,
-	0x86, 0x36, 0xc6, 0xd0, 0x12, 0x3e, 0x87, 0xa0, 0x34, 0x89, 0x04, 0x0f, 0x3d, 0x30, 0x2b, 0x25,
-	0xd8, 0x66, 0x8e, 0xdd, 0x4e, 0xe5, 0x27, 0x89, 0x1a, 0x9f, 0xa2, 0xda, 0x18, 0x5c, 0xe9, 0x2e,
-	0x44, 0xa4, 0x47, 0x1d, 0x2d, 0xa4, 0xf9, 0x4f, 0x09, 0xf4, 0x6a, 0x0e, 0x7d, 0xdc, 0x17, 0x1f,
-	0x24, 0x5a, 0xfc, 0x11, 0x6d, 0xe4, 0x8f, 0xd1, 0xa5, 0x1a, 0x2e, 0xe9, 0x15, 0xe9, 0x51, 0xc6,
-	0x43, 0x09, 0x26, 0x2a, 0x81, 0xae, 0xe5, 0x00, 0x4f, 0x53, 0xfd, 0x41, 0x2a, 0xc7, 0x31, 0x7a,
-	0x50, 0xfc, 0xba, 0x4c, 0xfa, 0x2c, 0x4e, 0x73, 0xb4, 0xf5, 0xa2, 0x57, 0x67, 0xc2, 0xf9, 0x05,
-	0xaa, 0xab, 0x80, 0x33, 0x4d, 0xb2, 0x3f, 0x19, 0xe1, 0xc2, 0xa1, 0x9c, 0x08, 0xc9, 0x5c, 0xe6,
-	0x13, 0x90, 0x52, 0x48, 0x65, 0xfe, 0xbb, 0x65, 0x6c, 0x57, 0xda, 0x9b, 0x49, 0x65, 0x6b, 0x50,
-	0xf8, 0xaa, 0x5f, 0x77, 0x98, 0x94, 0xb5, 0x92, 0x2a, 0x0c, 0x68, 0x2b, 0x9f, 0x7d, 0x0c, 0x94,
-	0x6d, 0xe0, 0xbf, 0x12, 0x8d, 0xba, 0x9b, 0xa3, 0xe4, 0x5c, 0xb2, 0xc8, 0x5f, 0x0c, 0xb4, 0x53,
-	0xdc, 0xad, 0x42, 0xc7, 0xa5, 0x69, 0x5a, 0xf6, 0xb0, 0xa8, 0x65, 0x05, 0x19, 0x14, 0xba, 0x3f,
-	0x8a, 0x30, 0x66, 0x3b, 0xf6, 0x25, 0x2e, 0x4f, 0xe3, 0x7d, 0x6f, 0x48, 0xcc, 0x19, 0xe6, 0x3e,
-	0xca, 0xe6, 0x57, 0xe3, 0xe7, 0xef, 0x59, 0xe3, 0xfb, 0xaf, 0x4d, 0x03, 0xd5, 0x99, 0xb0, 0x92,
-	0x4b, 0x24, 0x90, 0x22, 0xbe, 0xb2, 0x8a, 0xee, 0x93, 0xe6, 0xff, 0x93, 0x7f, 0xf2, 0xa3, 0xbe,
-	0xfd, 0x91, 0xf1, 0xe1, 0x65, 0xb9, 0xfb, 0x2d, 0xf8, 0xe4, 0xde, 0x7e, 0xc7, 0x75, 0xe6, 0x93,
-	0x4d, 0xed, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xba, 0xeb, 0x4f, 0x8e, 0x35, 0x07, 0x00, 0x00,
+	// 781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc7, 0x71, 0xe9, 0xee, 0x86, 0x59, 0xd8, 0x96, 0x21, 0x6c, 0x9d, 0xee, 0x12, 0x4a, 0x50,
+	0x51, 0xa9, 0x84, 0x2d, 0xa5, 0xea, 0x35, 0x52, 0x68, 0xca, 0xb7, 0x1a, 0xd2, 0xd2, 0x0a, 0x8a,
+	0x34, 0x9a, 0x38, 0x27, 0xce, 0x14, 0xdb, 0x63, 0x66, 0xc6, 0xae, 0x7b, 0x85, 0x78, 0x05, 0x9e,
+	0x02, 0xf1, 0x04, 0x5c, 0xf1, 0x30, 0xbc, 0x03, 0x37, 0xbd, 0x42, 0xfe, 0x4a, 0xec, 0xc4, 0x6c,
+	0xed, 0x3b, 0x67, 0x7c, 0xfe, 0xbf, 0xff, 0x39, 0x67, 0xc6, 0x27, 0x83, 0x88, 0xcd, 0xd4, 0x3c,
+	0x98, 0x18, 0x16, 0x77, 0x4d, 0xc9, 0x1d, 0xfe, 0x09, 0xe3, 0xa6, 0xed, 0x70, 0x6e, 0xfa, 0x82,
+	0xdf, 0x80, 0xa5, 0x64, 0xfa, 0x8b, 0xfa, 0xcc, 0x84, 0x48, 0x81, 0xf0, 0xa8, 0x63, 0x82, 0x17,
+	0xf2, 0xbb, 0x64, 0x29, 0xec, 0x9b, 0x96, 0x13, 0x48, 0x05, 0xc2, 0xe4, 0x81, 0x72, 0x18, 0x08,
+	0x32, 0x05, 0x05, 0x96, 0x62, 0xdc, 0x33, 0x7c, 0xc1, 0x15, 0xc7, 0xed, 0x24, 0xda, 0xa0, 0x3e,
+	0x33, 0xc2, 0xbe, 0x91, 0x45, 0xef, 0x76, 0x6d, 0xce, 0x6d, 0x07, 0xcc, 0x24, 0x66, 0x12, 0xcc,
+	0xcc, 0x69, 0x20, 0xe8, 0x52, 0xb5, 0xfe, 0xfe, 0x56, 0x50, 0xdf, 0x07, 0x21, 0xb3, 0xf7, 0x3b,
+	0x21, 0x75, 0xd8, 0x94, 0x2a, 0x30, 0xf3, 0x87, 0xec, 0x45, 0xdb, 0xe6, 0x36, 0x4f, 0x1e, 0xcd,
+	0xf8, 0x29, 0x5b, 0xc5, 0x10, 0xa9, 0x74, 0x11, 0x22, 0x95, 0xae, 0xf5, 0xfe, 0xde, 0x42, 0xdb,
+	0x67, 0x69, 0xd2, 0x27, 0x79, 0xce, 0x78, 0x88, 0xb6, 0x2c, 0xee, 0x49, 0xb0, 0x02, 0xc5, 0x42,
+	0x20, 0xc7, 0x51, 0xa4, 0x6b, 0x7b, 0xda, 0xc1, 0xd3, 0xfe, 0x4b, 0x23, 0xcd, 0xc8, 0xc8, 0x33,
+	0x32, 0xbe, 0xff, 0xd2, 0x53, 0x47, 0xfd, 0x4b, 0xea, 0x04, 0x30, 0x7e, 0x56, 0x10, 0x1d, 0x47,
+	0x11, 0xfe, 0x14, 0xb5, 0x98, 0xa7, 0x40, 0x84, 0xd4, 0xd1, 0x37, 0x12, 0x7d, 0x67, 0x4d, 0x7f,
+	0x92, 0x55, 0x3c, 0x68, 0xdd, 0x0f, 0x1e, 0xfd, 0xa9, 0x6d, 0x1c, 0xbe, 0x36, 0x5e, 0x88, 0xf0,
+	0x77, 0x08, 0x4f, 0xa8, 0x04, 0x02, 0x37, 0x69, 0x62, 0x44, 0x31, 0x17, 0xf4, 0xd7, 0xeb, 0xa3,
+	0xb6, 0x63, 0xf9, 0x30, 0x53, 0x5f, 0x30, 0x17, 0xf0, 0x15, 0x6a, 0xbb, 0x34, 0x5a, 0x12, 0x7d,
+	0x10, 0x16, 0x78, 0x4a, 0xdf, 0x7c, 0xb8, 0xbe, 0xc1, 0x93, 0xfb, 0xc1, 0xe6, 0xe1, 0x86, 0x3e,
+	0x1d, 0x63, 0x97, 0x46, 0x39, 0x75, 0x94, 0x02, 0x30, 0x45, 0x1d, 0xf0, 0x66, 0x5c, 0x58, 0xcc,
+	0xb3, 0xc9, 0x6a, 0xf7, 0x1e, 0x35, 0xa1, 0xef, 0x2c, 0x38, 0x9f, 0x95, 0xfb, 0x79, 0x8d, 0x9e,
+	0x2f, 0x2d, 0x64, 0x60, 0x59, 0x20, 0x25, 0x11, 0x54, 0x81, 0xfe, 0xb8, 0x09, 0xbf, 0xbd, 0x80,
+	0x9c, 0xa7, 0x8c, 0x31, 0x55, 0x80, 0x7f, 0x40, 0xbb, 0x45, 0x24, 0x71, 0x99, 0xc7, 0xdc, 0xc0,
+	0x25, 0x73, 0x2e, 0x95, 0xd4, 0x9f, 0xd4, 0xd8, 0xfe, 0x1d, 0xb9, 0xc4, 0x7d, 0x9b, 0xaa, 0xbf,
+	0x88, 0xc5, 0xf8, 0x1a, 0xbd, 0x28, 0xa1, 0x05, 0xfc, 0x12, 0x80, 0x54, 0x24, 0xe4, 0x4e, 0xe0,
+	0x82, 0xde, 0xaa, 0xc1, 0xd6, 0x0b, 0xec, 0x71, 0x2a, 0xbf, 0x4c, 0xd4, 0xf8, 0x0a, 0x75, 0x4a,
+	0x70, 0xa9, 0xa6, 0x10, 0x92, 0x19, 0xb5, 0x14, 0x17, 0xfa, 0x1b, 0x35, 0xd0, 0xcf, 0x0b, 0xe8,
+	0xf3, 0x58, 0x7c, 0x9a, 0x68, 0xf1, 0x4f, 0xe8, 0x45, 0x71, 0x1b, 0x6d, 0xaa, 0xe0, 0x96, 0xde,
+	0x91, 0x19, 0x65, 0x4e, 0x20, 0x40, 0x47, 0x35, 0xd0, 0x9d, 0x02, 0xe0, 0xf3, 0x54, 0x7f, 0x9a,
+	0xca, 0x71, 0x84, 0xf6, 0xab, 0x8f, 0xcb, 0xaa, 0xcf, 0xd3, 0x26, 0x5b, 0xdb, 0xab, 0x3a, 0x3a,
+	0x2b, 0xce, 0x5f, 0xa1, 0x9e, 0xf4, 0x1d, 0xa6, 0x48, 0x3e, 0xc9, 0x88, 0xc3, 0x2d, 0xea, 0x10,
+	0x2e, 0x98, 0xcd, 0x3c, 0x02, 0x42, 0x70, 0x21, 0xf5, 0x37, 0xf7, 0xb4, 0x83, 0xd6, 0xb8, 0x9b,
+	0x44, 0x0e, 0xb3, 0xc0, 0x6f, 0xe2, 0xb8, 0xb3, 0x24, 0x6c, 0x98, 0x44, 0x61, 0x40, 0x7b, 0xc5,
+	0xdc, 0x4b, 0xa0, 0xbc, 0x80, 0xb7, 0x6a, 0x34, 0xea, 0xbd, 0x02, 0xa5, 0xe0, 0x92, 0xa7, 0xfc,
+	0x9b, 0x86, 0x0e, 0xab, 0xbb, 0x55, 0xe9, 0xf8, 0xac, 0x49, 0xcb, 0x3e, 0xaa, 0x6a, 0x59, 0x45,
+	0x0e, 0x12, 0x7d, 0xb8, 0x4c, 0xa1, 0x64, 0x5b, 0xfa, 0x12, 0xb7, 0x9a, 0x78, 0xbf, 0xbf, 0x20,
+	0x16, 0x0c, 0x8b, 0x1f, 0xa5, 0x8d, 0x5e, 0x66, 0x45, 0xe5, 0x83, 0x8a, 0xda, 0x40, 0xd4, 0x5c,
+	0x80, 0x9c, 0x73, 0x67, 0xaa, 0x6f, 0x37, 0x71, 0xdb, 0xcd, 0x50, 0xa3, 0x05, 0xe9, 0x22, 0x07,
+	0xc5, 0x46, 0xcb, 0xea, 0xd6, 0x2d, 0xf5, 0xb7, 0x1b, 0x19, 0x2d, 0x50, 0xa7, 0xab, 0x8e, 0xf8,
+	0x57, 0xf4, 0xf1, 0xab, 0x8c, 0x4a, 0x9d, 0xd5, 0x71, 0x13, 0xd7, 0xfd, 0xff, 0x77, 0x2d, 0x74,
+	0x37, 0x3e, 0xb2, 0x15, 0xb6, 0xe5, 0x69, 0xf7, 0x4e, 0x9d, 0x23, 0xbb, 0xd6, 0xcd, 0xd2, 0xcc,
+	0xb3, 0xd1, 0x07, 0x15, 0x36, 0x2b, 0x93, 0xaf, 0x5d, 0xc3, 0xa7, 0xbb, 0xe6, 0x53, 0x9a, 0x7f,
+	0x83, 0xdf, 0xb5, 0xbf, 0xfe, 0xdd, 0xd4, 0xfe, 0xf8, 0xa7, 0xab, 0xa1, 0x1e, 0xe3, 0x46, 0x72,
+	0xcf, 0xf0, 0x05, 0x8f, 0xee, 0x8c, 0xaa, 0x2b, 0xc7, 0xe0, 0xdd, 0xd5, 0x3f, 0xfb, 0x51, 0x6c,
+	0x3a, 0xd2, 0x7e, 0xfc, 0xba, 0xde, 0x15, 0xc8, 0xff, 0xd9, 0x7e, 0xf8, 0x1a, 0x34, 0x79, 0x9c,
+	0x94, 0x72, 0xf4, 0xdf, 0x00, 0x3a, 0xad, 0x28, 0x97, 0x58, 0x09, 0x00, 0x00,
 }
 
 func (this *OutlierDetection) Equal(that interface{}) bool {
@@ -358,6 +419,21 @@ func (this *OutlierDetection) Equal(that interface{}) bool {
 	if !this.EnforcingLocalOriginSuccessRate.Equal(that1.EnforcingLocalOriginSuccessRate) {
 		return false
 	}
+	if !this.FailurePercentageThreshold.Equal(that1.FailurePercentageThreshold) {
+		return false
+	}
+	if !this.EnforcingFailurePercentage.Equal(that1.EnforcingFailurePercentage) {
+		return false
+	}
+	if !this.EnforcingFailurePercentageLocalOrigin.Equal(that1.EnforcingFailurePercentageLocalOrigin) {
+		return false
+	}
+	if !this.FailurePercentageMinimumHosts.Equal(that1.FailurePercentageMinimumHosts) {
+		return false
+	}
+	if !this.FailurePercentageRequestVolume.Equal(that1.FailurePercentageRequestVolume) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -0,0 +1,1636 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/jwt_authn/v3/config.proto
+
+package v3
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v31 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/route/v3"
+	_ "github.com/solo-io/gloo/projects/gloo/pkg/api/external/udpa/annotations"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Please see following for JWT authentication flow:
+//
+// * `JSON Web Token (JWT) <https://tools.ietf.org/html/rfc7519>`_
+// * `The OAuth 2.0 Authorization Framework <https://tools.ietf.org/html/rfc6749>`_
+// * `OpenID Connect <http://openid.net/connect>`_
+//
+// A JwtProvider message specifies how a JSON Web Token (JWT) can be verified. It specifies:
+//
+// * issuer: the principal that issues the JWT. If specified, it has to match the *iss* field in JWT.
+// * allowed audiences: the ones in the token have to be listed here.
+// * how to fetch public key JWKS to verify the token signature.
+// * how to extract JWT token in the request.
+// * how to pass successfully verified token payload.
+//
+// [#next-free-field: 11]
+type JwtProvider struct {
+	// Specify the `principal <https://tools.ietf.org/html/rfc7519#section-4.1.1>`_ that issued
+	// the JWT, usually a URL or an email address.
+	//
+	// It is optional. If specified, it has to match the *iss* field in JWT.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// The list of JWT `audiences <https://tools.ietf.org/html/rfc7519#section-4.1.3>`_ are
+	// allowed to access. A JWT containing any of these audiences will be accepted. If not specified,
+	// will not check audiences in the token.
+	Audiences []string `protobuf:"bytes,2,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	// `JSON Web Key Set (JWKS) <https://tools.ietf.org/html/rfc7517#appendix-A>`_ is needed to
+	// validate signature of a JWT. This field specifies where to fetch JWKS.
+	//
+	// Types that are valid to be assigned to JwksSourceSpecifier:
+	//	*JwtProvider_RemoteJwks
+	//	*JwtProvider_LocalJwks
+	JwksSourceSpecifier isJwtProvider_JwksSourceSpecifier `protobuf_oneof:"jwks_source_specifier"`
+	// If false, the JWT is removed in the request after a success verification. If true, the JWT is
+	// not removed in the request. Default value is false.
+	Forward bool `protobuf:"varint,5,opt,name=forward,proto3" json:"forward,omitempty"`
+	// Two fields below define where to extract the JWT from an HTTP request.
+	//
+	// If no explicit location is specified, the following default locations are tried in order:
+	//
+	// 1. The Authorization header using the `Bearer schema
+	// <https://tools.ietf.org/html/rfc6750#section-2.1>`_. Example::
+	//
+	//    Authorization: Bearer <token>.
+	//
+	// 2. `access_token <https://tools.ietf.org/html/rfc6750#section-2.3>`_ query parameter.
+	//
+	// Multiple JWTs can be verified for a request. Each JWT has to be extracted from the locations
+	// its provider specified or from the default locations.
+	//
+	// Specify the HTTP headers to extract JWT token. For examples, following config:
+	//
+	// .. code-block:: yaml
+	//
+	//   from_headers:
+	//   - name: x-goog-iap-jwt-assertion
+	//
+	// can be used for Google Cloud Identity-Aware Proxy.
+	FromHeaders []*JwtHeader `protobuf:"bytes,6,rep,name=from_headers,json=fromHeaders,proto3" json:"from_headers,omitempty"`
+	// JWT is sent in a query parameter. `jwt_params` represents the query parameter names.
+	FromParams []string `protobuf:"bytes,7,rep,name=from_params,json=fromParams,proto3" json:"from_params,omitempty"`
+	// This field specifies the header name to forward a successfully verified JWT payload to the
+	// backend. The forwarded data is::
+	//
+	//    base64url_encoded(jwt_payload_in_JSON)
+	//
+	// If it is not specified, the payload will not be forwarded.
+	ForwardPayloadHeader string `protobuf:"bytes,8,opt,name=forward_payload_header,json=forwardPayloadHeader,proto3" json:"forward_payload_header,omitempty"`
+	// If non empty, successfully verified JWT payloads will be written to StreamInfo DynamicMetadata
+	// in the format as: *namespace* is the jwt_authn filter name as **envoy.filters.http.jwt_authn**
+	// The value is the *protobuf::Struct*. The value of this field will be the key for its *fields*
+	// and the value is the *protobuf::Struct* converted from JWT JSON payload.
+	PayloadInMetadata string `protobuf:"bytes,9,opt,name=payload_in_metadata,json=payloadInMetadata,proto3" json:"payload_in_metadata,omitempty"`
+	// Specify the clock skew in seconds when verifying JWT time constraint,
+	// such as `exp`, and `nbf`. If not specified, default is 60 seconds.
+	ClockSkewSeconds     uint32   `protobuf:"varint,10,opt,name=clock_skew_seconds,json=clockSkewSeconds,proto3" json:"clock_skew_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JwtProvider) Reset()         { *m = JwtProvider{} }
+func (m *JwtProvider) String() string { return proto.CompactTextString(m) }
+func (*JwtProvider) ProtoMessage()    {}
+func (*JwtProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{0}
+}
+func (m *JwtProvider) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwtProvider.Unmarshal(m, b)
+}
+func (m *JwtProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwtProvider.Marshal(b, m, deterministic)
+}
+func (m *JwtProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwtProvider.Merge(m, src)
+}
+func (m *JwtProvider) XXX_Size() int {
+	return xxx_messageInfo_JwtProvider.Size(m)
+}
+func (m *JwtProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwtProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwtProvider proto.InternalMessageInfo
+
+type isJwtProvider_JwksSourceSpecifier interface {
+	isJwtProvider_JwksSourceSpecifier()
+	Equal(interface{}) bool
+}
+
+type JwtProvider_RemoteJwks struct {
+	RemoteJwks *RemoteJwks `protobuf:"bytes,3,opt,name=remote_jwks,json=remoteJwks,proto3,oneof" json:"remote_jwks,omitempty"`
+}
+type JwtProvider_LocalJwks struct {
+	LocalJwks *v3.DataSource `protobuf:"bytes,4,opt,name=local_jwks,json=localJwks,proto3,oneof" json:"local_jwks,omitempty"`
+}
+
+func (*JwtProvider_RemoteJwks) isJwtProvider_JwksSourceSpecifier() {}
+func (*JwtProvider_LocalJwks) isJwtProvider_JwksSourceSpecifier()  {}
+
+func (m *JwtProvider) GetJwksSourceSpecifier() isJwtProvider_JwksSourceSpecifier {
+	if m != nil {
+		return m.JwksSourceSpecifier
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *JwtProvider) GetAudiences() []string {
+	if m != nil {
+		return m.Audiences
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetRemoteJwks() *RemoteJwks {
+	if x, ok := m.GetJwksSourceSpecifier().(*JwtProvider_RemoteJwks); ok {
+		return x.RemoteJwks
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetLocalJwks() *v3.DataSource {
+	if x, ok := m.GetJwksSourceSpecifier().(*JwtProvider_LocalJwks); ok {
+		return x.LocalJwks
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetForward() bool {
+	if m != nil {
+		return m.Forward
+	}
+	return false
+}
+
+func (m *JwtProvider) GetFromHeaders() []*JwtHeader {
+	if m != nil {
+		return m.FromHeaders
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetFromParams() []string {
+	if m != nil {
+		return m.FromParams
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetForwardPayloadHeader() string {
+	if m != nil {
+		return m.ForwardPayloadHeader
+	}
+	return ""
+}
+
+func (m *JwtProvider) GetPayloadInMetadata() string {
+	if m != nil {
+		return m.PayloadInMetadata
+	}
+	return ""
+}
+
+func (m *JwtProvider) GetClockSkewSeconds() uint32 {
+	if m != nil {
+		return m.ClockSkewSeconds
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*JwtProvider) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*JwtProvider_RemoteJwks)(nil),
+		(*JwtProvider_LocalJwks)(nil),
+	}
+}
+
+// This message specifies how to fetch JWKS from remote and how to cache it.
+type RemoteJwks struct {
+	// The HTTP URI to fetch the JWKS. For example:
+	//
+	// .. code-block:: yaml
+	//
+	//    http_uri:
+	//      uri: https://www.googleapis.com/oauth2/v1/certs
+	//      cluster: jwt.www.googleapis.com|443
+	//      timeout: 1s
+	HttpUri *v3.HttpUri `protobuf:"bytes,1,opt,name=http_uri,json=httpUri,proto3" json:"http_uri,omitempty"`
+	// Duration after which the cached JWKS should be expired. If not specified, default cache
+	// duration is 5 minutes.
+	CacheDuration        *types.Duration `protobuf:"bytes,2,opt,name=cache_duration,json=cacheDuration,proto3" json:"cache_duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RemoteJwks) Reset()         { *m = RemoteJwks{} }
+func (m *RemoteJwks) String() string { return proto.CompactTextString(m) }
+func (*RemoteJwks) ProtoMessage()    {}
+func (*RemoteJwks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{1}
+}
+func (m *RemoteJwks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoteJwks.Unmarshal(m, b)
+}
+func (m *RemoteJwks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoteJwks.Marshal(b, m, deterministic)
+}
+func (m *RemoteJwks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteJwks.Merge(m, src)
+}
+func (m *RemoteJwks) XXX_Size() int {
+	return xxx_messageInfo_RemoteJwks.Size(m)
+}
+func (m *RemoteJwks) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteJwks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteJwks proto.InternalMessageInfo
+
+func (m *RemoteJwks) GetHttpUri() *v3.HttpUri {
+	if m != nil {
+		return m.HttpUri
+	}
+	return nil
+}
+
+func (m *RemoteJwks) GetCacheDuration() *types.Duration {
+	if m != nil {
+		return m.CacheDuration
+	}
+	return nil
+}
+
+// This message specifies a header location to extract JWT token.
+type JwtHeader struct {
+	// The HTTP header name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The value prefix. The value format is "value_prefix<token>"
+	// For example, for "Authorization: Bearer <token>", value_prefix="Bearer " with a space at the
+	// end.
+	ValuePrefix          string   `protobuf:"bytes,2,opt,name=value_prefix,json=valuePrefix,proto3" json:"value_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JwtHeader) Reset()         { *m = JwtHeader{} }
+func (m *JwtHeader) String() string { return proto.CompactTextString(m) }
+func (*JwtHeader) ProtoMessage()    {}
+func (*JwtHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{2}
+}
+func (m *JwtHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwtHeader.Unmarshal(m, b)
+}
+func (m *JwtHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwtHeader.Marshal(b, m, deterministic)
+}
+func (m *JwtHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwtHeader.Merge(m, src)
+}
+func (m *JwtHeader) XXX_Size() int {
+	return xxx_messageInfo_JwtHeader.Size(m)
+}
+func (m *JwtHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwtHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwtHeader proto.InternalMessageInfo
+
+func (m *JwtHeader) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JwtHeader) GetValuePrefix() string {
+	if m != nil {
+		return m.ValuePrefix
+	}
+	return ""
+}
+
+// Specify a required provider with audiences.
+type ProviderWithAudiences struct {
+	// Specify a required provider name.
+	ProviderName string `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	// This field overrides the one specified in the JwtProvider.
+	Audiences            []string `protobuf:"bytes,2,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProviderWithAudiences) Reset()         { *m = ProviderWithAudiences{} }
+func (m *ProviderWithAudiences) String() string { return proto.CompactTextString(m) }
+func (*ProviderWithAudiences) ProtoMessage()    {}
+func (*ProviderWithAudiences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{3}
+}
+func (m *ProviderWithAudiences) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProviderWithAudiences.Unmarshal(m, b)
+}
+func (m *ProviderWithAudiences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProviderWithAudiences.Marshal(b, m, deterministic)
+}
+func (m *ProviderWithAudiences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderWithAudiences.Merge(m, src)
+}
+func (m *ProviderWithAudiences) XXX_Size() int {
+	return xxx_messageInfo_ProviderWithAudiences.Size(m)
+}
+func (m *ProviderWithAudiences) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderWithAudiences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderWithAudiences proto.InternalMessageInfo
+
+func (m *ProviderWithAudiences) GetProviderName() string {
+	if m != nil {
+		return m.ProviderName
+	}
+	return ""
+}
+
+func (m *ProviderWithAudiences) GetAudiences() []string {
+	if m != nil {
+		return m.Audiences
+	}
+	return nil
+}
+
+// This message specifies a Jwt requirement. An empty message means JWT verification is not
+// required.
+// [#next-free-field: 7]
+type JwtRequirement struct {
+	// Types that are valid to be assigned to RequiresType:
+	//	*JwtRequirement_ProviderName
+	//	*JwtRequirement_ProviderAndAudiences
+	//	*JwtRequirement_RequiresAny
+	//	*JwtRequirement_RequiresAll
+	//	*JwtRequirement_AllowMissingOrFailed
+	//	*JwtRequirement_AllowMissing
+	RequiresType         isJwtRequirement_RequiresType `protobuf_oneof:"requires_type"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *JwtRequirement) Reset()         { *m = JwtRequirement{} }
+func (m *JwtRequirement) String() string { return proto.CompactTextString(m) }
+func (*JwtRequirement) ProtoMessage()    {}
+func (*JwtRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{4}
+}
+func (m *JwtRequirement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwtRequirement.Unmarshal(m, b)
+}
+func (m *JwtRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwtRequirement.Marshal(b, m, deterministic)
+}
+func (m *JwtRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwtRequirement.Merge(m, src)
+}
+func (m *JwtRequirement) XXX_Size() int {
+	return xxx_messageInfo_JwtRequirement.Size(m)
+}
+func (m *JwtRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwtRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwtRequirement proto.InternalMessageInfo
+
+type isJwtRequirement_RequiresType interface {
+	isJwtRequirement_RequiresType()
+	Equal(interface{}) bool
+}
+
+type JwtRequirement_ProviderName struct {
+	ProviderName string `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3,oneof" json:"provider_name,omitempty"`
+}
+type JwtRequirement_ProviderAndAudiences struct {
+	ProviderAndAudiences *ProviderWithAudiences `protobuf:"bytes,2,opt,name=provider_and_audiences,json=providerAndAudiences,proto3,oneof" json:"provider_and_audiences,omitempty"`
+}
+type JwtRequirement_RequiresAny struct {
+	RequiresAny *JwtRequirementOrList `protobuf:"bytes,3,opt,name=requires_any,json=requiresAny,proto3,oneof" json:"requires_any,omitempty"`
+}
+type JwtRequirement_RequiresAll struct {
+	RequiresAll *JwtRequirementAndList `protobuf:"bytes,4,opt,name=requires_all,json=requiresAll,proto3,oneof" json:"requires_all,omitempty"`
+}
+type JwtRequirement_AllowMissingOrFailed struct {
+	AllowMissingOrFailed *types.Empty `protobuf:"bytes,5,opt,name=allow_missing_or_failed,json=allowMissingOrFailed,proto3,oneof" json:"allow_missing_or_failed,omitempty"`
+}
+type JwtRequirement_AllowMissing struct {
+	AllowMissing *types.Empty `protobuf:"bytes,6,opt,name=allow_missing,json=allowMissing,proto3,oneof" json:"allow_missing,omitempty"`
+}
+
+func (*JwtRequirement_ProviderName) isJwtRequirement_RequiresType()         {}
+func (*JwtRequirement_ProviderAndAudiences) isJwtRequirement_RequiresType() {}
+func (*JwtRequirement_RequiresAny) isJwtRequirement_RequiresType()          {}
+func (*JwtRequirement_RequiresAll) isJwtRequirement_RequiresType()          {}
+func (*JwtRequirement_AllowMissingOrFailed) isJwtRequirement_RequiresType() {}
+func (*JwtRequirement_AllowMissing) isJwtRequirement_RequiresType()         {}
+
+func (m *JwtRequirement) GetRequiresType() isJwtRequirement_RequiresType {
+	if m != nil {
+		return m.RequiresType
+	}
+	return nil
+}
+
+func (m *JwtRequirement) GetProviderName() string {
+	if x, ok := m.GetRequiresType().(*JwtRequirement_ProviderName); ok {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (m *JwtRequirement) GetProviderAndAudiences() *ProviderWithAudiences {
+	if x, ok := m.GetRequiresType().(*JwtRequirement_ProviderAndAudiences); ok {
+		return x.ProviderAndAudiences
+	}
+	return nil
+}
+
+func (m *JwtRequirement) GetRequiresAny() *JwtRequirementOrList {
+	if x, ok := m.GetRequiresType().(*JwtRequirement_RequiresAny); ok {
+		return x.RequiresAny
+	}
+	return nil
+}
+
+func (m *JwtRequirement) GetRequiresAll() *JwtRequirementAndList {
+	if x, ok := m.GetRequiresType().(*JwtRequirement_RequiresAll); ok {
+		return x.RequiresAll
+	}
+	return nil
+}
+
+func (m *JwtRequirement) GetAllowMissingOrFailed() *types.Empty {
+	if x, ok := m.GetRequiresType().(*JwtRequirement_AllowMissingOrFailed); ok {
+		return x.AllowMissingOrFailed
+	}
+	return nil
+}
+
+func (m *JwtRequirement) GetAllowMissing() *types.Empty {
+	if x, ok := m.GetRequiresType().(*JwtRequirement_AllowMissing); ok {
+		return x.AllowMissing
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*JwtRequirement) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*JwtRequirement_ProviderName)(nil),
+		(*JwtRequirement_ProviderAndAudiences)(nil),
+		(*JwtRequirement_RequiresAny)(nil),
+		(*JwtRequirement_RequiresAll)(nil),
+		(*JwtRequirement_AllowMissingOrFailed)(nil),
+		(*JwtRequirement_AllowMissing)(nil),
+	}
+}
+
+// This message specifies a list of RequiredProvider.
+// Their results are OR-ed; if any one of them passes, the result is passed
+type JwtRequirementOrList struct {
+	// Specify a list of JwtRequirement.
+	Requirements         []*JwtRequirement `protobuf:"bytes,1,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *JwtRequirementOrList) Reset()         { *m = JwtRequirementOrList{} }
+func (m *JwtRequirementOrList) String() string { return proto.CompactTextString(m) }
+func (*JwtRequirementOrList) ProtoMessage()    {}
+func (*JwtRequirementOrList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{5}
+}
+func (m *JwtRequirementOrList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwtRequirementOrList.Unmarshal(m, b)
+}
+func (m *JwtRequirementOrList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwtRequirementOrList.Marshal(b, m, deterministic)
+}
+func (m *JwtRequirementOrList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwtRequirementOrList.Merge(m, src)
+}
+func (m *JwtRequirementOrList) XXX_Size() int {
+	return xxx_messageInfo_JwtRequirementOrList.Size(m)
+}
+func (m *JwtRequirementOrList) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwtRequirementOrList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwtRequirementOrList proto.InternalMessageInfo
+
+func (m *JwtRequirementOrList) GetRequirements() []*JwtRequirement {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
+}
+
+// This message specifies a list of RequiredProvider.
+// Their results are AND-ed; all of them must pass, if one of them fails or missing, it fails.
+type JwtRequirementAndList struct {
+	// Specify a list of JwtRequirement.
+	Requirements         []*JwtRequirement `protobuf:"bytes,1,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *JwtRequirementAndList) Reset()         { *m = JwtRequirementAndList{} }
+func (m *JwtRequirementAndList) String() string { return proto.CompactTextString(m) }
+func (*JwtRequirementAndList) ProtoMessage()    {}
+func (*JwtRequirementAndList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{6}
+}
+func (m *JwtRequirementAndList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwtRequirementAndList.Unmarshal(m, b)
+}
+func (m *JwtRequirementAndList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwtRequirementAndList.Marshal(b, m, deterministic)
+}
+func (m *JwtRequirementAndList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwtRequirementAndList.Merge(m, src)
+}
+func (m *JwtRequirementAndList) XXX_Size() int {
+	return xxx_messageInfo_JwtRequirementAndList.Size(m)
+}
+func (m *JwtRequirementAndList) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwtRequirementAndList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwtRequirementAndList proto.InternalMessageInfo
+
+func (m *JwtRequirementAndList) GetRequirements() []*JwtRequirement {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
+}
+
+// This message specifies a Jwt requirement for a specific Route condition.
+type RequirementRule struct {
+	// The route matching parameter. Only when the match is satisfied, the "requires" field will
+	// apply.
+	Match *v31.RouteMatch `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Specify a Jwt Requirement. Please detail comment in message JwtRequirement.
+	Requires             *JwtRequirement `protobuf:"bytes,2,opt,name=requires,proto3" json:"requires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RequirementRule) Reset()         { *m = RequirementRule{} }
+func (m *RequirementRule) String() string { return proto.CompactTextString(m) }
+func (*RequirementRule) ProtoMessage()    {}
+func (*RequirementRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{7}
+}
+func (m *RequirementRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequirementRule.Unmarshal(m, b)
+}
+func (m *RequirementRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequirementRule.Marshal(b, m, deterministic)
+}
+func (m *RequirementRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequirementRule.Merge(m, src)
+}
+func (m *RequirementRule) XXX_Size() int {
+	return xxx_messageInfo_RequirementRule.Size(m)
+}
+func (m *RequirementRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequirementRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequirementRule proto.InternalMessageInfo
+
+func (m *RequirementRule) GetMatch() *v31.RouteMatch {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *RequirementRule) GetRequires() *JwtRequirement {
+	if m != nil {
+		return m.Requires
+	}
+	return nil
+}
+
+// This message specifies Jwt requirements based on stream_info.filterState.
+// This FilterState should use `Router::StringAccessor` object to set a string value.
+// Other HTTP filters can use it to specify Jwt requirements dynamically.
+type FilterStateRule struct {
+	// The filter state name to retrieve the `Router::StringAccessor` object.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A map of string keys to requirements. The string key is the string value
+	// in the FilterState with the name specified in the *name* field above.
+	Requires             map[string]*JwtRequirement `protobuf:"bytes,3,rep,name=requires,proto3" json:"requires,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *FilterStateRule) Reset()         { *m = FilterStateRule{} }
+func (m *FilterStateRule) String() string { return proto.CompactTextString(m) }
+func (*FilterStateRule) ProtoMessage()    {}
+func (*FilterStateRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{8}
+}
+func (m *FilterStateRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilterStateRule.Unmarshal(m, b)
+}
+func (m *FilterStateRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilterStateRule.Marshal(b, m, deterministic)
+}
+func (m *FilterStateRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterStateRule.Merge(m, src)
+}
+func (m *FilterStateRule) XXX_Size() int {
+	return xxx_messageInfo_FilterStateRule.Size(m)
+}
+func (m *FilterStateRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterStateRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilterStateRule proto.InternalMessageInfo
+
+func (m *FilterStateRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FilterStateRule) GetRequires() map[string]*JwtRequirement {
+	if m != nil {
+		return m.Requires
+	}
+	return nil
+}
+
+// This is the Envoy HTTP filter config for JWT authentication.
+// [#next-free-field: 6]
+type JwtAuthentication struct {
+	// Map of provider names to JwtProviders.
+	Providers map[string]*JwtProvider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Specifies requirements based on the route matches. The first matched requirement will be
+	// applied. If there are overlapped match conditions, please put the most specific match first.
+	Rules []*RequirementRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	// This message specifies Jwt requirements based on stream_info.filterState.
+	// Other HTTP filters can use it to specify Jwt requirements dynamically.
+	// The *rules* field above is checked first, if it could not find any matches,
+	// check this one.
+	FilterStateRules *FilterStateRule `protobuf:"bytes,3,opt,name=filter_state_rules,json=filterStateRules,proto3" json:"filter_state_rules,omitempty"`
+	// When set to true, bypass the `CORS preflight request
+	// <http://www.w3.org/TR/cors/#cross-origin-request-with-preflight>`_ regardless of JWT
+	// requirements specified in the rules.
+	BypassCorsPreflight bool `protobuf:"varint,4,opt,name=bypass_cors_preflight,json=bypassCorsPreflight,proto3" json:"bypass_cors_preflight,omitempty"`
+	// A map of unique requirement_names to JwtRequirements.
+	// :ref:`requirement_name <envoy_v3_api_field_extensions.filters.http.jwt_authn.v3.PerRouteConfig.requirement_name>`
+	// in `PerRouteConfig` uses this map to specify a JwtRequirement.
+	RequirementMap       map[string]*JwtRequirement `protobuf:"bytes,5,rep,name=requirement_map,json=requirementMap,proto3" json:"requirement_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *JwtAuthentication) Reset()         { *m = JwtAuthentication{} }
+func (m *JwtAuthentication) String() string { return proto.CompactTextString(m) }
+func (*JwtAuthentication) ProtoMessage()    {}
+func (*JwtAuthentication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{9}
+}
+func (m *JwtAuthentication) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwtAuthentication.Unmarshal(m, b)
+}
+func (m *JwtAuthentication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwtAuthentication.Marshal(b, m, deterministic)
+}
+func (m *JwtAuthentication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwtAuthentication.Merge(m, src)
+}
+func (m *JwtAuthentication) XXX_Size() int {
+	return xxx_messageInfo_JwtAuthentication.Size(m)
+}
+func (m *JwtAuthentication) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwtAuthentication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwtAuthentication proto.InternalMessageInfo
+
+func (m *JwtAuthentication) GetProviders() map[string]*JwtProvider {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+func (m *JwtAuthentication) GetRules() []*RequirementRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *JwtAuthentication) GetFilterStateRules() *FilterStateRule {
+	if m != nil {
+		return m.FilterStateRules
+	}
+	return nil
+}
+
+func (m *JwtAuthentication) GetBypassCorsPreflight() bool {
+	if m != nil {
+		return m.BypassCorsPreflight
+	}
+	return false
+}
+
+func (m *JwtAuthentication) GetRequirementMap() map[string]*JwtRequirement {
+	if m != nil {
+		return m.RequirementMap
+	}
+	return nil
+}
+
+// Specify per-route config.
+type PerRouteConfig struct {
+	// Types that are valid to be assigned to RequirementSpecifier:
+	//	*PerRouteConfig_Disabled
+	//	*PerRouteConfig_RequirementName
+	RequirementSpecifier isPerRouteConfig_RequirementSpecifier `protobuf_oneof:"requirement_specifier"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *PerRouteConfig) Reset()         { *m = PerRouteConfig{} }
+func (m *PerRouteConfig) String() string { return proto.CompactTextString(m) }
+func (*PerRouteConfig) ProtoMessage()    {}
+func (*PerRouteConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{10}
+}
+func (m *PerRouteConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PerRouteConfig.Unmarshal(m, b)
+}
+func (m *PerRouteConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PerRouteConfig.Marshal(b, m, deterministic)
+}
+func (m *PerRouteConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerRouteConfig.Merge(m, src)
+}
+func (m *PerRouteConfig) XXX_Size() int {
+	return xxx_messageInfo_PerRouteConfig.Size(m)
+}
+func (m *PerRouteConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerRouteConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerRouteConfig proto.InternalMessageInfo
+
+type isPerRouteConfig_RequirementSpecifier interface {
+	isPerRouteConfig_RequirementSpecifier()
+	Equal(interface{}) bool
+}
+
+type PerRouteConfig_Disabled struct {
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3,oneof" json:"disabled,omitempty"`
+}
+type PerRouteConfig_RequirementName struct {
+	RequirementName string `protobuf:"bytes,2,opt,name=requirement_name,json=requirementName,proto3,oneof" json:"requirement_name,omitempty"`
+}
+
+func (*PerRouteConfig_Disabled) isPerRouteConfig_RequirementSpecifier()        {}
+func (*PerRouteConfig_RequirementName) isPerRouteConfig_RequirementSpecifier() {}
+
+func (m *PerRouteConfig) GetRequirementSpecifier() isPerRouteConfig_RequirementSpecifier {
+	if m != nil {
+		return m.RequirementSpecifier
+	}
+	return nil
+}
+
+func (m *PerRouteConfig) GetDisabled() bool {
+	if x, ok := m.GetRequirementSpecifier().(*PerRouteConfig_Disabled); ok {
+		return x.Disabled
+	}
+	return false
+}
+
+func (m *PerRouteConfig) GetRequirementName() string {
+	if x, ok := m.GetRequirementSpecifier().(*PerRouteConfig_RequirementName); ok {
+		return x.RequirementName
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*PerRouteConfig) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*PerRouteConfig_Disabled)(nil),
+		(*PerRouteConfig_RequirementName)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*JwtProvider)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtProvider")
+	proto.RegisterType((*RemoteJwks)(nil), "envoy.extensions.filters.http.jwt_authn.v3.RemoteJwks")
+	proto.RegisterType((*JwtHeader)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtHeader")
+	proto.RegisterType((*ProviderWithAudiences)(nil), "envoy.extensions.filters.http.jwt_authn.v3.ProviderWithAudiences")
+	proto.RegisterType((*JwtRequirement)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtRequirement")
+	proto.RegisterType((*JwtRequirementOrList)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtRequirementOrList")
+	proto.RegisterType((*JwtRequirementAndList)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtRequirementAndList")
+	proto.RegisterType((*RequirementRule)(nil), "envoy.extensions.filters.http.jwt_authn.v3.RequirementRule")
+	proto.RegisterType((*FilterStateRule)(nil), "envoy.extensions.filters.http.jwt_authn.v3.FilterStateRule")
+	proto.RegisterMapType((map[string]*JwtRequirement)(nil), "envoy.extensions.filters.http.jwt_authn.v3.FilterStateRule.RequiresEntry")
+	proto.RegisterType((*JwtAuthentication)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtAuthentication")
+	proto.RegisterMapType((map[string]*JwtProvider)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtAuthentication.ProvidersEntry")
+	proto.RegisterMapType((map[string]*JwtRequirement)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtAuthentication.RequirementMapEntry")
+	proto.RegisterType((*PerRouteConfig)(nil), "envoy.extensions.filters.http.jwt_authn.v3.PerRouteConfig")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/jwt_authn/v3/config.proto", fileDescriptor_c139cc57e0e9ac91)
+}
+
+var fileDescriptor_c139cc57e0e9ac91 = []byte{
+	// 1336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0x16, 0xe9, 0x9b, 0x74, 0x64, 0xd9, 0xca, 0xc4, 0x76, 0xf8, 0x3b, 0x37, 0x45, 0x41, 0x00,
+	0xe3, 0x47, 0x4a, 0x02, 0x4e, 0x2f, 0x41, 0x82, 0x02, 0x91, 0x72, 0x81, 0x62, 0xc4, 0x8d, 0x42,
+	0xa3, 0xb7, 0x2c, 0x4a, 0x8c, 0xc9, 0x91, 0x34, 0x16, 0xc5, 0x61, 0x67, 0x86, 0x56, 0x54, 0xa0,
+	0x40, 0xba, 0x2b, 0x5a, 0xa0, 0x8b, 0x3e, 0x42, 0x57, 0x5d, 0x74, 0x5b, 0xa0, 0xe8, 0xaa, 0xcb,
+	0x6c, 0xfb, 0x0a, 0xed, 0x4b, 0x14, 0x5e, 0x15, 0x1c, 0x92, 0x92, 0x98, 0x08, 0x6d, 0x94, 0x14,
+	0xd9, 0xcd, 0xcc, 0x39, 0xdf, 0x77, 0xce, 0x9c, 0x1b, 0x87, 0x70, 0xd4, 0xa5, 0xb2, 0x17, 0x1d,
+	0x9a, 0x2e, 0x1b, 0x58, 0x82, 0xf9, 0xec, 0x2d, 0xca, 0xac, 0xae, 0xcf, 0x98, 0x15, 0x72, 0x76,
+	0x44, 0x5c, 0x29, 0x92, 0x1d, 0x0e, 0xa9, 0x45, 0x9e, 0x48, 0xc2, 0x03, 0xec, 0x5b, 0x24, 0x38,
+	0x66, 0x23, 0xb5, 0x0d, 0x04, 0x65, 0x81, 0xb0, 0x3a, 0xd4, 0x97, 0x84, 0x0b, 0xab, 0x27, 0x65,
+	0x68, 0x1d, 0x0d, 0xa5, 0x83, 0x23, 0xd9, 0x0b, 0xac, 0xe3, 0x6b, 0x96, 0xcb, 0x82, 0x0e, 0xed,
+	0x9a, 0x21, 0x67, 0x92, 0xa1, 0xff, 0x2b, 0xa0, 0x39, 0x01, 0x9a, 0x29, 0xd0, 0x8c, 0x81, 0xe6,
+	0x18, 0x68, 0x1e, 0x5f, 0xdb, 0xbe, 0x98, 0x18, 0x49, 0xf0, 0x96, 0xcb, 0x38, 0x89, 0xe9, 0x0e,
+	0xb1, 0x20, 0x09, 0xd9, 0xf6, 0xe5, 0x99, 0x0a, 0x31, 0x91, 0x13, 0x71, 0x9a, 0x2a, 0x5d, 0xcd,
+	0x29, 0x71, 0x16, 0x49, 0xa5, 0xa5, 0x16, 0x8e, 0xcb, 0x06, 0x21, 0x0b, 0x48, 0x20, 0x45, 0xaa,
+	0x7d, 0xa1, 0xcb, 0x58, 0xd7, 0x27, 0x96, 0xda, 0x1d, 0x46, 0x1d, 0xcb, 0x8b, 0x38, 0x96, 0x94,
+	0x05, 0xa9, 0xfc, 0xec, 0xf3, 0x72, 0x32, 0x08, 0xe5, 0x28, 0x15, 0x9e, 0x8f, 0xbc, 0x10, 0x5b,
+	0x38, 0x08, 0x98, 0x54, 0x18, 0x61, 0x09, 0x89, 0x65, 0x94, 0x71, 0x9f, 0x39, 0xc6, 0x3e, 0xf5,
+	0x70, 0x6c, 0x3f, 0x5d, 0xa4, 0x82, 0x8d, 0x2e, 0xeb, 0x32, 0xb5, 0xb4, 0xe2, 0x55, 0x72, 0x5a,
+	0xff, 0x79, 0x11, 0xca, 0x7b, 0x43, 0xd9, 0xe6, 0xec, 0x98, 0x7a, 0x84, 0xa3, 0x2d, 0x58, 0xa6,
+	0x42, 0x44, 0x84, 0x1b, 0x5a, 0x4d, 0xdb, 0x29, 0xd9, 0xe9, 0x0e, 0x9d, 0x83, 0x12, 0x8e, 0x3c,
+	0x4a, 0x02, 0x97, 0x08, 0x43, 0xaf, 0x2d, 0xec, 0x94, 0xec, 0xc9, 0x01, 0xfa, 0x14, 0xca, 0x9c,
+	0x0c, 0x98, 0x24, 0xce, 0xd1, 0xb0, 0x2f, 0x8c, 0x85, 0x9a, 0xb6, 0x53, 0xde, 0x7d, 0xd7, 0x7c,
+	0xf9, 0x34, 0x98, 0xb6, 0x82, 0xef, 0x0d, 0xfb, 0xa2, 0x55, 0xb0, 0x81, 0x8f, 0x77, 0xa8, 0x01,
+	0xe0, 0x33, 0x17, 0xfb, 0x09, 0xf3, 0xa2, 0x62, 0xae, 0xa5, 0xcc, 0x69, 0xd2, 0xe3, 0x9c, 0xc4,
+	0x1c, 0x77, 0xb0, 0xc4, 0x07, 0x2c, 0xe2, 0x2e, 0x69, 0x15, 0xec, 0x92, 0x42, 0x29, 0x0a, 0x03,
+	0x56, 0x3a, 0x8c, 0x0f, 0x31, 0xf7, 0x8c, 0xa5, 0x9a, 0xb6, 0x53, 0xb4, 0xb3, 0x2d, 0xfa, 0x04,
+	0x56, 0x3b, 0x9c, 0x0d, 0x9c, 0x1e, 0xc1, 0x1e, 0xe1, 0xc2, 0x58, 0xae, 0x2d, 0xec, 0x94, 0x77,
+	0xdf, 0x99, 0xc7, 0xf1, 0xbd, 0xa1, 0x6c, 0x29, 0xb4, 0x5d, 0x8e, 0xa9, 0x92, 0xb5, 0x40, 0x17,
+	0x41, 0x6d, 0x9d, 0x10, 0x73, 0x3c, 0x10, 0xc6, 0x8a, 0x8a, 0x18, 0xc4, 0x47, 0x6d, 0x75, 0x82,
+	0x1a, 0xb0, 0x95, 0x7a, 0xe1, 0x84, 0x78, 0xe4, 0x33, 0xec, 0xa5, 0x5e, 0x18, 0xc5, 0x38, 0xf0,
+	0xcd, 0xf2, 0x49, 0xb3, 0xc8, 0x97, 0x7f, 0xd3, 0xb4, 0x67, 0x5a, 0xc1, 0xde, 0x48, 0x55, 0xdb,
+	0x89, 0x66, 0x62, 0x04, 0x99, 0x70, 0x3a, 0x83, 0xd2, 0xc0, 0x19, 0x10, 0x89, 0x3d, 0x2c, 0xb1,
+	0x51, 0x52, 0x89, 0x3b, 0x95, 0x8a, 0xee, 0x07, 0xfb, 0xa9, 0x00, 0x5d, 0x05, 0xe4, 0xfa, 0xcc,
+	0xed, 0x3b, 0xa2, 0x4f, 0x86, 0x8e, 0x20, 0x2e, 0x0b, 0x3c, 0x61, 0x40, 0x4d, 0xdb, 0xa9, 0xd8,
+	0x55, 0x25, 0x39, 0xe8, 0x93, 0xe1, 0x41, 0x72, 0xde, 0x3c, 0x07, 0x9b, 0x71, 0xc8, 0x1d, 0xa1,
+	0x22, 0xea, 0x88, 0x90, 0xb8, 0xb4, 0x43, 0x09, 0x47, 0x0b, 0x7f, 0x35, 0xb5, 0xfa, 0xd7, 0x1a,
+	0xc0, 0x24, 0x67, 0xe8, 0x3a, 0x14, 0xb3, 0x8e, 0x50, 0x85, 0x53, 0xde, 0x3d, 0x3f, 0x3b, 0x47,
+	0x2d, 0x29, 0xc3, 0x0f, 0x39, 0xb5, 0x57, 0x7a, 0xc9, 0x02, 0xdd, 0x82, 0x35, 0x17, 0xbb, 0x3d,
+	0xe2, 0x64, 0x3d, 0x60, 0xe8, 0x0a, 0xff, 0x3f, 0x33, 0x69, 0x02, 0x33, 0x6b, 0x02, 0xf3, 0x4e,
+	0xaa, 0x60, 0x57, 0x14, 0x20, 0xdb, 0xd6, 0x3f, 0x83, 0xd2, 0x38, 0x09, 0xe8, 0x12, 0x2c, 0x06,
+	0x78, 0x40, 0x92, 0xea, 0x6d, 0x56, 0x4e, 0x9a, 0xc0, 0x8b, 0x55, 0x2d, 0x0d, 0xa3, 0x12, 0x21,
+	0x13, 0x56, 0x8f, 0xb1, 0x1f, 0x11, 0x27, 0xe4, 0xa4, 0x43, 0x9f, 0x18, 0x7a, 0x2e, 0xde, 0x7a,
+	0xac, 0x58, 0x56, 0x0a, 0x6d, 0x25, 0xaf, 0x3f, 0x86, 0xcd, 0xac, 0x3d, 0x3e, 0xa6, 0xb2, 0xd7,
+	0x18, 0x57, 0xfd, 0x65, 0xa8, 0x84, 0xa9, 0xc0, 0x99, 0x18, 0xb5, 0x57, 0xb3, 0xc3, 0x0f, 0x62,
+	0x6b, 0xff, 0xd8, 0x38, 0xf5, 0x1f, 0x16, 0x61, 0x6d, 0x6f, 0x28, 0x6d, 0xf2, 0x79, 0x44, 0x39,
+	0x19, 0x90, 0x40, 0xa2, 0x2b, 0x33, 0x59, 0x5b, 0x85, 0xe7, 0x78, 0x47, 0xb0, 0x35, 0x56, 0xc3,
+	0x81, 0xe7, 0x4c, 0x1b, 0x89, 0xe3, 0xd7, 0x98, 0xa7, 0x88, 0x67, 0xde, 0xaf, 0x55, 0xb0, 0x37,
+	0x32, 0x13, 0x8d, 0xc0, 0x9b, 0xdc, 0x9b, 0xc0, 0x2a, 0x4f, 0x1c, 0x16, 0x0e, 0x0e, 0x46, 0x69,
+	0xbb, 0xdf, 0x9a, 0xb3, 0x6b, 0xa6, 0xee, 0xfc, 0x90, 0x3f, 0xa0, 0x42, 0xb6, 0x0a, 0x76, 0x39,
+	0xe3, 0x6d, 0x04, 0x23, 0xd4, 0x99, 0x36, 0xe3, 0xfb, 0xc6, 0xe2, 0xfc, 0xf7, 0xca, 0x9b, 0x69,
+	0x04, 0xde, 0x0b, 0x76, 0x7c, 0x1f, 0x3d, 0x84, 0x33, 0xd8, 0xf7, 0xd9, 0xd0, 0x19, 0x50, 0x21,
+	0x68, 0xd0, 0x75, 0x18, 0x77, 0x3a, 0x98, 0xfa, 0x24, 0x19, 0x17, 0xe5, 0xdd, 0xad, 0x17, 0x4a,
+	0xf1, 0x6e, 0x3c, 0x8f, 0xe3, 0xf8, 0x28, 0xe0, 0x7e, 0x82, 0x7b, 0xc8, 0xef, 0x29, 0x14, 0x7a,
+	0x1f, 0x2a, 0x39, 0x42, 0x63, 0xf9, 0x5f, 0x68, 0x56, 0xa7, 0x69, 0x9a, 0xeb, 0x50, 0x19, 0xdf,
+	0x5b, 0x8e, 0x42, 0x52, 0x7f, 0xaa, 0xc1, 0xc6, 0xac, 0x80, 0xa1, 0xde, 0x38, 0x42, 0xf1, 0xa1,
+	0x30, 0x34, 0x35, 0xbe, 0x6e, 0xbc, 0x7a, 0x84, 0x9a, 0xc5, 0x93, 0xe6, 0xd2, 0xf7, 0x9a, 0x5e,
+	0xd4, 0xed, 0x1c, 0x73, 0xfd, 0x2b, 0x0d, 0x36, 0x67, 0x06, 0xf3, 0x0d, 0xfa, 0xf0, 0x93, 0x06,
+	0xeb, 0x53, 0x7a, 0x76, 0xe4, 0x13, 0xd4, 0x80, 0xa5, 0x01, 0x96, 0x6e, 0x2f, 0x1d, 0x3a, 0x97,
+	0xf2, 0x43, 0x47, 0x7d, 0x7e, 0xd5, 0xd7, 0x25, 0x5e, 0xec, 0xc7, 0x8a, 0x8a, 0xfd, 0x1b, 0x4d,
+	0xaf, 0x6a, 0x76, 0x82, 0x44, 0x1f, 0x41, 0x31, 0x0b, 0x77, 0xda, 0x3a, 0xaf, 0xe1, 0xbc, 0x3d,
+	0xe6, 0xaa, 0x7f, 0xa7, 0xc3, 0xfa, 0x3d, 0x05, 0x3b, 0x90, 0x58, 0x12, 0xe5, 0xee, 0xd9, 0xdc,
+	0x74, 0x5a, 0x39, 0x69, 0x2e, 0xf2, 0xd8, 0x13, 0x75, 0x88, 0xc8, 0x94, 0x23, 0x0b, 0x2a, 0x8a,
+	0xf7, 0xe7, 0x71, 0xe4, 0x39, 0x5b, 0x66, 0xea, 0x95, 0xb8, 0x1b, 0x48, 0x3e, 0x9a, 0xf8, 0xb5,
+	0x3d, 0x84, 0x4a, 0x4e, 0x84, 0xaa, 0xb0, 0xd0, 0x27, 0xa3, 0x74, 0x78, 0xc5, 0x4b, 0xd4, 0x86,
+	0x25, 0x35, 0x00, 0xff, 0x83, 0x78, 0x24, 0x44, 0x37, 0xf4, 0xeb, 0x5a, 0xfd, 0xcf, 0x25, 0x38,
+	0xb5, 0x37, 0x94, 0x8d, 0x48, 0xf6, 0x48, 0x20, 0xa9, 0xab, 0xa6, 0x37, 0x3a, 0x82, 0x52, 0x36,
+	0x64, 0xb2, 0xe2, 0x79, 0x30, 0xa7, 0xbd, 0x3c, 0xe3, 0x78, 0x98, 0xa5, 0x37, 0x9f, 0xd0, 0xa3,
+	0x47, 0xb0, 0xc4, 0x23, 0x3f, 0x9d, 0xc3, 0xe5, 0xdd, 0x9b, 0xf3, 0x3d, 0x50, 0x72, 0x95, 0x67,
+	0x27, 0x4c, 0x88, 0x02, 0x4a, 0x30, 0x4e, 0xfc, 0x0a, 0x23, 0x4e, 0xc2, 0x9f, 0x4c, 0xc4, 0x9b,
+	0xaf, 0x91, 0x3e, 0xbb, 0xda, 0xc9, 0x1f, 0x08, 0xb4, 0x0b, 0x9b, 0x87, 0xa3, 0x10, 0x0b, 0xe1,
+	0xb8, 0x8c, 0x0b, 0xf5, 0xf5, 0xf2, 0x69, 0xb7, 0x27, 0xd5, 0x60, 0x2c, 0xda, 0xa7, 0x13, 0xe1,
+	0x6d, 0xc6, 0x45, 0x3b, 0x13, 0xa1, 0x2f, 0x60, 0x7d, 0xaa, 0x87, 0x9c, 0x01, 0x0e, 0x8d, 0x25,
+	0x75, 0xf7, 0x47, 0xaf, 0x17, 0xe3, 0xa9, 0x68, 0xec, 0xe3, 0x30, 0x09, 0xf4, 0x1a, 0xcf, 0x1d,
+	0x6e, 0x47, 0xb0, 0x96, 0x4f, 0xc5, 0x8c, 0x4a, 0xdb, 0xcf, 0x57, 0xda, 0x7b, 0x73, 0x7a, 0x95,
+	0xf1, 0x4f, 0x95, 0xd9, 0xf6, 0x97, 0x70, 0x7a, 0x86, 0x77, 0x6f, 0xac, 0xca, 0xbf, 0xd5, 0x60,
+	0xad, 0x4d, 0xb8, 0x9a, 0x38, 0xb7, 0xd5, 0x18, 0x42, 0x57, 0xa0, 0xe8, 0x51, 0x81, 0x0f, 0xe3,
+	0x2f, 0x4a, 0x6c, 0xbf, 0xa8, 0x3a, 0xff, 0x48, 0x2f, 0x6a, 0xad, 0x82, 0x3d, 0x16, 0xa1, 0xb7,
+	0xa1, 0x3a, 0x9d, 0x2b, 0x35, 0x28, 0xf4, 0xdc, 0xa0, 0x68, 0x15, 0xec, 0xe9, 0x74, 0xc6, 0xef,
+	0x80, 0xf8, 0x99, 0x36, 0x8d, 0xca, 0x3f, 0xd3, 0x9a, 0xbf, 0x68, 0x3f, 0xfe, 0x71, 0x41, 0xfb,
+	0xf5, 0xe9, 0xb3, 0xdf, 0x97, 0xf5, 0xaa, 0x0e, 0xd7, 0x29, 0x4b, 0x6e, 0x19, 0x72, 0xf6, 0x64,
+	0x34, 0xc7, 0x85, 0x9b, 0xe5, 0xe4, 0x2e, 0x6d, 0xce, 0x24, 0x6b, 0x6b, 0x8f, 0xf1, 0xcb, 0xfd,
+	0xcd, 0x85, 0xfd, 0xee, 0xab, 0xfe, 0xd1, 0x1d, 0x2e, 0xab, 0xcf, 0xe4, 0xb5, 0xbf, 0x07, 0x00,
+	0xca, 0x0f, 0x95, 0x2a, 0x39, 0x0e, 0x00, 0x00,
+}
+
+func (this *JwtProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtProvider)
+	if !ok {
+		that2, ok := that.(JwtProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Issuer != that1.Issuer {
+		return false
+	}
+	if len(this.Audiences) != len(that1.Audiences) {
+		return false
+	}
+	for i := range this.Audiences {
+		if this.Audiences[i] != that1.Audiences[i] {
+			return false
+		}
+	}
+	if that1.JwksSourceSpecifier == nil {
+		if this.JwksSourceSpecifier != nil {
+			return false
+		}
+	} else if this.JwksSourceSpecifier == nil {
+		return false
+	} else if !this.JwksSourceSpecifier.Equal(that1.JwksSourceSpecifier) {
+		return false
+	}
+	if this.Forward != that1.Forward {
+		return false
+	}
+	if len(this.FromHeaders) != len(that1.FromHeaders) {
+		return false
+	}
+	for i := range this.FromHeaders {
+		if !this.FromHeaders[i].Equal(that1.FromHeaders[i]) {
+			return false
+		}
+	}
+	if len(this.FromParams) != len(that1.FromParams) {
+		return false
+	}
+	for i := range this.FromParams {
+		if this.FromParams[i] != that1.FromParams[i] {
+			return false
+		}
+	}
+	if this.ForwardPayloadHeader != that1.ForwardPayloadHeader {
+		return false
+	}
+	if this.PayloadInMetadata != that1.PayloadInMetadata {
+		return false
+	}
+	if this.ClockSkewSeconds != that1.ClockSkewSeconds {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwtProvider_RemoteJwks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtProvider_RemoteJwks)
+	if !ok {
+		that2, ok := that.(JwtProvider_RemoteJwks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RemoteJwks.Equal(that1.RemoteJwks) {
+		return false
+	}
+	return true
+}
+func (this *JwtProvider_LocalJwks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtProvider_LocalJwks)
+	if !ok {
+		that2, ok := that.(JwtProvider_LocalJwks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LocalJwks.Equal(that1.LocalJwks) {
+		return false
+	}
+	return true
+}
+func (this *RemoteJwks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoteJwks)
+	if !ok {
+		that2, ok := that.(RemoteJwks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HttpUri.Equal(that1.HttpUri) {
+		return false
+	}
+	if !this.CacheDuration.Equal(that1.CacheDuration) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwtHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtHeader)
+	if !ok {
+		that2, ok := that.(JwtHeader)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.ValuePrefix != that1.ValuePrefix {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ProviderWithAudiences) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProviderWithAudiences)
+	if !ok {
+		that2, ok := that.(ProviderWithAudiences)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProviderName != that1.ProviderName {
+		return false
+	}
+	if len(this.Audiences) != len(that1.Audiences) {
+		return false
+	}
+	for i := range this.Audiences {
+		if this.Audiences[i] != that1.Audiences[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement)
+	if !ok {
+		that2, ok := that.(JwtRequirement)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.RequiresType == nil {
+		if this.RequiresType != nil {
+			return false
+		}
+	} else if this.RequiresType == nil {
+		return false
+	} else if !this.RequiresType.Equal(that1.RequiresType) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement_ProviderName) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement_ProviderName)
+	if !ok {
+		that2, ok := that.(JwtRequirement_ProviderName)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProviderName != that1.ProviderName {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement_ProviderAndAudiences) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement_ProviderAndAudiences)
+	if !ok {
+		that2, ok := that.(JwtRequirement_ProviderAndAudiences)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ProviderAndAudiences.Equal(that1.ProviderAndAudiences) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement_RequiresAny) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement_RequiresAny)
+	if !ok {
+		that2, ok := that.(JwtRequirement_RequiresAny)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequiresAny.Equal(that1.RequiresAny) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement_RequiresAll) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement_RequiresAll)
+	if !ok {
+		that2, ok := that.(JwtRequirement_RequiresAll)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequiresAll.Equal(that1.RequiresAll) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement_AllowMissingOrFailed) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement_AllowMissingOrFailed)
+	if !ok {
+		that2, ok := that.(JwtRequirement_AllowMissingOrFailed)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AllowMissingOrFailed.Equal(that1.AllowMissingOrFailed) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement_AllowMissing) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement_AllowMissing)
+	if !ok {
+		that2, ok := that.(JwtRequirement_AllowMissing)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AllowMissing.Equal(that1.AllowMissing) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirementOrList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirementOrList)
+	if !ok {
+		that2, ok := that.(JwtRequirementOrList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Requirements) != len(that1.Requirements) {
+		return false
+	}
+	for i := range this.Requirements {
+		if !this.Requirements[i].Equal(that1.Requirements[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirementAndList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirementAndList)
+	if !ok {
+		that2, ok := that.(JwtRequirementAndList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Requirements) != len(that1.Requirements) {
+		return false
+	}
+	for i := range this.Requirements {
+		if !this.Requirements[i].Equal(that1.Requirements[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RequirementRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RequirementRule)
+	if !ok {
+		that2, ok := that.(RequirementRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Match.Equal(that1.Match) {
+		return false
+	}
+	if !this.Requires.Equal(that1.Requires) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *FilterStateRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FilterStateRule)
+	if !ok {
+		that2, ok := that.(FilterStateRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Requires) != len(that1.Requires) {
+		return false
+	}
+	for i := range this.Requires {
+		if !this.Requires[i].Equal(that1.Requires[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwtAuthentication) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtAuthentication)
+	if !ok {
+		that2, ok := that.(JwtAuthentication)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Providers) != len(that1.Providers) {
+		return false
+	}
+	for i := range this.Providers {
+		if !this.Providers[i].Equal(that1.Providers[i]) {
+			return false
+		}
+	}
+	if len(this.Rules) != len(that1.Rules) {
+		return false
+	}
+	for i := range this.Rules {
+		if !this.Rules[i].Equal(that1.Rules[i]) {
+			return false
+		}
+	}
+	if !this.FilterStateRules.Equal(that1.FilterStateRules) {
+		return false
+	}
+	if this.BypassCorsPreflight != that1.BypassCorsPreflight {
+		return false
+	}
+	if len(this.RequirementMap) != len(that1.RequirementMap) {
+		return false
+	}
+	for i := range this.RequirementMap {
+		if !this.RequirementMap[i].Equal(that1.RequirementMap[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PerRouteConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PerRouteConfig)
+	if !ok {
+		that2, ok := that.(PerRouteConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.RequirementSpecifier == nil {
+		if this.RequirementSpecifier != nil {
+			return false
+		}
+	} else if this.RequirementSpecifier == nil {
+		return false
+	} else if !this.RequirementSpecifier.Equal(that1.RequirementSpecifier) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PerRouteConfig_Disabled) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PerRouteConfig_Disabled)
+	if !ok {
+		that2, ok := that.(PerRouteConfig_Disabled)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Disabled != that1.Disabled {
+		return false
+	}
+	return true
+}
+func (this *PerRouteConfig_RequirementName) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PerRouteConfig_RequirementName)
+	if !ok {
+		that2, ok := that.(PerRouteConfig_RequirementName)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RequirementName != that1.RequirementName {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/local_ratelimit/v3/local_rate_limit.proto
+
+package v3
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	v31 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	_ "github.com/solo-io/gloo/projects/gloo/pkg/api/external/udpa/annotations"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// [#next-free-field: 7]
+type LocalRateLimit struct {
+	// The human readable prefix to use when emitting stats.
+	StatPrefix string `protobuf:"bytes,1,opt,name=stat_prefix,json=statPrefix,proto3" json:"stat_prefix,omitempty"`
+	// This field allows for a custom HTTP response status code to the downstream client when
+	// the request has been rate limited.
+	// Defaults to 429 (TooManyRequests).
+	//
+	// Note: if this is set to < 400, 429 will be used instead.
+	Status *v3.HttpStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// The token bucket configuration to use for rate limiting requests that are processed by this
+	// filter. Each request processed by the filter consumes a single token. If the token is available,
+	// the request will be allowed. If no tokens are available, the request will receive the configured
+	// rate limit status.
+	//
+	// Note: it's fine for the token bucket to be unset for the global configuration since the rate limit
+	// can be applied at a the virtual host or route level. Thus, the token bucket must be set
+	// for the per route configuration otherwise the config will be rejected.
+	//
+	// Note: when using per route configuration, the bucket becomes unique to that route.
+	//
+	// Note: in the current implementation the token bucket's `fill_interval` must be >= 50ms
+	// to avoid too aggressive refills.
+	TokenBucket *v3.TokenBucket `protobuf:"bytes,3,opt,name=token_bucket,json=tokenBucket,proto3" json:"token_bucket,omitempty"`
+	// If set, this will enable -- but not necessarily enforce -- the rate limit for the given
+	// fraction of requests.
+	// Defaults to 0% of requests for safety.
+	FilterEnabled *v31.RuntimeFractionalPercent `protobuf:"bytes,4,opt,name=filter_enabled,json=filterEnabled,proto3" json:"filter_enabled,omitempty"`
+	// If set, this will enforce the rate limit decisions for the given fraction of requests.
+	//
+	// Note: this only applies to the fraction of enabled requests.
+	//
+	// Defaults to 0% of requests for safety.
+	FilterEnforced *v31.RuntimeFractionalPercent `protobuf:"bytes,5,opt,name=filter_enforced,json=filterEnforced,proto3" json:"filter_enforced,omitempty"`
+	// Specifies a list of HTTP headers that should be added to each response for requests that
+	// have been rate limited.
+	ResponseHeadersToAdd []*v31.HeaderValueOption `protobuf:"bytes,6,rep,name=response_headers_to_add,json=responseHeadersToAdd,proto3" json:"response_headers_to_add,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *LocalRateLimit) Reset()         { *m = LocalRateLimit{} }
+func (m *LocalRateLimit) String() string { return proto.CompactTextString(m) }
+func (*LocalRateLimit) ProtoMessage()    {}
+func (*LocalRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6737da28e58ed4, []int{0}
+}
+func (m *LocalRateLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocalRateLimit.Unmarshal(m, b)
+}
+func (m *LocalRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocalRateLimit.Marshal(b, m, deterministic)
+}
+func (m *LocalRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalRateLimit.Merge(m, src)
+}
+func (m *LocalRateLimit) XXX_Size() int {
+	return xxx_messageInfo_LocalRateLimit.Size(m)
+}
+func (m *LocalRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalRateLimit proto.InternalMessageInfo
+
+func (m *LocalRateLimit) GetStatPrefix() string {
+	if m != nil {
+		return m.StatPrefix
+	}
+	return ""
+}
+
+func (m *LocalRateLimit) GetStatus() *v3.HttpStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *LocalRateLimit) GetTokenBucket() *v3.TokenBucket {
+	if m != nil {
+		return m.TokenBucket
+	}
+	return nil
+}
+
+func (m *LocalRateLimit) GetFilterEnabled() *v31.RuntimeFractionalPercent {
+	if m != nil {
+		return m.FilterEnabled
+	}
+	return nil
+}
+
+func (m *LocalRateLimit) GetFilterEnforced() *v31.RuntimeFractionalPercent {
+	if m != nil {
+		return m.FilterEnforced
+	}
+	return nil
+}
+
+func (m *LocalRateLimit) GetResponseHeadersToAdd() []*v31.HeaderValueOption {
+	if m != nil {
+		return m.ResponseHeadersToAdd
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LocalRateLimit)(nil), "envoy.extensions.filters.http.local_ratelimit.v3.LocalRateLimit")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/local_ratelimit/v3/local_rate_limit.proto", fileDescriptor_ab6737da28e58ed4)
+}
+
+var fileDescriptor_ab6737da28e58ed4 = []byte{
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0x6e, 0x13, 0x3f,
+	0x10, 0xc7, 0xb5, 0x69, 0x9b, 0xdf, 0x8f, 0x0d, 0x94, 0x6a, 0xa9, 0xd4, 0x50, 0x09, 0x88, 0xb8,
+	0x90, 0x0b, 0x36, 0x34, 0x67, 0x90, 0x58, 0x09, 0xd4, 0x43, 0x25, 0xa2, 0xa5, 0x80, 0xc4, 0x65,
+	0xe5, 0xec, 0x4e, 0x36, 0xa6, 0x5b, 0x8f, 0x65, 0xcf, 0x46, 0x29, 0x27, 0x9e, 0x81, 0xa7, 0xe0,
+	0x11, 0x10, 0x0f, 0x80, 0x38, 0xc2, 0x2b, 0xf0, 0x0e, 0x5c, 0x7a, 0x42, 0xb6, 0x37, 0xb4, 0xa9,
+	0x38, 0xf0, 0xe7, 0x36, 0x7f, 0xbe, 0xfe, 0x8c, 0xc7, 0x33, 0x8e, 0xdf, 0x54, 0x92, 0x66, 0xcd,
+	0x84, 0x15, 0x78, 0xcc, 0x2d, 0xd6, 0x78, 0x57, 0x22, 0xaf, 0x6a, 0x44, 0xae, 0x0d, 0xbe, 0x86,
+	0x82, 0x6c, 0xf0, 0x84, 0x96, 0x1c, 0x16, 0x04, 0x46, 0x89, 0x9a, 0x83, 0x9a, 0xe3, 0x89, 0x77,
+	0x95, 0x95, 0xa8, 0x2c, 0x9f, 0xca, 0x9a, 0xc0, 0x58, 0x3e, 0x23, 0xd2, 0xbc, 0xc6, 0x42, 0xd4,
+	0xb9, 0x11, 0x04, 0xb5, 0x3c, 0x96, 0xc4, 0xe7, 0xa3, 0x73, 0xa1, 0xdc, 0xc7, 0x98, 0x36, 0x48,
+	0x98, 0xdc, 0xf3, 0x20, 0x76, 0x06, 0x62, 0x2d, 0x88, 0x39, 0x10, 0xbb, 0x00, 0x62, 0xf3, 0xd1,
+	0xee, 0xad, 0x50, 0xba, 0x40, 0x35, 0x95, 0x15, 0x2f, 0xd0, 0x80, 0xc3, 0x4f, 0x84, 0x85, 0x80,
+	0x5c, 0x0a, 0xe8, 0x44, 0xfb, 0x8c, 0xe3, 0xe4, 0x96, 0x04, 0x35, 0xb6, 0x15, 0x0c, 0x56, 0x05,
+	0x84, 0x47, 0xa0, 0xf2, 0x49, 0x53, 0x1c, 0x41, 0x7b, 0xab, 0xdd, 0x1b, 0x4d, 0xa9, 0x05, 0x17,
+	0x4a, 0x21, 0x09, 0xf2, 0xed, 0xad, 0x00, 0x76, 0xe6, 0xa2, 0x96, 0xa5, 0x20, 0xe0, 0x4b, 0xa3,
+	0x4d, 0x6c, 0x57, 0x58, 0xa1, 0x37, 0xb9, 0xb3, 0xda, 0x68, 0x02, 0x0b, 0x0a, 0x41, 0x58, 0xb4,
+	0x15, 0x6e, 0x7f, 0x5a, 0x8b, 0x37, 0x0f, 0x5c, 0x73, 0x99, 0x20, 0x38, 0x70, 0xbd, 0x25, 0xc3,
+	0xb8, 0xe7, 0xaa, 0xe4, 0xda, 0xc0, 0x54, 0x2e, 0xfa, 0xd1, 0x20, 0x1a, 0x5e, 0x4a, 0xff, 0x3b,
+	0x4d, 0xd7, 0x4d, 0x67, 0x2b, 0xca, 0x62, 0x97, 0x1b, 0xfb, 0x54, 0x72, 0x3f, 0xee, 0x86, 0xfb,
+	0xf4, 0x3b, 0x83, 0x68, 0xd8, 0xdb, 0xbb, 0xce, 0xc2, 0x2b, 0xba, 0x8e, 0xd8, 0x7c, 0xc4, 0xf6,
+	0x89, 0xf4, 0x33, 0x2f, 0xc8, 0x5a, 0x61, 0xf2, 0x20, 0xbe, 0x7c, 0xbe, 0xcf, 0xfe, 0x9a, 0x3f,
+	0xb8, 0x7b, 0xe1, 0xe0, 0xa1, 0x93, 0xa4, 0x5e, 0x91, 0xf5, 0xe8, 0xcc, 0x49, 0x9e, 0xc7, 0x9b,
+	0x61, 0x2e, 0x39, 0x28, 0x31, 0xa9, 0xa1, 0xec, 0xaf, 0x7b, 0x00, 0x6b, 0x01, 0x61, 0x1a, 0xcc,
+	0x4d, 0xc3, 0x71, 0xb2, 0x46, 0x91, 0x3c, 0x86, 0x27, 0x46, 0x14, 0xee, 0xf9, 0x44, 0x3d, 0x06,
+	0x53, 0x80, 0xa2, 0xec, 0x4a, 0xa0, 0x3c, 0x0e, 0x90, 0xe4, 0x65, 0x7c, 0xf5, 0x27, 0x76, 0x8a,
+	0xa6, 0x80, 0xb2, 0xbf, 0xf1, 0x57, 0xdc, 0xcd, 0x25, 0x37, 0x50, 0x92, 0x59, 0xbc, 0x63, 0xc0,
+	0x6a, 0x54, 0x16, 0xf2, 0x19, 0x88, 0x12, 0x8c, 0xcd, 0x09, 0x73, 0x51, 0x96, 0xfd, 0xee, 0x60,
+	0x6d, 0xd8, 0xdb, 0xbb, 0xf3, 0xeb, 0x02, 0xfb, 0x5e, 0xfb, 0x42, 0xd4, 0x0d, 0x3c, 0xd5, 0xae,
+	0x44, 0xfa, 0xff, 0x69, 0xba, 0xf1, 0x2e, 0xea, 0x6c, 0xc5, 0xd9, 0xf6, 0x92, 0x18, 0x44, 0xf6,
+	0x10, 0x1f, 0x95, 0x65, 0xfa, 0x25, 0xfa, 0xf0, 0x7d, 0x3d, 0x7a, 0xff, 0xed, 0x66, 0xf4, 0xf1,
+	0xed, 0xe7, 0xaf, 0xdd, 0xce, 0x56, 0x27, 0x7e, 0x28, 0x31, 0xd0, 0xb5, 0xc1, 0xc5, 0x09, 0xfb,
+	0xd3, 0x0d, 0x4f, 0xaf, 0xad, 0x2e, 0xc6, 0xd8, 0x20, 0xe1, 0x38, 0x7a, 0x55, 0xfd, 0xde, 0x37,
+	0xd5, 0x47, 0xd5, 0xbf, 0x7d, 0xd5, 0x49, 0xd7, 0xaf, 0xe8, 0xe8, 0xc7, 0x00, 0x0f, 0x67, 0x44,
+	0x2d, 0x18, 0x04, 0x00, 0x00,
+}
+
+func (this *LocalRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LocalRateLimit)
+	if !ok {
+		that2, ok := that.(LocalRateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StatPrefix != that1.StatPrefix {
+		return false
+	}
+	if !this.Status.Equal(that1.Status) {
+		return false
+	}
+	if !this.TokenBucket.Equal(that1.TokenBucket) {
+		return false
+	}
+	if !this.FilterEnabled.Equal(that1.FilterEnabled) {
+		return false
+	}
+	if !this.FilterEnforced.Equal(that1.FilterEnforced) {
+		return false
+	}
+	if len(this.ResponseHeadersToAdd) != len(that1.ResponseHeadersToAdd) {
+		return false
+	}
+	for i := range this.ResponseHeadersToAdd {
+		if !this.ResponseHeadersToAdd[i].Equal(that1.ResponseHeadersToAdd[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
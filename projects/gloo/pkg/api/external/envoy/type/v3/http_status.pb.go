@@ -0,0 +1,365 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/external/envoy/type/v3/http_status.proto
+
+package v3
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/solo-io/gloo/projects/gloo/pkg/api/external/udpa/annotations"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HTTP response codes supported in Envoy.
+// For more details: https://www.iana.org/assignments/http-status-codes/http-status-codes.xhtml
+type StatusCode int32
+
+const (
+	// Empty - This code not part of the HTTP status code specification, but it is needed for proto
+	// `enum` type.
+	StatusCode_Empty                         StatusCode = 0
+	StatusCode_Continue                      StatusCode = 100
+	StatusCode_OK                            StatusCode = 200
+	StatusCode_Created                       StatusCode = 201
+	StatusCode_Accepted                      StatusCode = 202
+	StatusCode_NonAuthoritativeInformation   StatusCode = 203
+	StatusCode_NoContent                     StatusCode = 204
+	StatusCode_ResetContent                  StatusCode = 205
+	StatusCode_PartialContent                StatusCode = 206
+	StatusCode_MultiStatus                   StatusCode = 207
+	StatusCode_AlreadyReported               StatusCode = 208
+	StatusCode_IMUsed                        StatusCode = 226
+	StatusCode_MultipleChoices               StatusCode = 300
+	StatusCode_MovedPermanently              StatusCode = 301
+	StatusCode_Found                         StatusCode = 302
+	StatusCode_SeeOther                      StatusCode = 303
+	StatusCode_NotModified                   StatusCode = 304
+	StatusCode_UseProxy                      StatusCode = 305
+	StatusCode_TemporaryRedirect             StatusCode = 307
+	StatusCode_PermanentRedirect             StatusCode = 308
+	StatusCode_BadRequest                    StatusCode = 400
+	StatusCode_Unauthorized                  StatusCode = 401
+	StatusCode_PaymentRequired               StatusCode = 402
+	StatusCode_Forbidden                     StatusCode = 403
+	StatusCode_NotFound                      StatusCode = 404
+	StatusCode_MethodNotAllowed              StatusCode = 405
+	StatusCode_NotAcceptable                 StatusCode = 406
+	StatusCode_ProxyAuthenticationRequired   StatusCode = 407
+	StatusCode_RequestTimeout                StatusCode = 408
+	StatusCode_Conflict                      StatusCode = 409
+	StatusCode_Gone                          StatusCode = 410
+	StatusCode_LengthRequired                StatusCode = 411
+	StatusCode_PreconditionFailed            StatusCode = 412
+	StatusCode_PayloadTooLarge               StatusCode = 413
+	StatusCode_URITooLong                    StatusCode = 414
+	StatusCode_UnsupportedMediaType          StatusCode = 415
+	StatusCode_RangeNotSatisfiable           StatusCode = 416
+	StatusCode_ExpectationFailed             StatusCode = 417
+	StatusCode_MisdirectedRequest            StatusCode = 421
+	StatusCode_UnprocessableEntity           StatusCode = 422
+	StatusCode_Locked                        StatusCode = 423
+	StatusCode_FailedDependency              StatusCode = 424
+	StatusCode_UpgradeRequired               StatusCode = 426
+	StatusCode_PreconditionRequired          StatusCode = 428
+	StatusCode_TooManyRequests               StatusCode = 429
+	StatusCode_RequestHeaderFieldsTooLarge   StatusCode = 431
+	StatusCode_InternalServerError           StatusCode = 500
+	StatusCode_NotImplemented                StatusCode = 501
+	StatusCode_BadGateway                    StatusCode = 502
+	StatusCode_ServiceUnavailable            StatusCode = 503
+	StatusCode_GatewayTimeout                StatusCode = 504
+	StatusCode_HTTPVersionNotSupported       StatusCode = 505
+	StatusCode_VariantAlsoNegotiates         StatusCode = 506
+	StatusCode_InsufficientStorage           StatusCode = 507
+	StatusCode_LoopDetected                  StatusCode = 508
+	StatusCode_NotExtended                   StatusCode = 510
+	StatusCode_NetworkAuthenticationRequired StatusCode = 511
+)
+
+var StatusCode_name = map[int32]string{
+	0:   "Empty",
+	100: "Continue",
+	200: "OK",
+	201: "Created",
+	202: "Accepted",
+	203: "NonAuthoritativeInformation",
+	204: "NoContent",
+	205: "ResetContent",
+	206: "PartialContent",
+	207: "MultiStatus",
+	208: "AlreadyReported",
+	226: "IMUsed",
+	300: "MultipleChoices",
+	301: "MovedPermanently",
+	302: "Found",
+	303: "SeeOther",
+	304: "NotModified",
+	305: "UseProxy",
+	307: "TemporaryRedirect",
+	308: "PermanentRedirect",
+	400: "BadRequest",
+	401: "Unauthorized",
+	402: "PaymentRequired",
+	403: "Forbidden",
+	404: "NotFound",
+	405: "MethodNotAllowed",
+	406: "NotAcceptable",
+	407: "ProxyAuthenticationRequired",
+	408: "RequestTimeout",
+	409: "Conflict",
+	410: "Gone",
+	411: "LengthRequired",
+	412: "PreconditionFailed",
+	413: "PayloadTooLarge",
+	414: "URITooLong",
+	415: "UnsupportedMediaType",
+	416: "RangeNotSatisfiable",
+	417: "ExpectationFailed",
+	421: "MisdirectedRequest",
+	422: "UnprocessableEntity",
+	423: "Locked",
+	424: "FailedDependency",
+	426: "UpgradeRequired",
+	428: "PreconditionRequired",
+	429: "TooManyRequests",
+	431: "RequestHeaderFieldsTooLarge",
+	500: "InternalServerError",
+	501: "NotImplemented",
+	502: "BadGateway",
+	503: "ServiceUnavailable",
+	504: "GatewayTimeout",
+	505: "HTTPVersionNotSupported",
+	506: "VariantAlsoNegotiates",
+	507: "InsufficientStorage",
+	508: "LoopDetected",
+	510: "NotExtended",
+	511: "NetworkAuthenticationRequired",
+}
+
+var StatusCode_value = map[string]int32{
+	"Empty":                         0,
+	"Continue":                      100,
+	"OK":                            200,
+	"Created":                       201,
+	"Accepted":                      202,
+	"NonAuthoritativeInformation":   203,
+	"NoContent":                     204,
+	"ResetContent":                  205,
+	"PartialContent":                206,
+	"MultiStatus":                   207,
+	"AlreadyReported":               208,
+	"IMUsed":                        226,
+	"MultipleChoices":               300,
+	"MovedPermanently":              301,
+	"Found":                         302,
+	"SeeOther":                      303,
+	"NotModified":                   304,
+	"UseProxy":                      305,
+	"TemporaryRedirect":             307,
+	"PermanentRedirect":             308,
+	"BadRequest":                    400,
+	"Unauthorized":                  401,
+	"PaymentRequired":               402,
+	"Forbidden":                     403,
+	"NotFound":                      404,
+	"MethodNotAllowed":              405,
+	"NotAcceptable":                 406,
+	"ProxyAuthenticationRequired":   407,
+	"RequestTimeout":                408,
+	"Conflict":                      409,
+	"Gone":                          410,
+	"LengthRequired":                411,
+	"PreconditionFailed":            412,
+	"PayloadTooLarge":               413,
+	"URITooLong":                    414,
+	"UnsupportedMediaType":          415,
+	"RangeNotSatisfiable":           416,
+	"ExpectationFailed":             417,
+	"MisdirectedRequest":            421,
+	"UnprocessableEntity":           422,
+	"Locked":                        423,
+	"FailedDependency":              424,
+	"UpgradeRequired":               426,
+	"PreconditionRequired":          428,
+	"TooManyRequests":               429,
+	"RequestHeaderFieldsTooLarge":   431,
+	"InternalServerError":           500,
+	"NotImplemented":                501,
+	"BadGateway":                    502,
+	"ServiceUnavailable":            503,
+	"GatewayTimeout":                504,
+	"HTTPVersionNotSupported":       505,
+	"VariantAlsoNegotiates":         506,
+	"InsufficientStorage":           507,
+	"LoopDetected":                  508,
+	"NotExtended":                   510,
+	"NetworkAuthenticationRequired": 511,
+}
+
+func (x StatusCode) String() string {
+	return proto.EnumName(StatusCode_name, int32(x))
+}
+
+func (StatusCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e31b9da4b0c1f5d9, []int{0}
+}
+
+// HTTP status.
+type HttpStatus struct {
+	// Supplies HTTP response code.
+	Code                 StatusCode `protobuf:"varint,1,opt,name=code,proto3,enum=envoy.type.v3.StatusCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *HttpStatus) Reset()         { *m = HttpStatus{} }
+func (m *HttpStatus) String() string { return proto.CompactTextString(m) }
+func (*HttpStatus) ProtoMessage()    {}
+func (*HttpStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31b9da4b0c1f5d9, []int{0}
+}
+func (m *HttpStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HttpStatus.Unmarshal(m, b)
+}
+func (m *HttpStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HttpStatus.Marshal(b, m, deterministic)
+}
+func (m *HttpStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpStatus.Merge(m, src)
+}
+func (m *HttpStatus) XXX_Size() int {
+	return xxx_messageInfo_HttpStatus.Size(m)
+}
+func (m *HttpStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HttpStatus proto.InternalMessageInfo
+
+func (m *HttpStatus) GetCode() StatusCode {
+	if m != nil {
+		return m.Code
+	}
+	return StatusCode_Empty
+}
+
+func init() {
+	proto.RegisterEnum("envoy.type.v3.StatusCode", StatusCode_name, StatusCode_value)
+	proto.RegisterType((*HttpStatus)(nil), "envoy.type.v3.HttpStatus")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/external/envoy/type/v3/http_status.proto", fileDescriptor_e31b9da4b0c1f5d9)
+}
+
+var fileDescriptor_e31b9da4b0c1f5d9 = []byte{
+	// 1031 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x49, 0x6f, 0x1c, 0x45,
+	0x14, 0x4e, 0x4f, 0x67, 0x73, 0x65, 0xab, 0x54, 0x12, 0x9c, 0x5d, 0x21, 0x27, 0x84, 0xc4, 0xb4,
+	0x44, 0x4e, 0x70, 0xb3, 0x1d, 0x3b, 0xb6, 0xf0, 0x4c, 0x46, 0xe3, 0x99, 0x1c, 0xb8, 0xa0, 0x72,
+	0xd7, 0x9b, 0x9e, 0xc2, 0x3d, 0xf5, 0x3a, 0xd5, 0xaf, 0xc7, 0x6e, 0x4e, 0x28, 0x27, 0x8e, 0xec,
+	0x4b, 0xd8, 0x0f, 0x40, 0x84, 0x12, 0x02, 0x02, 0x2e, 0xdc, 0x91, 0xc2, 0x0e, 0x7f, 0x21, 0xbf,
+	0x81, 0x35, 0x20, 0x40, 0x55, 0x3d, 0x33, 0x76, 0x84, 0x40, 0xdc, 0xaa, 0x5e, 0xbd, 0xe5, 0x7b,
+	0xdf, 0xf7, 0xfa, 0x35, 0x6b, 0x27, 0x9a, 0xfa, 0xc5, 0x6a, 0x3d, 0xc6, 0x41, 0x94, 0x63, 0x8a,
+	0x0f, 0x68, 0x8c, 0x92, 0x14, 0x31, 0xca, 0x2c, 0x3e, 0x0e, 0x31, 0xe5, 0xd5, 0x4d, 0x66, 0x3a,
+	0x82, 0x0d, 0x02, 0x6b, 0x64, 0x1a, 0x81, 0x19, 0x62, 0x19, 0x51, 0x99, 0x41, 0x34, 0x3c, 0x17,
+	0xf5, 0x89, 0xb2, 0xc7, 0x72, 0x92, 0x54, 0xe4, 0xf5, 0xcc, 0x22, 0xa1, 0xd8, 0xe7, 0x1d, 0xea,
+	0xce, 0xa1, 0x3e, 0x3c, 0x77, 0xfc, 0x54, 0xa1, 0x32, 0x19, 0x49, 0x63, 0x90, 0x24, 0x69, 0x34,
+	0x79, 0xb4, 0xd5, 0xfb, 0xf8, 0xbd, 0xff, 0x78, 0x1e, 0x82, 0xcd, 0x35, 0x1a, 0x6d, 0x92, 0x91,
+	0xcb, 0xf4, 0x50, 0xa6, 0x5a, 0x49, 0x82, 0x68, 0x7c, 0x18, 0x3d, 0x1c, 0x4e, 0x30, 0x41, 0x7f,
+	0x8c, 0xdc, 0xa9, 0xb2, 0x9e, 0x05, 0xc6, 0x16, 0x89, 0xb2, 0x15, 0x5f, 0x45, 0x3c, 0xc4, 0xb6,
+	0xc7, 0xa8, 0xe0, 0x68, 0x70, 0x26, 0xb8, 0x6f, 0xff, 0x83, 0xc7, 0xea, 0x77, 0x81, 0xab, 0x57,
+	0x4e, 0x73, 0xa8, 0x60, 0x96, 0xdd, 0x99, 0xdd, 0x75, 0x25, 0xd8, 0xce, 0x83, 0x33, 0xdb, 0xda,
+	0x3e, 0xe4, 0xe1, 0x93, 0x57, 0x3f, 0x7f, 0xea, 0xf4, 0x34, 0x3b, 0xb2, 0x25, 0x64, 0x33, 0xf1,
+	0xfd, 0x9f, 0x4e, 0x31, 0xb6, 0x19, 0x2e, 0xa6, 0xd8, 0x8e, 0xf9, 0x41, 0x46, 0x25, 0xdf, 0x26,
+	0xf6, 0xb2, 0xdd, 0x73, 0x68, 0x48, 0x9b, 0x02, 0xb8, 0x12, 0xbb, 0x58, 0xed, 0xe2, 0x23, 0xfc,
+	0x56, 0x20, 0xf6, 0xb2, 0x5d, 0x73, 0x16, 0x24, 0x81, 0xe2, 0x5f, 0x04, 0x62, 0x1f, 0xdb, 0x3d,
+	0x13, 0xc7, 0x90, 0xb9, 0xeb, 0x97, 0x81, 0x38, 0xc3, 0x4e, 0x34, 0xd1, 0xcc, 0x14, 0xd4, 0x47,
+	0xab, 0x1d, 0x13, 0x43, 0x58, 0x32, 0x3d, 0xb4, 0x03, 0x4f, 0x0a, 0xff, 0x2a, 0x10, 0xfb, 0xd9,
+	0x54, 0x13, 0x5d, 0x5e, 0x30, 0xc4, 0xbf, 0x0e, 0xc4, 0x41, 0xb6, 0xb7, 0x0d, 0x39, 0xd0, 0xd8,
+	0xf4, 0x4d, 0x20, 0x0e, 0xb1, 0xfd, 0x2d, 0x69, 0x49, 0xcb, 0x74, 0x6c, 0xfc, 0x36, 0x10, 0x9c,
+	0xed, 0x69, 0x14, 0x29, 0xe9, 0x0a, 0x2b, 0xff, 0x2e, 0x10, 0x87, 0xd9, 0x81, 0x99, 0xd4, 0x82,
+	0x54, 0x65, 0x1b, 0x32, 0xb4, 0x0e, 0xc1, 0xf7, 0x81, 0xd8, 0xc3, 0x76, 0x2e, 0x35, 0xba, 0x39,
+	0x28, 0x7e, 0xdb, 0xbb, 0xf8, 0xa0, 0x2c, 0x85, 0xb9, 0x3e, 0xea, 0x18, 0x72, 0x7e, 0xbd, 0x26,
+	0x8e, 0x30, 0xde, 0xc0, 0x21, 0xa8, 0x16, 0xd8, 0x81, 0x34, 0x60, 0x28, 0x2d, 0xf9, 0x8d, 0x9a,
+	0x60, 0x6c, 0xc7, 0x02, 0x16, 0x46, 0xf1, 0x0f, 0x6a, 0xae, 0xad, 0x15, 0x80, 0x8b, 0xd4, 0x07,
+	0xcb, 0x6f, 0xd6, 0x5c, 0xf1, 0x26, 0x52, 0x03, 0x95, 0xee, 0x69, 0x50, 0xfc, 0x43, 0xef, 0xd0,
+	0xcd, 0xa1, 0x65, 0x71, 0xa3, 0xe4, 0x1f, 0xd5, 0xc4, 0x3d, 0xec, 0x60, 0x07, 0x06, 0x19, 0x5a,
+	0x69, 0xcb, 0x36, 0x28, 0x6d, 0x21, 0x26, 0xfe, 0xb1, 0xb7, 0x4f, 0xaa, 0x4c, 0xec, 0x9f, 0xd4,
+	0xc4, 0x01, 0xc6, 0x66, 0xa5, 0x6a, 0xc3, 0xe5, 0x02, 0x72, 0xe2, 0x4f, 0x87, 0x8e, 0x86, 0xae,
+	0x91, 0x15, 0x6f, 0x4f, 0x80, 0xe2, 0xcf, 0x84, 0x0e, 0x7c, 0x4b, 0x96, 0x03, 0x1f, 0x79, 0xb9,
+	0xd0, 0x16, 0x14, 0x7f, 0x36, 0x74, 0xfc, 0x2d, 0xa0, 0x5d, 0xd5, 0x4a, 0x81, 0xe1, 0xcf, 0x85,
+	0x0e, 0x48, 0x13, 0xa9, 0x02, 0xfe, 0x7c, 0xe8, 0x7b, 0x03, 0xea, 0xa3, 0x6a, 0x22, 0xcd, 0xa4,
+	0x29, 0xae, 0x83, 0xe2, 0x2f, 0x84, 0x42, 0xb0, 0x7d, 0xce, 0xe0, 0x95, 0x92, 0xab, 0x29, 0xf0,
+	0x17, 0x43, 0xa7, 0x95, 0xc7, 0xef, 0xd4, 0x02, 0x43, 0x3a, 0xf6, 0x1a, 0x4d, 0x6a, 0xbd, 0x14,
+	0x3a, 0x21, 0x46, 0x10, 0x3b, 0x7a, 0x00, 0x58, 0x10, 0x7f, 0xd9, 0x17, 0x9c, 0x43, 0xd3, 0x4b,
+	0x75, 0x4c, 0xfc, 0x95, 0x50, 0x4c, 0xb1, 0xed, 0x17, 0xd0, 0x00, 0xbf, 0xea, 0xdd, 0x97, 0xc1,
+	0x24, 0xd4, 0x9f, 0xe4, 0x78, 0x35, 0x14, 0xd3, 0x4c, 0xb4, 0x2c, 0xc4, 0x68, 0x94, 0x76, 0xe9,
+	0x17, 0xa4, 0x4e, 0x41, 0xf1, 0xd7, 0xc6, 0xed, 0xa5, 0x28, 0x55, 0x07, 0x71, 0x59, 0xda, 0x04,
+	0xf8, 0xeb, 0xa1, 0x23, 0xa6, 0xdb, 0x5e, 0x72, 0x16, 0x34, 0x09, 0x7f, 0x23, 0x14, 0xc7, 0xd8,
+	0xe1, 0xae, 0xc9, 0x8b, 0xac, 0x52, 0xb8, 0x01, 0x4a, 0xcb, 0x4e, 0x99, 0x01, 0x7f, 0x33, 0x14,
+	0x47, 0xd9, 0xa1, 0xb6, 0x34, 0x09, 0x34, 0x91, 0x56, 0x24, 0xe9, 0xbc, 0xa7, 0x7d, 0x6b, 0x6f,
+	0x85, 0x8e, 0xf6, 0xf9, 0x8d, 0x0c, 0x62, 0x92, 0x5b, 0x6a, 0xbe, 0xed, 0xc1, 0x34, 0x74, 0x5e,
+	0xc9, 0x00, 0x13, 0xfa, 0xdf, 0xf1, 0xa9, 0xba, 0x26, 0xb3, 0x18, 0x43, 0x9e, 0xbb, 0x24, 0xf3,
+	0x86, 0x34, 0x95, 0xfc, 0xdd, 0xd0, 0xcd, 0xd3, 0x32, 0xc6, 0x6b, 0xa0, 0xf8, 0x7b, 0x9e, 0xdd,
+	0x2a, 0xd9, 0x79, 0xc8, 0xc0, 0x28, 0x30, 0x71, 0xc9, 0xaf, 0xf9, 0x56, 0xba, 0x59, 0x62, 0xa5,
+	0x82, 0x49, 0xe7, 0xef, 0x7b, 0xe4, 0x5b, 0x3b, 0x9f, 0x3c, 0x5d, 0xf7, 0x01, 0x1d, 0xc4, 0x86,
+	0x34, 0xe5, 0x08, 0x43, 0xce, 0x6f, 0x78, 0x41, 0x46, 0xd7, 0x45, 0x90, 0x0a, 0xec, 0x82, 0x86,
+	0x54, 0xe5, 0x13, 0x76, 0x6e, 0x7a, 0x98, 0x4b, 0xa6, 0xda, 0x5f, 0x2b, 0x60, 0x87, 0x60, 0xe7,
+	0xad, 0x45, 0xcb, 0x7f, 0xf4, 0xdc, 0x37, 0x91, 0x96, 0x06, 0x59, 0x0a, 0x6e, 0x62, 0x40, 0xf1,
+	0x9f, 0xc2, 0xd1, 0x94, 0x5d, 0x90, 0x04, 0xeb, 0xb2, 0xe4, 0x3f, 0xfb, 0xfe, 0x5d, 0x9c, 0x8e,
+	0xa1, 0x6b, 0xe4, 0x50, 0xea, 0xd4, 0x13, 0xf6, 0x8b, 0x0f, 0x1f, 0xb9, 0x8d, 0x95, 0xfe, 0x35,
+	0x14, 0x27, 0xd9, 0xf4, 0x62, 0xa7, 0xd3, 0xba, 0x54, 0x2d, 0x32, 0xc7, 0xf2, 0x58, 0x06, 0xfe,
+	0x5b, 0x28, 0x8e, 0xb3, 0x23, 0x97, 0xa4, 0xd5, 0xd2, 0xd0, 0x4c, 0x9a, 0x63, 0x13, 0x12, 0x24,
+	0x2d, 0x09, 0x72, 0x7e, 0x67, 0x84, 0x33, 0x2f, 0x7a, 0x3d, 0x1d, 0x6b, 0x30, 0xb4, 0x42, 0x68,
+	0x65, 0x02, 0xfc, 0x77, 0x3f, 0xe7, 0xcb, 0x88, 0xd9, 0x79, 0x20, 0x2f, 0x01, 0xff, 0x23, 0x1c,
+	0x7d, 0x5c, 0xf3, 0x1b, 0xe4, 0x18, 0x55, 0xfc, 0xcf, 0x50, 0x9c, 0x65, 0xa7, 0x9a, 0x40, 0xeb,
+	0x68, 0xd7, 0xfe, 0x65, 0x36, 0xff, 0x0a, 0x67, 0xaf, 0x04, 0xd7, 0x6e, 0x9f, 0x0e, 0x3e, 0x7b,
+	0xf2, 0xd6, 0x0f, 0x3b, 0x6b, 0xbc, 0xc6, 0x4e, 0x68, 0xac, 0x76, 0x62, 0xe6, 0x46, 0xfa, 0xee,
+	0xf5, 0x38, 0x7b, 0x60, 0x73, 0xdf, 0xb5, 0xdc, 0x6e, 0x6d, 0x05, 0x8f, 0x9e, 0xff, 0x7f, 0x7f,
+	0x8c, 0x6c, 0x2d, 0xf9, 0x8f, 0xbf, 0xc6, 0xea, 0x4e, 0xbf, 0xaa, 0xcf, 0xfd, 0x3d, 0x00, 0x3e,
+	0x36, 0x50, 0x4e, 0x80, 0x06, 0x00, 0x00,
+}
+
+func (this *HttpStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HttpStatus)
+	if !ok {
+		that2, ok := that.(HttpStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/external/envoy/type/v3/token_bucket.proto
+
+package v3
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/gloo/projects/gloo/pkg/api/external/udpa/annotations"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Configures a token bucket, typically used for rate limiting.
+type TokenBucket struct {
+	// The maximum tokens that the bucket can hold. This is also the number of tokens that the bucket
+	// initially contains.
+	MaxTokens uint32 `protobuf:"varint,1,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	// The number of tokens added to the bucket during each fill interval. If not specified, defaults
+	// to a single token.
+	TokensPerFill *types.UInt32Value `protobuf:"bytes,2,opt,name=tokens_per_fill,json=tokensPerFill,proto3" json:"tokens_per_fill,omitempty"`
+	// The fill interval that tokens are added to the bucket. During each fill interval
+	// `tokens_per_fill` are added to the bucket. The bucket will never contain more than
+	// `max_tokens` tokens.
+	FillInterval         *types.Duration `protobuf:"bytes,3,opt,name=fill_interval,json=fillInterval,proto3" json:"fill_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TokenBucket) Reset()         { *m = TokenBucket{} }
+func (m *TokenBucket) String() string { return proto.CompactTextString(m) }
+func (*TokenBucket) ProtoMessage()    {}
+func (*TokenBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1670aa5e61ded087, []int{0}
+}
+func (m *TokenBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenBucket.Unmarshal(m, b)
+}
+func (m *TokenBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenBucket.Marshal(b, m, deterministic)
+}
+func (m *TokenBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenBucket.Merge(m, src)
+}
+func (m *TokenBucket) XXX_Size() int {
+	return xxx_messageInfo_TokenBucket.Size(m)
+}
+func (m *TokenBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenBucket proto.InternalMessageInfo
+
+func (m *TokenBucket) GetMaxTokens() uint32 {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return 0
+}
+
+func (m *TokenBucket) GetTokensPerFill() *types.UInt32Value {
+	if m != nil {
+		return m.TokensPerFill
+	}
+	return nil
+}
+
+func (m *TokenBucket) GetFillInterval() *types.Duration {
+	if m != nil {
+		return m.FillInterval
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TokenBucket)(nil), "envoy.type.v3.TokenBucket")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/external/envoy/type/v3/token_bucket.proto", fileDescriptor_1670aa5e61ded087)
+}
+
+var fileDescriptor_1670aa5e61ded087 = []byte{
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0xc7, 0x77, 0xe2, 0xb2, 0xab, 0xb3, 0x06, 0x97, 0x20, 0x1a, 0x5f, 0xb6, 0x54, 0x0f, 0xb2,
+	0x2c, 0x38, 0x03, 0x9b, 0x9b, 0xc7, 0xb0, 0x08, 0x15, 0x84, 0x52, 0x5f, 0x0e, 0x5e, 0xc2, 0xa4,
+	0x9d, 0x8e, 0x63, 0xa7, 0xf3, 0x0c, 0x33, 0x93, 0x98, 0xde, 0x04, 0x2f, 0x7e, 0x06, 0x3f, 0x81,
+	0xf8, 0x11, 0xbc, 0x0b, 0x5e, 0xfd, 0x0a, 0x7e, 0x01, 0xef, 0x3d, 0x49, 0x26, 0x29, 0x56, 0x0a,
+	0xb2, 0xb7, 0x27, 0xf9, 0xbf, 0x90, 0x5f, 0x9e, 0x07, 0xbf, 0x10, 0xd2, 0xbf, 0xad, 0x4a, 0x32,
+	0x85, 0x25, 0x75, 0xa0, 0xe0, 0xb1, 0x04, 0x2a, 0x14, 0x00, 0x35, 0x16, 0xde, 0xf1, 0xa9, 0x77,
+	0xdd, 0x13, 0x33, 0x92, 0xf2, 0xc6, 0x73, 0xab, 0x99, 0xa2, 0x5c, 0xd7, 0xb0, 0xa2, 0x7e, 0x65,
+	0x38, 0xad, 0x33, 0xea, 0x61, 0xc1, 0x75, 0x51, 0x56, 0xd3, 0x05, 0xf7, 0xc4, 0x58, 0xf0, 0x90,
+	0xc4, 0xc1, 0x41, 0x5a, 0x07, 0xa9, 0xb3, 0xbb, 0x03, 0x01, 0x20, 0x14, 0xa7, 0x41, 0x2c, 0xab,
+	0x39, 0x9d, 0x55, 0x96, 0x79, 0x09, 0xba, 0xb3, 0xef, 0xea, 0xef, 0x2d, 0x33, 0x86, 0x5b, 0xd7,
+	0xeb, 0x27, 0xd5, 0xcc, 0x30, 0xca, 0xb4, 0x06, 0x1f, 0x62, 0x8e, 0x3a, 0xcf, 0x7c, 0xb5, 0x91,
+	0x1f, 0xec, 0xc8, 0x35, 0xb7, 0x4e, 0x82, 0x96, 0x5a, 0xf4, 0x96, 0xdb, 0x35, 0x53, 0x72, 0xc6,
+	0x3c, 0xa7, 0x9b, 0xa1, 0x17, 0x6e, 0x0a, 0x10, 0x10, 0x46, 0xda, 0x4e, 0xdd, 0xdb, 0x87, 0xbf,
+	0x11, 0x3e, 0x7a, 0xd9, 0x62, 0xe5, 0x81, 0x2a, 0x79, 0x84, 0xf1, 0x92, 0x35, 0x45, 0x20, 0x75,
+	0x29, 0x1a, 0xa2, 0xd3, 0x38, 0x3f, 0x5c, 0xe7, 0xfb, 0x67, 0xd1, 0x70, 0x6f, 0x72, 0x6d, 0xc9,
+	0x9a, 0x60, 0x76, 0xc9, 0x73, 0x7c, 0xa3, 0xf3, 0x14, 0x86, 0xdb, 0x62, 0x2e, 0x95, 0x4a, 0xa3,
+	0x21, 0x3a, 0x3d, 0x3a, 0xbf, 0x4f, 0x3a, 0x44, 0xb2, 0x41, 0x24, 0xaf, 0x46, 0xda, 0x67, 0xe7,
+	0xaf, 0x99, 0xaa, 0xf8, 0xdf, 0xaa, 0xb8, 0x4b, 0x8f, 0xb9, 0x7d, 0x2a, 0x95, 0x4a, 0x9e, 0xe1,
+	0xb8, 0xed, 0x28, 0xa4, 0xf6, 0xdc, 0xd6, 0x4c, 0xa5, 0x57, 0x42, 0xd9, 0x9d, 0x9d, 0xb2, 0x8b,
+	0xfe, 0x7f, 0xe6, 0x78, 0x9d, 0x1f, 0x7e, 0x45, 0xfb, 0x57, 0xd1, 0xd9, 0xde, 0xe4, 0x7a, 0x9b,
+	0x1d, 0xf5, 0xd1, 0x27, 0x27, 0x9f, 0xbf, 0x7f, 0x1a, 0xa4, 0xf8, 0xd6, 0xd6, 0x66, 0xb6, 0x08,
+	0xf3, 0x8f, 0xe8, 0xcb, 0xaf, 0x01, 0xfa, 0xf6, 0xe1, 0xc7, 0xcf, 0x83, 0xe8, 0x38, 0xc2, 0xf7,
+	0x24, 0x90, 0xe0, 0x34, 0x16, 0x9a, 0x15, 0xf9, 0x67, 0x9d, 0xf9, 0xf1, 0x56, 0x70, 0xdc, 0x7e,
+	0xc2, 0x18, 0xbd, 0xb9, 0xb8, 0xdc, 0x19, 0x99, 0x85, 0xf8, 0xcf, 0x29, 0x95, 0x07, 0x81, 0x28,
+	0xfb, 0x33, 0x00, 0x0a, 0x23, 0x98, 0x84, 0x95, 0x02, 0x00, 0x00,
+}
+
+func (this *TokenBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenBucket)
+	if !ok {
+		that2, ok := that.(TokenBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxTokens != that1.MaxTokens {
+		return false
+	}
+	if !this.TokensPerFill.Equal(that1.TokensPerFill) {
+		return false
+	}
+	if !this.FillInterval.Equal(that1.FillInterval) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
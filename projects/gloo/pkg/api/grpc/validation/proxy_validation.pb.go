@@ -6,13 +6,12 @@ package validation
 import (
 	context "context"
 	fmt "fmt"
-	math "math"
-
 	proto "github.com/gogo/protobuf/proto"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -76,7 +75,7 @@ func (x HttpListenerReport_Error_Type) String() string {
 }
 
 func (HttpListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{7, 0, 0}
 }
 
 type VirtualHostReport_Error_Type int32
@@ -107,7 +106,7 @@ func (x VirtualHostReport_Error_Type) String() string {
 }
 
 func (VirtualHostReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{8, 0, 0}
 }
 
 type RouteReport_Error_Type int32
@@ -132,7 +131,7 @@ func (x RouteReport_Error_Type) String() string {
 }
 
 func (RouteReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{9, 0, 0}
 }
 
 type RouteReport_Warning_Type int32
@@ -154,7 +153,7 @@ func (x RouteReport_Warning_Type) String() string {
 }
 
 func (RouteReport_Warning_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 1, 0}
+	return fileDescriptor_aacaf097b496f502, []int{9, 1, 0}
 }
 
 type TcpListenerReport_Error_Type int32
@@ -185,7 +184,7 @@ func (x TcpListenerReport_Error_Type) String() string {
 }
 
 func (TcpListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{10, 0, 0}
 }
 
 type TcpHostReport_Error_Type int32
@@ -213,7 +212,7 @@ func (x TcpHostReport_Error_Type) String() string {
 }
 
 func (TcpHostReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{11, 0, 0}
 }
 
 type ProxyValidationServiceRequest struct {
@@ -352,7 +351,6 @@ func (m *NotifyOnResyncResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NotifyOnResyncResponse proto.InternalMessageInfo
 
-//
 // The Proxy Report should contain one report for each sub-resource of the Proxy
 // E.g., each listener will have a corresponding report. Within each listener report is
 // a route report corresponding to each route on the listener.
@@ -402,6 +400,7 @@ type ListenerReport struct {
 	// Types that are valid to be assigned to ListenerTypeReport:
 	//	*ListenerReport_HttpListenerReport
 	//	*ListenerReport_TcpListenerReport
+	//	*ListenerReport_HybridListenerReport
 	ListenerTypeReport   isListenerReport_ListenerTypeReport `protobuf_oneof:"listener_type_report"`
 	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
 	XXX_unrecognized     []byte                              `json:"-"`
@@ -442,9 +441,13 @@ type ListenerReport_HttpListenerReport struct {
 type ListenerReport_TcpListenerReport struct {
 	TcpListenerReport *TcpListenerReport `protobuf:"bytes,4,opt,name=tcp_listener_report,json=tcpListenerReport,proto3,oneof" json:"tcp_listener_report,omitempty"`
 }
+type ListenerReport_HybridListenerReport struct {
+	HybridListenerReport *HybridListenerReport `protobuf:"bytes,5,opt,name=hybrid_listener_report,json=hybridListenerReport,proto3,oneof" json:"hybrid_listener_report,omitempty"`
+}
 
-func (*ListenerReport_HttpListenerReport) isListenerReport_ListenerTypeReport() {}
-func (*ListenerReport_TcpListenerReport) isListenerReport_ListenerTypeReport()  {}
+func (*ListenerReport_HttpListenerReport) isListenerReport_ListenerTypeReport()   {}
+func (*ListenerReport_TcpListenerReport) isListenerReport_ListenerTypeReport()    {}
+func (*ListenerReport_HybridListenerReport) isListenerReport_ListenerTypeReport() {}
 
 func (m *ListenerReport) GetListenerTypeReport() isListenerReport_ListenerTypeReport {
 	if m != nil {
@@ -474,11 +477,19 @@ func (m *ListenerReport) GetTcpListenerReport() *TcpListenerReport {
 	return nil
 }
 
+func (m *ListenerReport) GetHybridListenerReport() *HybridListenerReport {
+	if x, ok := m.GetListenerTypeReport().(*ListenerReport_HybridListenerReport); ok {
+		return x.HybridListenerReport
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ListenerReport) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ListenerReport_HttpListenerReport)(nil),
 		(*ListenerReport_TcpListenerReport)(nil),
+		(*ListenerReport_HybridListenerReport)(nil),
 	}
 }
 
@@ -531,6 +542,46 @@ func (m *ListenerReport_Error) GetReason() string {
 	return ""
 }
 
+type HybridListenerReport struct {
+	// reports for the matched listeners of the hybrid listener, in the same order.
+	// errors on the filter chains of a matched listener are reported on its listener report.
+	MatchedListenerReports []*ListenerReport `protobuf:"bytes,1,rep,name=matched_listener_reports,json=matchedListenerReports,proto3" json:"matched_listener_reports,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}          `json:"-"`
+	XXX_unrecognized       []byte            `json:"-"`
+	XXX_sizecache          int32             `json:"-"`
+}
+
+func (m *HybridListenerReport) Reset()         { *m = HybridListenerReport{} }
+func (m *HybridListenerReport) String() string { return proto.CompactTextString(m) }
+func (*HybridListenerReport) ProtoMessage()    {}
+func (*HybridListenerReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aacaf097b496f502, []int{6}
+}
+func (m *HybridListenerReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridListenerReport.Unmarshal(m, b)
+}
+func (m *HybridListenerReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridListenerReport.Marshal(b, m, deterministic)
+}
+func (m *HybridListenerReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridListenerReport.Merge(m, src)
+}
+func (m *HybridListenerReport) XXX_Size() int {
+	return xxx_messageInfo_HybridListenerReport.Size(m)
+}
+func (m *HybridListenerReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridListenerReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridListenerReport proto.InternalMessageInfo
+
+func (m *HybridListenerReport) GetMatchedListenerReports() []*ListenerReport {
+	if m != nil {
+		return m.MatchedListenerReports
+	}
+	return nil
+}
+
 type HttpListenerReport struct {
 	Errors []*HttpListenerReport_Error `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
 	// report for nested virtual hosts
@@ -544,7 +595,7 @@ func (m *HttpListenerReport) Reset()         { *m = HttpListenerReport{} }
 func (m *HttpListenerReport) String() string { return proto.CompactTextString(m) }
 func (*HttpListenerReport) ProtoMessage()    {}
 func (*HttpListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6}
+	return fileDescriptor_aacaf097b496f502, []int{7}
 }
 func (m *HttpListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListenerReport.Unmarshal(m, b)
@@ -593,7 +644,7 @@ func (m *HttpListenerReport_Error) Reset()         { *m = HttpListenerReport_Err
 func (m *HttpListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*HttpListenerReport_Error) ProtoMessage()    {}
 func (*HttpListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6, 0}
+	return fileDescriptor_aacaf097b496f502, []int{7, 0}
 }
 func (m *HttpListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListenerReport_Error.Unmarshal(m, b)
@@ -640,7 +691,7 @@ func (m *VirtualHostReport) Reset()         { *m = VirtualHostReport{} }
 func (m *VirtualHostReport) String() string { return proto.CompactTextString(m) }
 func (*VirtualHostReport) ProtoMessage()    {}
 func (*VirtualHostReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7}
+	return fileDescriptor_aacaf097b496f502, []int{8}
 }
 func (m *VirtualHostReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHostReport.Unmarshal(m, b)
@@ -689,7 +740,7 @@ func (m *VirtualHostReport_Error) Reset()         { *m = VirtualHostReport_Error
 func (m *VirtualHostReport_Error) String() string { return proto.CompactTextString(m) }
 func (*VirtualHostReport_Error) ProtoMessage()    {}
 func (*VirtualHostReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7, 0}
+	return fileDescriptor_aacaf097b496f502, []int{8, 0}
 }
 func (m *VirtualHostReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHostReport_Error.Unmarshal(m, b)
@@ -737,7 +788,7 @@ func (m *RouteReport) Reset()         { *m = RouteReport{} }
 func (m *RouteReport) String() string { return proto.CompactTextString(m) }
 func (*RouteReport) ProtoMessage()    {}
 func (*RouteReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8}
+	return fileDescriptor_aacaf097b496f502, []int{9}
 }
 func (m *RouteReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport.Unmarshal(m, b)
@@ -786,7 +837,7 @@ func (m *RouteReport_Error) Reset()         { *m = RouteReport_Error{} }
 func (m *RouteReport_Error) String() string { return proto.CompactTextString(m) }
 func (*RouteReport_Error) ProtoMessage()    {}
 func (*RouteReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 0}
+	return fileDescriptor_aacaf097b496f502, []int{9, 0}
 }
 func (m *RouteReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport_Error.Unmarshal(m, b)
@@ -835,7 +886,7 @@ func (m *RouteReport_Warning) Reset()         { *m = RouteReport_Warning{} }
 func (m *RouteReport_Warning) String() string { return proto.CompactTextString(m) }
 func (*RouteReport_Warning) ProtoMessage()    {}
 func (*RouteReport_Warning) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 1}
+	return fileDescriptor_aacaf097b496f502, []int{9, 1}
 }
 func (m *RouteReport_Warning) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport_Warning.Unmarshal(m, b)
@@ -882,7 +933,7 @@ func (m *TcpListenerReport) Reset()         { *m = TcpListenerReport{} }
 func (m *TcpListenerReport) String() string { return proto.CompactTextString(m) }
 func (*TcpListenerReport) ProtoMessage()    {}
 func (*TcpListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9}
+	return fileDescriptor_aacaf097b496f502, []int{10}
 }
 func (m *TcpListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpListenerReport.Unmarshal(m, b)
@@ -931,7 +982,7 @@ func (m *TcpListenerReport_Error) Reset()         { *m = TcpListenerReport_Error
 func (m *TcpListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*TcpListenerReport_Error) ProtoMessage()    {}
 func (*TcpListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9, 0}
+	return fileDescriptor_aacaf097b496f502, []int{10, 0}
 }
 func (m *TcpListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpListenerReport_Error.Unmarshal(m, b)
@@ -977,7 +1028,7 @@ func (m *TcpHostReport) Reset()         { *m = TcpHostReport{} }
 func (m *TcpHostReport) String() string { return proto.CompactTextString(m) }
 func (*TcpHostReport) ProtoMessage()    {}
 func (*TcpHostReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10}
+	return fileDescriptor_aacaf097b496f502, []int{11}
 }
 func (m *TcpHostReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHostReport.Unmarshal(m, b)
@@ -1019,7 +1070,7 @@ func (m *TcpHostReport_Error) Reset()         { *m = TcpHostReport_Error{} }
 func (m *TcpHostReport_Error) String() string { return proto.CompactTextString(m) }
 func (*TcpHostReport_Error) ProtoMessage()    {}
 func (*TcpHostReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10, 0}
+	return fileDescriptor_aacaf097b496f502, []int{11, 0}
 }
 func (m *TcpHostReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHostReport_Error.Unmarshal(m, b)
@@ -1068,6 +1119,7 @@ func init() {
 	proto.RegisterType((*ProxyReport)(nil), "gloo.solo.io.ProxyReport")
 	proto.RegisterType((*ListenerReport)(nil), "gloo.solo.io.ListenerReport")
 	proto.RegisterType((*ListenerReport_Error)(nil), "gloo.solo.io.ListenerReport.Error")
+	proto.RegisterType((*HybridListenerReport)(nil), "gloo.solo.io.HybridListenerReport")
 	proto.RegisterType((*HttpListenerReport)(nil), "gloo.solo.io.HttpListenerReport")
 	proto.RegisterType((*HttpListenerReport_Error)(nil), "gloo.solo.io.HttpListenerReport.Error")
 	proto.RegisterType((*VirtualHostReport)(nil), "gloo.solo.io.VirtualHostReport")
@@ -1086,62 +1138,65 @@ func init() {
 }
 
 var fileDescriptor_aacaf097b496f502 = []byte{
-	// 878 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x52, 0x2b, 0x45,
-	0x14, 0xc6, 0x33, 0x09, 0x17, 0xf5, 0x04, 0x72, 0x43, 0x27, 0x37, 0x84, 0xc1, 0xeb, 0x0d, 0x23,
-	0x20, 0x8a, 0x4e, 0x34, 0x5a, 0x25, 0xa2, 0x40, 0x15, 0x42, 0x89, 0x16, 0x62, 0x18, 0x22, 0x56,
-	0xb9, 0x30, 0x35, 0x0c, 0x4d, 0xd2, 0x9a, 0x4c, 0x0f, 0xdd, 0x9d, 0x68, 0x16, 0xee, 0xdc, 0xfb,
-	0x06, 0x2e, 0xdd, 0xf9, 0x10, 0x56, 0xe9, 0x03, 0xb8, 0x75, 0xe5, 0xce, 0x77, 0x70, 0x65, 0x65,
-	0xa6, 0x33, 0x64, 0xfe, 0x24, 0x33, 0xcb, 0xbb, 0xec, 0xce, 0x39, 0x5f, 0x7f, 0xfd, 0x3b, 0x27,
-	0x3d, 0x07, 0xf6, 0x1c, 0x46, 0xbf, 0xc5, 0x96, 0xe0, 0xf5, 0x4e, 0x8f, 0xd2, 0xba, 0xe9, 0x90,
-	0x7a, 0x87, 0x39, 0x56, 0x7d, 0x68, 0xf6, 0xc8, 0xad, 0x29, 0x08, 0xb5, 0xeb, 0x0e, 0xa3, 0x3f,
-	0x8c, 0xda, 0x0f, 0x1b, 0xba, 0xc3, 0xa8, 0xa0, 0x68, 0x69, 0x9c, 0xa0, 0x73, 0xda, 0xa3, 0x3a,
-	0xa1, 0xea, 0x96, 0x9b, 0x1e, 0x15, 0x1b, 0xbe, 0xe3, 0xe5, 0x7b, 0x49, 0xda, 0x67, 0xf0, 0xb4,
-	0x39, 0x5e, 0x5e, 0xfb, 0x6a, 0x57, 0x98, 0x0d, 0x89, 0x85, 0x0d, 0x7c, 0x3f, 0xc0, 0x5c, 0xa0,
-	0xd7, 0xe1, 0x91, 0x1b, 0x5f, 0x55, 0x6a, 0xca, 0x4e, 0xbe, 0x51, 0xd2, 0xa7, 0x4f, 0xd1, 0xdd,
-	0x5c, 0xc3, 0x8b, 0xd0, 0xbe, 0x81, 0x57, 0x66, 0x69, 0x71, 0x87, 0xda, 0x1c, 0xa3, 0x8f, 0x60,
-	0xc9, 0x33, 0xcf, 0xb0, 0x43, 0x99, 0x90, 0x9a, 0x6b, 0x71, 0x9a, 0x6e, 0x80, 0x91, 0x77, 0x1e,
-	0x16, 0xda, 0x2a, 0x3c, 0xb9, 0xa0, 0x82, 0xdc, 0x8d, 0xbe, 0xb0, 0x0d, 0xcc, 0x47, 0xb6, 0x25,
-	0x3d, 0x6a, 0x55, 0xa8, 0x84, 0x7f, 0xf0, 0x0e, 0xd4, 0xae, 0x21, 0x3f, 0x25, 0x87, 0x3e, 0x81,
-	0x62, 0x8f, 0x70, 0x81, 0x6d, 0xcc, 0xa4, 0x05, 0x5e, 0x55, 0x6a, 0xb9, 0x9d, 0x7c, 0xe3, 0xe5,
-	0xa0, 0x87, 0x73, 0x19, 0x25, 0x6d, 0x3c, 0xee, 0x05, 0xd6, 0x5c, 0xfb, 0x2b, 0x07, 0x85, 0x60,
-	0x0c, 0xda, 0x87, 0x45, 0xcc, 0x18, 0x65, 0xbc, 0x9a, 0x75, 0x15, 0xb5, 0x79, 0x8a, 0xfa, 0xe9,
-	0x38, 0xd4, 0x90, 0x19, 0xa8, 0x05, 0xe5, 0xae, 0x10, 0x4e, 0x3b, 0x64, 0xae, 0x9a, 0x73, 0xf9,
-	0xd4, 0x82, 0x4a, 0x67, 0x42, 0x38, 0x41, 0xb5, 0xb3, 0x8c, 0x81, 0xba, 0x91, 0x5d, 0x74, 0x09,
-	0x25, 0x61, 0x45, 0x45, 0x17, 0x5c, 0xd1, 0x67, 0x41, 0xd1, 0x96, 0x15, 0xd5, 0x5c, 0x11, 0xe1,
-	0x4d, 0xf5, 0x77, 0x05, 0x1e, 0xb9, 0xd6, 0xd1, 0x87, 0xb0, 0x20, 0x46, 0x0e, 0x76, 0x4b, 0x58,
-	0x68, 0xbc, 0x96, 0x7c, 0x59, 0xbd, 0x35, 0x72, 0xb0, 0xe1, 0x26, 0xa1, 0x0a, 0x2c, 0x32, 0x6c,
-	0x72, 0x6a, 0x57, 0xb3, 0x35, 0x65, 0xe7, 0x25, 0x43, 0xae, 0x34, 0x0b, 0x16, 0x5a, 0xde, 0xef,
-	0xe8, 0xc2, 0xec, 0xe3, 0x0b, 0x2a, 0xbe, 0xb4, 0xc9, 0xfd, 0x00, 0xbb, 0x02, 0xc5, 0x0c, 0x52,
-	0xa1, 0x72, 0x4c, 0xec, 0xdb, 0x26, 0x65, 0x22, 0xf4, 0x9b, 0x82, 0x10, 0x14, 0xae, 0xae, 0xce,
-	0x3f, 0xa6, 0xf6, 0x1d, 0xe9, 0x78, 0x7b, 0x59, 0x54, 0x82, 0xc7, 0x4d, 0x46, 0x2d, 0xcc, 0x39,
-	0xb1, 0xe5, 0x66, 0xee, 0xb8, 0x02, 0x65, 0x1f, 0xc9, 0xd8, 0x8d, 0xe4, 0xa2, 0xfd, 0x9a, 0x05,
-	0x14, 0x65, 0x8b, 0x0e, 0xfd, 0xba, 0x7a, 0x9d, 0xb2, 0x9d, 0x54, 0x8d, 0x50, 0x6d, 0x2f, 0xa1,
-	0x3c, 0x24, 0x4c, 0x0c, 0xcc, 0x5e, 0xbb, 0x4b, 0xb9, 0xf0, 0xfb, 0xce, 0xeb, 0x92, 0x50, 0x19,
-	0xae, 0xbd, 0xc8, 0x33, 0xca, 0x85, 0x6c, 0x3d, 0x34, 0x0c, 0x6f, 0x71, 0xf5, 0xc7, 0x49, 0x11,
-	0x8e, 0x02, 0x45, 0xd8, 0x4d, 0xe7, 0x2c, 0x4d, 0x21, 0xd6, 0x65, 0x21, 0x62, 0x00, 0x66, 0xb4,
-	0xbf, 0xb3, 0xb0, 0x12, 0x31, 0x8a, 0x0e, 0x42, 0x9c, 0xb6, 0x12, 0x6e, 0x16, 0xc2, 0x74, 0x08,
-	0xcb, 0x8c, 0x0e, 0x04, 0x0e, 0xf1, 0x09, 0xbd, 0x0d, 0xc6, 0x38, 0x44, 0x92, 0x59, 0x62, 0x0f,
-	0x0b, 0xae, 0xfe, 0xe9, 0x77, 0xe6, 0x61, 0x00, 0xca, 0x1b, 0xa9, 0x6c, 0xa4, 0x61, 0x72, 0x9b,
-	0xd0, 0x9c, 0x6b, 0xf0, 0xe4, 0x84, 0xf6, 0x4d, 0x62, 0xf3, 0x48, 0x6f, 0xc6, 0x60, 0xcc, 0xa2,
-	0x32, 0x14, 0x4f, 0xfb, 0x8e, 0x18, 0x79, 0x49, 0xb2, 0x3b, 0xb5, 0x5f, 0x72, 0x90, 0x9f, 0xba,
-	0x25, 0x7a, 0x3f, 0x84, 0xf5, 0xd9, 0x4c, 0x20, 0x21, 0xa0, 0x07, 0xf0, 0xe2, 0xf7, 0x26, 0xb3,
-	0x89, 0xdd, 0x99, 0xb0, 0xdc, 0x98, 0x9d, 0xfa, 0x95, 0x17, 0x69, 0xf8, 0x29, 0xea, 0xcf, 0x3e,
-	0xcf, 0xbd, 0x00, 0xcf, 0xcd, 0x84, 0xf3, 0xd3, 0x90, 0x7c, 0x4f, 0x92, 0x5c, 0x85, 0xd2, 0xa7,
-	0xb6, 0xfb, 0x1d, 0xfb, 0xdc, 0x14, 0x56, 0x17, 0xb3, 0x09, 0xca, 0x18, 0x5e, 0x8a, 0xfa, 0x93,
-	0x02, 0x2f, 0x48, 0x9f, 0x68, 0x3f, 0xe0, 0x69, 0x3b, 0xf1, 0x62, 0x69, 0x5c, 0x6d, 0x49, 0x57,
-	0x4f, 0x61, 0x4d, 0xba, 0x3a, 0xc1, 0x5c, 0x10, 0xdb, 0xfd, 0x92, 0x49, 0x9d, 0x62, 0x46, 0xfb,
-	0x27, 0x0b, 0x2b, 0x91, 0xd7, 0x32, 0xa9, 0xfb, 0x23, 0x09, 0xa1, 0x62, 0x9d, 0x42, 0x71, 0xfc,
-	0x54, 0xc7, 0x3c, 0x10, 0xeb, 0x11, 0xa1, 0xa9, 0xc7, 0xa1, 0x20, 0xa6, 0x97, 0x5c, 0xfd, 0x23,
-	0xdd, 0x9f, 0x60, 0x86, 0x9b, 0xe7, 0xe5, 0x85, 0xd6, 0xfe, 0x53, 0x60, 0x39, 0x70, 0x51, 0xf4,
-	0x41, 0xe8, 0xe3, 0xba, 0x31, 0x87, 0x4a, 0x10, 0xad, 0xfa, 0x9b, 0xcf, 0x64, 0x6e, 0xd3, 0xc4,
-	0x48, 0xa4, 0xe1, 0xd1, 0x4c, 0xe0, 0xb1, 0x0e, 0xab, 0xd1, 0x66, 0x9a, 0xf7, 0x2c, 0x34, 0xfe,
-	0x55, 0xa0, 0x12, 0x3f, 0x46, 0xa1, 0x36, 0x14, 0x82, 0x73, 0x0e, 0x7a, 0x35, 0x78, 0x89, 0xd8,
-	0xf1, 0x48, 0xdd, 0x9c, 0x1f, 0x24, 0x47, 0xa5, 0xcc, 0xdb, 0x0a, 0xea, 0xc1, 0xb2, 0x3c, 0x15,
-	0xbb, 0x16, 0xd0, 0x6e, 0xcc, 0x68, 0x36, 0x6b, 0x54, 0x54, 0xdf, 0x4c, 0x17, 0x3c, 0x39, 0xef,
-	0xf8, 0xe8, 0xeb, 0x83, 0x0e, 0x11, 0xdd, 0xc1, 0x8d, 0x6e, 0xd1, 0x7e, 0x7d, 0x9c, 0xf6, 0x16,
-	0xa1, 0xf5, 0x98, 0xb9, 0xd5, 0xf9, 0xae, 0x13, 0x37, 0x08, 0xdf, 0x2c, 0xba, 0x33, 0xec, 0xbb,
-	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x49, 0x1d, 0x72, 0x24, 0x34, 0x0b, 0x00, 0x00,
+	// 921 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4f, 0x73, 0x1b, 0x35,
+	0x18, 0xc6, 0xbd, 0xce, 0x1f, 0xe0, 0x75, 0xe2, 0x3a, 0x8a, 0xeb, 0x38, 0x1b, 0x4a, 0xdd, 0xa5,
+	0x29, 0x81, 0x82, 0x0d, 0x81, 0x19, 0x4a, 0x21, 0xe9, 0x4c, 0x68, 0x86, 0xc0, 0x84, 0x90, 0x6e,
+	0x42, 0x98, 0xe9, 0x01, 0xcf, 0x66, 0xad, 0xda, 0x02, 0x5b, 0xda, 0x4a, 0xb2, 0xc1, 0x07, 0x6e,
+	0xdc, 0xf9, 0x06, 0x1c, 0xb9, 0xe5, 0x43, 0x30, 0x03, 0x5f, 0x82, 0x13, 0x37, 0xbe, 0x03, 0x27,
+	0xc6, 0x5a, 0xd9, 0xf1, 0x6a, 0xd7, 0xde, 0x1d, 0x4e, 0x3d, 0x4a, 0xfb, 0xbe, 0x8f, 0x1e, 0xfd,
+	0xf4, 0x4a, 0xfb, 0xc2, 0x83, 0x80, 0xb3, 0xef, 0xb0, 0x2f, 0x45, 0xa3, 0xdd, 0x65, 0xac, 0xe1,
+	0x05, 0xa4, 0xd1, 0xe6, 0x81, 0xdf, 0x18, 0x78, 0x5d, 0xd2, 0xf2, 0x24, 0x61, 0xb4, 0x11, 0x70,
+	0xf6, 0xe3, 0xb0, 0x79, 0x3d, 0x51, 0x0f, 0x38, 0x93, 0x0c, 0xad, 0x8c, 0x12, 0xea, 0x82, 0x75,
+	0x59, 0x9d, 0x30, 0x7b, 0x5b, 0xa5, 0xc7, 0xc5, 0x06, 0xef, 0x85, 0xf9, 0x61, 0x92, 0xf3, 0x05,
+	0xdc, 0x3a, 0x1d, 0x0d, 0x2f, 0x26, 0x6a, 0x67, 0x98, 0x0f, 0x88, 0x8f, 0x5d, 0xfc, 0xbc, 0x8f,
+	0x85, 0x44, 0x6f, 0xc2, 0x92, 0x8a, 0xaf, 0x5a, 0x35, 0x6b, 0xa7, 0xb0, 0xbb, 0x5e, 0x9f, 0x5e,
+	0xa5, 0xae, 0x72, 0xdd, 0x30, 0xc2, 0xf9, 0x16, 0x5e, 0x9b, 0xa5, 0x25, 0x02, 0x46, 0x05, 0x46,
+	0x9f, 0xc0, 0x4a, 0x68, 0x9e, 0xe3, 0x80, 0x71, 0xa9, 0x35, 0x37, 0x93, 0x34, 0x55, 0x80, 0x5b,
+	0x08, 0xae, 0x07, 0xce, 0x06, 0xdc, 0x3c, 0x61, 0x92, 0x3c, 0x1b, 0x7e, 0x45, 0x5d, 0x2c, 0x86,
+	0xd4, 0xd7, 0x1e, 0x9d, 0x2a, 0x54, 0xcc, 0x0f, 0xe1, 0x82, 0xce, 0x05, 0x14, 0xa6, 0xe4, 0xd0,
+	0x67, 0x50, 0xea, 0x12, 0x21, 0x31, 0xc5, 0x5c, 0x5b, 0x10, 0x55, 0xab, 0xb6, 0xb0, 0x53, 0xd8,
+	0x7d, 0x35, 0xea, 0xe1, 0x58, 0x47, 0x69, 0x1b, 0x37, 0xba, 0x91, 0xb1, 0x70, 0xae, 0x16, 0xa1,
+	0x18, 0x8d, 0x41, 0x0f, 0x61, 0x19, 0x73, 0xce, 0xb8, 0xa8, 0xe6, 0x95, 0xa2, 0x33, 0x4f, 0xb1,
+	0x7e, 0x38, 0x0a, 0x75, 0x75, 0x06, 0x3a, 0x87, 0x72, 0x47, 0xca, 0xa0, 0x69, 0x98, 0xab, 0x2e,
+	0x28, 0x3e, 0xb5, 0xa8, 0xd2, 0x91, 0x94, 0x41, 0x54, 0xed, 0x28, 0xe7, 0xa2, 0x4e, 0x6c, 0x16,
+	0x3d, 0x81, 0x75, 0xe9, 0xc7, 0x45, 0x17, 0x95, 0xe8, 0xed, 0xa8, 0xe8, 0xb9, 0x1f, 0xd7, 0x5c,
+	0x93, 0xe6, 0x24, 0x7a, 0x0a, 0x95, 0xce, 0xf0, 0x92, 0x93, 0x56, 0x4c, 0x75, 0xa9, 0x66, 0xc5,
+	0x37, 0x7d, 0xa4, 0x62, 0x63, 0xc2, 0xe5, 0x4e, 0xc2, 0xbc, 0xfd, 0xbb, 0x05, 0x4b, 0x0a, 0x0b,
+	0xfa, 0x18, 0x16, 0xe5, 0x30, 0xc0, 0xaa, 0x3c, 0x8a, 0xbb, 0x6f, 0xa4, 0x83, 0xac, 0x9f, 0x0f,
+	0x03, 0xec, 0xaa, 0x24, 0x54, 0x81, 0x65, 0x8e, 0x3d, 0xc1, 0x68, 0x35, 0x5f, 0xb3, 0x76, 0x5e,
+	0x71, 0xf5, 0xc8, 0xf1, 0x61, 0xf1, 0x3c, 0xfc, 0x8e, 0x4e, 0xbc, 0x1e, 0x3e, 0x61, 0xf2, 0x6b,
+	0x4a, 0x9e, 0xf7, 0xb1, 0x12, 0x28, 0xe5, 0x90, 0x0d, 0x95, 0x03, 0x42, 0x5b, 0xa7, 0x8c, 0x4b,
+	0xe3, 0x9b, 0x85, 0x10, 0x14, 0xcf, 0xce, 0x8e, 0x3f, 0x65, 0xf4, 0x19, 0x69, 0x87, 0x73, 0x79,
+	0xb4, 0x0e, 0x37, 0x4e, 0x39, 0xf3, 0xb1, 0x10, 0x84, 0xea, 0xc9, 0x85, 0x83, 0x0a, 0x94, 0x27,
+	0x60, 0x46, 0x6e, 0x34, 0x1d, 0x87, 0x42, 0x39, 0x89, 0x05, 0xba, 0x80, 0x6a, 0xcf, 0x93, 0x7e,
+	0x07, 0xb7, 0x9a, 0xff, 0xab, 0x30, 0x2b, 0x3a, 0xfb, 0xd8, 0xa8, 0xcf, 0xdf, 0xf2, 0x80, 0xe2,
+	0x75, 0x82, 0xf6, 0x27, 0x35, 0x1a, 0x8a, 0xdf, 0x4b, 0xab, 0x2c, 0xa3, 0x4e, 0x9f, 0x40, 0x79,
+	0x40, 0xb8, 0xec, 0x7b, 0xdd, 0x66, 0x87, 0x09, 0x39, 0xb1, 0x1a, 0x56, 0xbc, 0x51, 0x52, 0x17,
+	0x61, 0xe4, 0x11, 0x13, 0x52, 0xbb, 0x45, 0x03, 0x73, 0x4a, 0xd8, 0x3f, 0x8d, 0x0f, 0xfd, 0x51,
+	0xe4, 0xd0, 0xef, 0x67, 0x73, 0x96, 0xe5, 0xe0, 0xb7, 0xf4, 0xc1, 0x27, 0x1c, 0x58, 0xce, 0xf9,
+	0x2b, 0x0f, 0x6b, 0x31, 0xa3, 0x68, 0xcf, 0xe0, 0xb4, 0x9d, 0xb2, 0x33, 0x03, 0xd3, 0x3e, 0xac,
+	0x72, 0xd6, 0x97, 0xd8, 0xe0, 0x63, 0xbc, 0x73, 0xee, 0x28, 0x44, 0x93, 0x59, 0xe1, 0xd7, 0x03,
+	0x61, 0xff, 0x39, 0xb9, 0x09, 0xfb, 0x11, 0x28, 0x6f, 0x65, 0xb2, 0x91, 0x85, 0x49, 0x2b, 0xe5,
+	0x32, 0x6c, 0xc2, 0xcd, 0xc7, 0xac, 0xe7, 0x11, 0x2a, 0x62, 0x77, 0x21, 0x01, 0x63, 0x1e, 0x95,
+	0xa1, 0x74, 0xd8, 0x0b, 0xe4, 0x30, 0x4c, 0xd2, 0xb7, 0xc1, 0xf9, 0x75, 0x01, 0x0a, 0x53, 0xbb,
+	0x44, 0x1f, 0x1a, 0x58, 0x6f, 0xcf, 0x04, 0x62, 0x00, 0xdd, 0x83, 0x97, 0x7f, 0xf0, 0x38, 0x25,
+	0xb4, 0x3d, 0x66, 0x79, 0x67, 0x76, 0xea, 0x37, 0x61, 0xa4, 0x3b, 0x49, 0xb1, 0x7f, 0x99, 0xf0,
+	0x7c, 0x10, 0xe1, 0x79, 0x37, 0x65, 0xfd, 0x2c, 0x24, 0x3f, 0xd0, 0x24, 0x37, 0x60, 0xfd, 0x73,
+	0xaa, 0xfe, 0xc9, 0x5f, 0xaa, 0x2b, 0xc9, 0xc7, 0x28, 0x13, 0x78, 0x59, 0xf6, 0xcf, 0x16, 0xbc,
+	0xa4, 0x7d, 0xa2, 0x87, 0x11, 0x4f, 0xf7, 0x52, 0x37, 0x96, 0xc5, 0xd5, 0xb6, 0x76, 0x75, 0x0b,
+	0x36, 0xb5, 0xab, 0xc7, 0x58, 0x48, 0x42, 0xd5, 0x5f, 0x59, 0xeb, 0x94, 0x72, 0xce, 0xdf, 0x79,
+	0x58, 0x8b, 0xbd, 0xfc, 0x69, 0xd5, 0x1f, 0x4b, 0x30, 0x0e, 0xeb, 0x10, 0x4a, 0xa3, 0xdf, 0x4e,
+	0xc2, 0x03, 0xb1, 0x15, 0x13, 0x9a, 0x7a, 0x1c, 0x8a, 0x72, 0x7a, 0x28, 0xec, 0x3f, 0xb2, 0x5d,
+	0x82, 0x19, 0x6e, 0x5e, 0x94, 0x3f, 0x82, 0xf3, 0xaf, 0x05, 0xab, 0x91, 0x8d, 0xa2, 0x8f, 0x8c,
+	0x46, 0xe1, 0xce, 0x1c, 0x2a, 0x51, 0xb4, 0xf6, 0xd5, 0x84, 0xc9, 0xdc, 0xa2, 0x49, 0x90, 0xc8,
+	0xc2, 0xe3, 0x34, 0x85, 0xc7, 0x16, 0x6c, 0xc4, 0x8b, 0x69, 0xde, 0xb3, 0xb0, 0xfb, 0x8f, 0x05,
+	0x95, 0xe4, 0x96, 0x10, 0x35, 0xa1, 0x18, 0xed, 0xd9, 0xd0, 0xeb, 0xd1, 0x4d, 0x24, 0xb6, 0x7a,
+	0xf6, 0xdd, 0xf9, 0x41, 0xba, 0xed, 0xcb, 0xbd, 0x6b, 0xa1, 0x2e, 0xac, 0xea, 0x55, 0xb1, 0xb2,
+	0x80, 0xee, 0x27, 0xb4, 0x99, 0xb3, 0xda, 0x5e, 0xfb, 0xed, 0x6c, 0xc1, 0xe3, 0xf5, 0x0e, 0x1e,
+	0x3d, 0xdd, 0x6b, 0x13, 0xd9, 0xe9, 0x5f, 0xd6, 0x7d, 0xd6, 0x6b, 0x8c, 0xd2, 0xde, 0x21, 0xac,
+	0x91, 0xd0, 0x83, 0x07, 0xdf, 0xb7, 0x93, 0x9a, 0xfa, 0xcb, 0x65, 0xd5, 0x8f, 0xbf, 0xff, 0xdf,
+	0x00, 0xe5, 0x1c, 0xec, 0xbe, 0x00, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
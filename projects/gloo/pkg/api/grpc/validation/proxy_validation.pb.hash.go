@@ -220,6 +220,57 @@ func (m *ListenerReport) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *ListenerReport_HybridListenerReport:
+
+		if h, ok := interface{}(m.GetHybridListenerReport()).(interface {
+			Hash(hasher hash.Hash64) (uint64, error)
+		}); ok {
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if val, err := hashstructure.Hash(m.GetHybridListenerReport(), nil); err != nil {
+				return 0, err
+			} else {
+				if err := binary.Write(hasher, binary.LittleEndian, val); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *HybridListenerReport) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+
+	for _, v := range m.GetMatchedListenerReports() {
+
+		if h, ok := interface{}(v).(interface {
+			Hash(hasher hash.Hash64) (uint64, error)
+		}); ok {
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if val, err := hashstructure.Hash(v, nil); err != nil {
+				return 0, err
+			} else {
+				if err := binary.Write(hasher, binary.LittleEndian, val); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
@@ -6,11 +6,11 @@ package matchers
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -44,10 +44,14 @@ type Matcher struct {
 	// query string for a match to occur.
 	QueryParameters []*QueryParameterMatcher `protobuf:"bytes,7,rep,name=query_parameters,json=queryParameters,proto3" json:"query_parameters,omitempty"`
 	// HTTP Method/Verb(s) to match on. If none specified, the matcher will ignore the HTTP Method
-	Methods              []string `protobuf:"bytes,8,rep,name=methods,proto3" json:"methods,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Methods []string `protobuf:"bytes,8,rep,name=methods,proto3" json:"methods,omitempty"`
+	// If specified, the matcher only matches this percentage of the requests that match its other criteria. Must be
+	// between 0 and 100. Envoy uses the same random value of a request for all the matchers, so consecutive routes
+	// with the same criteria and increasing percentages split the requests between them.
+	Percentage           *types.FloatValue `protobuf:"bytes,9,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Matcher) Reset()         { *m = Matcher{} }
@@ -142,6 +146,13 @@ func (m *Matcher) GetMethods() []string {
 	return nil
 }
 
+func (m *Matcher) GetPercentage() *types.FloatValue {
+	if m != nil {
+		return m.Percentage
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Matcher) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -301,31 +312,34 @@ func init() {
 }
 
 var fileDescriptor_9c5a9085c760cef4 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x4d, 0x4f, 0x02, 0x31,
-	0x10, 0x75, 0xf9, 0x5a, 0x28, 0x7e, 0x90, 0x06, 0x4d, 0xc3, 0xc1, 0x20, 0x27, 0x3c, 0xb8, 0x1b,
-	0xf4, 0xee, 0x01, 0x2f, 0x78, 0x30, 0xd1, 0xbd, 0x98, 0x18, 0x13, 0x52, 0x96, 0x61, 0xb7, 0xca,
-	0xd2, 0xd2, 0x2d, 0x64, 0xfd, 0x3b, 0x9e, 0xfc, 0x09, 0xfe, 0x1e, 0xff, 0x83, 0x77, 0xd3, 0x96,
-	0xc5, 0x90, 0xa0, 0x31, 0xf1, 0x36, 0xef, 0x75, 0xde, 0xeb, 0xcc, 0xcb, 0xa0, 0xeb, 0x88, 0xa9,
-	0x78, 0x31, 0xf2, 0x42, 0x9e, 0xf8, 0x29, 0x9f, 0xf2, 0x33, 0xc6, 0xfd, 0x68, 0xca, 0xb9, 0x2f,
-	0x24, 0x7f, 0x82, 0x50, 0xa5, 0x16, 0x51, 0xc1, 0xfc, 0x65, 0xcf, 0x0f, 0xb9, 0x04, 0x3f, 0xa1,
-	0x2a, 0x8c, 0x41, 0xa6, 0xeb, 0xc2, 0x13, 0x92, 0x2b, 0x8e, 0x5b, 0x6b, 0xac, 0xdb, 0x3c, 0xad,
-	0xf3, 0xb4, 0xa5, 0xc7, 0x78, 0xab, 0x19, 0xf1, 0x88, 0x9b, 0x36, 0x5f, 0x57, 0x56, 0xd1, 0xc2,
-	0x90, 0x29, 0x4b, 0x42, 0xa6, 0x2c, 0xd7, 0x79, 0x2d, 0x20, 0xf7, 0xc6, 0x1a, 0x61, 0x82, 0x2a,
-	0x42, 0xc2, 0x84, 0x65, 0xc4, 0x69, 0x3b, 0xdd, 0xda, 0x60, 0x27, 0x58, 0x61, 0x7c, 0x84, 0xca,
-	0x90, 0xd1, 0x50, 0x91, 0xc2, 0xea, 0xc1, 0x42, 0xcd, 0x4b, 0x88, 0x20, 0x23, 0xc5, 0x9c, 0x37,
-	0x10, 0x5f, 0x21, 0x37, 0x06, 0x3a, 0x06, 0x99, 0x92, 0x4a, 0xbb, 0xd8, 0xad, 0x9f, 0x9f, 0x7a,
-	0x3f, 0x4f, 0xeb, 0x0d, 0x4c, 0xeb, 0x6a, 0x8a, 0x20, 0x57, 0xe2, 0x47, 0xd4, 0x98, 0x2f, 0x40,
-	0xbe, 0x0c, 0x05, 0x95, 0x34, 0x01, 0xa5, 0xdd, 0x5c, 0xe3, 0xd6, 0xfb, 0xcd, 0xed, 0x4e, 0x6b,
-	0x6e, 0x73, 0x49, 0xee, 0x7a, 0x30, 0xdf, 0xa0, 0x53, 0x4c, 0x90, 0x9b, 0x80, 0x8a, 0xf9, 0x38,
-	0x25, 0xd5, 0x76, 0xb1, 0x5b, 0x0b, 0x72, 0xd8, 0x6f, 0xa0, 0x7d, 0x41, 0x55, 0x3c, 0x4c, 0x05,
-	0x84, 0x6c, 0xc2, 0x40, 0x76, 0x24, 0xda, 0xdb, 0x98, 0x11, 0x63, 0x54, 0x9a, 0xd1, 0x04, 0x6c,
-	0x4e, 0x81, 0xa9, 0x71, 0x13, 0x95, 0x97, 0x74, 0xba, 0x00, 0x9b, 0x51, 0x60, 0x81, 0x66, 0xbf,
-	0x13, 0xaa, 0xe6, 0xf9, 0x9c, 0xa0, 0x5d, 0x36, 0x5b, 0x82, 0x54, 0x43, 0xb3, 0x08, 0x29, 0x99,
-	0xc7, 0xba, 0xe5, 0xcc, 0x27, 0x9d, 0x7b, 0x74, 0xb8, 0x75, 0x93, 0xff, 0xfe, 0xdd, 0x1f, 0xbc,
-	0x7f, 0x96, 0x9c, 0xb7, 0x8f, 0x63, 0xe7, 0xe1, 0xf2, 0x6f, 0xc7, 0x28, 0x9e, 0xa3, 0xad, 0x07,
-	0x39, 0xaa, 0x98, 0x13, 0xba, 0xf8, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x19, 0xdf, 0xd1, 0xd5,
-	0x02, 0x00, 0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xdf, 0x8a, 0x13, 0x31,
+	0x14, 0xc6, 0x9d, 0x6d, 0xb7, 0xdd, 0xa6, 0xfe, 0x59, 0xc2, 0x2a, 0xa1, 0xc2, 0x52, 0x7b, 0x55,
+	0x2f, 0x4c, 0xd8, 0xf5, 0x52, 0xf0, 0x62, 0x05, 0xa9, 0x17, 0x82, 0xce, 0x85, 0x82, 0x08, 0x25,
+	0x9d, 0x3d, 0xcd, 0x44, 0x67, 0x7a, 0xb2, 0x99, 0x4c, 0x1d, 0xdf, 0xc8, 0x47, 0xf0, 0x6d, 0x04,
+	0xdf, 0xc1, 0x7b, 0x49, 0x32, 0x53, 0x5d, 0xa8, 0x22, 0x78, 0x77, 0xbe, 0x2f, 0xe7, 0x7c, 0x27,
+	0xf9, 0x11, 0xf2, 0x42, 0x69, 0x97, 0xd7, 0x2b, 0x9e, 0x61, 0x29, 0x2a, 0x2c, 0xf0, 0x91, 0x46,
+	0xa1, 0x0a, 0x44, 0x61, 0x2c, 0x7e, 0x80, 0xcc, 0x55, 0x51, 0x49, 0xa3, 0xc5, 0xf6, 0x4c, 0x64,
+	0x68, 0x41, 0x94, 0xd2, 0x65, 0x39, 0xd8, 0x6a, 0x57, 0x70, 0x63, 0xd1, 0x21, 0x9d, 0xec, 0xb4,
+	0x6f, 0xe3, 0x7e, 0x8e, 0xfb, 0x48, 0xae, 0x71, 0x72, 0xa2, 0x50, 0x61, 0x68, 0x13, 0xbe, 0x8a,
+	0x13, 0x13, 0x0a, 0x8d, 0x8b, 0x26, 0x34, 0xae, 0xf5, 0x4e, 0x15, 0xa2, 0x2a, 0x40, 0x04, 0xb5,
+	0xaa, 0xd7, 0xe2, 0x93, 0x95, 0xc6, 0xec, 0xb6, 0xcc, 0xbe, 0x1d, 0x90, 0xe1, 0xcb, 0xb8, 0x88,
+	0x32, 0x32, 0x30, 0x16, 0xd6, 0xba, 0x61, 0xc9, 0x34, 0x99, 0x8f, 0x16, 0x37, 0xd2, 0x56, 0xd3,
+	0x7b, 0xe4, 0x10, 0x1a, 0x99, 0x39, 0x76, 0xd0, 0x1e, 0x44, 0xe9, 0x7d, 0x0b, 0x0a, 0x1a, 0xd6,
+	0xeb, 0xfc, 0x20, 0xe9, 0x33, 0x32, 0xcc, 0x41, 0x5e, 0x82, 0xad, 0xd8, 0x60, 0xda, 0x9b, 0x8f,
+	0xcf, 0x1f, 0xf2, 0x3f, 0xbf, 0x86, 0x2f, 0x42, 0x6b, 0x7b, 0x8b, 0xb4, 0x9b, 0xa4, 0xef, 0xc9,
+	0xf1, 0x55, 0x0d, 0xf6, 0xf3, 0xd2, 0x48, 0x2b, 0x4b, 0x70, 0x3e, 0x6d, 0x18, 0xd2, 0xce, 0xfe,
+	0x96, 0xf6, 0xda, 0xcf, 0xbc, 0xea, 0x46, 0xba, 0xd4, 0x3b, 0x57, 0xd7, 0xec, 0x8a, 0x32, 0x32,
+	0x2c, 0xc1, 0xe5, 0x78, 0x59, 0xb1, 0xa3, 0x69, 0x6f, 0x3e, 0x4a, 0x3b, 0x49, 0x9f, 0x10, 0x62,
+	0xc0, 0x66, 0xb0, 0x71, 0x52, 0x01, 0x1b, 0x4d, 0x93, 0xf9, 0xf8, 0xfc, 0x3e, 0x8f, 0x1c, 0x79,
+	0xc7, 0x91, 0x3f, 0x2f, 0x50, 0xba, 0x37, 0xb2, 0xa8, 0x21, 0xfd, 0xad, 0xfd, 0xe2, 0x98, 0xdc,
+	0x36, 0xd2, 0xe5, 0xcb, 0xca, 0x40, 0xa6, 0xd7, 0x1a, 0xec, 0xcc, 0x92, 0x5b, 0xd7, 0x1e, 0x48,
+	0x29, 0xe9, 0x6f, 0x64, 0x09, 0x11, 0x72, 0x1a, 0x6a, 0x7a, 0x42, 0x0e, 0xb7, 0x3e, 0x2b, 0x02,
+	0x4e, 0xa3, 0xf0, 0xee, 0x2f, 0xbc, 0x47, 0x1d, 0xdc, 0x07, 0xe4, 0xa6, 0xde, 0x6c, 0xc1, 0xba,
+	0x65, 0xa0, 0xc0, 0xfa, 0xe1, 0x70, 0x1c, 0xbd, 0xb0, 0x64, 0xf6, 0x96, 0xdc, 0xdd, 0x8b, 0xe1,
+	0x7f, 0x77, 0x5f, 0x2c, 0xbe, 0xfe, 0xe8, 0x27, 0x5f, 0xbe, 0x9f, 0x26, 0xef, 0x9e, 0xfe, 0xdb,
+	0x4f, 0x37, 0x1f, 0xd5, 0xde, 0xdf, 0xbe, 0x1a, 0x04, 0x92, 0x8f, 0x7f, 0x0e, 0x00, 0x2d, 0x55,
+	0x3e, 0xf8, 0x32, 0x03, 0x00, 0x00,
 }
 
 func (this *Matcher) Equal(that interface{}) bool {
@@ -380,6 +394,9 @@ func (this *Matcher) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Percentage.Equal(that1.Percentage) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -6,13 +6,12 @@ package jwt
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -68,7 +67,9 @@ func (m *VhostExtension) GetProviders() map[string]*Provider {
 
 type RouteExtension struct {
 	// Disable JWT checks on this route.
-	Disable              bool     `protobuf:"varint,1,opt,name=disable,proto3" json:"disable,omitempty"`
+	Disable bool `protobuf:"varint,1,opt,name=disable,proto3" json:"disable,omitempty"`
+	// Only accept a JWT of this provider of the virtual host on this route, instead of a JWT of any of its providers.
+	Require              string   `protobuf:"bytes,2,opt,name=require,proto3" json:"require,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -105,6 +106,13 @@ func (m *RouteExtension) GetDisable() bool {
 	return false
 }
 
+func (m *RouteExtension) GetRequire() string {
+	if m != nil {
+		return m.Require
+	}
+	return ""
+}
+
 type Provider struct {
 	// The source for the keys to validate JWTs.
 	Jwks *Jwks `protobuf:"bytes,1,opt,name=jwks,proto3" json:"jwks,omitempty"`
@@ -539,51 +547,52 @@ func init() {
 }
 
 var fileDescriptor_3d83f6c4a43394a0 = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xbe, 0xce, 0x5f, 0x9b, 0x93, 0x34, 0xf7, 0xde, 0x51, 0x85, 0xdc, 0x88, 0x56, 0xc1, 0x80,
-	0x88, 0x90, 0xb0, 0x45, 0x58, 0x50, 0x01, 0xaa, 0x50, 0xa1, 0xa2, 0x42, 0x45, 0x2a, 0xd3, 0x96,
-	0x05, 0x9b, 0xe0, 0x38, 0x27, 0x89, 0x1b, 0xc7, 0x63, 0x66, 0xc6, 0x6d, 0xfa, 0x0c, 0xbc, 0x03,
-	0x6b, 0xd6, 0xac, 0x58, 0xb1, 0xe7, 0x35, 0x78, 0x07, 0xf6, 0x68, 0xc6, 0x63, 0x92, 0x82, 0x8c,
-	0xba, 0xb0, 0x34, 0xdf, 0x99, 0xf3, 0x7d, 0x67, 0xe6, 0x7c, 0x67, 0x0c, 0xaf, 0xc6, 0xa1, 0x9c,
-	0xa4, 0x03, 0x37, 0x60, 0x33, 0x4f, 0xb0, 0x88, 0xdd, 0x0b, 0x99, 0x37, 0x8e, 0x18, 0xf3, 0x12,
-	0xce, 0x4e, 0x31, 0x90, 0x22, 0x43, 0x7e, 0x12, 0x7a, 0x67, 0xf7, 0x3d, 0x8c, 0x25, 0xf2, 0x84,
-	0x87, 0x02, 0x3d, 0x96, 0xc8, 0x90, 0xc5, 0xc2, 0x3b, 0x3d, 0x97, 0xea, 0x73, 0x13, 0xce, 0x24,
-	0x23, 0xb6, 0x5a, 0x9a, 0x2d, 0x57, 0x31, 0x5d, 0x25, 0xea, 0x86, 0xac, 0xbd, 0xa1, 0xd5, 0xa7,
-	0xa1, 0xcc, 0xb5, 0x38, 0x8e, 0x32, 0x52, 0x7b, 0x7d, 0xcc, 0xc6, 0x4c, 0x2f, 0x3d, 0xb5, 0x32,
-	0x51, 0x82, 0x73, 0x99, 0x05, 0x71, 0x6e, 0xe4, 0xdb, 0x5b, 0x63, 0xc6, 0xc6, 0x11, 0x7a, 0x1a,
-	0x0d, 0xd2, 0x91, 0x37, 0x4c, 0xb9, 0xaf, 0x8a, 0x65, 0xfb, 0xce, 0x37, 0x0b, 0x5a, 0x6f, 0x26,
-	0x4c, 0xc8, 0xbd, 0xb9, 0xc4, 0x58, 0x84, 0x2c, 0x26, 0x27, 0x50, 0x4f, 0x38, 0x3b, 0x0b, 0x87,
-	0xc8, 0x85, 0x5d, 0xe9, 0x94, 0xbb, 0x8d, 0xde, 0x43, 0xb7, 0xe8, 0x94, 0xee, 0x65, 0xb2, 0x7b,
-	0x98, 0x33, 0xf7, 0x62, 0xc9, 0x2f, 0xe8, 0x42, 0xa9, 0xfd, 0x0e, 0x5a, 0x97, 0x37, 0xc9, 0x7f,
-	0x50, 0x9e, 0xe2, 0x85, 0x6d, 0x75, 0xac, 0x6e, 0x9d, 0xaa, 0x25, 0xd9, 0x86, 0xea, 0x99, 0x1f,
-	0xa5, 0x68, 0x97, 0x3a, 0x56, 0xb7, 0xd1, 0x73, 0x8a, 0xcb, 0xe6, 0x52, 0x34, 0x23, 0x3c, 0x2a,
-	0x6d, 0x5b, 0xce, 0x5d, 0x68, 0x51, 0x96, 0x4a, 0x5c, 0x5c, 0xc5, 0x86, 0x95, 0x61, 0x28, 0xfc,
-	0x41, 0x84, 0xba, 0xca, 0x2a, 0xcd, 0xa1, 0xf3, 0xb9, 0x04, 0xab, 0xb9, 0x06, 0xe9, 0x41, 0xe5,
-	0xf4, 0x7c, 0x2a, 0x74, 0x4e, 0xa3, 0xb7, 0x55, 0x5c, 0xf5, 0xe5, 0xf9, 0x54, 0x50, 0x9d, 0x4b,
-	0xae, 0x43, 0xdd, 0x4f, 0x87, 0x21, 0xc6, 0x01, 0x0a, 0xbb, 0xd4, 0x29, 0x77, 0xeb, 0x74, 0x11,
-	0x20, 0xd7, 0xa0, 0x16, 0x0a, 0x91, 0x22, 0xb7, 0xcb, 0xfa, 0x76, 0x06, 0x91, 0x7d, 0x68, 0x4a,
-	0x36, 0xc5, 0xb8, 0x2f, 0x58, 0xca, 0x03, 0xb4, 0x2b, 0xba, 0xe2, 0xed, 0xe2, 0x8a, 0xc7, 0x2a,
-	0xfb, 0x48, 0x27, 0xd3, 0x86, 0x5c, 0x00, 0xb2, 0x09, 0x30, 0x45, 0x4c, 0xfa, 0x3a, 0x66, 0x57,
-	0xf5, 0xed, 0xea, 0x2a, 0xa2, 0x19, 0xe4, 0x08, 0xfe, 0x0f, 0x22, 0x3f, 0x9c, 0x89, 0xbe, 0x64,
-	0xfd, 0x09, 0xfa, 0xda, 0xcc, 0x9a, 0x36, 0xf3, 0x4e, 0x71, 0xb5, 0x67, 0x8a, 0x72, 0xcc, 0xf6,
-	0x75, 0x3e, 0xfd, 0x37, 0x53, 0xc8, 0xb1, 0x70, 0x3e, 0x58, 0x50, 0x51, 0x2d, 0x20, 0x3b, 0x50,
-	0xe3, 0x38, 0x63, 0x12, 0x4d, 0xcb, 0x6e, 0x15, 0x4b, 0x52, 0x9d, 0xa7, 0x58, 0xfb, 0xff, 0x50,
-	0xc3, 0x22, 0x8f, 0xa1, 0x1a, 0xb1, 0xc0, 0x8f, 0x8c, 0xcf, 0x37, 0x8b, 0xe9, 0x07, 0x2a, 0xcd,
-	0xb0, 0x33, 0xce, 0x6e, 0x2d, 0x73, 0xcb, 0xf9, 0x68, 0x01, 0x2c, 0xd4, 0xd5, 0x34, 0xa5, 0x3c,
-	0xca, 0xa7, 0x29, 0xe5, 0x11, 0x79, 0x02, 0xcd, 0x34, 0x11, 0x92, 0xa3, 0x3f, 0xeb, 0x73, 0x1c,
-	0x99, 0x62, 0x1b, 0x6e, 0xc0, 0x38, 0x2e, 0x9d, 0x2f, 0xb3, 0x82, 0xe2, 0x88, 0x36, 0xf2, 0x74,
-	0x8a, 0x23, 0xf2, 0x14, 0x5a, 0x81, 0x1f, 0x4c, 0xb0, 0x9f, 0xbf, 0x18, 0x63, 0xd6, 0x86, 0x9b,
-	0x3d, 0x29, 0x37, 0x7f, 0x52, 0xee, 0x73, 0x93, 0x40, 0xd7, 0x34, 0x21, 0x87, 0xce, 0x26, 0xd4,
-	0x7f, 0x1d, 0xff, 0xcf, 0x61, 0x77, 0xbe, 0x5a, 0xd0, 0x58, 0xb2, 0x97, 0x1c, 0xc0, 0x4a, 0x6e,
-	0x94, 0xa5, 0x8d, 0xea, 0x5d, 0x69, 0x2c, 0xdc, 0xcc, 0x1d, 0x33, 0x23, 0xb9, 0x04, 0xb9, 0x01,
-	0xcd, 0xf7, 0x29, 0xf2, 0x8b, 0x7e, 0xe2, 0x73, 0x7f, 0x96, 0x8f, 0x68, 0x43, 0xc7, 0x0e, 0x75,
-	0xa8, 0xbd, 0x03, 0xcd, 0x65, 0xae, 0x1a, 0xda, 0x8c, 0x6d, 0x4e, 0x69, 0x90, 0x8a, 0x27, 0x1c,
-	0x47, 0xe1, 0x5c, 0x77, 0xb0, 0x4e, 0x0d, 0x72, 0x4e, 0x60, 0xed, 0xd2, 0xc0, 0x90, 0x75, 0xa8,
-	0xea, 0x91, 0x31, 0xfc, 0x0c, 0x2c, 0xc9, 0x96, 0x7e, 0x97, 0xf5, 0x93, 0x04, 0xe3, 0xa1, 0x6e,
-	0xec, 0x2a, 0x35, 0x68, 0xf7, 0xf5, 0x97, 0x1f, 0x15, 0xeb, 0xd3, 0xf7, 0x2d, 0xeb, 0xed, 0x8b,
-	0xab, 0xfd, 0x6a, 0x93, 0xe9, 0xf8, 0xef, 0xbf, 0xdb, 0x41, 0x4d, 0x7b, 0xf5, 0xe0, 0x67, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xce, 0x87, 0x5a, 0xbb, 0xbc, 0x05, 0x00, 0x00,
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x2e, 0xf5, 0x67, 0x6b, 0x24, 0xab, 0xed, 0xc2, 0x28, 0x68, 0xa1, 0x36, 0x54, 0xb6, 0x45,
+	0x75, 0x29, 0x89, 0xaa, 0x87, 0x18, 0x49, 0x60, 0x04, 0x8e, 0x8d, 0x18, 0x81, 0x03, 0x38, 0x6b,
+	0x3b, 0x87, 0x5c, 0x14, 0x8a, 0x1a, 0x49, 0xb4, 0x28, 0x2e, 0xbd, 0xbb, 0xb4, 0xe5, 0x67, 0xc8,
+	0x3b, 0xe4, 0x9c, 0x73, 0x4e, 0x39, 0xe5, 0x9e, 0xd7, 0xc8, 0x3b, 0xe4, 0x1e, 0xec, 0x72, 0x37,
+	0x92, 0x13, 0x28, 0xf0, 0x41, 0xc0, 0x7e, 0xb3, 0xf3, 0x7d, 0x33, 0xda, 0x6f, 0x86, 0xf0, 0x6c,
+	0x1c, 0xcb, 0x49, 0x3e, 0xf0, 0x23, 0x36, 0x0b, 0x04, 0x4b, 0xd8, 0xbf, 0x31, 0x0b, 0xc6, 0x09,
+	0x63, 0x41, 0xc6, 0xd9, 0x05, 0x46, 0x52, 0x14, 0x28, 0xcc, 0xe2, 0xe0, 0xea, 0xbf, 0x00, 0x53,
+	0x89, 0x3c, 0xe3, 0xb1, 0xc0, 0x80, 0x65, 0x32, 0x66, 0xa9, 0x08, 0x2e, 0xae, 0xa5, 0xfa, 0xf9,
+	0x19, 0x67, 0x92, 0x11, 0x57, 0x1d, 0xcd, 0x95, 0xaf, 0x98, 0xbe, 0x12, 0xf5, 0x63, 0xd6, 0xde,
+	0xd2, 0xea, 0xd3, 0x58, 0x5a, 0x2d, 0x8e, 0xa3, 0x82, 0xd4, 0xde, 0x1c, 0xb3, 0x31, 0xd3, 0xc7,
+	0x40, 0x9d, 0x4c, 0x94, 0xe0, 0x5c, 0x16, 0x41, 0x9c, 0x1b, 0xf9, 0xf6, 0xce, 0x98, 0xb1, 0x71,
+	0x82, 0x81, 0x46, 0x83, 0x7c, 0x14, 0x0c, 0x73, 0x1e, 0xaa, 0x62, 0xc5, 0xbd, 0xf7, 0xd1, 0x81,
+	0xd6, 0x8b, 0x09, 0x13, 0xf2, 0x70, 0x2e, 0x31, 0x15, 0x31, 0x4b, 0xc9, 0x39, 0xd4, 0x33, 0xce,
+	0xae, 0xe2, 0x21, 0x72, 0xe1, 0x56, 0x3a, 0xe5, 0x6e, 0xa3, 0x77, 0xcf, 0x5f, 0xd5, 0xa5, 0x7f,
+	0x9b, 0xec, 0x9f, 0x58, 0xe6, 0x61, 0x2a, 0xf9, 0x0d, 0x5d, 0x28, 0xb5, 0x5f, 0x41, 0xeb, 0xf6,
+	0x25, 0xf9, 0x05, 0xca, 0x53, 0xbc, 0x71, 0x9d, 0x8e, 0xd3, 0xad, 0x53, 0x75, 0x24, 0xbb, 0x50,
+	0xbd, 0x0a, 0x93, 0x1c, 0xdd, 0x52, 0xc7, 0xe9, 0x36, 0x7a, 0xde, 0xea, 0xb2, 0x56, 0x8a, 0x16,
+	0x84, 0xfb, 0xa5, 0x5d, 0xc7, 0x3b, 0x80, 0x16, 0x65, 0xb9, 0xc4, 0xc5, 0x5f, 0x71, 0x61, 0x6d,
+	0x18, 0x8b, 0x70, 0x90, 0xa0, 0xae, 0xb2, 0x4e, 0x2d, 0x54, 0x37, 0x1c, 0x2f, 0xf3, 0x98, 0x17,
+	0xb5, 0xea, 0xd4, 0x42, 0xef, 0x5d, 0x09, 0xd6, 0xad, 0x3a, 0xe9, 0x41, 0xe5, 0xe2, 0x7a, 0x2a,
+	0x34, 0xbb, 0xd1, 0xdb, 0x59, 0xdd, 0xcf, 0xd3, 0xeb, 0xa9, 0xa0, 0x3a, 0x97, 0xfc, 0x0e, 0xf5,
+	0x30, 0x1f, 0xc6, 0x98, 0x46, 0x28, 0xdc, 0x52, 0xa7, 0xdc, 0xad, 0xd3, 0x45, 0x80, 0xfc, 0x06,
+	0xb5, 0x58, 0x88, 0x1c, 0xb9, 0x5b, 0xd6, 0x75, 0x0d, 0x22, 0x47, 0xd0, 0x94, 0x6c, 0x8a, 0x69,
+	0x5f, 0xb0, 0x9c, 0x47, 0xe8, 0x56, 0x74, 0xc5, 0xbf, 0x57, 0x57, 0x3c, 0x53, 0xd9, 0xa7, 0x3a,
+	0x99, 0x36, 0xe4, 0x02, 0x90, 0x6d, 0x80, 0x29, 0x62, 0xd6, 0xd7, 0x31, 0xb7, 0xaa, 0xff, 0x77,
+	0x5d, 0x45, 0x34, 0x83, 0x9c, 0xc2, 0xaf, 0x51, 0x12, 0xc6, 0x33, 0xd1, 0x97, 0xac, 0x3f, 0xc1,
+	0x50, 0xdb, 0x5c, 0xd3, 0x36, 0xff, 0xb3, 0xba, 0xda, 0x63, 0x45, 0x39, 0x63, 0x47, 0x3a, 0x9f,
+	0xfe, 0x5c, 0x28, 0x58, 0x2c, 0xbc, 0xd7, 0x0e, 0x54, 0xd4, 0x13, 0x90, 0x3d, 0xa8, 0x71, 0x9c,
+	0x31, 0x89, 0xe6, 0xc9, 0xfe, 0x5a, 0x2d, 0x49, 0x75, 0x9e, 0x62, 0x1d, 0xfd, 0x44, 0x0d, 0x8b,
+	0x3c, 0x80, 0x6a, 0xc2, 0xa2, 0x30, 0x31, 0x13, 0xf0, 0xe7, 0x6a, 0xfa, 0xb1, 0x4a, 0x33, 0xec,
+	0x82, 0xb3, 0x5f, 0x2b, 0xdc, 0xf2, 0xde, 0x38, 0x00, 0x0b, 0x75, 0x35, 0x67, 0x39, 0x4f, 0xec,
+	0x9c, 0xe5, 0x3c, 0x21, 0x0f, 0xa1, 0x99, 0x67, 0x42, 0x72, 0x0c, 0x67, 0x7d, 0x8e, 0x23, 0x53,
+	0x6c, 0xcb, 0x8f, 0x18, 0xc7, 0xa5, 0xfe, 0x0a, 0x2b, 0x28, 0x8e, 0x68, 0xc3, 0xa6, 0x53, 0x1c,
+	0x91, 0x47, 0xd0, 0x8a, 0xc2, 0x68, 0x82, 0x7d, 0xbb, 0x4b, 0xc6, 0xac, 0x2d, 0xbf, 0x58, 0x36,
+	0xdf, 0x2e, 0x9b, 0x7f, 0x60, 0x12, 0xe8, 0x86, 0x26, 0x58, 0xe8, 0x6d, 0x43, 0xfd, 0x6b, 0xfb,
+	0xdf, 0xaf, 0x81, 0xf7, 0xc1, 0x81, 0xc6, 0x92, 0xbd, 0xe4, 0x18, 0xd6, 0xac, 0x51, 0x8e, 0x36,
+	0xaa, 0x77, 0xa7, 0xb1, 0xf0, 0x0b, 0x77, 0x0a, 0x40, 0xad, 0x04, 0xf9, 0x03, 0x9a, 0x97, 0x39,
+	0xf2, 0x9b, 0x7e, 0x16, 0xf2, 0x70, 0x66, 0x47, 0xb4, 0xa1, 0x63, 0x27, 0x3a, 0xd4, 0xde, 0x83,
+	0xe6, 0x32, 0x57, 0x0d, 0x6d, 0xc1, 0x36, 0x5d, 0x1a, 0xa4, 0xe2, 0x19, 0xc7, 0x51, 0x3c, 0x37,
+	0x4b, 0x64, 0x90, 0x77, 0x0e, 0x1b, 0xb7, 0x06, 0x86, 0x6c, 0x42, 0x55, 0x8f, 0x8c, 0xe1, 0x17,
+	0x60, 0x49, 0xb6, 0xf4, 0xad, 0x6c, 0x98, 0x65, 0x98, 0x0e, 0xf5, 0xc3, 0xae, 0x53, 0x83, 0xf6,
+	0x9f, 0xbf, 0xff, 0x5c, 0x71, 0xde, 0x7e, 0xda, 0x71, 0x5e, 0x3e, 0xb9, 0xdb, 0x47, 0x38, 0x9b,
+	0x8e, 0x7f, 0xfc, 0x21, 0x1e, 0xd4, 0xb4, 0x57, 0xff, 0x7f, 0x19, 0x00, 0x8a, 0x1b, 0x41, 0x7e,
+	0xd6, 0x05, 0x00, 0x00,
 }
 
 func (this *VhostExtension) Equal(that interface{}) bool {
@@ -640,6 +649,9 @@ func (this *RouteExtension) Equal(that interface{}) bool {
 	if this.Disable != that1.Disable {
 		return false
 	}
+	if this.Require != that1.Require {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
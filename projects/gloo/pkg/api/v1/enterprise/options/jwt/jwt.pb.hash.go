@@ -92,6 +92,10 @@ func (m *RouteExtension) Hash(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	if _, err = hasher.Write([]byte(m.GetRequire())); err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
 
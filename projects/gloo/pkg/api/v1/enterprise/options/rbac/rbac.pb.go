@@ -6,11 +6,11 @@ package rbac
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -163,11 +163,17 @@ func (m *Policy) GetPermissions() *Permissions {
 }
 
 // An RBAC principal - the identity entity (usually a user or a service account).
+// If more than one field is added, all of them need to match. An empty principal matches everyone.
 type Principal struct {
-	JwtPrincipal         *JWTPrincipal `protobuf:"bytes,1,opt,name=jwt_principal,json=jwtPrincipal,proto3" json:"jwt_principal,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	JwtPrincipal *JWTPrincipal `protobuf:"bytes,1,opt,name=jwt_principal,json=jwtPrincipal,proto3" json:"jwt_principal,omitempty"`
+	// The downstream address must be in one of these CIDR ranges, for example "10.0.0.0/8".
+	// A single IP address matches only that address.
+	SourceIps []string `protobuf:"bytes,2,rep,name=source_ips,json=sourceIps,proto3" json:"source_ips,omitempty"`
+	// The request must have all of these headers. Not supported by network RBAC.
+	Headers              []*matchers.HeaderMatcher `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *Principal) Reset()         { *m = Principal{} }
@@ -201,6 +207,20 @@ func (m *Principal) GetJwtPrincipal() *JWTPrincipal {
 	return nil
 }
 
+func (m *Principal) GetSourceIps() []string {
+	if m != nil {
+		return m.SourceIps
+	}
+	return nil
+}
+
+func (m *Principal) GetHeaders() []*matchers.HeaderMatcher {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
 // A JWT principal. To use this, JWT option MUST be enabled.
 type JWTPrincipal struct {
 	// Set of claims that make up this principal. Commonly, the 'iss' and 'sub' or 'email' claims are used.
@@ -258,7 +278,11 @@ type Permissions struct {
 	// Paths that have this prefix will be allowed.
 	PathPrefix string `protobuf:"bytes,1,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
 	// What http methods (GET, POST, ...) are allowed.
-	Methods              []string `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	Methods []string `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	// The request must have all of these headers. Not supported by network RBAC.
+	Headers []*matchers.HeaderMatcher `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	// The SNI of the downstream connection must be one of these server names.
+	ServerNames          []string `protobuf:"bytes,4,rep,name=server_names,json=serverNames,proto3" json:"server_names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -302,6 +326,20 @@ func (m *Permissions) GetMethods() []string {
 	return nil
 }
 
+func (m *Permissions) GetHeaders() []*matchers.HeaderMatcher {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *Permissions) GetServerNames() []string {
+	if m != nil {
+		return m.ServerNames
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Settings)(nil), "rbac.options.gloo.solo.io.Settings")
 	proto.RegisterType((*ExtensionSettings)(nil), "rbac.options.gloo.solo.io.ExtensionSettings")
@@ -318,38 +356,44 @@ func init() {
 }
 
 var fileDescriptor_b3e839952ea61f0e = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xd5, 0xb6, 0x10, 0xe2, 0x71, 0x2a, 0xc1, 0xaa, 0x07, 0xe3, 0x03, 0xa4, 0x16, 0x82, 0x5c,
-	0x6a, 0x8b, 0xf4, 0x00, 0xf4, 0x08, 0x54, 0x8a, 0x00, 0x89, 0x6a, 0x41, 0x20, 0x38, 0x10, 0xd9,
-	0xce, 0xe2, 0x6c, 0xea, 0x78, 0x96, 0xdd, 0x4d, 0x9a, 0xfc, 0x09, 0x9f, 0xc0, 0x99, 0x13, 0xdf,
-	0x83, 0xc4, 0x27, 0x70, 0x47, 0x5e, 0x3b, 0xae, 0x11, 0x10, 0x7a, 0xb1, 0x66, 0xde, 0xcc, 0x7b,
-	0x33, 0xe3, 0xd9, 0x81, 0x97, 0x99, 0x30, 0xd3, 0x45, 0x12, 0xa6, 0x38, 0x8f, 0x34, 0xe6, 0x78,
-	0x28, 0x30, 0xca, 0x72, 0xc4, 0x48, 0x2a, 0x9c, 0xf1, 0xd4, 0xe8, 0xca, 0x8b, 0xa5, 0x88, 0x96,
-	0xf7, 0x23, 0x5e, 0x18, 0xae, 0xa4, 0x12, 0x9a, 0x47, 0x28, 0x8d, 0xc0, 0x42, 0x47, 0x2a, 0x89,
-	0x53, 0xfb, 0x09, 0xa5, 0x42, 0x83, 0xf4, 0xa6, 0xb5, 0xeb, 0x68, 0x58, 0x92, 0xc3, 0x52, 0x37,
-	0x14, 0xe8, 0xef, 0x67, 0x98, 0xa1, 0xcd, 0x8a, 0x4a, 0xab, 0x22, 0xf8, 0x94, 0xaf, 0x4c, 0x05,
-	0xf2, 0x95, 0xa9, 0xb0, 0xe0, 0x10, 0xba, 0xaf, 0xb8, 0x31, 0xa2, 0xc8, 0x34, 0x3d, 0x80, 0x9e,
-	0xe2, 0x9f, 0x16, 0x42, 0xf1, 0x71, 0x29, 0xed, 0x91, 0x3e, 0x19, 0x74, 0x99, 0x5b, 0x63, 0x2c,
-	0x89, 0xd3, 0xe0, 0x07, 0x81, 0x1b, 0x27, 0x2b, 0xc3, 0x0b, 0x2d, 0xb0, 0x68, 0x88, 0x1e, 0x5c,
-	0x9b, 0x08, 0x1d, 0x27, 0x39, 0xaf, 0x39, 0x1b, 0x97, 0xbe, 0x81, 0xae, 0xc4, 0x5c, 0xa4, 0x82,
-	0x6b, 0x6f, 0xa7, 0xbf, 0x3b, 0x70, 0x87, 0xc7, 0xe1, 0x3f, 0xdb, 0x0e, 0xff, 0x50, 0x0e, 0x4f,
-	0x6b, 0xf2, 0x49, 0x61, 0xd4, 0x9a, 0x35, 0x5a, 0xfe, 0x07, 0xd8, 0xfb, 0x2d, 0x44, 0xaf, 0xc3,
-	0xee, 0x19, 0x5f, 0xdb, 0xf2, 0x0e, 0x2b, 0x4d, 0xfa, 0x00, 0xae, 0x2e, 0xe3, 0x7c, 0xc1, 0xbd,
-	0x9d, 0x3e, 0x19, 0xb8, 0xc3, 0x83, 0x2d, 0x75, 0xad, 0xd4, 0x9a, 0x55, 0xf9, 0xc7, 0x3b, 0x0f,
-	0x49, 0xf0, 0x99, 0x40, 0xa7, 0x42, 0xe9, 0x53, 0x00, 0xa9, 0x44, 0x91, 0x0a, 0x19, 0xe7, 0xda,
-	0x23, 0x76, 0x88, 0x3b, 0xdb, 0xc4, 0x36, 0xc9, 0xac, 0xc5, 0xa3, 0x23, 0x70, 0x25, 0x57, 0x73,
-	0xa1, 0xcb, 0xf1, 0x74, 0xdd, 0xd3, 0xdd, 0x6d, 0x32, 0x17, 0xd9, 0xac, 0x4d, 0x0d, 0xde, 0x81,
-	0xd3, 0x94, 0xa0, 0x2f, 0x60, 0x6f, 0x76, 0x6e, 0xc6, 0x4d, 0x21, 0xfb, 0x03, 0xdc, 0xe1, 0xbd,
-	0x2d, 0xc2, 0xcf, 0xde, 0xbe, 0xbe, 0x68, 0xb1, 0x37, 0x3b, 0x37, 0x8d, 0x17, 0x7c, 0x25, 0xd0,
-	0x6b, 0x87, 0xe9, 0x73, 0xe8, 0xa4, 0x79, 0x2c, 0xe6, 0x9b, 0xb9, 0x8f, 0x2e, 0xa9, 0x1b, 0x3e,
-	0xb1, 0xac, 0x6a, 0x6b, 0xb5, 0x04, 0xf5, 0xa1, 0x2b, 0x15, 0x2e, 0xc5, 0x84, 0x2b, 0x3b, 0xbf,
-	0xc3, 0x1a, 0xdf, 0x7f, 0x04, 0x6e, 0x8b, 0xf2, 0x97, 0x6d, 0xee, 0xb7, 0xb7, 0xe9, 0xb4, 0x57,
-	0x35, 0x02, 0xb7, 0xf5, 0xaf, 0xe8, 0x6d, 0x70, 0x65, 0x6c, 0xa6, 0x63, 0xa9, 0xf8, 0x47, 0xb1,
-	0xaa, 0x25, 0xa0, 0x84, 0x4e, 0x2d, 0x52, 0x3e, 0xd6, 0x39, 0x37, 0x53, 0x9c, 0x54, 0x2f, 0xd2,
-	0x61, 0x1b, 0xf7, 0x31, 0xfb, 0xf6, 0xf3, 0x0a, 0xf9, 0xf2, 0xfd, 0x16, 0x79, 0x3f, 0xba, 0xdc,
-	0xad, 0xca, 0xb3, 0xec, 0x3f, 0xf7, 0x9a, 0x74, 0xec, 0x99, 0x1d, 0xfd, 0x0a, 0x00, 0x00, 0xff,
-	0xff, 0xe6, 0x4e, 0x82, 0xa2, 0xfe, 0x03, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xd6, 0xb6, 0x7d, 0xdb, 0x78, 0x9c, 0x4a, 0x2f, 0xab, 0x1e, 0x4c, 0x24, 0x20, 0x8d, 0x10,
+	0x84, 0x43, 0x6d, 0x91, 0x4a, 0x7c, 0xf4, 0x48, 0xa9, 0x14, 0xbe, 0x2b, 0x83, 0x40, 0xe2, 0x40,
+	0xe4, 0x38, 0x83, 0xb3, 0xa9, 0xed, 0x5d, 0x76, 0x37, 0x69, 0xf2, 0x4f, 0xf8, 0x09, 0x1c, 0x11,
+	0x27, 0x2e, 0xfc, 0x19, 0x24, 0x7e, 0x02, 0x77, 0xe4, 0xf5, 0x47, 0x5c, 0x41, 0x43, 0x0f, 0x5c,
+	0xac, 0x99, 0xc7, 0xf3, 0x3c, 0x33, 0x3b, 0x3b, 0x3b, 0xf0, 0x22, 0x62, 0x7a, 0x3c, 0x1d, 0xba,
+	0x21, 0x4f, 0x3c, 0xc5, 0x63, 0xbe, 0xc7, 0xb8, 0x17, 0xc5, 0x9c, 0x7b, 0x42, 0xf2, 0x09, 0x86,
+	0x5a, 0xe5, 0x5e, 0x20, 0x98, 0x37, 0xbb, 0xed, 0x61, 0xaa, 0x51, 0x0a, 0xc9, 0x14, 0x7a, 0x5c,
+	0x68, 0xc6, 0x53, 0xe5, 0xc9, 0x61, 0x10, 0x9a, 0x8f, 0x2b, 0x24, 0xd7, 0x9c, 0x5e, 0x36, 0x76,
+	0xf1, 0xd7, 0xcd, 0xc8, 0x6e, 0xa6, 0xeb, 0x32, 0xde, 0xda, 0x89, 0x78, 0xc4, 0x4d, 0x94, 0x97,
+	0x59, 0x39, 0xa1, 0x45, 0x71, 0xae, 0x73, 0x10, 0xe7, 0xba, 0xc0, 0xee, 0x9c, 0x5f, 0x42, 0xc8,
+	0x25, 0x7a, 0x49, 0xa0, 0xc3, 0x31, 0x4a, 0x55, 0x19, 0x39, 0xaf, 0xb3, 0x07, 0x8d, 0x97, 0xa8,
+	0x35, 0x4b, 0x23, 0x45, 0x77, 0xa1, 0x29, 0xf1, 0xc3, 0x94, 0x49, 0x1c, 0x64, 0x25, 0x39, 0xa4,
+	0x4d, 0xba, 0x0d, 0xdf, 0x2e, 0x30, 0x7f, 0x18, 0x84, 0x9d, 0x1f, 0x04, 0x2e, 0x1d, 0xcd, 0x35,
+	0xa6, 0x8a, 0xf1, 0xb4, 0x22, 0x3a, 0xb0, 0x35, 0x62, 0x2a, 0x18, 0xc6, 0x58, 0x70, 0x4a, 0x97,
+	0xbe, 0x86, 0x86, 0xe0, 0x31, 0x0b, 0x19, 0x2a, 0x67, 0xad, 0xbd, 0xde, 0xb5, 0x7b, 0x07, 0xee,
+	0xb9, 0xc7, 0x75, 0x7f, 0x53, 0x76, 0x8f, 0x0b, 0xf2, 0x51, 0xaa, 0xe5, 0xc2, 0xaf, 0xb4, 0x5a,
+	0xef, 0x60, 0xfb, 0xcc, 0x2f, 0xfa, 0x3f, 0xac, 0x9f, 0xe0, 0xc2, 0xa4, 0xb7, 0xfc, 0xcc, 0xa4,
+	0x77, 0xe1, 0xbf, 0x59, 0x10, 0x4f, 0xd1, 0x59, 0x6b, 0x93, 0xae, 0xdd, 0xdb, 0x5d, 0x91, 0xd7,
+	0x48, 0x2d, 0xfc, 0x3c, 0xfe, 0x60, 0xed, 0x1e, 0xe9, 0x7c, 0x24, 0xb0, 0x99, 0xa3, 0xf4, 0x21,
+	0x80, 0x90, 0x2c, 0x0d, 0x99, 0x08, 0x62, 0xe5, 0x10, 0x73, 0x88, 0xeb, 0xab, 0xc4, 0xca, 0x60,
+	0xbf, 0xc6, 0xa3, 0x7d, 0xb0, 0x05, 0xca, 0x84, 0xa9, 0xec, 0x78, 0xaa, 0xa8, 0xe9, 0xc6, 0x2a,
+	0x99, 0x65, 0xb4, 0x5f, 0xa7, 0x76, 0xbe, 0x11, 0xb0, 0xaa, 0x1c, 0xf4, 0x29, 0x6c, 0x4f, 0x4e,
+	0xf5, 0xa0, 0xca, 0x64, 0x3a, 0x60, 0xf7, 0x6e, 0xae, 0x50, 0x7e, 0xfc, 0xe6, 0xd5, 0xb2, 0xc6,
+	0xe6, 0xe4, 0x54, 0x2f, 0xd5, 0xae, 0x00, 0x28, 0x3e, 0x95, 0x21, 0x0e, 0x98, 0xc8, 0x2f, 0xcc,
+	0xf2, 0xad, 0x1c, 0x79, 0x24, 0x14, 0x3d, 0x84, 0xad, 0x31, 0x06, 0x23, 0x94, 0xca, 0x59, 0x37,
+	0x7d, 0xb8, 0xe5, 0x56, 0xe3, 0x94, 0x4d, 0xd9, 0xd9, 0x3c, 0x7d, 0x13, 0xfa, 0x2c, 0x0f, 0xf0,
+	0x4b, 0x66, 0xe7, 0x0b, 0x81, 0x66, 0xbd, 0x04, 0xfa, 0x04, 0x36, 0xc3, 0x38, 0x60, 0x49, 0xd9,
+	0xdc, 0xfd, 0x0b, 0xd6, 0xee, 0x1e, 0x1a, 0x56, 0x3e, 0x1a, 0x85, 0x04, 0x6d, 0x41, 0x43, 0x48,
+	0x3e, 0x63, 0x23, 0x94, 0xa6, 0xc9, 0x96, 0x5f, 0xf9, 0xad, 0xfb, 0x60, 0xd7, 0x28, 0x7f, 0x18,
+	0x99, 0x9d, 0xfa, 0xc8, 0x58, 0xf5, 0x79, 0xf8, 0x4c, 0xc0, 0xae, 0xdd, 0x08, 0xbd, 0x06, 0xb6,
+	0x08, 0xf4, 0x78, 0x20, 0x24, 0xbe, 0x67, 0xf3, 0x42, 0x03, 0x32, 0xe8, 0xd8, 0x20, 0xd9, 0x93,
+	0x48, 0x50, 0x8f, 0xf9, 0xa8, 0x6c, 0x63, 0xe9, 0xfe, 0x93, 0x26, 0x66, 0x4f, 0x55, 0xa1, 0x9c,
+	0xa1, 0x1c, 0xa4, 0x41, 0x82, 0xca, 0xd9, 0x30, 0x39, 0xec, 0x1c, 0x7b, 0x9e, 0x41, 0x0f, 0xfc,
+	0xaf, 0x3f, 0x37, 0xc8, 0xa7, 0xef, 0x57, 0xc9, 0xdb, 0xfe, 0xc5, 0x36, 0x96, 0x38, 0x89, 0xfe,
+	0xb2, 0xb5, 0x86, 0x9b, 0x66, 0x69, 0xec, 0xff, 0x1a, 0x00, 0x01, 0x23, 0xe8, 0x15, 0x04, 0x05,
+	0x00, 0x00,
 }
 
 func (this *Settings) Equal(that interface{}) bool {
@@ -471,6 +515,22 @@ func (this *Principal) Equal(that interface{}) bool {
 	if !this.JwtPrincipal.Equal(that1.JwtPrincipal) {
 		return false
 	}
+	if len(this.SourceIps) != len(that1.SourceIps) {
+		return false
+	}
+	for i := range this.SourceIps {
+		if this.SourceIps[i] != that1.SourceIps[i] {
+			return false
+		}
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if !this.Headers[i].Equal(that1.Headers[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -541,6 +601,22 @@ func (this *Permissions) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if !this.Headers[i].Equal(that1.Headers[i]) {
+			return false
+		}
+	}
+	if len(this.ServerNames) != len(that1.ServerNames) {
+		return false
+	}
+	for i := range this.ServerNames {
+		if this.ServerNames[i] != that1.ServerNames[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
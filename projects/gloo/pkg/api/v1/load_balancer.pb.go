@@ -6,14 +6,13 @@ package v1
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-	time "time"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
-	_ "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
+	lbhash "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	math "math"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -45,10 +44,14 @@ type LoadBalancerConfig struct {
 	//	*LoadBalancerConfig_Random_
 	//	*LoadBalancerConfig_RingHash_
 	//	*LoadBalancerConfig_Maglev_
-	Type                 isLoadBalancerConfig_Type `protobuf_oneof:"type"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	Type isLoadBalancerConfig_Type `protobuf_oneof:"type"`
+	// Send the requests of a client to the same endpoint of the upstream, using a generated cookie. The routes to the
+	// upstream that do not define a hash policy use the cookie of the sticky session. If no load balancer type is set,
+	// the load balancer of the sticky session is used, otherwise it must be ring hash or maglev.
+	StickySession        *lbhash.StickySession `protobuf:"bytes,8,opt,name=sticky_session,json=stickySession,proto3" json:"sticky_session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *LoadBalancerConfig) Reset()         { *m = LoadBalancerConfig{} }
@@ -158,6 +161,13 @@ func (m *LoadBalancerConfig) GetMaglev() *LoadBalancerConfig_Maglev {
 	return nil
 }
 
+func (m *LoadBalancerConfig) GetStickySession() *lbhash.StickySession {
+	if m != nil {
+		return m.StickySession
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*LoadBalancerConfig) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -404,43 +414,45 @@ func init() {
 }
 
 var fileDescriptor_aaa1c019b03e4b0f = []byte{
-	// 570 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcb, 0x6e, 0xd4, 0x3c,
-	0x14, 0xc7, 0x67, 0xfa, 0xcd, 0x17, 0x8a, 0x3b, 0x2d, 0x34, 0x80, 0x08, 0x11, 0x2a, 0x97, 0x0d,
-	0x37, 0x35, 0xa1, 0x20, 0x36, 0xac, 0x60, 0xca, 0x22, 0x8b, 0x16, 0x90, 0xa9, 0x40, 0xb0, 0x89,
-	0x9c, 0xc4, 0x75, 0x0c, 0x8e, 0x4f, 0x70, 0x9c, 0xde, 0x9e, 0x84, 0x47, 0xe0, 0x11, 0x78, 0x9b,
-	0x4a, 0xbc, 0x03, 0x7b, 0xe4, 0xcb, 0x94, 0x42, 0x55, 0xcd, 0xac, 0xc6, 0xc7, 0xe7, 0xfc, 0xfe,
-	0xe7, 0x32, 0x27, 0x46, 0x2f, 0x18, 0xd7, 0x75, 0x5f, 0x24, 0x25, 0x34, 0x69, 0x07, 0x02, 0xd6,
-	0x39, 0xa4, 0x4c, 0x00, 0xa4, 0xad, 0x82, 0xcf, 0xb4, 0xd4, 0x9d, 0xb3, 0x48, 0xcb, 0xd3, 0xbd,
-	0x8d, 0x54, 0x00, 0xa9, 0xf2, 0x82, 0x08, 0x22, 0x4b, 0xaa, 0x92, 0x56, 0x81, 0x86, 0x70, 0x6c,
-	0x02, 0x12, 0xc3, 0x26, 0x1c, 0xe2, 0x67, 0xe7, 0xc3, 0xd0, 0x6a, 0x0e, 0xb2, 0x4b, 0x45, 0x51,
-	0x93, 0xae, 0xf6, 0x3f, 0x4e, 0x24, 0xbe, 0xca, 0x80, 0x81, 0x3d, 0xa6, 0xe6, 0xe4, 0x6f, 0xd7,
-	0x18, 0x00, 0x13, 0x34, 0xb5, 0x56, 0xd1, 0xef, 0xa6, 0x55, 0xaf, 0x88, 0x11, 0x39, 0xcf, 0xbf,
-	0xaf, 0x48, 0xdb, 0x52, 0xd5, 0x79, 0x7f, 0x48, 0x0f, 0xb4, 0x13, 0xa5, 0x07, 0xda, 0xdd, 0xdd,
-	0x3d, 0x0e, 0x50, 0xb8, 0x05, 0xa4, 0x9a, 0xf8, 0x2e, 0x36, 0x41, 0xee, 0x72, 0x16, 0xee, 0xa0,
-	0xeb, 0x35, 0x25, 0x42, 0xd7, 0x87, 0x79, 0x4b, 0x24, 0x2f, 0x73, 0x5d, 0x2b, 0xda, 0xd5, 0x20,
-	0xaa, 0x68, 0x78, 0x7b, 0x78, 0x7f, 0xe9, 0xc9, 0xcd, 0xc4, 0x25, 0x4b, 0xa6, 0xc9, 0x92, 0x57,
-	0xd0, 0x17, 0x82, 0xbe, 0x27, 0xa2, 0xa7, 0xf8, 0x9a, 0x87, 0xdf, 0x1a, 0x76, 0x67, 0x8a, 0x86,
-	0x6f, 0xd0, 0x95, 0xbe, 0xad, 0x88, 0xa6, 0x79, 0x43, 0x15, 0xa3, 0xf9, 0x3e, 0x97, 0x15, 0xec,
-	0x47, 0x0b, 0x56, 0xf1, 0xc6, 0x59, 0x45, 0xdf, 0xde, 0x64, 0xf4, 0xed, 0xf8, 0xd6, 0x10, 0xaf,
-	0x3a, 0x76, 0xdb, 0xa0, 0x1f, 0x2c, 0x19, 0xbe, 0x46, 0x4b, 0x0a, 0x7a, 0x59, 0xe5, 0x0a, 0x0a,
-	0x2e, 0xa3, 0xff, 0xac, 0xd0, 0xa3, 0xe4, 0xf4, 0x5f, 0x90, 0x9c, 0xed, 0x2e, 0xc1, 0x86, 0xc1,
-	0x06, 0xc9, 0x06, 0x18, 0xa9, 0x13, 0x2b, 0xdc, 0x41, 0xcb, 0x82, 0x92, 0x4e, 0xe7, 0x8a, 0x7e,
-	0xed, 0x69, 0xa7, 0xa3, 0x91, 0x55, 0x5c, 0x9f, 0xa9, 0xb8, 0x65, 0x28, 0xec, 0xa0, 0x6c, 0x80,
-	0xc7, 0xe2, 0x94, 0x1d, 0xbe, 0x44, 0x81, 0x22, 0xb2, 0x82, 0x26, 0xfa, 0xdf, 0xca, 0xdd, 0x9b,
-	0x5d, 0xa0, 0x0d, 0xcf, 0x06, 0xd8, 0x83, 0x61, 0x86, 0x2e, 0x2a, 0x2e, 0x59, 0x6e, 0x76, 0x24,
-	0x0a, 0xac, 0xca, 0x83, 0xd9, 0x2a, 0x5c, 0xb2, 0x8c, 0x74, 0x75, 0x36, 0xc0, 0x8b, 0xca, 0x9f,
-	0x4d, 0x31, 0x0d, 0x61, 0x82, 0xee, 0x45, 0x17, 0xe6, 0x2c, 0x66, 0xdb, 0x86, 0x9b, 0x62, 0x1c,
-	0x18, 0x8f, 0x11, 0xfa, 0x33, 0xc1, 0x78, 0x03, 0x8d, 0x4f, 0x77, 0x1f, 0xde, 0x41, 0xe3, 0xb2,
-	0x06, 0x5e, 0xd2, 0xbc, 0x84, 0x5e, 0x6a, 0xbb, 0x2f, 0xcb, 0x78, 0xc9, 0xdd, 0x6d, 0x9a, 0xab,
-	0x78, 0x11, 0x05, 0xae, 0xc3, 0xb8, 0x46, 0x2b, 0xd3, 0x2a, 0xfd, 0xe6, 0x3d, 0x44, 0xab, 0x0d,
-	0x97, 0xbc, 0xe9, 0x9b, 0xdc, 0x76, 0xdc, 0xf1, 0x23, 0x6a, 0x35, 0x46, 0xf8, 0x92, 0x77, 0x18,
-	0xe2, 0x1d, 0x3f, 0xa2, 0x36, 0x96, 0x1c, 0xfc, 0x13, 0xbb, 0xe0, 0x63, 0x9d, 0x63, 0x1a, 0x1b,
-	0x53, 0xb4, 0x38, 0xcd, 0x14, 0x7e, 0x44, 0x97, 0x4f, 0xa6, 0x99, 0x97, 0x36, 0xaf, 0x5f, 0xeb,
-	0x74, 0xee, 0xa1, 0x3a, 0x13, 0xaf, 0xa8, 0xbf, 0x6c, 0xd3, 0x9a, 0x9b, 0xd7, 0x24, 0x40, 0x23,
-	0x7d, 0xd8, 0xd2, 0xc9, 0xf3, 0x1f, 0xbf, 0x46, 0xc3, 0xef, 0x3f, 0xd7, 0x86, 0x9f, 0x1e, 0xcf,
-	0xf7, 0xb8, 0xb4, 0x5f, 0x98, 0x7f, 0x23, 0x8a, 0xc0, 0x7e, 0x0b, 0x4f, 0x7f, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x0a, 0xc7, 0x09, 0xc0, 0x97, 0x04, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4d, 0x6f, 0xd4, 0x3c,
+	0x10, 0xc7, 0x77, 0xfb, 0xec, 0xb3, 0x5d, 0xdc, 0x6d, 0xa1, 0x01, 0x44, 0x88, 0x50, 0x79, 0xb9,
+	0x00, 0x45, 0x4d, 0x28, 0x88, 0x0b, 0x27, 0xd8, 0x72, 0xd8, 0x43, 0xcb, 0x8b, 0x5b, 0x81, 0xe0,
+	0x12, 0x39, 0x89, 0x9b, 0x98, 0x3a, 0x9e, 0x60, 0x3b, 0xed, 0xb6, 0x9f, 0x84, 0x8f, 0xc0, 0x99,
+	0x13, 0xdf, 0x06, 0x89, 0xef, 0xc0, 0x1d, 0xf9, 0x65, 0xcb, 0x96, 0xaa, 0x6a, 0x4f, 0xf1, 0xd8,
+	0xf3, 0xfb, 0xcf, 0x8c, 0x67, 0x62, 0xf4, 0xa2, 0x64, 0xba, 0x6a, 0xb3, 0x38, 0x87, 0x3a, 0x51,
+	0xc0, 0x61, 0x8d, 0x41, 0x52, 0x72, 0x80, 0xa4, 0x91, 0xf0, 0x99, 0xe6, 0x5a, 0x39, 0x8b, 0x34,
+	0x2c, 0xd9, 0x5f, 0x4f, 0x38, 0x90, 0x22, 0xcd, 0x08, 0x27, 0x22, 0xa7, 0x32, 0x6e, 0x24, 0x68,
+	0x08, 0x86, 0xc6, 0x21, 0x36, 0x6c, 0xcc, 0x20, 0x7a, 0x76, 0x36, 0x0c, 0x8d, 0x66, 0x20, 0x54,
+	0xc2, 0xb3, 0x8a, 0xa8, 0xca, 0x7f, 0x9c, 0x48, 0x74, 0xad, 0x84, 0x12, 0xec, 0x32, 0x31, 0x2b,
+	0xbf, 0xbb, 0x52, 0x02, 0x94, 0x9c, 0x26, 0xd6, 0xca, 0xda, 0xdd, 0xa4, 0x68, 0x25, 0x31, 0x22,
+	0x67, 0x9d, 0x1f, 0x48, 0xd2, 0x34, 0x54, 0x2a, 0x7f, 0x1e, 0xd0, 0x89, 0x76, 0xa2, 0x74, 0xa2,
+	0xdd, 0xde, 0xbd, 0xef, 0xf3, 0x28, 0xd8, 0x04, 0x52, 0x8c, 0x7c, 0x15, 0x1b, 0x20, 0x76, 0x59,
+	0x19, 0xec, 0xa0, 0x1b, 0x15, 0x25, 0x5c, 0x57, 0x87, 0x69, 0x43, 0x04, 0xcb, 0x53, 0x5d, 0x49,
+	0xaa, 0x2a, 0xe0, 0x45, 0xd8, 0xbd, 0xd3, 0x7d, 0xb0, 0xf0, 0xe4, 0x56, 0xec, 0x82, 0xc5, 0xd3,
+	0x60, 0xf1, 0x2b, 0x68, 0x33, 0x4e, 0xdf, 0x13, 0xde, 0x52, 0x7c, 0xdd, 0xc3, 0x6f, 0x0d, 0xbb,
+	0x33, 0x45, 0x83, 0x37, 0xe8, 0x6a, 0xdb, 0x14, 0x44, 0xd3, 0xb4, 0xa6, 0xb2, 0xa4, 0xe9, 0x01,
+	0x13, 0x05, 0x1c, 0x84, 0x73, 0x56, 0xf1, 0xe6, 0x69, 0x45, 0x5f, 0xde, 0xa8, 0xf7, 0xf5, 0xe7,
+	0xed, 0x2e, 0x5e, 0x76, 0xec, 0x96, 0x41, 0x3f, 0x58, 0x32, 0x78, 0x8d, 0x16, 0x24, 0xb4, 0xa2,
+	0x48, 0x25, 0x64, 0x4c, 0x84, 0xff, 0x59, 0xa1, 0x47, 0xf1, 0x6c, 0x0b, 0xe2, 0xd3, 0xd5, 0xc5,
+	0xd8, 0x30, 0xd8, 0x20, 0xe3, 0x0e, 0x46, 0xf2, 0xd8, 0x0a, 0x76, 0xd0, 0x22, 0xa7, 0x44, 0xe9,
+	0x54, 0xd2, 0x2f, 0x2d, 0x55, 0x3a, 0xec, 0x59, 0xc5, 0xb5, 0x73, 0x15, 0x37, 0x0d, 0x85, 0x1d,
+	0x34, 0xee, 0xe0, 0x21, 0x9f, 0xb1, 0x83, 0x97, 0xa8, 0x2f, 0x89, 0x28, 0xa0, 0x0e, 0xff, 0xb7,
+	0x72, 0xf7, 0xcf, 0x4f, 0xd0, 0xba, 0x8f, 0x3b, 0xd8, 0x83, 0xc1, 0x18, 0x5d, 0x92, 0x4c, 0x94,
+	0xa9, 0x99, 0x91, 0xb0, 0x6f, 0x55, 0x1e, 0x9e, 0xaf, 0xc2, 0x44, 0x39, 0x26, 0xaa, 0x1a, 0x77,
+	0xf0, 0x40, 0xfa, 0xb5, 0x49, 0xa6, 0x26, 0x25, 0xa7, 0xfb, 0xe1, 0xfc, 0x05, 0x93, 0xd9, 0xb2,
+	0xee, 0x26, 0x19, 0x07, 0x06, 0xef, 0xd0, 0x92, 0xd2, 0x2c, 0xdf, 0x3b, 0x4c, 0x15, 0x55, 0x8a,
+	0x81, 0x08, 0x07, 0x56, 0x6a, 0x35, 0xf6, 0x43, 0xec, 0x47, 0xfb, 0xa4, 0xf2, 0xb6, 0x45, 0xb6,
+	0x1d, 0x81, 0x17, 0xd5, 0xac, 0x19, 0x0d, 0x11, 0xfa, 0xdb, 0x94, 0x68, 0x1d, 0x0d, 0x67, 0x2f,
+	0x34, 0xb8, 0x8b, 0x86, 0x79, 0x05, 0x2c, 0xa7, 0x69, 0x0e, 0xad, 0xd0, 0x76, 0x04, 0x17, 0xf1,
+	0x82, 0xdb, 0xdb, 0x30, 0x5b, 0xd1, 0x00, 0xf5, 0xdd, 0xa5, 0x45, 0x15, 0x5a, 0x9a, 0x16, 0xee,
+	0x87, 0x79, 0x15, 0x2d, 0xd7, 0x4c, 0xb0, 0xba, 0xad, 0x53, 0x7b, 0x89, 0x8a, 0x1d, 0x51, 0xab,
+	0xd1, 0xc3, 0x97, 0xfd, 0x81, 0x21, 0xb6, 0xd9, 0x11, 0xb5, 0xbe, 0x64, 0xf2, 0x8f, 0xef, 0x9c,
+	0xf7, 0x25, 0x93, 0x59, 0xdf, 0x88, 0xa2, 0xc1, 0x34, 0x52, 0xf0, 0x11, 0x5d, 0x39, 0x6e, 0x50,
+	0x9a, 0xdb, 0xb8, 0xfe, 0x4f, 0x49, 0x2e, 0xdc, 0x27, 0x67, 0xe2, 0x25, 0x79, 0xc2, 0x36, 0xa5,
+	0xb9, 0x16, 0x8c, 0xfa, 0xa8, 0xa7, 0x0f, 0x1b, 0x3a, 0x7a, 0xfe, 0xe3, 0x77, 0xaf, 0xfb, 0xed,
+	0xd7, 0x4a, 0xf7, 0xd3, 0xe3, 0x8b, 0xbd, 0x57, 0xcd, 0x5e, 0xe9, 0x9f, 0x9d, 0xac, 0x6f, 0x7f,
+	0xaf, 0xa7, 0x7f, 0x06, 0x00, 0x03, 0xb9, 0x37, 0x1a, 0xea, 0x04, 0x00, 0x00,
 }
 
 func (this *LoadBalancerConfig) Equal(that interface{}) bool {
@@ -483,6 +495,9 @@ func (this *LoadBalancerConfig) Equal(that interface{}) bool {
 	} else if !this.Type.Equal(that1.Type) {
 		return false
 	}
+	if !this.StickySession.Equal(that1.StickySession) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -6,9 +6,6 @@ package v1
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-	time "time"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
@@ -26,6 +23,7 @@ import (
 	aws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
 	azure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
 	cors "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
+	dynamic_forward_proxy "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dynamic_forward_proxy"
 	faultinjection "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/faultinjection"
 	grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	grpc_json "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
@@ -34,6 +32,7 @@ import (
 	headers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
 	healthcheck "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/healthcheck"
 	lbhash "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
+	local_ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
 	protocol_upgrade "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	rest "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
 	retries "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
@@ -44,6 +43,8 @@ import (
 	transformation "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/transformation"
 	wasm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	math "math"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -177,9 +178,12 @@ type HttpListenerOptions struct {
 	// this option will sanitize that header from downstream traffic.
 	// Defaults to false
 	SanitizeClusterHeader *types.BoolValue `protobuf:"bytes,14,opt,name=sanitize_cluster_header,json=sanitizeClusterHeader,proto3" json:"sanitize_cluster_header,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
-	XXX_unrecognized      []byte           `json:"-"`
-	XXX_sizecache         int32            `json:"-"`
+	// Limit the rate of requests on this listener in Envoy, without an external rate limit server.
+	// Virtual hosts and routes can override this limit with their own.
+	LocalRatelimit       *local_ratelimit.LocalRateLimit `protobuf:"bytes,15,opt,name=local_ratelimit,json=localRatelimit,proto3" json:"local_ratelimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
 func (m *HttpListenerOptions) Reset()         { *m = HttpListenerOptions{} }
@@ -304,12 +308,21 @@ func (m *HttpListenerOptions) GetSanitizeClusterHeader() *types.BoolValue {
 	return nil
 }
 
+func (m *HttpListenerOptions) GetLocalRatelimit() *local_ratelimit.LocalRateLimit {
+	if m != nil {
+		return m.LocalRatelimit
+	}
+	return nil
+}
+
 // Optional, feature-specific configuration that lives on tcp listeners
 type TcpListenerOptions struct {
-	TcpProxySettings     *tcp.TcpProxySettings `protobuf:"bytes,3,opt,name=tcp_proxy_settings,json=tcpProxySettings,proto3" json:"tcp_proxy_settings,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	TcpProxySettings *tcp.TcpProxySettings `protobuf:"bytes,3,opt,name=tcp_proxy_settings,json=tcpProxySettings,proto3" json:"tcp_proxy_settings,omitempty"`
+	// Network RBAC for the connections to this listener. Policies can match the source IP of the connection and its SNI.
+	Rbac                 *rbac.ExtensionSettings `protobuf:"bytes,4,opt,name=rbac,proto3" json:"rbac,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *TcpListenerOptions) Reset()         { *m = TcpListenerOptions{} }
@@ -343,6 +356,13 @@ func (m *TcpListenerOptions) GetTcpProxySettings() *tcp.TcpProxySettings {
 	return nil
 }
 
+func (m *TcpListenerOptions) GetRbac() *rbac.ExtensionSettings {
+	if m != nil {
+		return m.Rbac
+	}
+	return nil
+}
+
 // Optional, feature-specific configuration that lives on virtual hosts.
 // Each VirtualHostPlugin object contains configuration for a specific feature.
 // Note to developers: new Virtual Host plugins must be added to this struct
@@ -407,9 +427,12 @@ type VirtualHostOptions struct {
 	// Early transformations stage. These transformations run before most other options are processed.
 	// If the `regular` field is set in here, the `transformations` field is ignored.
 	StagedTransformations *transformation.TransformationStages `protobuf:"bytes,17,opt,name=staged_transformations,json=stagedTransformations,proto3" json:"staged_transformations,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                             `json:"-"`
-	XXX_unrecognized      []byte                               `json:"-"`
-	XXX_sizecache         int32                                `json:"-"`
+	// Limit the rate of requests to this virtual host in Envoy, without an external rate limit server.
+	// This overrides the limit of the listener, and routes can override it with their own.
+	LocalRatelimit       *local_ratelimit.LocalRateLimit `protobuf:"bytes,18,opt,name=local_ratelimit,json=localRatelimit,proto3" json:"local_ratelimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
 func (m *VirtualHostOptions) Reset()         { *m = VirtualHostOptions{} }
@@ -585,6 +608,13 @@ func (m *VirtualHostOptions) GetStagedTransformations() *transformation.Transfor
 	return nil
 }
 
+func (m *VirtualHostOptions) GetLocalRatelimit() *local_ratelimit.LocalRateLimit {
+	if m != nil {
+		return m.LocalRatelimit
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*VirtualHostOptions) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -668,9 +698,16 @@ type RouteOptions struct {
 	// Early transformations stage. These transformations run before most other options are processed.
 	// If the `regular` field is set in here, the `transformations` field is ignored.
 	StagedTransformations *transformation.TransformationStages `protobuf:"bytes,23,opt,name=staged_transformations,json=stagedTransformations,proto3" json:"staged_transformations,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                             `json:"-"`
-	XXX_unrecognized      []byte                               `json:"-"`
-	XXX_sizecache         int32                                `json:"-"`
+	// Limit the rate of requests to this route in Envoy, without an external rate limit server.
+	// This overrides the limit of the virtual host and the listener.
+	LocalRatelimit *local_ratelimit.LocalRateLimit `protobuf:"bytes,24,opt,name=local_ratelimit,json=localRatelimit,proto3" json:"local_ratelimit,omitempty"`
+	// Pick the subset of the destinations of the route from a header or query parameter of the requests.
+	SubsetSteering *SubsetSteering `protobuf:"bytes,26,opt,name=subset_steering,json=subsetSteering,proto3" json:"subset_steering,omitempty"`
+	// Options for the routes to dynamic forward proxy upstreams.
+	DynamicForwardProxy  *dynamic_forward_proxy.PerRouteConfig `protobuf:"bytes,27,opt,name=dynamic_forward_proxy,json=dynamicForwardProxy,proto3" json:"dynamic_forward_proxy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
 }
 
 func (m *RouteOptions) Reset()         { *m = RouteOptions{} }
@@ -906,6 +943,27 @@ func (m *RouteOptions) GetStagedTransformations() *transformation.Transformation
 	return nil
 }
 
+func (m *RouteOptions) GetLocalRatelimit() *local_ratelimit.LocalRateLimit {
+	if m != nil {
+		return m.LocalRatelimit
+	}
+	return nil
+}
+
+func (m *RouteOptions) GetSubsetSteering() *SubsetSteering {
+	if m != nil {
+		return m.SubsetSteering
+	}
+	return nil
+}
+
+func (m *RouteOptions) GetDynamicForwardProxy() *dynamic_forward_proxy.PerRouteConfig {
+	if m != nil {
+		return m.DynamicForwardProxy
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*RouteOptions) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1138,134 +1196,143 @@ func init() {
 }
 
 var fileDescriptor_94dcee4f7557dfdc = []byte{
-	// 2029 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdb, 0x72, 0x1c, 0x47,
-	0x19, 0xd6, 0x4a, 0x6b, 0x1d, 0x5a, 0xb2, 0x24, 0xb7, 0x0f, 0x19, 0x54, 0x71, 0xb0, 0x45, 0x41,
-	0x1c, 0x43, 0x7a, 0x9d, 0x55, 0xc0, 0xb1, 0x1c, 0x2a, 0x48, 0x1b, 0xdb, 0x6b, 0xa2, 0x14, 0xaa,
-	0x91, 0x62, 0x1b, 0x28, 0x6a, 0xaa, 0x77, 0xb6, 0x77, 0xb6, 0x9d, 0xd1, 0xf4, 0xd0, 0xdd, 0xa3,
-	0x95, 0x74, 0xc5, 0x03, 0xc0, 0x3d, 0xbc, 0x01, 0x37, 0x5c, 0xc3, 0x35, 0x57, 0x5c, 0xf1, 0x0a,
-	0x54, 0xf1, 0x0e, 0xdc, 0x53, 0x7d, 0x98, 0xc3, 0xae, 0x66, 0xb5, 0xb3, 0x8a, 0x92, 0x8b, 0x99,
-	0x9d, 0x43, 0x7f, 0x5f, 0x1f, 0xff, 0xef, 0xfb, 0xa7, 0x17, 0x6c, 0x07, 0x54, 0xf6, 0x93, 0x0e,
-	0xf2, 0xd9, 0x51, 0x43, 0xb0, 0x90, 0x7d, 0x48, 0x59, 0x23, 0x08, 0x19, 0x6b, 0xc4, 0x9c, 0xbd,
-	0x25, 0xbe, 0x14, 0xe6, 0x0e, 0xc7, 0xb4, 0x71, 0xfc, 0x51, 0x83, 0xc5, 0x92, 0xb2, 0x48, 0xa0,
-	0x98, 0x33, 0xc9, 0xe0, 0x8a, 0x7a, 0x85, 0x14, 0x0a, 0x51, 0xb6, 0xf1, 0x6e, 0xc0, 0x58, 0x10,
-	0x92, 0x86, 0x7e, 0xd7, 0x49, 0x7a, 0x0d, 0x21, 0x79, 0xe2, 0x4b, 0x53, 0x76, 0xe3, 0x56, 0xc0,
-	0x02, 0xa6, 0x2f, 0x1b, 0xea, 0xca, 0x3e, 0x85, 0xe4, 0x44, 0x9a, 0x87, 0xe4, 0x24, 0x2d, 0xf9,
-	0x70, 0x7c, 0xf5, 0xe4, 0x44, 0x92, 0x48, 0xe4, 0x2d, 0xd8, 0xf8, 0x68, 0x62, 0x53, 0x1b, 0x3e,
-	0xe3, 0xe6, 0x54, 0x1d, 0xc2, 0x89, 0x90, 0xfa, 0x54, 0x1d, 0x12, 0xf0, 0xd8, 0xd7, 0x27, 0x0b,
-	0x99, 0x3c, 0x86, 0x0d, 0x1c, 0xea, 0xc3, 0x02, 0x9e, 0x54, 0xab, 0xc3, 0x1b, 0x90, 0x4e, 0x76,
-	0x61, 0xa1, 0x4f, 0x2b, 0x42, 0xdf, 0x0a, 0x16, 0xe5, 0x57, 0xd5, 0x1b, 0xda, 0xf7, 0x8f, 0xd4,
-	0x61, 0x01, 0x3f, 0x9d, 0x0c, 0x08, 0x3b, 0x7d, 0x2c, 0xfa, 0xf6, 0xa7, 0x7a, 0x23, 0x45, 0x1f,
-	0x77, 0xd9, 0x80, 0x46, 0x41, 0x7e, 0x55, 0xbd, 0x91, 0xd2, 0x8f, 0xd5, 0x61, 0x01, 0x8f, 0x2b,
-	0x00, 0x38, 0xf6, 0x55, 0x5d, 0xf6, 0xb7, 0x3a, 0x90, 0x13, 0xc9, 0x29, 0xc9, 0x7e, 0x2d, 0x70,
-	0xab, 0x42, 0xff, 0x24, 0x96, 0xf6, 0x6c, 0x41, 0x9f, 0x4e, 0x06, 0xf5, 0x70, 0x12, 0x4a, 0x1a,
-	0xa9, 0x02, 0x94, 0x45, 0xe6, 0xb6, 0x7a, 0x5b, 0xfb, 0x04, 0x77, 0x09, 0xcf, 0x7e, 0xa7, 0x58,
-	0x9c, 0x03, 0x7d, 0x54, 0x0f, 0x80, 0x01, 0x16, 0x47, 0xfa, 0x54, 0x7d, 0x3c, 0xf0, 0x59, 0xc2,
-	0x89, 0x39, 0x5b, 0xd0, 0x67, 0x95, 0x7a, 0x14, 0xca, 0xbe, 0xdf, 0x27, 0xfe, 0xd7, 0xc5, 0x6b,
-	0x4b, 0xf0, 0x72, 0x32, 0x81, 0x2e, 0xe8, 0xb3, 0xd0, 0x4b, 0xe2, 0x80, 0xe3, 0x2e, 0x39, 0xf7,
-	0xc0, 0x52, 0x1d, 0x8e, 0xa1, 0x52, 0x1a, 0xc4, 0x23, 0x1c, 0x36, 0x48, 0x74, 0xcc, 0x4e, 0x0b,
-	0x92, 0xa4, 0x56, 0x52, 0x24, 0x7a, 0x8c, 0x1f, 0x61, 0x3d, 0x55, 0xc3, 0xb7, 0x96, 0x75, 0x7f,
-	0x6a, 0xd6, 0x98, 0xb3, 0x93, 0xd3, 0x10, 0x4b, 0x12, 0xf9, 0xa7, 0x43, 0x37, 0x97, 0x6e, 0x67,
-	0x8f, 0x86, 0x52, 0x2f, 0x0a, 0x29, 0xe3, 0x46, 0x27, 0xe9, 0xf5, 0x08, 0x6f, 0x1c, 0x6f, 0xd9,
-	0x2b, 0xcb, 0xfa, 0x45, 0x35, 0x56, 0x9f, 0x45, 0x3d, 0x1a, 0x58, 0x46, 0x43, 0x18, 0x9c, 0xd1,
-	0xb8, 0x71, 0xdc, 0xd4, 0xbf, 0x96, 0xec, 0xd9, 0x05, 0x8a, 0x1e, 0x49, 0xc2, 0x63, 0x4e, 0x05,
-	0xc9, 0x26, 0x88, 0x9c, 0x48, 0x9c, 0xc8, 0xbe, 0xd5, 0x7b, 0x75, 0x69, 0x69, 0xb6, 0xa7, 0xa2,
-	0x79, 0x3b, 0x90, 0xea, 0xb0, 0xd8, 0xe7, 0x53, 0x61, 0x39, 0x96, 0x24, 0xa4, 0x47, 0x54, 0xe6,
-	0x57, 0x93, 0x23, 0xb6, 0x8c, 0xa7, 0x83, 0x7d, 0x7d, 0xba, 0x54, 0x0f, 0x06, 0xb8, 0xa7, 0x8e,
-	0x4b, 0x61, 0xbb, 0x61, 0xac, 0x8e, 0xc9, 0x13, 0x50, 0x90, 0xc3, 0x89, 0x8b, 0xf7, 0xbd, 0x51,
-	0x87, 0xef, 0x26, 0xfc, 0xc2, 0xf7, 0x03, 0x8e, 0xe3, 0x38, 0xd3, 0x9d, 0xcd, 0xbf, 0xcc, 0x82,
-	0xb5, 0x3d, 0x2a, 0x24, 0x89, 0x08, 0xff, 0x95, 0xa9, 0x17, 0x76, 0xc1, 0x1d, 0xec, 0xfb, 0x44,
-	0x08, 0x2f, 0x64, 0x41, 0x40, 0xa3, 0xc0, 0x13, 0x84, 0x1f, 0x53, 0x9f, 0x38, 0xb5, 0x7b, 0xb5,
-	0x07, 0xcb, 0x4d, 0x84, 0x94, 0x47, 0xa6, 0x79, 0x47, 0x31, 0xe1, 0x40, 0x3b, 0x1a, 0xb7, 0x67,
-	0x60, 0x07, 0x06, 0xe5, 0xde, 0xc2, 0x25, 0x4f, 0xe1, 0x27, 0x00, 0xe4, 0x01, 0xe0, 0xcc, 0x6a,
-	0x66, 0x67, 0x98, 0xed, 0x59, 0xf6, 0xde, 0x2d, 0x94, 0x85, 0x3d, 0x70, 0x3f, 0x26, 0xdc, 0xf3,
-	0x59, 0x14, 0x19, 0x09, 0xf6, 0x4c, 0x9c, 0x78, 0x7a, 0x55, 0x78, 0x9d, 0x53, 0x49, 0x84, 0x33,
-	0xa7, 0x09, 0xdf, 0x45, 0xa6, 0xff, 0x28, 0xed, 0x3f, 0xfa, 0xea, 0x65, 0x24, 0xb7, 0x9a, 0xaf,
-	0x70, 0x98, 0x10, 0xf7, 0x6e, 0x4c, 0x78, 0x2b, 0x63, 0xd9, 0xd5, 0x24, 0x7b, 0x8a, 0x63, 0x57,
-	0x51, 0x6c, 0xfe, 0x73, 0x11, 0xdc, 0x6c, 0x4b, 0x19, 0x8f, 0x8e, 0xcf, 0x0e, 0x58, 0x4c, 0xed,
-	0xde, 0x8e, 0xc8, 0x8f, 0x50, 0xe6, 0xff, 0xa5, 0xc3, 0xf2, 0x82, 0xc7, 0xfe, 0x6b, 0xd2, 0x71,
-	0x17, 0x02, 0x73, 0x01, 0xff, 0x50, 0x03, 0xf7, 0x54, 0x68, 0x16, 0x3b, 0x71, 0x84, 0x23, 0x1c,
-	0x10, 0xee, 0x09, 0x22, 0x25, 0x8d, 0x82, 0x74, 0x4c, 0x1e, 0x23, 0x65, 0xf4, 0xa5, 0xb4, 0xaa,
-	0x71, 0x79, 0xfb, 0xbf, 0x34, 0xf8, 0x03, 0x0b, 0x77, 0xef, 0xf6, 0x2f, 0x7a, 0x0d, 0xf7, 0xc1,
-	0x8a, 0x11, 0x6b, 0x4f, 0xab, 0xb5, 0x53, 0xd7, 0xb5, 0x7d, 0x88, 0x8a, 0x0a, 0x5e, 0x5e, 0xab,
-	0x2e, 0xd0, 0x52, 0x05, 0xdc, 0xe5, 0x7e, 0x7e, 0x33, 0x32, 0xa3, 0x73, 0x53, 0xcc, 0xe8, 0xc7,
-	0x60, 0x6e, 0x80, 0x7b, 0xce, 0x35, 0x0d, 0xd9, 0x44, 0x2a, 0xc2, 0x4a, 0xab, 0xce, 0xfa, 0xa6,
-	0x8a, 0xc3, 0x4f, 0xc0, 0x5c, 0x37, 0x8c, 0x9d, 0x79, 0x3b, 0x05, 0x2a, 0xb6, 0x4a, 0x51, 0xcf,
-	0xb5, 0x14, 0xb6, 0xb4, 0x2e, 0xba, 0x0a, 0x02, 0x9f, 0x82, 0xba, 0xf2, 0x45, 0x67, 0x41, 0x43,
-	0xdf, 0x47, 0xda, 0x24, 0x4b, 0xb1, 0xfb, 0x61, 0x12, 0xd0, 0xe8, 0x80, 0x25, 0xdc, 0x27, 0xae,
-	0x06, 0xc1, 0xa7, 0x60, 0xc1, 0x8a, 0xa0, 0x03, 0x34, 0xfe, 0x3e, 0xca, 0xa3, 0x7d, 0x4c, 0x7b,
-	0x53, 0x04, 0x3c, 0x00, 0xeb, 0x99, 0x7e, 0xe9, 0xb0, 0x22, 0xdc, 0x59, 0xd6, 0x2c, 0x0f, 0x50,
-	0x2e, 0x6c, 0x17, 0x77, 0x7e, 0x2d, 0x2b, 0x78, 0xa0, 0x09, 0xe0, 0x36, 0xa8, 0x2b, 0x69, 0x77,
-	0x16, 0xed, 0x48, 0x68, 0x23, 0x40, 0xc6, 0x08, 0x90, 0x31, 0x02, 0xa4, 0x16, 0x03, 0xd2, 0x06,
-	0x70, 0xdc, 0x44, 0x2f, 0xce, 0x68, 0xec, 0x6a, 0x0c, 0xfc, 0x2d, 0xb8, 0xae, 0x1d, 0xcc, 0xb3,
-	0x16, 0xe6, 0x2c, 0x69, 0x92, 0x9f, 0x8d, 0x27, 0x19, 0x32, 0xbc, 0xe3, 0x26, 0xda, 0x57, 0xf7,
-	0x7b, 0xe6, 0xde, 0x5d, 0x89, 0x0b, 0x77, 0xf0, 0x05, 0x98, 0x37, 0xa1, 0xe9, 0xac, 0x68, 0xd6,
-	0x86, 0x65, 0x2d, 0x7c, 0x34, 0x58, 0xe7, 0x33, 0xd4, 0xd6, 0xef, 0x8e, 0xb7, 0x90, 0x09, 0x46,
-	0xd7, 0xc2, 0x61, 0x17, 0xdc, 0xca, 0xb2, 0x64, 0x4f, 0x0b, 0xa1, 0xcf, 0xba, 0x84, 0x3b, 0xd7,
-	0x35, 0x6d, 0x13, 0xe5, 0x29, 0xf4, 0xd8, 0xf8, 0xfb, 0xa5, 0x60, 0xd1, 0x61, 0x86, 0x74, 0x61,
-	0x70, 0xee, 0x19, 0x74, 0xc1, 0x3b, 0x02, 0x47, 0x54, 0xd2, 0x33, 0xe2, 0xf9, 0x61, 0x22, 0x24,
-	0xe1, 0x9e, 0x49, 0xd3, 0x9c, 0x55, 0x5d, 0xd1, 0xc6, 0x39, 0x39, 0xd9, 0x65, 0x2c, 0x34, 0x62,
-	0x72, 0x3b, 0x85, 0xb6, 0x0c, 0xb2, 0xad, 0x81, 0x9b, 0x11, 0x80, 0x87, 0xfe, 0x39, 0x09, 0x79,
-	0x03, 0xa0, 0xf4, 0x63, 0xcf, 0x8c, 0x7c, 0x16, 0xf0, 0x26, 0x64, 0x1e, 0x22, 0x95, 0x34, 0x97,
-	0xf6, 0xe3, 0xd0, 0x8f, 0xf5, 0x68, 0x67, 0x4b, 0x61, 0x5d, 0x8e, 0x3c, 0xd9, 0xfc, 0xd7, 0x32,
-	0x80, 0xaf, 0x28, 0x97, 0x09, 0x0e, 0xdb, 0x4c, 0xc8, 0xb4, 0xc2, 0xe1, 0xd8, 0xac, 0x4d, 0x11,
-	0x9b, 0x2d, 0xb0, 0x60, 0xd3, 0x6a, 0x1b, 0x9f, 0x1f, 0xa0, 0x34, 0xcd, 0x2e, 0x6d, 0xa3, 0x4b,
-	0x24, 0x3f, 0xdd, 0x67, 0x21, 0xf5, 0x4f, 0xdd, 0x14, 0x09, 0x1f, 0x83, 0x6b, 0x3a, 0xc9, 0xce,
-	0x22, 0xc6, 0xa4, 0xdc, 0xe5, 0xeb, 0x5c, 0xbd, 0x72, 0x4d, 0x79, 0x88, 0xc1, 0x4d, 0x33, 0x03,
-	0x4a, 0x1e, 0x69, 0x9c, 0x84, 0xda, 0xdc, 0xac, 0x34, 0x3e, 0x42, 0x69, 0x12, 0x3d, 0x4e, 0xa8,
-	0xba, 0x84, 0x7f, 0x59, 0xc0, 0xb9, 0xb0, 0x7f, 0xee, 0x19, 0x7c, 0x02, 0xea, 0xea, 0x5b, 0xd4,
-	0x8e, 0xfe, 0x0f, 0x91, 0xfe, 0x30, 0x2d, 0x25, 0x6c, 0x31, 0x2e, 0x6c, 0xcf, 0x34, 0x04, 0x76,
-	0xc0, 0xda, 0xb0, 0x2b, 0x0b, 0x2b, 0xa3, 0x1f, 0xa3, 0x11, 0xb7, 0x2e, 0x9f, 0xce, 0x61, 0xec,
-	0xee, 0xac, 0x53, 0x73, 0x47, 0x09, 0xe1, 0xaf, 0x41, 0x1e, 0xef, 0x5e, 0x07, 0x0b, 0xea, 0x5b,
-	0xc5, 0x7b, 0x34, 0x49, 0x30, 0x5e, 0x46, 0x01, 0x27, 0x42, 0xb8, 0x58, 0x12, 0xed, 0x6a, 0xee,
-	0x6a, 0x06, 0xd8, 0x55, 0x3c, 0xf0, 0x35, 0x58, 0xca, 0x9e, 0x38, 0xcf, 0xad, 0xdb, 0x4c, 0x20,
-	0xcd, 0xd8, 0x5e, 0xf5, 0x99, 0x90, 0xd9, 0x9a, 0x69, 0xcf, 0xb8, 0x39, 0x17, 0xf4, 0x01, 0x54,
-	0x37, 0xd6, 0x90, 0x8d, 0x86, 0x08, 0xe7, 0x85, 0xae, 0x61, 0xab, 0x72, 0x0d, 0x56, 0xb1, 0x49,
-	0x4f, 0xb4, 0x67, 0x5c, 0x2d, 0x9b, 0x85, 0xc7, 0x99, 0x69, 0x2c, 0x4e, 0x67, 0x1a, 0xdb, 0x60,
-	0xee, 0xed, 0x40, 0x5a, 0x95, 0x7b, 0x80, 0x54, 0x3a, 0x5a, 0x8a, 0x1a, 0xee, 0x9e, 0xab, 0x40,
-	0xf0, 0x17, 0xa0, 0xae, 0x32, 0x47, 0x2b, 0xd8, 0x3f, 0x41, 0x3a, 0x8d, 0x2c, 0x45, 0x67, 0xc0,
-	0xac, 0x72, 0x8d, 0x54, 0xc1, 0x94, 0x7a, 0xc7, 0x8a, 0x0d, 0xa6, 0x71, 0xde, 0xf1, 0xec, 0x44,
-	0xee, 0x24, 0xb2, 0x9f, 0x37, 0x21, 0xf3, 0x90, 0xa6, 0xf1, 0x3d, 0xa3, 0x7d, 0xf7, 0xc6, 0xfb,
-	0x5e, 0xd1, 0xf1, 0x30, 0x58, 0xb7, 0x49, 0x92, 0x4a, 0x9d, 0x38, 0x4b, 0x24, 0xb1, 0x9a, 0xf6,
-	0x78, 0x4a, 0x4d, 0xde, 0x27, 0xdc, 0x55, 0x70, 0x77, 0xb5, 0x33, 0x74, 0x0f, 0x7f, 0x07, 0xee,
-	0xd2, 0xc8, 0x0f, 0x93, 0x2e, 0xf1, 0x38, 0xf9, 0x7d, 0x42, 0x84, 0xf4, 0xb0, 0x94, 0xe4, 0x28,
-	0x56, 0x2b, 0x20, 0x89, 0xa4, 0xb3, 0x36, 0x51, 0x43, 0x37, 0x2c, 0x81, 0x6b, 0xf0, 0x3b, 0x06,
-	0xde, 0x52, 0x68, 0xd8, 0x05, 0xf7, 0x53, 0xfa, 0x21, 0x5a, 0x8f, 0x46, 0x1e, 0x27, 0x22, 0x66,
-	0x91, 0x20, 0xce, 0xfa, 0xc4, 0x2a, 0xd2, 0x36, 0x16, 0xb9, 0x5f, 0x46, 0xae, 0x25, 0x80, 0x31,
-	0xb8, 0x23, 0x24, 0x0e, 0x48, 0xd7, 0x1b, 0x0d, 0xec, 0x1b, 0x9a, 0xfa, 0xc9, 0x25, 0x02, 0xfb,
-	0x40, 0x11, 0x0a, 0xf7, 0xb6, 0x21, 0x1e, 0x0d, 0x7a, 0x07, 0xdc, 0x39, 0x17, 0x2b, 0x9e, 0x3c,
-	0x8d, 0xc9, 0xe6, 0xbf, 0x57, 0xc1, 0x8a, 0x1e, 0xda, 0x54, 0xc4, 0x4b, 0xe4, 0xa6, 0x76, 0xd5,
-	0x72, 0xf3, 0x19, 0x98, 0xd7, 0x1b, 0x1a, 0x69, 0xfa, 0xf9, 0x3e, 0x32, 0xfb, 0x1b, 0xe5, 0xa1,
-	0xaa, 0x5a, 0xf7, 0x5c, 0x17, 0x77, 0x2d, 0x0c, 0xb6, 0xc0, 0x6a, 0xcc, 0x49, 0x8f, 0x9e, 0x78,
-	0x9c, 0x0c, 0x38, 0x95, 0x64, 0x6c, 0x2a, 0x7e, 0x20, 0x39, 0x8d, 0x02, 0x33, 0x2d, 0xd7, 0x0d,
-	0xc6, 0x35, 0x10, 0xf8, 0x04, 0x2c, 0x48, 0x7a, 0x44, 0x58, 0x22, 0xad, 0xa0, 0x7e, 0xef, 0x1c,
-	0xfa, 0x73, 0xfb, 0xa1, 0xb3, 0x5b, 0xff, 0xf3, 0x7f, 0xbe, 0x5f, 0x73, 0xd3, 0xf2, 0x57, 0xe3,
-	0x57, 0xc3, 0x76, 0x39, 0x3f, 0x85, 0x5d, 0xee, 0x81, 0x05, 0xbb, 0x7d, 0x65, 0xb3, 0xcb, 0x26,
-	0x4a, 0xb7, 0xb3, 0xc6, 0x0f, 0xe1, 0xa1, 0x29, 0x91, 0xa7, 0x8b, 0x16, 0x02, 0xf7, 0xc0, 0x52,
-	0xb6, 0xf1, 0x66, 0x95, 0x0e, 0xa1, 0x7c, 0x2b, 0x6e, 0x3c, 0xe3, 0x41, 0x5a, 0xc6, 0xcd, 0x09,
-	0xc6, 0x99, 0xe9, 0xd2, 0x15, 0x9a, 0xe9, 0x0f, 0xc0, 0x8a, 0x12, 0xce, 0x6c, 0xee, 0x95, 0xdf,
-	0x2f, 0xb5, 0x67, 0xdc, 0x65, 0xf5, 0x34, 0x9d, 0xdd, 0x36, 0xb8, 0x81, 0x13, 0xc9, 0xbc, 0xa1,
-	0x92, 0x37, 0x27, 0x85, 0x6e, 0x7b, 0xc6, 0x5d, 0x53, 0xb0, 0x76, 0x81, 0x29, 0xf5, 0xee, 0xe5,
-	0xe9, 0xbd, 0xfb, 0x0b, 0xb0, 0x10, 0x76, 0xbc, 0x3e, 0x16, 0xa9, 0x14, 0x37, 0x91, 0xdd, 0x1d,
-	0x1d, 0x3f, 0xaa, 0x3b, 0xfa, 0x4b, 0xaa, 0x8d, 0x45, 0xdf, 0x6a, 0xeb, 0x7c, 0xd8, 0x51, 0x77,
-	0xf0, 0x0d, 0x58, 0xb4, 0x5b, 0x55, 0xc2, 0xb9, 0x7d, 0x6f, 0xee, 0xc1, 0x72, 0xf3, 0x53, 0x74,
-	0x6e, 0x13, 0xab, 0xfc, 0x03, 0xc3, 0x96, 0xfa, 0xca, 0x14, 0xb2, 0xbc, 0x19, 0x5b, 0x99, 0xfd,
-	0x5f, 0xbf, 0x22, 0xfb, 0x7f, 0x53, 0xb4, 0xff, 0x3f, 0xd6, 0xa6, 0xf4, 0x7f, 0x3d, 0x20, 0xb9,
-	0xff, 0xd7, 0x8a, 0xfe, 0xdf, 0x2d, 0xf5, 0xff, 0x3f, 0xd5, 0x2e, 0x9f, 0x00, 0xd4, 0xc6, 0x27,
-	0x00, 0x6b, 0x97, 0x4a, 0x00, 0xd6, 0x27, 0x25, 0x00, 0xc3, 0xfd, 0x1b, 0x4e, 0x00, 0x6e, 0x5c,
-	0x45, 0x02, 0x00, 0xbf, 0x69, 0x02, 0x70, 0xeb, 0x9b, 0x26, 0x00, 0x77, 0xae, 0x36, 0x01, 0x18,
-	0xef, 0x9d, 0xef, 0x7c, 0x4b, 0xde, 0x79, 0x13, 0xdc, 0x28, 0x6a, 0x88, 0xb6, 0xcd, 0x0b, 0x0c,
-	0xf5, 0x6f, 0xb3, 0x60, 0xed, 0x73, 0x22, 0x24, 0x8d, 0x0c, 0x77, 0x4c, 0x7c, 0xf8, 0x73, 0x30,
-	0x87, 0x07, 0xa9, 0x8f, 0x7e, 0x80, 0xf0, 0x60, 0x8c, 0x7e, 0x8c, 0xe0, 0xda, 0x33, 0xae, 0xc2,
-	0xc1, 0x16, 0xb8, 0xa6, 0x77, 0xcb, 0xad, 0x5b, 0xfe, 0x18, 0x99, 0xbd, 0xf3, 0x8a, 0x14, 0x06,
-	0xab, 0x97, 0x15, 0x11, 0x32, 0xfb, 0xfe, 0xd3, 0xff, 0x73, 0x55, 0xa4, 0xd0, 0x48, 0xc5, 0xa0,
-	0xbe, 0x67, 0xad, 0x59, 0x3e, 0xd4, 0xdf, 0xc3, 0x95, 0x19, 0x54, 0xe1, 0x5d, 0x08, 0xd6, 0xbb,
-	0xf9, 0x2b, 0x33, 0x5e, 0x7f, 0xaf, 0x83, 0x8d, 0xd7, 0x84, 0x06, 0x7d, 0x49, 0xba, 0x05, 0x5c,
-	0x9a, 0x8e, 0x8c, 0xb1, 0x93, 0xda, 0x15, 0xda, 0x49, 0x49, 0xc6, 0x33, 0x7b, 0xd5, 0x19, 0xcf,
-	0xe5, 0xb7, 0xad, 0x0a, 0xc1, 0x5c, 0xbf, 0x74, 0x30, 0x97, 0x05, 0xe6, 0xb5, 0xef, 0x2a, 0x30,
-	0xe7, 0xbf, 0xa5, 0xc0, 0xdc, 0xfe, 0xc7, 0xff, 0xea, 0xb5, 0xbf, 0xfe, 0xf7, 0xbd, 0xda, 0x6f,
-	0x1e, 0x55, 0xfb, 0x33, 0x3b, 0xfe, 0x3a, 0xb0, 0xdb, 0xdf, 0x9d, 0x79, 0x6d, 0x9c, 0x5b, 0xff,
-	0x0f, 0x00, 0x00, 0xff, 0xff, 0x36, 0x6c, 0xf5, 0x2b, 0x07, 0x1f, 0x00, 0x00,
+	// 2167 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4d, 0x73, 0xdb, 0xc6,
+	0x19, 0x36, 0x25, 0x59, 0xb2, 0x56, 0xb2, 0x24, 0xaf, 0x6c, 0x07, 0x55, 0xe3, 0xd4, 0x56, 0xa7,
+	0x89, 0xe3, 0x36, 0x4b, 0x87, 0x72, 0xeb, 0x58, 0x76, 0x27, 0x15, 0x15, 0x4b, 0x74, 0xa3, 0x4c,
+	0x35, 0xa0, 0x62, 0xbb, 0x1f, 0x19, 0xcc, 0x12, 0x58, 0x82, 0x70, 0x20, 0x2c, 0xba, 0xbb, 0x10,
+	0x25, 0x9f, 0xfa, 0x03, 0xda, 0x7b, 0x7b, 0xec, 0xa9, 0xbd, 0x74, 0xa6, 0xb7, 0xf6, 0x77, 0xf4,
+	0x0f, 0x74, 0xa6, 0xff, 0xa1, 0xf7, 0xce, 0x7e, 0x00, 0x24, 0x28, 0x40, 0x04, 0x15, 0x36, 0x07,
+	0x80, 0xd8, 0xc5, 0xfb, 0x3c, 0xfb, 0xf9, 0x3e, 0xef, 0x8b, 0x95, 0xc0, 0xb6, 0x1f, 0x88, 0x5e,
+	0xd2, 0x41, 0x2e, 0x3d, 0xae, 0x73, 0x1a, 0xd2, 0x8f, 0x02, 0x5a, 0xf7, 0x43, 0x4a, 0xeb, 0x31,
+	0xa3, 0x6f, 0x88, 0x2b, 0xb8, 0x2e, 0xe1, 0x38, 0xa8, 0x9f, 0x7c, 0x5c, 0xa7, 0xb1, 0x08, 0x68,
+	0xc4, 0x51, 0xcc, 0xa8, 0xa0, 0x70, 0x59, 0xbe, 0x42, 0x12, 0x85, 0x02, 0xba, 0xf1, 0xae, 0x4f,
+	0xa9, 0x1f, 0x92, 0xba, 0x7a, 0xd7, 0x49, 0xba, 0x75, 0x2e, 0x58, 0xe2, 0x0a, 0x6d, 0xbb, 0x71,
+	0xd3, 0xa7, 0x3e, 0x55, 0x8f, 0x75, 0xf9, 0x64, 0x6a, 0x21, 0x39, 0x15, 0xba, 0x92, 0x9c, 0xa6,
+	0x96, 0x0f, 0xca, 0x9b, 0x27, 0xa7, 0x82, 0x44, 0x7c, 0xd0, 0x83, 0x8d, 0x8f, 0xc7, 0x76, 0xb5,
+	0xee, 0x52, 0xa6, 0x6f, 0xd5, 0x21, 0x8c, 0x70, 0xa1, 0x6e, 0xd5, 0x21, 0x3e, 0x8b, 0x5d, 0x75,
+	0x33, 0x90, 0xf1, 0x73, 0x58, 0xc7, 0xa1, 0xba, 0x0c, 0xe0, 0x49, 0xb5, 0x36, 0x9c, 0x3e, 0xe9,
+	0x64, 0x0f, 0x06, 0xfa, 0xb4, 0x22, 0xf4, 0x0d, 0xa7, 0xd1, 0xe0, 0xa9, 0x7a, 0x47, 0x7b, 0xee,
+	0xb1, 0xbc, 0x0c, 0xe0, 0xc7, 0xe3, 0x01, 0x61, 0xa7, 0x87, 0x79, 0xcf, 0xfc, 0x54, 0xef, 0x24,
+	0xef, 0x61, 0x8f, 0xf6, 0x83, 0xc8, 0x1f, 0x3c, 0x55, 0xef, 0xa4, 0x70, 0x63, 0x79, 0x19, 0xc0,
+	0xe3, 0x0a, 0x00, 0x86, 0x5d, 0xd9, 0x96, 0xf9, 0xad, 0x0e, 0x64, 0x44, 0xb0, 0x80, 0x64, 0xbf,
+	0x06, 0xb8, 0x55, 0x61, 0x7c, 0x02, 0x0b, 0x73, 0x37, 0xa0, 0x67, 0xe3, 0x41, 0x5d, 0x9c, 0x84,
+	0x22, 0x88, 0xa4, 0x41, 0x40, 0x23, 0x5d, 0xac, 0xde, 0xd7, 0x1e, 0xc1, 0x1e, 0x61, 0xd9, 0xef,
+	0x04, 0x9b, 0xb3, 0xaf, 0xae, 0xea, 0x0e, 0xd0, 0xc7, 0xfc, 0x58, 0xdd, 0xaa, 0xcf, 0x07, 0x7e,
+	0x9b, 0x30, 0xa2, 0xef, 0x06, 0xf4, 0x69, 0xa5, 0x11, 0x85, 0xa2, 0xe7, 0xf6, 0x88, 0xfb, 0xf5,
+	0xf0, 0xb3, 0x21, 0x78, 0x31, 0x9e, 0x40, 0x19, 0xba, 0x34, 0x74, 0x92, 0xd8, 0x67, 0xd8, 0x23,
+	0xe7, 0x2a, 0x0c, 0xd5, 0x7e, 0x85, 0x7d, 0x4e, 0x5d, 0x1c, 0x3a, 0x0c, 0x0b, 0x12, 0x06, 0xc7,
+	0x81, 0x18, 0x2d, 0x1b, 0xa2, 0xf7, 0xcb, 0x89, 0x78, 0xd2, 0xe1, 0x24, 0xb5, 0x6b, 0x8f, 0x6f,
+	0xd0, 0x3b, 0x8b, 0xf0, 0x71, 0xe0, 0x3a, 0x5d, 0xca, 0xfa, 0x98, 0x79, 0x4e, 0xcc, 0xe8, 0xe9,
+	0x59, 0x71, 0xad, 0x21, 0x3d, 0x2a, 0x21, 0x95, 0x4a, 0xca, 0x22, 0x1c, 0xd6, 0x49, 0x74, 0x42,
+	0xcf, 0x86, 0x84, 0x55, 0xfa, 0x43, 0xc4, 0xbb, 0x94, 0x1d, 0x63, 0xb5, 0xe1, 0xf2, 0x45, 0xc3,
+	0x7a, 0x38, 0x31, 0xab, 0xea, 0x53, 0x88, 0x05, 0x89, 0xdc, 0xb3, 0x5c, 0xe1, 0xd2, 0xfd, 0xec,
+	0x06, 0xa1, 0x50, 0x5b, 0x5b, 0x88, 0xb8, 0xde, 0x49, 0xba, 0x5d, 0xc2, 0xea, 0x27, 0x5b, 0xe6,
+	0xc9, 0xb0, 0x7e, 0x5e, 0x8d, 0xd5, 0xa5, 0x51, 0x37, 0xf0, 0x0d, 0xa3, 0x26, 0xf4, 0xdf, 0x06,
+	0x71, 0xfd, 0xa4, 0xa1, 0x7e, 0x0d, 0xd9, 0xf3, 0x0b, 0xe2, 0x52, 0x24, 0x08, 0x8b, 0x59, 0xc0,
+	0x49, 0xb6, 0x54, 0xe4, 0x54, 0xe0, 0x44, 0xf4, 0x4c, 0xd4, 0x92, 0x8f, 0x86, 0x66, 0x7b, 0x22,
+	0x9a, 0x37, 0x7d, 0x21, 0x2f, 0x83, 0xdd, 0x9b, 0x08, 0x3b, 0xd8, 0x98, 0xa3, 0x5b, 0xf2, 0xd9,
+	0x64, 0x3c, 0x1d, 0xec, 0xaa, 0xdb, 0xa5, 0x46, 0xd0, 0xc7, 0x5d, 0x79, 0x5d, 0x0a, 0xeb, 0x85,
+	0xb1, 0xbc, 0xc6, 0x2f, 0xc0, 0x90, 0xa8, 0x8f, 0xdd, 0xbc, 0xef, 0x8d, 0xe6, 0x29, 0x5e, 0xc2,
+	0x2e, 0x7c, 0xdf, 0x67, 0x38, 0x8e, 0x33, 0xf5, 0xdc, 0xfc, 0xd3, 0x0c, 0x58, 0x3d, 0x08, 0xb8,
+	0x20, 0x11, 0x61, 0xbf, 0xd0, 0xed, 0x42, 0x0f, 0xdc, 0xc6, 0xae, 0x4b, 0x38, 0x77, 0x42, 0xea,
+	0xfb, 0x41, 0xe4, 0x3b, 0x9c, 0xb0, 0x93, 0xc0, 0x25, 0x56, 0xed, 0x6e, 0xed, 0xfe, 0x52, 0x03,
+	0x21, 0x19, 0xe9, 0x4d, 0x2f, 0xd1, 0x70, 0xda, 0x84, 0x76, 0x14, 0xee, 0x40, 0xc3, 0xda, 0x1a,
+	0x65, 0xdf, 0xc4, 0x05, 0xb5, 0xf0, 0x13, 0x00, 0x06, 0x0e, 0x60, 0xcd, 0x28, 0x66, 0x2b, 0xcf,
+	0xf6, 0x3c, 0x7b, 0x6f, 0x0f, 0xd9, 0xc2, 0x2e, 0xb8, 0x17, 0x13, 0xe6, 0xb8, 0x34, 0x8a, 0x74,
+	0x20, 0x71, 0xb4, 0x9f, 0x38, 0x6a, 0x57, 0x38, 0x9d, 0x33, 0x41, 0xb8, 0x35, 0xab, 0x08, 0xdf,
+	0x45, 0x7a, 0xfc, 0x28, 0x1d, 0x3f, 0xfa, 0xf2, 0x45, 0x24, 0xb6, 0x1a, 0x2f, 0x71, 0x98, 0x10,
+	0xfb, 0x4e, 0x4c, 0xd8, 0x6e, 0xc6, 0xd2, 0x54, 0x24, 0x07, 0x92, 0xa3, 0x29, 0x29, 0x36, 0xff,
+	0xbc, 0x08, 0xd6, 0x5b, 0x42, 0xc4, 0xa3, 0xf3, 0xb3, 0x03, 0xae, 0xa5, 0x49, 0x8b, 0x99, 0x91,
+	0xf7, 0x51, 0x5a, 0x51, 0x3c, 0x2d, 0xfb, 0x2c, 0x76, 0x5f, 0x91, 0x8e, 0xbd, 0xe0, 0xeb, 0x07,
+	0xf8, 0xbb, 0x1a, 0xb8, 0x2b, 0x5d, 0x73, 0x78, 0x10, 0xc7, 0x38, 0xc2, 0x3e, 0x61, 0x0e, 0x27,
+	0x42, 0x04, 0x91, 0x9f, 0xce, 0xc9, 0x63, 0x24, 0xd3, 0x95, 0x42, 0x5a, 0xd9, 0xb9, 0x41, 0xff,
+	0xbf, 0xd0, 0xf8, 0xb6, 0x81, 0xdb, 0x77, 0x7a, 0x17, 0xbd, 0x86, 0x87, 0x60, 0x59, 0x87, 0x1c,
+	0x47, 0xc5, 0x1c, 0x6b, 0x4e, 0xb5, 0xf6, 0x11, 0x1a, 0x8e, 0x43, 0xc5, 0xad, 0x2a, 0x83, 0x5d,
+	0x69, 0x60, 0x2f, 0xf5, 0x06, 0x85, 0x91, 0x15, 0x9d, 0x9d, 0x60, 0x45, 0x1f, 0x81, 0xd9, 0x3e,
+	0xee, 0x5a, 0x57, 0x15, 0x64, 0x13, 0x49, 0x0f, 0x2b, 0x6c, 0x3a, 0x1b, 0x9b, 0x34, 0x87, 0x9f,
+	0x80, 0x59, 0x2f, 0x8c, 0xad, 0x79, 0xb3, 0x04, 0xd2, 0xb7, 0x0a, 0x51, 0x7b, 0x4a, 0x0a, 0x77,
+	0x95, 0x2e, 0xda, 0x12, 0x02, 0x9f, 0x82, 0x39, 0x19, 0xdd, 0xad, 0x05, 0x05, 0xfd, 0x00, 0xc9,
+	0x42, 0x31, 0xf6, 0x30, 0x4c, 0xfc, 0x20, 0x6a, 0xd3, 0x84, 0xb9, 0xc4, 0x56, 0x20, 0xf8, 0x14,
+	0x2c, 0x18, 0x11, 0xb4, 0x80, 0xc2, 0xdf, 0x43, 0x03, 0x6f, 0x2f, 0xe9, 0x6f, 0x8a, 0x80, 0x6d,
+	0xb0, 0x96, 0xe9, 0x97, 0x72, 0x2b, 0xc2, 0xac, 0x25, 0xc5, 0x72, 0x1f, 0x65, 0x2f, 0xc6, 0x0c,
+	0x7e, 0x35, 0x33, 0x6c, 0x2b, 0x02, 0xb8, 0x0d, 0xe6, 0xa4, 0xb4, 0x5b, 0xd7, 0xcc, 0x4c, 0xa8,
+	0x40, 0x80, 0x74, 0x20, 0x40, 0x3a, 0x10, 0x20, 0xb9, 0x19, 0x90, 0xb4, 0x42, 0x27, 0x0d, 0xb4,
+	0xff, 0x36, 0x88, 0x6d, 0x85, 0x81, 0xbf, 0x06, 0xd7, 0x55, 0x04, 0x73, 0x4c, 0x08, 0xb3, 0x16,
+	0x15, 0xc9, 0x4f, 0xca, 0x49, 0x72, 0x01, 0xef, 0xa4, 0x81, 0x0e, 0x65, 0xf9, 0x40, 0x97, 0xed,
+	0xe5, 0x78, 0xa8, 0x04, 0xf7, 0xc1, 0xbc, 0x76, 0x4d, 0x6b, 0x59, 0xb1, 0xd6, 0x0d, 0xeb, 0x60,
+	0xe9, 0x0d, 0x33, 0xd7, 0xd4, 0xda, 0x18, 0x9d, 0x6c, 0x21, 0xed, 0x8c, 0xb6, 0x81, 0x43, 0x0f,
+	0xdc, 0xcc, 0x72, 0x7d, 0x47, 0x09, 0xa1, 0x4b, 0x3d, 0xc2, 0xac, 0xeb, 0x8a, 0xb6, 0x81, 0xb2,
+	0x97, 0xe5, 0xfe, 0xf7, 0x73, 0x4e, 0xa3, 0xa3, 0x0c, 0x69, 0x43, 0xff, 0x5c, 0x1d, 0xb4, 0xc1,
+	0x3b, 0x1c, 0x47, 0x81, 0x08, 0xde, 0x12, 0xc7, 0x0d, 0x13, 0x2e, 0x08, 0x73, 0x74, 0xb2, 0x69,
+	0xad, 0xa8, 0x86, 0x36, 0xce, 0xc9, 0x49, 0x93, 0xd2, 0x50, 0x8b, 0xc9, 0xad, 0x14, 0xba, 0xab,
+	0x91, 0x2d, 0x05, 0x84, 0x5f, 0x81, 0xd5, 0x91, 0x4c, 0xca, 0x5a, 0x55, 0x5c, 0x8f, 0xd0, 0x48,
+	0x7d, 0x71, 0xd7, 0x0f, 0xa4, 0x91, 0x8d, 0x05, 0x51, 0xc2, 0x64, 0xaf, 0x84, 0x69, 0x59, 0x61,
+	0x36, 0xff, 0x5e, 0x03, 0xf0, 0xc8, 0x3d, 0x27, 0x51, 0xaf, 0x01, 0x14, 0x6e, 0xac, 0x93, 0xa7,
+	0x81, 0xa0, 0x68, 0x97, 0x7c, 0x80, 0xe4, 0xa7, 0x45, 0x61, 0x63, 0x47, 0x6e, 0xac, 0x56, 0x33,
+	0xdb, 0x6a, 0x6b, 0x62, 0xa4, 0x06, 0xfe, 0x0c, 0xcc, 0xc9, 0xe8, 0x69, 0xe4, 0xe2, 0x47, 0x48,
+	0x16, 0x8a, 0xc9, 0x32, 0x5f, 0xcf, 0xd8, 0x14, 0x72, 0xf3, 0x2f, 0xcb, 0x00, 0xbe, 0x0c, 0x98,
+	0x48, 0x70, 0xd8, 0xa2, 0x5c, 0xa4, 0x5d, 0xce, 0xab, 0x47, 0x6d, 0x02, 0xf5, 0xd8, 0x05, 0x0b,
+	0xe6, 0xf3, 0xc5, 0x28, 0xc8, 0x87, 0xc8, 0x94, 0x8b, 0x3b, 0x66, 0x13, 0xc1, 0xce, 0x0e, 0x69,
+	0x18, 0xb8, 0x67, 0x76, 0x8a, 0x84, 0x8f, 0xc1, 0x55, 0xf5, 0x31, 0x93, 0xf9, 0xb4, 0x2a, 0x95,
+	0x78, 0xa2, 0x7c, 0x65, 0x6b, 0x7b, 0x88, 0xc1, 0xba, 0xde, 0x23, 0x52, 0xc0, 0x83, 0x38, 0x09,
+	0x55, 0xf8, 0x35, 0xe2, 0xfd, 0x10, 0xa5, 0x1f, 0x2b, 0x65, 0x52, 0xea, 0x11, 0xf6, 0xc5, 0x10,
+	0xce, 0x86, 0xbd, 0x73, 0x75, 0xf0, 0x09, 0x98, 0x73, 0x29, 0x4b, 0xd7, 0xef, 0x07, 0xc8, 0xa5,
+	0x65, 0x84, 0xbb, 0x94, 0x71, 0x33, 0x32, 0x05, 0x81, 0x1d, 0xb0, 0x9a, 0xcf, 0x1b, 0xb8, 0x59,
+	0xb9, 0x47, 0x28, 0x5f, 0x5f, 0xb2, 0x21, 0xf2, 0xd8, 0xe6, 0x8c, 0x55, 0xb3, 0x47, 0x09, 0xe1,
+	0x2f, 0xc1, 0x40, 0x91, 0x9c, 0x0e, 0xe6, 0x81, 0x6b, 0x34, 0xf9, 0xe1, 0x38, 0x49, 0x7b, 0x11,
+	0xf9, 0x8c, 0x70, 0x3e, 0xb4, 0xbd, 0x33, 0x40, 0x53, 0xf2, 0xc0, 0x57, 0x60, 0x71, 0xe0, 0x37,
+	0x7b, 0x26, 0x1e, 0x8e, 0x21, 0xcd, 0xd8, 0x5e, 0xf6, 0x28, 0x17, 0xd9, 0x9e, 0x69, 0x5d, 0xb1,
+	0x07, 0x5c, 0xd0, 0x05, 0x50, 0x16, 0x4c, 0xca, 0xa0, 0x55, 0x8e, 0x5b, 0xfb, 0xaa, 0x85, 0xad,
+	0xca, 0x2d, 0x98, 0x98, 0x42, 0xba, 0xbc, 0x75, 0xc5, 0x5e, 0x63, 0xf9, 0xea, 0x2c, 0xac, 0x5d,
+	0x9b, 0x2c, 0xac, 0x6d, 0x83, 0xd9, 0x37, 0x7d, 0x61, 0x74, 0xf8, 0x3e, 0x92, 0x09, 0x73, 0x21,
+	0x2a, 0x3f, 0x3c, 0x5b, 0x82, 0x32, 0xef, 0x5c, 0xba, 0xac, 0x77, 0x4a, 0x67, 0x4a, 0xa3, 0xdb,
+	0xb2, 0x71, 0xa6, 0xb2, 0xe8, 0xf6, 0xfc, 0x54, 0xec, 0x24, 0xa2, 0x37, 0xe8, 0x42, 0x16, 0xe5,
+	0x1a, 0x3a, 0x32, 0x6b, 0x75, 0xbe, 0x5b, 0x1e, 0x99, 0x87, 0x63, 0x32, 0x06, 0x6b, 0x26, 0x8d,
+	0x93, 0xc9, 0x1d, 0xa3, 0x89, 0x20, 0x46, 0x75, 0x1f, 0x4f, 0x18, 0x35, 0x0e, 0x09, 0xb3, 0x25,
+	0xdc, 0x5e, 0xe9, 0xe4, 0xca, 0xf0, 0x2b, 0x70, 0x27, 0x88, 0xdc, 0x30, 0xf1, 0x88, 0xc3, 0xc8,
+	0x6f, 0x13, 0xc2, 0x85, 0x83, 0x85, 0x20, 0xc7, 0xb1, 0xdc, 0x01, 0x49, 0x94, 0x2a, 0xf3, 0x45,
+	0x2a, 0xbf, 0x61, 0x08, 0x6c, 0x8d, 0xdf, 0xd1, 0xf0, 0x5d, 0x89, 0x86, 0x1e, 0xb8, 0x97, 0xd2,
+	0xe7, 0x68, 0x9d, 0x20, 0x72, 0x18, 0xe1, 0x31, 0x8d, 0x38, 0xb1, 0xd6, 0xc6, 0x36, 0x91, 0xf6,
+	0x71, 0x98, 0xfb, 0x45, 0x64, 0x1b, 0x02, 0x18, 0x83, 0xdb, 0x5c, 0x60, 0x9f, 0x78, 0xce, 0xa8,
+	0x63, 0xdf, 0x50, 0xd4, 0x4f, 0x2e, 0xe1, 0xd8, 0x6d, 0x49, 0xc8, 0xed, 0x5b, 0x9a, 0xf8, 0x68,
+	0xc4, 0xbf, 0x0b, 0x42, 0x18, 0x9c, 0x5e, 0x08, 0x6b, 0x5a, 0xe0, 0xf6, 0x39, 0x57, 0x74, 0xc4,
+	0x59, 0x4c, 0x36, 0xff, 0xb5, 0x06, 0x96, 0xd5, 0xca, 0xa5, 0x31, 0xa2, 0x40, 0xcd, 0x6a, 0xd3,
+	0x56, 0xb3, 0x4f, 0xc1, 0xbc, 0x3a, 0x97, 0x4a, 0xf3, 0xef, 0x0f, 0x90, 0x2a, 0x96, 0x28, 0x81,
+	0xec, 0xdd, 0x9e, 0x32, 0xb7, 0x0d, 0x0c, 0xee, 0x82, 0x95, 0x98, 0x91, 0x6e, 0x70, 0xea, 0x30,
+	0xd2, 0x67, 0x81, 0x20, 0xa5, 0xdf, 0x22, 0x6d, 0xc1, 0x82, 0xc8, 0xd7, 0xab, 0x7e, 0x5d, 0x63,
+	0x6c, 0x0d, 0x81, 0x4f, 0xc0, 0x82, 0x08, 0x8e, 0x09, 0x4d, 0x84, 0xd1, 0xeb, 0xef, 0x9c, 0x43,
+	0x7f, 0x66, 0xbe, 0xf4, 0x9a, 0x73, 0x7f, 0xfc, 0xf7, 0xf7, 0x6a, 0x76, 0x6a, 0x3f, 0x9d, 0x70,
+	0x98, 0x8f, 0xc6, 0xf3, 0x13, 0x44, 0xe3, 0x03, 0xb0, 0x60, 0x4e, 0x21, 0x4d, 0x7a, 0xdd, 0x40,
+	0xa6, 0x7c, 0xc1, 0x14, 0x1e, 0x69, 0x8b, 0x41, 0xbe, 0x6c, 0x20, 0xf0, 0x00, 0x2c, 0x66, 0xe7,
+	0xa7, 0x46, 0x48, 0x11, 0xca, 0x6a, 0x2e, 0x60, 0x6c, 0xa7, 0x36, 0xf6, 0x80, 0xa0, 0x2c, 0x56,
+	0x2f, 0x4e, 0x31, 0x56, 0x7f, 0x1f, 0x2c, 0x4b, 0x5d, 0xce, 0xd6, 0x5e, 0xa6, 0x13, 0x8b, 0xad,
+	0x2b, 0xf6, 0x92, 0xac, 0x4d, 0x57, 0xb7, 0x05, 0x6e, 0xe0, 0x44, 0x50, 0x27, 0x67, 0xb9, 0x3e,
+	0x4e, 0x19, 0x5a, 0x57, 0xec, 0x55, 0x09, 0x6b, 0x0d, 0x31, 0xa5, 0xa9, 0xc1, 0xd2, 0xe4, 0xa9,
+	0xc1, 0xe7, 0x60, 0x21, 0xec, 0x38, 0xf2, 0x54, 0xdb, 0x28, 0x7d, 0x03, 0x99, 0x43, 0xee, 0xf2,
+	0x59, 0xdd, 0x51, 0x9f, 0x92, 0x2d, 0xcc, 0x7b, 0x46, 0xba, 0xe7, 0xc3, 0x8e, 0x2c, 0xc1, 0xd7,
+	0xe0, 0x9a, 0x39, 0x71, 0xe4, 0xd6, 0xad, 0xbb, 0xb3, 0xf7, 0x97, 0x1a, 0xcf, 0xd0, 0xb9, 0xb3,
+	0xc8, 0xe2, 0x2f, 0x2c, 0x63, 0xf5, 0xa5, 0x36, 0x32, 0xbc, 0x19, 0x5b, 0x51, 0x76, 0x71, 0x7d,
+	0x4a, 0xd9, 0xc5, 0xeb, 0xe1, 0xec, 0xe2, 0xf7, 0xb5, 0x09, 0xd3, 0x0b, 0x35, 0x21, 0x83, 0xf4,
+	0xa2, 0x36, 0x9c, 0x5e, 0x78, 0x85, 0xe9, 0xc5, 0x1f, 0x6a, 0x97, 0xcf, 0x2f, 0x6a, 0xe5, 0xf9,
+	0xc5, 0xea, 0xa5, 0xf2, 0x8b, 0xb5, 0x71, 0xf9, 0x45, 0x7e, 0x7c, 0xf9, 0xfc, 0xe2, 0xc6, 0x34,
+	0xf2, 0x0b, 0xf8, 0x4d, 0xf3, 0x8b, 0x9b, 0xdf, 0x34, 0xbf, 0xb8, 0x3d, 0xdd, 0xfc, 0xa2, 0x3c,
+	0x34, 0xbf, 0xf3, 0xed, 0x85, 0x66, 0x6b, 0x7a, 0xa1, 0x19, 0x3e, 0x07, 0xab, 0xfa, 0x54, 0xdf,
+	0xe1, 0x82, 0x10, 0x19, 0xac, 0xac, 0x8d, 0x34, 0x96, 0xe5, 0x36, 0x98, 0x32, 0x6a, 0x1b, 0x1b,
+	0x7b, 0x85, 0xe7, 0xca, 0xf0, 0x37, 0xe0, 0x56, 0xe1, 0xb1, 0xbe, 0xf5, 0x5d, 0xb3, 0x07, 0xbd,
+	0x6e, 0xc9, 0x02, 0xa6, 0x53, 0x6b, 0x16, 0x72, 0xdd, 0xd0, 0xec, 0x69, 0x16, 0xf5, 0x65, 0xda,
+	0x5c, 0x07, 0x37, 0x86, 0x75, 0x54, 0xa5, 0x0e, 0x17, 0x24, 0x15, 0x7f, 0x9b, 0x01, 0xab, 0x9f,
+	0x11, 0x2e, 0x82, 0x48, 0xcf, 0x6f, 0x4c, 0x5c, 0xf8, 0x53, 0x30, 0x8b, 0xfb, 0x69, 0x2e, 0xf1,
+	0x21, 0x92, 0x7f, 0x2b, 0x2a, 0xec, 0xce, 0x08, 0xae, 0x75, 0xc5, 0x96, 0x38, 0xb8, 0x0b, 0xae,
+	0xaa, 0x3f, 0xfc, 0x98, 0x8c, 0xe1, 0x87, 0x48, 0x95, 0xaa, 0x52, 0x68, 0xac, 0x72, 0x2d, 0xc2,
+	0x45, 0xf6, 0x91, 0x2e, 0x0b, 0x55, 0x29, 0x14, 0x52, 0x32, 0xc8, 0x43, 0x0d, 0x93, 0x30, 0x3c,
+	0x50, 0x87, 0x22, 0x95, 0x19, 0xa4, 0x71, 0x13, 0x82, 0x35, 0x6f, 0xf0, 0x4a, 0xcf, 0xd7, 0x3f,
+	0xe6, 0xc0, 0xc6, 0x2b, 0x12, 0xf8, 0x3d, 0x41, 0xbc, 0x21, 0x5c, 0x9a, 0x92, 0x95, 0x84, 0xd4,
+	0xda, 0x14, 0x43, 0x6a, 0x41, 0xd6, 0x37, 0x33, 0xed, 0xac, 0xef, 0xf2, 0x67, 0x97, 0x43, 0x82,
+	0x36, 0x77, 0x69, 0x41, 0x2b, 0x12, 0xa7, 0xab, 0xdf, 0x96, 0x38, 0xcd, 0xff, 0x7f, 0xc4, 0xa9,
+	0xb9, 0xfd, 0xcf, 0xff, 0xce, 0xd5, 0xfe, 0xfa, 0x9f, 0xf7, 0x6a, 0xbf, 0x7a, 0x58, 0xed, 0xff,
+	0x32, 0xe2, 0xaf, 0x7d, 0xf3, 0x37, 0x90, 0xce, 0xbc, 0x4a, 0x1e, 0xb6, 0xfe, 0x37, 0x00, 0x5b,
+	0xfd, 0x7c, 0x19, 0xd2, 0x21, 0x00, 0x00,
 }
 
 func (this *ListenerOptions) Equal(that interface{}) bool {
@@ -1362,6 +1429,9 @@ func (this *HttpListenerOptions) Equal(that interface{}) bool {
 	if !this.SanitizeClusterHeader.Equal(that1.SanitizeClusterHeader) {
 		return false
 	}
+	if !this.LocalRatelimit.Equal(that1.LocalRatelimit) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1389,6 +1459,9 @@ func (this *TcpListenerOptions) Equal(that interface{}) bool {
 	if !this.TcpProxySettings.Equal(that1.TcpProxySettings) {
 		return false
 	}
+	if !this.Rbac.Equal(that1.Rbac) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1470,6 +1543,9 @@ func (this *VirtualHostOptions) Equal(that interface{}) bool {
 	if !this.StagedTransformations.Equal(that1.StagedTransformations) {
 		return false
 	}
+	if !this.LocalRatelimit.Equal(that1.LocalRatelimit) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1631,6 +1707,15 @@ func (this *RouteOptions) Equal(that interface{}) bool {
 	if !this.StagedTransformations.Equal(that1.StagedTransformations) {
 		return false
 	}
+	if !this.LocalRatelimit.Equal(that1.LocalRatelimit) {
+		return false
+	}
+	if !this.SubsetSteering.Equal(that1.SubsetSteering) {
+		return false
+	}
+	if !this.DynamicForwardProxy.Equal(that1.DynamicForwardProxy) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
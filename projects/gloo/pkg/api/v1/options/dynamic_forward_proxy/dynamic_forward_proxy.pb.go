@@ -0,0 +1,471 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/dynamic_forward_proxy/dynamic_forward_proxy.proto
+
+package dynamic_forward_proxy
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	math "math"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DnsCacheConfig_DnsLookupFamily int32
+
+const (
+	DnsCacheConfig_AUTO    DnsCacheConfig_DnsLookupFamily = 0
+	DnsCacheConfig_V4_ONLY DnsCacheConfig_DnsLookupFamily = 1
+	DnsCacheConfig_V6_ONLY DnsCacheConfig_DnsLookupFamily = 2
+)
+
+var DnsCacheConfig_DnsLookupFamily_name = map[int32]string{
+	0: "AUTO",
+	1: "V4_ONLY",
+	2: "V6_ONLY",
+}
+
+var DnsCacheConfig_DnsLookupFamily_value = map[string]int32{
+	"AUTO":    0,
+	"V4_ONLY": 1,
+	"V6_ONLY": 2,
+}
+
+func (x DnsCacheConfig_DnsLookupFamily) String() string {
+	return proto.EnumName(DnsCacheConfig_DnsLookupFamily_name, int32(x))
+}
+
+func (DnsCacheConfig_DnsLookupFamily) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3aea16acf049d928, []int{1, 0}
+}
+
+// Dynamic forward proxy upstreams forward each request to the host of its Host header, which Envoy resolves with DNS
+// when the request arrives. They make Gloo an egress gateway: a route to a dynamic forward proxy upstream forwards the
+// requests it matches to any host, or only to the hosts in `allowedHosts`.
+// Dynamic forward proxy upstreams can only be used as the single destination of routes.
+// Unlike upstreams created by service discovery, dynamic forward proxy upstreams must be created manually by users.
+type UpstreamSpec struct {
+	// The DNS cache resolving the hosts. All the dynamic forward proxy upstreams used by the routes of a listener must
+	// have the same DNS cache config.
+	DnsCacheConfig *DnsCacheConfig `protobuf:"bytes,1,opt,name=dns_cache_config,json=dnsCacheConfig,proto3" json:"dns_cache_config,omitempty"`
+	// The hosts the requests can be forwarded to, such as `api.example.com`, or `*.example.com` to allow all the
+	// subdomains of `example.com`. A host without a port allows all ports. Hosts are matched case-insensitively.
+	// The routes to the upstream only match the requests to these hosts. If empty, all the hosts are allowed.
+	AllowedHosts         []string `protobuf:"bytes,2,rep,name=allowed_hosts,json=allowedHosts,proto3" json:"allowed_hosts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aea16acf049d928, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetDnsCacheConfig() *DnsCacheConfig {
+	if m != nil {
+		return m.DnsCacheConfig
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetAllowedHosts() []string {
+	if m != nil {
+		return m.AllowedHosts
+	}
+	return nil
+}
+
+// Configures the DNS cache of dynamic forward proxy upstreams.
+// see more info [here](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/common/dynamic_forward_proxy/v3/dns_cache.proto).
+type DnsCacheConfig struct {
+	// The IP address families to resolve the hosts to. Defaults to AUTO, which prefers IPv6 addresses.
+	DnsLookupFamily DnsCacheConfig_DnsLookupFamily `protobuf:"varint,1,opt,name=dns_lookup_family,json=dnsLookupFamily,proto3,enum=dfp.options.gloo.solo.io.DnsCacheConfig_DnsLookupFamily" json:"dns_lookup_family,omitempty"`
+	// The interval at which the hosts are resolved again. Defaults to 60s.
+	DnsRefreshRate *time.Duration `protobuf:"bytes,2,opt,name=dns_refresh_rate,json=dnsRefreshRate,proto3,stdduration" json:"dns_refresh_rate,omitempty"`
+	// The time after which a host that is not used anymore is removed from the cache. Defaults to 5m.
+	HostTtl *time.Duration `protobuf:"bytes,3,opt,name=host_ttl,json=hostTtl,proto3,stdduration" json:"host_ttl,omitempty"`
+	// The maximum number of hosts in the cache. Defaults to 1024.
+	MaxHosts             *types.UInt32Value `protobuf:"bytes,4,opt,name=max_hosts,json=maxHosts,proto3" json:"max_hosts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DnsCacheConfig) Reset()         { *m = DnsCacheConfig{} }
+func (m *DnsCacheConfig) String() string { return proto.CompactTextString(m) }
+func (*DnsCacheConfig) ProtoMessage()    {}
+func (*DnsCacheConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aea16acf049d928, []int{1}
+}
+func (m *DnsCacheConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DnsCacheConfig.Unmarshal(m, b)
+}
+func (m *DnsCacheConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DnsCacheConfig.Marshal(b, m, deterministic)
+}
+func (m *DnsCacheConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DnsCacheConfig.Merge(m, src)
+}
+func (m *DnsCacheConfig) XXX_Size() int {
+	return xxx_messageInfo_DnsCacheConfig.Size(m)
+}
+func (m *DnsCacheConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DnsCacheConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DnsCacheConfig proto.InternalMessageInfo
+
+func (m *DnsCacheConfig) GetDnsLookupFamily() DnsCacheConfig_DnsLookupFamily {
+	if m != nil {
+		return m.DnsLookupFamily
+	}
+	return DnsCacheConfig_AUTO
+}
+
+func (m *DnsCacheConfig) GetDnsRefreshRate() *time.Duration {
+	if m != nil {
+		return m.DnsRefreshRate
+	}
+	return nil
+}
+
+func (m *DnsCacheConfig) GetHostTtl() *time.Duration {
+	if m != nil {
+		return m.HostTtl
+	}
+	return nil
+}
+
+func (m *DnsCacheConfig) GetMaxHosts() *types.UInt32Value {
+	if m != nil {
+		return m.MaxHosts
+	}
+	return nil
+}
+
+// Route options for the routes to dynamic forward proxy upstreams.
+type PerRouteConfig struct {
+	// Types that are valid to be assigned to HostRewriteSpecifier:
+	//	*PerRouteConfig_HostRewrite
+	//	*PerRouteConfig_AutoHostRewriteHeader
+	HostRewriteSpecifier isPerRouteConfig_HostRewriteSpecifier `protobuf_oneof:"host_rewrite_specifier"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *PerRouteConfig) Reset()         { *m = PerRouteConfig{} }
+func (m *PerRouteConfig) String() string { return proto.CompactTextString(m) }
+func (*PerRouteConfig) ProtoMessage()    {}
+func (*PerRouteConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aea16acf049d928, []int{2}
+}
+func (m *PerRouteConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PerRouteConfig.Unmarshal(m, b)
+}
+func (m *PerRouteConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PerRouteConfig.Marshal(b, m, deterministic)
+}
+func (m *PerRouteConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerRouteConfig.Merge(m, src)
+}
+func (m *PerRouteConfig) XXX_Size() int {
+	return xxx_messageInfo_PerRouteConfig.Size(m)
+}
+func (m *PerRouteConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerRouteConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerRouteConfig proto.InternalMessageInfo
+
+type isPerRouteConfig_HostRewriteSpecifier interface {
+	isPerRouteConfig_HostRewriteSpecifier()
+	Equal(interface{}) bool
+}
+
+type PerRouteConfig_HostRewrite struct {
+	HostRewrite string `protobuf:"bytes,1,opt,name=host_rewrite,json=hostRewrite,proto3,oneof" json:"host_rewrite,omitempty"`
+}
+type PerRouteConfig_AutoHostRewriteHeader struct {
+	AutoHostRewriteHeader string `protobuf:"bytes,2,opt,name=auto_host_rewrite_header,json=autoHostRewriteHeader,proto3,oneof" json:"auto_host_rewrite_header,omitempty"`
+}
+
+func (*PerRouteConfig_HostRewrite) isPerRouteConfig_HostRewriteSpecifier()           {}
+func (*PerRouteConfig_AutoHostRewriteHeader) isPerRouteConfig_HostRewriteSpecifier() {}
+
+func (m *PerRouteConfig) GetHostRewriteSpecifier() isPerRouteConfig_HostRewriteSpecifier {
+	if m != nil {
+		return m.HostRewriteSpecifier
+	}
+	return nil
+}
+
+func (m *PerRouteConfig) GetHostRewrite() string {
+	if x, ok := m.GetHostRewriteSpecifier().(*PerRouteConfig_HostRewrite); ok {
+		return x.HostRewrite
+	}
+	return ""
+}
+
+func (m *PerRouteConfig) GetAutoHostRewriteHeader() string {
+	if x, ok := m.GetHostRewriteSpecifier().(*PerRouteConfig_AutoHostRewriteHeader); ok {
+		return x.AutoHostRewriteHeader
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*PerRouteConfig) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*PerRouteConfig_HostRewrite)(nil),
+		(*PerRouteConfig_AutoHostRewriteHeader)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterEnum("dfp.options.gloo.solo.io.DnsCacheConfig_DnsLookupFamily", DnsCacheConfig_DnsLookupFamily_name, DnsCacheConfig_DnsLookupFamily_value)
+	proto.RegisterType((*UpstreamSpec)(nil), "dfp.options.gloo.solo.io.UpstreamSpec")
+	proto.RegisterType((*DnsCacheConfig)(nil), "dfp.options.gloo.solo.io.DnsCacheConfig")
+	proto.RegisterType((*PerRouteConfig)(nil), "dfp.options.gloo.solo.io.PerRouteConfig")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/options/dynamic_forward_proxy/dynamic_forward_proxy.proto", fileDescriptor_3aea16acf049d928)
+}
+
+var fileDescriptor_3aea16acf049d928 = []byte{
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xd1, 0x6e, 0xd3, 0x3c,
+	0x18, 0x5d, 0xba, 0xea, 0xdf, 0xe6, 0xf6, 0xef, 0x8a, 0x05, 0x28, 0x4c, 0x68, 0x4c, 0xe5, 0xa6,
+	0x37, 0x38, 0xa2, 0x03, 0xc4, 0xb8, 0xa3, 0x9b, 0x50, 0x27, 0x4d, 0x1b, 0x0a, 0xeb, 0x04, 0xdc,
+	0x58, 0x6e, 0xe2, 0xa4, 0x66, 0x49, 0x3e, 0xcb, 0x76, 0x68, 0xfb, 0x04, 0x48, 0x3c, 0x01, 0x8f,
+	0xc0, 0x23, 0xf0, 0x36, 0x48, 0xbc, 0x00, 0x57, 0xdc, 0x23, 0x3b, 0x41, 0xa3, 0xc0, 0xa4, 0xde,
+	0xf5, 0x9c, 0xef, 0x3b, 0xa7, 0xdf, 0x39, 0x91, 0x51, 0x92, 0x0a, 0x33, 0x2d, 0x27, 0x24, 0x82,
+	0x3c, 0xd0, 0x90, 0xc1, 0x03, 0x01, 0x41, 0x9a, 0x01, 0x04, 0x52, 0xc1, 0x3b, 0x1e, 0x19, 0x5d,
+	0x21, 0x26, 0x45, 0xf0, 0xfe, 0x61, 0x00, 0xd2, 0x08, 0x28, 0x74, 0x10, 0x2f, 0x0a, 0x96, 0x8b,
+	0x88, 0x26, 0xa0, 0x66, 0x4c, 0xc5, 0x54, 0x2a, 0x98, 0x2f, 0xfe, 0xcd, 0x12, 0xa9, 0xc0, 0x00,
+	0xf6, 0xe3, 0x44, 0x92, 0x5a, 0x4e, 0xac, 0x25, 0xb1, 0xff, 0x46, 0x04, 0xec, 0xdc, 0x4c, 0x21,
+	0x05, 0xb7, 0x14, 0xd8, 0x5f, 0xd5, 0xfe, 0x0e, 0xe6, 0x73, 0x53, 0x91, 0x7c, 0x6e, 0x6a, 0x6e,
+	0x37, 0x05, 0x48, 0x33, 0x1e, 0x38, 0x34, 0x29, 0x93, 0x20, 0x2e, 0x15, 0xb3, 0x8e, 0xd7, 0xcd,
+	0x67, 0x8a, 0x49, 0xc9, 0x95, 0xae, 0xe6, 0xbd, 0x0f, 0x1e, 0x6a, 0x8f, 0xa5, 0x36, 0x8a, 0xb3,
+	0xfc, 0x95, 0xe4, 0x11, 0x0e, 0x51, 0x37, 0x2e, 0x34, 0x8d, 0x58, 0x34, 0xe5, 0x34, 0x82, 0x22,
+	0x11, 0xa9, 0xef, 0xed, 0x79, 0xfd, 0xd6, 0xa0, 0x4f, 0xae, 0xbb, 0x97, 0x1c, 0x15, 0xfa, 0xd0,
+	0x0a, 0x0e, 0xdd, 0x7e, 0xd8, 0x89, 0x97, 0x30, 0xbe, 0x8f, 0xfe, 0x67, 0x59, 0x06, 0x33, 0x1e,
+	0xd3, 0x29, 0x68, 0xa3, 0xfd, 0xc6, 0xde, 0x7a, 0x7f, 0x2b, 0x6c, 0xd7, 0xe4, 0xc8, 0x72, 0xbd,
+	0xef, 0x0d, 0xd4, 0x59, 0xf6, 0xc1, 0x31, 0xba, 0x61, 0x6f, 0xc9, 0x00, 0x2e, 0x4b, 0x49, 0x13,
+	0x96, 0x8b, 0x6c, 0xe1, 0x8e, 0xe9, 0x0c, 0x9e, 0xae, 0x7a, 0x8c, 0x85, 0x27, 0xce, 0xe0, 0x85,
+	0xd3, 0x87, 0xdb, 0xf1, 0x32, 0x81, 0x8f, 0xab, 0xc4, 0x8a, 0x27, 0x8a, 0xeb, 0x29, 0x55, 0xcc,
+	0x70, 0xbf, 0xe1, 0x12, 0xdf, 0x21, 0x55, 0x7b, 0xe4, 0x57, 0x7b, 0xe4, 0xa8, 0x6e, 0x77, 0xd8,
+	0xfc, 0xf4, 0xf5, 0x9e, 0xe7, 0x82, 0x86, 0x95, 0x2e, 0x64, 0x86, 0xe3, 0x67, 0x68, 0xd3, 0x06,
+	0xa4, 0xc6, 0x64, 0xfe, 0xfa, 0x6a, 0x16, 0x1b, 0x56, 0x70, 0x6e, 0x32, 0x7c, 0x80, 0xb6, 0x72,
+	0x36, 0xaf, 0x0b, 0x6a, 0x3a, 0xf1, 0xdd, 0xbf, 0xc4, 0xe3, 0xe3, 0xc2, 0xec, 0x0f, 0x2e, 0x58,
+	0x56, 0xf2, 0x70, 0x33, 0x67, 0xf3, 0xaa, 0xba, 0xc7, 0x68, 0xfb, 0x8f, 0x94, 0x78, 0x13, 0x35,
+	0x9f, 0x8f, 0xcf, 0xcf, 0xba, 0x6b, 0xb8, 0x85, 0x36, 0x2e, 0x1e, 0xd1, 0xb3, 0xd3, 0x93, 0x37,
+	0x5d, 0xcf, 0x81, 0x27, 0x15, 0x68, 0xf4, 0x3e, 0x7a, 0xa8, 0xf3, 0x92, 0xab, 0x10, 0x4a, 0x73,
+	0xf5, 0xa5, 0xda, 0x2e, 0x80, 0xe2, 0x33, 0x25, 0x0c, 0x77, 0x65, 0x6f, 0x8d, 0xd6, 0xc2, 0x96,
+	0x65, 0xc3, 0x8a, 0xc4, 0x07, 0xc8, 0x67, 0xa5, 0x01, 0xfa, 0xfb, 0x26, 0x9d, 0x72, 0x16, 0x73,
+	0xe5, 0x37, 0x6a, 0xc1, 0x2d, 0xbb, 0x31, 0xba, 0x12, 0x8d, 0xdc, 0x78, 0xe8, 0xa3, 0xdb, 0x4b,
+	0x2a, 0x2d, 0x79, 0x24, 0x12, 0xc1, 0xd5, 0xf0, 0xf5, 0x97, 0x1f, 0x4d, 0xef, 0xf3, 0xb7, 0x5d,
+	0xef, 0xed, 0xe9, 0x6a, 0xcf, 0x4f, 0x5e, 0xa6, 0x2b, 0x3d, 0xc1, 0xc9, 0x7f, 0xae, 0xbd, 0xfd,
+	0x9f, 0x03, 0x00, 0xdd, 0x7b, 0xa9, 0xd2, 0xd7, 0x03, 0x00, 0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DnsCacheConfig.Equal(that1.DnsCacheConfig) {
+		return false
+	}
+	if len(this.AllowedHosts) != len(that1.AllowedHosts) {
+		return false
+	}
+	for i := range this.AllowedHosts {
+		if this.AllowedHosts[i] != that1.AllowedHosts[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DnsCacheConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DnsCacheConfig)
+	if !ok {
+		that2, ok := that.(DnsCacheConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DnsLookupFamily != that1.DnsLookupFamily {
+		return false
+	}
+	if this.DnsRefreshRate != nil && that1.DnsRefreshRate != nil {
+		if *this.DnsRefreshRate != *that1.DnsRefreshRate {
+			return false
+		}
+	} else if this.DnsRefreshRate != nil {
+		return false
+	} else if that1.DnsRefreshRate != nil {
+		return false
+	}
+	if this.HostTtl != nil && that1.HostTtl != nil {
+		if *this.HostTtl != *that1.HostTtl {
+			return false
+		}
+	} else if this.HostTtl != nil {
+		return false
+	} else if that1.HostTtl != nil {
+		return false
+	}
+	if !this.MaxHosts.Equal(that1.MaxHosts) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PerRouteConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PerRouteConfig)
+	if !ok {
+		that2, ok := that.(PerRouteConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.HostRewriteSpecifier == nil {
+		if this.HostRewriteSpecifier != nil {
+			return false
+		}
+	} else if this.HostRewriteSpecifier == nil {
+		return false
+	} else if !this.HostRewriteSpecifier.Equal(that1.HostRewriteSpecifier) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PerRouteConfig_HostRewrite) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PerRouteConfig_HostRewrite)
+	if !ok {
+		that2, ok := that.(PerRouteConfig_HostRewrite)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HostRewrite != that1.HostRewrite {
+		return false
+	}
+	return true
+}
+func (this *PerRouteConfig_AutoHostRewriteHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PerRouteConfig_AutoHostRewriteHeader)
+	if !ok {
+		that2, ok := that.(PerRouteConfig_AutoHostRewriteHeader)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AutoHostRewriteHeader != that1.AutoHostRewriteHeader {
+		return false
+	}
+	return true
+}
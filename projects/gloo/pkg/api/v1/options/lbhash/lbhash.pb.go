@@ -6,13 +6,12 @@ package lbhash
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-	time "time"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	math "math"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -27,6 +26,32 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// The hashing load balancers that sticky sessions can use.
+type StickySession_HashingLoadBalancer int32
+
+const (
+	StickySession_RING_HASH StickySession_HashingLoadBalancer = 0
+	StickySession_MAGLEV    StickySession_HashingLoadBalancer = 1
+)
+
+var StickySession_HashingLoadBalancer_name = map[int32]string{
+	0: "RING_HASH",
+	1: "MAGLEV",
+}
+
+var StickySession_HashingLoadBalancer_value = map[string]int32{
+	"RING_HASH": 0,
+	"MAGLEV":    1,
+}
+
+func (x StickySession_HashingLoadBalancer) String() string {
+	return proto.EnumName(StickySession_HashingLoadBalancer_name, int32(x))
+}
+
+func (StickySession_HashingLoadBalancer) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6236bb5504a063ef, []int{3, 0}
+}
+
 // Specifies the route’s hashing policy if the upstream cluster uses a hashing load balancer.
 // https://www.envoyproxy.io/docs/envoy/latest/api-v2/api/v2/route/route.proto#envoy-api-msg-route-routeaction-hashpolicy
 type RouteActionHashConfig struct {
@@ -233,10 +258,82 @@ func (*HashPolicy) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// Sticky sessions send the requests of a client to the same upstream host, using a cookie that Envoy generates when
+// the request does not have it. Unlike a cookie hash policy, the sticky session of an upstream configures both sides of
+// hashing: the hashing load balancer of the upstream, and the hash policy of its routes.
+type StickySession struct {
+	// The name of the cookie. Defaults to `gloo-session`.
+	CookieName string `protobuf:"bytes,1,opt,name=cookie_name,json=cookieName,proto3" json:"cookie_name,omitempty"`
+	// The TTL of the generated cookie. If unset or zero, the generated cookie is a session cookie.
+	Ttl *time.Duration `protobuf:"bytes,2,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+	// The path of the generated cookie. If no path is specified here, no path will be set for the cookie.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// The load balancer of an upstream with the sticky session that does not specify a load balancer type.
+	// Defaults to ring hash.
+	LoadBalancer         StickySession_HashingLoadBalancer `protobuf:"varint,4,opt,name=load_balancer,json=loadBalancer,proto3,enum=lbhash.options.gloo.solo.io.StickySession_HashingLoadBalancer" json:"load_balancer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *StickySession) Reset()         { *m = StickySession{} }
+func (m *StickySession) String() string { return proto.CompactTextString(m) }
+func (*StickySession) ProtoMessage()    {}
+func (*StickySession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6236bb5504a063ef, []int{3}
+}
+func (m *StickySession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StickySession.Unmarshal(m, b)
+}
+func (m *StickySession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StickySession.Marshal(b, m, deterministic)
+}
+func (m *StickySession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StickySession.Merge(m, src)
+}
+func (m *StickySession) XXX_Size() int {
+	return xxx_messageInfo_StickySession.Size(m)
+}
+func (m *StickySession) XXX_DiscardUnknown() {
+	xxx_messageInfo_StickySession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StickySession proto.InternalMessageInfo
+
+func (m *StickySession) GetCookieName() string {
+	if m != nil {
+		return m.CookieName
+	}
+	return ""
+}
+
+func (m *StickySession) GetTtl() *time.Duration {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+func (m *StickySession) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *StickySession) GetLoadBalancer() StickySession_HashingLoadBalancer {
+	if m != nil {
+		return m.LoadBalancer
+	}
+	return StickySession_RING_HASH
+}
+
 func init() {
+	proto.RegisterEnum("lbhash.options.gloo.solo.io.StickySession_HashingLoadBalancer", StickySession_HashingLoadBalancer_name, StickySession_HashingLoadBalancer_value)
 	proto.RegisterType((*RouteActionHashConfig)(nil), "lbhash.options.gloo.solo.io.RouteActionHashConfig")
 	proto.RegisterType((*Cookie)(nil), "lbhash.options.gloo.solo.io.Cookie")
 	proto.RegisterType((*HashPolicy)(nil), "lbhash.options.gloo.solo.io.HashPolicy")
+	proto.RegisterType((*StickySession)(nil), "lbhash.options.gloo.solo.io.StickySession")
 }
 
 func init() {
@@ -244,32 +341,38 @@ func init() {
 }
 
 var fileDescriptor_6236bb5504a063ef = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
-	0x14, 0x5c, 0xd3, 0x28, 0xb4, 0x5e, 0xb8, 0x58, 0x20, 0x85, 0x22, 0x96, 0xaa, 0x1c, 0xe8, 0x05,
-	0x5b, 0xbb, 0x9c, 0x11, 0xa2, 0xcb, 0x21, 0x2b, 0x38, 0xa0, 0x88, 0x13, 0x97, 0x95, 0x93, 0xbe,
-	0x3a, 0xa6, 0x69, 0x9e, 0x65, 0x3b, 0xd0, 0xfe, 0x09, 0x9f, 0x80, 0xc4, 0x0f, 0xf0, 0x37, 0x48,
-	0xfc, 0x03, 0x77, 0xe4, 0x38, 0x85, 0x0b, 0x54, 0x7b, 0xca, 0x1b, 0xbf, 0x99, 0xf1, 0x4c, 0x64,
-	0x9a, 0x2b, 0xed, 0xeb, 0xae, 0xe4, 0x15, 0x6e, 0x85, 0xc3, 0x06, 0x9f, 0x69, 0x14, 0xaa, 0x41,
-	0x14, 0xc6, 0xe2, 0x47, 0xa8, 0xbc, 0x8b, 0x48, 0x1a, 0x2d, 0x3e, 0x9d, 0x0b, 0x34, 0x5e, 0x63,
-	0xeb, 0x44, 0x53, 0xd6, 0xd2, 0xd5, 0xc3, 0x87, 0x1b, 0x8b, 0x1e, 0xd9, 0xc3, 0x01, 0x0d, 0x1c,
-	0x1e, 0x74, 0x3c, 0x58, 0x72, 0x8d, 0xd3, 0x7b, 0x0a, 0x15, 0xf6, 0x3c, 0x11, 0xa6, 0x28, 0x99,
-	0x9e, 0x29, 0x44, 0xd5, 0x80, 0xe8, 0x51, 0xd9, 0xad, 0xc5, 0x67, 0x2b, 0x8d, 0x01, 0xeb, 0xfe,
-	0xb7, 0x5f, 0x75, 0x56, 0x06, 0xf7, 0x61, 0xcf, 0x60, 0xe7, 0xa3, 0x29, 0xec, 0x7c, 0x3c, 0x9b,
-	0x03, 0xbd, 0x5f, 0x60, 0xe7, 0xe1, 0x55, 0x15, 0x88, 0xb9, 0x74, 0xf5, 0x25, 0xb6, 0x6b, 0xad,
-	0xd8, 0x5b, 0x7a, 0x37, 0xe4, 0xbb, 0x36, 0xd8, 0xe8, 0x4a, 0x83, 0xcb, 0xc8, 0x6c, 0xb4, 0x38,
-	0xbd, 0x78, 0xca, 0x8f, 0xe4, 0xe6, 0x41, 0xff, 0x2e, 0x08, 0xf6, 0xc5, 0x9d, 0xfa, 0x30, 0x6b,
-	0x70, 0xf3, 0x8a, 0xa6, 0x97, 0x88, 0x1b, 0x0d, 0x8c, 0xd1, 0xa4, 0x95, 0x5b, 0xc8, 0xc8, 0x8c,
-	0x2c, 0x26, 0x45, 0x3f, 0xb3, 0x73, 0x3a, 0xf2, 0xbe, 0xc9, 0x6e, 0xcd, 0xc8, 0xe2, 0xf4, 0xe2,
-	0x01, 0x8f, 0x35, 0xf8, 0xa1, 0x06, 0x7f, 0x3d, 0xd4, 0x58, 0x26, 0x5f, 0x7e, 0x3c, 0x26, 0x45,
-	0xe0, 0x06, 0x1b, 0x23, 0x7d, 0x9d, 0x8d, 0xa2, 0x4d, 0x98, 0xe7, 0xdf, 0x08, 0xa5, 0x7f, 0x13,
-	0xb0, 0x8c, 0xa6, 0x35, 0xc8, 0x15, 0xd8, 0x78, 0x57, 0x7e, 0x52, 0x0c, 0x98, 0xbd, 0xa0, 0x69,
-	0xd5, 0xa7, 0x19, 0xae, 0x7c, 0x72, 0xb4, 0x54, 0x0c, 0x1e, 0xe4, 0x51, 0xc4, 0x1e, 0xd1, 0x89,
-	0xc3, 0xce, 0x56, 0x70, 0xad, 0x4d, 0x1f, 0x60, 0x9c, 0x9f, 0x14, 0xe3, 0x78, 0x74, 0x65, 0xd8,
-	0x94, 0x8e, 0x3d, 0xd8, 0xad, 0x6e, 0x65, 0x93, 0x25, 0x61, 0x5b, 0xfc, 0xc1, 0xcb, 0x09, 0xbd,
-	0xfd, 0x06, 0xf6, 0xef, 0xf7, 0x06, 0x96, 0x57, 0xdf, 0x7f, 0x25, 0xe4, 0xeb, 0xcf, 0x33, 0xf2,
-	0xe1, 0xe5, 0xcd, 0x1e, 0x95, 0xd9, 0xa8, 0x7f, 0x3f, 0xac, 0x32, 0xed, 0x7f, 0xd5, 0xf3, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x62, 0xbe, 0x44, 0xbc, 0x9e, 0x02, 0x00, 0x00,
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xad, 0x9b, 0x28, 0x24, 0x93, 0x06, 0x55, 0x0b, 0x48, 0x26, 0x88, 0x36, 0x0a, 0x07, 0x72,
+	0xc1, 0xa6, 0xe1, 0x0c, 0x28, 0x29, 0xa8, 0x8e, 0x08, 0x15, 0x72, 0x10, 0x07, 0x2e, 0xd1, 0x66,
+	0xb3, 0xb5, 0x97, 0x6c, 0x3c, 0x2b, 0xef, 0x1a, 0x9a, 0x9f, 0xe0, 0xcc, 0x27, 0x20, 0xf1, 0x03,
+	0xfc, 0x0d, 0x12, 0xff, 0xc0, 0x1d, 0xad, 0xd7, 0x85, 0x22, 0x95, 0x88, 0x03, 0x27, 0xcf, 0x9b,
+	0x99, 0xf7, 0xe6, 0xcd, 0x58, 0x0b, 0x51, 0x22, 0x4c, 0x5a, 0x2c, 0x02, 0x86, 0xeb, 0x50, 0xa3,
+	0xc4, 0x07, 0x02, 0xc3, 0x44, 0x22, 0x86, 0x2a, 0xc7, 0x77, 0x9c, 0x19, 0xed, 0x10, 0x55, 0x22,
+	0x7c, 0x7f, 0x14, 0xa2, 0x32, 0x02, 0x33, 0x1d, 0xca, 0x45, 0x4a, 0x75, 0x5a, 0x7d, 0x02, 0x95,
+	0xa3, 0x41, 0x72, 0xa7, 0x42, 0x55, 0x4f, 0x60, 0x79, 0x81, 0x95, 0x0c, 0x04, 0x76, 0x6f, 0x26,
+	0x98, 0x60, 0xd9, 0x17, 0xda, 0xc8, 0x51, 0xba, 0x07, 0x09, 0x62, 0x22, 0x79, 0x58, 0xa2, 0x45,
+	0x71, 0x16, 0x7e, 0xc8, 0xa9, 0x52, 0x3c, 0xd7, 0x7f, 0xab, 0x2f, 0x8b, 0x9c, 0x5a, 0xf5, 0xaa,
+	0x4e, 0xf8, 0xb9, 0x71, 0xa2, 0xfc, 0xdc, 0xb8, 0x5c, 0x9f, 0xc3, 0xad, 0x18, 0x0b, 0xc3, 0x47,
+	0xcc, 0x36, 0x46, 0x54, 0xa7, 0xc7, 0x98, 0x9d, 0x89, 0x84, 0x4c, 0xa1, 0x63, 0xfd, 0xcd, 0x15,
+	0x4a, 0xc1, 0x04, 0xd7, 0xbe, 0xd7, 0xab, 0x0d, 0xda, 0xc3, 0xfb, 0xc1, 0x16, 0xdf, 0x81, 0xe5,
+	0xbf, 0xb2, 0x84, 0x4d, 0xbc, 0x97, 0x5e, 0xc4, 0x82, 0xeb, 0x3e, 0x83, 0xc6, 0x31, 0xe2, 0x4a,
+	0x70, 0x42, 0xa0, 0x9e, 0xd1, 0x35, 0xf7, 0xbd, 0x9e, 0x37, 0x68, 0xc5, 0x65, 0x4c, 0x8e, 0xa0,
+	0x66, 0x8c, 0xf4, 0x77, 0x7b, 0xde, 0xa0, 0x3d, 0xbc, 0x1d, 0xb8, 0x35, 0x82, 0x8b, 0x35, 0x82,
+	0x67, 0xd5, 0x1a, 0xe3, 0xfa, 0xa7, 0x6f, 0x87, 0x5e, 0x6c, 0x7b, 0xad, 0x8c, 0xa2, 0x26, 0xf5,
+	0x6b, 0x4e, 0xc6, 0xc6, 0xfd, 0x2f, 0x1e, 0xc0, 0x6f, 0x07, 0xc4, 0x87, 0x46, 0xca, 0xe9, 0x92,
+	0xe7, 0x6e, 0x56, 0xb4, 0x13, 0x57, 0x98, 0x3c, 0x86, 0x06, 0x2b, 0xdd, 0x54, 0x23, 0xef, 0x6d,
+	0x5d, 0xca, 0x19, 0xb7, 0x74, 0x47, 0x22, 0x77, 0xa1, 0xa5, 0xb1, 0xc8, 0x19, 0x9f, 0x0b, 0x55,
+	0x1a, 0x68, 0x46, 0x3b, 0x71, 0xd3, 0xa5, 0x26, 0x8a, 0x74, 0xa1, 0x69, 0x78, 0xbe, 0x16, 0x19,
+	0x95, 0x7e, 0xdd, 0x56, 0xe3, 0x5f, 0x78, 0xdc, 0x82, 0x6b, 0x2f, 0xf8, 0xe6, 0xf5, 0x46, 0xf1,
+	0xfe, 0xc7, 0x5d, 0xe8, 0xcc, 0x8c, 0x60, 0xab, 0xcd, 0x8c, 0x6b, 0x2d, 0x30, 0x23, 0x87, 0xd0,
+	0x76, 0x13, 0xe6, 0x97, 0x2e, 0x04, 0x2e, 0x75, 0xfa, 0xff, 0xee, 0x44, 0x18, 0x74, 0x24, 0xd2,
+	0xe5, 0x7c, 0x41, 0x25, 0xcd, 0x18, 0xcf, 0x4b, 0x97, 0xd7, 0x87, 0x4f, 0xb6, 0x5e, 0xe1, 0x0f,
+	0xab, 0xe5, 0x8f, 0x16, 0x59, 0x32, 0x45, 0xba, 0x1c, 0x57, 0x2a, 0xf1, 0x9e, 0xbc, 0x84, 0xfa,
+	0x0f, 0xe1, 0xc6, 0x15, 0x4d, 0xa4, 0x03, 0xad, 0x78, 0x72, 0x7a, 0x32, 0x8f, 0x46, 0xb3, 0x68,
+	0x7f, 0x87, 0x00, 0x34, 0x5e, 0x8e, 0x4e, 0xa6, 0xcf, 0xdf, 0xec, 0x7b, 0xe3, 0xc9, 0xd7, 0x1f,
+	0x75, 0xef, 0xf3, 0xf7, 0x03, 0xef, 0xed, 0xd3, 0x7f, 0x7b, 0x65, 0x6a, 0x95, 0x5c, 0xfd, 0xd2,
+	0x16, 0x8d, 0xf2, 0x26, 0x8f, 0x7e, 0x0e, 0x00, 0xbb, 0x79, 0x45, 0x93, 0xaf, 0x03, 0x00, 0x00,
 }
 
 func (this *RouteActionHashConfig) Equal(that interface{}) bool {
@@ -451,3 +554,45 @@ func (this *HashPolicy_SourceIp) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *StickySession) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StickySession)
+	if !ok {
+		that2, ok := that.(StickySession)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CookieName != that1.CookieName {
+		return false
+	}
+	if this.Ttl != nil && that1.Ttl != nil {
+		if *this.Ttl != *that1.Ttl {
+			return false
+		}
+	} else if this.Ttl != nil {
+		return false
+	} else if that1.Ttl != nil {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.LoadBalancer != that1.LoadBalancer {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
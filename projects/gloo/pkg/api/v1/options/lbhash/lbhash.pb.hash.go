@@ -150,3 +150,46 @@ func (m *HashPolicy) Hash(hasher hash.Hash64) (uint64, error) {
 
 	return hasher.Sum64(), nil
 }
+
+// Hash function
+func (m *StickySession) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("lbhash.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash.StickySession")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetCookieName())); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetTtl()).(safe_hasher.SafeHasher); ok {
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if val, err := hashstructure.Hash(m.GetTtl(), nil); err != nil {
+			return 0, err
+		} else {
+			if err := binary.Write(hasher, binary.LittleEndian, val); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if _, err = hasher.Write([]byte(m.GetPath())); err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetLoadBalancer())
+	if err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
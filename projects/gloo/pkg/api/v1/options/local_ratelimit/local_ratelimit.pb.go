@@ -0,0 +1,261 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/local_ratelimit/local_ratelimit.proto
+
+package local_ratelimit
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	headers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Limits the rate of requests in Envoy itself with a token bucket, without an external rate limit server.
+// Each Envoy instance keeps its own token buckets, so the limit applies to every gateway replica separately.
+// LocalRateLimit can be set on http listeners, virtual hosts and routes. A limit on a virtual host applies to
+// its routes instead of the listener's, and a limit on a route applies instead of its virtual host's.
+// Routes with their own limit also get their own token bucket.
+type LocalRateLimit struct {
+	// The token bucket requests are limited with. Each request takes one token; requests which arrive
+	// while the bucket is empty are rejected. Required.
+	TokenBucket *TokenBucket `protobuf:"bytes,1,opt,name=token_bucket,json=tokenBucket,proto3" json:"token_bucket,omitempty"`
+	// The HTTP status code of the response to rate limited requests. Defaults to 429 (Too Many Requests).
+	// Must be a valid status code of at least 400.
+	StatusCode uint32 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Headers to add to the responses to rate limited requests. At most 10 headers can be added.
+	ResponseHeadersToAdd []*headers.HeaderValueOption `protobuf:"bytes,3,rep,name=response_headers_to_add,json=responseHeadersToAdd,proto3" json:"response_headers_to_add,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *LocalRateLimit) Reset()         { *m = LocalRateLimit{} }
+func (m *LocalRateLimit) String() string { return proto.CompactTextString(m) }
+func (*LocalRateLimit) ProtoMessage()    {}
+func (*LocalRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e4ccbc4ef07400f, []int{0}
+}
+func (m *LocalRateLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocalRateLimit.Unmarshal(m, b)
+}
+func (m *LocalRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocalRateLimit.Marshal(b, m, deterministic)
+}
+func (m *LocalRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalRateLimit.Merge(m, src)
+}
+func (m *LocalRateLimit) XXX_Size() int {
+	return xxx_messageInfo_LocalRateLimit.Size(m)
+}
+func (m *LocalRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalRateLimit proto.InternalMessageInfo
+
+func (m *LocalRateLimit) GetTokenBucket() *TokenBucket {
+	if m != nil {
+		return m.TokenBucket
+	}
+	return nil
+}
+
+func (m *LocalRateLimit) GetStatusCode() uint32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *LocalRateLimit) GetResponseHeadersToAdd() []*headers.HeaderValueOption {
+	if m != nil {
+		return m.ResponseHeadersToAdd
+	}
+	return nil
+}
+
+// A bucket which holds up to `max_tokens` tokens, and is refilled with `tokens_per_fill` tokens every `fill_interval`.
+type TokenBucket struct {
+	// The maximum number of tokens in the bucket, which is also the number of tokens it starts with.
+	// This is the size of the largest burst of requests which is allowed. Must be greater than 0.
+	MaxTokens uint32 `protobuf:"varint,1,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	// The number of tokens added to the bucket on each fill. Defaults to 1.
+	TokensPerFill *types.UInt32Value `protobuf:"bytes,2,opt,name=tokens_per_fill,json=tokensPerFill,proto3" json:"tokens_per_fill,omitempty"`
+	// How often tokens are added to the bucket. Must be at least 50ms. Required.
+	FillInterval         *types.Duration `protobuf:"bytes,3,opt,name=fill_interval,json=fillInterval,proto3" json:"fill_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TokenBucket) Reset()         { *m = TokenBucket{} }
+func (m *TokenBucket) String() string { return proto.CompactTextString(m) }
+func (*TokenBucket) ProtoMessage()    {}
+func (*TokenBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e4ccbc4ef07400f, []int{1}
+}
+func (m *TokenBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenBucket.Unmarshal(m, b)
+}
+func (m *TokenBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenBucket.Marshal(b, m, deterministic)
+}
+func (m *TokenBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenBucket.Merge(m, src)
+}
+func (m *TokenBucket) XXX_Size() int {
+	return xxx_messageInfo_TokenBucket.Size(m)
+}
+func (m *TokenBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenBucket proto.InternalMessageInfo
+
+func (m *TokenBucket) GetMaxTokens() uint32 {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return 0
+}
+
+func (m *TokenBucket) GetTokensPerFill() *types.UInt32Value {
+	if m != nil {
+		return m.TokensPerFill
+	}
+	return nil
+}
+
+func (m *TokenBucket) GetFillInterval() *types.Duration {
+	if m != nil {
+		return m.FillInterval
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LocalRateLimit)(nil), "local_ratelimit.options.gloo.solo.io.LocalRateLimit")
+	proto.RegisterType((*TokenBucket)(nil), "local_ratelimit.options.gloo.solo.io.TokenBucket")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/options/local_ratelimit/local_ratelimit.proto", fileDescriptor_6e4ccbc4ef07400f)
+}
+
+var fileDescriptor_6e4ccbc4ef07400f = []byte{
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6a, 0x14, 0x41,
+	0x10, 0x65, 0x5c, 0x11, 0xec, 0xc9, 0x2a, 0x0c, 0x01, 0xd7, 0x45, 0xe3, 0x12, 0x3c, 0xec, 0xc5,
+	0x6e, 0xb2, 0x39, 0x78, 0x13, 0x8c, 0x41, 0x12, 0x08, 0x28, 0xc3, 0xea, 0x21, 0x97, 0xa6, 0x77,
+	0xa6, 0x76, 0xd2, 0x6e, 0xef, 0x54, 0xd3, 0x5d, 0x13, 0xf7, 0x93, 0x3c, 0x7b, 0xf2, 0x7b, 0xfc,
+	0x01, 0x4f, 0xde, 0xa5, 0xbb, 0x67, 0x55, 0xa2, 0x21, 0x39, 0xcd, 0x54, 0xbd, 0x7a, 0xaf, 0xde,
+	0xa3, 0x9a, 0x9d, 0x37, 0x9a, 0x2e, 0xba, 0x05, 0xaf, 0x70, 0x2d, 0x3c, 0x1a, 0x7c, 0xa1, 0x51,
+	0x34, 0x06, 0x51, 0x58, 0x87, 0x9f, 0xa0, 0x22, 0x9f, 0x2a, 0x65, 0xb5, 0xb8, 0x3c, 0x10, 0x68,
+	0x49, 0x63, 0xeb, 0x85, 0xc1, 0x4a, 0x19, 0xe9, 0x14, 0x81, 0xd1, 0x6b, 0x4d, 0x57, 0x6b, 0x6e,
+	0x1d, 0x12, 0x16, 0xcf, 0xaf, 0xb6, 0x7b, 0x3a, 0x0f, 0x92, 0x3c, 0x6c, 0xe3, 0x1a, 0xc7, 0xbb,
+	0x0d, 0x36, 0x18, 0x09, 0x22, 0xfc, 0x25, 0xee, 0xb8, 0x80, 0x0d, 0xa5, 0x26, 0x6c, 0x7a, 0xbd,
+	0xf1, 0x5e, 0x83, 0xd8, 0x18, 0x10, 0xb1, 0x5a, 0x74, 0x4b, 0x51, 0x77, 0x4e, 0x05, 0xc5, 0xeb,
+	0xf0, 0xcf, 0x4e, 0x59, 0x0b, 0xce, 0xf7, 0xf8, 0xcb, 0x9b, 0x83, 0x5d, 0x80, 0xaa, 0xc1, 0xfd,
+	0xfe, 0x26, 0xe2, 0xfe, 0x8f, 0x8c, 0x3d, 0x38, 0x0b, 0x59, 0x4a, 0x45, 0x70, 0x16, 0xa2, 0x14,
+	0x73, 0xb6, 0x43, 0xb8, 0x82, 0x56, 0x2e, 0xba, 0x6a, 0x05, 0x34, 0xca, 0x26, 0xd9, 0x34, 0x9f,
+	0x1d, 0xf0, 0xdb, 0x44, 0xe6, 0xf3, 0xc0, 0x3c, 0x8a, 0xc4, 0x32, 0xa7, 0x3f, 0x45, 0xf1, 0x8c,
+	0xe5, 0x9e, 0x14, 0x75, 0x5e, 0x56, 0x58, 0xc3, 0xe8, 0xce, 0x24, 0x9b, 0x0e, 0x4b, 0x96, 0x5a,
+	0x6f, 0xb0, 0x86, 0x62, 0xc9, 0x1e, 0x39, 0xf0, 0x16, 0x5b, 0x0f, 0xb2, 0xf7, 0x28, 0x09, 0xa5,
+	0xaa, 0xeb, 0xd1, 0x60, 0x32, 0x98, 0xe6, 0x33, 0xc1, 0xb7, 0xd6, 0xff, 0xbb, 0xf9, 0x24, 0x82,
+	0x1f, 0x95, 0xe9, 0xe0, 0x5d, 0xc4, 0xcb, 0xdd, 0xad, 0x5e, 0x82, 0xfc, 0x1c, 0x5f, 0xd7, 0xf5,
+	0xfe, 0xd7, 0x8c, 0xe5, 0x7f, 0xb9, 0x2c, 0x9e, 0x32, 0xb6, 0x56, 0x1b, 0x19, 0xbd, 0xfa, 0x18,
+	0x76, 0x58, 0xde, 0x5f, 0xab, 0x4d, 0x9c, 0xf1, 0xc5, 0x31, 0x7b, 0x98, 0x20, 0x69, 0xc1, 0xc9,
+	0xa5, 0x36, 0x26, 0x7a, 0xcf, 0x67, 0x4f, 0x78, 0xba, 0x09, 0xdf, 0xde, 0x84, 0x7f, 0x38, 0x6d,
+	0xe9, 0x70, 0x16, 0x1d, 0x94, 0xc3, 0x44, 0x7a, 0x0f, 0xee, 0xad, 0x36, 0xa6, 0x78, 0xc5, 0x86,
+	0x81, 0x2a, 0x75, 0x4b, 0xe0, 0x2e, 0x95, 0x19, 0x0d, 0xa2, 0xc6, 0xe3, 0x7f, 0x34, 0x8e, 0xfb,
+	0xbb, 0x97, 0x3b, 0x61, 0xfe, 0xb4, 0x1f, 0x3f, 0x2a, 0xbf, 0xfd, 0xbc, 0x9b, 0x7d, 0xf9, 0xbe,
+	0x97, 0x9d, 0x9f, 0xdc, 0xee, 0x55, 0xdb, 0x55, 0x73, 0xc3, 0xcb, 0x5e, 0xdc, 0x8b, 0x4b, 0x0f,
+	0x7f, 0x0d, 0x00, 0xc6, 0xfe, 0xa8, 0x54, 0x28, 0x03, 0x00, 0x00,
+}
+
+func (this *LocalRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LocalRateLimit)
+	if !ok {
+		that2, ok := that.(LocalRateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TokenBucket.Equal(that1.TokenBucket) {
+		return false
+	}
+	if this.StatusCode != that1.StatusCode {
+		return false
+	}
+	if len(this.ResponseHeadersToAdd) != len(that1.ResponseHeadersToAdd) {
+		return false
+	}
+	for i := range this.ResponseHeadersToAdd {
+		if !this.ResponseHeadersToAdd[i].Equal(that1.ResponseHeadersToAdd[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *TokenBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenBucket)
+	if !ok {
+		that2, ok := that.(TokenBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxTokens != that1.MaxTokens {
+		return false
+	}
+	if !this.TokensPerFill.Equal(that1.TokensPerFill) {
+		return false
+	}
+	if !this.FillInterval.Equal(that1.FillInterval) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
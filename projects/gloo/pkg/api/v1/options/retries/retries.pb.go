@@ -6,13 +6,12 @@ package retries
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-	time "time"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	math "math"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -36,10 +35,22 @@ type RetryPolicy struct {
 	// defaults to 1. These are the same conditions [documented for Envoy](https://www.envoyproxy.io/docs/envoy/v1.14.1/configuration/http/http_filters/router_filter#config-http-filters-router-x-envoy-retry-on)
 	NumRetries uint32 `protobuf:"varint,2,opt,name=num_retries,json=numRetries,proto3" json:"num_retries,omitempty"`
 	// Specifies a non-zero upstream timeout per retry attempt. This parameter is optional.
-	PerTryTimeout        *time.Duration `protobuf:"bytes,3,opt,name=per_try_timeout,json=perTryTimeout,proto3,stdduration" json:"per_try_timeout,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	// If the route has a timeout, the per try timeout must not be greater than it.
+	PerTryTimeout *time.Duration `protobuf:"bytes,3,opt,name=per_try_timeout,json=perTryTimeout,proto3,stdduration" json:"per_try_timeout,omitempty"`
+	// Specifies how long to wait between retries. If not set, Envoy uses a base interval of 25ms
+	// and a max interval of 250ms.
+	RetryBackOff *RetryBackOff `protobuf:"bytes,4,opt,name=retry_back_off,json=retryBackOff,proto3" json:"retry_back_off,omitempty"`
+	// HTTP status codes which should trigger a retry, in addition to those specified by `retry_on`.
+	// `retry_on` must include `retriable-status-codes` for these to take effect.
+	RetriableStatusCodes []uint32 `protobuf:"varint,5,rep,packed,name=retriable_status_codes,json=retriableStatusCodes,proto3" json:"retriable_status_codes,omitempty"`
+	// Avoid retrying requests on hosts which were already attempted for the same request.
+	AvoidPreviousHosts bool `protobuf:"varint,6,opt,name=avoid_previous_hosts,json=avoidPreviousHosts,proto3" json:"avoid_previous_hosts,omitempty"`
+	// The maximum number of times to select another host when the selected host was already attempted,
+	// before retrying on it anyways. Only used if `avoid_previous_hosts` is true. Defaults to 1.
+	HostSelectionRetryMaxAttempts uint32   `protobuf:"varint,7,opt,name=host_selection_retry_max_attempts,json=hostSelectionRetryMaxAttempts,proto3" json:"host_selection_retry_max_attempts,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
+	XXX_unrecognized              []byte   `json:"-"`
+	XXX_sizecache                 int32    `json:"-"`
 }
 
 func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
@@ -87,8 +98,89 @@ func (m *RetryPolicy) GetPerTryTimeout() *time.Duration {
 	return nil
 }
 
+func (m *RetryPolicy) GetRetryBackOff() *RetryBackOff {
+	if m != nil {
+		return m.RetryBackOff
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetRetriableStatusCodes() []uint32 {
+	if m != nil {
+		return m.RetriableStatusCodes
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetAvoidPreviousHosts() bool {
+	if m != nil {
+		return m.AvoidPreviousHosts
+	}
+	return false
+}
+
+func (m *RetryPolicy) GetHostSelectionRetryMaxAttempts() uint32 {
+	if m != nil {
+		return m.HostSelectionRetryMaxAttempts
+	}
+	return 0
+}
+
+// Exponential back off between retries. The interval before a retry is chosen randomly
+// between zero and `base_interval * (2^N - 1)`, where N is the number of retries so far,
+// and is capped by `max_interval`.
+type RetryBackOff struct {
+	// The base interval between retries. Must be greater than zero.
+	BaseInterval *time.Duration `protobuf:"bytes,1,opt,name=base_interval,json=baseInterval,proto3,stdduration" json:"base_interval,omitempty"`
+	// The maximum interval between retries. Must not be less than the base interval.
+	// Defaults to 10 times the base interval.
+	MaxInterval          *time.Duration `protobuf:"bytes,2,opt,name=max_interval,json=maxInterval,proto3,stdduration" json:"max_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RetryBackOff) Reset()         { *m = RetryBackOff{} }
+func (m *RetryBackOff) String() string { return proto.CompactTextString(m) }
+func (*RetryBackOff) ProtoMessage()    {}
+func (*RetryBackOff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c06018876f3ed3e, []int{1}
+}
+func (m *RetryBackOff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryBackOff.Unmarshal(m, b)
+}
+func (m *RetryBackOff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryBackOff.Marshal(b, m, deterministic)
+}
+func (m *RetryBackOff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryBackOff.Merge(m, src)
+}
+func (m *RetryBackOff) XXX_Size() int {
+	return xxx_messageInfo_RetryBackOff.Size(m)
+}
+func (m *RetryBackOff) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryBackOff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryBackOff proto.InternalMessageInfo
+
+func (m *RetryBackOff) GetBaseInterval() *time.Duration {
+	if m != nil {
+		return m.BaseInterval
+	}
+	return nil
+}
+
+func (m *RetryBackOff) GetMaxInterval() *time.Duration {
+	if m != nil {
+		return m.MaxInterval
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RetryPolicy)(nil), "retries.options.gloo.solo.io.RetryPolicy")
+	proto.RegisterType((*RetryBackOff)(nil), "retries.options.gloo.solo.io.RetryBackOff")
 }
 
 func init() {
@@ -96,25 +188,37 @@ func init() {
 }
 
 var fileDescriptor_3c06018876f3ed3e = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xcf, 0x4a, 0xf4, 0x30,
-	0x14, 0xc5, 0xc9, 0xf7, 0x0d, 0xfe, 0x49, 0x19, 0x84, 0xe2, 0xa2, 0x33, 0xc8, 0x4c, 0x71, 0xd5,
-	0x8d, 0x09, 0xea, 0x0b, 0xc8, 0x20, 0x08, 0xb3, 0x51, 0xca, 0xac, 0xdc, 0x94, 0x69, 0x8d, 0x31,
-	0xda, 0xe6, 0x86, 0xf4, 0x46, 0xa6, 0xaf, 0xe1, 0xca, 0x47, 0xf0, 0x11, 0x7c, 0x1b, 0xc1, 0x77,
-	0x70, 0x2f, 0x69, 0x3a, 0xee, 0x04, 0x57, 0xb9, 0xe7, 0x70, 0x4e, 0xee, 0x8f, 0x4b, 0x97, 0x52,
-	0xe1, 0x83, 0x2b, 0x59, 0x05, 0x0d, 0x6f, 0xa1, 0x86, 0x13, 0x05, 0x5c, 0xd6, 0x00, 0xdc, 0x58,
-	0x78, 0x14, 0x15, 0xb6, 0x41, 0xad, 0x8d, 0xe2, 0xcf, 0xa7, 0x1c, 0x0c, 0x2a, 0xd0, 0x2d, 0xb7,
-	0x02, 0xad, 0x12, 0x3f, 0x2f, 0x33, 0x16, 0x10, 0xe2, 0xa3, 0xad, 0x1c, 0x62, 0xcc, 0x57, 0x99,
-	0xff, 0x95, 0x29, 0x98, 0xce, 0x24, 0x80, 0xac, 0x05, 0xef, 0xb3, 0xa5, 0xbb, 0xe7, 0x77, 0xce,
-	0xae, 0x7d, 0x2e, 0xb4, 0xa7, 0x87, 0x12, 0x24, 0xf4, 0x23, 0xf7, 0xd3, 0xe0, 0xc6, 0x62, 0x83,
-	0xc1, 0x14, 0x1b, 0x0c, 0xde, 0xf1, 0x0b, 0xa1, 0x51, 0x2e, 0xd0, 0x76, 0x37, 0x50, 0xab, 0xaa,
-	0x8b, 0x27, 0x74, 0xcf, 0x6f, 0xee, 0x0a, 0xd0, 0x09, 0x49, 0x49, 0xb6, 0x9f, 0xef, 0xf6, 0xfa,
-	0x5a, 0xc7, 0x73, 0x1a, 0x69, 0xd7, 0x14, 0x03, 0x58, 0xf2, 0x2f, 0x25, 0xd9, 0x38, 0xa7, 0xda,
-	0x35, 0x79, 0x70, 0xe2, 0x2b, 0x7a, 0x60, 0x84, 0x2d, 0x7c, 0x1b, 0x55, 0x23, 0xc0, 0x61, 0xf2,
-	0x3f, 0x25, 0x59, 0x74, 0x36, 0x61, 0x81, 0x97, 0x6d, 0x79, 0xd9, 0xe5, 0xc0, 0xbb, 0x18, 0xbd,
-	0x7e, 0xcc, 0x49, 0x3e, 0x36, 0xc2, 0xae, 0x6c, 0xb7, 0x0a, 0xad, 0xc5, 0xf2, 0xfd, 0x6b, 0x44,
-	0xde, 0x3e, 0x67, 0xe4, 0xf6, 0xe2, 0x6f, 0x27, 0x35, 0x4f, 0xf2, 0x97, 0xb3, 0x96, 0x3b, 0xfd,
-	0xce, 0xf3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x5d, 0xe3, 0x4f, 0x9d, 0x01, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x96, 0xd7, 0xb2, 0x0d, 0xb7, 0x05, 0xc9, 0xaa, 0x50, 0x36, 0xc1, 0x16, 0x76, 0x15, 0x21,
+	0xe1, 0xf0, 0xf7, 0x00, 0x50, 0x26, 0x31, 0x26, 0xa1, 0x55, 0xd9, 0xae, 0xb8, 0xb1, 0x9c, 0xd4,
+	0xc9, 0x4c, 0x93, 0x9c, 0xc8, 0x76, 0xaa, 0xf4, 0x45, 0xd0, 0x1e, 0x81, 0x47, 0xe0, 0x6d, 0x90,
+	0x78, 0x07, 0xee, 0x91, 0xed, 0xb4, 0xda, 0x0d, 0xa8, 0x57, 0xf6, 0x39, 0xdf, 0x8f, 0xbf, 0x73,
+	0x64, 0x7c, 0x59, 0x48, 0x73, 0xdb, 0xa6, 0x34, 0x83, 0x2a, 0xd6, 0x50, 0xc2, 0x4b, 0x09, 0x71,
+	0x51, 0x02, 0xc4, 0x8d, 0x82, 0x6f, 0x22, 0x33, 0xda, 0x57, 0xbc, 0x91, 0xf1, 0xea, 0x75, 0x0c,
+	0x8d, 0x91, 0x50, 0xeb, 0x58, 0x09, 0xa3, 0xa4, 0xd8, 0x9e, 0xb4, 0x51, 0x60, 0x80, 0x3c, 0xdd,
+	0x94, 0x3d, 0x8d, 0x5a, 0x29, 0xb5, 0xae, 0x54, 0xc2, 0xf1, 0x49, 0x01, 0x50, 0x94, 0x22, 0x76,
+	0xdc, 0xb4, 0xcd, 0xe3, 0x45, 0xab, 0xb8, 0xe5, 0x79, 0xf5, 0xf1, 0xb4, 0x80, 0x02, 0xdc, 0x35,
+	0xb6, 0xb7, 0xbe, 0x4b, 0x44, 0x67, 0x7c, 0x53, 0x74, 0xc6, 0xf7, 0xce, 0xbe, 0x0f, 0xf0, 0x28,
+	0x11, 0x46, 0xad, 0xe7, 0x50, 0xca, 0x6c, 0x4d, 0x8e, 0xf0, 0xa1, 0x7d, 0x79, 0xcd, 0xa0, 0x0e,
+	0x50, 0x88, 0xa2, 0x87, 0xc9, 0x81, 0xab, 0xaf, 0x6a, 0x72, 0x8a, 0x47, 0x75, 0x5b, 0xb1, 0x3e,
+	0x58, 0xb0, 0x17, 0xa2, 0x68, 0x92, 0xe0, 0xba, 0xad, 0x12, 0xdf, 0x21, 0x9f, 0xf0, 0xe3, 0x46,
+	0x28, 0x66, 0xd5, 0x46, 0x56, 0x02, 0x5a, 0x13, 0x0c, 0x42, 0x14, 0x8d, 0xde, 0x1c, 0x51, 0x9f,
+	0x97, 0x6e, 0xf2, 0xd2, 0xf3, 0x3e, 0xef, 0x6c, 0x78, 0xf7, 0xeb, 0x14, 0x25, 0x93, 0x46, 0xa8,
+	0x1b, 0xb5, 0xbe, 0xf1, 0x2a, 0x32, 0xc7, 0x8f, 0x7c, 0x88, 0x94, 0x67, 0x4b, 0x06, 0x79, 0x1e,
+	0x0c, 0x9d, 0xcf, 0x0b, 0xfa, 0xbf, 0xad, 0x50, 0x37, 0xc7, 0x8c, 0x67, 0xcb, 0xab, 0x3c, 0x4f,
+	0xc6, 0xea, 0x5e, 0x45, 0xde, 0xe1, 0x27, 0x4e, 0xca, 0xd3, 0x52, 0x30, 0x6d, 0xb8, 0x69, 0x35,
+	0xcb, 0x60, 0x21, 0x74, 0xf0, 0x20, 0x1c, 0x44, 0x93, 0x64, 0xba, 0x45, 0xaf, 0x1d, 0xf8, 0xd1,
+	0x62, 0xe4, 0x15, 0x9e, 0xf2, 0x15, 0xc8, 0x05, 0x6b, 0x94, 0x58, 0x49, 0x68, 0x35, 0xbb, 0x05,
+	0x6d, 0x74, 0xb0, 0x1f, 0xa2, 0xe8, 0x30, 0x21, 0x0e, 0x9b, 0xf7, 0xd0, 0x85, 0x45, 0xc8, 0x05,
+	0x7e, 0x6e, 0x29, 0x4c, 0x8b, 0x52, 0x64, 0x36, 0x22, 0xf3, 0x83, 0x54, 0xbc, 0x63, 0xdc, 0x18,
+	0x51, 0x35, 0x46, 0x07, 0x07, 0x6e, 0x73, 0xcf, 0x2c, 0xf1, 0x7a, 0xc3, 0x73, 0xd9, 0xbf, 0xf0,
+	0xee, 0x43, 0x4f, 0x3a, 0xbb, 0x43, 0x78, 0x7c, 0x7f, 0x20, 0x72, 0x8e, 0x27, 0x29, 0xd7, 0x82,
+	0xc9, 0xda, 0x08, 0xb5, 0xe2, 0x65, 0x80, 0x76, 0xdb, 0xed, 0xd8, 0xaa, 0x3e, 0xf7, 0x22, 0x32,
+	0xc3, 0x63, 0x9b, 0x65, 0x6b, 0xb2, 0xb7, 0x9b, 0xc9, 0xa8, 0xe2, 0xdd, 0xc6, 0x63, 0x76, 0xf9,
+	0xf3, 0xcf, 0x10, 0xfd, 0xf8, 0x7d, 0x82, 0xbe, 0xbe, 0xdf, 0xed, 0xc7, 0x37, 0xcb, 0xe2, 0x1f,
+	0xbf, 0x3e, 0xdd, 0x77, 0x2f, 0xbe, 0xfd, 0x3b, 0x00, 0xae, 0xeb, 0x0c, 0x47, 0x3c, 0x03, 0x00,
+	0x00,
 }
 
 func (this *RetryPolicy) Equal(that interface{}) bool {
@@ -151,6 +255,65 @@ func (this *RetryPolicy) Equal(that interface{}) bool {
 	} else if that1.PerTryTimeout != nil {
 		return false
 	}
+	if !this.RetryBackOff.Equal(that1.RetryBackOff) {
+		return false
+	}
+	if len(this.RetriableStatusCodes) != len(that1.RetriableStatusCodes) {
+		return false
+	}
+	for i := range this.RetriableStatusCodes {
+		if this.RetriableStatusCodes[i] != that1.RetriableStatusCodes[i] {
+			return false
+		}
+	}
+	if this.AvoidPreviousHosts != that1.AvoidPreviousHosts {
+		return false
+	}
+	if this.HostSelectionRetryMaxAttempts != that1.HostSelectionRetryMaxAttempts {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RetryBackOff) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RetryBackOff)
+	if !ok {
+		that2, ok := that.(RetryBackOff)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BaseInterval != nil && that1.BaseInterval != nil {
+		if *this.BaseInterval != *that1.BaseInterval {
+			return false
+		}
+	} else if this.BaseInterval != nil {
+		return false
+	} else if that1.BaseInterval != nil {
+		return false
+	}
+	if this.MaxInterval != nil && that1.MaxInterval != nil {
+		if *this.MaxInterval != *that1.MaxInterval {
+			return false
+		}
+	} else if this.MaxInterval != nil {
+		return false
+	} else if that1.MaxInterval != nil {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
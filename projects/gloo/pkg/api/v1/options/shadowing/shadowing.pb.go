@@ -6,13 +6,13 @@ package shadowing
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
+	matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -35,10 +35,13 @@ type RouteShadowing struct {
 	// The upstream to which the shadowed traffic should be sent.
 	Upstream *core.ResourceRef `protobuf:"bytes,1,opt,name=upstream,proto3" json:"upstream,omitempty"`
 	// This should be a value between 0.0 and 100.0, with up to 6 significant digits.
-	Percentage           float32  `protobuf:"fixed32,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Percentage float32 `protobuf:"fixed32,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// Additional upstreams to which traffic should be shadowed. Traffic is shadowed to each of them independently.
+	// If `upstream` is also set, traffic is shadowed to it as well.
+	Destinations         []*ShadowDestination `protobuf:"bytes,3,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *RouteShadowing) Reset()         { *m = RouteShadowing{} }
@@ -79,8 +82,89 @@ func (m *RouteShadowing) GetPercentage() float32 {
 	return 0
 }
 
+func (m *RouteShadowing) GetDestinations() []*ShadowDestination {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+// An upstream to which traffic should be shadowed.
+type ShadowDestination struct {
+	// The upstream to which the shadowed traffic should be sent.
+	Upstream *core.ResourceRef `protobuf:"bytes,1,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	// The percentage of the traffic to shadow to the upstream.
+	// This should be a value between 0.0 and 100.0, with up to 6 significant digits.
+	Percentage float32 `protobuf:"fixed32,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// Whether shadowed requests should be sampled for tracing if the original request was sampled.
+	// Defaults to true.
+	TraceSampled *types.BoolValue `protobuf:"bytes,3,opt,name=trace_sampled,json=traceSampled,proto3" json:"trace_sampled,omitempty"`
+	// If set, only requests which match all of these headers are shadowed to the upstream.
+	// Envoy cannot match headers for shadowing, so Gloo adds a copy of the route matching these headers before the
+	// route, which shadows traffic to the destinations with these headers and to the destinations without headers.
+	// A request is shadowed to the destinations of the first of these copies it matches, so at most one set of headers
+	// applies to a request. At most 4 different sets of headers can be used on a route.
+	Headers              []*matchers.HeaderMatcher `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ShadowDestination) Reset()         { *m = ShadowDestination{} }
+func (m *ShadowDestination) String() string { return proto.CompactTextString(m) }
+func (*ShadowDestination) ProtoMessage()    {}
+func (*ShadowDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74006d9a50a86d32, []int{1}
+}
+func (m *ShadowDestination) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShadowDestination.Unmarshal(m, b)
+}
+func (m *ShadowDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShadowDestination.Marshal(b, m, deterministic)
+}
+func (m *ShadowDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShadowDestination.Merge(m, src)
+}
+func (m *ShadowDestination) XXX_Size() int {
+	return xxx_messageInfo_ShadowDestination.Size(m)
+}
+func (m *ShadowDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShadowDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShadowDestination proto.InternalMessageInfo
+
+func (m *ShadowDestination) GetUpstream() *core.ResourceRef {
+	if m != nil {
+		return m.Upstream
+	}
+	return nil
+}
+
+func (m *ShadowDestination) GetPercentage() float32 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
+func (m *ShadowDestination) GetTraceSampled() *types.BoolValue {
+	if m != nil {
+		return m.TraceSampled
+	}
+	return nil
+}
+
+func (m *ShadowDestination) GetHeaders() []*matchers.HeaderMatcher {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RouteShadowing)(nil), "shadowing.options.gloo.solo.io.RouteShadowing")
+	proto.RegisterType((*ShadowDestination)(nil), "shadowing.options.gloo.solo.io.ShadowDestination")
 }
 
 func init() {
@@ -88,24 +172,32 @@ func init() {
 }
 
 var fileDescriptor_74006d9a50a86d32 = []byte{
-	// 266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
-	0x14, 0x85, 0x95, 0x0a, 0x21, 0x14, 0x24, 0x86, 0x88, 0xa1, 0xed, 0x10, 0x55, 0x4c, 0x5d, 0xb0,
-	0x05, 0x88, 0x17, 0xe8, 0x8c, 0x18, 0xc2, 0xc6, 0xe6, 0xb8, 0x37, 0xae, 0x69, 0x9a, 0x63, 0xd9,
-	0xd7, 0xb4, 0x8f, 0xc4, 0x23, 0xf0, 0x3c, 0xbc, 0x03, 0x3b, 0xca, 0x1f, 0x65, 0xa9, 0xc4, 0x76,
-	0xef, 0x39, 0xc7, 0xc7, 0x9f, 0x6e, 0xfa, 0x6c, 0x2c, 0x6f, 0x62, 0x29, 0x34, 0x76, 0x32, 0xa0,
-	0xc6, 0xad, 0x85, 0x34, 0x35, 0x20, 0x9d, 0xc7, 0x1b, 0x69, 0x0e, 0xfd, 0xa6, 0x9c, 0x95, 0xef,
-	0x77, 0x12, 0x8e, 0x2d, 0x9a, 0x20, 0xc3, 0x46, 0xad, 0xb1, 0xb7, 0x8d, 0x39, 0x4e, 0xc2, 0x79,
-	0x30, 0xb2, 0xfc, 0x28, 0x0c, 0x61, 0xd1, 0x16, 0x88, 0xb6, 0x5b, 0x58, 0xcc, 0xaf, 0x0d, 0x0c,
-	0xba, 0xa8, 0x6c, 0xa7, 0xfe, 0xd5, 0x3c, 0x37, 0x80, 0xa9, 0x49, 0x76, 0x5b, 0x19, 0x2b, 0xb9,
-	0xf7, 0xca, 0x39, 0xf2, 0xe1, 0x94, 0xbf, 0x8e, 0x5e, 0xb5, 0xed, 0x83, 0x3f, 0xeb, 0xd0, 0xb7,
-	0x96, 0x47, 0x50, 0x4f, 0xd5, 0x60, 0x65, 0x74, 0xe0, 0xfe, 0x3f, 0x3a, 0x70, 0xaf, 0xdd, 0x98,
-	0xf4, 0xaa, 0x40, 0x64, 0x7a, 0x19, 0x59, 0xb3, 0xc7, 0xf4, 0x22, 0xba, 0xc0, 0x9e, 0xd4, 0x6e,
-	0x9a, 0x2c, 0x92, 0xe5, 0xe5, 0xfd, 0x4c, 0x68, 0x78, 0x1a, 0xb9, 0x45, 0x41, 0x01, 0xd1, 0x6b,
-	0x2a, 0xa8, 0x2a, 0x7e, 0xa3, 0x59, 0x9e, 0xa6, 0x8e, 0xbc, 0xa6, 0x86, 0x95, 0xa1, 0xe9, 0x64,
-	0x91, 0x2c, 0x27, 0xc5, 0x1f, 0x65, 0xf5, 0xf4, 0xf9, 0x7d, 0x96, 0x7c, 0x7c, 0xe5, 0xc9, 0xeb,
-	0xea, 0x7f, 0x77, 0x76, 0x5b, 0x73, 0xf2, 0xd6, 0xe5, 0x79, 0x47, 0xff, 0xf0, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x3a, 0xed, 0x6a, 0xf4, 0xb4, 0x01, 0x00, 0x00,
+	// 391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0x31, 0xcf, 0xd3, 0x30,
+	0x10, 0x55, 0xbe, 0x56, 0x80, 0xdc, 0x82, 0x44, 0xc4, 0x90, 0x76, 0x88, 0xaa, 0x4e, 0x65, 0xc0,
+	0x56, 0x8b, 0x60, 0x45, 0x2a, 0x0c, 0x0c, 0xc0, 0xe0, 0x0a, 0x06, 0x16, 0xe4, 0x26, 0x57, 0xc7,
+	0x34, 0xc9, 0x59, 0xb6, 0x43, 0xfb, 0x93, 0xf8, 0x09, 0x4c, 0xfc, 0x18, 0x7e, 0x01, 0x0b, 0x3b,
+	0x8a, 0x9d, 0xb4, 0x54, 0xa8, 0x88, 0xe5, 0xdb, 0xce, 0x77, 0xef, 0x3d, 0xbd, 0xe7, 0x3b, 0xf2,
+	0x4e, 0x2a, 0x57, 0x34, 0x5b, 0x9a, 0x61, 0xc5, 0x2c, 0x96, 0xf8, 0x44, 0x21, 0x93, 0x25, 0x22,
+	0xd3, 0x06, 0x3f, 0x43, 0xe6, 0x6c, 0x78, 0x09, 0xad, 0xd8, 0x97, 0x25, 0x43, 0xed, 0x14, 0xd6,
+	0x96, 0xd9, 0x42, 0xe4, 0x78, 0x50, 0xb5, 0x3c, 0x57, 0x54, 0x1b, 0x74, 0x18, 0xa7, 0xe7, 0x46,
+	0x07, 0xa6, 0xad, 0x00, 0x6d, 0xb5, 0xa9, 0xc2, 0xe9, 0x23, 0x89, 0x12, 0x3d, 0x94, 0xb5, 0x55,
+	0x60, 0x4d, 0x53, 0x89, 0x28, 0x4b, 0x60, 0xfe, 0xb5, 0x6d, 0x76, 0xec, 0x60, 0x84, 0xd6, 0x60,
+	0xec, 0xb5, 0x79, 0xde, 0x18, 0xd1, 0xaa, 0x77, 0xf3, 0x89, 0xb7, 0xbe, 0x57, 0xae, 0x37, 0x6a,
+	0x60, 0xd7, 0x8d, 0x9e, 0x5f, 0x4f, 0x93, 0xa1, 0x01, 0x56, 0x09, 0x97, 0x15, 0x60, 0xec, 0xa9,
+	0xe8, 0x78, 0x31, 0x1c, 0x5d, 0xf0, 0x09, 0x47, 0x17, 0x7a, 0xf3, 0xef, 0x11, 0x79, 0xc0, 0xb1,
+	0x71, 0xb0, 0xe9, 0x43, 0xc6, 0xcf, 0xc8, 0xbd, 0x46, 0x5b, 0x67, 0x40, 0x54, 0x49, 0x34, 0x8b,
+	0x16, 0xa3, 0xd5, 0x84, 0xb6, 0xba, 0x7d, 0x60, 0xca, 0xc1, 0x62, 0x63, 0x32, 0xe0, 0xb0, 0xe3,
+	0x27, 0x68, 0x9c, 0x12, 0xa2, 0xc1, 0x64, 0x50, 0x3b, 0x21, 0x21, 0xb9, 0x99, 0x45, 0x8b, 0x1b,
+	0xfe, 0x47, 0x27, 0x7e, 0x4f, 0xc6, 0x39, 0x58, 0xa7, 0x6a, 0x9f, 0xd2, 0x26, 0x83, 0xd9, 0x60,
+	0x31, 0x5a, 0x2d, 0xe9, 0xbf, 0x7f, 0x97, 0x06, 0x5f, 0xaf, 0xce, 0x4c, 0x7e, 0x21, 0x33, 0xff,
+	0x19, 0x91, 0x87, 0x7f, 0x61, 0x6e, 0x2b, 0xc3, 0x0b, 0x72, 0xdf, 0x19, 0x91, 0xc1, 0x27, 0x2b,
+	0x2a, 0x5d, 0x42, 0x9e, 0x0c, 0xbc, 0xf6, 0x94, 0x86, 0x65, 0xd2, 0x7e, 0x99, 0x74, 0x8d, 0x58,
+	0x7e, 0x10, 0x65, 0x03, 0x7c, 0xec, 0x09, 0x9b, 0x80, 0x8f, 0x5f, 0x92, 0xbb, 0x05, 0x88, 0x1c,
+	0x8c, 0x4d, 0x86, 0x3e, 0xff, 0x63, 0x7a, 0x5a, 0x92, 0xf7, 0x77, 0x91, 0xfd, 0xb5, 0x87, 0xbe,
+	0x0d, 0x00, 0xde, 0x33, 0xd7, 0x6f, 0xbe, 0xfd, 0x1a, 0x46, 0x5f, 0x7f, 0xa4, 0xd1, 0xc7, 0xf5,
+	0xff, 0x9d, 0xba, 0xde, 0xcb, 0xab, 0xe7, 0xbe, 0xbd, 0xe3, 0x4d, 0x3f, 0xfd, 0x3d, 0x00, 0xe9,
+	0xc6, 0x9c, 0xb6, 0x37, 0x03, 0x00, 0x00,
 }
 
 func (this *RouteShadowing) Equal(that interface{}) bool {
@@ -133,6 +225,55 @@ func (this *RouteShadowing) Equal(that interface{}) bool {
 	if this.Percentage != that1.Percentage {
 		return false
 	}
+	if len(this.Destinations) != len(that1.Destinations) {
+		return false
+	}
+	for i := range this.Destinations {
+		if !this.Destinations[i].Equal(that1.Destinations[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ShadowDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShadowDestination)
+	if !ok {
+		that2, ok := that.(ShadowDestination)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Upstream.Equal(that1.Upstream) {
+		return false
+	}
+	if this.Percentage != that1.Percentage {
+		return false
+	}
+	if !this.TraceSampled.Equal(that1.TraceSampled) {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if !this.Headers[i].Equal(that1.Headers[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -6,14 +6,14 @@ package v1
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -63,19 +63,18 @@ func (x RedirectAction_RedirectResponseCode) String() string {
 }
 
 func (RedirectAction_RedirectResponseCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{14, 0}
+	return fileDescriptor_c6a47f72e9923590, []int{17, 0}
 }
 
+// A Proxy is a container for the entire set of configuration that will to be applied to one or more Proxy instances.
+// Proxies can be understood as a set of listeners, represents a different bind address/port where the proxy will listen
+// for connections. Each listener has its own set of configuration.
 //
-//A Proxy is a container for the entire set of configuration that will to be applied to one or more Proxy instances.
-//Proxies can be understood as a set of listeners, represents a different bind address/port where the proxy will listen
-//for connections. Each listener has its own set of configuration.
+// If any of the sub-resources within a listener is declared invalid (e.g. due to invalid user configuration), the
+// proxy will be marked invalid by Gloo.
 //
-//If any of the sub-resources within a listener is declared invalid (e.g. due to invalid user configuration), the
-//proxy will be marked invalid by Gloo.
-//
-//Proxy instances that register with Gloo are assigned the proxy configuration corresponding with
-//a proxy-specific identifier.
+// Proxy instances that register with Gloo are assigned the proxy configuration corresponding with
+// a proxy-specific identifier.
 // In the case of Envoy, proxy instances are identified by their Node ID. Node IDs must match a existing Proxy
 // Node ID can be specified in Envoy with the `--service-node` flag, or in the Envoy instance's bootstrap config.
 type Proxy struct {
@@ -155,6 +154,7 @@ type Listener struct {
 	// Types that are valid to be assigned to ListenerType:
 	//	*Listener_HttpListener
 	//	*Listener_TcpListener
+	//	*Listener_HybridListener
 	ListenerType isListener_ListenerType `protobuf_oneof:"ListenerType"`
 	// SSL Config is optional for the listener. If provided, the listener will serve TLS for connections on this port.
 	// Multiple SslConfigs are supported for the purpose of SNI. Be aware that the SNI domain provided in the SSL Config
@@ -208,9 +208,13 @@ type Listener_HttpListener struct {
 type Listener_TcpListener struct {
 	TcpListener *TcpListener `protobuf:"bytes,5,opt,name=tcp_listener,json=tcpListener,proto3,oneof" json:"tcp_listener,omitempty"`
 }
+type Listener_HybridListener struct {
+	HybridListener *HybridListener `protobuf:"bytes,11,opt,name=hybrid_listener,json=hybridListener,proto3,oneof" json:"hybrid_listener,omitempty"`
+}
 
-func (*Listener_HttpListener) isListener_ListenerType() {}
-func (*Listener_TcpListener) isListener_ListenerType()  {}
+func (*Listener_HttpListener) isListener_ListenerType()   {}
+func (*Listener_TcpListener) isListener_ListenerType()    {}
+func (*Listener_HybridListener) isListener_ListenerType() {}
 
 func (m *Listener) GetListenerType() isListener_ListenerType {
 	if m != nil {
@@ -254,6 +258,13 @@ func (m *Listener) GetTcpListener() *TcpListener {
 	return nil
 }
 
+func (m *Listener) GetHybridListener() *HybridListener {
+	if x, ok := m.GetListenerType().(*Listener_HybridListener); ok {
+		return x.HybridListener
+	}
+	return nil
+}
+
 func (m *Listener) GetSslConfigurations() []*SslConfig {
 	if m != nil {
 		return m.SslConfigurations
@@ -287,7 +298,201 @@ func (*Listener) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Listener_HttpListener)(nil),
 		(*Listener_TcpListener)(nil),
+		(*Listener_HybridListener)(nil),
+	}
+}
+
+// A HybridListener contains listeners which share a bind address and port.
+// Each of them is translated into its own envoy filter chains, so they can use different options.
+type HybridListener struct {
+	// The listeners matching the connections of this listener.
+	// Their matchers must be unique, or the config will be considered invalid.
+	MatchedListeners     []*MatchedListener `protobuf:"bytes,1,rep,name=matched_listeners,json=matchedListeners,proto3" json:"matched_listeners,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *HybridListener) Reset()         { *m = HybridListener{} }
+func (m *HybridListener) String() string { return proto.CompactTextString(m) }
+func (*HybridListener) ProtoMessage()    {}
+func (*HybridListener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{2}
+}
+func (m *HybridListener) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridListener.Unmarshal(m, b)
+}
+func (m *HybridListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridListener.Marshal(b, m, deterministic)
+}
+func (m *HybridListener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridListener.Merge(m, src)
+}
+func (m *HybridListener) XXX_Size() int {
+	return xxx_messageInfo_HybridListener.Size(m)
+}
+func (m *HybridListener) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridListener.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridListener proto.InternalMessageInfo
+
+func (m *HybridListener) GetMatchedListeners() []*MatchedListener {
+	if m != nil {
+		return m.MatchedListeners
+	}
+	return nil
+}
+
+// A MatchedListener is an HTTP or TCP listener which only handles the connections satisfying its matcher.
+type MatchedListener struct {
+	// The connections handled by this listener.
+	Matcher *Matcher `protobuf:"bytes,1,opt,name=matcher,proto3" json:"matcher,omitempty"`
+	// Types that are valid to be assigned to ListenerType:
+	//	*MatchedListener_HttpListener
+	//	*MatchedListener_TcpListener
+	ListenerType isMatchedListener_ListenerType `protobuf_oneof:"ListenerType"`
+	// Metadata for the individual matched listener
+	// This data is opaque to Gloo, used
+	// by controllers to track ownership of matched listeners within a hybrid listener
+	Metadata             *types.Struct `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *MatchedListener) Reset()         { *m = MatchedListener{} }
+func (m *MatchedListener) String() string { return proto.CompactTextString(m) }
+func (*MatchedListener) ProtoMessage()    {}
+func (*MatchedListener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{3}
+}
+func (m *MatchedListener) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchedListener.Unmarshal(m, b)
+}
+func (m *MatchedListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchedListener.Marshal(b, m, deterministic)
+}
+func (m *MatchedListener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchedListener.Merge(m, src)
+}
+func (m *MatchedListener) XXX_Size() int {
+	return xxx_messageInfo_MatchedListener.Size(m)
+}
+func (m *MatchedListener) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchedListener.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchedListener proto.InternalMessageInfo
+
+type isMatchedListener_ListenerType interface {
+	isMatchedListener_ListenerType()
+	Equal(interface{}) bool
+}
+
+type MatchedListener_HttpListener struct {
+	HttpListener *HttpListener `protobuf:"bytes,2,opt,name=http_listener,json=httpListener,proto3,oneof" json:"http_listener,omitempty"`
+}
+type MatchedListener_TcpListener struct {
+	TcpListener *TcpListener `protobuf:"bytes,3,opt,name=tcp_listener,json=tcpListener,proto3,oneof" json:"tcp_listener,omitempty"`
+}
+
+func (*MatchedListener_HttpListener) isMatchedListener_ListenerType() {}
+func (*MatchedListener_TcpListener) isMatchedListener_ListenerType()  {}
+
+func (m *MatchedListener) GetListenerType() isMatchedListener_ListenerType {
+	if m != nil {
+		return m.ListenerType
+	}
+	return nil
+}
+
+func (m *MatchedListener) GetMatcher() *Matcher {
+	if m != nil {
+		return m.Matcher
+	}
+	return nil
+}
+
+func (m *MatchedListener) GetHttpListener() *HttpListener {
+	if x, ok := m.GetListenerType().(*MatchedListener_HttpListener); ok {
+		return x.HttpListener
+	}
+	return nil
+}
+
+func (m *MatchedListener) GetTcpListener() *TcpListener {
+	if x, ok := m.GetListenerType().(*MatchedListener_TcpListener); ok {
+		return x.TcpListener
 	}
+	return nil
+}
+
+func (m *MatchedListener) GetMetadata() *types.Struct {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MatchedListener) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MatchedListener_HttpListener)(nil),
+		(*MatchedListener_TcpListener)(nil),
+	}
+}
+
+// A Matcher selects the connections handled by a MatchedListener.
+// Connections are matched on the SNI domains and source address of the connection.
+type Matcher struct {
+	// If provided, the matched connections must use TLS and are terminated with this config.
+	// Its SNI domains are matched against the server name of the connection; connections with any server name
+	// are matched if there are none.
+	SslConfig *SslConfig `protobuf:"bytes,1,opt,name=ssl_config,json=sslConfig,proto3" json:"ssl_config,omitempty"`
+	// If provided, the matched connections must originate from one of these ranges.
+	SourcePrefixRanges   []*v3.CidrRange `protobuf:"bytes,2,rep,name=source_prefix_ranges,json=sourcePrefixRanges,proto3" json:"source_prefix_ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Matcher) Reset()         { *m = Matcher{} }
+func (m *Matcher) String() string { return proto.CompactTextString(m) }
+func (*Matcher) ProtoMessage()    {}
+func (*Matcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{4}
+}
+func (m *Matcher) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Matcher.Unmarshal(m, b)
+}
+func (m *Matcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Matcher.Marshal(b, m, deterministic)
+}
+func (m *Matcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Matcher.Merge(m, src)
+}
+func (m *Matcher) XXX_Size() int {
+	return xxx_messageInfo_Matcher.Size(m)
+}
+func (m *Matcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_Matcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Matcher proto.InternalMessageInfo
+
+func (m *Matcher) GetSslConfig() *SslConfig {
+	if m != nil {
+		return m.SslConfig
+	}
+	return nil
+}
+
+func (m *Matcher) GetSourcePrefixRanges() []*v3.CidrRange {
+	if m != nil {
+		return m.SourcePrefixRanges
+	}
+	return nil
 }
 
 type TcpListener struct {
@@ -309,7 +514,7 @@ func (m *TcpListener) Reset()         { *m = TcpListener{} }
 func (m *TcpListener) String() string { return proto.CompactTextString(m) }
 func (*TcpListener) ProtoMessage()    {}
 func (*TcpListener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{2}
+	return fileDescriptor_c6a47f72e9923590, []int{5}
 }
 func (m *TcpListener) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpListener.Unmarshal(m, b)
@@ -365,7 +570,7 @@ func (m *TcpHost) Reset()         { *m = TcpHost{} }
 func (m *TcpHost) String() string { return proto.CompactTextString(m) }
 func (*TcpHost) ProtoMessage()    {}
 func (*TcpHost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{3}
+	return fileDescriptor_c6a47f72e9923590, []int{6}
 }
 func (m *TcpHost) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHost.Unmarshal(m, b)
@@ -424,7 +629,7 @@ func (m *TcpHost_TcpAction) Reset()         { *m = TcpHost_TcpAction{} }
 func (m *TcpHost_TcpAction) String() string { return proto.CompactTextString(m) }
 func (*TcpHost_TcpAction) ProtoMessage()    {}
 func (*TcpHost_TcpAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{3, 0}
+	return fileDescriptor_c6a47f72e9923590, []int{6, 0}
 }
 func (m *TcpHost_TcpAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHost_TcpAction.Unmarshal(m, b)
@@ -536,7 +741,7 @@ func (m *HttpListener) Reset()         { *m = HttpListener{} }
 func (m *HttpListener) String() string { return proto.CompactTextString(m) }
 func (*HttpListener) ProtoMessage()    {}
 func (*HttpListener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{4}
+	return fileDescriptor_c6a47f72e9923590, []int{7}
 }
 func (m *HttpListener) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListener.Unmarshal(m, b)
@@ -577,7 +782,6 @@ func (m *HttpListener) GetStatPrefix() string {
 	return ""
 }
 
-//
 // Virtual Hosts group an ordered list of routes under one or more domains.
 // Each Virtual Host has a logical name, which must be unique for the listener.
 // An HTTP request is first matched to a virtual host based on its host header, then to a route within the virtual host.
@@ -613,7 +817,7 @@ func (m *VirtualHost) Reset()         { *m = VirtualHost{} }
 func (m *VirtualHost) String() string { return proto.CompactTextString(m) }
 func (*VirtualHost) ProtoMessage()    {}
 func (*VirtualHost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{5}
+	return fileDescriptor_c6a47f72e9923590, []int{8}
 }
 func (m *VirtualHost) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHost.Unmarshal(m, b)
@@ -668,7 +872,6 @@ func (m *VirtualHost) GetMetadata() *types.Struct {
 	return nil
 }
 
-//*
 // Routes declare the entry points on virtual hosts and the action to take for matched requests.
 type Route struct {
 	// Matchers contain parameters for matching requests (i.e., based on HTTP path, headers, etc.)
@@ -701,7 +904,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{6}
+	return fileDescriptor_c6a47f72e9923590, []int{9}
 }
 func (m *Route) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Route.Unmarshal(m, b)
@@ -826,7 +1029,7 @@ func (m *RouteAction) Reset()         { *m = RouteAction{} }
 func (m *RouteAction) String() string { return proto.CompactTextString(m) }
 func (*RouteAction) ProtoMessage()    {}
 func (*RouteAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{7}
+	return fileDescriptor_c6a47f72e9923590, []int{10}
 }
 func (m *RouteAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteAction.Unmarshal(m, b)
@@ -940,7 +1143,7 @@ func (m *Destination) Reset()         { *m = Destination{} }
 func (m *Destination) String() string { return proto.CompactTextString(m) }
 func (*Destination) ProtoMessage()    {}
 func (*Destination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{8}
+	return fileDescriptor_c6a47f72e9923590, []int{11}
 }
 func (m *Destination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Destination.Unmarshal(m, b)
@@ -1045,7 +1248,7 @@ func (m *KubernetesServiceDestination) Reset()         { *m = KubernetesServiceD
 func (m *KubernetesServiceDestination) String() string { return proto.CompactTextString(m) }
 func (*KubernetesServiceDestination) ProtoMessage()    {}
 func (*KubernetesServiceDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{9}
+	return fileDescriptor_c6a47f72e9923590, []int{12}
 }
 func (m *KubernetesServiceDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KubernetesServiceDestination.Unmarshal(m, b)
@@ -1101,7 +1304,7 @@ func (m *ConsulServiceDestination) Reset()         { *m = ConsulServiceDestinati
 func (m *ConsulServiceDestination) String() string { return proto.CompactTextString(m) }
 func (*ConsulServiceDestination) ProtoMessage()    {}
 func (*ConsulServiceDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{10}
+	return fileDescriptor_c6a47f72e9923590, []int{13}
 }
 func (m *ConsulServiceDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConsulServiceDestination.Unmarshal(m, b)
@@ -1159,7 +1362,7 @@ func (m *UpstreamGroup) Reset()         { *m = UpstreamGroup{} }
 func (m *UpstreamGroup) String() string { return proto.CompactTextString(m) }
 func (*UpstreamGroup) ProtoMessage()    {}
 func (*UpstreamGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{11}
+	return fileDescriptor_c6a47f72e9923590, []int{14}
 }
 func (m *UpstreamGroup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpstreamGroup.Unmarshal(m, b)
@@ -1215,7 +1418,7 @@ func (m *MultiDestination) Reset()         { *m = MultiDestination{} }
 func (m *MultiDestination) String() string { return proto.CompactTextString(m) }
 func (*MultiDestination) ProtoMessage()    {}
 func (*MultiDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{12}
+	return fileDescriptor_c6a47f72e9923590, []int{15}
 }
 func (m *MultiDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiDestination.Unmarshal(m, b)
@@ -1259,7 +1462,7 @@ func (m *WeightedDestination) Reset()         { *m = WeightedDestination{} }
 func (m *WeightedDestination) String() string { return proto.CompactTextString(m) }
 func (*WeightedDestination) ProtoMessage()    {}
 func (*WeightedDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{13}
+	return fileDescriptor_c6a47f72e9923590, []int{16}
 }
 func (m *WeightedDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WeightedDestination.Unmarshal(m, b)
@@ -1325,7 +1528,7 @@ func (m *RedirectAction) Reset()         { *m = RedirectAction{} }
 func (m *RedirectAction) String() string { return proto.CompactTextString(m) }
 func (*RedirectAction) ProtoMessage()    {}
 func (*RedirectAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{14}
+	return fileDescriptor_c6a47f72e9923590, []int{17}
 }
 func (m *RedirectAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RedirectAction.Unmarshal(m, b)
@@ -1436,7 +1639,7 @@ func (m *DirectResponseAction) Reset()         { *m = DirectResponseAction{} }
 func (m *DirectResponseAction) String() string { return proto.CompactTextString(m) }
 func (*DirectResponseAction) ProtoMessage()    {}
 func (*DirectResponseAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{15}
+	return fileDescriptor_c6a47f72e9923590, []int{18}
 }
 func (m *DirectResponseAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DirectResponseAction.Unmarshal(m, b)
@@ -1474,6 +1677,9 @@ func init() {
 	proto.RegisterEnum("gloo.solo.io.RedirectAction_RedirectResponseCode", RedirectAction_RedirectResponseCode_name, RedirectAction_RedirectResponseCode_value)
 	proto.RegisterType((*Proxy)(nil), "gloo.solo.io.Proxy")
 	proto.RegisterType((*Listener)(nil), "gloo.solo.io.Listener")
+	proto.RegisterType((*HybridListener)(nil), "gloo.solo.io.HybridListener")
+	proto.RegisterType((*MatchedListener)(nil), "gloo.solo.io.MatchedListener")
+	proto.RegisterType((*Matcher)(nil), "gloo.solo.io.Matcher")
 	proto.RegisterType((*TcpListener)(nil), "gloo.solo.io.TcpListener")
 	proto.RegisterType((*TcpHost)(nil), "gloo.solo.io.TcpHost")
 	proto.RegisterType((*TcpHost_TcpAction)(nil), "gloo.solo.io.TcpHost.TcpAction")
@@ -1496,111 +1702,120 @@ func init() {
 }
 
 var fileDescriptor_c6a47f72e9923590 = []byte{
-	// 1653 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x49, 0x6f, 0x23, 0xc7,
-	0x15, 0x56, 0x93, 0x14, 0x45, 0x3e, 0x2e, 0xa3, 0x29, 0x6b, 0xe4, 0x96, 0x3c, 0x5a, 0xdc, 0x03,
-	0x7b, 0x84, 0x2c, 0x64, 0x46, 0x36, 0xc6, 0x8e, 0x0c, 0x24, 0x16, 0x25, 0xda, 0x4c, 0x6c, 0x2d,
-	0x2e, 0xc9, 0x0a, 0xc6, 0x97, 0x46, 0xb3, 0xbb, 0x48, 0x76, 0x86, 0x64, 0x75, 0xaa, 0xaa, 0xb5,
-	0x5c, 0xe7, 0x87, 0xf8, 0x9c, 0x43, 0x90, 0xb3, 0x0f, 0x01, 0x72, 0xcd, 0x2f, 0xc8, 0x25, 0x80,
-	0x03, 0xe4, 0x1f, 0x28, 0x40, 0x72, 0xca, 0x21, 0xa8, 0xea, 0xea, 0x66, 0x37, 0x45, 0x6a, 0x62,
-	0xc0, 0x87, 0xf8, 0xa4, 0xaa, 0xb7, 0xf5, 0xab, 0xf7, 0xbe, 0xb7, 0x50, 0xf0, 0x61, 0xdf, 0x17,
-	0x83, 0xb0, 0xdb, 0x70, 0xe9, 0xa8, 0xc9, 0xe9, 0x90, 0xfe, 0xd4, 0xa7, 0xcd, 0xfe, 0x90, 0xd2,
-	0x66, 0xc0, 0xe8, 0x6f, 0x89, 0x2b, 0x78, 0x74, 0x73, 0x02, 0xbf, 0x79, 0xf9, 0x4c, 0x12, 0xaf,
-	0x6f, 0x1a, 0x01, 0xa3, 0x82, 0xa2, 0xaa, 0x64, 0x34, 0xa4, 0x4e, 0xc3, 0xa7, 0xeb, 0x9b, 0x7d,
-	0x4a, 0xfb, 0x43, 0xd2, 0x54, 0xbc, 0x6e, 0xd8, 0x6b, 0x5e, 0x31, 0x27, 0x08, 0x08, 0xe3, 0x91,
-	0xf4, 0xfa, 0x5b, 0xd3, 0x7c, 0x32, 0x0a, 0x84, 0x36, 0xb5, 0xfe, 0x78, 0x9a, 0xc9, 0x05, 0x0b,
-	0x5d, 0xa1, 0xb9, 0x2b, 0x7d, 0xda, 0xa7, 0xea, 0xd8, 0x94, 0x27, 0x4d, 0x45, 0xe4, 0x5a, 0x44,
-	0x44, 0x72, 0x1d, 0x4b, 0x6e, 0xaa, 0x17, 0xbc, 0xf4, 0x45, 0xec, 0xef, 0x88, 0x08, 0xc7, 0x73,
-	0x84, 0x13, 0x7f, 0x67, 0x9a, 0xcf, 0x85, 0x23, 0xc2, 0xd8, 0xc5, 0xb5, 0x69, 0x2e, 0x23, 0xbd,
-	0x79, 0x86, 0xe3, 0xbb, 0xe6, 0x3f, 0x99, 0x1f, 0x32, 0xce, 0x87, 0x5a, 0xe8, 0xdd, 0x7b, 0x84,
-	0xc2, 0x2e, 0x27, 0xb1, 0xb1, 0xa7, 0xf3, 0xe5, 0x68, 0x20, 0x7c, 0x3a, 0x8e, 0x1d, 0x7e, 0x3e,
-	0x5f, 0xd0, 0xa5, 0x8c, 0x34, 0x47, 0x8e, 0x70, 0x07, 0x84, 0xf1, 0xe4, 0x10, 0xe9, 0x59, 0x7f,
-	0x33, 0x60, 0xf1, 0x54, 0x66, 0x12, 0xbd, 0x0f, 0xe5, 0xa1, 0xcf, 0x05, 0x19, 0x13, 0xc6, 0xcd,
-	0xdc, 0x76, 0x7e, 0xa7, 0xb2, 0xbb, 0xda, 0x48, 0xe7, 0xb5, 0xf1, 0xb9, 0x66, 0xe3, 0x89, 0x20,
-	0xfa, 0x0c, 0x8a, 0x51, 0xe0, 0xcc, 0xe2, 0xb6, 0xb1, 0x53, 0xd9, 0x5d, 0x69, 0xc8, 0xcf, 0x25,
-	0x2a, 0x67, 0x8a, 0xd7, 0xda, 0xf8, 0xe6, 0x5f, 0x05, 0xe3, 0x2f, 0xdf, 0x6e, 0x2d, 0xfc, 0xf3,
-	0xdb, 0xad, 0x87, 0x82, 0x70, 0xe1, 0xf9, 0xbd, 0xde, 0x9e, 0xe5, 0xf7, 0xc7, 0x94, 0x11, 0x0b,
-	0x6b, 0x13, 0xe8, 0x43, 0x28, 0xc5, 0x59, 0x32, 0x97, 0x94, 0xb9, 0xd5, 0xac, 0xb9, 0x23, 0xcd,
-	0x6d, 0x15, 0xa4, 0x31, 0x9c, 0x48, 0xef, 0x3d, 0x7a, 0x75, 0x5b, 0x28, 0x40, 0x2e, 0xb8, 0x7e,
-	0x75, 0x5b, 0x28, 0xa3, 0x25, 0x89, 0x4d, 0x9f, 0x70, 0xeb, 0x36, 0x0f, 0xa5, 0xd8, 0x6b, 0x84,
-	0xa0, 0x30, 0x76, 0x46, 0xc4, 0x34, 0xb6, 0x8d, 0x9d, 0x32, 0x56, 0x67, 0xf4, 0x36, 0x54, 0xbb,
-	0xfe, 0xd8, 0xb3, 0x1d, 0xcf, 0x63, 0x84, 0xcb, 0x77, 0x4b, 0x5e, 0x45, 0xd2, 0xf6, 0x23, 0x12,
-	0x7a, 0x0b, 0xca, 0x4a, 0x24, 0xa0, 0x4c, 0x98, 0xf9, 0x6d, 0x63, 0xa7, 0x86, 0x4b, 0x92, 0x70,
-	0x4a, 0x99, 0x40, 0xfb, 0x50, 0x1b, 0x08, 0x11, 0xd8, 0x71, 0x40, 0xcc, 0x82, 0x72, 0x7b, 0x3d,
-	0x1b, 0xb8, 0x8e, 0x10, 0x41, 0xec, 0x46, 0x67, 0x01, 0x57, 0x07, 0xa9, 0x3b, 0xfa, 0x05, 0x54,
-	0x85, 0x9b, 0xb2, 0xb0, 0xa8, 0x2c, 0xac, 0x65, 0x2d, 0x9c, 0xbb, 0x69, 0x03, 0x15, 0x31, 0xb9,
-	0xa2, 0x4f, 0x00, 0x71, 0x3e, 0xb4, 0x5d, 0x3a, 0xee, 0xf9, 0xfd, 0x90, 0x39, 0x0a, 0x15, 0x66,
-	0x51, 0x25, 0xf0, 0xcd, 0xac, 0x95, 0x33, 0x3e, 0x3c, 0x50, 0x62, 0xf8, 0x21, 0x8f, 0x8f, 0xb1,
-	0x06, 0x6a, 0xc1, 0x83, 0x90, 0x13, 0x5b, 0x95, 0xb5, 0xad, 0xc0, 0xa1, 0x73, 0xb0, 0xde, 0x88,
-	0x4a, 0xb2, 0x11, 0x97, 0x64, 0xa3, 0x45, 0xe9, 0xf0, 0xc2, 0x19, 0x86, 0x04, 0xd7, 0x42, 0x4e,
-	0x14, 0x7c, 0x4e, 0x55, 0x1f, 0xf8, 0x00, 0x96, 0x34, 0x2c, 0xcd, 0x92, 0xd2, 0xdd, 0x98, 0x8d,
-	0xa0, 0x93, 0x48, 0x08, 0xc7, 0xd2, 0xe8, 0xe7, 0xa9, 0xcc, 0x97, 0x95, 0xe6, 0x9b, 0x77, 0xbe,
-	0x7a, 0xa6, 0x1a, 0x41, 0xab, 0x20, 0xb1, 0x34, 0x49, 0x7d, 0xab, 0x0e, 0xd5, 0xd8, 0xec, 0xf9,
-	0x4d, 0x40, 0xac, 0xaf, 0x0d, 0xa8, 0xa4, 0xc2, 0x85, 0x76, 0xa1, 0x2c, 0xe3, 0x3b, 0xa0, 0x5c,
-	0x70, 0xd3, 0x50, 0x61, 0x79, 0x74, 0x27, 0xb8, 0x1d, 0xca, 0x05, 0x2e, 0x89, 0xe8, 0xc0, 0xd1,
-	0xde, 0xf4, 0x3b, 0xb6, 0xe7, 0xa6, 0xe3, 0xce, 0x53, 0xb6, 0xa0, 0x22, 0xe1, 0x6c, 0x07, 0x8c,
-	0xf4, 0xfc, 0x6b, 0x85, 0x98, 0x32, 0x06, 0x49, 0x3a, 0x55, 0x14, 0xeb, 0xcf, 0x79, 0x58, 0xd2,
-	0x9f, 0x9c, 0x89, 0xc9, 0xe7, 0x00, 0x93, 0x84, 0x2a, 0xfd, 0x7b, 0x12, 0x59, 0x4e, 0x12, 0x89,
-	0xf6, 0xa1, 0xe2, 0x11, 0x2e, 0xfc, 0xb1, 0x4a, 0xa8, 0x46, 0xe2, 0xd6, 0xcc, 0xa7, 0xca, 0xbf,
-	0xfb, 0xae, 0x14, 0xc3, 0x69, 0x9d, 0xf5, 0xaf, 0x73, 0x50, 0x4e, 0x58, 0xe8, 0x3d, 0x28, 0x72,
-	0x7f, 0xdc, 0x1f, 0x46, 0xee, 0xdd, 0xc1, 0xe4, 0xe1, 0x44, 0xb1, 0xb3, 0x80, 0xb5, 0x28, 0x7a,
-	0x0e, 0x8b, 0xa3, 0x70, 0x28, 0x7c, 0x55, 0x4a, 0x95, 0xdd, 0xcd, 0xac, 0xce, 0x91, 0x64, 0x65,
-	0x15, 0x23, 0x71, 0xd4, 0x82, 0x7a, 0x18, 0x70, 0xc1, 0x88, 0x33, 0xb2, 0xfb, 0x8c, 0x86, 0x81,
-	0x7e, 0xf9, 0x5a, 0xb6, 0x03, 0x60, 0xc2, 0x69, 0xc8, 0x5c, 0x82, 0x49, 0xaf, 0xb3, 0x80, 0x6b,
-	0xb1, 0xca, 0xa7, 0x52, 0x03, 0x7d, 0x01, 0x66, 0x8f, 0xb2, 0x2b, 0x87, 0x79, 0x36, 0x1f, 0xfb,
-	0xb6, 0x3b, 0x0c, 0xb9, 0x20, 0xcc, 0x56, 0x11, 0x2e, 0xe8, 0x7e, 0x32, 0x8d, 0xaa, 0xb6, 0x9c,
-	0x3d, 0x9d, 0x05, 0xfc, 0x48, 0x6b, 0x9e, 0x8d, 0xfd, 0x83, 0x48, 0xef, 0xd8, 0x19, 0x91, 0x56,
-	0x2d, 0x13, 0xd4, 0x5f, 0x17, 0x4a, 0xb9, 0xe5, 0xbc, 0xf5, 0x07, 0x03, 0xaa, 0x9d, 0x6c, 0x0d,
-	0xd7, 0x2e, 0x7d, 0x26, 0x42, 0x67, 0x98, 0xc1, 0xd9, 0x54, 0xc0, 0x2e, 0x22, 0x11, 0x85, 0xb5,
-	0xea, 0xe5, 0xe4, 0xc2, 0xd1, 0x47, 0x13, 0xbc, 0x45, 0x61, 0x7b, 0x7b, 0x7e, 0x03, 0xf9, 0xee,
-	0x80, 0xfb, 0xbb, 0x01, 0x95, 0xd4, 0xb7, 0x67, 0x82, 0xce, 0x84, 0x25, 0x8f, 0x8e, 0x1c, 0x7f,
-	0x1c, 0xf5, 0xfe, 0x32, 0x8e, 0xaf, 0xe8, 0xc7, 0x50, 0x64, 0x34, 0x14, 0x84, 0x9b, 0x79, 0xf5,
-	0xa8, 0x37, 0xb2, 0xae, 0x61, 0xc9, 0xc3, 0x5a, 0x24, 0x5d, 0x38, 0x85, 0x59, 0x85, 0x93, 0x72,
-	0xe3, 0xde, 0x1e, 0x50, 0xfc, 0x4e, 0x3d, 0xc0, 0xfa, 0x53, 0x1e, 0x16, 0x95, 0x23, 0xe8, 0x97,
-	0x50, 0x8a, 0x27, 0x9c, 0x4e, 0xc2, 0x93, 0x46, 0x32, 0xf2, 0x14, 0x92, 0xb2, 0x78, 0x8c, 0x58,
-	0x38, 0x51, 0x92, 0xed, 0x58, 0xbd, 0xc5, 0x76, 0x54, 0x11, 0xe8, 0x7c, 0xac, 0xcd, 0x78, 0x74,
-	0x54, 0x25, 0xb2, 0x1d, 0xb3, 0xc9, 0x15, 0x7d, 0x0a, 0x0f, 0x18, 0xf1, 0x7c, 0x46, 0x5c, 0x11,
-	0x9b, 0x88, 0x80, 0xfc, 0x78, 0xca, 0x84, 0x16, 0x4a, 0xac, 0xd4, 0x59, 0x86, 0x82, 0xbe, 0x82,
-	0x55, 0x6d, 0x86, 0x11, 0x1e, 0xd0, 0x31, 0x4f, 0x5c, 0x8a, 0x22, 0x6b, 0x4d, 0x55, 0xa3, 0x92,
-	0xc5, 0x5a, 0x34, 0xb1, 0xba, 0xe2, 0xcd, 0xa0, 0xa3, 0xf7, 0x27, 0x69, 0x5a, 0x9c, 0x35, 0xb0,
-	0xd4, 0xfb, 0xbe, 0xc7, 0x04, 0x25, 0x90, 0x5b, 0x9a, 0x40, 0xae, 0x55, 0x82, 0x62, 0xf4, 0x20,
-	0xeb, 0xdf, 0x06, 0x54, 0x52, 0x21, 0xfd, 0xe1, 0x35, 0x9e, 0xa7, 0x50, 0x8f, 0x9b, 0xcd, 0x80,
-	0x38, 0x9e, 0xde, 0x03, 0xca, 0x52, 0x50, 0xd3, 0x3b, 0x8a, 0x3c, 0xd5, 0x4e, 0xac, 0xbf, 0xe6,
-	0xa0, 0x92, 0x72, 0x0a, 0x7d, 0x00, 0xa5, 0xd8, 0xb0, 0x09, 0xaf, 0xf7, 0x22, 0x11, 0x46, 0x1f,
-	0x43, 0xe1, 0x65, 0xd8, 0x25, 0x66, 0x45, 0x29, 0xfd, 0x28, 0xfb, 0xf6, 0xcf, 0xc2, 0x2e, 0x61,
-	0x63, 0x22, 0x08, 0x3f, 0x23, 0xec, 0xd2, 0x77, 0x49, 0x36, 0x0e, 0x4a, 0x13, 0x7d, 0x0c, 0x45,
-	0x97, 0x8e, 0x79, 0x38, 0x34, 0xab, 0xca, 0xc6, 0xbb, 0x59, 0x1b, 0x07, 0x8a, 0x37, 0x53, 0x5f,
-	0xeb, 0xa1, 0x0e, 0x2c, 0xa7, 0xde, 0x66, 0xf3, 0x80, 0xb8, 0x3a, 0x17, 0x1b, 0x73, 0xf3, 0x77,
-	0x16, 0x10, 0x17, 0x3f, 0xf0, 0xb2, 0x04, 0xf4, 0x13, 0x28, 0x46, 0x5b, 0xb0, 0x4e, 0xc5, 0xca,
-	0xd4, 0xf4, 0x53, 0x3c, 0xac, 0x65, 0x5a, 0x28, 0xfb, 0x5d, 0x21, 0x97, 0x00, 0x02, 0x8f, 0xef,
-	0x7b, 0x35, 0x7a, 0x06, 0x79, 0x46, 0x7a, 0x09, 0xbc, 0xe6, 0xc5, 0x58, 0xef, 0x99, 0x52, 0x56,
-	0x42, 0x58, 0xad, 0x80, 0x39, 0xb5, 0x02, 0xaa, 0xb3, 0x25, 0xc0, 0x9c, 0x17, 0x18, 0xb9, 0x5a,
-	0xf2, 0x88, 0x6a, 0xa7, 0xba, 0x6d, 0x45, 0xd3, 0xe4, 0x70, 0x91, 0x26, 0x85, 0xd3, 0x8f, 0x3b,
-	0xae, 0x3a, 0x4b, 0x35, 0x59, 0x31, 0xb6, 0x4b, 0xc6, 0x42, 0x36, 0xb1, 0xbc, 0xe2, 0x55, 0x24,
-	0xed, 0x20, 0x22, 0x59, 0xff, 0x31, 0xa0, 0xf6, 0x65, 0x06, 0x7f, 0x6d, 0xa8, 0xa6, 0x42, 0x10,
-	0x77, 0xbe, 0xa9, 0x21, 0xf2, 0x1b, 0xe2, 0xf7, 0x07, 0x82, 0x78, 0x29, 0x27, 0x71, 0x46, 0xed,
-	0xff, 0x65, 0x99, 0xdf, 0x88, 0x96, 0xf9, 0xb0, 0xff, 0xea, 0xb6, 0xf0, 0x10, 0x3d, 0xc8, 0x56,
-	0x27, 0xb7, 0x5e, 0xc0, 0xf2, 0x74, 0x35, 0x7f, 0x4f, 0x01, 0xb0, 0xfe, 0x68, 0xc0, 0x1b, 0x33,
-	0xa4, 0xd0, 0x47, 0xd9, 0xd5, 0xea, 0x75, 0x5d, 0x29, 0xb3, 0x54, 0xa1, 0x55, 0x28, 0x5e, 0x29,
-	0x9b, 0x1a, 0x3a, 0xfa, 0x86, 0x5a, 0x93, 0x26, 0x1c, 0xc1, 0x7c, 0xe7, 0xb5, 0xee, 0x4e, 0xb7,
-	0x64, 0xeb, 0x9b, 0x3c, 0xd4, 0xb3, 0x93, 0x04, 0x3d, 0x81, 0x9a, 0xdc, 0x41, 0xec, 0x78, 0x9c,
-	0x68, 0xe0, 0x55, 0x25, 0x31, 0x16, 0x45, 0xef, 0x40, 0x2d, 0x70, 0xc4, 0x60, 0x22, 0x94, 0xd3,
-	0xfd, 0xaa, 0x2a, 0xc9, 0x89, 0xd8, 0x53, 0xa8, 0x47, 0x5b, 0x85, 0xcd, 0xc8, 0x15, 0xf3, 0x05,
-	0x51, 0xe3, 0x42, 0xf5, 0xb5, 0x88, 0x8e, 0x23, 0x32, 0xba, 0x80, 0x5a, 0x32, 0xa5, 0x5c, 0xea,
-	0x11, 0xf5, 0xa2, 0xfa, 0xee, 0xb3, 0xfb, 0x66, 0x5e, 0x72, 0x8d, 0x87, 0xd3, 0x01, 0xf5, 0x08,
-	0xae, 0xb2, 0xd4, 0x0d, 0xbd, 0x03, 0x75, 0xf9, 0x63, 0x89, 0x4f, 0x1c, 0x95, 0x8d, 0xb5, 0x84,
-	0xd5, 0xaf, 0x2e, 0x9e, 0xf8, 0xa9, 0x56, 0x20, 0xe6, 0x07, 0xf6, 0xef, 0x42, 0xc2, 0x6e, 0x14,
-	0x7a, 0x4b, 0x72, 0x05, 0x62, 0x7e, 0xf0, 0x85, 0xa4, 0x58, 0x57, 0xb0, 0x32, 0xeb, 0x6b, 0xe8,
-	0x11, 0x3c, 0x3c, 0x3a, 0xb9, 0x68, 0x1f, 0xda, 0xa7, 0x6d, 0x7c, 0xb4, 0x7f, 0xdc, 0x3e, 0x3e,
-	0xff, 0xfc, 0xc5, 0xf2, 0x02, 0x2a, 0xc3, 0xe2, 0x27, 0x27, 0x5f, 0x1e, 0x1f, 0x2e, 0x1b, 0xa8,
-	0x06, 0xe5, 0xb3, 0x76, 0xdb, 0x3e, 0x39, 0xef, 0xb4, 0xf1, 0x72, 0x0e, 0xad, 0x02, 0x3a, 0x6f,
-	0x1f, 0x9d, 0x9e, 0xe0, 0x7d, 0xfc, 0xc2, 0xc6, 0xed, 0xc3, 0x5f, 0xe1, 0xf6, 0xc1, 0xf9, 0x72,
-	0x5e, 0xd2, 0x13, 0x13, 0x13, 0x7a, 0xa1, 0x65, 0xc2, 0xaa, 0x0e, 0xb4, 0x0a, 0x94, 0xea, 0x8a,
-	0x7e, 0xcf, 0x27, 0xcc, 0x6a, 0xc1, 0xca, 0xac, 0x99, 0x2d, 0xe1, 0xa2, 0x8b, 0xd0, 0x88, 0xe0,
-	0xa2, 0xeb, 0x09, 0x41, 0xa1, 0x4b, 0xbd, 0x1b, 0xfd, 0x13, 0x55, 0x9d, 0x5b, 0x7b, 0xb2, 0x14,
-	0x7f, 0xff, 0x8f, 0x4d, 0xe3, 0xab, 0x9f, 0xfd, 0x6f, 0xff, 0xbb, 0x09, 0x5e, 0xf6, 0xf5, 0xbf,
-	0x05, 0xba, 0x45, 0x35, 0xb3, 0xdf, 0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x09, 0x97, 0xa2,
-	0x30, 0xf6, 0x11, 0x00, 0x00,
+	// 1798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x14, 0x45, 0x3e, 0xfe, 0x90, 0x34, 0x91, 0x94, 0xb5, 0x62, 0x5b, 0xca, 0x1a,
+	0x89, 0x85, 0xef, 0xb7, 0x25, 0x6b, 0x39, 0x70, 0x52, 0x07, 0x68, 0x23, 0xca, 0x4c, 0xd8, 0x24,
+	0xb2, 0xe4, 0x91, 0xe2, 0xc2, 0x41, 0x81, 0xc5, 0x6a, 0x77, 0x48, 0x6e, 0x4d, 0x72, 0xb6, 0x33,
+	0xb3, 0xfa, 0x71, 0xcd, 0x5f, 0xd0, 0x43, 0xcf, 0x39, 0xf7, 0x50, 0xf4, 0x1c, 0x14, 0x05, 0x7a,
+	0xed, 0x5f, 0xd0, 0xde, 0x52, 0xa0, 0xff, 0x41, 0x0a, 0xb4, 0xe8, 0xb1, 0x98, 0x1f, 0xbb, 0xdc,
+	0xa5, 0x28, 0x3b, 0x46, 0x72, 0x68, 0x4f, 0x9c, 0x79, 0xef, 0xf3, 0xde, 0xbe, 0x79, 0xf3, 0x79,
+	0x6f, 0x66, 0x08, 0xef, 0x0d, 0x42, 0x31, 0x8c, 0x4f, 0x5b, 0x3e, 0x1d, 0xb7, 0x39, 0x1d, 0xd1,
+	0x1f, 0x86, 0xb4, 0x3d, 0x18, 0x51, 0xda, 0x8e, 0x18, 0xfd, 0x25, 0xf1, 0x05, 0xd7, 0x33, 0x2f,
+	0x0a, 0xdb, 0x67, 0xf7, 0xa4, 0xf0, 0xe2, 0xb2, 0x15, 0x31, 0x2a, 0x28, 0xaa, 0x4b, 0x45, 0x4b,
+	0xda, 0xb4, 0x42, 0xba, 0x79, 0x7b, 0x40, 0xe9, 0x60, 0x44, 0xda, 0x4a, 0x77, 0x1a, 0xf7, 0xdb,
+	0xe7, 0xcc, 0x8b, 0x22, 0xc2, 0xb8, 0x46, 0x6f, 0xbe, 0x31, 0xab, 0x27, 0xe3, 0x48, 0x18, 0x57,
+	0x9b, 0x37, 0x67, 0x95, 0x5c, 0xb0, 0xd8, 0x17, 0x46, 0xbb, 0x36, 0xa0, 0x03, 0xaa, 0x86, 0x6d,
+	0x39, 0x32, 0x52, 0x44, 0x2e, 0x84, 0x16, 0x92, 0x8b, 0x04, 0x79, 0x5b, 0xad, 0xe0, 0x79, 0x28,
+	0x92, 0x78, 0xc7, 0x44, 0x78, 0x81, 0x27, 0xbc, 0xe4, 0x3b, 0xb3, 0x7a, 0x2e, 0x3c, 0x11, 0x27,
+	0x21, 0xde, 0x98, 0xd5, 0x32, 0xd2, 0xbf, 0xce, 0x71, 0x32, 0x37, 0xfa, 0x3b, 0xd7, 0xa7, 0x8c,
+	0xf3, 0x91, 0x01, 0xbd, 0xfd, 0x02, 0x50, 0x7c, 0xca, 0x49, 0xe2, 0xec, 0xee, 0xf5, 0x38, 0x1a,
+	0x89, 0x90, 0x4e, 0x92, 0x80, 0x1f, 0x5c, 0x0f, 0xf4, 0x29, 0x23, 0xed, 0xb1, 0x27, 0xfc, 0x21,
+	0x61, 0x3c, 0x1d, 0x18, 0x3b, 0x87, 0x4c, 0xce, 0xe8, 0x65, 0xdb, 0xa7, 0x93, 0x7e, 0x38, 0xd0,
+	0xd0, 0xb3, 0xfb, 0x6d, 0x2f, 0x08, 0x18, 0xe1, 0x06, 0xe3, 0xfc, 0xd5, 0x82, 0xc5, 0x23, 0xb9,
+	0xdb, 0xe8, 0x1d, 0xa8, 0x8e, 0x42, 0x2e, 0xc8, 0x84, 0x30, 0x6e, 0x17, 0xb6, 0x8b, 0x3b, 0xb5,
+	0xdd, 0x8d, 0x56, 0x76, 0xef, 0x5b, 0x9f, 0x1a, 0x35, 0x9e, 0x02, 0xd1, 0x27, 0x50, 0xd6, 0xc9,
+	0xb5, 0xcb, 0xdb, 0xd6, 0x4e, 0x6d, 0x77, 0xad, 0x25, 0xbf, 0x93, 0x9a, 0x1c, 0x2b, 0x5d, 0xe7,
+	0xd6, 0x57, 0xff, 0x2c, 0x59, 0x7f, 0xfe, 0x7a, 0x6b, 0xe1, 0x1f, 0x5f, 0x6f, 0xad, 0x0a, 0xc2,
+	0x45, 0x10, 0xf6, 0xfb, 0x0f, 0x9d, 0x70, 0x30, 0xa1, 0x8c, 0x38, 0xd8, 0xb8, 0x40, 0xef, 0x41,
+	0x25, 0xd9, 0x49, 0x7b, 0x49, 0xb9, 0xdb, 0xc8, 0xbb, 0x3b, 0x30, 0xda, 0x4e, 0x49, 0x3a, 0xc3,
+	0x29, 0xfa, 0xe1, 0xea, 0x17, 0xdf, 0x94, 0x1a, 0x50, 0x88, 0x2e, 0xd0, 0x92, 0xe4, 0x6e, 0x48,
+	0xb8, 0xf3, 0x87, 0x12, 0x54, 0x92, 0x88, 0x11, 0x82, 0xd2, 0xc4, 0x1b, 0x13, 0xdb, 0xda, 0xb6,
+	0x76, 0xaa, 0x58, 0x8d, 0xd1, 0x9b, 0x50, 0x3f, 0x0d, 0x27, 0x81, 0x6b, 0x12, 0x62, 0x17, 0x94,
+	0xae, 0x26, 0x65, 0x7b, 0x5a, 0x84, 0xde, 0x80, 0xaa, 0x82, 0x44, 0x94, 0x09, 0xbb, 0xb8, 0x6d,
+	0xed, 0x34, 0x70, 0x45, 0x0a, 0x8e, 0x28, 0x13, 0x68, 0x0f, 0x1a, 0x43, 0x21, 0x22, 0x37, 0x49,
+	0x86, 0x5d, 0x52, 0x21, 0x6f, 0xe6, 0x93, 0xd6, 0x13, 0x22, 0x4a, 0xc2, 0xe8, 0x2d, 0xe0, 0xfa,
+	0x30, 0x33, 0x47, 0x3f, 0x81, 0xba, 0xf0, 0x33, 0x1e, 0x16, 0x95, 0x87, 0x1b, 0x79, 0x0f, 0x27,
+	0x7e, 0xd6, 0x41, 0x4d, 0x4c, 0xa7, 0xe8, 0x23, 0x58, 0x1e, 0x5e, 0x9e, 0xb2, 0x30, 0x98, 0xba,
+	0xa8, 0x29, 0x17, 0x37, 0x67, 0x82, 0x50, 0xa0, 0x8c, 0x97, 0xe6, 0x30, 0x27, 0x41, 0x1f, 0x02,
+	0xe2, 0x7c, 0xe4, 0x6a, 0xaa, 0xc4, 0xcc, 0x53, 0xf4, 0xb3, 0xcb, 0x8a, 0x05, 0xaf, 0xe7, 0x7d,
+	0x1d, 0xf3, 0xd1, 0xbe, 0x82, 0xe1, 0x55, 0x9e, 0x0c, 0x13, 0x0b, 0xd4, 0x81, 0xe5, 0x98, 0x13,
+	0x57, 0xf5, 0x0f, 0x57, 0x31, 0xcc, 0x6c, 0xe4, 0x66, 0x4b, 0xd7, 0x7e, 0x2b, 0xa9, 0xfd, 0x56,
+	0x87, 0xd2, 0xd1, 0x53, 0x6f, 0x14, 0x13, 0xdc, 0x88, 0x39, 0x51, 0x1c, 0x3c, 0x92, 0x3a, 0xf4,
+	0x2e, 0x2c, 0x19, 0xfe, 0xdb, 0x15, 0x65, 0x7b, 0x6b, 0x3e, 0x0d, 0x0f, 0x35, 0x08, 0x27, 0x68,
+	0xf4, 0xe3, 0x0c, 0x7d, 0xaa, 0xca, 0xf2, 0xf5, 0x2b, 0x5f, 0x3d, 0x56, 0x1d, 0xa7, 0x53, 0x92,
+	0x84, 0x9c, 0xf2, 0xa7, 0xd3, 0x84, 0x7a, 0xe2, 0xf6, 0xe4, 0x32, 0x22, 0xce, 0x2f, 0xa0, 0x99,
+	0xcf, 0x19, 0xfa, 0x18, 0x56, 0x75, 0x79, 0x4d, 0x73, 0xcd, 0x6d, 0x6b, 0xbb, 0x78, 0x35, 0xbe,
+	0x03, 0x0d, 0x4b, 0xab, 0x65, 0x65, 0x9c, 0x17, 0x70, 0xe7, 0xd7, 0x05, 0x58, 0x9e, 0x41, 0xa1,
+	0x36, 0x2c, 0x99, 0xf2, 0x55, 0x24, 0xad, 0xed, 0xae, 0xcf, 0xf3, 0xca, 0x70, 0x82, 0xba, 0x4a,
+	0xbf, 0xc2, 0x77, 0xa6, 0x5f, 0xf1, 0x15, 0xe9, 0x97, 0x4d, 0x78, 0xe9, 0xbb, 0x25, 0xfc, 0x37,
+	0x16, 0x2c, 0x99, 0x25, 0xa2, 0x07, 0x00, 0x53, 0x32, 0x9a, 0x6c, 0x5c, 0x4b, 0xc2, 0x6a, 0x4a,
+	0x42, 0xf4, 0x04, 0xd6, 0x38, 0x8d, 0x99, 0x2f, 0xf9, 0x47, 0xfa, 0xe1, 0x85, 0xcb, 0xbc, 0xc9,
+	0x80, 0x24, 0xcd, 0x6c, 0xab, 0xa5, 0xda, 0x61, 0x4b, 0xbb, 0xd5, 0x7d, 0xe5, 0xec, 0x7e, 0x6b,
+	0x3f, 0x0c, 0x18, 0x96, 0x38, 0x8c, 0xb4, 0xf1, 0x91, 0xb2, 0x55, 0x22, 0xee, 0x7c, 0x69, 0x41,
+	0x2d, 0x93, 0x00, 0xb4, 0x0b, 0x55, 0x99, 0xb1, 0x21, 0xe5, 0x22, 0xd9, 0xfd, 0xf5, 0x2b, 0xe9,
+	0xea, 0x51, 0x2e, 0x70, 0x45, 0xe8, 0x01, 0x47, 0x0f, 0x67, 0xf9, 0xbc, 0x7d, 0x6d, 0x82, 0xaf,
+	0x50, 0x7a, 0x0b, 0x6a, 0xb2, 0x37, 0x9a, 0x05, 0xa9, 0x0d, 0xaa, 0x62, 0x90, 0x22, 0x1d, 0xa6,
+	0xf3, 0xa7, 0x22, 0x2c, 0x99, 0x4f, 0xce, 0x6d, 0x72, 0xf9, 0x5c, 0x16, 0xbf, 0x75, 0x2e, 0xf7,
+	0xa0, 0x16, 0x10, 0x2e, 0xc2, 0x89, 0x2a, 0x6c, 0xb3, 0xbb, 0x5b, 0x73, 0x97, 0x2a, 0x7f, 0xf7,
+	0x7c, 0x09, 0xc3, 0x59, 0x9b, 0xcd, 0x2f, 0x0b, 0x50, 0x4d, 0x55, 0xe8, 0x3e, 0x94, 0x79, 0x38,
+	0x19, 0x8c, 0x88, 0x6d, 0xcd, 0x63, 0xd9, 0xa3, 0xa9, 0x61, 0x6f, 0x01, 0x1b, 0x28, 0x7a, 0x00,
+	0x8b, 0xe3, 0x78, 0x24, 0x42, 0xc3, 0xed, 0xdb, 0x33, 0x25, 0x21, 0x55, 0x79, 0x43, 0x0d, 0x47,
+	0x1d, 0x68, 0xc6, 0x11, 0x17, 0x8c, 0x78, 0x63, 0x77, 0xc0, 0x68, 0x1c, 0xa5, 0xd4, 0xce, 0x1d,
+	0x27, 0x98, 0xe8, 0x2d, 0xc7, 0xa4, 0xdf, 0x5b, 0xc0, 0x8d, 0xc4, 0xe4, 0x23, 0x69, 0x81, 0x9e,
+	0x80, 0xdd, 0xa7, 0xec, 0xdc, 0x63, 0x81, 0xcb, 0x27, 0xa1, 0xeb, 0x8f, 0x62, 0x2e, 0x08, 0x73,
+	0x55, 0x86, 0x4b, 0xe6, 0x70, 0x9a, 0x25, 0x7b, 0x57, 0x5e, 0x76, 0x7a, 0x0b, 0x78, 0xdd, 0x58,
+	0x1e, 0x4f, 0xc2, 0x7d, 0x6d, 0xf7, 0xd8, 0x1b, 0x93, 0x4e, 0x23, 0x97, 0xd4, 0x8f, 0x4b, 0x95,
+	0xc2, 0x4a, 0xd1, 0xf9, 0x9d, 0x05, 0xf5, 0x5e, 0xbe, 0x2a, 0x1b, 0x67, 0x21, 0x13, 0xb1, 0x37,
+	0xca, 0xf1, 0x6c, 0x26, 0x61, 0x4f, 0x35, 0x44, 0x71, 0xad, 0x7e, 0x36, 0x9d, 0x70, 0xf4, 0xfe,
+	0x94, 0x6f, 0x3a, 0x6d, 0x6f, 0x5e, 0xdf, 0x12, 0x5e, 0x9d, 0x70, 0x7f, 0xb3, 0xa0, 0x96, 0xf9,
+	0xf6, 0x5c, 0xd2, 0xd9, 0xb0, 0x14, 0xd0, 0xb1, 0x17, 0x4e, 0x74, 0xed, 0x55, 0x71, 0x32, 0x45,
+	0xff, 0x0f, 0x65, 0x46, 0x63, 0x41, 0xb8, 0x5d, 0x54, 0x8b, 0x7a, 0x2d, 0x1f, 0x1a, 0x96, 0x3a,
+	0x6c, 0x20, 0xd9, 0xc2, 0x29, 0xcd, 0x2b, 0x9c, 0x4c, 0x18, 0x2f, 0x3c, 0x0b, 0xca, 0xaf, 0xd4,
+	0x9a, 0x9c, 0x3f, 0x16, 0x61, 0x51, 0x05, 0x82, 0x7e, 0x0a, 0x95, 0xe4, 0x4a, 0x65, 0x36, 0xe1,
+	0x4e, 0x2b, 0x11, 0x68, 0x26, 0xcd, 0x6d, 0xd1, 0xa9, 0x91, 0x6c, 0xb0, 0x6a, 0x2d, 0xae, 0xa7,
+	0x8a, 0xc0, 0xec, 0xc7, 0x8d, 0x39, 0x8b, 0xd6, 0x55, 0x22, 0x1b, 0x2c, 0x9b, 0x4e, 0xe5, 0xf9,
+	0xce, 0x48, 0x10, 0x32, 0xe2, 0x8b, 0xc4, 0x45, 0x71, 0xde, 0xf9, 0x8e, 0x0d, 0x28, 0xf5, 0xd2,
+	0x64, 0x39, 0x09, 0xfa, 0x1c, 0x36, 0x8c, 0x1b, 0x46, 0x78, 0x44, 0x27, 0x3c, 0x0d, 0x49, 0x67,
+	0xd6, 0x99, 0xa9, 0x46, 0x85, 0xc5, 0x06, 0x9a, 0x7a, 0x5d, 0x0b, 0xe6, 0xc8, 0xd1, 0x3b, 0xd3,
+	0x6d, 0x5a, 0x9c, 0x77, 0x04, 0xa9, 0xf5, 0x7d, 0x8f, 0x1b, 0x94, 0x52, 0x6e, 0x69, 0x4a, 0xb9,
+	0x4e, 0x05, 0xca, 0x7a, 0x41, 0xce, 0xbf, 0x2c, 0xa8, 0x65, 0x52, 0xfa, 0xbf, 0xd7, 0x78, 0xee,
+	0x42, 0x33, 0x69, 0x36, 0x43, 0xe2, 0x05, 0xe6, 0x62, 0x59, 0x95, 0x40, 0x23, 0xef, 0x29, 0xf1,
+	0x4c, 0x3b, 0x71, 0xfe, 0x52, 0x80, 0x5a, 0x26, 0x28, 0xf4, 0x2e, 0x54, 0x12, 0xc7, 0x36, 0xbc,
+	0x3c, 0x8a, 0x14, 0x8c, 0x3e, 0x80, 0xd2, 0xf3, 0xf8, 0x94, 0x98, 0xab, 0xe4, 0xff, 0xe5, 0xd7,
+	0xfe, 0x49, 0x7c, 0x4a, 0xd8, 0x84, 0x08, 0xc2, 0x8f, 0x09, 0x3b, 0x0b, 0x7d, 0x92, 0xcf, 0x83,
+	0xb2, 0x44, 0x1f, 0x40, 0xd9, 0xa7, 0x13, 0x1e, 0x8f, 0xec, 0xba, 0xf2, 0xf1, 0x76, 0xde, 0xc7,
+	0xbe, 0xd2, 0xcd, 0xb5, 0x37, 0x76, 0xa8, 0x07, 0x2b, 0x99, 0xb5, 0xb9, 0x3c, 0x22, 0xbe, 0xd9,
+	0x8b, 0x5b, 0xd7, 0xee, 0xdf, 0x71, 0x44, 0x7c, 0xbc, 0x1c, 0xe4, 0x05, 0xe8, 0x07, 0x50, 0xd6,
+	0xcf, 0x2e, 0xb3, 0x15, 0x6b, 0x33, 0xa7, 0x9f, 0xd2, 0x61, 0x83, 0xe9, 0xa0, 0xfc, 0x77, 0x85,
+	0xbc, 0x9b, 0x10, 0xb8, 0xf9, 0xa2, 0x55, 0xa3, 0x7b, 0x50, 0x64, 0xa4, 0x6f, 0x5b, 0x2f, 0xc9,
+	0xb1, 0x79, 0xb4, 0x48, 0xac, 0xa4, 0xb0, 0x7a, 0x53, 0x14, 0xd4, 0x9b, 0x42, 0x8d, 0x1d, 0x01,
+	0xf6, 0x75, 0x89, 0x91, 0x6f, 0x15, 0xae, 0xa5, 0x6e, 0xa6, 0xdb, 0xd6, 0x8c, 0x4c, 0x1e, 0x2e,
+	0xd2, 0xa5, 0xf0, 0x06, 0x49, 0xc7, 0x55, 0x63, 0x69, 0x26, 0x2b, 0xc6, 0xf5, 0xc9, 0x44, 0x10,
+	0xa6, 0x9b, 0x6e, 0x15, 0xd7, 0xa4, 0x6c, 0x5f, 0x8b, 0x9c, 0x7f, 0x5b, 0xd0, 0xf8, 0x2c, 0xc7,
+	0xbf, 0x2e, 0xd4, 0x33, 0x29, 0x48, 0x3a, 0xdf, 0xcc, 0x21, 0xf2, 0x73, 0x12, 0x0e, 0x86, 0x82,
+	0x04, 0x99, 0x20, 0x71, 0xce, 0xec, 0xbf, 0xe5, 0x65, 0x78, 0xe3, 0x8b, 0x6f, 0x4a, 0xeb, 0x50,
+	0x88, 0x07, 0x68, 0x39, 0x5f, 0x99, 0xdc, 0x79, 0x06, 0x2b, 0xb3, 0x95, 0xfc, 0x3d, 0x2d, 0xde,
+	0xf9, 0xbd, 0x05, 0xaf, 0xcd, 0x41, 0xa1, 0xf7, 0xf3, 0xd7, 0xaa, 0x97, 0x75, 0xa4, 0xdc, 0x85,
+	0x0a, 0x6d, 0x40, 0xf9, 0x5c, 0xf9, 0x34, 0xb4, 0x31, 0x33, 0xd4, 0x99, 0x36, 0x60, 0x4d, 0xf1,
+	0x9d, 0x97, 0x86, 0x3b, 0xdb, 0x8e, 0x9d, 0xaf, 0x8a, 0xd0, 0xcc, 0x9f, 0x22, 0xe8, 0x0e, 0x34,
+	0xe4, 0xfd, 0xc3, 0x4d, 0x8e, 0x12, 0x43, 0xba, 0xba, 0x14, 0x26, 0x50, 0xf4, 0x16, 0x34, 0x22,
+	0x4f, 0x0c, 0xa7, 0xa0, 0x82, 0xe9, 0x55, 0x75, 0x29, 0x4e, 0x61, 0x77, 0xa1, 0x99, 0xdc, 0xc9,
+	0xc9, 0x39, 0x0b, 0x05, 0xb1, 0x17, 0x0d, 0xae, 0xa1, 0xe5, 0x58, 0x8b, 0xd1, 0x53, 0x68, 0xa4,
+	0x27, 0x94, 0x4f, 0x03, 0xa2, 0x56, 0xd4, 0xdc, 0xbd, 0xf7, 0xa2, 0xf3, 0x2e, 0x9d, 0x26, 0x07,
+	0xd3, 0x3e, 0x0d, 0x08, 0xae, 0xb3, 0xcc, 0x0c, 0xbd, 0x05, 0x4d, 0xf9, 0xf4, 0xe1, 0xd3, 0x40,
+	0x65, 0x53, 0xad, 0x60, 0xf5, 0x86, 0xe2, 0x69, 0x9c, 0xea, 0xfa, 0xc3, 0xc2, 0xc8, 0xfd, 0x55,
+	0x4c, 0xd8, 0xa5, 0x62, 0x6e, 0x45, 0x5e, 0x7f, 0x58, 0x18, 0x3d, 0x91, 0x12, 0xe7, 0x1c, 0xd6,
+	0xe6, 0x7d, 0x0d, 0xad, 0xc3, 0xea, 0xc1, 0xe1, 0xd3, 0xee, 0x23, 0xf7, 0xa8, 0x8b, 0x0f, 0xf6,
+	0x1e, 0x77, 0x1f, 0x9f, 0x7c, 0xfa, 0x6c, 0x65, 0x01, 0x55, 0x61, 0xf1, 0xc3, 0xc3, 0xcf, 0x1e,
+	0x3f, 0x5a, 0xb1, 0x50, 0x03, 0xaa, 0xc7, 0xdd, 0xae, 0x7b, 0x78, 0xd2, 0xeb, 0xe2, 0x95, 0x02,
+	0xda, 0x00, 0x74, 0xd2, 0x3d, 0x38, 0x3a, 0xc4, 0x7b, 0xf8, 0x99, 0x8b, 0xbb, 0x8f, 0x7e, 0x86,
+	0xbb, 0xfb, 0x27, 0x2b, 0x45, 0x29, 0x4f, 0x5d, 0x4c, 0xe5, 0xa5, 0x8e, 0x0d, 0x1b, 0x26, 0xd1,
+	0x2a, 0x51, 0xaa, 0x23, 0x86, 0xfd, 0x90, 0x30, 0xa7, 0x03, 0x6b, 0xf3, 0xce, 0x6b, 0x49, 0x17,
+	0x53, 0x80, 0x96, 0xa6, 0x8b, 0x9e, 0xc9, 0x46, 0x71, 0x4a, 0x83, 0x4b, 0xf3, 0x7f, 0x87, 0x1a,
+	0x77, 0x1e, 0xca, 0x32, 0xfc, 0xed, 0xdf, 0x6f, 0x5b, 0x9f, 0xff, 0xe8, 0xdb, 0xfd, 0x51, 0x18,
+	0x3d, 0x1f, 0x98, 0xff, 0xa0, 0x4e, 0xcb, 0xea, 0xbc, 0xbe, 0xff, 0x9f, 0x01, 0x00, 0x19, 0xd1,
+	0x91, 0xdb, 0x63, 0x14, 0x00, 0x00,
 }
 
 func (this *Proxy) Equal(that interface{}) bool {
@@ -1748,6 +1963,184 @@ func (this *Listener_TcpListener) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Listener_HybridListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Listener_HybridListener)
+	if !ok {
+		that2, ok := that.(Listener_HybridListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HybridListener.Equal(that1.HybridListener) {
+		return false
+	}
+	return true
+}
+func (this *HybridListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HybridListener)
+	if !ok {
+		that2, ok := that.(HybridListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.MatchedListeners) != len(that1.MatchedListeners) {
+		return false
+	}
+	for i := range this.MatchedListeners {
+		if !this.MatchedListeners[i].Equal(that1.MatchedListeners[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MatchedListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchedListener)
+	if !ok {
+		that2, ok := that.(MatchedListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Matcher.Equal(that1.Matcher) {
+		return false
+	}
+	if that1.ListenerType == nil {
+		if this.ListenerType != nil {
+			return false
+		}
+	} else if this.ListenerType == nil {
+		return false
+	} else if !this.ListenerType.Equal(that1.ListenerType) {
+		return false
+	}
+	if !this.Metadata.Equal(that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MatchedListener_HttpListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchedListener_HttpListener)
+	if !ok {
+		that2, ok := that.(MatchedListener_HttpListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HttpListener.Equal(that1.HttpListener) {
+		return false
+	}
+	return true
+}
+func (this *MatchedListener_TcpListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchedListener_TcpListener)
+	if !ok {
+		that2, ok := that.(MatchedListener_TcpListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TcpListener.Equal(that1.TcpListener) {
+		return false
+	}
+	return true
+}
+func (this *Matcher) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Matcher)
+	if !ok {
+		that2, ok := that.(Matcher)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SslConfig.Equal(that1.SslConfig) {
+		return false
+	}
+	if len(this.SourcePrefixRanges) != len(that1.SourcePrefixRanges) {
+		return false
+	}
+	for i := range this.SourcePrefixRanges {
+		if !this.SourcePrefixRanges[i].Equal(that1.SourcePrefixRanges[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *TcpListener) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -6,17 +6,17 @@ package v1
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
+	cluster "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/cluster"
 	aws "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/aws"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
 	ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	rbac "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -523,7 +523,7 @@ var xxx_messageInfo_Settings_KubernetesSecrets proto.InternalMessageInfo
 // Use [HashiCorp Vault](https://www.vaultproject.io/) as storage for secret data.
 type Settings_VaultSecrets struct {
 	// the Token used to authenticate to Vault
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty" logging:"redact"`
 	// address is the address of the Vault server. This should be a complete
 	// URL such as http://solo.io
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -873,7 +873,7 @@ type Settings_ConsulConfiguration struct {
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 	// Token is used to provide a per-request ACL token
 	// which overrides the agent's default token.
-	Token string `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	Token string `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty" logging:"redact"`
 	// caFile is the optional path to the CA certificate used for Consul
 	// communication, defaults to the system bundle if not specified.
 	CaFile string `protobuf:"bytes,6,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`
@@ -1304,10 +1304,13 @@ type GlooOptions struct {
 	// 2. https://github.com/solo-io/gloo/issues/3710
 	// 3. https://github.com/solo-io/gloo/issues/3219
 	// Rest XDS, as opposed to grpc, uses http polling rather than streaming
-	EnableRestEds        *types.BoolValue `protobuf:"bytes,12,opt,name=enable_rest_eds,json=enableRestEds,proto3" json:"enable_rest_eds,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	EnableRestEds *types.BoolValue `protobuf:"bytes,12,opt,name=enable_rest_eds,json=enableRestEds,proto3" json:"enable_rest_eds,omitempty"`
+	// Default outlier detection configuration to use for upstreams,
+	// when not provided by specific upstream.
+	OutlierDetection     *cluster.OutlierDetection `protobuf:"bytes,13,opt,name=outlier_detection,json=outlierDetection,proto3" json:"outlier_detection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *GlooOptions) Reset()         { *m = GlooOptions{} }
@@ -1418,6 +1421,13 @@ func (m *GlooOptions) GetEnableRestEds() *types.BoolValue {
 	return nil
 }
 
+func (m *GlooOptions) GetOutlierDetection() *cluster.OutlierDetection {
+	if m != nil {
+		return m.OutlierDetection
+	}
+	return nil
+}
+
 type GlooOptions_AWSOptions struct {
 	// Types that are valid to be assigned to CredentialsFetcher:
 	//	*GlooOptions_AWSOptions_EnableCredentialsDiscovey
@@ -1578,10 +1588,14 @@ type GatewayOptions struct {
 	AlwaysSortRouteTableRoutes bool `protobuf:"varint,5,opt,name=always_sort_route_table_routes,json=alwaysSortRouteTableRoutes,proto3" json:"always_sort_route_table_routes,omitempty"` // Deprecated: Do not use.
 	// If set, compresses proxy space. This can help make the Proxy CRD smaller to fit in etcd.
 	// This is an advanced option. Use with care.
-	CompressedProxySpec  bool     `protobuf:"varint,6,opt,name=compressed_proxy_spec,json=compressedProxySpec,proto3" json:"compressed_proxy_spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CompressedProxySpec bool `protobuf:"varint,6,opt,name=compressed_proxy_spec,json=compressedProxySpec,proto3" json:"compressed_proxy_spec,omitempty"`
+	// When true, references to resources in other namespaces are denied unless a ReferencePolicy in the namespace
+	// of the referenced resource allows them. By default, such references are allowed unless a ReferencePolicy
+	// restricts or denies them.
+	DenyCrossNamespaceReferences bool     `protobuf:"varint,7,opt,name=deny_cross_namespace_references,json=denyCrossNamespaceReferences,proto3" json:"deny_cross_namespace_references,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
 }
 
 func (m *GatewayOptions) Reset()         { *m = GatewayOptions{} }
@@ -1644,6 +1658,13 @@ func (m *GatewayOptions) GetCompressedProxySpec() bool {
 	return false
 }
 
+func (m *GatewayOptions) GetDenyCrossNamespaceReferences() bool {
+	if m != nil {
+		return m.DenyCrossNamespaceReferences
+	}
+	return false
+}
+
 // options for configuring admission control / validation
 type GatewayOptions_ValidationOptions struct {
 	// Address of the `gloo` proxy validation grpc server. Defaults to `gloo:9988`.
@@ -1765,169 +1786,175 @@ func init() {
 }
 
 var fileDescriptor_bd7533c2495e1752 = []byte{
-	// 2578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0x4d, 0x6f, 0x1b, 0xc7,
-	0xf9, 0x37, 0x65, 0x59, 0x22, 0x1f, 0xea, 0x75, 0x24, 0x4b, 0x2b, 0x4a, 0x96, 0x1c, 0xfd, 0x93,
-	0x7f, 0x9d, 0x04, 0x21, 0x13, 0x25, 0x4d, 0xd3, 0x38, 0x41, 0x2a, 0xca, 0x52, 0xa4, 0xca, 0x4e,
-	0x9d, 0xa5, 0x6c, 0x17, 0x41, 0xd1, 0xc5, 0x70, 0x77, 0x48, 0x4d, 0xb9, 0xdc, 0x59, 0xcc, 0x0c,
-	0x29, 0x31, 0xc7, 0x7c, 0x85, 0xa2, 0x87, 0x7e, 0x83, 0x02, 0xb9, 0xf6, 0x90, 0x73, 0x4f, 0x2d,
-	0x7a, 0xea, 0x07, 0x68, 0x0e, 0xfd, 0x06, 0x29, 0x10, 0xa0, 0x40, 0x2f, 0xc5, 0xbc, 0xec, 0x0b,
-	0x29, 0x91, 0x96, 0x2f, 0x02, 0x67, 0x9e, 0xe7, 0xf7, 0x9b, 0x99, 0x67, 0x9e, 0xb7, 0x59, 0xc1,
-	0xc3, 0x36, 0x95, 0xe7, 0xbd, 0x66, 0xd5, 0x67, 0xdd, 0x9a, 0x60, 0x21, 0x7b, 0x87, 0xb2, 0x5a,
-	0x3b, 0x64, 0xac, 0x16, 0x73, 0xf6, 0x3b, 0xe2, 0x4b, 0x61, 0x46, 0x38, 0xa6, 0xb5, 0xfe, 0x7b,
-	0x35, 0x41, 0xa4, 0xa4, 0x51, 0x5b, 0x54, 0x63, 0xce, 0x24, 0x43, 0x73, 0x4a, 0x56, 0x55, 0xb0,
-	0x2a, 0x65, 0x95, 0xd5, 0x36, 0x6b, 0x33, 0x2d, 0xa8, 0xa9, 0x5f, 0x46, 0xa7, 0x82, 0xc8, 0xa5,
-	0x34, 0x93, 0xe4, 0x52, 0xda, 0xb9, 0x6d, 0xbd, 0x52, 0x87, 0xca, 0x84, 0xb7, 0x4b, 0x24, 0x0e,
-	0xb0, 0xc4, 0x56, 0xbe, 0x35, 0x2a, 0x17, 0x12, 0xcb, 0x9e, 0x18, 0x87, 0x4e, 0xc6, 0x56, 0xbe,
-	0x31, 0x2a, 0xe7, 0xa4, 0x65, 0x45, 0x6f, 0x8d, 0x3f, 0x1a, 0xb9, 0x94, 0x24, 0x12, 0x94, 0x45,
-	0xc9, 0x32, 0x47, 0x13, 0x74, 0x23, 0x49, 0x78, 0xcc, 0xa9, 0x20, 0x35, 0x16, 0x4b, 0x85, 0xa9,
-	0x71, 0x2c, 0x49, 0x48, 0xbb, 0x54, 0x66, 0xbf, 0x2c, 0xcf, 0xe1, 0x2b, 0xf1, 0x90, 0x4b, 0x89,
-	0x7b, 0xf2, 0xdc, 0xee, 0x48, 0xfd, 0xb4, 0x34, 0x9f, 0xbc, 0xda, 0x76, 0x9a, 0xd8, 0xd7, 0x7f,
-	0x2c, 0x7a, 0xc2, 0x9d, 0xfa, 0x94, 0xfb, 0x3d, 0x2a, 0xbd, 0x26, 0x27, 0xb8, 0x43, 0xb8, 0x05,
-	0xec, 0x8f, 0x01, 0x28, 0x33, 0xf1, 0x08, 0x87, 0x35, 0x12, 0xf5, 0xd9, 0x20, 0x67, 0xb5, 0x1a,
-	0xbe, 0x10, 0xb5, 0x16, 0x0d, 0x65, 0x4a, 0xb1, 0xdd, 0x66, 0xac, 0x1d, 0x92, 0x9a, 0x1e, 0x35,
-	0x7b, 0xad, 0x5a, 0xd0, 0xe3, 0x58, 0x6d, 0x6f, 0x9c, 0xfc, 0x82, 0xe3, 0x38, 0x26, 0xdc, 0x5e,
-	0xc0, 0xee, 0x5f, 0x76, 0xa0, 0xd8, 0xb0, 0x0e, 0x87, 0x6a, 0xb0, 0x12, 0x50, 0xe1, 0xb3, 0x3e,
-	0xe1, 0x03, 0x2f, 0xc2, 0x5d, 0x22, 0x62, 0xec, 0x13, 0xa7, 0x70, 0xbf, 0xf0, 0xa0, 0xe4, 0xa2,
-	0x54, 0xf4, 0x45, 0x22, 0x41, 0x6f, 0xc2, 0xd2, 0x05, 0x96, 0xfe, 0x79, 0xa6, 0x2c, 0x9c, 0xa9,
-	0xfb, 0xb7, 0x1f, 0x94, 0xdc, 0x45, 0x3d, 0x9f, 0x6a, 0x0a, 0x84, 0xc1, 0xe9, 0xf4, 0x9a, 0x84,
-	0x47, 0x44, 0x12, 0xe1, 0xf9, 0x2c, 0x6a, 0xd1, 0xb6, 0x27, 0x58, 0x8f, 0xfb, 0xc4, 0x99, 0xbe,
-	0x5f, 0x78, 0x50, 0xde, 0x7b, 0xa3, 0x9a, 0xf7, 0xf4, 0x6a, 0xb2, 0xab, 0xea, 0x69, 0x0a, 0x3b,
-	0xe0, 0x81, 0x38, 0xbe, 0xe5, 0xae, 0x65, 0x44, 0x07, 0x9a, 0xa7, 0xa1, 0x69, 0xd0, 0x57, 0xb0,
-	0x1e, 0x50, 0x4e, 0x7c, 0xc9, 0xf8, 0x60, 0x64, 0x85, 0x3b, 0x7a, 0x85, 0xfb, 0x63, 0x56, 0x78,
-	0x94, 0xa0, 0x8e, 0x6f, 0xb9, 0x77, 0x53, 0x8a, 0x21, 0xee, 0x53, 0x58, 0xf2, 0x59, 0x24, 0x7a,
-	0xa1, 0xd7, 0xe9, 0x27, 0xa4, 0x77, 0x35, 0xe9, 0xce, 0x18, 0xd2, 0x03, 0xad, 0x7e, 0xda, 0x3f,
-	0xbe, 0xe5, 0x2e, 0xf8, 0xf6, 0xb7, 0x25, 0x0b, 0x86, 0x6c, 0x21, 0x88, 0xcf, 0x89, 0x4c, 0x48,
-	0x67, 0x34, 0xe9, 0x83, 0x97, 0xda, 0xa2, 0xa1, 0x51, 0xe2, 0xb8, 0x90, 0x37, 0x87, 0x99, 0xb4,
-	0xab, 0x3c, 0x83, 0x95, 0x3e, 0xee, 0x85, 0x72, 0x64, 0x81, 0x59, 0xbd, 0xc0, 0xff, 0x8d, 0x59,
-	0xe0, 0xb9, 0x42, 0x64, 0xdc, 0xcb, 0xfd, 0x6c, 0x7c, 0x9d, 0x95, 0x87, 0xa9, 0x8b, 0x37, 0xb4,
-	0x72, 0x21, 0x67, 0xe5, 0x21, 0xee, 0x0e, 0x54, 0x72, 0x86, 0xc1, 0x5c, 0xd2, 0x16, 0xf6, 0x53,
-	0xfa, 0x92, 0xa6, 0x7f, 0xfb, 0xe5, 0x6e, 0xa2, 0x2f, 0xae, 0x8b, 0x63, 0x71, 0x3c, 0xe5, 0xe6,
-	0x2c, 0xbd, 0x6f, 0xf9, 0xec, 0x62, 0xbf, 0x85, 0x8d, 0xec, 0x20, 0xa3, 0x6b, 0xc1, 0x0d, 0x8f,
-	0x32, 0xe5, 0x66, 0xd6, 0x18, 0xe1, 0xff, 0x0d, 0x6c, 0x64, 0x2e, 0x33, 0xca, 0xbf, 0x7e, 0x33,
-	0xdf, 0x99, 0x72, 0xd7, 0x12, 0xdf, 0x19, 0x61, 0xff, 0x04, 0xe6, 0x38, 0x69, 0x71, 0x22, 0xce,
-	0x3d, 0x95, 0x0c, 0x9d, 0x39, 0x4d, 0xb8, 0x51, 0x35, 0xf1, 0x5e, 0x4d, 0xe2, 0xbd, 0xfa, 0xc8,
-	0xe6, 0x03, 0xb7, 0x6c, 0xd5, 0x5d, 0x2c, 0x09, 0xda, 0x80, 0x62, 0x40, 0xfa, 0x5e, 0x97, 0x05,
-	0xc4, 0x99, 0xbf, 0x5f, 0x78, 0x50, 0x74, 0x67, 0x03, 0xd2, 0x7f, 0xc2, 0x02, 0x82, 0x1c, 0x98,
-	0x0d, 0x69, 0xd4, 0x21, 0x3c, 0x70, 0x96, 0x8d, 0xc4, 0x0e, 0xd1, 0x67, 0x30, 0xdb, 0x89, 0xb0,
-	0xa4, 0x7d, 0xe2, 0xa0, 0xc9, 0x11, 0x6b, 0xb4, 0x7e, 0x65, 0xf2, 0xa4, 0x9b, 0xa0, 0xd0, 0x21,
-	0x94, 0xd2, 0x24, 0xe2, 0xac, 0x68, 0x8a, 0x9f, 0x8c, 0xb5, 0xb0, 0xd5, 0x4b, 0x48, 0x32, 0x24,
-	0x7a, 0x07, 0xa6, 0x15, 0xc8, 0x71, 0x92, 0x23, 0xe7, 0x19, 0x3e, 0x0f, 0x19, 0x4b, 0x30, 0x5a,
-	0x0d, 0x7d, 0x08, 0xb3, 0x6d, 0x2c, 0xc9, 0x05, 0x1e, 0x38, 0x1b, 0x1a, 0xb1, 0x35, 0x82, 0x30,
-	0xc2, 0x74, 0xb7, 0x56, 0x19, 0xd5, 0x61, 0xc6, 0xd8, 0xde, 0x59, 0xd5, 0xb0, 0xb7, 0x26, 0x5e,
-	0x96, 0x71, 0xba, 0xc4, 0xd8, 0x16, 0x89, 0x08, 0x2c, 0x9a, 0x5f, 0xe9, 0x79, 0x9c, 0x6d, 0x4d,
-	0xf6, 0x70, 0x22, 0xd9, 0xb3, 0x58, 0x48, 0x4e, 0x70, 0x37, 0x45, 0x0d, 0xb3, 0x8f, 0x72, 0xa2,
-	0x2f, 0x00, 0x32, 0x37, 0x77, 0xd6, 0xf4, 0x0a, 0xd5, 0x1b, 0xc6, 0x49, 0x42, 0x9a, 0x63, 0x40,
-	0x1f, 0x01, 0x64, 0x45, 0xc7, 0x59, 0xd2, 0x7c, 0xce, 0x30, 0xdf, 0x61, 0x2a, 0x77, 0x73, 0xba,
-	0xe8, 0x09, 0x94, 0xd2, 0xda, 0xec, 0x54, 0x34, 0xb0, 0x56, 0xcd, 0xaa, 0xb5, 0x2d, 0x9d, 0xa3,
-	0x5b, 0xe3, 0x7d, 0xea, 0x93, 0x64, 0x87, 0x6e, 0xc6, 0x80, 0x1a, 0xb0, 0x94, 0x0e, 0x3c, 0x41,
-	0x78, 0x9f, 0x70, 0x67, 0xd3, 0x66, 0xc8, 0x97, 0xb2, 0x5a, 0xba, 0xc5, 0x54, 0xb1, 0xa1, 0x09,
-	0xd0, 0xcf, 0x60, 0x5a, 0x55, 0x6d, 0x67, 0xcb, 0x66, 0x42, 0x5d, 0xc2, 0x27, 0x73, 0x68, 0x00,
-	0x7a, 0x08, 0xb3, 0xb6, 0x5f, 0x70, 0xee, 0x69, 0xec, 0x6b, 0xd5, 0xac, 0x2d, 0x18, 0x83, 0x4c,
-	0x10, 0xe8, 0x23, 0x28, 0x26, 0x1d, 0x98, 0xb3, 0xa0, 0xd1, 0x6b, 0x55, 0x9f, 0x71, 0x92, 0x42,
-	0x9e, 0x58, 0x69, 0x7d, 0xfa, 0xaf, 0xdf, 0xef, 0xdc, 0x72, 0x53, 0x6d, 0x74, 0x0a, 0x33, 0xa6,
-	0x37, 0x73, 0x16, 0x35, 0x6e, 0x75, 0x18, 0xd7, 0xd0, 0xb2, 0xfa, 0xbd, 0xef, 0x7e, 0x9c, 0x2e,
-	0x28, 0xe4, 0xbf, 0xbf, 0xdf, 0x59, 0x96, 0x44, 0xc8, 0x80, 0xb6, 0x5a, 0x1f, 0xef, 0xd2, 0x76,
-	0xc4, 0x38, 0xd9, 0x75, 0x2d, 0x45, 0x65, 0x09, 0x16, 0x86, 0x0b, 0x6a, 0x65, 0x05, 0x96, 0xaf,
-	0x94, 0x95, 0xca, 0xb7, 0x53, 0x30, 0x97, 0xaf, 0x05, 0x68, 0x15, 0xee, 0x48, 0xd6, 0x21, 0x91,
-	0xed, 0x06, 0xcc, 0x40, 0x25, 0x0b, 0x1c, 0x04, 0x9c, 0x08, 0x55, 0xf7, 0xd5, 0x7c, 0x32, 0x44,
-	0xeb, 0x30, 0xeb, 0x63, 0xcf, 0x27, 0x5c, 0x3a, 0xb7, 0xb5, 0x64, 0xc6, 0xc7, 0x07, 0x84, 0x4b,
-	0x2b, 0x88, 0xb1, 0x3c, 0xd7, 0x75, 0x5f, 0x0b, 0x9e, 0x62, 0x79, 0x8e, 0x76, 0xa0, 0xec, 0x87,
-	0x94, 0x44, 0xd2, 0xa0, 0xee, 0x68, 0x21, 0x98, 0x29, 0x8d, 0xbc, 0x07, 0x76, 0xe4, 0x75, 0xc8,
-	0x40, 0x17, 0xca, 0x92, 0x5b, 0x32, 0x33, 0xa7, 0x64, 0x80, 0xfe, 0x1f, 0x16, 0x65, 0x28, 0xac,
-	0x97, 0xe8, 0x8e, 0x44, 0xd7, 0xba, 0x92, 0x3b, 0x2f, 0x43, 0x61, 0xae, 0x5e, 0xf5, 0x23, 0xe8,
-	0x43, 0x28, 0xd2, 0x48, 0x10, 0xbf, 0xc7, 0x93, 0x8a, 0x55, 0xb9, 0x92, 0x35, 0xeb, 0x8c, 0x85,
-	0xcf, 0x71, 0xd8, 0x23, 0x6e, 0xaa, 0xab, 0x72, 0x26, 0x67, 0xcc, 0x2c, 0x5e, 0x32, 0x87, 0x55,
-	0xe3, 0x53, 0x32, 0xa8, 0xbc, 0x01, 0xc5, 0x24, 0x65, 0x0f, 0xa9, 0x15, 0x86, 0xd5, 0xd6, 0x60,
-	0xf5, 0xba, 0x2a, 0x55, 0x79, 0x13, 0x4a, 0x69, 0x45, 0x41, 0x5b, 0x2a, 0x49, 0xda, 0x81, 0x25,
-	0xc8, 0x26, 0x2a, 0xff, 0x2c, 0xc0, 0xc2, 0x70, 0x7a, 0x45, 0xfb, 0x70, 0xcf, 0x0f, 0x7b, 0x42,
-	0x12, 0xee, 0xd1, 0xa8, 0xad, 0x8c, 0xef, 0xc5, 0x9c, 0x5d, 0x0e, 0xbc, 0xe4, 0x66, 0x0c, 0x49,
-	0xc5, 0x2a, 0x9d, 0x18, 0x9d, 0xa7, 0x4a, 0x65, 0xdf, 0x5e, 0xd6, 0x01, 0x6c, 0xdb, 0x1c, 0xed,
-	0x25, 0xbd, 0xe7, 0x08, 0x87, 0xb9, 0xdd, 0x4d, 0xab, 0x75, 0x68, 0x95, 0xc6, 0x91, 0xd0, 0xe8,
-	0x5a, 0x92, 0xdb, 0x43, 0x24, 0x27, 0xd1, 0x55, 0x92, 0xca, 0x1f, 0x0a, 0xb0, 0x34, 0x9a, 0xfb,
-	0xd1, 0x2f, 0xa1, 0xd8, 0x0a, 0x84, 0xa9, 0x56, 0xea, 0x30, 0x0b, 0x7b, 0xb5, 0x1b, 0x96, 0x8d,
-	0xea, 0x51, 0x20, 0x54, 0x55, 0x73, 0x67, 0x5b, 0xe6, 0xc7, 0xee, 0x4f, 0x61, 0xd6, 0xce, 0xa1,
-	0x79, 0x28, 0xd5, 0x1f, 0xef, 0x1f, 0x9c, 0x3e, 0x3e, 0x69, 0x9c, 0x2d, 0xdd, 0x52, 0xc3, 0x17,
-	0xc7, 0x27, 0x67, 0x87, 0x7a, 0x58, 0x40, 0x73, 0x50, 0x7c, 0x74, 0xd2, 0xd8, 0xaf, 0x3f, 0x3e,
-	0x7c, 0xb4, 0x34, 0x55, 0xf9, 0xc7, 0x1d, 0x58, 0xb9, 0x26, 0xd1, 0xa3, 0xad, 0x2c, 0x00, 0xb4,
-	0x99, 0xeb, 0x53, 0x4e, 0x21, 0x0b, 0x82, 0x6d, 0x00, 0x15, 0xc1, 0xbe, 0xce, 0x12, 0xd6, 0x86,
-	0xb9, 0x19, 0x54, 0x81, 0x62, 0x4f, 0x28, 0x23, 0x74, 0x89, 0x35, 0x4e, 0x3a, 0x56, 0xb2, 0x18,
-	0x0b, 0x71, 0xc1, 0x78, 0x60, 0x03, 0x25, 0x1d, 0x67, 0xc1, 0x78, 0x27, 0x1f, 0x8c, 0x26, 0xb2,
-	0x5a, 0x34, 0x24, 0x36, 0x38, 0x66, 0x7c, 0x7c, 0x44, 0x43, 0x92, 0x0f, 0xb9, 0xd9, 0xa1, 0x90,
-	0xdb, 0x84, 0x92, 0x8a, 0x35, 0x83, 0x29, 0x9a, 0x45, 0xd4, 0x84, 0x46, 0x6d, 0x40, 0xb1, 0x43,
-	0x06, 0x46, 0x66, 0xfd, 0xbd, 0x43, 0x06, 0x5a, 0xf4, 0x18, 0x56, 0x93, 0xb0, 0xf0, 0x44, 0x87,
-	0xc6, 0x5e, 0x9f, 0x70, 0xda, 0x1a, 0xd8, 0xae, 0x69, 0x52, 0x38, 0xa1, 0x04, 0xd7, 0xe8, 0xd0,
-	0xf8, 0xb9, 0x46, 0xa1, 0x0f, 0xa1, 0x74, 0x81, 0xa9, 0xf4, 0x24, 0xed, 0x12, 0xa7, 0xfc, 0xb2,
-	0x3e, 0xa6, 0xa8, 0x74, 0xcf, 0x68, 0x97, 0x20, 0x06, 0xcb, 0xc2, 0x94, 0x0e, 0x2f, 0x6b, 0x2b,
-	0x4c, 0x1f, 0x54, 0xbf, 0x79, 0xad, 0x4e, 0xca, 0xcf, 0x95, 0x8e, 0x63, 0x49, 0x8c, 0x08, 0xd0,
-	0x6b, 0x30, 0x77, 0x2e, 0x65, 0x9c, 0xfa, 0xf3, 0xbc, 0xb6, 0x4a, 0x59, 0xcd, 0x25, 0x41, 0xb0,
-	0x03, 0xe5, 0x20, 0x12, 0xa9, 0xc6, 0x82, 0xbd, 0xf2, 0x48, 0x24, 0x0a, 0xa7, 0xb0, 0xaa, 0x14,
-	0x62, 0x16, 0x86, 0x34, 0x6a, 0x9b, 0x48, 0xe9, 0xe3, 0xd0, 0xa6, 0xf6, 0x09, 0xe7, 0x46, 0x41,
-	0x24, 0x9e, 0x1a, 0xd4, 0x89, 0x05, 0x55, 0x3e, 0x81, 0xf5, 0x31, 0xbb, 0x57, 0x7b, 0x55, 0x8e,
-	0xe6, 0x19, 0x4f, 0x53, 0xde, 0xa9, 0x9e, 0x65, 0x65, 0x35, 0x77, 0x60, 0xa6, 0x2a, 0x7f, 0x2f,
-	0xc0, 0xeb, 0x37, 0xe9, 0x37, 0xd0, 0xeb, 0x30, 0xdf, 0x13, 0xe4, 0x2c, 0x14, 0x67, 0xb8, 0xdd,
-	0xa6, 0x51, 0x5b, 0x77, 0x04, 0x45, 0x77, 0x78, 0x52, 0x39, 0xbb, 0xd4, 0x23, 0x95, 0x65, 0x75,
-	0xef, 0x58, 0x72, 0x73, 0x33, 0xe8, 0x3d, 0x98, 0x51, 0x89, 0xf0, 0x00, 0xdb, 0xee, 0x71, 0x63,
-	0xb8, 0x8c, 0xb9, 0xc4, 0xb4, 0xc6, 0x2e, 0x69, 0xb9, 0x56, 0x11, 0xbd, 0x05, 0x4b, 0x22, 0x0e,
-	0xa9, 0x3c, 0x33, 0x09, 0x9c, 0xaa, 0xf7, 0xe5, 0x8a, 0x5e, 0xfb, 0xca, 0x7c, 0xe5, 0xdb, 0x02,
-	0xac, 0x8f, 0xe9, 0x6d, 0xd0, 0x57, 0x50, 0x56, 0x4d, 0x80, 0xa7, 0xbb, 0x00, 0x13, 0xa9, 0xe5,
-	0xbd, 0x9f, 0xbf, 0x5a, 0x83, 0x54, 0x55, 0x8d, 0xf3, 0x63, 0x4d, 0xe0, 0x02, 0x4f, 0x7f, 0x57,
-	0x3e, 0x00, 0xc8, 0x24, 0x68, 0x09, 0x6e, 0x7f, 0xf9, 0xb4, 0xa1, 0x57, 0x98, 0x72, 0xd5, 0x4f,
-	0x15, 0xab, 0xcd, 0x1e, 0x17, 0x52, 0x87, 0xff, 0xbc, 0x6b, 0x06, 0x1f, 0xaf, 0x7d, 0xf3, 0xc3,
-	0xf4, 0x34, 0x4c, 0x09, 0xf9, 0xcd, 0x0f, 0xd3, 0x80, 0x8a, 0xc9, 0x37, 0x9f, 0xfa, 0x22, 0xcc,
-	0x0f, 0xbd, 0x5c, 0xd5, 0xc4, 0xd0, 0x23, 0xab, 0xbe, 0x0c, 0x8b, 0x23, 0x8f, 0x89, 0xdd, 0x3f,
-	0x03, 0x94, 0x73, 0x7d, 0x2f, 0xda, 0x85, 0xf9, 0xcb, 0x40, 0x78, 0x4d, 0x1a, 0x05, 0xda, 0x13,
-	0x6d, 0x05, 0x28, 0x5f, 0x06, 0xa2, 0x4e, 0xa3, 0x40, 0xb9, 0x22, 0x7a, 0x17, 0x56, 0xfb, 0x38,
-	0xa4, 0x81, 0x3e, 0x5b, 0x4e, 0xd5, 0x24, 0x29, 0x94, 0xc9, 0x52, 0xc4, 0x13, 0x58, 0x1a, 0xf9,
-	0x8c, 0x61, 0x32, 0x7a, 0x79, 0x6f, 0x77, 0xd8, 0x92, 0x07, 0x46, 0xab, 0x6e, 0x94, 0x8c, 0x11,
-	0xdd, 0x45, 0x7f, 0x68, 0x56, 0xa0, 0x67, 0xb0, 0x41, 0xa2, 0x20, 0x66, 0x34, 0x92, 0xc2, 0xbb,
-	0xc0, 0xbc, 0xab, 0xc2, 0x41, 0xa5, 0x00, 0xd6, 0x93, 0xf6, 0x8b, 0xc0, 0x84, 0x68, 0x58, 0x4f,
-	0xb1, 0x2f, 0x0c, 0xf4, 0xcc, 0x20, 0xd1, 0x21, 0x94, 0xf1, 0x85, 0xf0, 0x6c, 0x3b, 0x67, 0x1f,
-	0xfe, 0xaf, 0x8f, 0x7d, 0x23, 0x54, 0xf7, 0x5f, 0x34, 0x92, 0x80, 0x07, 0x7c, 0x21, 0x12, 0x13,
-	0x62, 0xb8, 0x4b, 0x23, 0x6d, 0x84, 0xe4, 0x4b, 0x42, 0xcc, 0x42, 0xea, 0x0f, 0xec, 0xfb, 0xfc,
-	0x9d, 0xf1, 0x84, 0x27, 0x06, 0x66, 0x8e, 0xfd, 0x54, 0x83, 0xdc, 0x15, 0x7a, 0x75, 0x12, 0x1d,
-	0xc1, 0x4e, 0x40, 0x05, 0x6e, 0x86, 0xc4, 0xcb, 0x3d, 0x7a, 0x03, 0x22, 0x24, 0x8d, 0xb0, 0xd9,
-	0xfd, 0xac, 0xf6, 0xf5, 0x7b, 0x56, 0x2d, 0x73, 0xcc, 0x47, 0x39, 0x25, 0xf4, 0x08, 0x96, 0x12,
-	0x9e, 0x36, 0x8f, 0x7d, 0xef, 0x82, 0x34, 0x6f, 0xd0, 0xd7, 0x2c, 0x58, 0xcc, 0xe7, 0x3c, 0xf6,
-	0x5f, 0x90, 0x26, 0xf2, 0xe1, 0x7e, 0xc2, 0x62, 0x8a, 0x76, 0x1b, 0xf3, 0x26, 0x6e, 0x13, 0xcf,
-	0x67, 0x61, 0x48, 0x7c, 0xb5, 0x94, 0x7d, 0x80, 0x4f, 0x62, 0x4d, 0xb6, 0xaa, 0x6b, 0xfa, 0xe7,
-	0x86, 0xe1, 0x20, 0x25, 0x40, 0x5f, 0xc2, 0x1a, 0x27, 0x6d, 0x72, 0xe9, 0x75, 0xf1, 0xa5, 0x5a,
-	0xa6, 0xcd, 0x71, 0xd7, 0x13, 0xf4, 0xeb, 0xe4, 0xbd, 0xbd, 0x75, 0x85, 0xfa, 0xd9, 0x49, 0x24,
-	0xdf, 0xdf, 0x33, 0xe4, 0x2b, 0x1a, 0xfb, 0x04, 0x5f, 0x3e, 0x35, 0xc8, 0x06, 0xfd, 0x9a, 0xa0,
-	0xb7, 0x01, 0x71, 0x22, 0xa4, 0x37, 0xec, 0xf0, 0x65, 0xed, 0xc5, 0x8b, 0x4a, 0xf2, 0xeb, 0x9c,
-	0xd3, 0xd7, 0x61, 0x91, 0x44, 0xfa, 0x8c, 0x1a, 0x43, 0x02, 0x61, 0xeb, 0xc5, 0xa4, 0x33, 0xcd,
-	0x1b, 0x88, 0x4b, 0x84, 0x3c, 0x0c, 0x44, 0xe5, 0xbf, 0x05, 0x80, 0xcc, 0x69, 0xd0, 0x2f, 0x60,
-	0xd3, 0x52, 0xfa, 0x9c, 0x04, 0x24, 0x92, 0x14, 0x87, 0x22, 0xa9, 0x47, 0xa6, 0x81, 0x2b, 0x1e,
-	0xdf, 0x72, 0x37, 0x8c, 0xd2, 0x41, 0xa6, 0x63, 0x73, 0xed, 0x00, 0xfd, 0xbe, 0x00, 0x9b, 0x49,
-	0x1d, 0xc3, 0xbe, 0xcf, 0x7a, 0xaa, 0x03, 0xce, 0xf4, 0x74, 0x44, 0x96, 0xf7, 0xbe, 0xac, 0xea,
-	0x8f, 0x81, 0x55, 0xe3, 0x8d, 0x55, 0xfb, 0x11, 0x50, 0x95, 0x9e, 0xaa, 0xf2, 0xf7, 0x10, 0x77,
-	0x9b, 0x01, 0xae, 0xf6, 0xf7, 0x94, 0x43, 0x3f, 0xd6, 0x03, 0xe3, 0x6c, 0x49, 0x79, 0xdb, 0x37,
-	0xcc, 0xb9, 0x0d, 0xa8, 0x5d, 0x89, 0x71, 0xc2, 0xfa, 0x5d, 0x58, 0xc9, 0x1f, 0xa8, 0x45, 0xa4,
-	0x7f, 0x4e, 0x78, 0xe5, 0x6f, 0x05, 0x58, 0xb9, 0xc6, 0xc3, 0xd1, 0x07, 0xea, 0x66, 0xe3, 0x10,
-	0xfb, 0xaa, 0xf9, 0x33, 0x71, 0xc3, 0x59, 0x4f, 0xbd, 0x46, 0xb5, 0x05, 0xdc, 0x55, 0x2b, 0xb5,
-	0x58, 0x57, 0xcb, 0xd0, 0xa7, 0xb0, 0x39, 0xa4, 0xad, 0xae, 0x25, 0x66, 0x91, 0x50, 0x5e, 0x17,
-	0x10, 0x9b, 0x31, 0x1d, 0x9a, 0xc3, 0xb8, 0x56, 0xe1, 0x40, 0x35, 0x70, 0xe3, 0xe1, 0x4d, 0x16,
-	0x0c, 0x6c, 0x47, 0x75, 0x2d, 0xbc, 0xce, 0x82, 0xc1, 0xee, 0x7f, 0xee, 0xc0, 0xc2, 0xf0, 0xe3,
-	0x5f, 0x1d, 0x23, 0x97, 0x15, 0xed, 0x53, 0x22, 0x97, 0x42, 0x73, 0x39, 0xd3, 0xbc, 0x28, 0xb4,
-	0x5b, 0x7d, 0x01, 0x90, 0xcd, 0xdb, 0x9c, 0x58, 0x9d, 0xf4, 0x91, 0xa1, 0xfa, 0x3c, 0x55, 0x4f,
-	0x93, 0x4f, 0xc6, 0x80, 0x8e, 0xe1, 0x35, 0x4e, 0x70, 0xe0, 0xd9, 0x2f, 0x11, 0xc2, 0x6b, 0x71,
-	0xd6, 0xf5, 0x70, 0x18, 0xe6, 0xbf, 0xb3, 0x4e, 0x9b, 0xdc, 0xa0, 0x14, 0x2d, 0xb9, 0x38, 0xe2,
-	0xac, 0xbb, 0x1f, 0x86, 0xb9, 0xaf, 0xae, 0x47, 0xb0, 0x8d, 0x43, 0x4d, 0x21, 0x18, 0x97, 0xd6,
-	0x4a, 0xd2, 0x44, 0x80, 0xb9, 0x1e, 0x95, 0x20, 0x8b, 0xba, 0x6b, 0xad, 0x18, 0xcd, 0x06, 0xe3,
-	0x52, 0xdb, 0xea, 0x4c, 0x7b, 0xbd, 0xb9, 0xa8, 0x3d, 0xb8, 0xeb, 0xb3, 0x6e, 0xac, 0x3a, 0x18,
-	0x12, 0xd8, 0x04, 0x21, 0x62, 0xe2, 0xeb, 0x74, 0x58, 0x74, 0x57, 0x32, 0xa1, 0x8e, 0xfc, 0x46,
-	0x4c, 0xfc, 0xca, 0x1f, 0x6f, 0xc3, 0xf2, 0x95, 0x73, 0xa2, 0xcf, 0x60, 0xcb, 0xc0, 0xc7, 0xd8,
-	0xd9, 0xd4, 0x9f, 0x0d, 0xad, 0xf3, 0xfc, 0x3a, 0x63, 0x7f, 0x0a, 0x9b, 0x39, 0xe8, 0x05, 0x69,
-	0x9e, 0x33, 0xd6, 0xf1, 0xd4, 0xcb, 0x2f, 0xf7, 0xd8, 0x74, 0x32, 0x95, 0x17, 0x46, 0xe3, 0x2c,
-	0x14, 0xfa, 0x11, 0xf9, 0x10, 0x2a, 0x63, 0xe0, 0xea, 0xc1, 0x66, 0x1a, 0xed, 0xf5, 0xeb, 0xd0,
-	0xea, 0x89, 0x79, 0x00, 0xdb, 0xe6, 0x3d, 0xed, 0xa9, 0xcb, 0xcd, 0x1f, 0xa1, 0x85, 0x69, 0xa8,
-	0x1e, 0x94, 0xda, 0x9c, 0xee, 0xa6, 0xd1, 0x52, 0x65, 0x21, 0x3b, 0xc3, 0x91, 0x51, 0x41, 0x9f,
-	0xc1, 0xbc, 0xbd, 0x13, 0xec, 0xfb, 0x24, 0x96, 0xb6, 0xa4, 0x4c, 0x4a, 0x41, 0x73, 0x06, 0xb0,
-	0xaf, 0xf5, 0xd1, 0x3e, 0x2c, 0xe0, 0x30, 0x64, 0x17, 0xaa, 0x6a, 0x46, 0xaa, 0x6b, 0xb0, 0xdf,
-	0x74, 0x27, 0x26, 0x31, 0x8d, 0x78, 0x61, 0x01, 0xf5, 0x8f, 0xbf, 0xfb, 0x71, 0xba, 0xf0, 0xa7,
-	0x7f, 0x6d, 0x17, 0xbe, 0x7a, 0xf7, 0x66, 0xff, 0x9b, 0x8a, 0x3b, 0x6d, 0xfb, 0xbf, 0x8c, 0xe6,
-	0x8c, 0xa6, 0x7f, 0xff, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0xd6, 0xbe, 0x51, 0xd6, 0x1a,
-	0x00, 0x00,
+	// 2675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xd9, 0x37, 0x65, 0x59, 0x22, 0x1f, 0xea, 0x83, 0x1a, 0xc9, 0xf6, 0x8a, 0x92, 0x25, 0x47, 0x6f,
+	0x92, 0xd7, 0x71, 0x10, 0x32, 0x51, 0xf2, 0xe6, 0x4d, 0xed, 0x04, 0xa9, 0x48, 0xcb, 0x91, 0x2a,
+	0x3b, 0x71, 0x96, 0xb2, 0x55, 0x04, 0x45, 0x17, 0xc3, 0xdd, 0x21, 0x35, 0xe5, 0x72, 0x67, 0x31,
+	0x33, 0xa4, 0xc4, 0x1c, 0x7b, 0xeb, 0xb9, 0xe8, 0xa1, 0xff, 0x41, 0x81, 0xfe, 0x03, 0xbd, 0x15,
+	0xbd, 0xb5, 0xe8, 0xdf, 0xd0, 0x1c, 0x7a, 0x29, 0xd0, 0x5b, 0x03, 0xf4, 0xd4, 0x4b, 0x31, 0x1f,
+	0xfb, 0x41, 0x4a, 0xb4, 0x94, 0x8b, 0xc0, 0x99, 0xe7, 0xf9, 0xfd, 0x66, 0xe6, 0x99, 0xe7, 0x6b,
+	0x56, 0xf0, 0xb8, 0x4b, 0xe5, 0xe9, 0xa0, 0x5d, 0xf3, 0x59, 0xbf, 0x2e, 0x58, 0xc8, 0xde, 0xa3,
+	0xac, 0xde, 0x0d, 0x19, 0xab, 0xc7, 0x9c, 0xfd, 0x82, 0xf8, 0x52, 0x98, 0x11, 0x8e, 0x69, 0x7d,
+	0xf8, 0x41, 0x5d, 0x10, 0x29, 0x69, 0xd4, 0x15, 0xb5, 0x98, 0x33, 0xc9, 0xd0, 0x82, 0x92, 0xd5,
+	0x14, 0xac, 0x46, 0x59, 0x75, 0xad, 0xcb, 0xba, 0x4c, 0x0b, 0xea, 0xea, 0x97, 0xd1, 0xa9, 0x22,
+	0x72, 0x2e, 0xcd, 0x24, 0x39, 0x97, 0x76, 0x6e, 0x4b, 0xaf, 0xd4, 0xa3, 0x32, 0xe1, 0xed, 0x13,
+	0x89, 0x03, 0x2c, 0xb1, 0x95, 0x6f, 0x4e, 0xca, 0x85, 0xc4, 0x72, 0x20, 0xa6, 0xa1, 0x93, 0xb1,
+	0x95, 0xaf, 0x4f, 0xca, 0x39, 0xe9, 0x58, 0xd1, 0xc3, 0xe9, 0x47, 0x23, 0xe7, 0x92, 0x44, 0x82,
+	0xb2, 0x28, 0x59, 0xe6, 0xe9, 0x6b, 0x74, 0x23, 0x49, 0x78, 0xcc, 0xa9, 0x20, 0x75, 0x16, 0x4b,
+	0x85, 0xa9, 0x73, 0x2c, 0x49, 0x48, 0xfb, 0x54, 0x66, 0xbf, 0x2c, 0xcf, 0xfe, 0x0f, 0xe2, 0x21,
+	0xe7, 0x12, 0x0f, 0xe4, 0xa9, 0xdd, 0x91, 0xfa, 0x69, 0x69, 0x3e, 0xfd, 0x61, 0xdb, 0x69, 0x63,
+	0x5f, 0xff, 0xb1, 0xe8, 0xd7, 0xdc, 0xa9, 0x4f, 0xb9, 0x3f, 0xa0, 0xd2, 0x6b, 0x73, 0x82, 0x7b,
+	0x84, 0x5b, 0xc0, 0xb3, 0x29, 0x00, 0x65, 0x26, 0x1e, 0xe1, 0xb0, 0x4e, 0xa2, 0x21, 0x1b, 0x19,
+	0x8e, 0xdd, 0xba, 0x1f, 0x0e, 0x84, 0x24, 0xbc, 0xce, 0x06, 0x32, 0xa4, 0x84, 0x7b, 0x01, 0x91,
+	0xc4, 0x57, 0x3b, 0xb1, 0x6c, 0x7b, 0xd7, 0x63, 0xcb, 0xee, 0xa0, 0x8e, 0xcf, 0x44, 0xbd, 0x43,
+	0x43, 0x99, 0x6e, 0x68, 0xab, 0xcb, 0x58, 0x37, 0x24, 0x75, 0x3d, 0x6a, 0x0f, 0x3a, 0xf5, 0x60,
+	0xc0, 0x71, 0x6e, 0x89, 0x0b, 0xf2, 0x33, 0x8e, 0xe3, 0x98, 0x70, 0x7b, 0x9d, 0x3b, 0xff, 0xdc,
+	0x86, 0x62, 0xcb, 0xba, 0x2f, 0xaa, 0xc3, 0x6a, 0x40, 0x85, 0xcf, 0x86, 0x84, 0x8f, 0xbc, 0x08,
+	0xf7, 0x89, 0x88, 0xb1, 0x4f, 0x9c, 0xc2, 0xfd, 0xc2, 0x83, 0x92, 0x8b, 0x52, 0xd1, 0x97, 0x89,
+	0x04, 0xbd, 0x03, 0x95, 0x33, 0x2c, 0xfd, 0xd3, 0x4c, 0x59, 0x38, 0x33, 0xf7, 0x6f, 0x3e, 0x28,
+	0xb9, 0xcb, 0x7a, 0x3e, 0xd5, 0x14, 0x08, 0x83, 0xd3, 0x1b, 0xb4, 0x09, 0x8f, 0x88, 0x24, 0xc2,
+	0xf3, 0x59, 0xd4, 0xa1, 0x5d, 0x4f, 0xb0, 0x01, 0xf7, 0x89, 0x33, 0x7b, 0xbf, 0xf0, 0xa0, 0xbc,
+	0xfb, 0x56, 0x2d, 0x1f, 0x37, 0xb5, 0x64, 0x57, 0xb5, 0xa3, 0x14, 0xd6, 0xe4, 0x81, 0x38, 0xb8,
+	0xe1, 0xde, 0xc9, 0x88, 0x9a, 0x9a, 0xa7, 0xa5, 0x69, 0xd0, 0x37, 0x70, 0x37, 0xa0, 0x9c, 0xf8,
+	0x92, 0xf1, 0xd1, 0xc4, 0x0a, 0xb7, 0xf4, 0x0a, 0xf7, 0xa7, 0xac, 0xf0, 0x24, 0x41, 0x1d, 0xdc,
+	0x70, 0x6f, 0xa7, 0x14, 0x63, 0xdc, 0x47, 0x50, 0xf1, 0x59, 0x24, 0x06, 0xa1, 0xd7, 0x1b, 0x26,
+	0xa4, 0xb7, 0x35, 0xe9, 0xf6, 0x14, 0xd2, 0xa6, 0x56, 0x3f, 0x1a, 0x1e, 0xdc, 0x70, 0x97, 0x7c,
+	0xfb, 0xdb, 0x92, 0x05, 0x63, 0xb6, 0x10, 0xc4, 0xe7, 0x44, 0x26, 0xa4, 0x73, 0x9a, 0xf4, 0xc1,
+	0x95, 0xb6, 0x68, 0x69, 0x94, 0x38, 0x28, 0xe4, 0xcd, 0x61, 0x26, 0xed, 0x2a, 0x2f, 0x61, 0x75,
+	0x88, 0x07, 0xa1, 0x9c, 0x58, 0x60, 0x5e, 0x2f, 0xf0, 0x3f, 0x53, 0x16, 0x78, 0xa5, 0x10, 0x19,
+	0xf7, 0xca, 0x30, 0x1b, 0x5f, 0x66, 0xe5, 0x71, 0xea, 0xe2, 0x35, 0xad, 0x5c, 0xc8, 0x59, 0x79,
+	0x8c, 0xbb, 0x07, 0xd5, 0x9c, 0x61, 0x30, 0x97, 0xb4, 0x83, 0xfd, 0x94, 0xbe, 0xa4, 0xe9, 0xdf,
+	0xbd, 0xda, 0x4d, 0xf4, 0xc5, 0xf5, 0x71, 0x2c, 0x0e, 0x66, 0xdc, 0x9c, 0xa5, 0xf7, 0x2c, 0x9f,
+	0x5d, 0xec, 0xe7, 0xb0, 0x9e, 0x1d, 0x64, 0x72, 0x2d, 0xb8, 0xe6, 0x51, 0x66, 0xdc, 0xcc, 0x1a,
+	0x13, 0xfc, 0x3f, 0x83, 0xf5, 0xcc, 0x65, 0x26, 0xf9, 0xef, 0x5e, 0xcf, 0x77, 0x66, 0xdc, 0x3b,
+	0x89, 0xef, 0x4c, 0xb0, 0x7f, 0x0a, 0x0b, 0x9c, 0x74, 0x38, 0x11, 0xa7, 0x9e, 0x4a, 0xad, 0xce,
+	0x82, 0x26, 0x5c, 0xaf, 0x99, 0x78, 0xaf, 0x25, 0xf1, 0x5e, 0x7b, 0x62, 0xf3, 0x81, 0x5b, 0xb6,
+	0xea, 0x2e, 0x96, 0x04, 0xad, 0x43, 0x31, 0x20, 0x43, 0xaf, 0xcf, 0x02, 0xe2, 0x2c, 0xde, 0x2f,
+	0x3c, 0x28, 0xba, 0xf3, 0x01, 0x19, 0x3e, 0x67, 0x01, 0x41, 0x0e, 0xcc, 0x87, 0x34, 0xea, 0x11,
+	0x1e, 0x38, 0x2b, 0x46, 0x62, 0x87, 0xe8, 0x73, 0x98, 0xef, 0x45, 0x58, 0xd2, 0x21, 0x71, 0xd0,
+	0xeb, 0x23, 0xd6, 0x68, 0x7d, 0x65, 0xb2, 0xae, 0x9b, 0xa0, 0xd0, 0x3e, 0x94, 0xd2, 0x24, 0xe2,
+	0xac, 0x6a, 0x8a, 0xff, 0x9d, 0x6a, 0x61, 0xab, 0x97, 0x90, 0x64, 0x48, 0xf4, 0x1e, 0xcc, 0x2a,
+	0x90, 0xe3, 0x24, 0x47, 0xce, 0x33, 0x7c, 0x11, 0x32, 0x96, 0x60, 0xb4, 0x1a, 0xfa, 0x18, 0xe6,
+	0xbb, 0x58, 0x92, 0x33, 0x3c, 0x72, 0xd6, 0x35, 0x62, 0x73, 0x02, 0x61, 0x84, 0xe9, 0x6e, 0xad,
+	0x32, 0x6a, 0xc0, 0x9c, 0xb1, 0xbd, 0xb3, 0xa6, 0x61, 0x0f, 0x5f, 0x7b, 0x59, 0xc6, 0xe9, 0x12,
+	0x63, 0x5b, 0x24, 0x22, 0xb0, 0x6c, 0x7e, 0xa5, 0xe7, 0x71, 0xb6, 0x34, 0xd9, 0xe3, 0xd7, 0x92,
+	0xbd, 0x8c, 0x85, 0xe4, 0x04, 0xf7, 0x53, 0xd4, 0x38, 0xfb, 0x24, 0x27, 0xfa, 0x12, 0x20, 0x73,
+	0x73, 0xe7, 0x8e, 0x5e, 0xa1, 0x76, 0xcd, 0x38, 0x49, 0x48, 0x73, 0x0c, 0xe8, 0x13, 0x80, 0xac,
+	0xe8, 0x38, 0x15, 0xcd, 0xe7, 0x8c, 0xf3, 0xed, 0xa7, 0x72, 0x37, 0xa7, 0x8b, 0x9e, 0x43, 0x29,
+	0xad, 0xf4, 0x4e, 0x55, 0x03, 0xeb, 0xb5, 0x74, 0xa6, 0x66, 0x0b, 0xf1, 0xe4, 0xd6, 0xf8, 0x90,
+	0xfa, 0x24, 0xd9, 0xa1, 0x9b, 0x31, 0xa0, 0x16, 0x54, 0xd2, 0x81, 0x27, 0x08, 0x1f, 0x12, 0xee,
+	0x6c, 0xd8, 0x0c, 0x79, 0x25, 0xab, 0xa5, 0x5b, 0x4e, 0x15, 0x5b, 0x9a, 0x00, 0xfd, 0x3f, 0xcc,
+	0xaa, 0x1e, 0xc0, 0xd9, 0xb4, 0x99, 0x50, 0x0d, 0xae, 0xe0, 0xd0, 0x00, 0xf4, 0x18, 0xe6, 0x6d,
+	0xf7, 0xe1, 0xdc, 0xd3, 0xd8, 0x37, 0x6a, 0x59, 0x93, 0x31, 0x05, 0x99, 0x20, 0xd0, 0x27, 0x50,
+	0x4c, 0xfa, 0x39, 0x67, 0x49, 0xa3, 0xef, 0xd4, 0x7c, 0xc6, 0x49, 0x0a, 0x79, 0x6e, 0xa5, 0x8d,
+	0xd9, 0x3f, 0x7f, 0xb7, 0x7d, 0xc3, 0x4d, 0xb5, 0xd1, 0x11, 0xcc, 0x99, 0x4e, 0xcf, 0x59, 0xd6,
+	0xb8, 0xb5, 0x71, 0x5c, 0x4b, 0xcb, 0x1a, 0xf7, 0xfe, 0xf0, 0xef, 0xd9, 0x82, 0x42, 0x7e, 0xff,
+	0xdd, 0xf6, 0x8a, 0x24, 0x42, 0x06, 0xb4, 0xd3, 0x79, 0xb4, 0x43, 0xbb, 0x11, 0xe3, 0x64, 0xc7,
+	0xb5, 0x14, 0xd5, 0x0a, 0x2c, 0x8d, 0x17, 0xd4, 0xea, 0x2a, 0xac, 0x5c, 0x28, 0x2b, 0xd5, 0x3f,
+	0xcd, 0xc0, 0x42, 0xbe, 0x16, 0xa0, 0x87, 0x70, 0x4b, 0xb2, 0x1e, 0x89, 0x4c, 0x37, 0xd0, 0x58,
+	0xfb, 0xfe, 0xbb, 0xed, 0x4a, 0xc8, 0xba, 0x5d, 0x1a, 0x75, 0x1f, 0xed, 0x70, 0x12, 0x60, 0x5f,
+	0xee, 0xb8, 0x46, 0x45, 0xa5, 0x10, 0x1c, 0x04, 0x9c, 0x08, 0xd5, 0x0d, 0xa8, 0xde, 0x21, 0x19,
+	0xa2, 0xbb, 0x30, 0xef, 0x63, 0xcf, 0x27, 0x5c, 0x3a, 0x37, 0xb5, 0x64, 0xce, 0xc7, 0x4d, 0xc2,
+	0xa5, 0x15, 0xc4, 0x58, 0x9e, 0x3a, 0xb3, 0x89, 0xe0, 0x05, 0x96, 0xa7, 0x68, 0x1b, 0xca, 0x7e,
+	0x48, 0x49, 0x24, 0x0d, 0xea, 0x96, 0x16, 0x82, 0x99, 0xd2, 0xc8, 0x7b, 0x60, 0x47, 0x5e, 0x8f,
+	0x8c, 0x74, 0xf9, 0x2c, 0xb9, 0x25, 0x33, 0x73, 0x44, 0x46, 0xe8, 0x6d, 0x58, 0x96, 0xa1, 0xb0,
+	0xbe, 0xa3, 0xfb, 0x14, 0x5d, 0x01, 0x4b, 0xee, 0xa2, 0x0c, 0x85, 0x71, 0x08, 0xd5, 0xa5, 0xa0,
+	0x8f, 0xa1, 0x48, 0x23, 0x41, 0xfc, 0x01, 0x4f, 0xea, 0x58, 0xf5, 0x42, 0x2e, 0x6d, 0x30, 0x16,
+	0xbe, 0xc2, 0xe1, 0x80, 0xb8, 0xa9, 0xae, 0xca, 0xa4, 0x9c, 0x31, 0xb3, 0x78, 0xc9, 0x1c, 0x56,
+	0x8d, 0x8f, 0xc8, 0xa8, 0xfa, 0x16, 0x14, 0x93, 0x44, 0x3e, 0xa6, 0x56, 0x18, 0x57, 0xbb, 0x03,
+	0x6b, 0x97, 0xd5, 0xae, 0xea, 0x3b, 0x50, 0x4a, 0xeb, 0x0c, 0xda, 0x54, 0xa9, 0xd3, 0x0e, 0x2c,
+	0x41, 0x36, 0x51, 0xfd, 0x5b, 0x01, 0x96, 0xc6, 0x93, 0x2e, 0xda, 0x83, 0x7b, 0xb6, 0xfd, 0xf4,
+	0x68, 0xd4, 0x55, 0xc6, 0xf7, 0x62, 0xce, 0xce, 0x47, 0x5e, 0x72, 0x33, 0x86, 0xa4, 0x6a, 0x95,
+	0x0e, 0x8d, 0xce, 0x0b, 0xa5, 0xb2, 0x67, 0x2f, 0xab, 0x09, 0x5b, 0x36, 0x73, 0x7b, 0x49, 0x47,
+	0x3a, 0xc1, 0x61, 0x6e, 0x77, 0xc3, 0x6a, 0xed, 0x5b, 0xa5, 0x69, 0x24, 0x34, 0xba, 0x94, 0xe4,
+	0xe6, 0x18, 0xc9, 0x61, 0x74, 0x91, 0xa4, 0xfa, 0x9b, 0x02, 0x54, 0x26, 0x2b, 0x02, 0xfa, 0x09,
+	0x14, 0x3b, 0x81, 0x30, 0x35, 0x4c, 0x1d, 0x66, 0x69, 0xb7, 0x7e, 0xcd, 0x62, 0x52, 0x7b, 0x1a,
+	0x08, 0x55, 0xeb, 0xdc, 0xf9, 0x8e, 0xf9, 0xb1, 0xf3, 0x7f, 0x30, 0x6f, 0xe7, 0xd0, 0x22, 0x94,
+	0x1a, 0xcf, 0xf6, 0x9a, 0x47, 0xcf, 0x0e, 0x5b, 0xc7, 0x95, 0x1b, 0x6a, 0x78, 0x72, 0x70, 0x78,
+	0xbc, 0xaf, 0x87, 0x05, 0xb4, 0x00, 0xc5, 0x27, 0x87, 0xad, 0xbd, 0xc6, 0xb3, 0xfd, 0x27, 0x95,
+	0x99, 0xea, 0x3f, 0x6e, 0xc1, 0xea, 0x25, 0xe9, 0x1f, 0x6d, 0x66, 0x01, 0x60, 0xc2, 0x65, 0xc6,
+	0x29, 0x64, 0x41, 0xb0, 0x05, 0xa0, 0xe2, 0xda, 0xd7, 0xb9, 0xc3, 0xda, 0x30, 0x37, 0x83, 0xaa,
+	0x50, 0x1c, 0x08, 0x65, 0x84, 0x3e, 0xb1, 0xc6, 0x49, 0xc7, 0x4a, 0x16, 0x63, 0x21, 0xce, 0x18,
+	0x0f, 0x6c, 0xa0, 0xa4, 0xe3, 0x2c, 0x44, 0x6f, 0x5d, 0x1d, 0xa2, 0x26, 0xde, 0x3a, 0x34, 0x24,
+	0x36, 0x64, 0xe6, 0x7c, 0xfc, 0x94, 0x86, 0x24, 0x1f, 0x88, 0xf3, 0x63, 0x81, 0xb8, 0x01, 0x25,
+	0x15, 0x81, 0x06, 0x53, 0x34, 0x4b, 0xab, 0x09, 0x8d, 0x5a, 0x87, 0x62, 0x8f, 0x8c, 0x8c, 0xcc,
+	0x46, 0x41, 0x8f, 0x8c, 0xb4, 0xe8, 0x19, 0xac, 0x25, 0xc1, 0xe2, 0x89, 0x1e, 0x8d, 0xbd, 0x21,
+	0xe1, 0xb4, 0x33, 0x72, 0xe0, 0xca, 0x20, 0x43, 0x09, 0xae, 0xd5, 0xa3, 0xf1, 0x2b, 0x8d, 0x42,
+	0x1f, 0x43, 0xe9, 0x0c, 0x53, 0xe9, 0x49, 0xda, 0x27, 0x4e, 0xf9, 0xaa, 0x9e, 0xa7, 0xa8, 0x74,
+	0x8f, 0x69, 0x9f, 0x20, 0x06, 0x2b, 0xc2, 0x94, 0x19, 0x2f, 0x6b, 0x41, 0x4c, 0xcf, 0xd4, 0xb8,
+	0x7e, 0x5d, 0x4f, 0x4a, 0xd5, 0x85, 0xee, 0xa4, 0x22, 0x26, 0x04, 0xe8, 0x0d, 0x58, 0x38, 0x95,
+	0x32, 0x4e, 0xbd, 0x7c, 0x51, 0x5b, 0xa5, 0xac, 0xe6, 0x92, 0xd0, 0xd8, 0x86, 0x72, 0x10, 0x89,
+	0x54, 0x63, 0xc9, 0x3a, 0x42, 0x24, 0x12, 0x85, 0x23, 0x58, 0x53, 0x0a, 0x31, 0x0b, 0x43, 0x1a,
+	0x75, 0x4d, 0xfc, 0x0c, 0x71, 0xe8, 0x2c, 0x5f, 0x75, 0x6e, 0x14, 0x44, 0xe2, 0x85, 0x41, 0x1d,
+	0x5a, 0x50, 0xf5, 0x53, 0xb8, 0x3b, 0x65, 0xf7, 0x6a, 0xaf, 0xca, 0xfd, 0x3c, 0xe3, 0x7f, 0xca,
+	0x67, 0xd5, 0x13, 0xae, 0xac, 0xe6, 0x9a, 0x66, 0xaa, 0xfa, 0xd7, 0x02, 0xbc, 0x79, 0x9d, 0xde,
+	0x04, 0xbd, 0x09, 0x8b, 0x03, 0x41, 0x8e, 0x43, 0x71, 0x8c, 0xb5, 0xe7, 0xe9, 0xee, 0xa1, 0xe8,
+	0x8e, 0x4f, 0xaa, 0x10, 0x90, 0x7a, 0xa4, 0x72, 0xaf, 0xee, 0x33, 0x4b, 0x6e, 0x6e, 0x06, 0x7d,
+	0x00, 0x73, 0x9c, 0x31, 0xd9, 0xc4, 0xb6, 0xd3, 0x5c, 0x1f, 0x2f, 0x79, 0x2e, 0x31, 0x6d, 0xb4,
+	0x4b, 0x3a, 0xae, 0x55, 0x44, 0x0f, 0xa1, 0x22, 0xe2, 0x90, 0xca, 0x63, 0x93, 0xd6, 0xa9, 0x7a,
+	0x8b, 0xae, 0xea, 0xb5, 0x2f, 0xcc, 0x57, 0x7f, 0x5f, 0x80, 0xbb, 0x53, 0xfa, 0x20, 0xf4, 0x0d,
+	0x94, 0x39, 0x96, 0xc4, 0xd3, 0x1d, 0x83, 0x89, 0xdf, 0xf2, 0xee, 0x8f, 0x7e, 0x58, 0x33, 0x55,
+	0x53, 0x4d, 0xf6, 0x33, 0x4d, 0xe0, 0x02, 0x4f, 0x7f, 0x57, 0x3f, 0x02, 0xc8, 0x24, 0xa8, 0x02,
+	0x37, 0xbf, 0x7e, 0xd1, 0xd2, 0x2b, 0xcc, 0xb8, 0xea, 0x27, 0x5a, 0x83, 0x5b, 0xed, 0x01, 0x17,
+	0x52, 0x27, 0x85, 0x45, 0xd7, 0x0c, 0x1e, 0xa1, 0x5f, 0xfe, 0x6b, 0x76, 0x09, 0x66, 0x84, 0x44,
+	0xc5, 0xe4, 0x4b, 0x53, 0x63, 0x19, 0x16, 0xc7, 0x5e, 0xb8, 0x6a, 0x62, 0xec, 0x31, 0xd6, 0x58,
+	0x81, 0xe5, 0x89, 0x47, 0xc7, 0xce, 0xaf, 0xca, 0x50, 0xce, 0xf5, 0xc7, 0x68, 0x07, 0x16, 0xcf,
+	0x03, 0xe1, 0xb5, 0x69, 0x14, 0x68, 0x2f, 0xb4, 0x35, 0xa1, 0x7c, 0x1e, 0x88, 0x06, 0x8d, 0x02,
+	0xe5, 0x86, 0xe8, 0x7d, 0x58, 0x1b, 0xe2, 0x90, 0x06, 0xfa, 0x5c, 0x39, 0x55, 0x93, 0xb6, 0x50,
+	0x26, 0x4b, 0x11, 0xcf, 0xa1, 0x32, 0xf1, 0xf1, 0xc4, 0xe4, 0xf8, 0xf2, 0xee, 0xce, 0xb8, 0x15,
+	0x9b, 0x46, 0xab, 0x61, 0x94, 0x8c, 0x01, 0xdd, 0x65, 0x7f, 0x6c, 0x56, 0xa0, 0x97, 0xb0, 0x4e,
+	0xa2, 0x20, 0x66, 0x34, 0x92, 0xc2, 0x3b, 0xc3, 0xbc, 0xaf, 0x42, 0x41, 0x85, 0x3f, 0x1b, 0x48,
+	0x67, 0xf6, 0xaa, 0x48, 0xb8, 0x9b, 0x62, 0x4f, 0x0c, 0xf4, 0xd8, 0x20, 0xd1, 0x3e, 0x94, 0xf1,
+	0x99, 0xf0, 0x6c, 0xdb, 0x67, 0x3f, 0x10, 0xbc, 0x39, 0xf5, 0x2d, 0x51, 0xdb, 0x3b, 0x69, 0xd9,
+	0x9f, 0x2e, 0xe0, 0x33, 0x91, 0x98, 0x10, 0xc3, 0x6d, 0x1a, 0x69, 0x23, 0x24, 0x5f, 0x1c, 0x62,
+	0x16, 0x52, 0x7f, 0x64, 0xdf, 0xf1, 0xef, 0x4d, 0x27, 0x3c, 0x34, 0x30, 0x73, 0xec, 0x17, 0x1a,
+	0xe4, 0xae, 0xd2, 0x8b, 0x93, 0xe8, 0x29, 0x6c, 0x07, 0x54, 0xe0, 0x76, 0x48, 0xbc, 0xdc, 0xe3,
+	0x38, 0x20, 0x42, 0xd2, 0x08, 0x9b, 0xdd, 0xcf, 0x6b, 0x3f, 0xbf, 0x67, 0xd5, 0x32, 0xa7, 0x7c,
+	0x92, 0x53, 0x42, 0x4f, 0xa0, 0x92, 0xf0, 0x74, 0x79, 0xec, 0x7b, 0x67, 0xa4, 0x7d, 0x8d, 0x4e,
+	0x67, 0xc9, 0x62, 0xbe, 0xe0, 0xb1, 0x7f, 0x42, 0xda, 0xc8, 0x87, 0xfb, 0x09, 0x8b, 0x29, 0xe3,
+	0x5d, 0xcc, 0xdb, 0xb8, 0x4b, 0x3c, 0x9f, 0x85, 0xa1, 0xf9, 0xba, 0xe5, 0x94, 0xae, 0x64, 0x4d,
+	0xb6, 0xaa, 0xab, 0xfc, 0x17, 0x86, 0xa1, 0x99, 0x12, 0xa0, 0xaf, 0xe1, 0x0e, 0x27, 0x5d, 0x72,
+	0xee, 0xf5, 0xf1, 0xb9, 0x5a, 0xa6, 0xcb, 0x71, 0xdf, 0x13, 0xf4, 0xdb, 0xe4, 0x5d, 0xbe, 0x79,
+	0x81, 0xfa, 0xe5, 0x61, 0x24, 0x3f, 0xdc, 0x35, 0xe4, 0xab, 0x1a, 0xfb, 0x1c, 0x9f, 0xbf, 0x30,
+	0xc8, 0x16, 0xfd, 0x96, 0xa0, 0x77, 0x01, 0x71, 0x22, 0xa4, 0x37, 0xee, 0xf0, 0x65, 0xed, 0xc5,
+	0xcb, 0x4a, 0xf2, 0xd3, 0x9c, 0xd3, 0x37, 0x60, 0x99, 0x44, 0xfa, 0x8c, 0x1a, 0x43, 0x02, 0xe1,
+	0x2c, 0x5c, 0x79, 0xa6, 0x45, 0x03, 0x71, 0x89, 0x90, 0xfb, 0x81, 0x40, 0x2d, 0x58, 0xb9, 0xf0,
+	0xdd, 0x4f, 0x57, 0x81, 0xf2, 0xee, 0xdb, 0x35, 0xfd, 0x61, 0xaf, 0x86, 0x63, 0x5a, 0x1b, 0xee,
+	0xd6, 0x6c, 0x0b, 0x56, 0xfb, 0xca, 0xa8, 0x3f, 0x49, 0xb4, 0xdd, 0x0a, 0x9b, 0x98, 0xa9, 0xfe,
+	0xa7, 0x00, 0x90, 0x79, 0x22, 0xfa, 0x31, 0x6c, 0xd8, 0x7d, 0xfa, 0x9c, 0x04, 0x24, 0x92, 0x14,
+	0x87, 0x22, 0x29, 0x70, 0xa6, 0x4f, 0x2c, 0x1e, 0xdc, 0x70, 0xd7, 0x8d, 0x52, 0x33, 0xd3, 0xb1,
+	0xc9, 0x7b, 0x84, 0x7e, 0x5d, 0x80, 0x8d, 0xa4, 0x30, 0x62, 0xdf, 0x67, 0x03, 0xd5, 0x68, 0x67,
+	0x7a, 0x3a, 0xcc, 0xcb, 0xbb, 0x5f, 0xdb, 0x0d, 0x1b, 0x17, 0xaf, 0xd9, 0x2f, 0x90, 0xaa, 0x96,
+	0xd5, 0x54, 0x10, 0x85, 0xb8, 0xdf, 0x0e, 0xb0, 0x3a, 0xca, 0xde, 0x49, 0xeb, 0x99, 0x1e, 0x18,
+	0x0f, 0x4e, 0xea, 0xe5, 0x9e, 0x61, 0xce, 0x6d, 0x40, 0xed, 0x4a, 0x4c, 0x13, 0x36, 0x6e, 0xc3,
+	0x6a, 0xfe, 0x40, 0x1d, 0x22, 0xfd, 0x53, 0xc2, 0xab, 0x7f, 0x29, 0xc0, 0xea, 0x25, 0x61, 0x83,
+	0x3e, 0x52, 0xee, 0x12, 0x87, 0xd8, 0x57, 0x3d, 0xa6, 0x09, 0x46, 0xce, 0x06, 0xea, 0x29, 0xac,
+	0x2d, 0xe0, 0xae, 0x59, 0xa9, 0xc5, 0xba, 0x5a, 0x86, 0x3e, 0x83, 0x8d, 0x31, 0x6d, 0x75, 0xd7,
+	0x31, 0x8b, 0x84, 0x72, 0xe5, 0x80, 0xd8, 0x14, 0xec, 0xd0, 0x1c, 0xc6, 0xb5, 0x0a, 0x4d, 0xd5,
+	0x27, 0x4e, 0x87, 0xb7, 0x59, 0x30, 0xb2, 0x8d, 0xdb, 0xa5, 0xf0, 0x06, 0x0b, 0x46, 0x3b, 0x7f,
+	0x9c, 0x83, 0xa5, 0xf1, 0x2f, 0x0f, 0xea, 0x18, 0xb9, 0x54, 0x6b, 0x5f, 0x2c, 0xb9, 0xbc, 0x9c,
+	0x4b, 0xc4, 0xe6, 0xe1, 0xa2, 0x7d, 0xf5, 0x4b, 0x80, 0x6c, 0xde, 0xb9, 0x79, 0xd9, 0xdb, 0x7f,
+	0x7c, 0x9d, 0xda, 0xab, 0x54, 0x3d, 0xcd, 0x68, 0x19, 0x03, 0x3a, 0x80, 0x37, 0x38, 0xc1, 0x81,
+	0x67, 0x3f, 0x83, 0x08, 0xaf, 0xc3, 0x59, 0xdf, 0xc3, 0x61, 0x98, 0xff, 0xc8, 0x3b, 0x6b, 0x12,
+	0x8e, 0x52, 0xb4, 0xe4, 0xe2, 0x29, 0x67, 0xfd, 0xbd, 0x30, 0xcc, 0x7d, 0xf2, 0x7d, 0x0a, 0x5b,
+	0x38, 0xd4, 0x14, 0x82, 0x71, 0x69, 0xad, 0x24, 0x4d, 0x58, 0x99, 0xeb, 0x51, 0x59, 0xb7, 0xa8,
+	0x9b, 0xe3, 0xaa, 0xd1, 0x6c, 0x31, 0x2e, 0xb5, 0xad, 0x8e, 0x75, 0x28, 0x99, 0x8b, 0xda, 0x85,
+	0xdb, 0x3e, 0xeb, 0xc7, 0x9c, 0x08, 0x41, 0x02, 0x9b, 0x75, 0x44, 0x4c, 0x7c, 0x9d, 0x63, 0x8b,
+	0xee, 0x6a, 0x26, 0xd4, 0xe9, 0xa4, 0x15, 0x13, 0x1f, 0xed, 0xc3, 0x76, 0x40, 0xa2, 0x91, 0xe7,
+	0x73, 0x26, 0x44, 0xb6, 0x73, 0x8f, 0x93, 0x0e, 0xe1, 0x24, 0xf2, 0x49, 0x92, 0x34, 0x37, 0x95,
+	0x5a, 0x53, 0x69, 0xa5, 0x3b, 0x77, 0x53, 0x9d, 0xea, 0x6f, 0x6f, 0xc2, 0xca, 0x05, 0x73, 0xa1,
+	0xcf, 0x61, 0xd3, 0xec, 0x62, 0xca, 0x75, 0x99, 0xda, 0xb8, 0xae, 0x75, 0x5e, 0x5d, 0x76, 0x67,
+	0x9f, 0xc1, 0x46, 0x0e, 0x7a, 0x46, 0xda, 0xa7, 0x8c, 0xf5, 0x3c, 0xf5, 0x4e, 0xcd, 0x3d, 0x8d,
+	0x9d, 0x4c, 0xe5, 0xc4, 0x68, 0x1c, 0x87, 0x42, 0x3f, 0x79, 0x1f, 0x43, 0x75, 0x0a, 0x5c, 0x3d,
+	0x2f, 0xcd, 0xb3, 0xe0, 0xee, 0x65, 0x68, 0xf5, 0x20, 0x6e, 0xc2, 0x96, 0xf9, 0x26, 0xe0, 0x29,
+	0x1f, 0xc9, 0x1f, 0xa1, 0x83, 0x69, 0xa8, 0x9e, 0xbf, 0xfa, 0x56, 0xdc, 0x0d, 0xa3, 0xa5, 0x4a,
+	0x56, 0x76, 0x86, 0xa7, 0x46, 0x05, 0x7d, 0x0e, 0x8b, 0xf6, 0x6a, 0xb1, 0xef, 0x93, 0x58, 0x3a,
+	0x73, 0x57, 0xa6, 0xc7, 0x05, 0x03, 0xd8, 0xd3, 0xfa, 0x68, 0x0f, 0x96, 0x70, 0x18, 0xb2, 0x33,
+	0x55, 0xd1, 0x23, 0xd5, 0xd1, 0x38, 0xf3, 0x57, 0x32, 0x2c, 0x6a, 0xc4, 0x89, 0x05, 0x34, 0x1e,
+	0xa9, 0x0f, 0x1e, 0xbf, 0xfb, 0xfb, 0x56, 0xe1, 0x9b, 0xf7, 0xaf, 0xf7, 0xdf, 0xba, 0xb8, 0xd7,
+	0xb5, 0xff, 0xdd, 0x69, 0xcf, 0x69, 0xfa, 0x0f, 0xff, 0x3b, 0x00, 0xfa, 0x5e, 0xdc, 0xdc, 0xe8,
+	0x1b, 0x00, 0x00,
 }
 
 func (this *Settings) Equal(that interface{}) bool {
@@ -2745,6 +2772,9 @@ func (this *GlooOptions) Equal(that interface{}) bool {
 	if !this.EnableRestEds.Equal(that1.EnableRestEds) {
 		return false
 	}
+	if !this.OutlierDetection.Equal(that1.OutlierDetection) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2898,6 +2928,9 @@ func (this *GatewayOptions) Equal(that interface{}) bool {
 	if this.CompressedProxySpec != that1.CompressedProxySpec {
 		return false
 	}
+	if this.DenyCrossNamespaceReferences != that1.DenyCrossNamespaceReferences {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -6,11 +6,10 @@ package v1
 import (
 	bytes "bytes"
 	fmt "fmt"
-	math "math"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -24,6 +23,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// What to do with the requests whose value does not match the subset of any endpoint.
+type SubsetSteering_FallbackPolicy int32
+
+const (
+	// Send the requests to any endpoint of the destinations.
+	SubsetSteering_ANY_ENDPOINT SubsetSteering_FallbackPolicy = 0
+	// Send the requests to the default subset. The requests without the header or query parameter are sent to it too.
+	SubsetSteering_DEFAULT_SUBSET SubsetSteering_FallbackPolicy = 1
+	// Respond to the requests with a 503 status code.
+	SubsetSteering_NO_FALLBACK SubsetSteering_FallbackPolicy = 2
+)
+
+var SubsetSteering_FallbackPolicy_name = map[int32]string{
+	0: "ANY_ENDPOINT",
+	1: "DEFAULT_SUBSET",
+	2: "NO_FALLBACK",
+}
+
+var SubsetSteering_FallbackPolicy_value = map[string]int32{
+	"ANY_ENDPOINT":   0,
+	"DEFAULT_SUBSET": 1,
+	"NO_FALLBACK":    2,
+}
+
+func (x SubsetSteering_FallbackPolicy) String() string {
+	return proto.EnumName(SubsetSteering_FallbackPolicy_name, int32(x))
+}
+
+func (SubsetSteering_FallbackPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b102e418fb8ab4ba, []int{1, 0}
+}
+
 type Subset struct {
 	Values               map[string]string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
@@ -62,9 +93,126 @@ func (m *Subset) GetValues() map[string]string {
 	return nil
 }
 
+// Picks the subset of the destinations of a route from a header or query parameter of each request. For example, the
+// requests with the `x-version: v2` header can be sent to the endpoints with the `version=v2` label, to pin the version
+// serving each request without writing a route per version.
+//
+// The route is expanded into a route for each value of the key among the endpoints of its upstreams, so the upstreams
+// must have a subset selector with the key, and the keys of the subsets of the destinations if any.
+// Only the first 32 values, in lexicographic order, are expanded: the requests with the other values are handled by the
+// fallback policy, and a warning is reported on the route.
+// The requests without the header or query parameter are routed as if there was no subset steering, unless the
+// fallback policy is `DEFAULT_SUBSET`.
+type SubsetSteering struct {
+	// Types that are valid to be assigned to Source:
+	//	*SubsetSteering_Header
+	//	*SubsetSteering_QueryParameter
+	Source isSubsetSteering_Source `protobuf_oneof:"source"`
+	// The subset key, i.e. the endpoint label, whose value is picked from the request.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// Defaults to `ANY_ENDPOINT`.
+	FallbackPolicy SubsetSteering_FallbackPolicy `protobuf:"varint,4,opt,name=fallback_policy,json=fallbackPolicy,proto3,enum=gloo.solo.io.SubsetSteering_FallbackPolicy" json:"fallback_policy,omitempty"`
+	// The subset used by the `DEFAULT_SUBSET` fallback policy. It is merged into the subsets of the destinations.
+	DefaultSubset        *Subset  `protobuf:"bytes,5,opt,name=default_subset,json=defaultSubset,proto3" json:"default_subset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubsetSteering) Reset()         { *m = SubsetSteering{} }
+func (m *SubsetSteering) String() string { return proto.CompactTextString(m) }
+func (*SubsetSteering) ProtoMessage()    {}
+func (*SubsetSteering) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b102e418fb8ab4ba, []int{1}
+}
+func (m *SubsetSteering) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubsetSteering.Unmarshal(m, b)
+}
+func (m *SubsetSteering) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubsetSteering.Marshal(b, m, deterministic)
+}
+func (m *SubsetSteering) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubsetSteering.Merge(m, src)
+}
+func (m *SubsetSteering) XXX_Size() int {
+	return xxx_messageInfo_SubsetSteering.Size(m)
+}
+func (m *SubsetSteering) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubsetSteering.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubsetSteering proto.InternalMessageInfo
+
+type isSubsetSteering_Source interface {
+	isSubsetSteering_Source()
+	Equal(interface{}) bool
+}
+
+type SubsetSteering_Header struct {
+	Header string `protobuf:"bytes,1,opt,name=header,proto3,oneof" json:"header,omitempty"`
+}
+type SubsetSteering_QueryParameter struct {
+	QueryParameter string `protobuf:"bytes,2,opt,name=query_parameter,json=queryParameter,proto3,oneof" json:"query_parameter,omitempty"`
+}
+
+func (*SubsetSteering_Header) isSubsetSteering_Source()         {}
+func (*SubsetSteering_QueryParameter) isSubsetSteering_Source() {}
+
+func (m *SubsetSteering) GetSource() isSubsetSteering_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *SubsetSteering) GetHeader() string {
+	if x, ok := m.GetSource().(*SubsetSteering_Header); ok {
+		return x.Header
+	}
+	return ""
+}
+
+func (m *SubsetSteering) GetQueryParameter() string {
+	if x, ok := m.GetSource().(*SubsetSteering_QueryParameter); ok {
+		return x.QueryParameter
+	}
+	return ""
+}
+
+func (m *SubsetSteering) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SubsetSteering) GetFallbackPolicy() SubsetSteering_FallbackPolicy {
+	if m != nil {
+		return m.FallbackPolicy
+	}
+	return SubsetSteering_ANY_ENDPOINT
+}
+
+func (m *SubsetSteering) GetDefaultSubset() *Subset {
+	if m != nil {
+		return m.DefaultSubset
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SubsetSteering) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SubsetSteering_Header)(nil),
+		(*SubsetSteering_QueryParameter)(nil),
+	}
+}
+
 func init() {
+	proto.RegisterEnum("gloo.solo.io.SubsetSteering_FallbackPolicy", SubsetSteering_FallbackPolicy_name, SubsetSteering_FallbackPolicy_value)
 	proto.RegisterType((*Subset)(nil), "gloo.solo.io.Subset")
 	proto.RegisterMapType((map[string]string)(nil), "gloo.solo.io.Subset.ValuesEntry")
+	proto.RegisterType((*SubsetSteering)(nil), "gloo.solo.io.SubsetSteering")
 }
 
 func init() {
@@ -72,21 +220,34 @@ func init() {
 }
 
 var fileDescriptor_b102e418fb8ab4ba = []byte{
-	// 219 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0x4c, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0xce, 0xcf, 0xc9, 0xd7, 0xcd, 0xcc, 0xd7, 0x4f,
-	0xcf, 0xc9, 0xcf, 0xd7, 0x2f, 0x28, 0xca, 0xcf, 0x4a, 0x4d, 0x2e, 0x29, 0x86, 0xf0, 0x12, 0x0b,
-	0x32, 0xf5, 0xcb, 0x0c, 0xf5, 0x8b, 0x4b, 0x93, 0x8a, 0x53, 0x4b, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b,
-	0xf2, 0x85, 0x78, 0x40, 0x32, 0x7a, 0x20, 0x4d, 0x7a, 0x99, 0xf9, 0x52, 0x22, 0xe9, 0xf9, 0xe9,
-	0xf9, 0x60, 0x09, 0x7d, 0x10, 0x0b, 0xa2, 0x46, 0x4a, 0x28, 0xb5, 0xa2, 0x04, 0x22, 0x98, 0x5a,
-	0x01, 0xd5, 0xa7, 0x54, 0xcb, 0xc5, 0x16, 0x0c, 0x36, 0x47, 0xc8, 0x82, 0x8b, 0xad, 0x2c, 0x31,
-	0xa7, 0x34, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x41, 0x0f, 0xd9, 0x48, 0x3d,
-	0x88, 0x2a, 0xbd, 0x30, 0xb0, 0x12, 0xd7, 0xbc, 0x92, 0xa2, 0xca, 0x20, 0xa8, 0x7a, 0x29, 0x4b,
-	0x2e, 0x6e, 0x24, 0x61, 0x21, 0x01, 0x2e, 0xe6, 0xec, 0xd4, 0x4a, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0xce, 0x20, 0x10, 0x53, 0x48, 0x84, 0x8b, 0x15, 0xac, 0x54, 0x82, 0x09, 0x2c, 0x06, 0xe1, 0x58,
-	0x31, 0x59, 0x30, 0x3a, 0x59, 0xed, 0xf8, 0xca, 0xc2, 0xb8, 0xe2, 0x91, 0x1c, 0x63, 0x94, 0x01,
-	0x71, 0x7e, 0x2f, 0xc8, 0x4e, 0x87, 0xfa, 0x3f, 0x89, 0x0d, 0xec, 0x03, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x0c, 0x7f, 0x33, 0x81, 0x36, 0x01, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x33, 0x49, 0x6b, 0xc1, 0x4b, 0x71, 0xac, 0x51, 0x16, 0x56, 0x16, 0xc8, 0xca, 0x2a,
+	0x08, 0x31, 0x86, 0xb0, 0x69, 0xcb, 0x2a, 0xa1, 0x09, 0x45, 0x44, 0x6e, 0x64, 0xbb, 0x48, 0xb0,
+	0xb1, 0xc6, 0xee, 0xc4, 0x35, 0x71, 0x3b, 0x66, 0x3c, 0xae, 0xea, 0x05, 0xf7, 0xe1, 0x08, 0x5c,
+	0x82, 0x4b, 0x70, 0x07, 0xf6, 0xc8, 0x33, 0x8e, 0x68, 0xa5, 0x2e, 0xba, 0x9b, 0xff, 0x7f, 0xff,
+	0xff, 0xe9, 0x59, 0x7e, 0x70, 0x94, 0x66, 0xf2, 0xb2, 0x8a, 0x49, 0xc2, 0xaf, 0xdc, 0x92, 0xe7,
+	0xfc, 0x55, 0xc6, 0xdd, 0x34, 0xe7, 0xdc, 0x2d, 0x04, 0xff, 0xc6, 0x12, 0x59, 0x6a, 0x45, 0x8b,
+	0xcc, 0xbd, 0x79, 0xe3, 0x96, 0x55, 0x5c, 0x32, 0x49, 0x0a, 0xc1, 0x25, 0xc7, 0x07, 0xcd, 0x84,
+	0x34, 0x25, 0x92, 0xf1, 0xd1, 0x30, 0xe5, 0x29, 0x57, 0x03, 0xb7, 0x79, 0xe9, 0xcc, 0x08, 0xb3,
+	0x5b, 0xa9, 0x4d, 0x76, 0xdb, 0xf6, 0xc6, 0x3f, 0xc0, 0x08, 0x14, 0x07, 0x1f, 0x82, 0x71, 0x43,
+	0xf3, 0x8a, 0x95, 0x36, 0x72, 0x7a, 0x93, 0xfe, 0xd4, 0x21, 0x77, 0x91, 0x44, 0xa7, 0xc8, 0x67,
+	0x15, 0x59, 0x5c, 0x4b, 0x51, 0xfb, 0x6d, 0x7e, 0x74, 0x04, 0xfd, 0x3b, 0x36, 0xb6, 0xa0, 0xb7,
+	0x65, 0xb5, 0x8d, 0x1c, 0x34, 0x79, 0xea, 0x37, 0x4f, 0x3c, 0x84, 0x7d, 0x15, 0xb5, 0xbb, 0xca,
+	0xd3, 0xe2, 0xb8, 0x7b, 0x88, 0xc6, 0xbf, 0xbb, 0x60, 0x6a, 0x72, 0x20, 0x19, 0x13, 0xd9, 0x75,
+	0x8a, 0x6d, 0x30, 0x2e, 0x19, 0xbd, 0x60, 0x42, 0x13, 0x4e, 0x3b, 0x7e, 0xab, 0xf1, 0x0b, 0x18,
+	0x7c, 0xaf, 0x98, 0xa8, 0xa3, 0x82, 0x0a, 0x7a, 0xc5, 0x24, 0x13, 0x1a, 0x78, 0xda, 0xf1, 0x4d,
+	0x35, 0x58, 0xef, 0xfc, 0xdd, 0x0e, 0xbd, 0xff, 0x3b, 0x84, 0x30, 0xd8, 0xd0, 0x3c, 0x8f, 0x69,
+	0xb2, 0x8d, 0x0a, 0x9e, 0x67, 0x49, 0x6d, 0xef, 0x39, 0x68, 0x62, 0x4e, 0x5f, 0x3e, 0xf4, 0x9d,
+	0xbb, 0x6d, 0xc8, 0xb2, 0xed, 0xac, 0x55, 0xc5, 0x37, 0x37, 0xf7, 0x34, 0x7e, 0x07, 0xe6, 0x05,
+	0xdb, 0xd0, 0x2a, 0x97, 0x91, 0xfe, 0x1d, 0xf6, 0xbe, 0x83, 0x26, 0xfd, 0xe9, 0xf0, 0x21, 0xa8,
+	0xff, 0xac, 0xcd, 0x6a, 0x39, 0xfe, 0x00, 0xe6, 0x7d, 0x3c, 0xb6, 0xe0, 0x60, 0xe6, 0x7d, 0x89,
+	0x16, 0xde, 0xc9, 0xfa, 0xec, 0xa3, 0x17, 0x5a, 0x1d, 0x8c, 0xc1, 0x3c, 0x59, 0x2c, 0x67, 0xe7,
+	0xab, 0x30, 0x0a, 0xce, 0xe7, 0xc1, 0x22, 0xb4, 0x10, 0x1e, 0x40, 0xdf, 0x3b, 0x8b, 0x96, 0xb3,
+	0xd5, 0x6a, 0x3e, 0x7b, 0xff, 0xc9, 0xea, 0xce, 0x9f, 0x80, 0x51, 0xf2, 0x4a, 0x24, 0x6c, 0x7e,
+	0xfc, 0xeb, 0xef, 0x1e, 0xfa, 0xf9, 0xe7, 0x39, 0xfa, 0xfa, 0xfa, 0x71, 0xb7, 0x54, 0x6c, 0xd3,
+	0xf6, 0x9e, 0x62, 0x43, 0x5d, 0xc4, 0xdb, 0x7f, 0x03, 0x00, 0x1e, 0x00, 0xd5, 0x55, 0x86, 0x02,
+	0x00, 0x00,
 }
 
 func (this *Subset) Equal(that interface{}) bool {
@@ -121,3 +282,93 @@ func (this *Subset) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SubsetSteering) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubsetSteering)
+	if !ok {
+		that2, ok := that.(SubsetSteering)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Source == nil {
+		if this.Source != nil {
+			return false
+		}
+	} else if this.Source == nil {
+		return false
+	} else if !this.Source.Equal(that1.Source) {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.FallbackPolicy != that1.FallbackPolicy {
+		return false
+	}
+	if !this.DefaultSubset.Equal(that1.DefaultSubset) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SubsetSteering_Header) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubsetSteering_Header)
+	if !ok {
+		that2, ok := that.(SubsetSteering_Header)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Header != that1.Header {
+		return false
+	}
+	return true
+}
+func (this *SubsetSteering_QueryParameter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubsetSteering_QueryParameter)
+	if !ok {
+		that2, ok := that.(SubsetSteering_QueryParameter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.QueryParameter != that1.QueryParameter {
+		return false
+	}
+	return true
+}
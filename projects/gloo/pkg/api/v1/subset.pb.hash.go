@@ -63,3 +63,58 @@ func (m *Subset) Hash(hasher hash.Hash64) (uint64, error) {
 
 	return hasher.Sum64(), nil
 }
+
+// Hash function
+func (m *SubsetSteering) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.SubsetSteering")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetKey())); err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetFallbackPolicy())
+	if err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetDefaultSubset()).(safe_hasher.SafeHasher); ok {
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if val, err := hashstructure.Hash(m.GetDefaultSubset(), nil); err != nil {
+			return 0, err
+		} else {
+			if err := binary.Write(hasher, binary.LittleEndian, val); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	switch m.Source.(type) {
+
+	case *SubsetSteering_Header:
+
+		if _, err = hasher.Write([]byte(m.GetHeader())); err != nil {
+			return 0, err
+		}
+
+	case *SubsetSteering_QueryParameter:
+
+		if _, err = hasher.Write([]byte(m.GetQueryParameter())); err != nil {
+			return 0, err
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
@@ -1,7 +1,11 @@
 package basicroute
 
 import (
+	"strings"
+	"time"
+
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	previous_hosts "github.com/envoyproxy/go-control-plane/envoy/config/retry/previous_hosts/v2"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -9,9 +13,33 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/upgradeconfig"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/errors"
 )
 
+const (
+	previousHostsPredicate      = "envoy.retry_host_predicates.previous_hosts"
+	retriableStatusCodesRetryOn = "retriable-status-codes"
+)
+
+var (
+	InvalidPerTryTimeoutErr = func(perTryTimeout time.Duration) error {
+		return errors.Errorf("retry policy per try timeout must be greater than zero, got %v", perTryTimeout)
+	}
+	PerTryTimeoutExceedsTimeoutErr = func(perTryTimeout, timeout time.Duration) error {
+		return errors.Errorf("retry policy per try timeout %v must not be greater than the route timeout %v", perTryTimeout, timeout)
+	}
+	MissingBaseIntervalErr = errors.Errorf("retry back off base interval must be greater than zero")
+	InvalidMaxIntervalErr  = func(maxInterval, baseInterval time.Duration) error {
+		return errors.Errorf("retry back off max interval %v must not be less than the base interval %v", maxInterval, baseInterval)
+	}
+	RetriableStatusCodesNotEnabledErr = errors.Errorf("retriable status codes are only used if retry on includes %v", retriableStatusCodesRetryOn)
+	InvalidRetriableStatusCodeErr     = func(code uint32) error {
+		return errors.Errorf("retriable status code %v is not a valid HTTP status code", code)
+	}
+	HostSelectionWithoutPredicateErr = errors.Errorf("host selection retry max attempts is only used when avoiding previous hosts")
+)
+
 type Plugin struct{}
 
 var _ plugins.RoutePlugin = NewPlugin()
@@ -43,7 +71,7 @@ func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	if err := applyTimeout(in, out); err != nil {
 		return err
 	}
-	if err := applyRetries(in, out); err != nil {
+	if err := applyRetries(in, params.VirtualHost, out); err != nil {
 		return err
 	}
 	if err := applyHostRewrite(in, out); err != nil {
@@ -89,10 +117,11 @@ func applyTimeout(in *v1.Route, out *envoyroute.Route) error {
 	return nil
 }
 
-func applyRetries(in *v1.Route, out *envoyroute.Route) error {
+func applyRetries(in *v1.Route, vhost *v1.VirtualHost, out *envoyroute.Route) error {
 	policy := in.Options.Retries
 	if policy == nil {
-		return nil
+		// the retry policy of the virtual host applies to the route
+		return validateRetriesForTimeout(vhost.GetOptions().GetRetries(), in.Options.Timeout)
 	}
 	routeAction, ok := out.Action.(*envoyroute.Route_Route)
 	if !ok {
@@ -102,8 +131,15 @@ func applyRetries(in *v1.Route, out *envoyroute.Route) error {
 		return errors.Errorf("internal error: route %v specified a prefix, but output Envoy object "+
 			"had nil route", in.Action)
 	}
+	if err := validateRetriesForTimeout(policy, in.Options.Timeout); err != nil {
+		return err
+	}
 
-	routeAction.Route.RetryPolicy = convertPolicy(policy)
+	retryPolicy, err := convertPolicy(policy)
+	if err != nil {
+		return err
+	}
+	routeAction.Route.RetryPolicy = retryPolicy
 	return nil
 }
 
@@ -168,13 +204,17 @@ func applyUpgrades(in *v1.Route, out *envoyroute.Route) error {
 }
 
 func applyRetriesVhost(in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
-	out.RetryPolicy = convertPolicy(in.Options.Retries)
+	retryPolicy, err := convertPolicy(in.Options.Retries)
+	if err != nil {
+		return err
+	}
+	out.RetryPolicy = retryPolicy
 	return nil
 }
 
-func convertPolicy(policy *retries.RetryPolicy) *envoyroute.RetryPolicy {
+func convertPolicy(policy *retries.RetryPolicy) (*envoyroute.RetryPolicy, error) {
 	if policy == nil {
-		return nil
+		return nil, nil
 	}
 
 	numRetries := policy.NumRetries
@@ -182,9 +222,70 @@ func convertPolicy(policy *retries.RetryPolicy) *envoyroute.RetryPolicy {
 		numRetries = 1
 	}
 
-	return &envoyroute.RetryPolicy{
-		RetryOn:       policy.RetryOn,
-		NumRetries:    &wrappers.UInt32Value{Value: numRetries},
-		PerTryTimeout: gogoutils.DurationStdToProto(policy.PerTryTimeout),
+	out := &envoyroute.RetryPolicy{
+		RetryOn:              policy.RetryOn,
+		NumRetries:           &wrappers.UInt32Value{Value: numRetries},
+		PerTryTimeout:        gogoutils.DurationStdToProto(policy.PerTryTimeout),
+		RetriableStatusCodes: policy.RetriableStatusCodes,
+	}
+
+	if policy.PerTryTimeout != nil && *policy.PerTryTimeout <= 0 {
+		return nil, InvalidPerTryTimeoutErr(*policy.PerTryTimeout)
+	}
+
+	if backOff := policy.RetryBackOff; backOff != nil {
+		if backOff.BaseInterval == nil || *backOff.BaseInterval <= 0 {
+			return nil, MissingBaseIntervalErr
+		}
+		if backOff.MaxInterval != nil && *backOff.MaxInterval < *backOff.BaseInterval {
+			return nil, InvalidMaxIntervalErr(*backOff.MaxInterval, *backOff.BaseInterval)
+		}
+		out.RetryBackOff = &envoyroute.RetryPolicy_RetryBackOff{
+			BaseInterval: gogoutils.DurationStdToProto(backOff.BaseInterval),
+			MaxInterval:  gogoutils.DurationStdToProto(backOff.MaxInterval),
+		}
+	}
+
+	if len(policy.RetriableStatusCodes) > 0 && !retriesOn(policy.RetryOn, retriableStatusCodesRetryOn) {
+		return nil, RetriableStatusCodesNotEnabledErr
+	}
+	for _, code := range policy.RetriableStatusCodes {
+		if code < 100 || code >= 600 {
+			return nil, InvalidRetriableStatusCodeErr(code)
+		}
+	}
+
+	if policy.AvoidPreviousHosts {
+		out.RetryHostPredicate = []*envoyroute.RetryPolicy_RetryHostPredicate{{
+			Name: previousHostsPredicate,
+			ConfigType: &envoyroute.RetryPolicy_RetryHostPredicate_TypedConfig{
+				TypedConfig: utils.MustMessageToAny(&previous_hosts.PreviousHostsPredicate{}),
+			},
+		}}
+		out.HostSelectionRetryMaxAttempts = int64(policy.HostSelectionRetryMaxAttempts)
+	} else if policy.HostSelectionRetryMaxAttempts != 0 {
+		return nil, HostSelectionWithoutPredicateErr
+	}
+
+	return out, nil
+}
+
+// the per try timeout cannot be longer than the timeout the route sets for the whole request
+func validateRetriesForTimeout(policy *retries.RetryPolicy, timeout *time.Duration) error {
+	if policy.GetPerTryTimeout() == nil || timeout == nil || *timeout == 0 {
+		return nil
+	}
+	if *policy.GetPerTryTimeout() > *timeout {
+		return PerTryTimeoutExceedsTimeoutErr(*policy.GetPerTryTimeout(), *timeout)
+	}
+	return nil
+}
+
+func retriesOn(retryOn, condition string) bool {
+	for _, c := range strings.Split(retryOn, ",") {
+		if strings.TrimSpace(c) == condition {
+			return true
+		}
 	}
+	return false
 }
@@ -137,6 +137,108 @@ var _ = Describe("retries", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(out.RetryPolicy).To(Equal(expectedRetryPolicy))
 	})
+
+	It("translates back off, retriable status codes and host predicates", func() {
+		base, max := 100*time.Millisecond, time.Second
+		retryPolicy.RetryOn = "5xx,retriable-status-codes"
+		retryPolicy.RetryBackOff = &retries.RetryBackOff{BaseInterval: &base, MaxInterval: &max}
+		retryPolicy.RetriableStatusCodes = []uint32{409}
+		retryPolicy.AvoidPreviousHosts = true
+		retryPolicy.HostSelectionRetryMaxAttempts = 3
+
+		out := &envoyroute.VirtualHost{}
+		err := plugin.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				Retries: retryPolicy,
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.RetryPolicy.RetryOn).To(Equal("5xx,retriable-status-codes"))
+		Expect(out.RetryPolicy.RetryBackOff).To(Equal(&envoyroute.RetryPolicy_RetryBackOff{
+			BaseInterval: gogoutils.DurationStdToProto(&base),
+			MaxInterval:  gogoutils.DurationStdToProto(&max),
+		}))
+		Expect(out.RetryPolicy.RetriableStatusCodes).To(Equal([]uint32{409}))
+		Expect(out.RetryPolicy.RetryHostPredicate).To(HaveLen(1))
+		Expect(out.RetryPolicy.RetryHostPredicate[0].Name).To(Equal("envoy.retry_host_predicates.previous_hosts"))
+		Expect(out.RetryPolicy.HostSelectionRetryMaxAttempts).To(BeEquivalentTo(3))
+	})
+
+	Context("validation", func() {
+
+		processRoute := func(timeout *time.Duration, vhost *v1.VirtualHost, routeRetries *retries.RetryPolicy) error {
+			out := &envoyroute.Route{
+				Action: &envoyroute.Route_Route{
+					Route: &envoyroute.RouteAction{},
+				},
+			}
+			return plugin.ProcessRoute(plugins.RouteParams{VirtualHost: vhost}, &v1.Route{
+				Options: &v1.RouteOptions{
+					Timeout: timeout,
+					Retries: routeRetries,
+				},
+			}, out)
+		}
+
+		processVhost := func() error {
+			return plugin.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{
+					Retries: retryPolicy,
+				},
+			}, &envoyroute.VirtualHost{})
+		}
+
+		It("rejects per try timeouts longer than the route timeout", func() {
+			timeout := 30 * time.Second
+			Expect(processRoute(&timeout, nil, retryPolicy)).To(MatchError(PerTryTimeoutExceedsTimeoutErr(time.Minute, timeout).Error()))
+		})
+
+		It("rejects per try timeouts of the virtual host longer than the route timeout", func() {
+			timeout := 30 * time.Second
+			vhost := &v1.VirtualHost{Options: &v1.VirtualHostOptions{Retries: retryPolicy}}
+			Expect(processRoute(&timeout, vhost, nil)).To(MatchError(PerTryTimeoutExceedsTimeoutErr(time.Minute, timeout).Error()))
+
+			// the route retry policy overrides the one of the virtual host
+			perTryTimeout := 10 * time.Second
+			Expect(processRoute(&timeout, vhost, &retries.RetryPolicy{PerTryTimeout: &perTryTimeout})).NotTo(HaveOccurred())
+		})
+
+		It("accepts per try timeouts if the route has no timeout", func() {
+			Expect(processRoute(nil, nil, retryPolicy)).NotTo(HaveOccurred())
+			vhost := &v1.VirtualHost{Options: &v1.VirtualHostOptions{Retries: retryPolicy}}
+			Expect(processRoute(nil, vhost, nil)).NotTo(HaveOccurred())
+			disabled := time.Duration(0)
+			Expect(processRoute(&disabled, nil, retryPolicy)).NotTo(HaveOccurred())
+		})
+
+		It("requires a base interval for back off", func() {
+			max := time.Second
+			retryPolicy.RetryBackOff = &retries.RetryBackOff{MaxInterval: &max}
+			Expect(processVhost()).To(MatchError(MissingBaseIntervalErr))
+		})
+
+		It("rejects max intervals less than the base interval", func() {
+			base, max := time.Second, 100*time.Millisecond
+			retryPolicy.RetryBackOff = &retries.RetryBackOff{BaseInterval: &base, MaxInterval: &max}
+			Expect(processVhost()).To(MatchError(InvalidMaxIntervalErr(max, base).Error()))
+		})
+
+		It("requires retriable status codes to be enabled", func() {
+			retryPolicy.RetriableStatusCodes = []uint32{409}
+			Expect(processVhost()).To(MatchError(RetriableStatusCodesNotEnabledErr))
+		})
+
+		It("rejects invalid retriable status codes", func() {
+			retryPolicy.RetryOn = "retriable-status-codes"
+			retryPolicy.RetriableStatusCodes = []uint32{600}
+			Expect(processVhost()).To(MatchError(InvalidRetriableStatusCodeErr(600).Error()))
+		})
+
+		It("rejects host selection attempts without avoiding previous hosts", func() {
+			retryPolicy.HostSelectionRetryMaxAttempts = 3
+			Expect(processVhost()).To(MatchError(HostSelectionWithoutPredicateErr))
+		})
+	})
 })
 
 var _ = Describe("host rewrite", func() {
@@ -0,0 +1,13 @@
+package dynamicforwardproxy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDynamicForwardProxy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Dynamic Forward Proxy Suite")
+}
@@ -0,0 +1,224 @@
+package dynamicforwardproxy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoydfpcluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/dynamic_forward_proxy/v2alpha"
+	envoydfpcommon "github.com/envoyproxy/go-control-plane/envoy/config/common/dynamic_forward_proxy/v2alpha"
+	envoydfp "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/dynamic_forward_proxy/v2alpha"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/gogoutils"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dynamic_forward_proxy"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+const (
+	FilterName      = "envoy.filters.http.dynamic_forward_proxy"
+	ClusterTypeName = "envoy.clusters.dynamic_forward_proxy"
+)
+
+// the filter must resolve the host before the router forwards the request
+var pluginStage = plugins.DuringStage(plugins.RouteStage)
+
+var (
+	ConflictingDnsCacheConfigsErr = eris.New("the dynamic forward proxy upstreams of a listener must have the same dns cache config")
+	MultipleDestinationsErr       = eris.New("dynamic forward proxy upstreams can only be the single destination of a route")
+	MissingUpstreamErr            = eris.New("dynamic forward proxy route options require a dynamic forward proxy upstream destination")
+	HostNotAllowedError           = func(host string) error {
+		return eris.Errorf("the host rewrite %v is not one of the allowed hosts of the upstream", host)
+	}
+)
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.UpstreamPlugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+
+type Plugin struct{}
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	// not ours
+	dfpSpec, ok := in.UpstreamType.(*v1.Upstream_DynamicForwardProxy)
+	if !ok {
+		return nil
+	}
+
+	dnsCacheConfig, err := translateDnsCacheConfig(dfpSpec.DynamicForwardProxy.GetDnsCacheConfig())
+	if err != nil {
+		return err
+	}
+	clusterConfig, err := utils.MessageToAny(&envoydfpcluster.ClusterConfig{DnsCacheConfig: dnsCacheConfig})
+	if err != nil {
+		return err
+	}
+	out.ClusterDiscoveryType = &envoyapi.Cluster_ClusterType{
+		ClusterType: &envoyapi.Cluster_CustomClusterType{
+			Name:        ClusterTypeName,
+			TypedConfig: clusterConfig,
+		},
+	}
+	// the hosts are resolved by the dns cache, not by eds
+	out.EdsClusterConfig = nil
+	out.LoadAssignment = nil
+	out.LbPolicy = envoyapi.Cluster_CLUSTER_PROVIDED
+	return nil
+}
+
+// The filter is only added to the listeners with routes to dynamic forward proxy upstreams. Envoy requires the dns cache
+// config of the filter to be the one of the clusters, so these upstreams must share their dns cache config.
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	var cacheConfig *dynamic_forward_proxy.DnsCacheConfig
+	var found bool
+	for _, vhost := range listener.GetVirtualHosts() {
+		for _, route := range vhost.GetRoutes() {
+			for _, upstream := range dynamicForwardProxyUpstreams(params.Snapshot, route) {
+				config := upstream.GetDynamicForwardProxy().GetDnsCacheConfig()
+				if found && !config.Equal(cacheConfig) {
+					return nil, ConflictingDnsCacheConfigsErr
+				}
+				cacheConfig, found = config, true
+			}
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+
+	dnsCacheConfig, err := translateDnsCacheConfig(cacheConfig)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := plugins.NewStagedFilterWithConfig(FilterName, &envoydfp.FilterConfig{DnsCacheConfig: dnsCacheConfig}, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	upstreams := dynamicForwardProxyUpstreams(params.Snapshot, in)
+	if len(upstreams) == 0 {
+		if in.GetOptions().GetDynamicForwardProxy() != nil {
+			return MissingUpstreamErr
+		}
+		return nil
+	}
+	if in.GetRouteAction().GetSingle() == nil {
+		return MultipleDestinationsErr
+	}
+
+	perRouteConfig := in.GetOptions().GetDynamicForwardProxy()
+	if allowedHosts := upstreams[0].GetDynamicForwardProxy().GetAllowedHosts(); len(allowedHosts) > 0 {
+		// match the allowed hosts against the host the request is forwarded to
+		regex := AllowedHostsRegex(allowedHosts)
+		hostHeader := ":authority"
+		switch specifier := perRouteConfig.GetHostRewriteSpecifier().(type) {
+		case *dynamic_forward_proxy.PerRouteConfig_HostRewrite:
+			if !regexp.MustCompile("^" + regex + "$").MatchString(specifier.HostRewrite) {
+				return HostNotAllowedError(specifier.HostRewrite)
+			}
+			hostHeader = ""
+		case *dynamic_forward_proxy.PerRouteConfig_AutoHostRewriteHeader:
+			hostHeader = specifier.AutoHostRewriteHeader
+		}
+		if hostHeader != "" && out.Match != nil {
+			out.Match.Headers = append(out.Match.Headers, &envoyroute.HeaderMatcher{
+				Name: hostHeader,
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_SafeRegexMatch{
+					SafeRegexMatch: regexutils.NewRegex(params.Ctx, regex),
+				},
+			})
+		}
+	}
+
+	envoyPerRouteConfig := translatePerRouteConfig(perRouteConfig)
+	if envoyPerRouteConfig == nil {
+		return nil
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, envoyPerRouteConfig)
+}
+
+// Returns the regex matching the allowed hosts. `*.` prefixes match any subdomain,
+// and hosts without a port match any port. Hosts are case-insensitive, like the :authority header.
+func AllowedHostsRegex(allowedHosts []string) string {
+	var regexes []string
+	for _, host := range allowedHosts {
+		var regex string
+		if strings.HasPrefix(host, "*.") {
+			regex = `[^:/]+\.`
+			host = strings.TrimPrefix(host, "*.")
+		}
+		regex += regexp.QuoteMeta(host)
+		if !strings.Contains(host, ":") {
+			regex += `(:[0-9]+)?`
+		}
+		regexes = append(regexes, regex)
+	}
+	return fmt.Sprintf("(?i)(%s)", strings.Join(regexes, "|"))
+}
+
+// Returns the dynamic forward proxy upstreams among the destinations of the route.
+func dynamicForwardProxyUpstreams(snap *v1.ApiSnapshot, in *v1.Route) []*v1.Upstream {
+	var upstreams []*v1.Upstream
+	for _, upstream := range pluginutils.DestinationUpstreamsInSnapshot(snap, in.GetRouteAction()) {
+		if upstream.GetDynamicForwardProxy() != nil {
+			upstreams = append(upstreams, upstream)
+		}
+	}
+	return upstreams
+}
+
+// The name of the cache is derived from its config, so that upstreams with the same config share their cache.
+func translateDnsCacheConfig(in *dynamic_forward_proxy.DnsCacheConfig) (*envoydfpcommon.DnsCacheConfig, error) {
+	hash, err := in.Hash(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &envoydfpcommon.DnsCacheConfig{
+		Name:            fmt.Sprintf("dynamic_forward_proxy_cache_%d", hash),
+		DnsLookupFamily: translateDnsLookupFamily(in.GetDnsLookupFamily()),
+		DnsRefreshRate:  gogoutils.DurationStdToProto(in.GetDnsRefreshRate()),
+		HostTtl:         gogoutils.DurationStdToProto(in.GetHostTtl()),
+		MaxHosts:        gogoutils.UInt32GogoToProto(in.GetMaxHosts()),
+	}, nil
+}
+
+func translateDnsLookupFamily(in dynamic_forward_proxy.DnsCacheConfig_DnsLookupFamily) envoyapi.Cluster_DnsLookupFamily {
+	switch in {
+	case dynamic_forward_proxy.DnsCacheConfig_V4_ONLY:
+		return envoyapi.Cluster_V4_ONLY
+	case dynamic_forward_proxy.DnsCacheConfig_V6_ONLY:
+		return envoyapi.Cluster_V6_ONLY
+	}
+	return envoyapi.Cluster_AUTO
+}
+
+func translatePerRouteConfig(in *dynamic_forward_proxy.PerRouteConfig) *envoydfp.PerRouteConfig {
+	switch specifier := in.GetHostRewriteSpecifier().(type) {
+	case *dynamic_forward_proxy.PerRouteConfig_HostRewrite:
+		return &envoydfp.PerRouteConfig{
+			HostRewriteSpecifier: &envoydfp.PerRouteConfig_HostRewrite{HostRewrite: specifier.HostRewrite},
+		}
+	case *dynamic_forward_proxy.PerRouteConfig_AutoHostRewriteHeader:
+		return &envoydfp.PerRouteConfig{
+			HostRewriteSpecifier: &envoydfp.PerRouteConfig_AutoHostRewriteHeader{AutoHostRewriteHeader: specifier.AutoHostRewriteHeader},
+		}
+	}
+	return nil
+}
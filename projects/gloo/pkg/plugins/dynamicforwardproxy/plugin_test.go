@@ -0,0 +1,227 @@
+package dynamicforwardproxy_test
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoydfpcluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/dynamic_forward_proxy/v2alpha"
+	envoydfp "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/dynamic_forward_proxy/v2alpha"
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dynamic_forward_proxy"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/dynamicforwardproxy"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		plugin   *Plugin
+		params   plugins.Params
+		upstream *v1.Upstream
+		static   *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		refreshRate := 30 * time.Second
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "egress", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_DynamicForwardProxy{
+				DynamicForwardProxy: &dynamic_forward_proxy.UpstreamSpec{
+					DnsCacheConfig: &dynamic_forward_proxy.DnsCacheConfig{
+						DnsLookupFamily: dynamic_forward_proxy.DnsCacheConfig_V4_ONLY,
+						DnsRefreshRate:  &refreshRate,
+						MaxHosts:        &types.UInt32Value{Value: 100},
+					},
+					AllowedHosts: []string{"api.example.com", "*.solo.io", "localhost:8080"},
+				},
+			},
+		}
+		static = &v1.Upstream{
+			Metadata:     core.Metadata{Name: "static", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{},
+		}
+		params = plugins.Params{
+			Ctx:      context.TODO(),
+			Snapshot: &v1.ApiSnapshot{Upstreams: v1.UpstreamList{upstream, static}},
+		}
+	})
+
+	routeTo := func(upstreams ...*v1.Upstream) *v1.Route {
+		if len(upstreams) == 1 {
+			ref := upstreams[0].Metadata.Ref()
+			return &v1.Route{Action: &v1.Route_RouteAction{RouteAction: &v1.RouteAction{
+				Destination: &v1.RouteAction_Single{Single: &v1.Destination{
+					DestinationType: &v1.Destination_Upstream{Upstream: &ref},
+				}},
+			}}}
+		}
+		var destinations []*v1.WeightedDestination
+		for _, us := range upstreams {
+			ref := us.Metadata.Ref()
+			destinations = append(destinations, &v1.WeightedDestination{Weight: 1, Destination: &v1.Destination{
+				DestinationType: &v1.Destination_Upstream{Upstream: &ref},
+			}})
+		}
+		return &v1.Route{Action: &v1.Route_RouteAction{RouteAction: &v1.RouteAction{
+			Destination: &v1.RouteAction_Multi{Multi: &v1.MultiDestination{Destinations: destinations}},
+		}}}
+	}
+
+	listenerWith := func(routes ...*v1.Route) *v1.HttpListener {
+		return &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{{Name: "vhost", Routes: routes}}}
+	}
+
+	Context("upstreams", func() {
+
+		It("configures a dynamic forward proxy cluster", func() {
+			out := &envoyapi.Cluster{EdsClusterConfig: &envoyapi.Cluster_EdsClusterConfig{}}
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(out.LbPolicy).To(Equal(envoyapi.Cluster_CLUSTER_PROVIDED))
+			Expect(out.EdsClusterConfig).To(BeNil())
+			Expect(out.GetClusterType().GetName()).To(Equal(ClusterTypeName))
+			msg, err := utils.AnyToMessage(out.GetClusterType().GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			cacheConfig := msg.(*envoydfpcluster.ClusterConfig).GetDnsCacheConfig()
+			Expect(cacheConfig.GetName()).NotTo(BeEmpty())
+			Expect(cacheConfig.GetDnsLookupFamily()).To(Equal(envoyapi.Cluster_V4_ONLY))
+			Expect(cacheConfig.GetDnsRefreshRate().GetSeconds()).To(BeEquivalentTo(30))
+			Expect(cacheConfig.GetMaxHosts().GetValue()).To(BeEquivalentTo(100))
+			Expect(cacheConfig.GetHostTtl()).To(BeNil())
+		})
+
+		It("ignores other upstreams", func() {
+			out := &envoyapi.Cluster{}
+			err := plugin.ProcessUpstream(params, static, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(&envoyapi.Cluster{}))
+		})
+	})
+
+	Context("http filters", func() {
+
+		It("adds the filter with the dns cache of the upstreams", func() {
+			filters, err := plugin.HttpFilters(params, listenerWith(routeTo(static), routeTo(upstream)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter.Name).To(Equal(FilterName))
+
+			msg, err := utils.AnyToMessage(filters[0].HttpFilter.GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			cluster := &envoyapi.Cluster{}
+			Expect(plugin.ProcessUpstream(params, upstream, cluster)).To(Succeed())
+			clusterConfig, err := utils.AnyToMessage(cluster.GetClusterType().GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg.(*envoydfp.FilterConfig).GetDnsCacheConfig()).To(Equal(clusterConfig.(*envoydfpcluster.ClusterConfig).GetDnsCacheConfig()))
+		})
+
+		It("does not add the filter without routes to dynamic forward proxy upstreams", func() {
+			filters, err := plugin.HttpFilters(params, listenerWith(routeTo(static)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("errors when the upstreams have different dns caches", func() {
+			other := &v1.Upstream{
+				Metadata: core.Metadata{Name: "other", Namespace: "gloo-system"},
+				UpstreamType: &v1.Upstream_DynamicForwardProxy{
+					DynamicForwardProxy: &dynamic_forward_proxy.UpstreamSpec{},
+				},
+			}
+			params.Snapshot.Upstreams = append(params.Snapshot.Upstreams, other)
+			_, err := plugin.HttpFilters(params, listenerWith(routeTo(upstream), routeTo(other)))
+			Expect(err).To(MatchError(ConflictingDnsCacheConfigsErr))
+		})
+	})
+
+	Context("routes", func() {
+
+		var routeParams plugins.RouteParams
+
+		BeforeEach(func() {
+			routeParams = plugins.RouteParams{VirtualHostParams: plugins.VirtualHostParams{Params: params}}
+		})
+
+		It("only matches the allowed hosts", func() {
+			out := &envoyroute.Route{Match: &envoyroute.RouteMatch{}}
+			err := plugin.ProcessRoute(routeParams, routeTo(upstream), out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Match.Headers).To(HaveLen(1))
+			Expect(out.Match.Headers[0].Name).To(Equal(":authority"))
+
+			regex := regexp.MustCompile("^" + out.Match.Headers[0].GetSafeRegexMatch().GetRegex() + "$")
+			for _, host := range []string{"api.example.com", "api.example.com:443", "API.Example.com", "www.solo.io", "docs.WWW.solo.io:80", "localhost:8080"} {
+				Expect(regex.MatchString(host)).To(BeTrue(), host)
+			}
+			for _, host := range []string{"example.com", "apixexample.com", "solo.io", "localhost", "localhost:9090", "evil.com/api.example.com"} {
+				Expect(regex.MatchString(host)).To(BeFalse(), host)
+			}
+		})
+
+		It("matches all hosts without allowed hosts", func() {
+			upstream.GetDynamicForwardProxy().AllowedHosts = nil
+			out := &envoyroute.Route{Match: &envoyroute.RouteMatch{}}
+			err := plugin.ProcessRoute(routeParams, routeTo(upstream), out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Match.Headers).To(BeEmpty())
+		})
+
+		It("sets the per route config", func() {
+			route := routeTo(upstream)
+			route.Options = &v1.RouteOptions{DynamicForwardProxy: &dynamic_forward_proxy.PerRouteConfig{
+				HostRewriteSpecifier: &dynamic_forward_proxy.PerRouteConfig_AutoHostRewriteHeader{AutoHostRewriteHeader: "x-host"},
+			}}
+			out := &envoyroute.Route{Match: &envoyroute.RouteMatch{}}
+			err := plugin.ProcessRoute(routeParams, route, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			msg, err := utils.AnyToMessage(out.GetTypedPerFilterConfig()[FilterName])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg.(*envoydfp.PerRouteConfig).GetAutoHostRewriteHeader()).To(Equal("x-host"))
+
+			// the allowed hosts apply to the host the requests are forwarded to
+			Expect(out.Match.Headers).To(HaveLen(1))
+			Expect(out.Match.Headers[0].Name).To(Equal("x-host"))
+		})
+
+		It("errors when the host rewrite is not allowed", func() {
+			route := routeTo(upstream)
+			route.Options = &v1.RouteOptions{DynamicForwardProxy: &dynamic_forward_proxy.PerRouteConfig{
+				HostRewriteSpecifier: &dynamic_forward_proxy.PerRouteConfig_HostRewrite{HostRewrite: "example.com"},
+			}}
+			err := plugin.ProcessRoute(routeParams, route, &envoyroute.Route{Match: &envoyroute.RouteMatch{}})
+			Expect(err).To(MatchError(HostNotAllowedError("example.com")))
+
+			route.GetOptions().GetDynamicForwardProxy().HostRewriteSpecifier = &dynamic_forward_proxy.PerRouteConfig_HostRewrite{HostRewrite: "API.example.com"}
+			out := &envoyroute.Route{Match: &envoyroute.RouteMatch{}}
+			err = plugin.ProcessRoute(routeParams, route, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Match.Headers).To(BeEmpty())
+		})
+
+		It("errors when the upstream is not the single destination", func() {
+			err := plugin.ProcessRoute(routeParams, routeTo(upstream, static), &envoyroute.Route{})
+			Expect(err).To(MatchError(MultipleDestinationsErr))
+		})
+
+		It("errors when routes to other upstreams have dynamic forward proxy options", func() {
+			route := routeTo(static)
+			route.Options = &v1.RouteOptions{DynamicForwardProxy: &dynamic_forward_proxy.PerRouteConfig{
+				HostRewriteSpecifier: &dynamic_forward_proxy.PerRouteConfig_HostRewrite{HostRewrite: "example.com"},
+			}}
+			err := plugin.ProcessRoute(routeParams, route, &envoyroute.Route{})
+			Expect(err).To(MatchError(MissingUpstreamErr))
+		})
+	})
+})
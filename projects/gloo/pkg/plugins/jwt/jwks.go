@@ -0,0 +1,124 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
+var (
+	EmptyKeyErr          = eris.New("key must not be empty")
+	NoKeysErr            = eris.New("json web key set must have at least one key")
+	UnsupportedKeyErr    = eris.New("PEM keys must be RSA or EC public keys")
+	UnsupportedCurveErr  = func(curve string) error { return eris.Errorf("unsupported elliptic curve %v", curve) }
+	InvalidPemErr        = eris.New("key is not a json web key, json web key set or PEM public key")
+	InvalidJsonWebKeyErr = eris.New("json web key must have a key type")
+)
+
+type jsonWebKey map[string]interface{}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// Envoy only accepts json web key sets, so convert single json web keys and PEM public keys to key sets.
+func translateLocalJwks(key string) (string, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return "", EmptyKeyErr
+	}
+
+	if strings.HasPrefix(key, "{") {
+		var keySet jsonWebKeySet
+		if err := json.Unmarshal([]byte(key), &keySet); err != nil {
+			return "", err
+		}
+		if keySet.Keys == nil {
+			// a single json web key
+			var jwk jsonWebKey
+			if err := json.Unmarshal([]byte(key), &jwk); err != nil {
+				return "", err
+			}
+			if _, ok := jwk["kty"]; !ok {
+				return "", InvalidJsonWebKeyErr
+			}
+			keySet.Keys = []jsonWebKey{jwk}
+		}
+		if len(keySet.Keys) == 0 {
+			return "", NoKeysErr
+		}
+		return marshalKeySet(keySet)
+	}
+
+	jwk, err := pemToJsonWebKey(key)
+	if err != nil {
+		return "", err
+	}
+	return marshalKeySet(jsonWebKeySet{Keys: []jsonWebKey{jwk}})
+}
+
+func pemToJsonWebKey(key string) (jsonWebKey, error) {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, InvalidPemErr
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		// PKCS #1 RSA public keys
+		rsaKey, rsaErr := x509.ParsePKCS1PublicKey(block.Bytes)
+		if rsaErr != nil {
+			return nil, err
+		}
+		publicKey = rsaKey
+	}
+
+	switch publicKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		return jsonWebKey{
+			"kty": "RSA",
+			"n":   base64Url(publicKey.N.Bytes()),
+			"e":   base64Url(big.NewInt(int64(publicKey.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		curve := publicKey.Curve.Params().Name
+		if curve != "P-256" && curve != "P-384" && curve != "P-521" {
+			return nil, UnsupportedCurveErr(curve)
+		}
+		size := (publicKey.Curve.Params().BitSize + 7) / 8
+		return jsonWebKey{
+			"kty": "EC",
+			"crv": curve,
+			"x":   base64Url(padded(publicKey.X.Bytes(), size)),
+			"y":   base64Url(padded(publicKey.Y.Bytes(), size)),
+		}, nil
+	default:
+		return nil, UnsupportedKeyErr
+	}
+}
+
+func marshalKeySet(keySet jsonWebKeySet) (string, error) {
+	out, err := json.Marshal(keySet)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func base64Url(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// coordinates of EC keys must have the size of the curve
+func padded(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b
+	}
+	return append(make([]byte, size-len(b)), b...)
+}
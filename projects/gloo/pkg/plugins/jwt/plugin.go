@@ -0,0 +1,292 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	glooenvoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	envoyjwt "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/jwt_authn/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	jwtapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+const (
+	FilterName = "envoy.filters.http.jwt_authn"
+
+	// the namespace of the dynamic metadata to which the filter writes the verified payloads
+	metadataNamespace = FilterName
+)
+
+// validate JWTs before the other authentication filters, so that they can use the verified claims
+var pluginStage = plugins.BeforeStage(plugins.AuthNStage)
+
+var (
+	DefaultRemoteJwksTimeout = 5 * time.Second
+
+	NoProvidersErr = func(vhost string) error {
+		return eris.Errorf("jwt on virtual host %v must have at least one provider", vhost)
+	}
+	MissingJwksErr = func(provider string) error {
+		return eris.Errorf("jwt provider %v must have jwks", provider)
+	}
+	MissingRemoteJwksUrlErr = func(provider string) error {
+		return eris.Errorf("remote jwks of jwt provider %v must have a url", provider)
+	}
+	MissingRemoteJwksUpstreamErr = func(provider string) error {
+		return eris.Errorf("remote jwks of jwt provider %v must have an upstream", provider)
+	}
+	RemoteJwksUpstreamNotFoundErr = func(provider string, err error) error {
+		return eris.Wrapf(err, "upstream of the remote jwks of jwt provider %v not found", provider)
+	}
+	InvalidLocalJwksErr = func(provider string, err error) error {
+		return eris.Wrapf(err, "invalid local jwks of jwt provider %v", provider)
+	}
+	MissingTokenHeaderErr = func(provider string) error {
+		return eris.Errorf("token source headers of jwt provider %v must have a header name", provider)
+	}
+	InvalidClaimToHeaderErr = func(provider string) error {
+		return eris.Errorf("claims to headers of jwt provider %v must have a claim and a header", provider)
+	}
+	RequireAndDisableErr     = eris.New("jwt on a route cannot both require a provider and disable the checks")
+	RequiredProviderNotFound = func(provider, vhost string) error {
+		return eris.Errorf("jwt provider %v required by the route is not a provider of virtual host %v", provider, vhost)
+	}
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+var _ plugins.VirtualHostPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+
+type Plugin struct {
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+// All virtual hosts of a listener share the jwt filter. Each virtual host adds its providers to the filter,
+// a requirement named after the virtual host which accepts a JWT of any of them, and a requirement named after
+// each provider for the routes which require it.
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	config := &envoyjwt.JwtAuthentication{}
+	for _, vhost := range listener.GetVirtualHosts() {
+		jwt := vhost.GetOptions().GetJwt()
+		if jwt == nil {
+			continue
+		}
+		vhostName := envoyVhostName(params.Ctx, vhost.GetName())
+		if len(jwt.GetProviders()) == 0 {
+			return nil, NoProvidersErr(vhostName)
+		}
+
+		var requirements []*envoyjwt.JwtRequirement
+		for _, name := range sortedProviderNames(jwt) {
+			providerName := vhostProviderName(vhostName, name)
+			provider, err := translateProvider(params, providerName, jwt.GetProviders()[name])
+			if err != nil {
+				return nil, err
+			}
+			if config.Providers == nil {
+				config.Providers = map[string]*envoyjwt.JwtProvider{}
+			}
+			config.Providers[providerName] = provider
+			requirement := &envoyjwt.JwtRequirement{
+				RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: providerName},
+			}
+			if config.RequirementMap == nil {
+				config.RequirementMap = map[string]*envoyjwt.JwtRequirement{}
+			}
+			config.RequirementMap[providerName] = requirement
+			requirements = append(requirements, requirement)
+		}
+
+		config.RequirementMap[requirementName(vhostName)] = requiresAny(requirements)
+	}
+
+	if len(config.GetProviders()) == 0 {
+		return nil, nil
+	}
+
+	filter, err := plugins.NewStagedFilterWithConfig(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	jwt := in.GetOptions().GetJwt()
+	if jwt == nil {
+		return nil
+	}
+
+	vhostName := envoyVhostName(params.Ctx, in.GetName())
+	// the verified payloads are written to the dynamic metadata, from which the router copies the claims to the headers
+	for _, name := range sortedProviderNames(jwt) {
+		providerName := vhostProviderName(vhostName, name)
+		for _, claimToHeader := range jwt.GetProviders()[name].GetClaimsToHeaders() {
+			if claimToHeader.GetClaim() == "" || claimToHeader.GetHeader() == "" {
+				return InvalidClaimToHeaderErr(providerName)
+			}
+			out.RequestHeadersToAdd = append(out.RequestHeadersToAdd, &envoycore.HeaderValueOption{
+				Header: &envoycore.HeaderValue{
+					Key:   claimToHeader.GetHeader(),
+					Value: fmt.Sprintf(`%%DYNAMIC_METADATA(["%s","%s","%s"])%%`, metadataNamespace, providerName, claimToHeader.GetClaim()),
+				},
+				Append: &wrappers.BoolValue{Value: claimToHeader.GetAppend()},
+			})
+		}
+	}
+
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, &envoyjwt.PerRouteConfig{
+		RequirementSpecifier: &envoyjwt.PerRouteConfig_RequirementName{RequirementName: requirementName(vhostName)},
+	})
+}
+
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	jwt := in.GetOptions().GetJwt()
+	vhostJwt := params.VirtualHost.GetOptions().GetJwt()
+	switch {
+	case jwt.GetDisable() && jwt.GetRequire() != "":
+		return RequireAndDisableErr
+	case jwt.GetRequire() != "":
+		// routes can require the JWT of one of the providers of their virtual host
+		vhostName := envoyVhostName(params.Ctx, params.VirtualHost.GetName())
+		if _, ok := vhostJwt.GetProviders()[jwt.GetRequire()]; !ok {
+			return RequiredProviderNotFound(jwt.GetRequire(), vhostName)
+		}
+		return pluginutils.SetRoutePerFilterConfig(out, FilterName, &envoyjwt.PerRouteConfig{
+			RequirementSpecifier: &envoyjwt.PerRouteConfig_RequirementName{
+				RequirementName: vhostProviderName(vhostName, jwt.GetRequire()),
+			},
+		})
+	case jwt.GetDisable() && vhostJwt != nil:
+		return pluginutils.SetRoutePerFilterConfig(out, FilterName, &envoyjwt.PerRouteConfig{
+			RequirementSpecifier: &envoyjwt.PerRouteConfig_Disabled{Disabled: true},
+		})
+	}
+	// the other routes require the JWT of their virtual host
+	return nil
+}
+
+func translateProvider(params plugins.Params, name string, in *jwtapi.Provider) (*envoyjwt.JwtProvider, error) {
+	out := &envoyjwt.JwtProvider{
+		Issuer:            in.GetIssuer(),
+		Audiences:         in.GetAudiences(),
+		Forward:           in.GetKeepToken(),
+		FromParams:        in.GetTokenSource().GetQueryParams(),
+		PayloadInMetadata: name,
+	}
+	for _, header := range in.GetTokenSource().GetHeaders() {
+		if header.GetHeader() == "" {
+			return nil, MissingTokenHeaderErr(name)
+		}
+		out.FromHeaders = append(out.FromHeaders, &envoyjwt.JwtHeader{
+			Name:        header.GetHeader(),
+			ValuePrefix: header.GetPrefix(),
+		})
+	}
+
+	switch jwks := in.GetJwks().GetJwks().(type) {
+	case *jwtapi.Jwks_Remote:
+		remote, err := translateRemoteJwks(params, name, jwks.Remote)
+		if err != nil {
+			return nil, err
+		}
+		out.JwksSourceSpecifier = &envoyjwt.JwtProvider_RemoteJwks{RemoteJwks: remote}
+	case *jwtapi.Jwks_Local:
+		keySet, err := translateLocalJwks(jwks.Local.GetKey())
+		if err != nil {
+			return nil, InvalidLocalJwksErr(name, err)
+		}
+		out.JwksSourceSpecifier = &envoyjwt.JwtProvider_LocalJwks{
+			LocalJwks: &glooenvoycore.DataSource{
+				Specifier: &glooenvoycore.DataSource_InlineString{InlineString: keySet},
+			},
+		}
+	default:
+		return nil, MissingJwksErr(name)
+	}
+
+	return out, nil
+}
+
+func translateRemoteJwks(params plugins.Params, provider string, in *jwtapi.RemoteJwks) (*envoyjwt.RemoteJwks, error) {
+	if in.GetUrl() == "" {
+		return nil, MissingRemoteJwksUrlErr(provider)
+	}
+	upstreamRef := in.GetUpstreamRef()
+	if upstreamRef == nil {
+		return nil, MissingRemoteJwksUpstreamErr(provider)
+	}
+	if _, err := params.Snapshot.Upstreams.Find(upstreamRef.GetNamespace(), upstreamRef.GetName()); err != nil {
+		return nil, RemoteJwksUpstreamNotFoundErr(provider, err)
+	}
+	return &envoyjwt.RemoteJwks{
+		HttpUri: &glooenvoycore.HttpUri{
+			Uri: in.GetUrl(),
+			HttpUpstreamType: &glooenvoycore.HttpUri_Cluster{
+				Cluster: translator.UpstreamToClusterName(*upstreamRef),
+			},
+			Timeout: types.DurationProto(DefaultRemoteJwksTimeout),
+		},
+		CacheDuration: in.GetCacheDuration(),
+	}, nil
+}
+
+func requiresAny(requirements []*envoyjwt.JwtRequirement) *envoyjwt.JwtRequirement {
+	if len(requirements) == 1 {
+		return requirements[0]
+	}
+	return &envoyjwt.JwtRequirement{
+		RequiresType: &envoyjwt.JwtRequirement_RequiresAny{
+			RequiresAny: &envoyjwt.JwtRequirementOrList{Requirements: requirements},
+		},
+	}
+}
+
+// EnvoyProviderName is the name of the provider and of its requirement in the filter, and the key of its verified
+// payloads in the dynamic metadata. The providers of all virtual hosts share the filter, so they are prefixed with the
+// name of their virtual host and its length, which keeps the names unique whatever the names of the virtual hosts
+// and providers. The name of the virtual host is sanitized like the name of the Envoy virtual host.
+func EnvoyProviderName(ctx context.Context, vhost, provider string) string {
+	return vhostProviderName(envoyVhostName(ctx, vhost), provider)
+}
+
+func vhostProviderName(vhost, provider string) string {
+	return fmt.Sprintf("%d_%s_%s", len(vhost), vhost, provider)
+}
+
+func envoyVhostName(ctx context.Context, vhost string) string {
+	return utils.SanitizeForEnvoy(ctx, vhost, "virtual host")
+}
+
+// The name of the requirement of a virtual host, which is unique for the same reason as the names of the providers.
+func requirementName(vhost string) string {
+	return fmt.Sprintf("%d_%s", len(vhost), vhost)
+}
+
+func sortedProviderNames(jwt *jwtapi.VhostExtension) []string {
+	var names []string
+	for name := range jwt.GetProviders() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,299 @@
+package jwt_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"time"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	envoyjwt "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/jwt_authn/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	jwtapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+
+	const jwks = `{"keys":[{"k":"c2VjcmV0","kty":"oct"}]}`
+
+	var (
+		params   plugins.Params
+		upstream *v1.Upstream
+		provider *jwtapi.Provider
+		vhost    *v1.VirtualHost
+	)
+
+	unmarshalAny := func(a *any.Any, out proto.Message) {
+		Expect(a).NotTo(BeNil())
+		Expect(proto.Unmarshal(a.GetValue(), out)).NotTo(HaveOccurred())
+	}
+
+	filterConfig := func(listener *v1.HttpListener) *envoyjwt.JwtAuthentication {
+		filters, err := NewPlugin().HttpFilters(params, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.Name).To(Equal(FilterName))
+		config := &envoyjwt.JwtAuthentication{}
+		unmarshalAny(filters[0].HttpFilter.GetTypedConfig(), config)
+		return config
+	}
+
+	filterError := func() error {
+		_, err := NewPlugin().HttpFilters(params, &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{vhost}})
+		return err
+	}
+
+	BeforeEach(func() {
+		upstream = &v1.Upstream{Metadata: core.Metadata{Name: "jwks", Namespace: "gloo-system"}}
+		params = plugins.Params{
+			Ctx:      context.Background(),
+			Snapshot: &v1.ApiSnapshot{Upstreams: v1.UpstreamList{upstream}},
+		}
+		provider = &jwtapi.Provider{
+			Jwks: &jwtapi.Jwks{
+				Jwks: &jwtapi.Jwks_Local{Local: &jwtapi.LocalJwks{Key: jwks}},
+			},
+			Issuer:    "gloo",
+			Audiences: []string{"api"},
+			TokenSource: &jwtapi.TokenSource{
+				Headers:     []*jwtapi.TokenSource_HeaderSource{{Header: "x-jwt", Prefix: "JWT "}},
+				QueryParams: []string{"token"},
+			},
+			KeepToken: true,
+			ClaimsToHeaders: []*jwtapi.ClaimToHeader{{
+				Claim:  "sub",
+				Header: "x-sub",
+			}},
+		}
+		vhost = &v1.VirtualHost{
+			Name: "vhost",
+			Options: &v1.VirtualHostOptions{
+				Jwt: &jwtapi.VhostExtension{
+					Providers: map[string]*jwtapi.Provider{"provider": provider},
+				},
+			},
+		}
+	})
+
+	It("does not add the filter without providers", func() {
+		filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{{Name: "vhost"}}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("translates providers", func() {
+		config := filterConfig(&v1.HttpListener{VirtualHosts: []*v1.VirtualHost{vhost}})
+		Expect(config.Providers).To(Equal(map[string]*envoyjwt.JwtProvider{
+			"5_vhost_provider": {
+				Issuer:    "gloo",
+				Audiences: []string{"api"},
+				JwksSourceSpecifier: &envoyjwt.JwtProvider_LocalJwks{
+					LocalJwks: &envoycore.DataSource{
+						Specifier: &envoycore.DataSource_InlineString{InlineString: jwks},
+					},
+				},
+				Forward:           true,
+				FromHeaders:       []*envoyjwt.JwtHeader{{Name: "x-jwt", ValuePrefix: "JWT "}},
+				FromParams:        []string{"token"},
+				PayloadInMetadata: "5_vhost_provider",
+			},
+		}))
+		Expect(config.RequirementMap).To(Equal(map[string]*envoyjwt.JwtRequirement{
+			"5_vhost":          {RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: "5_vhost_provider"}},
+			"5_vhost_provider": {RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: "5_vhost_provider"}},
+		}))
+	})
+
+	It("keeps the names of the providers of different virtual hosts apart", func() {
+		// joined with an underscore, the names of these providers would both be vhost_provider_x
+		other := &v1.VirtualHost{
+			Name: "vhost_provider",
+			Options: &v1.VirtualHostOptions{
+				Jwt: &jwtapi.VhostExtension{
+					Providers: map[string]*jwtapi.Provider{"x": provider},
+				},
+			},
+		}
+		vhost.Options.Jwt.Providers = map[string]*jwtapi.Provider{"provider_x": provider}
+		config := filterConfig(&v1.HttpListener{VirtualHosts: []*v1.VirtualHost{vhost, other}})
+		Expect(config.Providers).To(HaveLen(2))
+		Expect(config.Providers).To(HaveKey("5_vhost_provider_x"))
+		Expect(config.Providers).To(HaveKey("14_vhost_provider_x"))
+		Expect(config.RequirementMap).To(HaveLen(4))
+		Expect(config.RequirementMap).To(HaveKey("5_vhost"))
+		Expect(config.RequirementMap).To(HaveKey("14_vhost_provider"))
+	})
+
+	It("accepts the JWT of any provider of a virtual host", func() {
+		vhost.Options.Jwt.Providers["other"] = provider
+		config := filterConfig(&v1.HttpListener{VirtualHosts: []*v1.VirtualHost{vhost}})
+		Expect(config.Providers).To(HaveLen(2))
+		Expect(config.RequirementMap["5_vhost"].GetRequiresAny().GetRequirements()).To(Equal([]*envoyjwt.JwtRequirement{
+			{RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: "5_vhost_other"}},
+			{RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: "5_vhost_provider"}},
+		}))
+	})
+
+	It("translates remote jwks", func() {
+		upstreamRef := upstream.Metadata.Ref()
+		provider.Jwks = &jwtapi.Jwks{
+			Jwks: &jwtapi.Jwks_Remote{Remote: &jwtapi.RemoteJwks{
+				Url:           "http://jwks/keys",
+				UpstreamRef:   &upstreamRef,
+				CacheDuration: types.DurationProto(time.Minute),
+			}},
+		}
+		config := filterConfig(&v1.HttpListener{VirtualHosts: []*v1.VirtualHost{vhost}})
+		Expect(config.Providers["5_vhost_provider"].GetRemoteJwks()).To(Equal(&envoyjwt.RemoteJwks{
+			HttpUri: &envoycore.HttpUri{
+				Uri:              "http://jwks/keys",
+				HttpUpstreamType: &envoycore.HttpUri_Cluster{Cluster: "jwks_gloo-system"},
+				Timeout:          types.DurationProto(DefaultRemoteJwksTimeout),
+			},
+			CacheDuration: types.DurationProto(time.Minute),
+		}))
+	})
+
+	It("requires the upstream of remote jwks to exist", func() {
+		provider.Jwks = &jwtapi.Jwks{
+			Jwks: &jwtapi.Jwks_Remote{Remote: &jwtapi.RemoteJwks{
+				Url:         "http://jwks/keys",
+				UpstreamRef: &core.ResourceRef{Name: "missing", Namespace: "gloo-system"},
+			}},
+		}
+		Expect(filterError()).To(MatchError(ContainSubstring("upstream of the remote jwks of jwt provider 5_vhost_provider not found")))
+	})
+
+	It("requires jwks", func() {
+		provider.Jwks = nil
+		Expect(filterError()).To(MatchError(MissingJwksErr("5_vhost_provider").Error()))
+	})
+
+	It("requires the virtual host to have providers", func() {
+		vhost.Options.Jwt.Providers = nil
+		Expect(filterError()).To(MatchError(NoProvidersErr("vhost").Error()))
+	})
+
+	It("copies claims to headers and sets the requirement of the virtual host", func() {
+		out := &envoyroute.VirtualHost{}
+		err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, vhost, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.RequestHeadersToAdd).To(HaveLen(1))
+		Expect(out.RequestHeadersToAdd[0].Header.Key).To(Equal("x-sub"))
+		Expect(out.RequestHeadersToAdd[0].Header.Value).To(Equal(`%DYNAMIC_METADATA(["envoy.filters.http.jwt_authn","5_vhost_provider","sub"])%`))
+		Expect(out.RequestHeadersToAdd[0].Append).To(Equal(&wrappers.BoolValue{Value: false}))
+
+		perRoute := &envoyjwt.PerRouteConfig{}
+		unmarshalAny(out.TypedPerFilterConfig[FilterName], perRoute)
+		Expect(perRoute.GetRequirementName()).To(Equal("5_vhost"))
+	})
+
+	It("disables the checks on routes", func() {
+		out := &envoyroute.Route{}
+		err := NewPlugin().ProcessRoute(plugins.RouteParams{VirtualHost: vhost}, &v1.Route{
+			Options: &v1.RouteOptions{Jwt: &jwtapi.RouteExtension{Disable: true}},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		perRoute := &envoyjwt.PerRouteConfig{}
+		unmarshalAny(out.TypedPerFilterConfig[FilterName], perRoute)
+		Expect(perRoute.GetDisabled()).To(BeTrue())
+
+		// routes which don't disable the checks use the requirement of their virtual host
+		out = &envoyroute.Route{}
+		err = NewPlugin().ProcessRoute(plugins.RouteParams{VirtualHost: vhost}, &v1.Route{}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.TypedPerFilterConfig).To(BeEmpty())
+	})
+
+	It("requires the JWT of a provider on routes", func() {
+		vhost.Options.Jwt.Providers["other"] = provider
+		out := &envoyroute.Route{}
+		err := NewPlugin().ProcessRoute(plugins.RouteParams{VirtualHost: vhost}, &v1.Route{
+			Options: &v1.RouteOptions{Jwt: &jwtapi.RouteExtension{Require: "other"}},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		perRoute := &envoyjwt.PerRouteConfig{}
+		unmarshalAny(out.TypedPerFilterConfig[FilterName], perRoute)
+		Expect(perRoute.GetRequirementName()).To(Equal("5_vhost_other"))
+
+		config := filterConfig(&v1.HttpListener{VirtualHosts: []*v1.VirtualHost{vhost}})
+		Expect(config.RequirementMap["5_vhost_other"]).To(Equal(&envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: "5_vhost_other"},
+		}))
+	})
+
+	It("requires routes to require a provider of their virtual host", func() {
+		err := NewPlugin().ProcessRoute(plugins.RouteParams{VirtualHost: vhost}, &v1.Route{
+			Options: &v1.RouteOptions{Jwt: &jwtapi.RouteExtension{Require: "missing"}},
+		}, &envoyroute.Route{})
+		Expect(err).To(MatchError(RequiredProviderNotFound("missing", "vhost").Error()))
+
+		err = NewPlugin().ProcessRoute(plugins.RouteParams{VirtualHost: vhost}, &v1.Route{
+			Options: &v1.RouteOptions{Jwt: &jwtapi.RouteExtension{Require: "provider", Disable: true}},
+		}, &envoyroute.Route{})
+		Expect(err).To(MatchError(RequireAndDisableErr))
+	})
+
+	Context("local jwks", func() {
+
+		localKeySet := func(key string) map[string]interface{} {
+			provider.Jwks.GetLocal().Key = key
+			config := filterConfig(&v1.HttpListener{VirtualHosts: []*v1.VirtualHost{vhost}})
+			keySet := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(config.Providers["5_vhost_provider"].GetLocalJwks().GetInlineString()), &keySet)).NotTo(HaveOccurred())
+			return keySet
+		}
+
+		It("wraps single json web keys in a key set", func() {
+			Expect(localKeySet(`{"kty":"oct","k":"c2VjcmV0"}`)).To(Equal(map[string]interface{}{
+				"keys": []interface{}{map[string]interface{}{"kty": "oct", "k": "c2VjcmV0"}},
+			}))
+		})
+
+		It("converts RSA PEM public keys", func() {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+			Expect(err).NotTo(HaveOccurred())
+			keys := localKeySet(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))["keys"].([]interface{})
+			Expect(keys).To(HaveLen(1))
+			Expect(keys[0]).To(HaveKeyWithValue("kty", "RSA"))
+			Expect(keys[0]).To(HaveKeyWithValue("e", "AQAB"))
+			Expect(keys[0]).To(HaveKey("n"))
+		})
+
+		It("converts EC PEM public keys", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+			Expect(err).NotTo(HaveOccurred())
+			keys := localKeySet(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))["keys"].([]interface{})
+			Expect(keys).To(HaveLen(1))
+			Expect(keys[0]).To(HaveKeyWithValue("kty", "EC"))
+			Expect(keys[0]).To(HaveKeyWithValue("crv", "P-256"))
+		})
+
+		It("rejects invalid keys", func() {
+			provider.Jwks.GetLocal().Key = "not a key"
+			Expect(filterError()).To(MatchError(ContainSubstring("invalid local jwks of jwt provider 5_vhost_provider")))
+
+			provider.Jwks.GetLocal().Key = `{"keys":[]}`
+			Expect(filterError()).To(MatchError(ContainSubstring(NoKeysErr.Error())))
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package loadbalancer
 
 import (
+	"time"
+
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	envoytype "github.com/envoyproxy/go-control-plane/envoy/type"
@@ -10,20 +12,42 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 )
 
 var _ plugins.Plugin = new(Plugin)
 var _ plugins.RoutePlugin = new(Plugin)
 
+// The name of the cookie of sticky sessions that do not specify one.
+const DefaultStickySessionCookieName = "gloo-session"
+
 type Plugin struct{}
 
 var (
 	InvalidRouteTypeError = func(e error) error {
 		return eris.Wrapf(e, "cannot use lbhash plugin on non-Route_Route route actions")
 	}
+	StickySessionLoadBalancerError = func(lbType string) error {
+		return eris.Errorf("sticky sessions require a ring hash or maglev load balancer, but the upstream uses %v", lbType)
+	}
 )
 
+// Hashing is misconfigured when the route has a hash policy but its upstreams do not use a hashing load balancer, in
+// which case Envoy silently ignores the hash policy. This is reported as a warning on the route.
+type HashingMisconfiguredError struct {
+	reason string
+}
+
+func (e *HashingMisconfiguredError) Error() string {
+	return e.reason
+}
+
+func IsHashingMisconfiguredError(err error) bool {
+	_, ok := err.(*HashingMisconfiguredError)
+	return ok
+}
+
 func NewPlugin() *Plugin {
 	return &Plugin{}
 }
@@ -33,18 +57,66 @@ func (p *Plugin) Init(params plugins.InitParams) error {
 }
 
 func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
-	lbPlugin := in.Options.GetLbHash()
-	if lbPlugin == nil {
+	lbPlugin := in.GetOptions().GetLbHash()
+	upstreams := pluginutils.DestinationUpstreamsInSnapshot(params.Snapshot, in.GetRouteAction())
+
+	var hashPolicies []*envoyroute.RouteAction_HashPolicy
+	if lbPlugin != nil {
+		hashPolicies = getHashPoliciesFromSpec(lbPlugin.HashPolicies)
+	} else {
+		// routes without a hash policy use the sticky session of their upstreams
+		for _, upstream := range upstreams {
+			if stickySession := upstream.GetLoadBalancerConfig().GetStickySession(); stickySession != nil {
+				hashPolicies = []*envoyroute.RouteAction_HashPolicy{stickySessionHashPolicy(stickySession)}
+				break
+			}
+		}
+	}
+	if len(hashPolicies) > 0 {
+		if err := utils.EnsureRouteAction(out); err != nil {
+			return InvalidRouteTypeError(err)
+		}
+		out.GetRoute().HashPolicy = hashPolicies
+	}
+
+	if len(hashPolicies) == 0 {
 		return nil
 	}
-	if err := utils.EnsureRouteAction(out); err != nil {
-		return InvalidRouteTypeError(err)
+	return checkHashing(upstreams)
+}
+
+// Returns an error if one of the upstreams of a route with a hash policy does not use a hashing load balancer.
+func checkHashing(upstreams []*v1.Upstream) error {
+	for _, upstream := range upstreams {
+		if !usesHashingLoadBalancer(upstream) {
+			return &HashingMisconfiguredError{reason: "the route has a hash policy, but upstream " + upstream.GetMetadata().Ref().Key() +
+				" does not use a hashing load balancer, so the hash policy is ignored: set a sticky session, or a ring hash or maglev load balancer, on the upstream"}
+		}
 	}
-	outRa := out.GetRoute()
-	outRa.HashPolicy = getHashPoliciesFromSpec(lbPlugin.HashPolicies)
 	return nil
 }
 
+func stickySessionHashPolicy(stickySession *lbhash.StickySession) *envoyroute.RouteAction_HashPolicy {
+	name := stickySession.GetCookieName()
+	if name == "" {
+		name = DefaultStickySessionCookieName
+	}
+	// Envoy only generates the cookie if the TTL is set, and generates a session cookie if it is zero
+	var ttl time.Duration
+	if stickySession.GetTtl() != nil {
+		ttl = *stickySession.GetTtl()
+	}
+	return &envoyroute.RouteAction_HashPolicy{
+		PolicySpecifier: &envoyroute.RouteAction_HashPolicy_Cookie_{
+			Cookie: &envoyroute.RouteAction_HashPolicy_Cookie{
+				Name: name,
+				Ttl:  gogoutils.DurationStdToProto(&ttl),
+				Path: stickySession.GetPath(),
+			},
+		},
+	}
+}
+
 func getHashPoliciesFromSpec(spec []*lbhash.HashPolicy) []*envoyroute.RouteAction_HashPolicy {
 	var policies []*envoyroute.RouteAction_HashPolicy
 	for _, s := range spec {
@@ -81,6 +153,14 @@ func getHashPoliciesFromSpec(spec []*lbhash.HashPolicy) []*envoyroute.RouteActio
 func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
 
 	cfg := in.GetLoadBalancerConfig()
+	if cfg.GetType() == nil {
+		// upstreams without a load balancer type use the load balancer of their sticky session
+		if stickySession := cfg.GetStickySession(); stickySession != nil {
+			setStickySessionLoadBalancer(out, stickySession)
+		}
+	} else if cfg.GetStickySession() != nil && !isHashingLoadBalancer(cfg) {
+		return StickySessionLoadBalancerError(loadBalancerTypeName(cfg))
+	}
 	if cfg == nil {
 		return nil
 	}
@@ -143,3 +223,46 @@ func setRingHashLbConfig(out *envoyapi.Cluster, userConfig *v1.LoadBalancerConfi
 	}
 	out.LbConfig = cfg
 }
+
+func setStickySessionLoadBalancer(out *envoyapi.Cluster, stickySession *lbhash.StickySession) {
+	switch stickySession.GetLoadBalancer() {
+	case lbhash.StickySession_MAGLEV:
+		out.LbPolicy = envoyapi.Cluster_MAGLEV
+	default:
+		out.LbPolicy = envoyapi.Cluster_RING_HASH
+		setRingHashLbConfig(out, nil)
+	}
+}
+
+func isHashingLoadBalancer(cfg *v1.LoadBalancerConfig) bool {
+	switch cfg.GetType().(type) {
+	case *v1.LoadBalancerConfig_RingHash_, *v1.LoadBalancerConfig_Maglev_:
+		return true
+	}
+	return false
+}
+
+func loadBalancerTypeName(cfg *v1.LoadBalancerConfig) string {
+	switch cfg.GetType().(type) {
+	case *v1.LoadBalancerConfig_RoundRobin_:
+		return "round robin"
+	case *v1.LoadBalancerConfig_LeastRequest_:
+		return "least request"
+	case *v1.LoadBalancerConfig_Random_:
+		return "random"
+	case *v1.LoadBalancerConfig_RingHash_:
+		return "ring hash"
+	case *v1.LoadBalancerConfig_Maglev_:
+		return "maglev"
+	}
+	return "the default load balancer"
+}
+
+// An upstream uses a hashing load balancer if its type is ring hash or maglev, or if it has no type and a sticky session.
+func usesHashingLoadBalancer(upstream *v1.Upstream) bool {
+	cfg := upstream.GetLoadBalancerConfig()
+	if cfg.GetType() != nil {
+		return isHashingLoadBalancer(cfg)
+	}
+	return cfg.GetStickySession() != nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -363,4 +364,146 @@ status: {}
 			Expect(outRoute.GetRoute().HashPolicy).To(BeNil())
 		})
 	})
+
+	Context("sticky sessions", func() {
+		var (
+			snap        *v1.ApiSnapshot
+			routeParams plugins.RouteParams
+			route       *v1.Route
+			outRoute    *envoyroute.Route
+		)
+
+		sessionCookie := &envoyroute.RouteAction_HashPolicy{
+			PolicySpecifier: &envoyroute.RouteAction_HashPolicy_Cookie_{
+				Cookie: &envoyroute.RouteAction_HashPolicy_Cookie{
+					Name: DefaultStickySessionCookieName,
+					Ttl:  gogoutils.DurationStdToProto(new(time.Duration)),
+				},
+			},
+		}
+
+		BeforeEach(func() {
+			upstream.Metadata = core.Metadata{Name: "us", Namespace: "ns"}
+			ref := upstream.Metadata.Ref()
+			route = &v1.Route{
+				Action: &v1.Route_RouteAction{
+					RouteAction: &v1.RouteAction{
+						Destination: &v1.RouteAction_Single{
+							Single: &v1.Destination{
+								DestinationType: &v1.Destination_Upstream{Upstream: &ref},
+							},
+						},
+					},
+				},
+			}
+			snap = &v1.ApiSnapshot{
+				Upstreams: v1.UpstreamList{upstream},
+			}
+			params.Snapshot = snap
+			routeParams = plugins.RouteParams{}
+			routeParams.Snapshot = snap
+			outRoute = &envoyroute.Route{Action: &envoyroute.Route_Route{Route: &envoyroute.RouteAction{}}}
+		})
+
+		It("configures the upstream and its routes from the sticky session of the upstream", func() {
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				StickySession: &lbhash.StickySession{},
+			}
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.LbPolicy).To(Equal(envoyapi.Cluster_RING_HASH))
+
+			err = plugin.ProcessRoute(routeParams, route, outRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outRoute.GetRoute().HashPolicy).To(Equal([]*envoyroute.RouteAction_HashPolicy{sessionCookie}))
+		})
+
+		It("configures the cookie of the routes from the sticky session of the upstream", func() {
+			ttl := time.Hour
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				StickySession: &lbhash.StickySession{
+					CookieName:   "session",
+					Ttl:          &ttl,
+					Path:         "/",
+					LoadBalancer: lbhash.StickySession_MAGLEV,
+				},
+			}
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.LbPolicy).To(Equal(envoyapi.Cluster_MAGLEV))
+
+			err = plugin.ProcessRoute(routeParams, route, outRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outRoute.GetRoute().HashPolicy).To(Equal([]*envoyroute.RouteAction_HashPolicy{{
+				PolicySpecifier: &envoyroute.RouteAction_HashPolicy_Cookie_{
+					Cookie: &envoyroute.RouteAction_HashPolicy_Cookie{
+						Name: "session",
+						Ttl:  gogoutils.DurationStdToProto(&ttl),
+						Path: "/",
+					},
+				},
+			}}))
+		})
+
+		It("keeps the hash policy of the routes to an upstream with a sticky session", func() {
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				StickySession: &lbhash.StickySession{},
+			}
+			route.Options = &v1.RouteOptions{
+				LbHash: &lbhash.RouteActionHashConfig{
+					HashPolicies: []*lbhash.HashPolicy{{KeyType: &lbhash.HashPolicy_SourceIp{SourceIp: true}}},
+				},
+			}
+
+			err := plugin.ProcessRoute(routeParams, route, outRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outRoute.GetRoute().HashPolicy).To(HaveLen(1))
+			Expect(outRoute.GetRoute().HashPolicy[0].GetConnectionProperties().GetSourceIp()).To(BeTrue())
+		})
+
+		It("keeps the hashing load balancer type of the upstream", func() {
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				Type:          &v1.LoadBalancerConfig_Maglev_{Maglev: &v1.LoadBalancerConfig_Maglev{}},
+				StickySession: &lbhash.StickySession{},
+			}
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.LbPolicy).To(Equal(envoyapi.Cluster_MAGLEV))
+		})
+
+		It("errors on upstreams with a sticky session and a non-hashing load balancer", func() {
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				Type:          &v1.LoadBalancerConfig_RoundRobin_{RoundRobin: &v1.LoadBalancerConfig_RoundRobin{}},
+				StickySession: &lbhash.StickySession{},
+			}
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(StickySessionLoadBalancerError("round robin").Error()))
+		})
+
+		It("warns about routes with a hash policy to upstreams without a hashing load balancer", func() {
+			route.Options = &v1.RouteOptions{
+				LbHash: &lbhash.RouteActionHashConfig{
+					HashPolicies: []*lbhash.HashPolicy{{KeyType: &lbhash.HashPolicy_SourceIp{SourceIp: true}}},
+				},
+			}
+
+			err := plugin.ProcessRoute(routeParams, route, outRoute)
+			Expect(IsHashingMisconfiguredError(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("does not use a hashing load balancer"))
+		})
+
+		It("accepts routes without a hash policy to upstreams with a hashing load balancer", func() {
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				Type: &v1.LoadBalancerConfig_RingHash_{RingHash: &v1.LoadBalancerConfig_RingHash{}},
+			}
+
+			err := plugin.ProcessRoute(routeParams, route, outRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outRoute.GetRoute().HashPolicy).To(BeEmpty())
+		})
+	})
 })
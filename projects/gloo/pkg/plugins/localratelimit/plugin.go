@@ -0,0 +1,195 @@
+package localratelimit
+
+import (
+	"time"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/types"
+	"github.com/rotisserie/eris"
+
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	envoylocalratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoytype "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+const (
+	FilterName = "envoy.filters.http.local_ratelimit"
+	StatPrefix = "http_local_rate_limiter"
+
+	enabledRuntimeKey  = "local_rate_limit_enabled"
+	enforcedRuntimeKey = "local_rate_limit_enforced"
+
+	// envoy does not refill token buckets more often than this
+	minFillInterval = 50 * time.Millisecond
+	// envoy does not add more headers than this to rate limited responses
+	maxResponseHeaders = 10
+)
+
+// local rate limiting is cheaper than the external rate limit server, so do it first
+var pluginStage = plugins.BeforeStage(plugins.RateLimitStage)
+
+var (
+	MissingTokenBucketErr = eris.New("local rate limit must have a token bucket")
+	NoMaxTokensErr        = eris.New("local rate limit token bucket must have max tokens greater than 0")
+	NoTokensPerFillErr    = eris.New("local rate limit token bucket must have tokens per fill greater than 0")
+	FillIntervalErr       = func(interval time.Duration) error {
+		return eris.Errorf("local rate limit token bucket fill interval must be at least %v, got %v", minFillInterval, interval)
+	}
+	InvalidStatusCodeErr = func(code uint32) error {
+		return eris.Errorf("local rate limit status code %v is not a valid HTTP error status code", code)
+	}
+	TooManyResponseHeadersErr = eris.Errorf("local rate limit can add at most %v response headers", maxResponseHeaders)
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+var _ plugins.VirtualHostPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+
+type Plugin struct {
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if limit := listener.GetOptions().GetLocalRatelimit(); limit != nil {
+		config, err := TranslateLocalRateLimit(limit)
+		if err != nil {
+			return nil, err
+		}
+		filter, err := plugins.NewStagedFilterWithConfig(FilterName, config, pluginStage)
+		if err != nil {
+			return nil, eris.Wrapf(err, "generating filter config")
+		}
+		return []plugins.StagedHttpFilter{filter}, nil
+	}
+
+	if !usedOnVirtualHosts(listener) {
+		return nil, nil
+	}
+
+	// the filter does not limit anything by itself without a token bucket,
+	// it only applies the limits on the virtual hosts and routes.
+	filter, err := plugins.NewStagedFilterWithConfig(FilterName, &envoylocalratelimit.LocalRateLimit{StatPrefix: StatPrefix}, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	limit := in.GetOptions().GetLocalRatelimit()
+	if limit == nil {
+		return nil
+	}
+	config, err := TranslateLocalRateLimit(limit)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, config)
+}
+
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	limit := in.GetOptions().GetLocalRatelimit()
+	if limit == nil {
+		return nil
+	}
+	config, err := TranslateLocalRateLimit(limit)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, config)
+}
+
+func usedOnVirtualHosts(listener *v1.HttpListener) bool {
+	for _, vhost := range listener.GetVirtualHosts() {
+		if vhost.GetOptions().GetLocalRatelimit() != nil {
+			return true
+		}
+		for _, route := range vhost.GetRoutes() {
+			if route.GetOptions().GetLocalRatelimit() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TranslateLocalRateLimit validates the local rate limit and converts it to the config of the envoy filter.
+// The limit is enabled and enforced for all requests.
+func TranslateLocalRateLimit(in *local_ratelimit.LocalRateLimit) (*envoylocalratelimit.LocalRateLimit, error) {
+	tokenBucket, err := translateTokenBucket(in.GetTokenBucket())
+	if err != nil {
+		return nil, err
+	}
+
+	out := &envoylocalratelimit.LocalRateLimit{
+		StatPrefix:     StatPrefix,
+		TokenBucket:    tokenBucket,
+		FilterEnabled:  allRequests(enabledRuntimeKey),
+		FilterEnforced: allRequests(enforcedRuntimeKey),
+	}
+
+	if code := in.GetStatusCode(); code != 0 {
+		if _, ok := envoytype.StatusCode_name[int32(code)]; !ok || code < 400 {
+			return nil, InvalidStatusCodeErr(code)
+		}
+		out.Status = &envoytype.HttpStatus{Code: envoytype.StatusCode(code)}
+	}
+
+	if len(in.GetResponseHeadersToAdd()) > maxResponseHeaders {
+		return nil, TooManyResponseHeadersErr
+	}
+	for _, header := range in.GetResponseHeadersToAdd() {
+		out.ResponseHeadersToAdd = append(out.ResponseHeadersToAdd, &envoycore.HeaderValueOption{
+			Header: &envoycore.HeaderValue{
+				Key:   header.GetHeader().GetKey(),
+				Value: header.GetHeader().GetValue(),
+			},
+			Append: header.GetAppend(),
+		})
+	}
+
+	return out, nil
+}
+
+func translateTokenBucket(in *local_ratelimit.TokenBucket) (*envoytype.TokenBucket, error) {
+	if in == nil {
+		return nil, MissingTokenBucketErr
+	}
+	if in.GetMaxTokens() == 0 {
+		return nil, NoMaxTokensErr
+	}
+	if in.GetTokensPerFill() != nil && in.GetTokensPerFill().GetValue() == 0 {
+		return nil, NoTokensPerFillErr
+	}
+	fillInterval, err := types.DurationFromProto(in.GetFillInterval())
+	if err != nil || fillInterval < minFillInterval {
+		return nil, FillIntervalErr(fillInterval)
+	}
+	return &envoytype.TokenBucket{
+		MaxTokens:     in.GetMaxTokens(),
+		TokensPerFill: in.GetTokensPerFill(),
+		FillInterval:  in.GetFillInterval(),
+	}, nil
+}
+
+func allRequests(runtimeKey string) *envoycore.RuntimeFractionalPercent {
+	return &envoycore.RuntimeFractionalPercent{
+		DefaultValue: &envoytype.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoytype.FractionalPercent_HUNDRED,
+		},
+		RuntimeKey: runtimeKey,
+	}
+}
@@ -0,0 +1,174 @@
+package localratelimit_test
+
+import (
+	"time"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	envoylocalratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoytype "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/localratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		limit    *local_ratelimit.LocalRateLimit
+		expected *envoylocalratelimit.LocalRateLimit
+	)
+
+	allRequests := func(runtimeKey string) *envoycore.RuntimeFractionalPercent {
+		return &envoycore.RuntimeFractionalPercent{
+			DefaultValue: &envoytype.FractionalPercent{Numerator: 100, Denominator: envoytype.FractionalPercent_HUNDRED},
+			RuntimeKey:   runtimeKey,
+		}
+	}
+
+	BeforeEach(func() {
+		limit = &local_ratelimit.LocalRateLimit{
+			TokenBucket: &local_ratelimit.TokenBucket{
+				MaxTokens:     10,
+				TokensPerFill: &types.UInt32Value{Value: 2},
+				FillInterval:  types.DurationProto(time.Second),
+			},
+			StatusCode: 503,
+			ResponseHeadersToAdd: []*headers.HeaderValueOption{{
+				Header: &headers.HeaderValue{Key: "x-rate-limited", Value: "true"},
+			}},
+		}
+		expected = &envoylocalratelimit.LocalRateLimit{
+			StatPrefix: StatPrefix,
+			Status:     &envoytype.HttpStatus{Code: envoytype.StatusCode_ServiceUnavailable},
+			TokenBucket: &envoytype.TokenBucket{
+				MaxTokens:     10,
+				TokensPerFill: &types.UInt32Value{Value: 2},
+				FillInterval:  types.DurationProto(time.Second),
+			},
+			FilterEnabled:  allRequests("local_rate_limit_enabled"),
+			FilterEnforced: allRequests("local_rate_limit_enforced"),
+			ResponseHeadersToAdd: []*envoycore.HeaderValueOption{{
+				Header: &envoycore.HeaderValue{Key: "x-rate-limited", Value: "true"},
+			}},
+		}
+	})
+
+	It("copies the listener limit to the filter", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{LocalRatelimit: limit},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(Equal([]plugins.StagedHttpFilter{{
+			HttpFilter: &envoyhcm.HttpFilter{
+				Name: FilterName,
+				ConfigType: &envoyhcm.HttpFilter_TypedConfig{
+					TypedConfig: utils.MustMessageToAny(expected),
+				},
+			},
+			Stage: plugins.BeforeStage(plugins.RateLimitStage),
+		}}))
+	})
+
+	It("does not add the filter if no limit is set", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			VirtualHosts: []*v1.VirtualHost{{Routes: []*v1.Route{{}}}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("adds the filter without a token bucket if only a route has a limit", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			VirtualHosts: []*v1.VirtualHost{{Routes: []*v1.Route{{
+				Options: &v1.RouteOptions{LocalRatelimit: limit},
+			}}}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.GetTypedConfig()).To(Equal(utils.MustMessageToAny(&envoylocalratelimit.LocalRateLimit{
+			StatPrefix: StatPrefix,
+		})))
+	})
+
+	It("sets the limit on virtual hosts", func() {
+		out := &envoyroute.VirtualHost{}
+		err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{LocalRatelimit: limit},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()).To(HaveKeyWithValue(FilterName, utils.MustMessageToAny(expected)))
+	})
+
+	It("sets the limit on routes", func() {
+		out := &envoyroute.Route{}
+		err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{LocalRatelimit: limit},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()).To(HaveKeyWithValue(FilterName, utils.MustMessageToAny(expected)))
+	})
+
+	Context("validation", func() {
+
+		It("defaults the status code", func() {
+			limit.StatusCode = 0
+			out, err := TranslateLocalRateLimit(limit)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetStatus()).To(BeNil())
+		})
+
+		It("requires a token bucket", func() {
+			limit.TokenBucket = nil
+			_, err := TranslateLocalRateLimit(limit)
+			Expect(err).To(MatchError(MissingTokenBucketErr))
+		})
+
+		It("requires max tokens", func() {
+			limit.TokenBucket.MaxTokens = 0
+			_, err := TranslateLocalRateLimit(limit)
+			Expect(err).To(MatchError(NoMaxTokensErr))
+		})
+
+		It("rejects empty fills", func() {
+			limit.TokenBucket.TokensPerFill = &types.UInt32Value{}
+			_, err := TranslateLocalRateLimit(limit)
+			Expect(err).To(MatchError(NoTokensPerFillErr))
+		})
+
+		It("rejects short fill intervals", func() {
+			limit.TokenBucket.FillInterval = types.DurationProto(10 * time.Millisecond)
+			_, err := TranslateLocalRateLimit(limit)
+			Expect(err).To(MatchError(FillIntervalErr(10 * time.Millisecond)))
+
+			limit.TokenBucket.FillInterval = nil
+			_, err = TranslateLocalRateLimit(limit)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rejects status codes which are not errors", func() {
+			limit.StatusCode = 200
+			_, err := TranslateLocalRateLimit(limit)
+			Expect(err).To(MatchError(InvalidStatusCodeErr(200)))
+
+			limit.StatusCode = 499
+			_, err = TranslateLocalRateLimit(limit)
+			Expect(err).To(MatchError(InvalidStatusCodeErr(499)))
+		})
+
+		It("limits the number of response headers", func() {
+			for i := 0; i < 10; i++ {
+				limit.ResponseHeadersToAdd = append(limit.ResponseHeadersToAdd, limit.ResponseHeadersToAdd[0])
+			}
+			_, err := TranslateLocalRateLimit(limit)
+			Expect(err).To(MatchError(TooManyResponseHeadersErr))
+		})
+	})
+})
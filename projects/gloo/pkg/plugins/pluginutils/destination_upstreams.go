@@ -32,6 +32,30 @@ func DestinationUpstreams(snap *v1.ApiSnapshot, in *v1.RouteAction) ([]core.Reso
 	panic("invalid route")
 }
 
+// Returns the upstreams of the destinations of the route action that are in the snapshot. Cluster header destinations
+// have no known upstreams, and missing destinations are reported by the translator, so both are skipped.
+func DestinationUpstreamsInSnapshot(snap *v1.ApiSnapshot, in *v1.RouteAction) []*v1.Upstream {
+	if snap == nil {
+		return nil
+	}
+	switch in.GetDestination().(type) {
+	case *v1.RouteAction_Single, *v1.RouteAction_Multi, *v1.RouteAction_UpstreamGroup:
+	default:
+		return nil
+	}
+	refs, err := DestinationUpstreams(snap, in)
+	if err != nil {
+		return nil
+	}
+	var upstreams []*v1.Upstream
+	for _, ref := range refs {
+		if upstream, err := snap.Upstreams.Find(ref.Namespace, ref.Name); err == nil {
+			upstreams = append(upstreams, upstream)
+		}
+	}
+	return upstreams
+}
+
 func destinationsToRefs(destinations []*v1.WeightedDestination) ([]core.ResourceRef, error) {
 	var upstreams []core.ResourceRef
 	for _, dest := range destinations {
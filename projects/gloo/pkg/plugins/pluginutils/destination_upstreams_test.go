@@ -0,0 +1,62 @@
+package pluginutils_test
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+var _ = Describe("DestinationUpstreamsInSnapshot", func() {
+	var (
+		first, second *v1.Upstream
+		snap          *v1.ApiSnapshot
+	)
+
+	destination := func(upstream core.ResourceRef) *v1.Destination {
+		return &v1.Destination{DestinationType: &v1.Destination_Upstream{Upstream: &upstream}}
+	}
+
+	BeforeEach(func() {
+		first = &v1.Upstream{Metadata: core.Metadata{Name: "first", Namespace: "ns"}}
+		second = &v1.Upstream{Metadata: core.Metadata{Name: "second", Namespace: "ns"}}
+		snap = &v1.ApiSnapshot{
+			Upstreams: v1.UpstreamList{first, second},
+			UpstreamGroups: v1.UpstreamGroupList{{
+				Metadata: core.Metadata{Name: "group", Namespace: "ns"},
+				Destinations: []*v1.WeightedDestination{
+					{Destination: destination(first.Metadata.Ref())},
+					{Destination: destination(second.Metadata.Ref())},
+				},
+			}},
+		}
+	})
+
+	It("returns the upstreams of the destinations", func() {
+		single := &v1.RouteAction{Destination: &v1.RouteAction_Single{Single: destination(first.Metadata.Ref())}}
+		Expect(DestinationUpstreamsInSnapshot(snap, single)).To(Equal([]*v1.Upstream{first}))
+
+		group := &v1.RouteAction{Destination: &v1.RouteAction_UpstreamGroup{UpstreamGroup: &core.ResourceRef{Name: "group", Namespace: "ns"}}}
+		Expect(DestinationUpstreamsInSnapshot(snap, group)).To(Equal([]*v1.Upstream{first, second}))
+	})
+
+	It("skips the missing destinations and the cluster header destinations", func() {
+		multi := &v1.RouteAction{Destination: &v1.RouteAction_Multi{Multi: &v1.MultiDestination{
+			Destinations: []*v1.WeightedDestination{
+				{Destination: destination(core.ResourceRef{Name: "missing", Namespace: "ns"})},
+				{Destination: destination(second.Metadata.Ref())},
+			},
+		}}}
+		Expect(DestinationUpstreamsInSnapshot(snap, multi)).To(Equal([]*v1.Upstream{second}))
+
+		missingGroup := &v1.RouteAction{Destination: &v1.RouteAction_UpstreamGroup{UpstreamGroup: &core.ResourceRef{Name: "missing", Namespace: "ns"}}}
+		Expect(DestinationUpstreamsInSnapshot(snap, missingGroup)).To(BeEmpty())
+
+		clusterHeader := &v1.RouteAction{Destination: &v1.RouteAction_ClusterHeader{ClusterHeader: "x-cluster"}}
+		Expect(DestinationUpstreamsInSnapshot(snap, clusterHeader)).To(BeEmpty())
+		Expect(DestinationUpstreamsInSnapshot(nil, clusterHeader)).To(BeEmpty())
+	})
+})
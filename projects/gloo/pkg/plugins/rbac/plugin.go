@@ -0,0 +1,471 @@
+package rbac
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhttprbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoynetworkrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	rbacapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+const (
+	FilterName        = wellknown.RoleBasedAccessControl
+	NetworkFilterName = "envoy.filters.network.rbac"
+
+	NetworkStatPrefix = "rbac"
+)
+
+var (
+	InvalidSourceIpErr = func(policy, ip string) error {
+		return eris.Errorf("rbac policy %v has an invalid source ip %v", policy, ip)
+	}
+	JwtNotEnabledErr = func(policy string) error {
+		return eris.Errorf("rbac policy %v has a jwt principal, but jwt is not enabled on the virtual host", policy)
+	}
+	JwtProviderNotFoundErr = func(policy, provider string) error {
+		return eris.Errorf("rbac policy %v references jwt provider %v, which is not defined on the virtual host", policy, provider)
+	}
+	EmptyJwtPrincipalErr = func(policy string) error {
+		return eris.Errorf("jwt principal of rbac policy %v must have at least one claim", policy)
+	}
+	UnsupportedNetworkRbacErr = func(policy, field string) error {
+		return eris.Errorf("rbac policy %v of a tcp listener cannot use %v, which is only available for http", policy, field)
+	}
+)
+
+// validate the identity of requests before authorizing them
+var pluginStage = plugins.DuringStage(plugins.AuthZStage)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+var _ plugins.VirtualHostPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+var _ plugins.ListenerPlugin = new(Plugin)
+
+type Plugin struct {
+	settings *rbacapi.Settings
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	p.settings = params.Settings.GetRbac()
+	return nil
+}
+
+// The filter itself does not enforce any policy, unless RBAC is required for all virtual hosts.
+// Virtual hosts and routes override it with their own policies.
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	config := &envoyhttprbac.RBAC{}
+	if p.settings.GetRequireRbac() {
+		// an allow list without policies denies all requests
+		config.Rules = &envoyrbac.RBAC{Action: envoyrbac.RBAC_ALLOW}
+	} else if !usesRbac(listener) {
+		return nil, nil
+	}
+
+	filter, err := plugins.NewStagedFilterWithConfig(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	settings := in.GetOptions().GetRbac()
+	if settings == nil {
+		return nil
+	}
+	perRoute, err := translatePerRoute(params.Ctx, in, settings)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, perRoute)
+}
+
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	settings := in.GetOptions().GetRbac()
+	if settings == nil {
+		return nil
+	}
+	perRoute, err := translatePerRoute(params.Ctx, params.VirtualHost, settings)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRoute)
+}
+
+// Tcp listeners enforce their policies with the network RBAC filter, which runs before the other filters of each filter chain.
+func (p *Plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *envoyapi.Listener) error {
+	settings := in.GetTcpListener().GetOptions().GetRbac()
+	if settings == nil || settings.GetDisable() {
+		return nil
+	}
+	rules, err := translateRules(params.Ctx, nil, settings)
+	if err != nil {
+		return err
+	}
+	filter, err := translator.NewFilterWithTypedConfig(NetworkFilterName, &envoynetworkrbac.RBAC{
+		Rules:      rules,
+		StatPrefix: NetworkStatPrefix,
+	})
+	if err != nil {
+		return err
+	}
+	for _, filterChain := range out.GetFilterChains() {
+		filterChain.Filters = append([]*envoylistener.Filter{filter}, filterChain.GetFilters()...)
+	}
+	return nil
+}
+
+func usesRbac(listener *v1.HttpListener) bool {
+	for _, vhost := range listener.GetVirtualHosts() {
+		if vhost.GetOptions().GetRbac() != nil {
+			return true
+		}
+		for _, route := range vhost.GetRoutes() {
+			if route.GetOptions().GetRbac() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// an empty per route config disables the filter
+func translatePerRoute(ctx context.Context, vhost *v1.VirtualHost, settings *rbacapi.ExtensionSettings) (*envoyhttprbac.RBACPerRoute, error) {
+	if settings.GetDisable() {
+		return &envoyhttprbac.RBACPerRoute{}, nil
+	}
+	rules, err := translateRules(ctx, vhost, settings)
+	if err != nil {
+		return nil, err
+	}
+	return &envoyhttprbac.RBACPerRoute{
+		Rbac: &envoyhttprbac.RBAC{Rules: rules},
+	}, nil
+}
+
+// The policies are an allow list, so a request is denied unless it matches one of the policies.
+// The virtual host is nil for network RBAC.
+func translateRules(ctx context.Context, vhost *v1.VirtualHost, settings *rbacapi.ExtensionSettings) (*envoyrbac.RBAC, error) {
+	rules := &envoyrbac.RBAC{Action: envoyrbac.RBAC_ALLOW}
+	for _, name := range sortedPolicyNames(settings) {
+		policy, err := translatePolicy(ctx, vhost, name, settings.GetPolicies()[name])
+		if err != nil {
+			return nil, err
+		}
+		if rules.Policies == nil {
+			rules.Policies = map[string]*envoyrbac.Policy{}
+		}
+		rules.Policies[name] = policy
+	}
+	return rules, nil
+}
+
+func translatePolicy(ctx context.Context, vhost *v1.VirtualHost, name string, in *rbacapi.Policy) (*envoyrbac.Policy, error) {
+	out := &envoyrbac.Policy{}
+	for _, principal := range in.GetPrincipals() {
+		converted, err := translatePrincipal(ctx, vhost, name, principal)
+		if err != nil {
+			return nil, err
+		}
+		out.Principals = append(out.Principals, converted)
+	}
+	if len(out.Principals) == 0 {
+		// envoy requires at least one principal, and policies without principals apply to everyone
+		out.Principals = []*envoyrbac.Principal{anyPrincipal()}
+	}
+
+	permission, err := translatePermissions(ctx, vhost, name, in.GetPermissions())
+	if err != nil {
+		return nil, err
+	}
+	out.Permissions = []*envoyrbac.Permission{permission}
+	return out, nil
+}
+
+func translatePrincipal(ctx context.Context, vhost *v1.VirtualHost, policy string, in *rbacapi.Principal) (*envoyrbac.Principal, error) {
+	var ids []*envoyrbac.Principal
+
+	if in.GetJwtPrincipal() != nil {
+		if vhost == nil {
+			return nil, UnsupportedNetworkRbacErr(policy, "jwt principals")
+		}
+		jwtId, err := translateJwtPrincipal(ctx, vhost, policy, in.GetJwtPrincipal())
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, jwtId)
+	}
+
+	var sourceIps []*envoyrbac.Principal
+	for _, ip := range in.GetSourceIps() {
+		cidr, err := cidrRange(ip)
+		if err != nil {
+			return nil, InvalidSourceIpErr(policy, ip)
+		}
+		sourceIps = append(sourceIps, &envoyrbac.Principal{
+			Identifier: &envoyrbac.Principal_DirectRemoteIp{DirectRemoteIp: cidr},
+		})
+	}
+	if len(sourceIps) > 0 {
+		ids = append(ids, orIds(sourceIps))
+	}
+
+	if len(in.GetHeaders()) > 0 && vhost == nil {
+		return nil, UnsupportedNetworkRbacErr(policy, "headers")
+	}
+	for _, header := range envoyHeaderMatchers(ctx, in.GetHeaders()) {
+		ids = append(ids, &envoyrbac.Principal{
+			Identifier: &envoyrbac.Principal_Header{Header: header},
+		})
+	}
+
+	return andIds(ids), nil
+}
+
+// The jwt filter writes the verified payloads to the dynamic metadata, under the name of their provider.
+// Without an explicit provider, the claims may come from any provider of the virtual host.
+func translateJwtPrincipal(ctx context.Context, vhost *v1.VirtualHost, policy string, in *rbacapi.JWTPrincipal) (*envoyrbac.Principal, error) {
+	providers := vhost.GetOptions().GetJwt().GetProviders()
+	if len(providers) == 0 {
+		return nil, JwtNotEnabledErr(policy)
+	}
+	if len(in.GetClaims()) == 0 {
+		return nil, EmptyJwtPrincipalErr(policy)
+	}
+
+	var providerNames []string
+	if in.GetProvider() != "" {
+		if _, ok := providers[in.GetProvider()]; !ok {
+			return nil, JwtProviderNotFoundErr(policy, in.GetProvider())
+		}
+		providerNames = []string{in.GetProvider()}
+	} else {
+		for provider := range providers {
+			providerNames = append(providerNames, provider)
+		}
+		sort.Strings(providerNames)
+	}
+
+	var claims []string
+	for claim := range in.GetClaims() {
+		claims = append(claims, claim)
+	}
+	sort.Strings(claims)
+
+	var byProvider []*envoyrbac.Principal
+	for _, provider := range providerNames {
+		var claimIds []*envoyrbac.Principal
+		for _, claim := range claims {
+			claimIds = append(claimIds, &envoyrbac.Principal{
+				Identifier: &envoyrbac.Principal_Metadata{
+					Metadata: &envoymatcher.MetadataMatcher{
+						Filter: jwt.FilterName,
+						Path: []*envoymatcher.MetadataMatcher_PathSegment{
+							{Segment: &envoymatcher.MetadataMatcher_PathSegment_Key{Key: jwt.EnvoyProviderName(ctx, vhost.GetName(), provider)}},
+							{Segment: &envoymatcher.MetadataMatcher_PathSegment_Key{Key: claim}},
+						},
+						Value: &envoymatcher.ValueMatcher{
+							MatchPattern: &envoymatcher.ValueMatcher_StringMatch{
+								StringMatch: &envoymatcher.StringMatcher{
+									MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: in.GetClaims()[claim]},
+								},
+							},
+						},
+					},
+				},
+			})
+		}
+		byProvider = append(byProvider, andIds(claimIds))
+	}
+	return orIds(byProvider), nil
+}
+
+// All set fields of the permissions must match, and empty permissions allow everything.
+func translatePermissions(ctx context.Context, vhost *v1.VirtualHost, policy string, in *rbacapi.Permissions) (*envoyrbac.Permission, error) {
+	var rules []*envoyrbac.Permission
+
+	if in.GetPathPrefix() != "" {
+		if vhost == nil {
+			return nil, UnsupportedNetworkRbacErr(policy, "path prefixes")
+		}
+		rules = append(rules, &envoyrbac.Permission{
+			Rule: &envoyrbac.Permission_UrlPath{
+				UrlPath: &envoymatcher.PathMatcher{
+					Rule: &envoymatcher.PathMatcher_Path{
+						Path: &envoymatcher.StringMatcher{
+							MatchPattern: &envoymatcher.StringMatcher_Prefix{Prefix: in.GetPathPrefix()},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	if len(in.GetMethods()) > 0 {
+		if vhost == nil {
+			return nil, UnsupportedNetworkRbacErr(policy, "methods")
+		}
+		var methods []*envoyrbac.Permission
+		for _, method := range in.GetMethods() {
+			methods = append(methods, &envoyrbac.Permission{
+				Rule: &envoyrbac.Permission_Header{
+					Header: &envoyroutev3.HeaderMatcher{
+						Name:                 ":method",
+						HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_ExactMatch{ExactMatch: strings.ToUpper(method)},
+					},
+				},
+			})
+		}
+		rules = append(rules, orRules(methods))
+	}
+
+	if len(in.GetHeaders()) > 0 && vhost == nil {
+		return nil, UnsupportedNetworkRbacErr(policy, "headers")
+	}
+	for _, header := range envoyHeaderMatchers(ctx, in.GetHeaders()) {
+		rules = append(rules, &envoyrbac.Permission{
+			Rule: &envoyrbac.Permission_Header{Header: header},
+		})
+	}
+
+	var serverNames []*envoyrbac.Permission
+	for _, serverName := range in.GetServerNames() {
+		serverNames = append(serverNames, &envoyrbac.Permission{
+			Rule: &envoyrbac.Permission_RequestedServerName{
+				RequestedServerName: &envoymatcher.StringMatcher{
+					MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: serverName},
+				},
+			},
+		})
+	}
+	if len(serverNames) > 0 {
+		rules = append(rules, orRules(serverNames))
+	}
+
+	switch len(rules) {
+	case 0:
+		return &envoyrbac.Permission{Rule: &envoyrbac.Permission_Any{Any: true}}, nil
+	case 1:
+		return rules[0], nil
+	}
+	return &envoyrbac.Permission{
+		Rule: &envoyrbac.Permission_AndRules{AndRules: &envoyrbac.Permission_Set{Rules: rules}},
+	}, nil
+}
+
+func anyPrincipal() *envoyrbac.Principal {
+	return &envoyrbac.Principal{Identifier: &envoyrbac.Principal_Any{Any: true}}
+}
+
+func andIds(ids []*envoyrbac.Principal) *envoyrbac.Principal {
+	switch len(ids) {
+	case 0:
+		return anyPrincipal()
+	case 1:
+		return ids[0]
+	}
+	return &envoyrbac.Principal{
+		Identifier: &envoyrbac.Principal_AndIds{AndIds: &envoyrbac.Principal_Set{Ids: ids}},
+	}
+}
+
+func orIds(ids []*envoyrbac.Principal) *envoyrbac.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &envoyrbac.Principal{
+		Identifier: &envoyrbac.Principal_OrIds{OrIds: &envoyrbac.Principal_Set{Ids: ids}},
+	}
+}
+
+func orRules(rules []*envoyrbac.Permission) *envoyrbac.Permission {
+	if len(rules) == 1 {
+		return rules[0]
+	}
+	return &envoyrbac.Permission{
+		Rule: &envoyrbac.Permission_OrRules{OrRules: &envoyrbac.Permission_Set{Rules: rules}},
+	}
+}
+
+// accepts CIDR ranges and single addresses
+func cidrRange(in string) (*envoycore.CidrRange, error) {
+	if !strings.Contains(in, "/") {
+		ip := net.ParseIP(in)
+		if ip == nil {
+			return nil, eris.Errorf("invalid ip %v", in)
+		}
+		prefixLen := uint32(128)
+		if ip.To4() != nil {
+			prefixLen = 32
+		}
+		return &envoycore.CidrRange{AddressPrefix: ip.String(), PrefixLen: &wrappers.UInt32Value{Value: prefixLen}}, nil
+	}
+	ip, ipNet, err := net.ParseCIDR(in)
+	if err != nil {
+		return nil, err
+	}
+	prefixLen, _ := ipNet.Mask.Size()
+	return &envoycore.CidrRange{AddressPrefix: ip.String(), PrefixLen: &wrappers.UInt32Value{Value: uint32(prefixLen)}}, nil
+}
+
+func envoyHeaderMatchers(ctx context.Context, in []*matchers.HeaderMatcher) []*envoyroutev3.HeaderMatcher {
+	var out []*envoyroutev3.HeaderMatcher
+	for _, matcher := range in {
+		envoyMatch := &envoyroutev3.HeaderMatcher{
+			Name:        matcher.GetName(),
+			InvertMatch: matcher.GetInvertMatch(),
+		}
+		if matcher.GetValue() == "" {
+			envoyMatch.HeaderMatchSpecifier = &envoyroutev3.HeaderMatcher_PresentMatch{PresentMatch: true}
+		} else if matcher.GetRegex() {
+			envoyMatch.HeaderMatchSpecifier = &envoyroutev3.HeaderMatcher_SafeRegexMatch{
+				SafeRegexMatch: &envoymatcher.RegexMatcher{
+					EngineType: &envoymatcher.RegexMatcher_GoogleRe2{
+						GoogleRe2: &envoymatcher.RegexMatcher_GoogleRE2{
+							MaxProgramSize: regexutils.NewRegex(ctx, matcher.GetValue()).GetGoogleRe2().GetMaxProgramSize(),
+						},
+					},
+					Regex: matcher.GetValue(),
+				},
+			}
+		} else {
+			envoyMatch.HeaderMatchSpecifier = &envoyroutev3.HeaderMatcher_ExactMatch{ExactMatch: matcher.GetValue()}
+		}
+		out = append(out, envoyMatch)
+	}
+	return out
+}
+
+func sortedPolicyNames(settings *rbacapi.ExtensionSettings) []string {
+	var names []string
+	for name := range settings.GetPolicies() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,357 @@
+package rbac_test
+
+import (
+	"context"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhttprbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoynetworkrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	envoyjwt "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/jwt_authn/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	jwtapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	rbacapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/rbac"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		plugin   *Plugin
+		params   plugins.Params
+		settings *rbacapi.ExtensionSettings
+		vhost    *v1.VirtualHost
+	)
+
+	unmarshalAny := func(a *any.Any, out proto.Message) {
+		Expect(a).NotTo(BeNil())
+		Expect(ptypes.UnmarshalAny(a, out)).NotTo(HaveOccurred())
+	}
+
+	vhostConfig := func() *envoyhttprbac.RBACPerRoute {
+		out := &envoyroute.VirtualHost{}
+		err := plugin.ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, vhost, out)
+		Expect(err).NotTo(HaveOccurred())
+		config := &envoyhttprbac.RBACPerRoute{}
+		unmarshalAny(out.GetTypedPerFilterConfig()[FilterName], config)
+		Expect(config.Validate()).NotTo(HaveOccurred())
+		return config
+	}
+
+	vhostError := func() error {
+		return plugin.ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, vhost, &envoyroute.VirtualHost{})
+	}
+
+	exactHeader := func(name, value string) *envoyroutev3.HeaderMatcher {
+		return &envoyroutev3.HeaderMatcher{
+			Name:                 name,
+			HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_ExactMatch{ExactMatch: value},
+		}
+	}
+
+	claimMatcher := func(provider, claim, value string) *envoyrbac.Principal {
+		return &envoyrbac.Principal{
+			Identifier: &envoyrbac.Principal_Metadata{
+				Metadata: &envoymatcher.MetadataMatcher{
+					Filter: jwt.FilterName,
+					Path: []*envoymatcher.MetadataMatcher_PathSegment{
+						{Segment: &envoymatcher.MetadataMatcher_PathSegment_Key{Key: provider}},
+						{Segment: &envoymatcher.MetadataMatcher_PathSegment_Key{Key: claim}},
+					},
+					Value: &envoymatcher.ValueMatcher{
+						MatchPattern: &envoymatcher.ValueMatcher_StringMatch{
+							StringMatch: &envoymatcher.StringMatcher{
+								MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: value},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		Expect(plugin.Init(plugins.InitParams{Settings: &v1.Settings{}})).NotTo(HaveOccurred())
+		params = plugins.Params{Ctx: context.Background()}
+		settings = &rbacapi.ExtensionSettings{
+			Policies: map[string]*rbacapi.Policy{
+				"admins": {
+					Principals: []*rbacapi.Principal{{
+						SourceIps: []string{"10.0.0.0/8", "192.168.1.1"},
+						Headers:   []*matchers.HeaderMatcher{{Name: "x-team", Value: "ops"}},
+					}},
+					Permissions: &rbacapi.Permissions{
+						PathPrefix: "/admin",
+						Methods:    []string{"get", "POST"},
+					},
+				},
+			},
+		}
+		vhost = &v1.VirtualHost{
+			Name:    "vhost",
+			Options: &v1.VirtualHostOptions{Rbac: settings},
+		}
+	})
+
+	Context("http filter", func() {
+
+		It("does not add the filter when no virtual host uses rbac", func() {
+			filters, err := plugin.HttpFilters(params, &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{{Name: "vhost"}}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("adds a filter without rules when a route uses rbac", func() {
+			listener := &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{{
+				Name:   "vhost",
+				Routes: []*v1.Route{{Options: &v1.RouteOptions{Rbac: settings}}},
+			}}}
+			filters, err := plugin.HttpFilters(params, listener)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter.Name).To(Equal(FilterName))
+			config := &envoyhttprbac.RBAC{}
+			unmarshalAny(filters[0].HttpFilter.GetTypedConfig(), config)
+			Expect(config.GetRules()).To(BeNil())
+		})
+
+		It("denies all requests by default when rbac is required", func() {
+			err := plugin.Init(plugins.InitParams{Settings: &v1.Settings{Rbac: &rbacapi.Settings{RequireRbac: true}}})
+			Expect(err).NotTo(HaveOccurred())
+			filters, err := plugin.HttpFilters(params, &v1.HttpListener{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			config := &envoyhttprbac.RBAC{}
+			unmarshalAny(filters[0].HttpFilter.GetTypedConfig(), config)
+			Expect(config.GetRules()).To(Equal(&envoyrbac.RBAC{Action: envoyrbac.RBAC_ALLOW}))
+		})
+	})
+
+	Context("virtual hosts and routes", func() {
+
+		It("translates principals and permissions", func() {
+			config := vhostConfig()
+			Expect(config.GetRbac().GetRules().GetAction()).To(Equal(envoyrbac.RBAC_ALLOW))
+			policy := config.GetRbac().GetRules().GetPolicies()["admins"]
+			Expect(policy.GetPrincipals()).To(Equal([]*envoyrbac.Principal{{
+				Identifier: &envoyrbac.Principal_AndIds{AndIds: &envoyrbac.Principal_Set{Ids: []*envoyrbac.Principal{
+					{Identifier: &envoyrbac.Principal_OrIds{OrIds: &envoyrbac.Principal_Set{Ids: []*envoyrbac.Principal{
+						{Identifier: &envoyrbac.Principal_DirectRemoteIp{DirectRemoteIp: &envoycore.CidrRange{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}}}},
+						{Identifier: &envoyrbac.Principal_DirectRemoteIp{DirectRemoteIp: &envoycore.CidrRange{AddressPrefix: "192.168.1.1", PrefixLen: &wrappers.UInt32Value{Value: 32}}}},
+					}}}},
+					{Identifier: &envoyrbac.Principal_Header{Header: exactHeader("x-team", "ops")}},
+				}}},
+			}}))
+			Expect(policy.GetPermissions()).To(Equal([]*envoyrbac.Permission{{
+				Rule: &envoyrbac.Permission_AndRules{AndRules: &envoyrbac.Permission_Set{Rules: []*envoyrbac.Permission{
+					{Rule: &envoyrbac.Permission_UrlPath{UrlPath: &envoymatcher.PathMatcher{
+						Rule: &envoymatcher.PathMatcher_Path{Path: &envoymatcher.StringMatcher{
+							MatchPattern: &envoymatcher.StringMatcher_Prefix{Prefix: "/admin"},
+						}},
+					}}},
+					{Rule: &envoyrbac.Permission_OrRules{OrRules: &envoyrbac.Permission_Set{Rules: []*envoyrbac.Permission{
+						{Rule: &envoyrbac.Permission_Header{Header: exactHeader(":method", "GET")}},
+						{Rule: &envoyrbac.Permission_Header{Header: exactHeader(":method", "POST")}},
+					}}}},
+				}}},
+			}}))
+		})
+
+		It("allows everyone everything for empty principals and permissions", func() {
+			settings.Policies = map[string]*rbacapi.Policy{"all": {}}
+			policy := vhostConfig().GetRbac().GetRules().GetPolicies()["all"]
+			Expect(policy.GetPrincipals()).To(Equal([]*envoyrbac.Principal{{Identifier: &envoyrbac.Principal_Any{Any: true}}}))
+			Expect(policy.GetPermissions()).To(Equal([]*envoyrbac.Permission{{Rule: &envoyrbac.Permission_Any{Any: true}}}))
+		})
+
+		It("matches SNI server names", func() {
+			settings.Policies = map[string]*rbacapi.Policy{"sni": {
+				Permissions: &rbacapi.Permissions{ServerNames: []string{"internal.example.com"}},
+			}}
+			policy := vhostConfig().GetRbac().GetRules().GetPolicies()["sni"]
+			Expect(policy.GetPermissions()).To(Equal([]*envoyrbac.Permission{{
+				Rule: &envoyrbac.Permission_RequestedServerName{RequestedServerName: &envoymatcher.StringMatcher{
+					MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: "internal.example.com"},
+				}},
+			}}))
+		})
+
+		It("disables the filter", func() {
+			settings.Disable = true
+			Expect(vhostConfig()).To(Equal(&envoyhttprbac.RBACPerRoute{}))
+		})
+
+		It("sets the policies of routes", func() {
+			out := &envoyroute.Route{}
+			route := &v1.Route{Options: &v1.RouteOptions{Rbac: &rbacapi.ExtensionSettings{Disable: true}}}
+			err := plugin.ProcessRoute(plugins.RouteParams{VirtualHostParams: plugins.VirtualHostParams{Params: params}, VirtualHost: vhost}, route, out)
+			Expect(err).NotTo(HaveOccurred())
+			config := &envoyhttprbac.RBACPerRoute{}
+			unmarshalAny(out.GetTypedPerFilterConfig()[FilterName], config)
+			Expect(config).To(Equal(&envoyhttprbac.RBACPerRoute{}))
+		})
+
+		It("rejects invalid source ips", func() {
+			settings.Policies["admins"].Principals[0].SourceIps = []string{"10.0.0.0/33"}
+			Expect(vhostError()).To(MatchError(InvalidSourceIpErr("admins", "10.0.0.0/33").Error()))
+		})
+	})
+
+	Context("jwt principals", func() {
+
+		BeforeEach(func() {
+			vhost.Options.Jwt = &jwtapi.VhostExtension{
+				Providers: map[string]*jwtapi.Provider{
+					"first":  {},
+					"second": {},
+				},
+			}
+			settings.Policies = map[string]*rbacapi.Policy{"users": {
+				Principals: []*rbacapi.Principal{{
+					JwtPrincipal: &rbacapi.JWTPrincipal{
+						Claims: map[string]string{"sub": "user", "iss": "gloo"},
+					},
+				}},
+			}}
+		})
+
+		It("matches the claims of any provider of the virtual host", func() {
+			policy := vhostConfig().GetRbac().GetRules().GetPolicies()["users"]
+			first, second := jwt.EnvoyProviderName(params.Ctx, "vhost", "first"), jwt.EnvoyProviderName(params.Ctx, "vhost", "second")
+			Expect(policy.GetPrincipals()).To(Equal([]*envoyrbac.Principal{{
+				Identifier: &envoyrbac.Principal_OrIds{OrIds: &envoyrbac.Principal_Set{Ids: []*envoyrbac.Principal{
+					{Identifier: &envoyrbac.Principal_AndIds{AndIds: &envoyrbac.Principal_Set{Ids: []*envoyrbac.Principal{
+						claimMatcher(first, "iss", "gloo"),
+						claimMatcher(first, "sub", "user"),
+					}}}},
+					{Identifier: &envoyrbac.Principal_AndIds{AndIds: &envoyrbac.Principal_Set{Ids: []*envoyrbac.Principal{
+						claimMatcher(second, "iss", "gloo"),
+						claimMatcher(second, "sub", "user"),
+					}}}},
+				}}},
+			}}))
+		})
+
+		It("matches the claims of a single provider", func() {
+			principal := settings.Policies["users"].Principals[0].JwtPrincipal
+			principal.Provider = "second"
+			principal.Claims = map[string]string{"sub": "user"}
+			policy := vhostConfig().GetRbac().GetRules().GetPolicies()["users"]
+			Expect(policy.GetPrincipals()).To(Equal([]*envoyrbac.Principal{
+				claimMatcher(jwt.EnvoyProviderName(params.Ctx, "vhost", "second"), "sub", "user"),
+			}))
+		})
+
+		It("matches the payloads under the provider names of the jwt filter", func() {
+			// the virtual hosts generated by the gateway translator are named after the namespace and the name of
+			// their virtual service
+			vhost.Name = "gloo-system.petstore"
+			vhost.Options.Jwt.Providers["second"] = &jwtapi.Provider{
+				Jwks: &jwtapi.Jwks{
+					Jwks: &jwtapi.Jwks_Local{Local: &jwtapi.LocalJwks{Key: `{"keys":[{"k":"c2VjcmV0","kty":"oct"}]}`}},
+				},
+			}
+			principal := settings.Policies["users"].Principals[0].JwtPrincipal
+			principal.Provider = "second"
+			principal.Claims = map[string]string{"sub": "user"}
+
+			filters, err := jwt.NewPlugin().HttpFilters(params, &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{{
+				Name:    vhost.Name,
+				Options: &v1.VirtualHostOptions{Jwt: &jwtapi.VhostExtension{Providers: map[string]*jwtapi.Provider{"second": vhost.Options.Jwt.Providers["second"]}}},
+			}}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			filterConfig := &envoyjwt.JwtAuthentication{}
+			Expect(gogoproto.Unmarshal(filters[0].HttpFilter.GetTypedConfig().GetValue(), filterConfig)).NotTo(HaveOccurred())
+			Expect(filterConfig.GetProviders()).To(HaveKey("20_gloo-system_petstore_second"))
+
+			policy := vhostConfig().GetRbac().GetRules().GetPolicies()["users"]
+			Expect(policy.GetPrincipals()).To(Equal([]*envoyrbac.Principal{
+				claimMatcher("20_gloo-system_petstore_second", "sub", "user"),
+			}))
+		})
+
+		It("rejects unknown providers", func() {
+			settings.Policies["users"].Principals[0].JwtPrincipal.Provider = "third"
+			Expect(vhostError()).To(MatchError(JwtProviderNotFoundErr("users", "third").Error()))
+		})
+
+		It("requires jwt on the virtual host", func() {
+			vhost.Options.Jwt = nil
+			Expect(vhostError()).To(MatchError(JwtNotEnabledErr("users").Error()))
+		})
+	})
+
+	Context("tcp listeners", func() {
+
+		var (
+			listener *v1.Listener
+			out      *envoyapi.Listener
+		)
+
+		BeforeEach(func() {
+			listener = &v1.Listener{
+				ListenerType: &v1.Listener_TcpListener{
+					TcpListener: &v1.TcpListener{
+						Options: &v1.TcpListenerOptions{
+							Rbac: &rbacapi.ExtensionSettings{
+								Policies: map[string]*rbacapi.Policy{"internal": {
+									Principals: []*rbacapi.Principal{{SourceIps: []string{"10.0.0.0/8"}}},
+								}},
+							},
+						},
+					},
+				},
+			}
+			out = &envoyapi.Listener{
+				FilterChains: []*envoylistener.FilterChain{
+					{Filters: []*envoylistener.Filter{{Name: "tcp_proxy"}}},
+					{Filters: []*envoylistener.Filter{{Name: "tcp_proxy"}}},
+				},
+			}
+		})
+
+		It("prepends the network filter to every filter chain", func() {
+			Expect(plugin.ProcessListener(params, listener, out)).NotTo(HaveOccurred())
+			for _, filterChain := range out.GetFilterChains() {
+				Expect(filterChain.GetFilters()).To(HaveLen(2))
+				Expect(filterChain.GetFilters()[0].GetName()).To(Equal(NetworkFilterName))
+				config := &envoynetworkrbac.RBAC{}
+				unmarshalAny(filterChain.GetFilters()[0].GetTypedConfig(), config)
+				Expect(config.Validate()).NotTo(HaveOccurred())
+				Expect(config.GetStatPrefix()).To(Equal(NetworkStatPrefix))
+				Expect(config.GetRules().GetPolicies()["internal"].GetPrincipals()).To(Equal([]*envoyrbac.Principal{{
+					Identifier: &envoyrbac.Principal_DirectRemoteIp{DirectRemoteIp: &envoycore.CidrRange{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}}},
+				}}))
+			}
+		})
+
+		It("rejects http only fields", func() {
+			listener.GetTcpListener().Options.Rbac.Policies["internal"].Permissions = &rbacapi.Permissions{PathPrefix: "/"}
+			err := plugin.ProcessListener(params, listener, out)
+			Expect(err).To(MatchError(UnsupportedNetworkRbacErr("internal", "path prefixes").Error()))
+		})
+
+		It("ignores http listeners", func() {
+			listener.ListenerType = &v1.Listener_HttpListener{HttpListener: &v1.HttpListener{}}
+			Expect(plugin.ProcessListener(params, listener, out)).NotTo(HaveOccurred())
+			Expect(out.GetFilterChains()[0].GetFilters()).To(HaveLen(1))
+		})
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/buffer"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/cors"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/dynamicforwardproxy"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/extauth"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/faultinjection"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpc"
@@ -20,13 +21,16 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/hcm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/headers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/healthcheck"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/linkerd"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/listener"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/loadbalancer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/localratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pipe"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/protocoloptions"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/rbac"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/rest"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
@@ -58,6 +62,7 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...func() plugin
 		hcmPlugin,
 		als.NewPlugin(),
 		pipe.NewPlugin(),
+		dynamicforwardproxy.NewPlugin(),
 		tcp.NewPlugin(utils.NewSslConfigTranslator()),
 		static.NewPlugin(),
 		transformationPlugin,
@@ -78,6 +83,9 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...func() plugin
 		wasm.NewPlugin(),
 		gzip.NewPlugin(),
 		buffer.NewPlugin(),
+		localratelimit.NewPlugin(),
+		jwt.NewPlugin(),
+		rbac.NewPlugin(),
 		listener.NewPlugin(),
 		virtualhost.NewPlugin(),
 		protocoloptions.NewPlugin(),
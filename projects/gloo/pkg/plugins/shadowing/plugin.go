@@ -4,6 +4,7 @@ import (
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -17,6 +18,7 @@ var (
 	InvalidNumeratorError    = func(num float32) error {
 		return eris.Errorf("shadow percentage must be between 0 and 100, received %v", num)
 	}
+	TooManyShadowHeaderSetsError = eris.Errorf("the shadow destinations of a route can use at most %v different sets of headers", translator.MaxShadowHeaderSets)
 )
 
 func NewPlugin() *Plugin {
@@ -56,19 +58,56 @@ func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 }
 
 func applyShadowSpec(out *envoyroute.RouteAction, spec *shadowing.RouteShadowing) error {
-	if spec.Upstream == nil {
-		return UnspecifiedUpstreamError
+	if len(spec.Destinations) == 0 {
+		policy, err := mirrorPolicy(&shadowing.ShadowDestination{
+			Upstream:   spec.Upstream,
+			Percentage: spec.Percentage,
+		})
+		if err != nil {
+			return err
+		}
+		out.RequestMirrorPolicy = policy
+		return nil
 	}
-	if spec.Percentage < 0 || spec.Percentage > 100 {
-		return InvalidNumeratorError(spec.Percentage)
+	// Envoy shadows traffic to each mirror policy independently, with the runtime fraction of the policy
+	var policies []*envoyroute.RouteAction_RequestMirrorPolicy
+	destinations := spec.Destinations
+	if spec.Upstream != nil {
+		destinations = append([]*shadowing.ShadowDestination{{
+			Upstream:   spec.Upstream,
+			Percentage: spec.Percentage,
+		}}, destinations...)
 	}
-	out.RequestMirrorPolicy = &envoyroute.RouteAction_RequestMirrorPolicy{
-		Cluster:         translator.UpstreamToClusterName(*spec.Upstream),
-		RuntimeFraction: getFractionalPercent(spec.Percentage),
+	for _, dest := range destinations {
+		// the translator has already split the route by the headers of the destinations,
+		// unless they use too many sets of headers
+		if len(dest.Headers) > 0 {
+			return TooManyShadowHeaderSetsError
+		}
+		policy, err := mirrorPolicy(dest)
+		if err != nil {
+			return err
+		}
+		policies = append(policies, policy)
 	}
+	out.RequestMirrorPolicies = policies
 	return nil
 }
 
+func mirrorPolicy(dest *shadowing.ShadowDestination) (*envoyroute.RouteAction_RequestMirrorPolicy, error) {
+	if dest.Upstream == nil {
+		return nil, UnspecifiedUpstreamError
+	}
+	if dest.Percentage < 0 || dest.Percentage > 100 {
+		return nil, InvalidNumeratorError(dest.Percentage)
+	}
+	return &envoyroute.RouteAction_RequestMirrorPolicy{
+		Cluster:         translator.UpstreamToClusterName(*dest.Upstream),
+		RuntimeFraction: getFractionalPercent(dest.Percentage),
+		TraceSampled:    gogoutils.BoolGogoToProto(dest.TraceSampled),
+	}, nil
+}
+
 func getFractionalPercent(numerator float32) *envoycore.RuntimeFractionalPercent {
 	return &envoycore.RuntimeFractionalPercent{
 		DefaultValue: common.ToEnvoyv2Percentage(numerator),
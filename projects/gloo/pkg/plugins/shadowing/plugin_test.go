@@ -3,6 +3,9 @@ package shadowing
 import (
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
 	. "github.com/solo-io/go-utils/testutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -144,6 +147,72 @@ var _ = Describe("Plugin", func() {
 		Expect(err).To(HaveInErrorChain(UnspecifiedUpstreamError))
 	})
 
+	It("should shadow to multiple destinations", func() {
+		p := NewPlugin()
+
+		in := &v1.Route{
+			Options: &v1.RouteOptions{
+				Shadowing: &shadowing.RouteShadowing{
+					Upstream:   &core.ResourceRef{Name: "staging", Namespace: "default"},
+					Percentage: 100,
+					Destinations: []*shadowing.ShadowDestination{{
+						Upstream:     &core.ResourceRef{Name: "recorder", Namespace: "default"},
+						Percentage:   10,
+						TraceSampled: &types.BoolValue{Value: false},
+					}},
+				},
+			},
+		}
+		out := &envoyroute.Route{}
+		err := p.ProcessRoute(plugins.RouteParams{}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+		// Envoy rejects routes with both the deprecated mirror policy and mirror policies
+		Expect(out.GetRoute().RequestMirrorPolicy).To(BeNil())
+		policies := out.GetRoute().RequestMirrorPolicies
+		Expect(policies).To(HaveLen(2))
+		Expect(policies[0].Cluster).To(Equal("staging_default"))
+		checkFraction(policies[0].RuntimeFraction, 100)
+		Expect(policies[0].TraceSampled).To(BeNil())
+		Expect(policies[1].Cluster).To(Equal("recorder_default"))
+		checkFraction(policies[1].RuntimeFraction, 10)
+		Expect(policies[1].TraceSampled).To(Equal(&wrappers.BoolValue{Value: false}))
+	})
+
+	It("should error on destinations with headers which the translator did not split", func() {
+		p := NewPlugin()
+
+		in := &v1.Route{
+			Options: &v1.RouteOptions{
+				Shadowing: &shadowing.RouteShadowing{
+					Destinations: []*shadowing.ShadowDestination{{
+						Upstream:   &core.ResourceRef{Name: "recorder", Namespace: "default"},
+						Percentage: 100,
+						Headers:    []*matchers.HeaderMatcher{{Name: "x-mirror"}},
+					}},
+				},
+			},
+		}
+		err := p.ProcessRoute(plugins.RouteParams{}, in, &envoyroute.Route{})
+		Expect(err).To(HaveInErrorChain(TooManyShadowHeaderSetsError))
+	})
+
+	It("should validate destinations", func() {
+		p := NewPlugin()
+
+		in := &v1.Route{
+			Options: &v1.RouteOptions{
+				Shadowing: &shadowing.RouteShadowing{
+					Destinations: []*shadowing.ShadowDestination{{
+						Upstream:   &core.ResourceRef{Name: "recorder", Namespace: "default"},
+						Percentage: -1,
+					}},
+				},
+			},
+		}
+		err := p.ProcessRoute(plugins.RouteParams{}, in, &envoyroute.Route{})
+		Expect(err).To(HaveInErrorChain(InvalidNumeratorError(-1)))
+	})
+
 })
 
 func checkFraction(frac *envoycore.RuntimeFractionalPercent, percentage float32) {
@@ -107,6 +107,7 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 				if err := s.xdsCache.SetSnapshot(key, emptySnapshot); err != nil {
 					return err
 				}
+				delete(s.snapshotHashes, key)
 			}
 		}
 	}
@@ -153,10 +154,22 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 			logger.Infof("successfully updated EDS information for proxy %v", proxy.Metadata.Ref().Key())
 		}
 
-		if err := s.xdsCache.SetSnapshot(key, sanitizedSnapshot); err != nil {
-			err := eris.Wrapf(err, "failed while updating xDS snapshot cache")
-			logger.DPanicw("", zap.Error(err))
-			return err
+		// setting a snapshot wakes up every watch on the key, so skip it if no resource changed since the last one
+		snapshotHash, hashed := snapshotContentHash(sanitizedSnapshot)
+		previousHash, seen := s.snapshotHashes[key]
+		unchanged := hashed && seen && previousHash == snapshotHash
+
+		if !unchanged {
+			if err := s.xdsCache.SetSnapshot(key, sanitizedSnapshot); err != nil {
+				err := eris.Wrapf(err, "failed while updating xDS snapshot cache")
+				logger.DPanicw("", zap.Error(err))
+				return err
+			}
+			if hashed {
+				s.snapshotHashes[key] = snapshotHash
+			} else {
+				delete(s.snapshotHashes, key)
+			}
 		}
 
 		// Record some metrics
@@ -170,7 +183,16 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 		measureResource(proxyCtx, "routes", routesLen)
 		measureResource(proxyCtx, "endpoints", endpointsLen)
 
+		if unchanged {
+			logger.Debugw("xDS snapshot unchanged, not updating", "key", key)
+			continue
+		}
+
 		logger.Infow("Setting xDS Snapshot", "key", key,
+			"clustersVersion", sanitizedSnapshot.GetResources(xds.ClusterType).Version,
+			"listenersVersion", sanitizedSnapshot.GetResources(xds.ListenerType).Version,
+			"routesVersion", sanitizedSnapshot.GetResources(xds.RouteType).Version,
+			"endpointsVersion", sanitizedSnapshot.GetResources(xds.EndpointType).Version,
 			"clusters", clustersLen,
 			"listeners", listenersLen,
 			"routes", routesLen,
@@ -227,3 +249,12 @@ func (s *translatorSyncer) updateEndpointsOnly(snapshotKey string, current envoy
 
 	return newSnapshot, nil
 }
+
+// snapshotContentHash returns a hash of the resources in the snapshot, or false if it cannot be hashed.
+func snapshotContentHash(snapshot envoycache.Snapshot) (uint64, bool) {
+	envoySnapshot, ok := snapshot.(*xds.EnvoySnapshot)
+	if !ok {
+		return 0, false
+	}
+	return envoySnapshot.Hash()
+}
@@ -115,7 +115,7 @@ type setupSyncer struct {
 func NewControlPlane(ctx context.Context, grpcServer *grpc.Server, bindAddr net.Addr, callbacks xdsserver.Callbacks, start bool) bootstrap.ControlPlane {
 	hasher := &xds.ProxyKeyHasher{}
 	snapshotCache := cache.NewSnapshotCache(true, hasher, contextutils.LoggerFrom(ctx))
-	xdsServer := server.NewServer(snapshotCache, callbacks)
+	xdsServer := xds.NewAdsServer(server.NewServer(snapshotCache, callbacks), snapshotCache, callbacks)
 	envoyv2.RegisterAggregatedDiscoveryServiceServer(grpcServer, xdsServer)
 	reflection.Register(grpcServer)
 
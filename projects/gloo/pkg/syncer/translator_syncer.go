@@ -26,6 +26,8 @@ type translatorSyncer struct {
 	// used to track which envoy node IDs exist without belonging to a proxy
 	extensionKeys map[string]struct{}
 	settings      *v1.Settings
+	// content hashes of the latest xDS snapshot set for each proxy, used to skip updates which change nothing
+	snapshotHashes map[string]uint64
 }
 
 type TranslatorSyncerExtensionParams struct {
@@ -48,6 +50,8 @@ func NewTranslatorSyncer(translator translator.Translator, xdsCache envoycache.S
 		extensions: extensions,
 		sanitizer:  sanitizer,
 		settings:   settings,
+
+		snapshotHashes: map[string]uint64{},
 	}
 	if devMode {
 		// TODO(ilackarms): move this somewhere else?
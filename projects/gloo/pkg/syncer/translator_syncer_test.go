@@ -118,6 +118,31 @@ var _ = Describe("Translate Proxy", func() {
 		Expect(xdsCache.setSnap).To(BeEquivalentTo(sanitizer.snap))
 	})
 
+	It("does not update the cache if the snapshot content did not change", func() {
+		clusterSnap := func(version string, name string) envoycache.Snapshot {
+			return xds.NewSnapshot(version, nil, []envoycache.Resource{
+				xds.NewEnvoyResource(&v2.Cluster{Name: name}),
+			}, nil, nil)
+		}
+
+		sanitizer.snap = clusterSnap("1", "cluster")
+		err := syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(xdsCache.setSnap).To(BeIdenticalTo(sanitizer.snap))
+
+		xdsCache.called = false
+		sanitizer.snap = clusterSnap("2", "cluster")
+		err = syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(xdsCache.called).To(BeFalse())
+
+		sanitizer.snap = clusterSnap("3", "other-cluster")
+		err = syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(xdsCache.called).To(BeTrue())
+		Expect(xdsCache.setSnap).To(BeIdenticalTo(sanitizer.snap))
+	})
+
 	It("uses listeners and routes from the previous snapshot when sanitization fails", func() {
 		sanitizer.err = errors.Errorf("we ran out of coffee")
 
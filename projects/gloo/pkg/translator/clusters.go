@@ -1,6 +1,7 @@
 package translator
 
 import (
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
+	envoycluster_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/cluster"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
@@ -65,7 +67,7 @@ func (t *translatorInstance) initializeCluster(upstream *v1.Upstream, endpoints
 	if err != nil {
 		reports.AddError(upstream, err)
 	}
-	detectCfg, err := createOutlierDetectionConfig(upstream)
+	detectCfg, err := createOutlierDetectionConfig(upstream, t.settings.GetGloo().GetOutlierDetection())
 	if err != nil {
 		reports.AddError(upstream, err)
 	}
@@ -112,6 +114,22 @@ var (
 	NilFieldError = func(fieldName string) error {
 		return eris.Errorf("The field %s cannot be nil", fieldName)
 	}
+
+	InvalidHealthCheckError = func(err error, index int) error {
+		return eris.Wrapf(err, "invalid HealthCheck[%d]", index)
+	}
+
+	InvalidExpectedStatusesError = func(start, end int64) error {
+		return eris.Errorf("invalid expected status range [%d, %d), statuses must be in [100, 600) and the range must not be empty", start, end)
+	}
+
+	InvalidPayloadError = func(err error) error {
+		return eris.Wrapf(err, "text payloads must be hex encoded")
+	}
+
+	InvalidOutlierDetectionError = func(err error) error {
+		return eris.Wrapf(err, "invalid OutlierDetection")
+	}
 )
 
 func createHealthCheckConfig(upstream *v1.Upstream, secrets *v1.SecretList) ([]*envoycore.HealthCheck, error) {
@@ -134,22 +152,58 @@ func createHealthCheckConfig(upstream *v1.Upstream, secrets *v1.SecretList) ([]*
 		if err != nil {
 			return nil, err
 		}
+		if err := validateHealthCheck(converted); err != nil {
+			return nil, InvalidHealthCheckError(err, i)
+		}
 		result = append(result, converted)
 	}
 	return result, nil
 }
 
-func createOutlierDetectionConfig(upstream *v1.Upstream) (*envoycluster.OutlierDetection, error) {
+// Envoy rejects the whole cluster if one of its health checks is invalid,
+// so check everything it checks when loading the config.
+func validateHealthCheck(hc *envoycore.HealthCheck) error {
+	if err := hc.Validate(); err != nil {
+		return err
+	}
+	switch checker := hc.GetHealthChecker().(type) {
+	case *envoycore.HealthCheck_HttpHealthCheck_:
+		for _, statuses := range checker.HttpHealthCheck.GetExpectedStatuses() {
+			if statuses.GetStart() < 100 || statuses.GetEnd() > 600 || statuses.GetStart() >= statuses.GetEnd() {
+				return InvalidExpectedStatusesError(statuses.GetStart(), statuses.GetEnd())
+			}
+		}
+	case *envoycore.HealthCheck_TcpHealthCheck_:
+		payloads := append([]*envoycore.HealthCheck_Payload{checker.TcpHealthCheck.GetSend()}, checker.TcpHealthCheck.GetReceive()...)
+		for _, payload := range payloads {
+			if _, err := hex.DecodeString(payload.GetText()); err != nil {
+				return InvalidPayloadError(err)
+			}
+		}
+	}
+	return nil
+}
+
+// Convert the outlier detection of the upstream, or the default one if the upstream has none.
+func createOutlierDetectionConfig(upstream *v1.Upstream, defaultDetection *envoycluster_gloo.OutlierDetection) (*envoycluster.OutlierDetection, error) {
 	if upstream == nil {
 		return nil, nil
 	}
-	if upstream.GetOutlierDetection() == nil {
+	detection := upstream.GetOutlierDetection()
+	if detection == nil {
+		detection = defaultDetection
+	}
+	if detection == nil {
 		return nil, nil
 	}
-	if upstream.GetOutlierDetection().GetInterval() == nil {
+	if detection.GetInterval() == nil {
 		return nil, NilFieldError(fmt.Sprintf(fmt.Sprintf("OutlierDetection.HealthChecker")))
 	}
-	return gogoutils.ToEnvoyOutlierDetection(upstream.GetOutlierDetection()), nil
+	converted := gogoutils.ToEnvoyOutlierDetection(detection)
+	if err := converted.Validate(); err != nil {
+		return nil, InvalidOutlierDetectionError(err)
+	}
+	return converted, nil
 }
 
 func createLbConfig(upstream *v1.Upstream) *envoyapi.Cluster_LbSubsetConfig {
@@ -28,13 +28,58 @@ func (t *translatorInstance) computeListener(params plugins.Params, proxy *v1.Pr
 	params.Ctx = contextutils.WithLogger(params.Ctx, "compute_listener."+listener.Name)
 
 	validateListenerPorts(proxy, listenerReport)
+	var (
+		filterChains    []*envoylistener.FilterChain
+		listenerFilters []*envoylistener.ListenerFilter
+	)
+	switch listenerType := listener.GetListenerType().(type) {
+	case *v1.Listener_HybridListener:
+		filterChains, listenerFilters = t.computeHybridFilterChains(params, listener, listenerType.HybridListener, listenerReport.GetHybridListenerReport())
+		if len(filterChains) == 0 {
+			return nil
+		}
+	default:
+		var ok bool
+		filterChains, ok = t.computeFilterChains(params, listener, listenerReport)
+		if !ok {
+			return nil
+		}
+	}
+
+	CheckForDuplicateFilterChainMatches(filterChains, listenerReport)
+
+	out := &envoyapi.Listener{
+		Name: listener.Name,
+		Address: &envoycore.Address{
+			Address: &envoycore.Address_SocketAddress{
+				SocketAddress: &envoycore.SocketAddress{
+					Protocol: envoycore.SocketAddress_TCP,
+					Address:  listener.BindAddress,
+					PortSpecifier: &envoycore.SocketAddress_PortValue{
+						PortValue: listener.BindPort,
+					},
+					Ipv4Compat: true,
+				},
+			},
+		},
+		FilterChains:    filterChains,
+		ListenerFilters: listenerFilters,
+	}
+
+	t.runListenerPlugins(params, listener, out, listenerReport)
+
+	return out
+}
+
+// computeFilterChains returns false if the listener should not be translated
+func (t *translatorInstance) computeFilterChains(params plugins.Params, listener *v1.Listener, listenerReport *validationapi.ListenerReport) ([]*envoylistener.FilterChain, bool) {
 	var filterChains []*envoylistener.FilterChain
 	switch listener.GetListenerType().(type) {
 	case *v1.Listener_HttpListener:
 		// run the http filter chain plugins and listener plugins
 		listenerFilters := t.computeListenerFilters(params, listener, listenerReport)
 		if len(listenerFilters) == 0 {
-			return nil
+			return nil, false
 		}
 		filterChains = t.computeFilterChainsFromSslConfig(params.Snapshot, listener, listenerFilters, listenerReport)
 	case *v1.Listener_TcpListener:
@@ -54,27 +99,115 @@ func (t *translatorInstance) computeListener(params plugins.Params, proxy *v1.Pr
 			filterChains = append(filterChains, result...)
 		}
 	}
+	return filterChains, true
+}
 
-	CheckForDuplicateFilterChainMatches(filterChains, listenerReport)
+// Each matched listener of a hybrid listener is translated as if it were a listener of its own,
+// and its filter chains only match the connections satisfying its matcher.
+// The listener plugins run on the filter chains of each matched listener, so that its options only apply to them.
+func (t *translatorInstance) computeHybridFilterChains(params plugins.Params, listener *v1.Listener, hybridListener *v1.HybridListener, hybridListenerReport *validationapi.HybridListenerReport) ([]*envoylistener.FilterChain, []*envoylistener.ListenerFilter) {
+	var (
+		filterChains    []*envoylistener.FilterChain
+		listenerFilters []*envoylistener.ListenerFilter
+	)
+	for i, matchedListener := range hybridListener.GetMatchedListeners() {
+		matchedListenerReport := hybridListenerReport.GetMatchedListenerReports()[i]
+		inner := matchedListenerToListener(listener, matchedListener)
+
+		innerFilterChains, ok := t.computeFilterChains(params, inner, matchedListenerReport)
+		if !ok {
+			continue
+		}
+		out := &envoyapi.Listener{
+			Name:         inner.Name,
+			FilterChains: innerFilterChains,
+		}
+		t.runListenerPlugins(params, inner, out, matchedListenerReport)
 
-	out := &envoyapi.Listener{
-		Name: listener.Name,
-		Address: &envoycore.Address{
-			Address: &envoycore.Address_SocketAddress{
-				SocketAddress: &envoycore.SocketAddress{
-					Protocol: envoycore.SocketAddress_TCP,
-					Address:  listener.BindAddress,
-					PortSpecifier: &envoycore.SocketAddress_PortValue{
-						PortValue: listener.BindPort,
-					},
-					Ipv4Compat: true,
-				},
-			},
-		},
-		FilterChains: filterChains,
+		for _, filterChain := range out.FilterChains {
+			if err := t.applyMatcher(params.Snapshot, filterChain, matchedListener.GetMatcher()); err != nil {
+				validation.AppendListenerError(matchedListenerReport,
+					validationapi.ListenerReport_Error_SSLConfigError, err.Error())
+				continue
+			}
+			filterChains = append(filterChains, filterChain)
+		}
+		for _, listenerFilter := range out.ListenerFilters {
+			listenerFilters = appendListenerFilter(listenerFilters, listenerFilter)
+		}
+	}
+	return filterChains, listenerFilters
+}
+
+// matchedListenerToListener returns the listener to translate the matched listener of a hybrid listener with.
+// It is named after the matcher, so that its route configuration is not affected by the order of the matched listeners.
+func matchedListenerToListener(listener *v1.Listener, matchedListener *v1.MatchedListener) *v1.Listener {
+	hash, _ := matchedListener.GetMatcher().Hash(nil)
+	inner := &v1.Listener{
+		Name:          fmt.Sprintf("%v-%d", listener.Name, hash),
+		BindAddress:   listener.BindAddress,
+		BindPort:      listener.BindPort,
+		Options:       listener.Options,
+		UseProxyProto: listener.UseProxyProto,
+		Metadata:      matchedListener.Metadata,
+	}
+	switch listenerType := matchedListener.GetListenerType().(type) {
+	case *v1.MatchedListener_HttpListener:
+		inner.ListenerType = &v1.Listener_HttpListener{HttpListener: listenerType.HttpListener}
+	case *v1.MatchedListener_TcpListener:
+		inner.ListenerType = &v1.Listener_TcpListener{TcpListener: listenerType.TcpListener}
+	}
+	if sslConfig := matchedListener.GetMatcher().GetSslConfig(); sslConfig != nil {
+		inner.SslConfigurations = []*v1.SslConfig{sslConfig}
+	}
+	return inner
+}
+
+// applyMatcher restricts the filter chain to the connections satisfying the matcher.
+// Filter chains which don't terminate TLS yet, such as those of tcp listeners, do so with the ssl config of the matcher.
+func (t *translatorInstance) applyMatcher(snap *v1.ApiSnapshot, filterChain *envoylistener.FilterChain, matcher *v1.Matcher) error {
+	if filterChain.FilterChainMatch == nil {
+		filterChain.FilterChainMatch = &envoylistener.FilterChainMatch{}
+	}
+	for _, cidrRange := range matcher.GetSourcePrefixRanges() {
+		filterChain.FilterChainMatch.SourcePrefixRanges = append(filterChain.FilterChainMatch.SourcePrefixRanges, &envoycore.CidrRange{
+			AddressPrefix: cidrRange.GetAddressPrefix(),
+			PrefixLen:     gogoutils.UInt32GogoToProto(cidrRange.GetPrefixLen()),
+		})
+	}
+
+	sslConfig := matcher.GetSslConfig()
+	if sslConfig == nil {
+		return nil
+	}
+	if filterChain.TransportSocket == nil {
+		downstreamConfig, err := t.sslConfigTranslator.ResolveDownstreamSslConfig(snap.Secrets, sslConfig)
+		if err != nil {
+			return err
+		}
+		filterChain.TransportSocket = &envoycore.TransportSocket{
+			Name:       wellknown.TransportSocketTls,
+			ConfigType: &envoycore.TransportSocket_TypedConfig{TypedConfig: utils.MustMessageToAny(downstreamConfig)},
+		}
+		if len(filterChain.FilterChainMatch.ServerNames) == 0 {
+			filterChain.FilterChainMatch.ServerNames = sslConfig.SniDomains
+		}
+	}
+	// plaintext and tls connections may be served by different filter chains of the same hybrid listener
+	filterChain.FilterChainMatch.TransportProtocol = "tls"
+	return nil
+}
+
+func appendListenerFilter(listenerFilters []*envoylistener.ListenerFilter, listenerFilter *envoylistener.ListenerFilter) []*envoylistener.ListenerFilter {
+	for _, existing := range listenerFilters {
+		if existing.Name == listenerFilter.Name {
+			return listenerFilters
+		}
 	}
+	return append(listenerFilters, listenerFilter)
+}
 
-	// run the Listener Plugins
+func (t *translatorInstance) runListenerPlugins(params plugins.Params, listener *v1.Listener, out *envoyapi.Listener, listenerReport *validationapi.ListenerReport) {
 	for _, plug := range t.plugins {
 		listenerPlugin, ok := plug.(plugins.ListenerPlugin)
 		if !ok {
@@ -86,8 +219,6 @@ func (t *translatorInstance) computeListener(params plugins.Params, proxy *v1.Pr
 				err.Error())
 		}
 	}
-
-	return out
 }
 
 func (t *translatorInstance) computeListenerFilters(params plugins.Params, listener *v1.Listener, listenerReport *validationapi.ListenerReport) []*envoylistener.Filter {
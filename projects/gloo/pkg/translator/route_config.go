@@ -6,6 +6,7 @@ import (
 	"unicode"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/headers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/loadbalancer"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
@@ -20,6 +21,7 @@ import (
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type"
 	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher"
 	errors "github.com/rotisserie/eris"
 	regexutils "github.com/solo-io/gloo/pkg/utils/regexutils"
@@ -138,15 +140,80 @@ func (t *translatorInstance) computeVirtualHost(params plugins.VirtualHostParams
 
 func (t *translatorInstance) envoyRoutes(params plugins.RouteParams, routeReport *validationapi.RouteReport, in *v1.Route) []*envoyroute.Route {
 
-	out := initRoutes(params, in, routeReport)
+	var routes []*v1.Route
+	for i, route := range expandShadowedRoute(in) {
+		steeredRoutes, err := expandSubsetSteeredRoute(params.Snapshot, route)
+		// copies of the route share their subset steering, so only report its errors once
+		switch {
+		case i > 0:
+		case IsSubsetSteeringTooManyValuesError(err):
+			validation.AppendRouteWarning(routeReport,
+				validationapi.RouteReport_Warning_InvalidDestinationWarning,
+				err.Error(),
+			)
+		case err != nil:
+			validation.AppendRouteError(routeReport,
+				validationapi.RouteReport_Error_ProcessingError,
+				err.Error(),
+			)
+		}
+		routes = append(routes, steeredRoutes...)
+	}
 
-	for i := range out {
-		t.setAction(params, routeReport, in, out[i])
+	var out []*envoyroute.Route
+	for i, route := range routes {
+		report := routeReport
+		if i > 0 {
+			// copies of the route only differ in their shadowing and subsets, so only report the errors they
+			// do not share with the first one
+			report = &validationapi.RouteReport{}
+		}
+		routes := initRoutes(params, route, report)
+
+		for j := range routes {
+			t.setAction(params, report, route, routes[j])
+		}
+		out = append(out, routes...)
+		if i > 0 {
+			appendNewRouteReports(routeReport, report)
+		}
 	}
 
 	return out
 }
 
+// appends the errors and warnings of the report which the other report does not have yet
+func appendNewRouteReports(to, from *validationapi.RouteReport) {
+	for _, err := range from.GetErrors() {
+		if !containsRouteError(to.GetErrors(), err) {
+			to.Errors = append(to.Errors, err)
+		}
+	}
+	for _, warning := range from.GetWarnings() {
+		if !containsRouteWarning(to.GetWarnings(), warning) {
+			to.Warnings = append(to.Warnings, warning)
+		}
+	}
+}
+
+func containsRouteError(errs []*validationapi.RouteReport_Error, err *validationapi.RouteReport_Error) bool {
+	for _, other := range errs {
+		if other.GetType() == err.GetType() && other.GetReason() == err.GetReason() {
+			return true
+		}
+	}
+	return false
+}
+
+func containsRouteWarning(warnings []*validationapi.RouteReport_Warning, warning *validationapi.RouteReport_Warning) bool {
+	for _, other := range warnings {
+		if other.GetType() == warning.GetType() && other.GetReason() == warning.GetReason() {
+			return true
+		}
+	}
+	return false
+}
+
 // creates Envoy routes for each matcher provided on our Gateway route
 func initRoutes(params plugins.RouteParams, in *v1.Route, routeReport *validationapi.RouteReport) []*envoyroute.Route {
 	out := make([]*envoyroute.Route, len(in.Matchers))
@@ -168,6 +235,12 @@ func initRoutes(params plugins.RouteParams, in *v1.Route, routeReport *validatio
 				"no path specifier provided",
 			)
 		}
+		if percentage := matcher.GetPercentage(); percentage != nil && (percentage.GetValue() < 0 || percentage.GetValue() > 100) {
+			validation.AppendRouteError(routeReport,
+				validationapi.RouteReport_Error_InvalidMatcherError,
+				fmt.Sprintf("invalid percentage %v: must be between 0 and 100", percentage.GetValue()),
+			)
+		}
 		match := GlooMatcherToEnvoyMatcher(params.Params, matcher)
 		out[i] = &envoyroute.Route{
 			Match: &match,
@@ -194,6 +267,14 @@ func GlooMatcherToEnvoyMatcher(params plugins.Params, matcher *matchers.Matcher)
 			},
 		})
 	}
+	if percentage := matcher.GetPercentage(); percentage != nil {
+		match.RuntimeFraction = &envoycore.RuntimeFractionalPercent{
+			DefaultValue: &envoy_type.FractionalPercent{
+				Numerator:   uint32(percentage.GetValue() * 10000),
+				Denominator: envoy_type.FractionalPercent_MILLION,
+			},
+		}
+	}
 	// need to do this because Go's proto implementation makes oneofs private
 	// which genius thought of that?
 	setEnvoyPathMatcher(params, matcher, &match)
@@ -240,6 +321,14 @@ func (t *translatorInstance) setAction(params plugins.RouteParams, routeReport *
 				if isWarningErr(err) {
 					continue
 				}
+				// a hash policy on a route to upstreams without a hashing load balancer is ignored by envoy
+				if loadbalancer.IsHashingMisconfiguredError(err) {
+					validation.AppendRouteWarning(routeReport,
+						validationapi.RouteReport_Warning_InvalidDestinationWarning,
+						err.Error(),
+					)
+					continue
+				}
 				validation.AppendRouteError(routeReport,
 					validationapi.RouteReport_Error_ProcessingError,
 					fmt.Sprintf("%T: %v", routePlugin, err.Error()),
@@ -0,0 +1,91 @@
+package translator
+
+import (
+	"github.com/gogo/protobuf/proto"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
+)
+
+// Every set of headers of the shadow destinations needs its own copy of the route,
+// so limit the number of these sets to limit the number of routes.
+const MaxShadowHeaderSets = 4
+
+// Envoy cannot match headers for mirror policies, so split routes which shadow traffic
+// depending on headers into a copy for each set of headers of their destinations.
+// The copy for a set of headers matches these headers, and shadows traffic to the destinations
+// with these headers and to the destinations without headers. The copies come before the route,
+// which keeps the name of the route and only shadows traffic to the destinations without headers.
+func expandShadowedRoute(in *v1.Route) []*v1.Route {
+	spec := in.GetOptions().GetShadowing()
+
+	var headerSets [][]*matchers.HeaderMatcher
+	byHeaders := map[int][]*shadowing.ShadowDestination{}
+	var unconditional []*shadowing.ShadowDestination
+	for _, dest := range spec.GetDestinations() {
+		if len(dest.GetHeaders()) == 0 {
+			unconditional = append(unconditional, dest)
+			continue
+		}
+		i := headerSetIndex(headerSets, dest.GetHeaders())
+		if i == len(headerSets) {
+			headerSets = append(headerSets, dest.GetHeaders())
+		}
+		byHeaders[i] = append(byHeaders[i], dest)
+	}
+	// the shadowing plugin reports routes with too many sets of headers
+	if len(headerSets) == 0 || len(headerSets) > MaxShadowHeaderSets {
+		return []*v1.Route{in}
+	}
+
+	var out []*v1.Route
+	for i, headers := range headerSets {
+		route := proto.Clone(in).(*v1.Route)
+		destinations := append([]*shadowing.ShadowDestination{}, unconditional...)
+		for _, dest := range byHeaders[i] {
+			dest = proto.Clone(dest).(*shadowing.ShadowDestination)
+			dest.Headers = nil
+			destinations = append(destinations, dest)
+		}
+		route.Options.Shadowing.Destinations = destinations
+		route.Matchers = matchersWithHeaders(route.Matchers, headers)
+		out = append(out, route)
+	}
+
+	route := proto.Clone(in).(*v1.Route)
+	route.Options.Shadowing.Destinations = unconditional
+	if route.Options.Shadowing.Upstream == nil && len(unconditional) == 0 {
+		route.Options.Shadowing = nil
+	}
+	return append(out, route)
+}
+
+func headerSetIndex(sets [][]*matchers.HeaderMatcher, headers []*matchers.HeaderMatcher) int {
+	for i, set := range sets {
+		if len(set) != len(headers) {
+			continue
+		}
+		equal := true
+		for j := range set {
+			if !set[j].Equal(headers[j]) {
+				equal = false
+				break
+			}
+		}
+		if equal {
+			return i
+		}
+	}
+	return len(sets)
+}
+
+func matchersWithHeaders(in []*matchers.Matcher, headers []*matchers.HeaderMatcher) []*matchers.Matcher {
+	if len(in) == 0 {
+		// routes without matchers match all requests
+		in = []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}}}
+	}
+	for _, matcher := range in {
+		matcher.Headers = append(matcher.Headers, headers...)
+	}
+	return in
+}
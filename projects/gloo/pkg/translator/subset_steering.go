@@ -0,0 +1,250 @@
+package translator
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	usconversion "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// The maximum number of values of the subset key that a route with subset steering is expanded for.
+const MaxSubsetSteeringValues = 32
+
+var (
+	SubsetSteeringMissingSourceErr     = errors.New("subset steering must specify a header or a query parameter")
+	SubsetSteeringMissingKeyErr        = errors.New("subset steering must specify a subset key")
+	SubsetSteeringMissingDefaultErr    = errors.New("subset steering with the DEFAULT_SUBSET fallback policy must specify a default subset")
+	SubsetSteeringInvalidActionErr     = errors.New("subset steering requires a route action with upstream destinations")
+	SubsetSteeringMissingSelectorError = func(upstream core.ResourceRef, keys []string) error {
+		return errors.Errorf("subset steering requires upstream %v to have a subset selector with the keys %v", upstream.Key(), keys)
+	}
+)
+
+// The values of the subset key beyond the maximum are handled by the fallback policy. This is reported as a warning on
+// the route.
+type SubsetSteeringTooManyValuesError struct {
+	values int
+}
+
+func (e *SubsetSteeringTooManyValuesError) Error() string {
+	return fmt.Sprintf("the endpoints have %d values of the subset key, but subset steering only routes the first %d to "+
+		"their subsets: the requests with the other values are handled by the fallback policy", e.values, MaxSubsetSteeringValues)
+}
+
+func IsSubsetSteeringTooManyValuesError(err error) bool {
+	_, ok := err.(*SubsetSteeringTooManyValuesError)
+	return ok
+}
+
+// Envoy cannot pick the subset of a route from the headers of the requests, so split routes with subset steering into
+// a copy for each value of the subset key among the endpoints of their upstreams. Each copy matches the value in the
+// header or query parameter, and routes to the subset with the value. The copies are followed by the route for the
+// requests matching no value, as configured by the fallback policy. Only the first MaxSubsetSteeringValues values are
+// expanded, so that the number of routes stays bounded.
+func expandSubsetSteeredRoute(snap *v1.ApiSnapshot, in *v1.Route) ([]*v1.Route, error) {
+	steering := in.GetOptions().GetSubsetSteering()
+	if steering == nil {
+		return []*v1.Route{in}, nil
+	}
+	if err := validateSubsetSteering(steering); err != nil {
+		return []*v1.Route{in}, err
+	}
+	destinations, err := steeredDestinations(snap, in)
+	if err != nil {
+		return []*v1.Route{in}, err
+	}
+	for _, dest := range destinations {
+		if err := checkSteeredUpstream(snap, dest, steering.GetKey()); err != nil {
+			return []*v1.Route{in}, err
+		}
+	}
+
+	var warning error
+	values := subsetValues(snap, destinations, steering.GetKey())
+	if len(values) > MaxSubsetSteeringValues {
+		warning = &SubsetSteeringTooManyValuesError{values: len(values)}
+		values = values[:MaxSubsetSteeringValues]
+	}
+
+	var out []*v1.Route
+	for _, value := range values {
+		route := routeWithSubset(in, destinations, map[string]string{steering.GetKey(): value})
+		route.Matchers = matchersWithSteeringValue(route.Matchers, steering, value)
+		if route.Name != "" {
+			route.Name = fmt.Sprintf("%s-subset-%d", route.Name, len(out))
+		}
+		out = append(out, route)
+	}
+
+	switch steering.GetFallbackPolicy() {
+	case v1.SubsetSteering_ANY_ENDPOINT:
+		out = append(out, withoutSubsetSteering(in))
+	case v1.SubsetSteering_DEFAULT_SUBSET:
+		out = append(out, routeWithSubset(in, destinations, steering.GetDefaultSubset().GetValues()))
+	case v1.SubsetSteering_NO_FALLBACK:
+		route := withoutSubsetSteering(in)
+		route.Matchers = matchersWithSteeringValue(route.Matchers, steering, "")
+		route.Action = &v1.Route_DirectResponseAction{
+			DirectResponseAction: &v1.DirectResponseAction{Status: http.StatusServiceUnavailable},
+		}
+		if route.Name != "" {
+			route.Name = fmt.Sprintf("%s-subset-fallback", route.Name)
+		}
+		out = append(out, route, withoutSubsetSteering(in))
+	}
+	return out, warning
+}
+
+func validateSubsetSteering(steering *v1.SubsetSteering) error {
+	if steering.GetHeader() == "" && steering.GetQueryParameter() == "" {
+		return SubsetSteeringMissingSourceErr
+	}
+	if steering.GetKey() == "" {
+		return SubsetSteeringMissingKeyErr
+	}
+	if steering.GetFallbackPolicy() == v1.SubsetSteering_DEFAULT_SUBSET && len(steering.GetDefaultSubset().GetValues()) == 0 {
+		return SubsetSteeringMissingDefaultErr
+	}
+	return nil
+}
+
+// Returns the destinations of the route. The destinations of upstream groups are resolved, so that their subsets can be
+// set on the copies of the route.
+func steeredDestinations(snap *v1.ApiSnapshot, in *v1.Route) ([]*v1.WeightedDestination, error) {
+	switch dest := in.GetRouteAction().GetDestination().(type) {
+	case *v1.RouteAction_Single:
+		return []*v1.WeightedDestination{{Destination: dest.Single}}, nil
+	case *v1.RouteAction_Multi:
+		return dest.Multi.GetDestinations(), nil
+	case *v1.RouteAction_UpstreamGroup:
+		upstreamGroup, err := snap.UpstreamGroups.Find(dest.UpstreamGroup.Namespace, dest.UpstreamGroup.Name)
+		if err != nil {
+			// missing upstream groups are reported by the translator
+			return nil, nil
+		}
+		return upstreamGroup.GetDestinations(), nil
+	}
+	return nil, SubsetSteeringInvalidActionErr
+}
+
+// The upstream of the destination must have a subset selector with the steered key and the keys of the subset of the
+// destination, otherwise the copies of the route would route to no subset.
+func checkSteeredUpstream(snap *v1.ApiSnapshot, dest *v1.WeightedDestination, key string) error {
+	ref, err := usconversion.DestinationToUpstreamRef(dest.GetDestination())
+	if err != nil {
+		return err
+	}
+	upstream, err := snap.Upstreams.Find(ref.Namespace, ref.Name)
+	if err != nil {
+		// missing upstreams are reported by the translator
+		return nil
+	}
+
+	keys := []string{key}
+	for k := range dest.GetDestination().GetSubset().GetValues() {
+		if k != key {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+Selectors:
+	for _, selector := range getSubsets(upstream).GetSelectors() {
+		if len(selector.GetKeys()) != len(keys) {
+			continue
+		}
+		for _, k := range selector.GetKeys() {
+			if i := sort.SearchStrings(keys, k); i == len(keys) || keys[i] != k {
+				continue Selectors
+			}
+		}
+		return nil
+	}
+	return SubsetSteeringMissingSelectorError(*ref, keys)
+}
+
+// Returns the sorted values of the key among the endpoints of the upstreams of the destinations.
+func subsetValues(snap *v1.ApiSnapshot, destinations []*v1.WeightedDestination, key string) []string {
+	upstreams := map[core.ResourceRef]bool{}
+	for _, dest := range destinations {
+		if ref, err := usconversion.DestinationToUpstreamRef(dest.GetDestination()); err == nil {
+			upstreams[*ref] = true
+		}
+	}
+
+	valueSet := map[string]bool{}
+	for _, endpoint := range snap.Endpoints {
+		value, ok := endpoint.GetMetadata().Labels[key]
+		if !ok {
+			continue
+		}
+		for _, ref := range endpoint.GetUpstreams() {
+			if ref != nil && upstreams[*ref] {
+				valueSet[value] = true
+				break
+			}
+		}
+	}
+
+	var values []string
+	for value := range valueSet {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return values
+}
+
+// Returns a copy of the route whose destinations have the given subset values merged into their subsets.
+func routeWithSubset(in *v1.Route, destinations []*v1.WeightedDestination, values map[string]string) *v1.Route {
+	route := withoutSubsetSteering(in)
+	var subsetDestinations []*v1.WeightedDestination
+	for _, dest := range destinations {
+		dest = proto.Clone(dest).(*v1.WeightedDestination)
+		subset := map[string]string{}
+		for k, v := range dest.GetDestination().GetSubset().GetValues() {
+			subset[k] = v
+		}
+		for k, v := range values {
+			subset[k] = v
+		}
+		dest.Destination.Subset = &v1.Subset{Values: subset}
+		subsetDestinations = append(subsetDestinations, dest)
+	}
+
+	if single := route.GetRouteAction().GetSingle(); single != nil {
+		single.Subset = subsetDestinations[0].GetDestination().GetSubset()
+	} else {
+		route.GetRouteAction().Destination = &v1.RouteAction_Multi{
+			Multi: &v1.MultiDestination{Destinations: subsetDestinations},
+		}
+	}
+	return route
+}
+
+func withoutSubsetSteering(in *v1.Route) *v1.Route {
+	route := proto.Clone(in).(*v1.Route)
+	route.Options.SubsetSteering = nil
+	return route
+}
+
+// Adds the header or query parameter matcher of the value to the matchers. An empty value matches any value.
+func matchersWithSteeringValue(in []*matchers.Matcher, steering *v1.SubsetSteering, value string) []*matchers.Matcher {
+	if header := steering.GetHeader(); header != "" {
+		return matchersWithHeaders(in, []*matchers.HeaderMatcher{{Name: header, Value: value}})
+	}
+	if len(in) == 0 {
+		in = []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}}}
+	}
+	for _, matcher := range in {
+		matcher.QueryParameters = append(matcher.QueryParameters, &matchers.QueryParameterMatcher{
+			Name:  steering.GetQueryParameter(),
+			Value: value,
+		})
+	}
+	return in
+}
@@ -1,8 +1,6 @@
 package translator
 
 import (
-	"fmt"
-
 	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -15,7 +13,6 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
-	"github.com/mitchellh/hashstructure"
 	errors "github.com/rotisserie/eris"
 	"go.opencensus.io/trace"
 )
@@ -133,9 +130,7 @@ ClusterLoop:
 		envoyResources := t.computeListenerResources(params, proxy, listener, listenerReport)
 		if envoyResources != nil {
 			listeners = append(listeners, envoyResources.listener)
-			if envoyResources.routeConfig != nil {
-				routeConfigs = append(routeConfigs, envoyResources.routeConfig)
-			}
+			routeConfigs = append(routeConfigs, envoyResources.routeConfigs...)
 		}
 	}
 
@@ -171,8 +166,8 @@ ClusterLoop:
 // the set of resources returned by one iteration for a single v1.Listener
 // the top level Translate function should aggregate these into a finished snapshot
 type listenerResources struct {
-	routeConfig *envoyapi.RouteConfiguration
-	listener    *envoyapi.Listener
+	routeConfigs []*envoyapi.RouteConfiguration
+	listener     *envoyapi.Listener
 }
 
 func (t *translatorInstance) computeListenerResources(params plugins.Params, proxy *v1.Proxy, listener *v1.Listener, listenerReport *validationapi.ListenerReport) *listenerResources {
@@ -180,10 +175,20 @@ func (t *translatorInstance) computeListenerResources(params plugins.Params, pro
 	params.Ctx = ctx
 	defer span.End()
 
-	rdsName := routeConfigName(listener)
-
 	// Calculate routes before listeners, so that HttpFilters is called after ProcessVirtualHost\ProcessRoute
-	routeConfig := t.computeRouteConfig(params, proxy, listener, rdsName, listenerReport)
+	var routeConfigs []*envoyapi.RouteConfiguration
+	if hybridListener := listener.GetHybridListener(); hybridListener != nil {
+		// each matched http listener has its own route configuration
+		for i, matchedListener := range hybridListener.GetMatchedListeners() {
+			inner := matchedListenerToListener(listener, matchedListener)
+			matchedListenerReport := listenerReport.GetHybridListenerReport().GetMatchedListenerReports()[i]
+			if routeConfig := t.computeRouteConfig(params, proxy, inner, routeConfigName(inner), matchedListenerReport); routeConfig != nil {
+				routeConfigs = append(routeConfigs, routeConfig)
+			}
+		}
+	} else if routeConfig := t.computeRouteConfig(params, proxy, listener, routeConfigName(listener), listenerReport); routeConfig != nil {
+		routeConfigs = append(routeConfigs, routeConfig)
+	}
 
 	envoyListener := t.computeListener(params, proxy, listener, listenerReport)
 	if envoyListener == nil {
@@ -191,8 +196,8 @@ func (t *translatorInstance) computeListenerResources(params plugins.Params, pro
 	}
 
 	return &listenerResources{
-		listener:    envoyListener,
-		routeConfig: routeConfig,
+		listener:     envoyListener,
+		routeConfigs: routeConfigs,
 	}
 }
 
@@ -216,34 +221,24 @@ func generateXDSSnapshot(clusters []*envoyapi.Cluster,
 		}
 		listenersProto = append(listenersProto, xds.NewEnvoyResource(listener))
 	}
-	// construct version
-	// TODO: investigate whether we need a more sophisticated versioning algorithm
-	endpointsVersion, err := hashstructure.Hash(endpointsProto, nil)
+	// versions are derived from the content hash of each resource, so a type version
+	// only changes if one of its resources was added, removed or changed
+	snapshot, err := xds.NewHashedSnapshot(endpointsProto, clustersProto, makeRdsProto(routeConfigs), listenersProto)
 	if err != nil {
-		panic(errors.Wrap(err, "constructing version hash for endpoints envoy snapshot components"))
-	}
-
-	clustersVersion, err := hashstructure.Hash(clustersProto, nil)
-	if err != nil {
-		panic(errors.Wrap(err, "constructing version hash for clusters envoy snapshot components"))
+		panic(errors.Wrap(err, "constructing version hash for envoy snapshot components"))
 	}
+	return snapshot
+}
 
-	listenersVersion, err := hashstructure.Hash(listenersProto, nil)
+func MakeRdsResources(routeConfigs []*envoyapi.RouteConfiguration) envoycache.Resources {
+	routes, err := xds.NewHashedResources(makeRdsProto(routeConfigs))
 	if err != nil {
-		panic(errors.Wrap(err, "constructing version hash for listeners envoy snapshot components"))
+		panic(errors.Wrap(err, "constructing version hash for routes envoy snapshot components"))
 	}
-
-	// if clusters are updated, provider a new version of the endpoints,
-	// so the clusters are warm
-	return xds.NewSnapshotFromResources(
-		envoycache.NewResources(fmt.Sprintf("%v-%v", clustersVersion, endpointsVersion), endpointsProto),
-		envoycache.NewResources(fmt.Sprintf("%v", clustersVersion), clustersProto),
-		MakeRdsResources(routeConfigs),
-		envoycache.NewResources(fmt.Sprintf("%v", listenersVersion), listenersProto))
-
+	return routes
 }
 
-func MakeRdsResources(routeConfigs []*envoyapi.RouteConfiguration) envoycache.Resources {
+func makeRdsProto(routeConfigs []*envoyapi.RouteConfiguration) []envoycache.Resource {
 	var routesProto []envoycache.Resource
 
 	for _, routeCfg := range routeConfigs {
@@ -253,10 +248,5 @@ func MakeRdsResources(routeConfigs []*envoyapi.RouteConfiguration) envoycache.Re
 		}
 		routesProto = append(routesProto, xds.NewEnvoyResource(routeCfg))
 	}
-
-	routesVersion, err := hashstructure.Hash(routesProto, nil)
-	if err != nil {
-		panic(errors.Wrap(err, "constructing version hash for routes envoy snapshot components"))
-	}
-	return envoycache.NewResources(fmt.Sprintf("%v", routesVersion), routesProto)
+	return routesProto
 }
@@ -21,11 +21,14 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	gloo_envoy_core "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
+	envoycorev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
 	consul2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/hcm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
 	mock_consul "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
 	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 
@@ -46,6 +49,7 @@ import (
 	"github.com/solo-io/gloo/pkg/utils"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -533,6 +537,107 @@ var _ = Describe("Translator", func() {
 				Expect(fooRoute).To(Equal(barRoute))
 			})
 		})
+
+		Context("matcher percentage", func() {
+			It("should translate the percentage to a runtime fraction", func() {
+				matcher.Percentage = &types.FloatValue{Value: 12.5}
+				translate()
+
+				Expect(routeConfiguration.VirtualHosts[0].Routes[0].Match.RuntimeFraction).To(Equal(&envoycore.RuntimeFractionalPercent{
+					DefaultValue: &envoy_type.FractionalPercent{
+						Numerator:   125000,
+						Denominator: envoy_type.FractionalPercent_MILLION,
+					},
+				}))
+			})
+
+			It("should error when the percentage is out of range", func() {
+				matcher.Percentage = &types.FloatValue{Value: 120}
+				_, errs, _, err := translator.Translate(params, proxy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(errs.Validate()).To(HaveOccurred())
+				Expect(errs.Validate().Error()).To(ContainSubstring("Route Error: InvalidMatcherError. Reason: invalid percentage 120: must be between 0 and 100"))
+			})
+		})
+	})
+
+	Context("route shadowing", func() {
+
+		It("shadows traffic to multiple destinations from a single route", func() {
+			routes[0].Options = &v1.RouteOptions{
+				Shadowing: &shadowing.RouteShadowing{
+					Destinations: []*shadowing.ShadowDestination{
+						{Upstream: &core.ResourceRef{Name: "staging", Namespace: "gloo-system"}, Percentage: 100},
+						{Upstream: &core.ResourceRef{Name: "recorder", Namespace: "gloo-system"}, Percentage: 10},
+					},
+				},
+			}
+			translate()
+
+			envoyRoutes := routeConfiguration.VirtualHosts[0].Routes
+			Expect(envoyRoutes).To(HaveLen(1))
+			policies := envoyRoutes[0].GetRoute().GetRequestMirrorPolicies()
+			Expect(policies).To(HaveLen(2))
+			Expect(policies[0].GetCluster()).To(Equal("staging_gloo-system"))
+			Expect(policies[0].GetRuntimeFraction().GetDefaultValue().GetNumerator()).To(BeEquivalentTo(1000000))
+			Expect(policies[1].GetCluster()).To(Equal("recorder_gloo-system"))
+			Expect(policies[1].GetRuntimeFraction().GetDefaultValue().GetNumerator()).To(BeEquivalentTo(100000))
+		})
+
+		It("only shadows the requests matching the headers of a destination to it", func() {
+			mirror := []*matchers.HeaderMatcher{{Name: "x-mirror", Value: "true"}}
+			routes[0].Options = &v1.RouteOptions{
+				Shadowing: &shadowing.RouteShadowing{
+					Destinations: []*shadowing.ShadowDestination{
+						{Upstream: &core.ResourceRef{Name: "staging", Namespace: "gloo-system"}, Percentage: 100, Headers: mirror},
+						{Upstream: &core.ResourceRef{Name: "recorder", Namespace: "gloo-system"}, Percentage: 10},
+						{Upstream: &core.ResourceRef{Name: "debug", Namespace: "gloo-system"}, Percentage: 100, Headers: mirror},
+					},
+				},
+			}
+			translate()
+
+			envoyRoutes := routeConfiguration.VirtualHosts[0].Routes
+			Expect(envoyRoutes).To(HaveLen(2))
+			for _, route := range envoyRoutes {
+				Expect(route.GetName()).To(Equal("testRouteName-0"))
+			}
+
+			// the requests with the headers are shadowed to all the destinations
+			Expect(envoyRoutes[0].GetMatch().GetHeaders()).To(HaveLen(1))
+			Expect(envoyRoutes[0].GetMatch().GetHeaders()[0].GetName()).To(Equal("x-mirror"))
+			var clusters []string
+			for _, policy := range envoyRoutes[0].GetRoute().GetRequestMirrorPolicies() {
+				clusters = append(clusters, policy.GetCluster())
+			}
+			Expect(clusters).To(Equal([]string{"recorder_gloo-system", "staging_gloo-system", "debug_gloo-system"}))
+
+			// the other requests are only shadowed to the destination without headers
+			Expect(envoyRoutes[1].GetMatch().GetHeaders()).To(BeEmpty())
+			policies := envoyRoutes[1].GetRoute().GetRequestMirrorPolicies()
+			Expect(policies).To(HaveLen(1))
+			Expect(policies[0].GetCluster()).To(Equal("recorder_gloo-system"))
+		})
+
+		It("does not shadow the requests which do not match the headers of any destination", func() {
+			routes[0].Options = &v1.RouteOptions{
+				Shadowing: &shadowing.RouteShadowing{
+					Destinations: []*shadowing.ShadowDestination{{
+						Upstream:   &core.ResourceRef{Name: "staging", Namespace: "gloo-system"},
+						Percentage: 100,
+						Headers:    []*matchers.HeaderMatcher{{Name: "x-mirror", Value: "true"}},
+					}},
+				},
+			}
+			translate()
+
+			envoyRoutes := routeConfiguration.VirtualHosts[0].Routes
+			Expect(envoyRoutes).To(HaveLen(2))
+			Expect(envoyRoutes[0].GetRoute().GetRequestMirrorPolicies()).To(HaveLen(1))
+			Expect(envoyRoutes[1].GetMatch().GetHeaders()).To(BeEmpty())
+			Expect(envoyRoutes[1].GetRoute().GetRequestMirrorPolicies()).To(BeEmpty())
+			Expect(envoyRoutes[1].GetRoute().GetRequestMirrorPolicy()).To(BeNil())
+		})
 	})
 
 	Context("Health check config", func() {
@@ -610,6 +715,101 @@ var _ = Describe("Translator", func() {
 			Expect(cluster.HealthChecks).To(BeEquivalentTo(expectedResult))
 		})
 
+		It("can translate the tcp health check", func() {
+			expectedResult := []*envoycore.HealthCheck{
+				{
+					Timeout:            gogoutils.DurationStdToProto(&DefaultHealthCheckTimeout),
+					Interval:           gogoutils.DurationStdToProto(&DefaultHealthCheckInterval),
+					HealthyThreshold:   gogoutils.UInt32GogoToProto(DefaultThreshold),
+					UnhealthyThreshold: gogoutils.UInt32GogoToProto(DefaultThreshold),
+					HealthChecker: &envoycore.HealthCheck_TcpHealthCheck_{
+						TcpHealthCheck: &envoycore.HealthCheck_TcpHealthCheck{
+							Send: &envoycore.HealthCheck_Payload{
+								Payload: &envoycore.HealthCheck_Payload_Text{Text: "50494e47"},
+							},
+							Receive: []*envoycore.HealthCheck_Payload{{
+								Payload: &envoycore.HealthCheck_Payload_Text{Text: "504f4e47"},
+							}},
+						},
+					},
+				},
+			}
+			var err error
+			upstream.HealthChecks, err = gogoutils.ToGlooHealthCheckList(expectedResult)
+			Expect(err).NotTo(HaveOccurred())
+			translate()
+			Expect(cluster.HealthChecks).To(BeEquivalentTo(expectedResult))
+		})
+
+		Context("validation", func() {
+
+			var healthCheck *envoycore.HealthCheck
+
+			BeforeEach(func() {
+				healthCheck = &envoycore.HealthCheck{
+					Timeout:            gogoutils.DurationStdToProto(&DefaultHealthCheckTimeout),
+					Interval:           gogoutils.DurationStdToProto(&DefaultHealthCheckInterval),
+					HealthyThreshold:   gogoutils.UInt32GogoToProto(DefaultThreshold),
+					UnhealthyThreshold: gogoutils.UInt32GogoToProto(DefaultThreshold),
+					HealthChecker: &envoycore.HealthCheck_HttpHealthCheck_{
+						HttpHealthCheck: &envoycore.HealthCheck_HttpHealthCheck{
+							Path:             "/health",
+							ExpectedStatuses: []*envoy_type.Int64Range{{Start: 200, End: 300}},
+						},
+					},
+				}
+			})
+
+			expectInvalid := func(message string) {
+				var err error
+				upstream.HealthChecks, err = gogoutils.ToGlooHealthCheckList([]*envoycore.HealthCheck{healthCheck})
+				Expect(err).NotTo(HaveOccurred())
+				_, errs, _, err := translator.Translate(params, proxy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(errs.Validate()).To(MatchError(ContainSubstring("invalid HealthCheck[0]")))
+				Expect(errs.Validate()).To(MatchError(ContainSubstring(message)))
+			}
+
+			It("accepts a valid http health check", func() {
+				var err error
+				upstream.HealthChecks, err = gogoutils.ToGlooHealthCheckList([]*envoycore.HealthCheck{healthCheck})
+				Expect(err).NotTo(HaveOccurred())
+				translate()
+				Expect(cluster.HealthChecks).To(HaveLen(1))
+			})
+
+			It("requires an interval", func() {
+				healthCheck.Interval = nil
+				expectInvalid("Interval")
+			})
+
+			It("requires an http path", func() {
+				healthCheck.GetHttpHealthCheck().Path = ""
+				expectInvalid("Path")
+			})
+
+			It("rejects expected statuses which are not http statuses", func() {
+				healthCheck.GetHttpHealthCheck().ExpectedStatuses = []*envoy_type.Int64Range{{Start: 200, End: 700}}
+				expectInvalid("invalid expected status range [200, 700)")
+			})
+
+			It("rejects empty expected status ranges", func() {
+				healthCheck.GetHttpHealthCheck().ExpectedStatuses = []*envoy_type.Int64Range{{Start: 200, End: 200}}
+				expectInvalid("invalid expected status range [200, 200)")
+			})
+
+			It("rejects tcp payloads which are not hex encoded", func() {
+				healthCheck.HealthChecker = &envoycore.HealthCheck_TcpHealthCheck_{
+					TcpHealthCheck: &envoycore.HealthCheck_TcpHealthCheck{
+						Send: &envoycore.HealthCheck_Payload{
+							Payload: &envoycore.HealthCheck_Payload_Text{Text: "PING"},
+						},
+					},
+				}
+				expectInvalid("text payloads must be hex encoded")
+			})
+		})
+
 		It("can properly translate outlier detection config", func() {
 			dur := &duration.Duration{Seconds: 1}
 			expectedResult := &envoycluster.OutlierDetection{
@@ -641,6 +841,60 @@ var _ = Describe("Translator", func() {
 			Expect(report).To(Equal(validationutils.MakeReport(proxy)))
 		})
 
+		It("can translate failure percentage outlier detection", func() {
+			expectedResult := &envoycluster.OutlierDetection{
+				Interval:                       &duration.Duration{Seconds: 1},
+				FailurePercentageThreshold:     &wrappers.UInt32Value{Value: 50},
+				EnforcingFailurePercentage:     &wrappers.UInt32Value{Value: 100},
+				FailurePercentageMinimumHosts:  &wrappers.UInt32Value{Value: 3},
+				FailurePercentageRequestVolume: &wrappers.UInt32Value{Value: 10},
+			}
+			upstream.OutlierDetection = gogoutils.ToGlooOutlierDetection(expectedResult)
+			translate()
+			Expect(cluster.OutlierDetection).To(BeEquivalentTo(expectedResult))
+		})
+
+		It("rejects outlier detection with invalid percentages", func() {
+			upstream.OutlierDetection = gogoutils.ToGlooOutlierDetection(&envoycluster.OutlierDetection{
+				Interval:                   &duration.Duration{Seconds: 1},
+				EnforcingFailurePercentage: &wrappers.UInt32Value{Value: 101},
+			})
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs.Validate()).To(MatchError(ContainSubstring("invalid OutlierDetection")))
+		})
+
+		It("should translate outlier detection on settings", func() {
+			expectedResult := &envoycluster.OutlierDetection{
+				Interval:                  &duration.Duration{Seconds: 10},
+				Consecutive_5Xx:           &wrappers.UInt32Value{Value: 5},
+				ConsecutiveGatewayFailure: &wrappers.UInt32Value{Value: 3},
+				BaseEjectionTime:          &duration.Duration{Seconds: 30},
+				MaxEjectionPercent:        &wrappers.UInt32Value{Value: 50},
+			}
+			settings.Gloo = &v1.GlooOptions{
+				OutlierDetection: gogoutils.ToGlooOutlierDetection(expectedResult),
+			}
+			translate()
+			Expect(cluster.OutlierDetection).To(BeEquivalentTo(expectedResult))
+		})
+
+		It("should override outlier detection on upstream", func() {
+			settings.Gloo = &v1.GlooOptions{
+				OutlierDetection: gogoutils.ToGlooOutlierDetection(&envoycluster.OutlierDetection{
+					Interval:        &duration.Duration{Seconds: 10},
+					Consecutive_5Xx: &wrappers.UInt32Value{Value: 5},
+				}),
+			}
+			expectedResult := &envoycluster.OutlierDetection{
+				Interval:             &duration.Duration{Seconds: 1},
+				EnforcingSuccessRate: &wrappers.UInt32Value{Value: 100},
+			}
+			upstream.OutlierDetection = gogoutils.ToGlooOutlierDetection(expectedResult)
+			translate()
+			Expect(cluster.OutlierDetection).To(BeEquivalentTo(expectedResult))
+		})
+
 		It("can translate health check with secret header", func() {
 			params.Snapshot.Secrets = v1.SecretList{
 				{
@@ -1362,6 +1616,126 @@ var _ = Describe("Translator", func() {
 				Expect(report).To(Equal(expectedReport))
 			})
 		})
+
+		Context("subset steering", func() {
+
+			var steering *v1.SubsetSteering
+
+			BeforeEach(func() {
+				ref := upstream.Metadata.Ref()
+				params.Snapshot.Endpoints = append(params.Snapshot.Endpoints, &v1.Endpoint{
+					Metadata: core.Metadata{
+						Name:      "test2",
+						Namespace: "gloo-system",
+						Labels:    map[string]string{"testkey": "othervalue"},
+					},
+					Upstreams: []*core.ResourceRef{&ref},
+					Address:   "1.2.3.5",
+					Port:      1234,
+				})
+				steering = &v1.SubsetSteering{
+					Source: &v1.SubsetSteering_Header{Header: "x-version"},
+					Key:    "testkey",
+				}
+				routes[0].GetRouteAction().GetSingle().Subset = nil
+				routes[0].Options = &v1.RouteOptions{SubsetSteering: steering}
+			})
+
+			subsetValue := func(route *envoyrouteapi.Route) string {
+				return route.GetRoute().GetMetadataMatch().GetFilterMetadata()["envoy.lb"].GetFields()["testkey"].GetStringValue()
+			}
+
+			It("routes each value of the header to its subset", func() {
+				translate()
+
+				envoyRoutes := routeConfiguration.VirtualHosts[0].Routes
+				Expect(envoyRoutes).To(HaveLen(3))
+				for i, value := range []string{"othervalue", "testvalue"} {
+					Expect(envoyRoutes[i].Match.Headers).To(ContainElement(&envoyrouteapi.HeaderMatcher{
+						Name:                 "x-version",
+						HeaderMatchSpecifier: &envoyrouteapi.HeaderMatcher_ExactMatch{ExactMatch: value},
+					}))
+					Expect(subsetValue(envoyRoutes[i])).To(Equal(value))
+				}
+				// the requests without a known value are sent to any endpoint
+				Expect(envoyRoutes[2].Match.Headers).To(BeEmpty())
+				Expect(envoyRoutes[2].GetRoute().GetMetadataMatch()).To(BeNil())
+			})
+
+			It("routes each value of the query parameter to its subset", func() {
+				steering.Source = &v1.SubsetSteering_QueryParameter{QueryParameter: "version"}
+				translate()
+
+				envoyRoutes := routeConfiguration.VirtualHosts[0].Routes
+				Expect(envoyRoutes).To(HaveLen(3))
+				Expect(envoyRoutes[1].Match.QueryParameters).To(HaveLen(1))
+				Expect(envoyRoutes[1].Match.QueryParameters[0].Name).To(Equal("version"))
+				Expect(envoyRoutes[1].Match.QueryParameters[0].GetStringMatch().GetExact()).To(Equal("testvalue"))
+				Expect(subsetValue(envoyRoutes[1])).To(Equal("testvalue"))
+			})
+
+			It("routes the requests without a known value to the default subset", func() {
+				steering.FallbackPolicy = v1.SubsetSteering_DEFAULT_SUBSET
+				steering.DefaultSubset = &v1.Subset{Values: map[string]string{"testkey": "testvalue"}}
+				translate()
+
+				envoyRoutes := routeConfiguration.VirtualHosts[0].Routes
+				Expect(envoyRoutes).To(HaveLen(3))
+				Expect(envoyRoutes[2].Match.Headers).To(BeEmpty())
+				Expect(subsetValue(envoyRoutes[2])).To(Equal("testvalue"))
+			})
+
+			It("responds 503 to the requests with an unknown value", func() {
+				steering.FallbackPolicy = v1.SubsetSteering_NO_FALLBACK
+				translate()
+
+				envoyRoutes := routeConfiguration.VirtualHosts[0].Routes
+				Expect(envoyRoutes).To(HaveLen(4))
+				Expect(envoyRoutes[2].Match.Headers).To(ContainElement(&envoyrouteapi.HeaderMatcher{
+					Name:                 "x-version",
+					HeaderMatchSpecifier: &envoyrouteapi.HeaderMatcher_PresentMatch{PresentMatch: true},
+				}))
+				Expect(envoyRoutes[2].GetDirectResponse().GetStatus()).To(BeEquivalentTo(503))
+				Expect(envoyRoutes[3].Match.Headers).To(BeEmpty())
+				Expect(envoyRoutes[3].GetRoute()).NotTo(BeNil())
+			})
+
+			It("only routes the first values to their subsets and warns about the others", func() {
+				ref := upstream.Metadata.Ref()
+				for i := 0; i < MaxSubsetSteeringValues; i++ {
+					params.Snapshot.Endpoints = append(params.Snapshot.Endpoints, &v1.Endpoint{
+						Metadata: core.Metadata{
+							Name:      fmt.Sprintf("value-%02d", i),
+							Namespace: "gloo-system",
+							Labels:    map[string]string{"testkey": fmt.Sprintf("value-%02d", i)},
+						},
+						Upstreams: []*core.ResourceRef{&ref},
+						Address:   "1.2.3.6",
+						Port:      1234,
+					})
+				}
+
+				snap, errs, report, err := translator.Translate(params, proxy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(errs.Validate()).NotTo(HaveOccurred())
+				warnings := report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()[0].GetWarnings()
+				Expect(warnings).To(HaveLen(1))
+				Expect(warnings[0].GetReason()).To(ContainSubstring(fmt.Sprintf("the endpoints have %d values of the subset key", MaxSubsetSteeringValues+2)))
+
+				envoyRoutes := snap.GetResources(xds.RouteType).Items["http-listener-routes"].ResourceProto().(*envoyapi.RouteConfiguration).VirtualHosts[0].Routes
+				Expect(envoyRoutes).To(HaveLen(MaxSubsetSteeringValues + 1))
+				Expect(subsetValue(envoyRoutes[MaxSubsetSteeringValues-1])).To(Equal(fmt.Sprintf("value-%02d", MaxSubsetSteeringValues-3)))
+				Expect(envoyRoutes[MaxSubsetSteeringValues].Match.Headers).To(BeEmpty())
+			})
+
+			It("errors when the upstream has no subset selector with the key", func() {
+				steering.Key = "otherkey"
+				_, errs, _, err := translator.Translate(params, proxy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(errs.Validate()).To(HaveOccurred())
+				Expect(errs.Validate().Error()).To(ContainSubstring(SubsetSteeringMissingSelectorError(upstream.Metadata.Ref(), []string{"otherkey"}).Error()))
+			})
+		})
 	})
 
 	Context("when translating a route that points directly to a service", func() {
@@ -1822,6 +2196,152 @@ var _ = Describe("Translator", func() {
 			Expect(clusterSpec).To(Equal("test_gloo-system"))
 		})
 	})
+	Context("Hybrid", func() {
+
+		var hybridListener *v1.Listener
+
+		BeforeEach(func() {
+			hybridListener = &v1.Listener{
+				Name:        "hybrid-listener",
+				BindAddress: "127.0.0.1",
+				BindPort:    8443,
+				ListenerType: &v1.Listener_HybridListener{
+					HybridListener: &v1.HybridListener{
+						MatchedListeners: []*v1.MatchedListener{
+							{
+								Matcher: &v1.Matcher{
+									SslConfig: &v1.SslConfig{
+										SslSecrets: &v1.SslConfig_SslFiles{
+											SslFiles: &v1.SSLFiles{
+												TlsCert: "cert",
+												TlsKey:  "key",
+											},
+										},
+										SniDomains: []string{"public.example.com"},
+									},
+								},
+								ListenerType: &v1.MatchedListener_HttpListener{
+									HttpListener: &v1.HttpListener{
+										VirtualHosts: []*v1.VirtualHost{{
+											Name:    "public",
+											Domains: []string{"public.example.com"},
+											Routes:  routes,
+										}},
+									},
+								},
+							},
+							{
+								Matcher: &v1.Matcher{
+									SourcePrefixRanges: []*envoycorev3.CidrRange{{
+										AddressPrefix: "10.0.0.0",
+										PrefixLen:     &types.UInt32Value{Value: 8},
+									}},
+								},
+								ListenerType: &v1.MatchedListener_HttpListener{
+									HttpListener: &v1.HttpListener{
+										Options: &v1.HttpListenerOptions{
+											HttpConnectionManagerSettings: &hcm.HttpConnectionManagerSettings{
+												ServerName: "internal",
+											},
+										},
+										VirtualHosts: []*v1.VirtualHost{{
+											Name:    "internal",
+											Domains: []string{"*"},
+											Routes:  routes,
+										}},
+									},
+								},
+							},
+							{
+								Matcher: &v1.Matcher{
+									SslConfig: &v1.SslConfig{
+										SslSecrets: &v1.SslConfig_SslFiles{
+											SslFiles: &v1.SSLFiles{
+												TlsCert: "cert",
+												TlsKey:  "key",
+											},
+										},
+										SniDomains: []string{"tcp.example.com"},
+									},
+								},
+								ListenerType: &v1.MatchedListener_TcpListener{
+									TcpListener: proxy.GetListeners()[1].GetTcpListener(),
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			proxy.Listeners = append(proxy.Listeners, hybridListener)
+		})
+
+		It("translates each matched listener into its own filter chains", func() {
+			translate()
+			listeners := snapshot.GetResources(xds.ListenerType).Items
+			Expect(listeners).To(HaveKey("hybrid-listener"))
+			listener := listeners["hybrid-listener"].ResourceProto().(*envoyapi.Listener)
+			Expect(listener.GetFilterChains()).To(HaveLen(3))
+
+			publicChain := listener.GetFilterChains()[0]
+			Expect(publicChain.GetFilterChainMatch().GetServerNames()).To(Equal([]string{"public.example.com"}))
+			Expect(publicChain.GetFilterChainMatch().GetTransportProtocol()).To(Equal("tls"))
+			Expect(publicChain.GetTransportSocket()).NotTo(BeNil())
+
+			internalChain := listener.GetFilterChains()[1]
+			Expect(internalChain.GetFilterChainMatch().GetSourcePrefixRanges()).To(Equal([]*envoycore.CidrRange{{
+				AddressPrefix: "10.0.0.0",
+				PrefixLen:     &wrappers.UInt32Value{Value: 8},
+			}}))
+			Expect(internalChain.GetFilterChainMatch().GetTransportProtocol()).To(BeEmpty())
+			Expect(internalChain.GetTransportSocket()).To(BeNil())
+
+			// the http connection manager settings only apply to the filter chain of their matched listener
+			serverName := func(filterChain *envoylistener.FilterChain) string {
+				var cfg envoyhttp.HttpConnectionManager
+				Expect(ParseTypedConfig(filterChain.GetFilters()[0], &cfg)).NotTo(HaveOccurred())
+				return cfg.GetServerName()
+			}
+			Expect(serverName(publicChain)).To(BeEmpty())
+			Expect(serverName(internalChain)).To(Equal("internal"))
+
+			// the tcp listener terminates tls with the ssl config of its matcher
+			tcpChain := listener.GetFilterChains()[2]
+			Expect(tcpChain.GetFilterChainMatch().GetServerNames()).To(Equal([]string{"tcp.example.com"}))
+			Expect(tcpChain.GetFilterChainMatch().GetTransportProtocol()).To(Equal("tls"))
+			Expect(tcpChain.GetTransportSocket()).NotTo(BeNil())
+			Expect(tcpChain.GetFilters()[0].GetName()).To(Equal(wellknown.TCPProxy))
+
+			// each matched http listener has its own route configuration
+			var routeConfigNames []string
+			for _, filterChain := range listener.GetFilterChains()[:2] {
+				var cfg envoyhttp.HttpConnectionManager
+				Expect(ParseTypedConfig(filterChain.GetFilters()[0], &cfg)).NotTo(HaveOccurred())
+				routeConfigNames = append(routeConfigNames, cfg.GetRds().GetRouteConfigName())
+			}
+			Expect(routeConfigNames[0]).NotTo(Equal(routeConfigNames[1]))
+			routeConfigs := snapshot.GetResources(xds.RouteType).Items
+			for i, name := range routeConfigNames {
+				Expect(routeConfigs).To(HaveKey(name))
+				routeConfig := routeConfigs[name].ResourceProto().(*envoyapi.RouteConfiguration)
+				Expect(routeConfig.GetVirtualHosts()).To(HaveLen(1))
+				Expect(routeConfig.GetVirtualHosts()[0].GetName()).To(Equal([]string{"public", "internal"}[i]))
+			}
+		})
+
+		It("reports errors on the matched listeners", func() {
+			hybridListener.GetHybridListener().GetMatchedListeners()[1].GetHttpListener().GetVirtualHosts()[0].Domains = []string{""}
+
+			report := translateWithError()
+			hybridReport := report.GetListenerReports()[2].GetHybridListenerReport()
+			Expect(hybridReport.GetMatchedListenerReports()).To(HaveLen(3))
+			Expect(hybridReport.GetMatchedListenerReports()[1].GetHttpListenerReport().GetVirtualHostReports()[0].GetErrors()).NotTo(BeEmpty())
+			Expect(hybridReport.GetMatchedListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetErrors()).To(BeEmpty())
+		})
+	})
+
 	Context("Ssl - cluster", func() {
 
 		var (
@@ -16,36 +16,24 @@ func MakeReport(proxy *v1.Proxy) *validation.ProxyReport {
 	for i, listener := range listeners {
 		switch listenerType := listener.GetListenerType().(type) {
 		case *v1.Listener_HttpListener:
-
-			vhostReports := make([]*validation.VirtualHostReport, len(listenerType.HttpListener.GetVirtualHosts()))
-
-			for j, vh := range listenerType.HttpListener.GetVirtualHosts() {
-				routeReports := make([]*validation.RouteReport, len(vh.GetRoutes()))
-				for k := range vh.GetRoutes() {
-					routeReports[k] = &validation.RouteReport{}
-				}
-
-				vhostReports[j] = &validation.VirtualHostReport{
-					RouteReports: routeReports,
-				}
-			}
-
-			listenerReports[i] = &validation.ListenerReport{
-				ListenerTypeReport: &validation.ListenerReport_HttpListenerReport{
-					HttpListenerReport: &validation.HttpListenerReport{
-						VirtualHostReports: vhostReports,
-					},
-				},
-			}
+			listenerReports[i] = makeHttpListenerReport(listenerType.HttpListener)
 		case *v1.Listener_TcpListener:
-			tcpHostReports := make([]*validation.TcpHostReport, len(listenerType.TcpListener.GetTcpHosts()))
-			for j := range listenerType.TcpListener.GetTcpHosts() {
-				tcpHostReports[j] = &validation.TcpHostReport{}
+			listenerReports[i] = makeTcpListenerReport(listenerType.TcpListener)
+		case *v1.Listener_HybridListener:
+			matchedListeners := listenerType.HybridListener.GetMatchedListeners()
+			matchedListenerReports := make([]*validation.ListenerReport, len(matchedListeners))
+			for j, matchedListener := range matchedListeners {
+				switch matchedListenerType := matchedListener.GetListenerType().(type) {
+				case *v1.MatchedListener_HttpListener:
+					matchedListenerReports[j] = makeHttpListenerReport(matchedListenerType.HttpListener)
+				case *v1.MatchedListener_TcpListener:
+					matchedListenerReports[j] = makeTcpListenerReport(matchedListenerType.TcpListener)
+				}
 			}
 			listenerReports[i] = &validation.ListenerReport{
-				ListenerTypeReport: &validation.ListenerReport_TcpListenerReport{
-					TcpListenerReport: &validation.TcpListenerReport{
-						TcpHostReports: tcpHostReports,
+				ListenerTypeReport: &validation.ListenerReport_HybridListenerReport{
+					HybridListenerReport: &validation.HybridListenerReport{
+						MatchedListenerReports: matchedListenerReports,
 					},
 				},
 			}
@@ -57,6 +45,43 @@ func MakeReport(proxy *v1.Proxy) *validation.ProxyReport {
 	}
 }
 
+func makeHttpListenerReport(httpListener *v1.HttpListener) *validation.ListenerReport {
+	vhostReports := make([]*validation.VirtualHostReport, len(httpListener.GetVirtualHosts()))
+
+	for j, vh := range httpListener.GetVirtualHosts() {
+		routeReports := make([]*validation.RouteReport, len(vh.GetRoutes()))
+		for k := range vh.GetRoutes() {
+			routeReports[k] = &validation.RouteReport{}
+		}
+
+		vhostReports[j] = &validation.VirtualHostReport{
+			RouteReports: routeReports,
+		}
+	}
+
+	return &validation.ListenerReport{
+		ListenerTypeReport: &validation.ListenerReport_HttpListenerReport{
+			HttpListenerReport: &validation.HttpListenerReport{
+				VirtualHostReports: vhostReports,
+			},
+		},
+	}
+}
+
+func makeTcpListenerReport(tcpListener *v1.TcpListener) *validation.ListenerReport {
+	tcpHostReports := make([]*validation.TcpHostReport, len(tcpListener.GetTcpHosts()))
+	for j := range tcpListener.GetTcpHosts() {
+		tcpHostReports[j] = &validation.TcpHostReport{}
+	}
+	return &validation.ListenerReport{
+		ListenerTypeReport: &validation.ListenerReport_TcpListenerReport{
+			TcpListenerReport: &validation.TcpListenerReport{
+				TcpHostReports: tcpHostReports,
+			},
+		},
+	}
+}
+
 func mkErr(level, errType, reason string) error {
 	return errors.Errorf("%v Error: %v. Reason: %v", level, errType, reason)
 }
@@ -125,63 +150,83 @@ func GetTcpHostErr(tcpHost *validation.TcpHostReport) []error {
 func GetProxyError(proxyRpt *validation.ProxyReport) error {
 	var errs []error
 	for _, listener := range proxyRpt.GetListenerReports() {
-		if err := GetListenerErr(listener); err != nil {
+		errs = append(errs, getListenerReportErrs(listener)...)
+	}
+
+	var combinedErr error
+	for _, err := range errs {
+		combinedErr = multierr.Append(combinedErr, err)
+	}
+
+	return combinedErr
+}
+
+func getListenerReportErrs(listener *validation.ListenerReport) []error {
+	var errs []error
+	if err := GetListenerErr(listener); err != nil {
+		errs = append(errs, err...)
+	}
+	switch listenerType := listener.ListenerTypeReport.(type) {
+	case *validation.ListenerReport_HttpListenerReport:
+		httpListener := listenerType.HttpListenerReport
+		if err := GetHttpListenerErr(httpListener); err != nil {
 			errs = append(errs, err...)
 		}
-		switch listenerType := listener.ListenerTypeReport.(type) {
-		case *validation.ListenerReport_HttpListenerReport:
-			httpListener := listenerType.HttpListenerReport
-			if err := GetHttpListenerErr(httpListener); err != nil {
+		for _, vhReport := range httpListener.GetVirtualHostReports() {
+			if err := GetVirtualHostErr(vhReport); err != nil {
 				errs = append(errs, err...)
 			}
-			for _, vhReport := range httpListener.GetVirtualHostReports() {
-				if err := GetVirtualHostErr(vhReport); err != nil {
+			for _, routeReport := range vhReport.GetRouteReports() {
+				if err := GetRouteErr(routeReport); err != nil {
 					errs = append(errs, err...)
 				}
-				for _, routeReport := range vhReport.GetRouteReports() {
-					if err := GetRouteErr(routeReport); err != nil {
-						errs = append(errs, err...)
-					}
-				}
 			}
-		case *validation.ListenerReport_TcpListenerReport:
-			tcpListener := listenerType.TcpListenerReport
-			if err := GetTcpListenerErr(tcpListener); err != nil {
+		}
+	case *validation.ListenerReport_TcpListenerReport:
+		tcpListener := listenerType.TcpListenerReport
+		if err := GetTcpListenerErr(tcpListener); err != nil {
+			errs = append(errs, err...)
+		}
+		for _, hostReport := range tcpListener.GetTcpHostReports() {
+			if err := GetTcpHostErr(hostReport); err != nil {
 				errs = append(errs, err...)
 			}
-			for _, hostReport := range tcpListener.GetTcpHostReports() {
-				if err := GetTcpHostErr(hostReport); err != nil {
-					errs = append(errs, err...)
-				}
-			}
+		}
+	case *validation.ListenerReport_HybridListenerReport:
+		for _, matchedListener := range listenerType.HybridListenerReport.GetMatchedListenerReports() {
+			errs = append(errs, getListenerReportErrs(matchedListener)...)
 		}
 	}
-
-	var combinedErr error
-	for _, err := range errs {
-		combinedErr = multierr.Append(combinedErr, err)
-	}
-
-	return combinedErr
+	return errs
 }
 
 func GetProxyWarning(proxyRpt *validation.ProxyReport) []string {
 	var warnings []string
 
 	for _, listener := range proxyRpt.GetListenerReports() {
-		switch listenerType := listener.ListenerTypeReport.(type) {
-		case *validation.ListenerReport_HttpListenerReport:
-			httpListener := listenerType.HttpListenerReport
-			for _, vhReport := range httpListener.GetVirtualHostReports() {
-				for _, routeReport := range vhReport.GetRouteReports() {
-					if warns := GetRouteWarning(routeReport); len(warns) > 0 {
-						warnings = append(warnings, warns...)
-					}
+		warnings = append(warnings, getListenerReportWarnings(listener)...)
+	}
+
+	return warnings
+}
+
+func getListenerReportWarnings(listener *validation.ListenerReport) []string {
+	var warnings []string
+	switch listenerType := listener.ListenerTypeReport.(type) {
+	case *validation.ListenerReport_HttpListenerReport:
+		httpListener := listenerType.HttpListenerReport
+		for _, vhReport := range httpListener.GetVirtualHostReports() {
+			for _, routeReport := range vhReport.GetRouteReports() {
+				if warns := GetRouteWarning(routeReport); len(warns) > 0 {
+					warnings = append(warnings, warns...)
 				}
 			}
 		}
+	case *validation.ListenerReport_HybridListenerReport:
+		for _, matchedListener := range listenerType.HybridListenerReport.GetMatchedListenerReports() {
+			warnings = append(warnings, getListenerReportWarnings(matchedListener)...)
+		}
 	}
-
 	return warnings
 }
 
@@ -80,6 +80,41 @@ var _ = Describe("validation utils", func() {
 			}
 
 		})
+
+		It("generates a report which matches a hybrid proxy", func() {
+
+			httpProxy, tcpProxy := makeProxy(true), makeProxy(false)
+			proxy := &v1.Proxy{
+				Listeners: []*v1.Listener{{
+					ListenerType: &v1.Listener_HybridListener{
+						HybridListener: &v1.HybridListener{
+							MatchedListeners: []*v1.MatchedListener{
+								{ListenerType: &v1.MatchedListener_HttpListener{HttpListener: httpProxy.Listeners[0].GetHttpListener()}},
+								{ListenerType: &v1.MatchedListener_TcpListener{TcpListener: tcpProxy.Listeners[0].GetTcpListener()}},
+							},
+						},
+					},
+				}},
+			}
+
+			rpt := MakeReport(proxy)
+			Expect(rpt.ListenerReports).To(HaveLen(1))
+			matchedReports := rpt.ListenerReports[0].GetHybridListenerReport().GetMatchedListenerReports()
+			Expect(matchedReports).To(HaveLen(2))
+			Expect(matchedReports[0].GetHttpListenerReport().VirtualHostReports).To(HaveLen(numVhosts))
+			Expect(matchedReports[0].GetHttpListenerReport().VirtualHostReports[0].RouteReports).To(HaveLen(numRoutes))
+			Expect(matchedReports[1].GetTcpListenerReport().TcpHostReports).To(HaveLen(numVhosts))
+
+			matchedReports[1].GetTcpListenerReport().TcpHostReports[2].Errors = append(matchedReports[1].GetTcpListenerReport().TcpHostReports[2].Errors,
+				&validation.TcpHostReport_Error{
+					Type:   validation.TcpHostReport_Error_InvalidDestinationError,
+					Reason: "bad destination",
+				},
+			)
+			err := GetProxyError(rpt)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("TcpHost Error: InvalidDestinationError. Reason: bad destination"))
+		})
 	})
 
 	var _ = Describe("GetProxyError", func() {
@@ -0,0 +1,382 @@
+package xds
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"sync/atomic"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Envoy nodes that set this key to true in their bootstrap node metadata receive
+// ADS responses in make-before-break order (see NewAdsServer).
+const OrderedAdsMetadataKey = "ordered_ads"
+
+// The order in which resource types are pushed on an ordered ADS stream.
+// Clusters (and their endpoints) are pushed before the listeners and routes that may reference them,
+// so a route never points at a cluster that has not arrived yet.
+var orderedAdsTypes = []string{
+	ClusterType,
+	EndpointType,
+	ListenerType,
+	RouteType,
+}
+
+// OrderedAdsEnabled returns true if the node opted into ordered ADS in its bootstrap metadata.
+func OrderedAdsEnabled(node *core.Node) bool {
+	if node.GetMetadata() == nil {
+		return false
+	}
+	value := node.GetMetadata().GetFields()[OrderedAdsMetadataKey]
+	if value == nil {
+		return false
+	}
+	if value.GetBoolValue() {
+		return true
+	}
+	enabled, _ := strconv.ParseBool(value.GetStringValue())
+	return enabled
+}
+
+type adsServer struct {
+	server.Server
+	cache     envoycache.Cache
+	callbacks server.Callbacks
+
+	// streamCount for counting ordered bi-di streams
+	streamCount int64
+}
+
+// NewAdsServer wraps the generic xDS server with an Aggregated Discovery Service that, for nodes
+// which opt in via OrderedAdsMetadataKey, pushes resources in CDS -> EDS -> LDS -> RDS order.
+// A response for a type is held back while a response for a type earlier in that order is still
+// waiting to be ACKed (or NACKed) by Envoy. Streams from other nodes are served by the generic server.
+func NewAdsServer(genericServer server.Server, config envoycache.Cache, callbacks server.Callbacks) server.Server {
+	return &adsServer{Server: genericServer, cache: config, callbacks: callbacks}
+}
+
+func (s *adsServer) StreamAggregatedResources(stream discovery.AggregatedDiscoveryService_StreamAggregatedResourcesServer) error {
+	// the node is only guaranteed to be set on the first request, so we have to
+	// read it before we can decide how to serve the stream.
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first == nil {
+		return status.Errorf(codes.Unavailable, "empty request")
+	}
+	if !OrderedAdsEnabled(first.GetNode()) {
+		return s.Server.StreamAggregatedResources(&peekedAdsStream{
+			AggregatedDiscoveryService_StreamAggregatedResourcesServer: stream,
+			first: first,
+		})
+	}
+
+	reqCh := make(chan *v2.DiscoveryRequest, 1)
+	reqCh <- first
+	reqStop := int32(0)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if atomic.LoadInt32(&reqStop) != 0 {
+				return
+			}
+			if err != nil {
+				close(reqCh)
+				return
+			}
+			reqCh <- req
+		}
+	}()
+
+	err = s.processOrdered(stream, reqCh)
+
+	// prevents writing to a closed channel if send failed on blocked recv
+	atomic.StoreInt32(&reqStop, 1)
+
+	return err
+}
+
+// peekedAdsStream replays the first request, which was consumed to read the node.
+type peekedAdsStream struct {
+	discovery.AggregatedDiscoveryService_StreamAggregatedResourcesServer
+	first *v2.DiscoveryRequest
+}
+
+func (p *peekedAdsStream) Recv() (*v2.DiscoveryRequest, error) {
+	if p.first != nil {
+		first := p.first
+		p.first = nil
+		return first, nil
+	}
+	return p.AggregatedDiscoveryService_StreamAggregatedResourcesServer.Recv()
+}
+
+// the state of the watch for a single resource type on an ordered ADS stream
+type adsWatch struct {
+	watch  chan envoycache.Response
+	cancel func()
+	// nonce of the last response sent for this type
+	nonce string
+	// whether we are waiting for Envoy to ACK or NACK the last response
+	inFlight bool
+	// whether we expect Envoy to request resources it has not asked for yet,
+	// e.g. the endpoints of clusters which were just sent
+	expected bool
+	// the resource names of the last request
+	names map[string]bool
+	// a response received from the cache which has not been sent yet
+	pending *envoycache.Response
+}
+
+func (s *adsServer) processOrdered(stream server.Stream, reqCh <-chan *v2.DiscoveryRequest) error {
+	streamID := atomic.AddInt64(&s.streamCount, 1)
+
+	var streamNonce int64
+	watches := map[string]*adsWatch{}
+	defer func() {
+		for _, w := range watches {
+			if w.cancel != nil {
+				w.cancel()
+			}
+		}
+		if s.callbacks != nil {
+			s.callbacks.OnStreamClosed(streamID)
+		}
+	}()
+
+	send := func(typeURL string, w *adsWatch) error {
+		resp := w.pending
+		w.pending = nil
+		out, err := createAdsResponse(resp, typeURL)
+		if err != nil {
+			return err
+		}
+		streamNonce = streamNonce + 1
+		out.Nonce = strconv.FormatInt(streamNonce, 10)
+		if s.callbacks != nil {
+			s.callbacks.OnStreamResponse(streamID, &resp.Request, out)
+		}
+		w.nonce = out.Nonce
+		w.inFlight = true
+		w.expected = false
+		if typeURL == ClusterType {
+			expectEndpoints(watches, resp.Resources)
+		}
+		return stream.Send(out)
+	}
+
+	// sends the pending responses in order. a response for one of the ordered types is
+	// held back while a type earlier in the order waits for an ACK; any other type is sent right away.
+	flush := func() error {
+		blocked := false
+		for _, typeURL := range orderedAdsTypes {
+			w := watches[typeURL]
+			if w == nil {
+				continue
+			}
+			if w.pending != nil && !blocked {
+				if err := send(typeURL, w); err != nil {
+					return err
+				}
+			}
+			if w.inFlight || w.expected {
+				blocked = true
+			}
+		}
+		var others []string
+		for typeURL, w := range watches {
+			if w.pending != nil && !isOrderedAdsType(typeURL) {
+				others = append(others, typeURL)
+			}
+		}
+		sort.Strings(others)
+		for _, typeURL := range others {
+			if err := send(typeURL, watches[typeURL]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// stores a response from the cache; the watch is done once it has responded.
+	receive := func(typeURL string, resp envoycache.Response, ok bool) error {
+		w := watches[typeURL]
+		if !ok {
+			return status.Errorf(codes.Unavailable, "watching failed for "+typeURL)
+		}
+		w.watch = nil
+		w.pending = &resp
+		return nil
+	}
+
+	if s.callbacks != nil {
+		s.callbacks.OnStreamOpen(streamID, envoycache.AnyType)
+	}
+
+	// node may only be set on the first discovery request
+	var node = &core.Node{}
+	for {
+		// select on the request channel and every open watch
+		cases := []reflect.SelectCase{{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(reqCh)}}
+		var caseTypes []string
+		for typeURL, w := range watches {
+			if w.watch == nil {
+				continue
+			}
+			cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(w.watch)})
+			caseTypes = append(caseTypes, typeURL)
+		}
+		chosen, value, ok := reflect.Select(cases)
+
+		if chosen > 0 {
+			if err := receive(caseTypes[chosen-1], value.Interface().(envoycache.Response), ok); err != nil {
+				return err
+			}
+			// a snapshot responds to all of its open watches at once, so pick up the
+			// responses for the other types before deciding what to send first.
+			for _, typeURL := range caseTypes {
+				w := watches[typeURL]
+				if w.watch == nil {
+					continue
+				}
+				select {
+				case resp, ok := <-w.watch:
+					if err := receive(typeURL, resp, ok); err != nil {
+						return err
+					}
+				default:
+				}
+			}
+			if err := flush(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// input stream ended or errored out
+		if !ok {
+			return nil
+		}
+		req := value.Interface().(*v2.DiscoveryRequest)
+		if req == nil {
+			return status.Errorf(codes.Unavailable, "empty request")
+		}
+
+		// node field in discovery request is delta-compressed
+		if req.Node != nil {
+			node = req.Node
+		} else {
+			req.Node = node
+		}
+
+		// type URL is required for ADS
+		if req.TypeUrl == "" {
+			return status.Errorf(codes.InvalidArgument, "type URL is required for ADS")
+		}
+
+		if s.callbacks != nil {
+			s.callbacks.OnStreamRequest(streamID, req)
+		}
+
+		typeURL := req.TypeUrl
+		w := watches[typeURL]
+		if w == nil {
+			w = &adsWatch{}
+			watches[typeURL] = w
+		}
+		// nonces can be reused across streams; we verify nonce only if nonce is not initialized
+		if w.nonce != "" && w.nonce != req.GetResponseNonce() {
+			continue
+		}
+		// this request ACKs or NACKs the last response for this type,
+		// cancel the existing watch to (re-)request a newer version
+		w.inFlight = false
+		w.pending = nil
+		w.names = map[string]bool{}
+		for _, name := range req.GetResourceNames() {
+			w.names[name] = true
+		}
+		if w.cancel != nil {
+			w.cancel()
+		}
+		w.watch, w.cancel = s.cache.CreateWatch(*req)
+		if w.expected {
+			// the cache responds right away if it has endpoints of another version than the one Envoy has.
+			// otherwise Envoy already has the latest endpoints, and no response is coming for them.
+			select {
+			case resp, ok := <-w.watch:
+				if err := receive(typeURL, resp, ok); err != nil {
+					return err
+				}
+			default:
+				w.expected = false
+			}
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+}
+
+// Envoy requests the endpoints for EDS clusters after it receives them. If the clusters reference
+// endpoints which were not part of the last endpoints request, the next endpoints response must be
+// sent before anything that comes after endpoints in the push order.
+func expectEndpoints(watches map[string]*adsWatch, clusters []envoycache.Resource) {
+	referenced := GetResourceReferences(envoycache.IndexResourcesByName(clusters))
+	w := watches[EndpointType]
+	if w == nil {
+		if len(referenced) == 0 {
+			return
+		}
+		w = &adsWatch{}
+		watches[EndpointType] = w
+	}
+	if len(referenced) != len(w.names) {
+		w.expected = true
+		return
+	}
+	for name := range referenced {
+		if !w.names[name] {
+			w.expected = true
+			return
+		}
+	}
+}
+
+func isOrderedAdsType(typeURL string) bool {
+	for _, t := range orderedAdsTypes {
+		if t == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+func createAdsResponse(resp *envoycache.Response, typeURL string) (*v2.DiscoveryResponse, error) {
+	resources := make([]*any.Any, len(resp.Resources))
+	for i := 0; i < len(resp.Resources); i++ {
+		data, err := proto.Marshal(resp.Resources[i].ResourceProto())
+		if err != nil {
+			return nil, err
+		}
+		resources[i] = &any.Any{
+			TypeUrl: typeURL,
+			Value:   data,
+		}
+	}
+	return &v2.DiscoveryResponse{
+		VersionInfo: resp.Version,
+		Resources:   resources,
+		TypeUrl:     typeURL,
+	}, nil
+}
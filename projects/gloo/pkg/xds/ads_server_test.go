@@ -0,0 +1,190 @@
+package xds_test
+
+import (
+	"context"
+	"io"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
+	"google.golang.org/grpc"
+)
+
+type fakeAdsStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  chan *v2.DiscoveryRequest
+	responses chan *v2.DiscoveryResponse
+}
+
+func (f *fakeAdsStream) Context() context.Context { return f.ctx }
+
+func (f *fakeAdsStream) Send(resp *v2.DiscoveryResponse) error {
+	f.responses <- resp
+	return nil
+}
+
+func (f *fakeAdsStream) Recv() (*v2.DiscoveryRequest, error) {
+	select {
+	case <-f.ctx.Done():
+		return nil, io.EOF
+	case req := <-f.requests:
+		return req, nil
+	}
+}
+
+var _ = Describe("AdsServer", func() {
+
+	const nodeKey = "gloo-system~gateway-proxy"
+
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		snapshotCache cache.SnapshotCache
+		stream        *fakeAdsStream
+	)
+
+	node := func(ordered bool) *core.Node {
+		fields := map[string]*structpb.Value{
+			"role": {Kind: &structpb.Value_StringValue{StringValue: nodeKey}},
+		}
+		if ordered {
+			fields[xds.OrderedAdsMetadataKey] = &structpb.Value{Kind: &structpb.Value_BoolValue{BoolValue: true}}
+		}
+		return &core.Node{Metadata: &structpb.Struct{Fields: fields}}
+	}
+
+	setSnapshot := func(version string) {
+		snap := xds.NewSnapshot(version,
+			[]cache.Resource{xds.NewEnvoyResource(&v2.ClusterLoadAssignment{ClusterName: "cluster"})},
+			[]cache.Resource{xds.NewEnvoyResource(&v2.Cluster{Name: "cluster", ClusterDiscoveryType: &v2.Cluster_Type{Type: v2.Cluster_EDS}})},
+			[]cache.Resource{xds.NewEnvoyResource(&v2.RouteConfiguration{Name: "routes"})},
+			[]cache.Resource{xds.NewEnvoyResource(&v2.Listener{Name: "listener"})},
+		)
+		Expect(snapshotCache.SetSnapshot(nodeKey, snap)).NotTo(HaveOccurred())
+	}
+
+	receive := func() *v2.DiscoveryResponse {
+		var resp *v2.DiscoveryResponse
+		EventuallyWithOffset(1, stream.responses).Should(Receive(&resp))
+		return resp
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		snapshotCache = cache.NewSnapshotCache(true, xds.NewNodeHasher(), nil)
+		stream = &fakeAdsStream{
+			ctx:       ctx,
+			requests:  make(chan *v2.DiscoveryRequest, 10),
+			responses: make(chan *v2.DiscoveryResponse, 10),
+		}
+		adsServer := xds.NewAdsServer(server.NewServer(snapshotCache, nil), snapshotCache, nil)
+		go func() {
+			defer GinkgoRecover()
+			_ = adsServer.StreamAggregatedResources(stream)
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("pushes clusters, endpoints, listeners and routes in order, waiting for acks", func() {
+		setSnapshot("1")
+		stream.requests <- &v2.DiscoveryRequest{Node: node(true), TypeUrl: xds.ClusterType}
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.ListenerType}
+
+		cds := receive()
+		Expect(cds.GetTypeUrl()).To(Equal(xds.ClusterType))
+		Consistently(stream.responses).ShouldNot(Receive())
+
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.ClusterType, VersionInfo: "1", ResponseNonce: cds.GetNonce()}
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.EndpointType, ResourceNames: []string{"cluster"}}
+		eds := receive()
+		Expect(eds.GetTypeUrl()).To(Equal(xds.EndpointType))
+		Consistently(stream.responses).ShouldNot(Receive())
+
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.EndpointType, VersionInfo: "1", ResourceNames: []string{"cluster"}, ResponseNonce: eds.GetNonce()}
+		lds := receive()
+		Expect(lds.GetTypeUrl()).To(Equal(xds.ListenerType))
+
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.RouteType, ResourceNames: []string{"routes"}}
+		Consistently(stream.responses).ShouldNot(Receive())
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.ListenerType, VersionInfo: "1", ResponseNonce: lds.GetNonce()}
+		rds := receive()
+		Expect(rds.GetTypeUrl()).To(Equal(xds.RouteType))
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.RouteType, VersionInfo: "1", ResourceNames: []string{"routes"}, ResponseNonce: rds.GetNonce()}
+
+		By("updating every resource type at once")
+		setSnapshot("2")
+		cds = receive()
+		Expect(cds.GetTypeUrl()).To(Equal(xds.ClusterType))
+		Expect(cds.GetVersionInfo()).To(Equal("2"))
+		Consistently(stream.responses).ShouldNot(Receive())
+
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.ClusterType, VersionInfo: "2", ResponseNonce: cds.GetNonce()}
+		eds = receive()
+		Expect(eds.GetTypeUrl()).To(Equal(xds.EndpointType))
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.EndpointType, VersionInfo: "2", ResourceNames: []string{"cluster"}, ResponseNonce: eds.GetNonce()}
+		lds = receive()
+		Expect(lds.GetTypeUrl()).To(Equal(xds.ListenerType))
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.ListenerType, VersionInfo: "2", ResponseNonce: lds.GetNonce()}
+		rds = receive()
+		Expect(rds.GetTypeUrl()).To(Equal(xds.RouteType))
+	})
+
+	It("does not wait for endpoints when the clusters change but the endpoints do not", func() {
+		setVersionedSnapshot := func(clustersVersion string, clusterType v2.Cluster_DiscoveryType, listenersVersion string) {
+			snap := xds.NewSnapshotFromResources(
+				cache.NewResources("endpoints", []cache.Resource{xds.NewEnvoyResource(&v2.ClusterLoadAssignment{ClusterName: "a"})}),
+				cache.NewResources(clustersVersion, []cache.Resource{xds.NewEnvoyResource(&v2.Cluster{
+					Name:                 "a",
+					ClusterDiscoveryType: &v2.Cluster_Type{Type: clusterType},
+				})}),
+				cache.NewResources("routes", nil),
+				cache.NewResources(listenersVersion, []cache.Resource{xds.NewEnvoyResource(&v2.Listener{Name: "listener"})}),
+			)
+			Expect(snapshotCache.SetSnapshot(nodeKey, snap)).NotTo(HaveOccurred())
+		}
+
+		setVersionedSnapshot("clusters-1", v2.Cluster_EDS, "listeners-1")
+		stream.requests <- &v2.DiscoveryRequest{Node: node(true), TypeUrl: xds.ClusterType}
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.ListenerType}
+		cds := receive()
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.ClusterType, VersionInfo: "clusters-1", ResponseNonce: cds.GetNonce()}
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.EndpointType, ResourceNames: []string{"a"}}
+		eds := receive()
+		Expect(eds.GetTypeUrl()).To(Equal(xds.EndpointType))
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.EndpointType, VersionInfo: "endpoints", ResourceNames: []string{"a"}, ResponseNonce: eds.GetNonce()}
+		lds := receive()
+		Expect(lds.GetTypeUrl()).To(Equal(xds.ListenerType))
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.ListenerType, VersionInfo: "listeners-1", ResponseNonce: lds.GetNonce()}
+
+		By("changing the cluster to a static one, which does not reference the endpoints anymore")
+		setVersionedSnapshot("clusters-2", v2.Cluster_STATIC, "listeners-2")
+		cds = receive()
+		Expect(cds.GetTypeUrl()).To(Equal(xds.ClusterType))
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.ClusterType, VersionInfo: "clusters-2", ResponseNonce: cds.GetNonce()}
+		Consistently(stream.responses).ShouldNot(Receive())
+
+		// Envoy unsubscribes from the endpoints with the version it already has, so the cache does not respond
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.EndpointType, VersionInfo: "endpoints", ResponseNonce: eds.GetNonce()}
+		lds = receive()
+		Expect(lds.GetTypeUrl()).To(Equal(xds.ListenerType))
+		Expect(lds.GetVersionInfo()).To(Equal("listeners-2"))
+	})
+
+	It("serves nodes which did not opt in with the generic server", func() {
+		setSnapshot("1")
+		stream.requests <- &v2.DiscoveryRequest{Node: node(false), TypeUrl: xds.ClusterType}
+		stream.requests <- &v2.DiscoveryRequest{TypeUrl: xds.ListenerType}
+
+		types := []string{receive().GetTypeUrl(), receive().GetTypeUrl()}
+		Expect(types).To(ConsistOf(xds.ClusterType, xds.ListenerType))
+	})
+})
@@ -0,0 +1,324 @@
+package xds
+
+import (
+	"strconv"
+	"sync/atomic"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/solo-io/go-utils/contextutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeltaStream is the server side of an incremental xDS stream for a single resource type.
+type DeltaStream interface {
+	Send(*v2.DeltaDiscoveryResponse) error
+	Recv() (*v2.DeltaDiscoveryRequest, error)
+	grpc.ServerStream
+}
+
+// DeltaServer serves incremental (delta) xDS from the same snapshot cache used by the state-of-the-world server.
+// Each stream tracks the resources the proxy subscribed to and the version of every resource it was sent,
+// so that only added, changed and removed resources are pushed when a new snapshot is set.
+type DeltaServer interface {
+	DeltaStream(stream DeltaStream, typeURL string) error
+}
+
+type deltaServer struct {
+	cache  envoycache.SnapshotCache
+	hasher envoycache.NodeHash
+
+	// streamCount for counting bi-di streams
+	streamCount int64
+}
+
+func NewDeltaServer(snapshotCache envoycache.SnapshotCache, hasher envoycache.NodeHash) DeltaServer {
+	return &deltaServer{cache: snapshotCache, hasher: hasher}
+}
+
+// deltaStreamState is the per-stream subscription state.
+type deltaStreamState struct {
+	typeURL string
+
+	// wildcard is set if the first request on the stream did not subscribe to any names,
+	// which means the proxy wants every resource of this type (the default for CDS and LDS)
+	wildcard   bool
+	subscribed map[string]struct{}
+
+	// versions of the resources the proxy acknowledged, indexed by name
+	known map[string]string
+	// versions of the resources sent to the proxy, including the ones it has not acknowledged yet
+	sent map[string]string
+	// the resources of each response not acknowledged yet, by nonce: the version sent, or "" if the resource was removed
+	pending map[string]map[string]string
+
+	// the latest resources received from the cache, nil until the first snapshot is available
+	resources map[string]envoycache.Resource
+	version   string
+
+	// whether we have responded on this stream yet
+	responded bool
+}
+
+func newDeltaStreamState(typeURL string) *deltaStreamState {
+	return &deltaStreamState{
+		typeURL:    typeURL,
+		subscribed: map[string]struct{}{},
+		known:      map[string]string{},
+		sent:       map[string]string{},
+		pending:    map[string]map[string]string{},
+	}
+}
+
+func (s *deltaStreamState) isSubscribed(name string) bool {
+	if s.wildcard {
+		return true
+	}
+	_, ok := s.subscribed[name]
+	return ok
+}
+
+// applyRequest updates the subscriptions with the names in the request.
+func (s *deltaStreamState) applyRequest(req *v2.DeltaDiscoveryRequest, first bool) {
+	if first {
+		s.wildcard = len(req.GetResourceNamesSubscribe()) == 0
+		for name, version := range req.GetInitialResourceVersions() {
+			s.known[name] = version
+			s.sent[name] = version
+		}
+	}
+	for _, name := range req.GetResourceNamesSubscribe() {
+		if name == "*" {
+			s.wildcard = true
+			continue
+		}
+		s.subscribed[name] = struct{}{}
+	}
+	for _, name := range req.GetResourceNamesUnsubscribe() {
+		if name == "*" {
+			s.wildcard = false
+			continue
+		}
+		delete(s.subscribed, name)
+		delete(s.known, name)
+		delete(s.sent, name)
+	}
+}
+
+// ack commits the resources of the acknowledged response to the versions the proxy knows about.
+func (s *deltaStreamState) ack(nonce string) {
+	for name, version := range s.pending[nonce] {
+		if version == "" {
+			delete(s.known, name)
+		} else {
+			s.known[name] = version
+		}
+	}
+	delete(s.pending, nonce)
+}
+
+// nack reverts the resources of the rejected response to the versions the proxy knows about,
+// so that they are sent again with the next changes.
+func (s *deltaStreamState) nack(nonce string) {
+	for name := range s.pending[nonce] {
+		if version, ok := s.known[name]; ok {
+			s.sent[name] = version
+		} else {
+			delete(s.sent, name)
+		}
+	}
+	delete(s.pending, nonce)
+}
+
+// diff computes the resources that must be sent to bring the proxy up to date with the latest resources.
+// The sent versions are updated, and the changes are returned to be committed when the proxy acknowledges them.
+func (s *deltaStreamState) diff() (*v2.DeltaDiscoveryResponse, map[string]string, error) {
+	out := &v2.DeltaDiscoveryResponse{
+		SystemVersionInfo: s.version,
+		TypeUrl:           s.typeURL,
+	}
+	changes := map[string]string{}
+	for name, resource := range s.resources {
+		if !s.isSubscribed(name) {
+			continue
+		}
+		version, err := resourceVersion(resource)
+		if err != nil {
+			return nil, nil, err
+		}
+		if sent, ok := s.sent[name]; ok && sent == version {
+			continue
+		}
+		data, err := proto.Marshal(resource.ResourceProto())
+		if err != nil {
+			return nil, nil, err
+		}
+		out.Resources = append(out.Resources, &v2.Resource{
+			Name:    name,
+			Version: version,
+			Resource: &any.Any{
+				TypeUrl: s.typeURL,
+				Value:   data,
+			},
+		})
+		s.sent[name] = version
+		changes[name] = version
+	}
+	for name := range s.sent {
+		if _, ok := s.resources[name]; ok {
+			continue
+		}
+		if s.isSubscribed(name) {
+			out.RemovedResources = append(out.RemovedResources, name)
+		}
+		delete(s.sent, name)
+		changes[name] = ""
+	}
+	return out, changes, nil
+}
+
+// resourceVersion returns a content hash of the resource, used as its version on delta streams.
+func resourceVersion(resource envoycache.Resource) (string, error) {
+	hash, err := HashResource(resource)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatUint(hash, 10), nil
+}
+
+func (s *deltaServer) DeltaStream(stream DeltaStream, typeURL string) error {
+	// a channel for receiving incoming requests
+	reqCh := make(chan *v2.DeltaDiscoveryRequest)
+	reqStop := int32(0)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if atomic.LoadInt32(&reqStop) != 0 {
+				return
+			}
+			if err != nil {
+				close(reqCh)
+				return
+			}
+			reqCh <- req
+		}
+	}()
+
+	err := s.process(stream, reqCh, typeURL)
+
+	// prevents writing to a closed channel if send failed on blocked recv
+	atomic.StoreInt32(&reqStop, 1)
+
+	return err
+}
+
+func (s *deltaServer) process(stream DeltaStream, reqCh <-chan *v2.DeltaDiscoveryRequest, typeURL string) error {
+	logger := contextutils.LoggerFrom(stream.Context())
+	streamID := atomic.AddInt64(&s.streamCount, 1)
+
+	var (
+		streamNonce int64
+		state       = newDeltaStreamState(typeURL)
+		// node may only be set on the first discovery request
+		node        *core.Node
+		watch       chan envoycache.Response
+		cancelWatch func()
+	)
+	defer func() {
+		if cancelWatch != nil {
+			cancelWatch()
+		}
+	}()
+
+	// sends the pending changes, if any. the first response on a stream is always sent
+	// so that the proxy can finish initializing even if there are no resources for it.
+	sendDiff := func() error {
+		if state.resources == nil {
+			return nil
+		}
+		out, changes, err := state.diff()
+		if err != nil {
+			return err
+		}
+		if state.responded && len(out.GetResources()) == 0 && len(out.GetRemovedResources()) == 0 {
+			return nil
+		}
+		streamNonce = streamNonce + 1
+		out.Nonce = strconv.FormatInt(streamNonce, 10)
+		state.pending[out.Nonce] = changes
+		state.responded = true
+		return stream.Send(out)
+	}
+
+	// watches the cache for a version of the resources other than the one we have
+	openWatch := func() {
+		watch, cancelWatch = s.cache.CreateWatch(envoycache.Request{
+			Node:        node,
+			TypeUrl:     typeURL,
+			VersionInfo: state.version,
+		})
+	}
+
+	for {
+		select {
+		case resp, more := <-watch:
+			if !more {
+				return status.Errorf(codes.Unavailable, "watching failed for "+typeURL)
+			}
+			cancelWatch()
+			state.resources = envoycache.IndexResourcesByName(resp.Resources)
+			state.version = resp.Version
+			if err := sendDiff(); err != nil {
+				return err
+			}
+			openWatch()
+
+		case req, more := <-reqCh:
+			// input stream ended or errored out
+			if !more {
+				return nil
+			}
+			if req == nil {
+				return status.Errorf(codes.Unavailable, "empty request")
+			}
+			if req.GetTypeUrl() != "" && req.GetTypeUrl() != typeURL {
+				return status.Errorf(codes.InvalidArgument, "unexpected type URL %v on %v stream", req.GetTypeUrl(), typeURL)
+			}
+
+			// node field in discovery request is delta-compressed
+			first := node == nil
+			if req.GetNode() != nil {
+				node = req.GetNode()
+			} else if first {
+				node = &core.Node{}
+			}
+
+			nacked := req.GetErrorDetail() != nil
+			if nacked {
+				logger.Warnf("delta xds stream %v: proxy %v rejected %v resources with nonce %v: %v",
+					streamID, s.hasher.ID(node), typeURL, req.GetResponseNonce(), req.GetErrorDetail().GetMessage())
+				state.nack(req.GetResponseNonce())
+			} else if req.GetResponseNonce() != "" {
+				state.ack(req.GetResponseNonce())
+			}
+
+			state.applyRequest(req, first)
+			if first {
+				openWatch()
+				continue
+			}
+			// the rejected resources are sent again with the next snapshot, not right back
+			if nacked && len(req.GetResourceNamesSubscribe()) == 0 {
+				continue
+			}
+			if err := sendDiff(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package xds_test
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/golang/protobuf/ptypes/duration"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+)
+
+type fakeDeltaStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  chan *v2.DeltaDiscoveryRequest
+	responses chan *v2.DeltaDiscoveryResponse
+}
+
+func newFakeDeltaStream(ctx context.Context) *fakeDeltaStream {
+	return &fakeDeltaStream{
+		ctx:       ctx,
+		requests:  make(chan *v2.DeltaDiscoveryRequest, 10),
+		responses: make(chan *v2.DeltaDiscoveryResponse, 10),
+	}
+}
+
+func (f *fakeDeltaStream) Context() context.Context { return f.ctx }
+
+func (f *fakeDeltaStream) Send(resp *v2.DeltaDiscoveryResponse) error {
+	f.responses <- resp
+	return nil
+}
+
+func (f *fakeDeltaStream) Recv() (*v2.DeltaDiscoveryRequest, error) {
+	select {
+	case <-f.ctx.Done():
+		return nil, io.EOF
+	case req := <-f.requests:
+		return req, nil
+	}
+}
+
+var _ = Describe("DeltaServer", func() {
+
+	const nodeKey = "gloo-system~gateway-proxy"
+
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		snapshotCache cache.SnapshotCache
+		stream        *fakeDeltaStream
+		node          *core.Node
+	)
+
+	cluster := func(name string, timeoutSeconds int64) cache.Resource {
+		return xds.NewEnvoyResource(&v2.Cluster{
+			Name:                 name,
+			ClusterDiscoveryType: &v2.Cluster_Type{Type: v2.Cluster_STATIC},
+			ConnectTimeout:       &duration.Duration{Seconds: timeoutSeconds},
+		})
+	}
+
+	endpoint := func(name string) cache.Resource {
+		return xds.NewEnvoyResource(&v2.ClusterLoadAssignment{ClusterName: name})
+	}
+
+	setSnapshot := func(version string, endpoints []cache.Resource, clusters ...cache.Resource) {
+		err := snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshot(version, endpoints, clusters, nil, nil))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	names := func(resp *v2.DeltaDiscoveryResponse) []string {
+		var out []string
+		for _, res := range resp.GetResources() {
+			out = append(out, res.GetName())
+		}
+		sort.Strings(out)
+		return out
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		snapshotCache = cache.NewSnapshotCache(true, xds.NewNodeHasher(), nil)
+		stream = newFakeDeltaStream(ctx)
+		node = &core.Node{
+			Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"role": {Kind: &structpb.Value_StringValue{StringValue: nodeKey}},
+			}},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	serve := func(typeURL string) {
+		server := xds.NewDeltaServer(snapshotCache, xds.NewNodeHasher())
+		go func() {
+			defer GinkgoRecover()
+			_ = server.DeltaStream(stream, typeURL)
+		}()
+	}
+
+	It("sends only added, changed and removed clusters on a wildcard stream", func() {
+		setSnapshot("1", nil, cluster("a", 1), cluster("b", 1))
+		serve(xds.ClusterType)
+
+		stream.requests <- &v2.DeltaDiscoveryRequest{Node: node, TypeUrl: xds.ClusterType}
+		var resp *v2.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(Equal([]string{"a", "b"}))
+		Expect(resp.GetRemovedResources()).To(BeEmpty())
+
+		stream.requests <- &v2.DeltaDiscoveryRequest{ResponseNonce: resp.GetNonce()}
+
+		By("changing one cluster and adding another")
+		setSnapshot("2", nil, cluster("a", 1), cluster("b", 2), cluster("c", 1))
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(Equal([]string{"b", "c"}))
+
+		By("removing a cluster")
+		setSnapshot("3", nil, cluster("b", 2), cluster("c", 1))
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(resp.GetResources()).To(BeEmpty())
+		Expect(resp.GetRemovedResources()).To(Equal([]string{"a"}))
+
+		By("setting a snapshot with a new version but the same content")
+		setSnapshot("4", nil, cluster("b", 2), cluster("c", 1))
+		Consistently(stream.responses).ShouldNot(Receive())
+	})
+
+	It("sends the resources the proxy rejected again with the next snapshot", func() {
+		setSnapshot("1", nil, cluster("a", 1), cluster("b", 1))
+		serve(xds.ClusterType)
+
+		stream.requests <- &v2.DeltaDiscoveryRequest{Node: node, TypeUrl: xds.ClusterType}
+		var resp *v2.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		stream.requests <- &v2.DeltaDiscoveryRequest{ResponseNonce: resp.GetNonce()}
+
+		setSnapshot("2", nil, cluster("a", 2), cluster("b", 1))
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(Equal([]string{"a"}))
+
+		By("rejecting the changed cluster")
+		stream.requests <- &v2.DeltaDiscoveryRequest{
+			ResponseNonce: resp.GetNonce(),
+			ErrorDetail:   &status.Status{Message: "invalid cluster a"},
+		}
+		Consistently(stream.responses).ShouldNot(Receive())
+
+		setSnapshot("3", nil, cluster("a", 2), cluster("b", 1), cluster("c", 1))
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(Equal([]string{"a", "c"}))
+	})
+
+	It("does not resend resources the proxy already has", func() {
+		setSnapshot("1", nil, cluster("a", 1), cluster("b", 1))
+		serve(xds.ClusterType)
+
+		stream.requests <- &v2.DeltaDiscoveryRequest{Node: node, TypeUrl: xds.ClusterType}
+		var first *v2.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&first))
+		cancel()
+
+		ctx, cancel = context.WithCancel(context.Background())
+		stream = newFakeDeltaStream(ctx)
+		serve(xds.ClusterType)
+		initialVersions := map[string]string{}
+		for _, res := range first.GetResources() {
+			initialVersions[res.GetName()] = res.GetVersion()
+		}
+		initialVersions["a"] = "stale"
+		stream.requests <- &v2.DeltaDiscoveryRequest{Node: node, TypeUrl: xds.ClusterType, InitialResourceVersions: initialVersions}
+
+		var resp *v2.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(Equal([]string{"a"}))
+	})
+
+	It("tracks subscriptions on a named stream", func() {
+		setSnapshot("1", []cache.Resource{endpoint("a"), endpoint("b")}, cluster("a", 1), cluster("b", 1))
+		serve(xds.EndpointType)
+
+		stream.requests <- &v2.DeltaDiscoveryRequest{Node: node, TypeUrl: xds.EndpointType, ResourceNamesSubscribe: []string{"a"}}
+		var resp *v2.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(Equal([]string{"a"}))
+
+		stream.requests <- &v2.DeltaDiscoveryRequest{ResponseNonce: resp.GetNonce(), ResourceNamesSubscribe: []string{"b"}}
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(Equal([]string{"b"}))
+
+		By("unsubscribing and removing the unsubscribed resource")
+		stream.requests <- &v2.DeltaDiscoveryRequest{ResponseNonce: resp.GetNonce(), ResourceNamesUnsubscribe: []string{"a"}}
+		Consistently(stream.responses).ShouldNot(Receive())
+		setSnapshot("2", []cache.Resource{endpoint("b")}, cluster("b", 1))
+		Consistently(stream.responses).ShouldNot(Receive())
+	})
+
+	It("rejects requests for another type on the stream", func() {
+		server := xds.NewDeltaServer(snapshotCache, xds.NewNodeHasher())
+		errs := make(chan error, 1)
+		go func() {
+			errs <- server.DeltaStream(stream, xds.ClusterType)
+		}()
+		stream.requests <- &v2.DeltaDiscoveryRequest{Node: node, TypeUrl: xds.ListenerType}
+		Eventually(errs).Should(Receive(HaveOccurred()))
+	})
+})
@@ -73,7 +73,7 @@ func SetupEnvoyXds(grpcServer *grpc.Server, xdsServer envoyserver.Server, envoyC
 	if _, ok := grpcServer.GetServiceInfo()["envoy.api.v2.EndpointDiscoveryService"]; ok {
 		return
 	}
-	envoyServer := NewEnvoyServer(xdsServer)
+	envoyServer := NewEnvoyServer(xdsServer, NewDeltaServer(envoyCache, NewNodeHasher()))
 
 	v2.RegisterEndpointDiscoveryServiceServer(grpcServer, envoyServer)
 	v2.RegisterClusterDiscoveryServiceServer(grpcServer, envoyServer)
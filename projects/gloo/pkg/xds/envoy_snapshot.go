@@ -17,6 +17,9 @@ package xds
 import (
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
@@ -37,6 +40,10 @@ type EnvoySnapshot struct {
 
 	// Listeners are items in the LDS response payload.
 	Listeners cache.Resources
+
+	// hashes of the content of each resource, indexed by type and then by name.
+	// resources which could not be hashed are missing.
+	hashes map[string]map[string]uint64
 }
 
 var _ cache.Snapshot = &EnvoySnapshot{}
@@ -47,24 +54,141 @@ func NewSnapshot(version string,
 	clusters []cache.Resource,
 	routes []cache.Resource,
 	listeners []cache.Resource) *EnvoySnapshot {
-	return &EnvoySnapshot{
+	snap := &EnvoySnapshot{
 		Endpoints: cache.NewResources(version, endpoints),
 		Clusters:  cache.NewResources(version, clusters),
 		Routes:    cache.NewResources(version, routes),
 		Listeners: cache.NewResources(version, listeners),
 	}
+	snap.hashResources()
+	return snap
 }
 
 func NewSnapshotFromResources(endpoints cache.Resources,
 	clusters cache.Resources,
 	routes cache.Resources,
 	listeners cache.Resources) cache.Snapshot {
-	return &EnvoySnapshot{
+	snap := &EnvoySnapshot{
 		Endpoints: endpoints,
 		Clusters:  clusters,
 		Routes:    routes,
 		Listeners: listeners,
 	}
+	snap.hashResources()
+	return snap
+}
+
+// NewHashedSnapshot creates a snapshot in which the version of each resource type is derived from the
+// content hashes of its resources, so a version only changes if a resource of that type was added,
+// removed or changed.
+func NewHashedSnapshot(endpoints []cache.Resource,
+	clusters []cache.Resource,
+	routes []cache.Resource,
+	listeners []cache.Resource) (*EnvoySnapshot, error) {
+	snap := NewSnapshot("", endpoints, clusters, routes, listeners)
+	for _, typ := range ResponseTypes {
+		if len(snap.hashes[typ]) != len(snap.GetResources(typ).Items) {
+			return nil, fmt.Errorf("failed to hash %v resources", typ)
+		}
+	}
+	snap.Clusters.Version = hashesVersion(snap.hashes[ClusterType])
+	// if clusters are updated, provide a new version of the endpoints,
+	// so the clusters are warm
+	snap.Endpoints.Version = fmt.Sprintf("%v-%v", snap.Clusters.Version, hashesVersion(snap.hashes[EndpointType]))
+	snap.Routes.Version = hashesVersion(snap.hashes[RouteType])
+	snap.Listeners.Version = hashesVersion(snap.hashes[ListenerType])
+	return snap, nil
+}
+
+// NewHashedResources indexes the resources by name and derives their version from the content hash
+// of each resource.
+func NewHashedResources(items []cache.Resource) (cache.Resources, error) {
+	resources := cache.NewResources("", items)
+	hashes := hashItems(resources.Items)
+	if len(hashes) != len(resources.Items) {
+		return cache.Resources{}, errors.New("failed to hash resources")
+	}
+	resources.Version = hashesVersion(hashes)
+	return resources, nil
+}
+
+// HashResource returns a hash of the serialized content of the resource.
+func HashResource(resource cache.Resource) (uint64, error) {
+	buf := proto.NewBuffer(nil)
+	buf.SetDeterministic(true)
+	// marshal a copy, marshalling caches sizes on the message which would make
+	// the hashed resource differ from equivalent ones that were never serialized
+	if err := buf.Marshal(proto.Clone(resource.ResourceProto())); err != nil {
+		return 0, err
+	}
+	hasher := fnv.New64a()
+	if _, err := hasher.Write(buf.Bytes()); err != nil {
+		return 0, err
+	}
+	return hasher.Sum64(), nil
+}
+
+func hashItems(items map[string]cache.Resource) map[string]uint64 {
+	hashes := make(map[string]uint64, len(items))
+	for name, item := range items {
+		if item == nil {
+			continue
+		}
+		if hash, err := HashResource(item); err == nil {
+			hashes[name] = hash
+		}
+	}
+	return hashes
+}
+
+// hashesVersion combines the names and hashes of a set of resources into a version string.
+func hashesVersion(hashes map[string]uint64) string {
+	names := make([]string, 0, len(hashes))
+	for name := range hashes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	hasher := fnv.New64a()
+	for _, name := range names {
+		_, _ = hasher.Write([]byte(name))
+		_, _ = hasher.Write([]byte(strconv.FormatUint(hashes[name], 16)))
+	}
+	return strconv.FormatUint(hasher.Sum64(), 10)
+}
+
+func (s *EnvoySnapshot) hashResources() {
+	s.hashes = make(map[string]map[string]uint64, len(ResponseTypes))
+	for _, typ := range ResponseTypes {
+		s.hashes[typ] = hashItems(s.GetResources(typ).Items)
+	}
+}
+
+// GetResourceHash returns the content hash of the named resource of the given type,
+// or false if the snapshot does not contain it.
+func (s *EnvoySnapshot) GetResourceHash(typ, name string) (uint64, bool) {
+	if s == nil {
+		return 0, false
+	}
+	hash, ok := s.hashes[typ][name]
+	return hash, ok
+}
+
+// Hash returns a hash of the names and content of all resources in the snapshot, regardless of the
+// snapshot versions. The second return value is false if some resource could not be hashed, in which
+// case the snapshot can not be compared to others by its hash.
+func (s *EnvoySnapshot) Hash() (uint64, bool) {
+	if s == nil {
+		return 0, false
+	}
+	hasher := fnv.New64a()
+	for _, typ := range ResponseTypes {
+		if len(s.hashes[typ]) != len(s.GetResources(typ).Items) {
+			return 0, false
+		}
+		_, _ = hasher.Write([]byte(typ))
+		_, _ = hasher.Write([]byte(hashesVersion(s.hashes[typ])))
+	}
+	return hasher.Sum64(), true
 }
 
 // Consistent check verifies that the dependent resources are exactly listed in the
@@ -135,6 +259,14 @@ func (s *EnvoySnapshot) Clone() cache.Snapshot {
 		Items:   cloneItems(s.Listeners.Items),
 	}
 
+	snapshotClone.hashes = make(map[string]map[string]uint64, len(s.hashes))
+	for typ, hashes := range s.hashes {
+		snapshotClone.hashes[typ] = make(map[string]uint64, len(hashes))
+		for name, hash := range hashes {
+			snapshotClone.hashes[typ][name] = hash
+		}
+	}
+
 	return snapshotClone
 }
 
@@ -3,6 +3,7 @@ package xds_test
 import (
 	"reflect"
 
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	"github.com/golang/protobuf/ptypes/any"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -42,4 +43,76 @@ var _ = Describe("EnvoySnapshot", func() {
 		Expect(reflect.DeepEqual(toBeCloned, clone)).To(BeFalse())
 		Expect(reflect.DeepEqual(toBeCloned, untouched)).To(BeTrue())
 	})
+
+	Context("hashed snapshots", func() {
+
+		cluster := func(name string, eds bool) cache.Resource {
+			cluster := &v2.Cluster{Name: name}
+			if eds {
+				cluster.ClusterDiscoveryType = &v2.Cluster_Type{Type: v2.Cluster_EDS}
+			}
+			return xds.NewEnvoyResource(cluster)
+		}
+
+		snapshot := func(clusters ...cache.Resource) *xds.EnvoySnapshot {
+			snap, err := xds.NewHashedSnapshot(
+				[]cache.Resource{xds.NewEnvoyResource(&v2.ClusterLoadAssignment{ClusterName: "a"})},
+				clusters,
+				[]cache.Resource{xds.NewEnvoyResource(&v2.RouteConfiguration{Name: "routes"})},
+				[]cache.Resource{xds.NewEnvoyResource(&v2.Listener{Name: "listener"})},
+			)
+			Expect(err).NotTo(HaveOccurred())
+			return snap
+		}
+
+		It("only changes the versions of the types whose resources changed", func() {
+			snap := snapshot(cluster("a", false), cluster("b", false))
+			same := snapshot(cluster("b", false), cluster("a", false))
+			for _, typ := range xds.ResponseTypes {
+				Expect(same.GetResources(typ).Version).To(Equal(snap.GetResources(typ).Version))
+			}
+
+			changed := snapshot(cluster("a", false), cluster("b", true))
+			Expect(changed.GetResources(xds.ClusterType).Version).NotTo(Equal(snap.GetResources(xds.ClusterType).Version))
+			// endpoints are re-sent when the clusters change, so the clusters can warm
+			Expect(changed.GetResources(xds.EndpointType).Version).NotTo(Equal(snap.GetResources(xds.EndpointType).Version))
+			Expect(changed.GetResources(xds.RouteType).Version).To(Equal(snap.GetResources(xds.RouteType).Version))
+			Expect(changed.GetResources(xds.ListenerType).Version).To(Equal(snap.GetResources(xds.ListenerType).Version))
+
+			hashA, ok := snap.GetResourceHash(xds.ClusterType, "a")
+			Expect(ok).To(BeTrue())
+			changedA, _ := changed.GetResourceHash(xds.ClusterType, "a")
+			Expect(changedA).To(Equal(hashA))
+			hashB, _ := snap.GetResourceHash(xds.ClusterType, "b")
+			changedB, _ := changed.GetResourceHash(xds.ClusterType, "b")
+			Expect(changedB).NotTo(Equal(hashB))
+		})
+
+		It("hashes the content of a snapshot regardless of its versions", func() {
+			resources := func() ([]cache.Resource, []cache.Resource) {
+				return []cache.Resource{cluster("a", false)}, []cache.Resource{xds.NewEnvoyResource(&v2.Listener{Name: "listener"})}
+			}
+			clusters, listeners := resources()
+			snap := xds.NewSnapshot("1", nil, clusters, nil, listeners)
+			clusters, listeners = resources()
+			same := xds.NewSnapshot("2", nil, clusters, nil, listeners)
+			other := xds.NewSnapshot("1", nil, clusters, nil, nil)
+
+			hash, ok := snap.Hash()
+			Expect(ok).To(BeTrue())
+			sameHash, _ := same.Hash()
+			Expect(sameHash).To(Equal(hash))
+			otherHash, _ := other.Hash()
+			Expect(otherHash).NotTo(Equal(hash))
+			cloneHash, _ := snap.Clone().(*xds.EnvoySnapshot).Hash()
+			Expect(cloneHash).To(Equal(hash))
+		})
+
+		It("does not modify the hashed resources", func() {
+			hashed := &v2.Cluster{Name: "a"}
+			_, err := xds.HashResource(xds.NewEnvoyResource(hashed))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hashed).To(Equal(&v2.Cluster{Name: "a"}))
+		})
+	})
 })
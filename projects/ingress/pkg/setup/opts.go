@@ -21,4 +21,6 @@ type Opts struct {
 	RequireIngressClass         bool
 	CustomIngressClass          string
 	IngressProxyLabel           string
+	EnableGatewayApi            bool
+	GatewayApiControllerName    string
 }
@@ -21,6 +21,10 @@ import (
 	"github.com/solo-io/gloo/pkg/utils"
 	clusteringressv1 "github.com/solo-io/gloo/projects/clusteringress/pkg/api/v1"
 	clusteringresstranslator "github.com/solo-io/gloo/projects/clusteringress/pkg/translator"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/gatewayapi"
+	gatewayapiv1 "github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1"
+	gatewayapistatus "github.com/solo-io/gloo/projects/gatewayapi/pkg/status"
+	gatewayapitranslator "github.com/solo-io/gloo/projects/gatewayapi/pkg/translator"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	gloodefaults "github.com/solo-io/gloo/projects/gloo/pkg/defaults"
@@ -38,6 +42,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	knativeclientset "knative.dev/serving/pkg/client/clientset/versioned"
@@ -108,6 +113,8 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 	customIngressClass := os.Getenv("CUSTOM_INGRESS_CLASS")
 	knativeVersion := os.Getenv("KNATIVE_VERSION")
 	ingressProxyLabel := os.Getenv("INGRESS_PROXY_LABEL")
+	enableGatewayApi := envTrue("ENABLE_GATEWAY_API")
+	gatewayApiControllerName := os.Getenv("GATEWAY_API_CONTROLLER_NAME")
 
 	clusterIngressProxyAddress := defaultClusterIngressProxyAddress
 	if settings.Knative != nil && settings.Knative.ClusterIngressProxyAddress != "" {
@@ -128,6 +135,10 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		ingressProxyLabel = "ingress-proxy"
 	}
 
+	if len(gatewayApiControllerName) == 0 {
+		gatewayApiControllerName = gatewayapitranslator.DefaultControllerName
+	}
+
 	opts := Opts{
 		ClusterIngressProxyAddress:  clusterIngressProxyAddress,
 		KnativeExternalProxyAddress: knativeExternalProxyAddress,
@@ -141,12 +152,14 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 			Ctx:         ctx,
 			RefreshRate: refreshRate,
 		},
-		EnableKnative:       enableKnative,
-		KnativeVersion:      knativeVersion,
-		DisableKubeIngress:  disableKubeIngress,
-		RequireIngressClass: requireIngressClass,
-		CustomIngressClass:  customIngressClass,
-		IngressProxyLabel:   ingressProxyLabel,
+		EnableKnative:            enableKnative,
+		KnativeVersion:           knativeVersion,
+		DisableKubeIngress:       disableKubeIngress,
+		RequireIngressClass:      requireIngressClass,
+		CustomIngressClass:       customIngressClass,
+		IngressProxyLabel:        ingressProxyLabel,
+		EnableGatewayApi:         enableGatewayApi,
+		GatewayApiControllerName: gatewayApiControllerName,
 	}
 
 	return RunIngress(opts)
@@ -156,9 +169,10 @@ func RunIngress(opts Opts) error {
 	opts.WatchOpts = opts.WatchOpts.WithDefaults()
 	opts.WatchOpts.Ctx = contextutils.WithLogger(opts.WatchOpts.Ctx, "ingress")
 
-	if opts.DisableKubeIngress && !opts.EnableKnative {
-		return errors.Errorf("ingress controller must be enabled for either Knative (clusteringress) or " +
-			"basic kubernetes ingress. set DISABLE_KUBE_INGRESS=0 or ENABLE_KNATIVE_INGRESS=1")
+	if opts.DisableKubeIngress && !opts.EnableKnative && !opts.EnableGatewayApi {
+		return errors.Errorf("ingress controller must be enabled for either Knative (clusteringress), " +
+			"the Kubernetes Gateway API or basic kubernetes ingress. " +
+			"set DISABLE_KUBE_INGRESS=0, ENABLE_KNATIVE_INGRESS=1 or ENABLE_GATEWAY_API=1")
 	}
 
 	cfg, err := kubeutils.GetConfig("", "")
@@ -278,6 +292,13 @@ func RunIngress(opts Opts) error {
 		}
 	}
 
+	if opts.EnableGatewayApi {
+		logger.Infof("starting Ingress with Kubernetes Gateway API support enabled")
+		if err := runGatewayApi(opts, cfg, proxyClient, writeErrs); err != nil {
+			return err
+		}
+	}
+
 	go func() {
 		for {
 			select {
@@ -292,6 +313,60 @@ func RunIngress(opts Opts) error {
 	return nil
 }
 
+func runGatewayApi(opts Opts, cfg *rest.Config, proxyClient gloov1.ProxyClient, writeErrs chan error) error {
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return errors.Wrapf(err, "creating dynamic kube client")
+	}
+
+	upstreamClient, err := gloov1.NewUpstreamClient(opts.Upstreams)
+	if err != nil {
+		return err
+	}
+	if err := upstreamClient.Register(); err != nil {
+		return err
+	}
+
+	baseGatewayClassClient, err := gatewayapi.NewResourceClient(dynamicClient, &gatewayapiv1.GatewayClass{})
+	if err != nil {
+		return err
+	}
+	gatewayClassClient := gatewayapiv1.NewGatewayClassClientWithBase(baseGatewayClassClient)
+	baseGatewayClient, err := gatewayapi.NewResourceClient(dynamicClient, &gatewayapiv1.Gateway{})
+	if err != nil {
+		return err
+	}
+	gatewayClient := gatewayapiv1.NewGatewayClientWithBase(baseGatewayClient)
+	baseHttpRouteClient, err := gatewayapi.NewResourceClient(dynamicClient, &gatewayapiv1.HttpRoute{})
+	if err != nil {
+		return err
+	}
+	httpRouteClient := gatewayapiv1.NewHttpRouteClientWithBase(baseHttpRouteClient)
+	baseTlsRouteClient, err := gatewayapi.NewResourceClient(dynamicClient, &gatewayapiv1.TlsRoute{})
+	if err != nil {
+		return err
+	}
+	tlsRouteClient := gatewayapiv1.NewTlsRouteClientWithBase(baseTlsRouteClient)
+	baseReferenceGrantClient, err := gatewayapi.NewResourceClient(dynamicClient, &gatewayapiv1.ReferenceGrant{})
+	if err != nil {
+		return err
+	}
+	referenceGrantClient := gatewayapiv1.NewReferenceGrantClientWithBase(baseReferenceGrantClient)
+
+	emitter := gatewayapiv1.NewTranslatorEmitter(upstreamClient, gatewayClassClient, gatewayClient, httpRouteClient, tlsRouteClient, referenceGrantClient)
+	sync := gatewayapiv1.TranslatorSyncers{
+		gatewayapitranslator.NewSyncer(opts.GatewayApiControllerName, opts.WriteNamespace, proxyClient),
+		gatewayapistatus.NewSyncer(opts.GatewayApiControllerName, gatewayClassClient, gatewayClient, httpRouteClient, tlsRouteClient),
+	}
+	eventLoop := gatewayapiv1.NewTranslatorEventLoop(emitter, sync)
+	eventLoopErrs, err := eventLoop.Run(opts.WatchNamespaces, opts.WatchOpts)
+	if err != nil {
+		return err
+	}
+	go errutils.AggregateErrs(opts.WatchOpts.Ctx, writeErrs, eventLoopErrs, "gateway_api_translator_event_loop")
+	return nil
+}
+
 // change this to set whether we default to assuming
 // knative is pre-0.8.0 in the absence of a valid version parameter
 const defaultPre080 = true
@@ -0,0 +1,169 @@
+package e2e_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/solo-io/gloo/pkg/utils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dynamic_forward_proxy"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/test/services"
+	"github.com/solo-io/gloo/test/v1helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Dynamic forward proxy", func() {
+
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		envoyInstance *services.EnvoyInstance
+		testUpstream  *v1helpers.TestUpstream
+		testClients   services.TestClients
+		allowedHost   string
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		var err error
+		envoyInstance, err = envoyFactory.NewEnvoyInstance()
+		Expect(err).NotTo(HaveOccurred())
+
+		testClients = services.RunGlooGatewayUdsFds(ctx, &services.RunOptions{
+			NsToWrite: defaults.GlooSystem,
+			NsToWatch: []string{"default", defaults.GlooSystem},
+			WhatToRun: services.What{
+				DisableGateway: true,
+				DisableFds:     true,
+				DisableUds:     true,
+			},
+		})
+
+		err = envoyInstance.Run(testClients.GlooPort)
+		Expect(err).NotTo(HaveOccurred())
+
+		// the dns cache resolves the host of the test server, which is localhost unless envoy runs in docker
+		testUpstream = v1helpers.NewTestHttpUpstream(ctx, envoyInstance.LocalAddr())
+		host := envoyInstance.LocalAddr()
+		if host == "127.0.0.1" {
+			host = "localhost"
+		}
+		allowedHost = fmt.Sprintf("%s:%d", host, testUpstream.Port)
+
+		egress := &gloov1.Upstream{
+			Metadata: core.Metadata{
+				Name:      "egress",
+				Namespace: "default",
+			},
+			UpstreamType: &gloov1.Upstream_DynamicForwardProxy{
+				DynamicForwardProxy: &dynamic_forward_proxy.UpstreamSpec{
+					DnsCacheConfig: &dynamic_forward_proxy.DnsCacheConfig{
+						DnsLookupFamily: dynamic_forward_proxy.DnsCacheConfig_V4_ONLY,
+					},
+					AllowedHosts: []string{allowedHost},
+				},
+			},
+		}
+		_, err = testClients.UpstreamClient.Write(egress, clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		proxy := getProxyDynamicForwardProxy("default", "proxy", defaults.HttpPort, egress.Metadata.Ref())
+		_, err = testClients.ProxyClient.Write(proxy, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(func() (core.Status, error) {
+			proxy, err := testClients.ProxyClient.Read(proxy.Metadata.Namespace, proxy.Metadata.Name, clients.ReadOpts{})
+			if err != nil {
+				return core.Status{}, err
+			}
+			return proxy.Status, nil
+		}, "60s", "0.1s").Should(MatchFields(IgnoreExtras, Fields{
+			"Reason": BeEmpty(),
+			"State":  Equal(core.Status_Accepted),
+		}))
+	})
+
+	AfterEach(func() {
+		cancel()
+
+		if envoyInstance != nil {
+			_ = envoyInstance.Clean()
+		}
+	})
+
+	getStatus := func(host string) func() (int, error) {
+		return func() (int, error) {
+			req, err := http.NewRequest("GET", fmt.Sprintf("http://localhost:%d/", defaults.HttpPort), nil)
+			if err != nil {
+				return 0, err
+			}
+			req.Host = host
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return 0, err
+			}
+			defer resp.Body.Close()
+			return resp.StatusCode, nil
+		}
+	}
+
+	It("forwards the requests to the allowed hosts", func() {
+		Eventually(getStatus(allowedHost), "30s", "1s").Should(Equal(http.StatusOK))
+		Eventually(testUpstream.C).Should(Receive(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Host": Equal(allowedHost),
+		}))))
+	})
+
+	It("does not forward the requests to other hosts", func() {
+		Eventually(getStatus(allowedHost), "30s", "1s").Should(Equal(http.StatusOK))
+		Consistently(getStatus("example.com"), "3s", "1s").Should(Equal(http.StatusNotFound))
+	})
+})
+
+func getProxyDynamicForwardProxy(namespace, name string, envoyPort uint32, upstream core.ResourceRef) *gloov1.Proxy {
+	return &gloov1.Proxy{
+		Metadata: core.Metadata{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Listeners: []*gloov1.Listener{{
+			Name:        "listener",
+			BindAddress: "0.0.0.0",
+			BindPort:    envoyPort,
+			ListenerType: &gloov1.Listener_HttpListener{
+				HttpListener: &gloov1.HttpListener{
+					VirtualHosts: []*gloov1.VirtualHost{{
+						Name:    "gloo-system.egress",
+						Domains: []string{"*"},
+						Routes: []*gloov1.Route{{
+							Matchers: []*matchers.Matcher{{
+								PathSpecifier: &matchers.Matcher_Prefix{
+									Prefix: "/",
+								},
+							}},
+							Action: &gloov1.Route_RouteAction{
+								RouteAction: &gloov1.RouteAction{
+									Destination: &gloov1.RouteAction_Single{
+										Single: &gloov1.Destination{
+											DestinationType: &gloov1.Destination_Upstream{
+												Upstream: utils.ResourceRefPtr(upstream),
+											},
+										},
+									},
+								},
+							},
+						}},
+					}},
+				},
+			},
+		}},
+	}
+}
@@ -0,0 +1,271 @@
+package e2e_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+
+	"github.com/dgrijalva/jwt-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/solo-io/gloo/pkg/utils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	jwtapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/test/services"
+	"github.com/solo-io/gloo/test/v1helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("JWT", func() {
+
+	const (
+		jwksPort = 8096
+		issuer   = "gloo-e2e"
+		audience = "petstore"
+		keyId    = "e2e-key"
+	)
+
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		envoyInstance *services.EnvoyInstance
+		testUpstream  *v1helpers.TestUpstream
+		testClients   services.TestClients
+		privateKey    *rsa.PrivateKey
+		jwksServer    *http.Server
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		var err error
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		// fake the remote jwks with a local server
+		jwksServer, err = startJwksServer(jwksPort, keyId, &privateKey.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		envoyInstance, err = envoyFactory.NewEnvoyInstance()
+		Expect(err).NotTo(HaveOccurred())
+
+		jwksUpstream := &gloov1.Upstream{
+			Metadata: core.Metadata{
+				Name:      "jwks",
+				Namespace: "default",
+			},
+			UpstreamType: &gloov1.Upstream_Static{
+				Static: &static.UpstreamSpec{
+					Hosts: []*static.Host{{
+						Addr: envoyInstance.GlooAddr,
+						Port: jwksPort,
+					}},
+				},
+			},
+		}
+
+		testClients = services.RunGlooGatewayUdsFds(ctx, &services.RunOptions{
+			NsToWrite: defaults.GlooSystem,
+			NsToWatch: []string{"default", defaults.GlooSystem},
+			WhatToRun: services.What{
+				DisableGateway: true,
+				DisableFds:     true,
+				DisableUds:     true,
+			},
+		})
+
+		_, err = testClients.UpstreamClient.Write(jwksUpstream, clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = envoyInstance.Run(testClients.GlooPort)
+		Expect(err).NotTo(HaveOccurred())
+
+		testUpstream = v1helpers.NewTestHttpUpstream(ctx, envoyInstance.LocalAddr())
+		_, err = testClients.UpstreamClient.Write(testUpstream.Upstream, clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		proxy := getProxyJwt("default", "proxy", defaults.HttpPort, testUpstream.Upstream.Metadata.Ref(), &jwtapi.Provider{
+			Jwks: &jwtapi.Jwks{
+				Jwks: &jwtapi.Jwks_Remote{
+					Remote: &jwtapi.RemoteJwks{
+						Url:         fmt.Sprintf("http://jwks:%d/jwks", jwksPort),
+						UpstreamRef: utils.ResourceRefPtr(jwksUpstream.Metadata.Ref()),
+					},
+				},
+			},
+			Issuer:    issuer,
+			Audiences: []string{audience},
+			ClaimsToHeaders: []*jwtapi.ClaimToHeader{{
+				Claim:  "sub",
+				Header: "x-sub",
+			}},
+		})
+		_, err = testClients.ProxyClient.Write(proxy, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(func() (core.Status, error) {
+			proxy, err := testClients.ProxyClient.Read(proxy.Metadata.Namespace, proxy.Metadata.Name, clients.ReadOpts{})
+			if err != nil {
+				return core.Status{}, err
+			}
+			return proxy.Status, nil
+		}, "60s", "0.1s").Should(MatchFields(IgnoreExtras, Fields{
+			"Reason": BeEmpty(),
+			"State":  Equal(core.Status_Accepted),
+		}))
+	})
+
+	AfterEach(func() {
+		cancel()
+
+		if envoyInstance != nil {
+			_ = envoyInstance.Clean()
+		}
+		if jwksServer != nil {
+			_ = jwksServer.Close()
+		}
+	})
+
+	sign := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = keyId
+		signed, err := token.SignedString(privateKey)
+		Expect(err).NotTo(HaveOccurred())
+		return signed
+	}
+
+	expectStatus := func(path, token string, expectedStatus int) {
+		Eventually(func() (int, error) {
+			req, err := http.NewRequest("GET", fmt.Sprintf("http://%s:%d/%s", "localhost", defaults.HttpPort, path), nil)
+			if err != nil {
+				return 0, err
+			}
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return 0, err
+			}
+			return resp.StatusCode, nil
+		}, "10s", "0.5s").Should(Equal(expectedStatus))
+	}
+
+	It("rejects requests without a valid JWT", func() {
+		expectStatus("private", "", http.StatusUnauthorized)
+		expectStatus("private", "not-a-jwt", http.StatusUnauthorized)
+		expectStatus("private", sign(jwt.MapClaims{"iss": "someone-else", "aud": audience, "sub": "user"}), http.StatusUnauthorized)
+		expectStatus("private", sign(jwt.MapClaims{"iss": issuer, "aud": "other-api", "sub": "user"}), http.StatusForbidden)
+	})
+
+	It("accepts valid JWTs and forwards their claims", func() {
+		expectStatus("private", sign(jwt.MapClaims{"iss": issuer, "aud": audience, "sub": "user"}), http.StatusOK)
+
+		var received *v1helpers.ReceivedRequest
+		Eventually(testUpstream.C).Should(Receive(&received))
+		Expect(received.Headers.Get("x-sub")).To(Equal("user"))
+	})
+
+	It("does not check JWTs on routes which disable the checks", func() {
+		expectStatus("public", "", http.StatusOK)
+	})
+})
+
+func startJwksServer(port int, keyId string, key *rsa.PublicKey) (*http.Server, error) {
+	keySet, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"alg": "RS256",
+			"kid": keyId,
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jwks", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write(keySet)
+	})
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, err
+	}
+	srv := &http.Server{Handler: mux}
+	go func() {
+		defer GinkgoRecover()
+		_ = srv.Serve(listener)
+	}()
+	return srv, nil
+}
+
+func getProxyJwt(namespace, name string, envoyPort uint32, upstream core.ResourceRef, provider *jwtapi.Provider) *gloov1.Proxy {
+	route := func(prefix string, options *gloov1.RouteOptions) *gloov1.Route {
+		return &gloov1.Route{
+			Matchers: []*matchers.Matcher{{
+				PathSpecifier: &matchers.Matcher_Prefix{
+					Prefix: prefix,
+				},
+			}},
+			Options: options,
+			Action: &gloov1.Route_RouteAction{
+				RouteAction: &gloov1.RouteAction{
+					Destination: &gloov1.RouteAction_Single{
+						Single: &gloov1.Destination{
+							DestinationType: &gloov1.Destination_Upstream{
+								Upstream: utils.ResourceRefPtr(upstream),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return &gloov1.Proxy{
+		Metadata: core.Metadata{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Listeners: []*gloov1.Listener{{
+			Name:        "listener",
+			BindAddress: "0.0.0.0",
+			BindPort:    envoyPort,
+			ListenerType: &gloov1.Listener_HttpListener{
+				HttpListener: &gloov1.HttpListener{
+					VirtualHosts: []*gloov1.VirtualHost{{
+						Name:    "gloo-system.virt1",
+						Domains: []string{"*"},
+						Options: &gloov1.VirtualHostOptions{
+							Jwt: &jwtapi.VhostExtension{
+								Providers: map[string]*jwtapi.Provider{
+									"provider": provider,
+								},
+							},
+						},
+						Routes: []*gloov1.Route{
+							route("/public", &gloov1.RouteOptions{
+								Jwt: &jwtapi.RouteExtension{Disable: true},
+							}),
+							route("/private", nil),
+						},
+					}},
+				},
+			},
+		}},
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/solo-io/gloo/pkg/utils"
 	gwv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	envoycorev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
@@ -289,3 +290,78 @@ func GatewaySnapshotWithDelegateChain(us core.ResourceRef, namespace string) *gw
 	snap.RouteTables = rtList
 	return snap
 }
+
+func HybridGatewaySnapshot(us core.ResourceRef, namespace string) *gwv1.ApiSnapshot {
+	return &gwv1.ApiSnapshot{
+		Gateways: []*gwv1.Gateway{
+			{
+				Metadata: core.Metadata{
+					Name:      "hybrid-gateway",
+					Namespace: namespace,
+				},
+				ProxyNames: []string{defaults.GatewayProxyName},
+				GatewayType: &gwv1.Gateway_HybridGateway{
+					HybridGateway: &gwv1.HybridGateway{
+						MatchedGateways: []*gwv1.MatchedGateway{
+							{
+								Matcher: &gwv1.Matcher{
+									SourcePrefixRanges: []*envoycorev3.CidrRange{{
+										AddressPrefix: "10.0.0.0",
+										PrefixLen:     &types.UInt32Value{Value: 8},
+									}},
+								},
+								GatewayType: &gwv1.MatchedGateway_TcpGateway{
+									TcpGateway: &gwv1.TcpGateway{
+										TcpHosts: []*v1.TcpHost{
+											{
+												Name: "tcp-dest",
+												Destination: &v1.TcpHost_TcpAction{
+													Destination: &v1.TcpHost_TcpAction_Single{
+														Single: &v1.Destination{
+															DestinationType: &v1.Destination_Upstream{
+																Upstream: utils.ResourceRefPtr(us),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						DelegatedHttpGateways: &gwv1.DelegatedHttpGateway{
+							SelectionType: &gwv1.DelegatedHttpGateway_Selector{
+								Selector: &gwv1.HttpGatewaySelector{
+									Labels: map[string]string{"gateway": "hybrid"},
+								},
+							},
+						},
+					},
+				},
+				BindAddress:   "::",
+				BindPort:      8080,
+				UseProxyProto: &types.BoolValue{Value: false},
+			},
+		},
+		HttpGateways: []*gwv1.MatchableHttpGateway{
+			{
+				Metadata: core.Metadata{
+					Name:      "http-gateway",
+					Namespace: namespace,
+					Labels:    map[string]string{"gateway": "hybrid"},
+				},
+				HttpGateway: &gwv1.HttpGateway{},
+			},
+		},
+		VirtualServices: []*gwv1.VirtualService{
+			{
+				Metadata: core.Metadata{Namespace: namespace, Name: "virtualservice"},
+				VirtualHost: &gwv1.VirtualHost{
+					Domains: []string{"*"},
+					Routes:  SimpleRoute(us),
+				},
+			},
+		},
+	}
+}
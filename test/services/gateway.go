@@ -99,7 +99,7 @@ type RunOptions struct {
 	ConsulDnsAddress string
 }
 
-//noinspection GoUnhandledErrorResult
+// noinspection GoUnhandledErrorResult
 func RunGlooGatewayUdsFds(ctx context.Context, runOptions *RunOptions) TestClients {
 	if runOptions.GlooPort == 0 {
 		runOptions.GlooPort = AllocateGlooPort()
@@ -210,13 +210,19 @@ func defaultTestConstructOpts(ctx context.Context, runOptions *RunOptions) trans
 	}
 
 	return translator.Opts{
-		GlooNamespace:   meta.GetNamespace(),
-		WriteNamespace:  runOptions.NsToWrite,
-		WatchNamespaces: runOptions.NsToWatch,
-		Gateways:        f,
-		VirtualServices: f,
-		RouteTables:     f,
-		Proxies:         f,
+		GlooNamespace:         meta.GetNamespace(),
+		WriteNamespace:        runOptions.NsToWrite,
+		WatchNamespaces:       runOptions.NsToWatch,
+		Gateways:              f,
+		VirtualServices:       f,
+		RouteTables:           f,
+		RouteOptions:          f,
+		VirtualHostOptions:    f,
+		MatchableHttpGateways: f,
+		ReferencePolicies:     f,
+		Canaries:              f,
+		UpstreamGroups:        f,
+		Proxies:               f,
 		WatchOpts: clients.WatchOpts{
 			Ctx:         ctx,
 			RefreshRate: time.Minute,
@@ -33,6 +33,7 @@ type ReceivedRequest struct {
 	URL         *url.URL
 	Body        []byte
 	Host        string
+	Headers     http.Header
 	GRPCRequest proto.Message
 	Port        uint32
 }
@@ -141,6 +142,7 @@ func runTestServer(ctx context.Context, reply string, serveTls bool) (uint32, <-
 
 		rr.Host = r.Host
 		rr.URL = r.URL
+		rr.Headers = r.Header
 
 		bodyChan <- &rr
 	}